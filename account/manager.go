@@ -30,6 +30,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/cipher"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/audit"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -40,6 +41,9 @@ const (
 	EccSecp256K1Value = 1
 )
 
+// DefaultSigningPassphraseEnv is the environment variable holding the signing key passphrase at boot.
+const DefaultSigningPassphraseEnv = "NEB_SIGNING_PASSPHRASE"
+
 var (
 	// ErrAddrNotFind address not find.
 	ErrAddrNotFind = errors.New("address not find")
@@ -52,6 +56,9 @@ var (
 
 	// ErrTxSignFrom sign addr not from
 	ErrTxSignFrom = errors.New("transaction sign not use from addr")
+
+	// ErrSigningKeyRestricted the signing key can only sign blocks.
+	ErrSigningKeyRestricted = errors.New("signing key is restricted to sign blocks")
 )
 
 // Neblet interface breaks cycle import dependency and hides unused services.
@@ -76,6 +83,15 @@ type Manager struct {
 
 	// account slice
 	accounts []*account
+
+	// signing key save path, apart from keydir
+	signingKeydir string
+
+	// block signing address, never usable through the account apis
+	signer *core.Address
+
+	// policy of transactions signed with local keys
+	policy *Policy
 }
 
 // NewManager new a account manager
@@ -85,6 +101,7 @@ func NewManager(neblet Neblet) *Manager {
 	m.signatureAlg = keystore.SECP256K1
 	m.encryptAlg = keystore.SCRYPT
 	m.keydir, _ = filepath.Abs("keydir")
+	m.policy, _ = NewPolicy(nil)
 
 	if neblet != nil {
 		// conf := neblet.Config().Account
@@ -97,6 +114,26 @@ func NewManager(neblet Neblet) *Manager {
 			m.keydir, _ = filepath.Abs(keydir)
 		}
 
+		policy, err := NewPolicy(neblet.Config().TxPolicy)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Fatal("Failed to load the transaction policy.")
+		}
+		m.policy = policy
+
+		if len(conf.SigningKeydir) > 0 {
+			m.signingKeydir, _ = filepath.Abs(conf.SigningKeydir)
+			if signer, err := core.AddressParse(conf.Miner); err == nil {
+				m.signer = signer
+			} else {
+				logging.CLog().WithFields(logrus.Fields{
+					"miner": conf.Miner,
+					"err":   err,
+				}).Error("Failed to parse the signing address.")
+			}
+		}
+
 		if len(conf.SignatureCiphers) > 0 {
 			if conf.SignatureCiphers[0] == EccSecp256K1 {
 				m.signatureAlg = keystore.Algorithm(EccSecp256K1Value)
@@ -132,6 +169,10 @@ func (m *Manager) storeAddress(priv keystore.PrivateKey, passphrase []byte, writ
 	if err != nil {
 		return nil, err
 	}
+	// the signing key is never replaced by a loaded or imported one.
+	if m.isSigner(addr) {
+		return nil, ErrSigningKeyRestricted
+	}
 	// set key to keystore
 	err = m.ks.SetKey(addr.String(), priv, passphrase)
 	if err != nil {
@@ -167,6 +208,38 @@ func (m *Manager) Contains(addr *core.Address) bool {
 
 // Unlock unlock address with passphrase
 func (m *Manager) Unlock(addr *core.Address, passphrase []byte, duration time.Duration) error {
+	if m.isSigner(addr) {
+		audit.Record(audit.ActionKeyUnlock, audit.OriginNode, addr.String(), duration.String(), ErrSigningKeyRestricted)
+		return ErrSigningKeyRestricted
+	}
+	err := m.unlock(addr, passphrase, duration)
+	audit.Record(audit.ActionKeyUnlock, audit.OriginNode, addr.String(), duration.String(), err)
+	return err
+}
+
+// UnlockSigner unlock the block signing key for mining. The key is loaded from
+// signing keydir when configured, otherwise it is a wallet key in keydir.
+func (m *Manager) UnlockSigner(addr *core.Address, passphrase []byte) error {
+	err := m.unlockSigner(addr, passphrase)
+	audit.Record(audit.ActionKeyUnlock, audit.OriginNode, addr.String(), "signer", err)
+	return err
+}
+
+func (m *Manager) unlockSigner(addr *core.Address, passphrase []byte) error {
+	if !m.isSigner(addr) {
+		return m.unlock(addr, passphrase, keystore.YearUnlockDuration)
+	}
+	if err := m.loadSigningFile(addr, passphrase); err != nil {
+		return err
+	}
+	return m.ks.Unlock(addr.String(), passphrase, keystore.YearUnlockDuration)
+}
+
+func (m *Manager) isSigner(addr *core.Address) bool {
+	return m.signer != nil && m.signer.Equals(addr)
+}
+
+func (m *Manager) unlock(addr *core.Address, passphrase []byte, duration time.Duration) error {
 	res, err := m.ks.ContainsAlias(addr.String())
 	if err != nil || res == false {
 		err = m.loadFile(addr, passphrase)
@@ -179,7 +252,13 @@ func (m *Manager) Unlock(addr *core.Address, passphrase []byte, duration time.Du
 
 // Lock lock address
 func (m *Manager) Lock(addr *core.Address) error {
-	return m.ks.Lock(addr.String())
+	if m.isSigner(addr) {
+		audit.Record(audit.ActionKeyLock, audit.OriginNode, addr.String(), "", ErrSigningKeyRestricted)
+		return ErrSigningKeyRestricted
+	}
+	err := m.ks.Lock(addr.String())
+	audit.Record(audit.ActionKeyLock, audit.OriginNode, addr.String(), "", err)
+	return err
 }
 
 // Accounts returns slice of address
@@ -194,6 +273,9 @@ func (m *Manager) Accounts() []*core.Address {
 
 // Update update addr locked passphrase
 func (m *Manager) Update(addr *core.Address, oldPassphrase, newPassphrase []byte) error {
+	if m.isSigner(addr) {
+		return ErrSigningKeyRestricted
+	}
 	key, err := m.ks.GetKey(addr.String(), oldPassphrase)
 	if err != nil {
 		err = m.loadFile(addr, oldPassphrase)
@@ -230,6 +312,9 @@ func (m *Manager) readKey(keyjson, passphrase []byte, write bool) (*core.Address
 
 // Export export address to key file
 func (m *Manager) Export(addr *core.Address, passphrase []byte) ([]byte, error) {
+	if m.isSigner(addr) {
+		return nil, ErrSigningKeyRestricted
+	}
 	key, err := m.ks.GetKey(addr.String(), passphrase)
 	if err != nil {
 		return nil, err
@@ -251,6 +336,9 @@ func (m *Manager) Export(addr *core.Address, passphrase []byte) ([]byte, error)
 
 // Delete delete address
 func (m *Manager) Delete(addr *core.Address, passphrase []byte) error {
+	if m.isSigner(addr) {
+		return ErrSigningKeyRestricted
+	}
 	err := m.ks.Delete(addr.String(), passphrase)
 	if err != nil {
 		return err
@@ -265,6 +353,9 @@ func (m *Manager) SignTransaction(addr *core.Address, tx *core.Transaction) erro
 	if !tx.From().Equals(addr) {
 		return ErrTxSignFrom
 	}
+	if m.isSigner(addr) {
+		return ErrSigningKeyRestricted
+	}
 	key, err := m.ks.GetUnlocked(addr.String())
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -275,12 +366,25 @@ func (m *Manager) SignTransaction(addr *core.Address, tx *core.Transaction) erro
 		return err
 	}
 
-	signature, err := crypto.NewSignature(m.signatureAlg)
-	if err != nil {
+	return m.signTx(addr, key, tx)
+}
+
+// signTx sign transaction with the key if the policy allows it, the policy
+// counts its value once signed.
+func (m *Manager) signTx(addr *core.Address, key keystore.Key, tx *core.Transaction) error {
+	var hash string
+	err := m.policy.Sign(tx, func() error {
+		signature, err := crypto.NewSignature(m.signatureAlg)
+		if err != nil {
+			return err
+		}
+		signature.InitSign(key.(keystore.PrivateKey))
+		err = tx.Sign(signature)
+		hash = tx.Hash().String()
 		return err
-	}
-	signature.InitSign(key.(keystore.PrivateKey))
-	return tx.Sign(signature)
+	})
+	audit.Record(audit.ActionSignTx, audit.OriginNode, addr.String(), hash, err)
+	return err
 }
 
 // SignBlock sign block with the specified algorithm
@@ -300,7 +404,34 @@ func (m *Manager) SignBlock(addr *core.Address, block *core.Block) error {
 		return err
 	}
 	signature.InitSign(key.(keystore.PrivateKey))
-	return block.Sign(signature)
+	err = block.Sign(signature)
+	audit.Record(audit.ActionSignBlock, audit.OriginNode, addr.String(), block.Hash().String(), err)
+	return err
+}
+
+// SignSnapshot sign the attestation of a snapshot published for bootstrapping nodes
+func (m *Manager) SignSnapshot(addr *core.Address, attestation *core.SnapshotAttestation) error {
+	if m.isSigner(addr) {
+		return ErrSigningKeyRestricted
+	}
+	key, err := m.ks.GetUnlocked(addr.String())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"func":     "SignSnapshot",
+			"err":      err,
+			"snapshot": attestation.ID,
+		}).Error("snapshot signer's address locked")
+		return err
+	}
+
+	signature, err := crypto.NewSignature(m.signatureAlg)
+	if err != nil {
+		return err
+	}
+	signature.InitSign(key.(keystore.PrivateKey))
+	err = attestation.Sign(signature)
+	audit.Record(audit.ActionSignSnapshot, audit.OriginNode, addr.String(), attestation.Hash().String(), err)
+	return err
 }
 
 // SignTransactionWithPassphrase sign transaction with the from passphrase
@@ -309,6 +440,9 @@ func (m *Manager) SignTransactionWithPassphrase(addr *core.Address, tx *core.Tra
 	if !tx.From().Equals(addr) {
 		return ErrTxSignFrom
 	}
+	if m.isSigner(addr) {
+		return ErrSigningKeyRestricted
+	}
 	res, err := m.ks.ContainsAlias(addr.String())
 	if err != nil || res == false {
 		err = m.loadFile(addr, passphrase)
@@ -327,10 +461,5 @@ func (m *Manager) SignTransactionWithPassphrase(addr *core.Address, tx *core.Tra
 		return err
 	}
 
-	signature, err := crypto.NewSignature(m.signatureAlg)
-	if err != nil {
-		return err
-	}
-	signature.InitSign(key.(keystore.PrivateKey))
-	return tx.Sign(signature)
+	return m.signTx(addr, key, tx)
 }
@@ -26,6 +26,8 @@ import (
 	"strings"
 
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/cipher"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -42,10 +44,20 @@ type account struct {
 
 // refreshAccounts sync key files to memory
 func (m *Manager) refreshAccounts() error {
-	files, err := ioutil.ReadDir(m.keydir)
+	accounts, err := readAccounts(m.keydir)
 	if err != nil {
 		return err
 	}
+	m.accounts = accounts
+	return nil
+}
+
+// readAccounts parse the address of key files in dir
+func readAccounts(dir string) ([]*account, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
 	var (
 		accounts []*account
 		keyJSON  struct {
@@ -53,7 +65,7 @@ func (m *Manager) refreshAccounts() error {
 		}
 	)
 	for _, file := range files {
-		path := filepath.Join(m.keydir, file.Name())
+		path := filepath.Join(dir, file.Name())
 		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || strings.HasSuffix(file.Name(), "~") {
 			logging.VLog().WithFields(logrus.Fields{
 				"path": path,
@@ -97,8 +109,7 @@ func (m *Manager) refreshAccounts() error {
 		}
 		accounts = append(accounts, &account{addr, path})
 	}
-	m.accounts = accounts
-	return nil
+	return accounts, nil
 }
 
 // loadFile import key to keystore in keydir
@@ -115,6 +126,41 @@ func (m *Manager) loadFile(addr *core.Address, passphrase []byte) error {
 	return err
 }
 
+// loadSigningFile import the signing key in signing keydir to keystore,
+// it is not added to the wallet accounts.
+func (m *Manager) loadSigningFile(addr *core.Address, passphrase []byte) error {
+	accounts, err := readAccounts(m.signingKeydir)
+	if err != nil {
+		return err
+	}
+	for _, acc := range accounts {
+		if !acc.addr.Equals(addr) {
+			continue
+		}
+		raw, err := ioutil.ReadFile(acc.path)
+		if err != nil {
+			return err
+		}
+		data, err := cipher.NewCipher(uint8(m.encryptAlg)).DecryptKey(raw, passphrase)
+		if err != nil {
+			return err
+		}
+		priv, err := crypto.NewPrivateKey(m.signatureAlg, data)
+		if err != nil {
+			return err
+		}
+		pub, err := priv.PublicKey().Encoded()
+		if err != nil {
+			return err
+		}
+		if signer, err := core.NewAddressFromPublicKey(pub); err != nil || !signer.Equals(addr) {
+			return ErrAddrNotFind
+		}
+		return m.ks.SetKey(addr.String(), priv, passphrase)
+	}
+	return ErrAddrNotFind
+}
+
 func (m *Manager) exportFile(addr *core.Address, passphrase []byte) (path string, err error) {
 	raw, err := m.Export(addr, passphrase)
 	if err != nil {
@@ -19,10 +19,14 @@
 package account
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -217,3 +221,63 @@ func TestManager_SignTransactionWithPassphrase(t *testing.T) {
 		})
 	}
 }
+
+type mockNeb struct {
+	config nebletpb.Config
+}
+
+func (n *mockNeb) Config() nebletpb.Config {
+	return n.config
+}
+
+func TestManager_SigningKey(t *testing.T) {
+	keydir, err := ioutil.TempDir("", "keydir")
+	assert.Nil(t, err)
+	defer os.RemoveAll(keydir)
+	signingKeydir, err := ioutil.TempDir("", "signingkeydir")
+	assert.Nil(t, err)
+	defer os.RemoveAll(signingKeydir)
+
+	// move a new key from keydir to signing keydir.
+	passphrase := []byte("passphrase")
+	wallet := NewManager(&mockNeb{nebletpb.Config{Chain: &nebletpb.ChainConfig{Keydir: keydir}}})
+	signer, err := wallet.NewAccount(passphrase)
+	assert.Nil(t, err)
+	keyJSON, err := wallet.Export(signer, passphrase)
+	assert.Nil(t, err)
+	assert.Nil(t, WriteFile(filepath.Join(signingKeydir, signer.String()), keyJSON))
+	assert.Nil(t, wallet.Delete(signer, passphrase))
+
+	manager := NewManager(&mockNeb{nebletpb.Config{Chain: &nebletpb.ChainConfig{
+		Keydir:        keydir,
+		SigningKeydir: signingKeydir,
+		Miner:         signer.String(),
+	}}})
+	assert.NotContains(t, manager.Accounts(), signer)
+
+	// the signing key is never usable through the account apis.
+	assert.Equal(t, ErrSigningKeyRestricted, manager.Unlock(signer, passphrase, keystore.DefaultUnlockDuration))
+	_, err = manager.Export(signer, passphrase)
+	assert.Equal(t, ErrSigningKeyRestricted, err)
+	assert.Equal(t, ErrSigningKeyRestricted, manager.Delete(signer, passphrase))
+	assert.Equal(t, ErrSigningKeyRestricted, manager.Update(signer, passphrase, []byte("new")))
+	_, err = manager.Load(keyJSON, passphrase)
+	assert.Equal(t, ErrSigningKeyRestricted, err)
+	_, err = manager.Import(keyJSON, passphrase)
+	assert.Equal(t, ErrSigningKeyRestricted, err)
+
+	assert.NotNil(t, manager.UnlockSigner(signer, []byte("wrong")))
+	assert.Nil(t, manager.UnlockSigner(signer, passphrase))
+	assert.Equal(t, ErrSigningKeyRestricted, manager.Lock(signer))
+
+	tx := core.NewTransaction(0, signer, signer, util.NewUint128FromInt(5), 0, core.TxPayloadBinaryType, nil, util.NewUint128FromInt(1), util.NewUint128FromInt(5))
+	assert.Equal(t, ErrSigningKeyRestricted, manager.SignTransaction(signer, tx))
+	assert.Equal(t, ErrSigningKeyRestricted, manager.SignTransactionWithPassphrase(signer, tx, passphrase))
+
+	// wallet keys are not affected.
+	spender, err := manager.NewAccount(passphrase)
+	assert.Nil(t, err)
+	assert.Nil(t, manager.Unlock(spender, passphrase, keystore.DefaultUnlockDuration))
+	assert.Nil(t, manager.Lock(spender))
+	assert.Nil(t, manager.Delete(spender, passphrase))
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package account
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+var (
+	// ErrPolicyValueExceeded transaction value exceeds the per transaction limit.
+	ErrPolicyValueExceeded = errors.New("transaction value exceeds the policy limit")
+
+	// ErrPolicyDailyValueExceeded transaction value exceeds the daily limit of the sender.
+	ErrPolicyDailyValueExceeded = errors.New("transaction value exceeds the policy daily limit")
+
+	// ErrPolicyDestinationNotAllowed transaction destination is not in the allowlist.
+	ErrPolicyDestinationNotAllowed = errors.New("transaction destination is not allowed by policy")
+
+	// ErrPolicyContractCallDenied calling smart contracts is denied.
+	ErrPolicyContractCallDenied = errors.New("contract call is denied by policy")
+
+	// ErrPolicyContractDeployDenied deploying smart contracts is denied.
+	ErrPolicyContractDeployDenied = errors.New("contract deploy is denied by policy")
+
+	// ErrInvalidPolicyConfig policy config is invalid.
+	ErrInvalidPolicyConfig = errors.New("invalid transaction policy config")
+)
+
+// dailySpend is the value sent by an address in a day.
+type dailySpend struct {
+	day   string
+	total *util.Uint128
+}
+
+// Policy allows or denies the transactions signed with local keys, so that
+// custodial operators can enforce withdrawal limits at the node.
+// The daily spends are kept in memory and reset on restart.
+type Policy struct {
+	maxValuePerTx      *util.Uint128
+	maxValuePerDay     *util.Uint128
+	allowlist          map[string]bool
+	denyContractCall   bool
+	denyContractDeploy bool
+
+	mu     sync.Mutex
+	spends map[string]*dailySpend
+	now    func() time.Time
+}
+
+// NewPolicy create a transaction policy, nil config allows all transactions.
+func NewPolicy(conf *nebletpb.TxPolicyConfig) (*Policy, error) {
+	p := &Policy{
+		allowlist: make(map[string]bool),
+		spends:    make(map[string]*dailySpend),
+		now:       time.Now,
+	}
+	if conf == nil {
+		return p, nil
+	}
+
+	var err error
+	if p.maxValuePerTx, err = parsePolicyValue(conf.MaxValuePerTx); err != nil {
+		return nil, err
+	}
+	if p.maxValuePerDay, err = parsePolicyValue(conf.MaxValuePerDay); err != nil {
+		return nil, err
+	}
+	for _, v := range conf.DestinationAllowlist {
+		addr, err := core.AddressParse(v)
+		if err != nil {
+			return nil, ErrInvalidPolicyConfig
+		}
+		p.allowlist[addr.String()] = true
+	}
+	p.denyContractCall = conf.DenyContractCall
+	p.denyContractDeploy = conf.DenyContractDeploy
+	return p, nil
+}
+
+func parsePolicyValue(v string) (*util.Uint128, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	value, ok := util.NewUint128().FromString(v)
+	if !ok {
+		return nil, ErrInvalidPolicyConfig
+	}
+	return value, nil
+}
+
+// Sign signs the transaction with sign if the policy allows it, a signed
+// transaction is counted into the daily spend of its sender. The policy is
+// locked across the check and the signing, so concurrent transactions don't
+// both pass the daily limit, and a transaction failing to sign isn't counted.
+func (p *Policy) Sign(tx *core.Transaction, sign func() error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	spend, err := p.check(tx)
+	if err != nil {
+		return err
+	}
+	if err := sign(); err != nil {
+		return err
+	}
+	if spend != nil {
+		p.spends[tx.From().String()] = spend
+	}
+	return nil
+}
+
+// check returns the daily spend of the sender with the transaction counted,
+// nil without a daily limit, or the policy error.
+func (p *Policy) check(tx *core.Transaction) (*dailySpend, error) {
+	switch tx.Type() {
+	case core.TxPayloadCallType:
+		if p.denyContractCall {
+			return nil, ErrPolicyContractCallDenied
+		}
+	case core.TxPayloadDeployType, core.TxPayloadLibraryType:
+		if p.denyContractDeploy {
+			return nil, ErrPolicyContractDeployDenied
+		}
+	}
+
+	// deploy transactions send their value to the destination too, so they
+	// are checked like the others.
+	if len(p.allowlist) > 0 && !p.allowlist[tx.To().String()] {
+		return nil, ErrPolicyDestinationNotAllowed
+	}
+
+	value := tx.Value()
+	if p.maxValuePerTx != nil && value.Cmp(p.maxValuePerTx.Int) > 0 {
+		return nil, ErrPolicyValueExceeded
+	}
+	if p.maxValuePerDay == nil {
+		return nil, nil
+	}
+
+	day := p.now().UTC().Format("2006-01-02")
+	total := util.NewUint128()
+	if spend, ok := p.spends[tx.From().String()]; ok && spend.day == day {
+		total.Add(spend.total.Int, value.Int)
+	} else {
+		total.Add(total.Int, value.Int)
+	}
+	if total.Cmp(p.maxValuePerDay.Int) > 0 {
+		return nil, ErrPolicyDailyValueExceeded
+	}
+	return &dailySpend{day: day, total: total}, nil
+}
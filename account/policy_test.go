@@ -0,0 +1,99 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package account
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func signed() error { return nil }
+
+func mockPolicyTx(from, to *core.Address, value int64, payloadType string) *core.Transaction {
+	return core.NewTransaction(100, from, to, util.NewUint128FromInt(value), 1, payloadType, nil, util.NewUint128FromInt(1), util.NewUint128FromInt(20000))
+}
+
+func TestNewPolicy(t *testing.T) {
+	_, err := NewPolicy(&nebletpb.TxPolicyConfig{MaxValuePerTx: "abc"})
+	assert.Equal(t, ErrInvalidPolicyConfig, err)
+	_, err = NewPolicy(&nebletpb.TxPolicyConfig{DestinationAllowlist: []string{"0x01"}})
+	assert.Equal(t, ErrInvalidPolicyConfig, err)
+
+	p, err := NewPolicy(nil)
+	assert.Nil(t, err)
+	from := &core.Address{}
+	assert.Nil(t, p.Sign(mockPolicyTx(from, from, 1000000, core.TxPayloadCallType), signed))
+}
+
+func TestPolicy_Sign(t *testing.T) {
+	from, _ := core.AddressParse("eb31ad2d8a89a0ca6935c308d5425730430bc2d63f2573b8")
+	to, _ := core.AddressParse("75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f")
+	other, _ := core.AddressParse("7da9dabedb4c6e121146fb4250a9883d6180570e63d6b080")
+
+	p, err := NewPolicy(&nebletpb.TxPolicyConfig{
+		MaxValuePerTx:        "100",
+		MaxValuePerDay:       "150",
+		DestinationAllowlist: []string{to.String()},
+		DenyContractCall:     true,
+	})
+	assert.Nil(t, err)
+	now := time.Date(2018, 1, 1, 23, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+
+	assert.Equal(t, ErrPolicyContractCallDenied, p.Sign(mockPolicyTx(from, to, 1, core.TxPayloadCallType), signed))
+	assert.Equal(t, ErrPolicyDestinationNotAllowed, p.Sign(mockPolicyTx(from, other, 1, core.TxPayloadBinaryType), signed))
+	assert.Equal(t, ErrPolicyDestinationNotAllowed, p.Sign(mockPolicyTx(from, from, 1, core.TxPayloadDeployType), signed))
+	assert.Equal(t, ErrPolicyValueExceeded, p.Sign(mockPolicyTx(from, to, 101, core.TxPayloadBinaryType), signed))
+
+	// a transaction failing to sign isn't counted.
+	failed := errors.New("sign failed")
+	assert.Equal(t, failed, p.Sign(mockPolicyTx(from, to, 100, core.TxPayloadBinaryType), func() error { return failed }))
+
+	assert.Nil(t, p.Sign(mockPolicyTx(from, to, 100, core.TxPayloadBinaryType), signed))
+	assert.Equal(t, ErrPolicyDailyValueExceeded, p.Sign(mockPolicyTx(from, to, 51, core.TxPayloadBinaryType), signed))
+	assert.Nil(t, p.Sign(mockPolicyTx(from, to, 50, core.TxPayloadBinaryType), signed))
+
+	// other senders have their own limit.
+	assert.Nil(t, p.Sign(mockPolicyTx(to, to, 100, core.TxPayloadBinaryType), signed))
+
+	// a new day resets the limit.
+	now = now.Add(time.Hour)
+	assert.Nil(t, p.Sign(mockPolicyTx(from, to, 100, core.TxPayloadBinaryType), signed))
+}
+
+func TestManager_SignTransactionPolicy(t *testing.T) {
+	manager := NewManager(nil)
+	policy, err := NewPolicy(&nebletpb.TxPolicyConfig{MaxValuePerTx: "10"})
+	assert.Nil(t, err)
+	manager.policy = policy
+
+	passphrase := []byte("passphrase")
+	addr, err := manager.NewAccount(passphrase)
+	assert.Nil(t, err)
+	defer manager.Delete(addr, passphrase)
+
+	assert.Equal(t, ErrPolicyValueExceeded, manager.SignTransactionWithPassphrase(addr, mockPolicyTx(addr, addr, 11, core.TxPayloadBinaryType), passphrase))
+	assert.Nil(t, manager.SignTransactionWithPassphrase(addr, mockPolicyTx(addr, addr, 10, core.TxPayloadBinaryType), passphrase))
+}
@@ -0,0 +1,345 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package bench
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// Default settings of a benchmark.
+const (
+	DefaultDuration       = 30 * time.Second
+	DefaultRate           = 100
+	DefaultConfirmTimeout = 60 * time.Second
+	DefaultPollInterval   = 500 * time.Millisecond
+)
+
+// Errors
+var (
+	ErrNoSender         = errors.New("benchmark requires at least one sender")
+	ErrInvalidRate      = errors.New("benchmark rate must be positive")
+	ErrInvalidMix       = errors.New("benchmark tx mix must have a positive weight")
+	ErrContractRequired = errors.New("contract calls in the tx mix require a contract address")
+)
+
+// Signer signs the transactions of the senders.
+type Signer interface {
+	SignTransaction(addr *core.Address, tx *core.Transaction) error
+}
+
+// Config is the settings of a benchmark.
+type Config struct {
+	// Senders of the transactions, sending in turn.
+	From []*core.Address
+	// Receiver of the transfers, the sender itself if not set.
+	To    *core.Address
+	Value *util.Uint128
+
+	// Duration of submitting transactions.
+	Duration time.Duration
+	// Transactions submitted per second in total.
+	Rate int
+
+	// Weights of transfers and contract calls in the tx mix.
+	Transfers int
+	Calls     int
+	Contract  *core.Address
+	Function  string
+	Args      string
+
+	GasPrice *util.Uint128
+	GasLimit *util.Uint128
+
+	// How long to wait for the confirmation of submitted transactions after the submission ends.
+	ConfirmTimeout time.Duration
+	PollInterval   time.Duration
+
+	// Pid of the node process to sample resource usage from, 0 for the benchmark process.
+	Pid int
+}
+
+// Bench submits a tx mix to a node and measures the throughput and confirmation latency.
+type Bench struct {
+	conf   *Config
+	client rpcpb.ApiServiceClient
+	signer Signer
+
+	chainID uint32
+
+	mu        sync.Mutex
+	pending   map[string]time.Time
+	latencies []time.Duration
+	report    *Report
+}
+
+// NewBench returns a benchmark submitting through the client.
+func NewBench(conf *Config, client rpcpb.ApiServiceClient, signer Signer) (*Bench, error) {
+	if len(conf.From) == 0 {
+		return nil, ErrNoSender
+	}
+	if conf.Rate <= 0 {
+		return nil, ErrInvalidRate
+	}
+	if conf.Transfers < 0 || conf.Calls < 0 || conf.Transfers+conf.Calls == 0 {
+		return nil, ErrInvalidMix
+	}
+	if conf.Calls > 0 && conf.Contract == nil {
+		return nil, ErrContractRequired
+	}
+	if conf.Duration <= 0 {
+		conf.Duration = DefaultDuration
+	}
+	if conf.ConfirmTimeout <= 0 {
+		conf.ConfirmTimeout = DefaultConfirmTimeout
+	}
+	if conf.PollInterval <= 0 {
+		conf.PollInterval = DefaultPollInterval
+	}
+	if conf.Value == nil {
+		conf.Value = util.NewUint128()
+	}
+	if conf.GasPrice == nil {
+		conf.GasPrice = core.TransactionGasPrice
+	}
+	if conf.GasLimit == nil {
+		conf.GasLimit = util.NewUint128FromInt(200000)
+	}
+	return &Bench{
+		conf:    conf,
+		client:  client,
+		signer:  signer,
+		pending: make(map[string]time.Time),
+		report:  &Report{Senders: len(conf.From)},
+	}, nil
+}
+
+// Run submits the transactions for the configured duration, waits for their
+// confirmation and returns the report.
+func (b *Bench) Run(ctx context.Context) (*Report, error) {
+	state, err := b.client.GetNebState(ctx, &rpcpb.NonParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	b.chainID = state.ChainId
+
+	nonces := make([]uint64, len(b.conf.From))
+	for i, from := range b.conf.From {
+		acc, err := b.client.GetAccountState(ctx, &rpcpb.GetAccountStateRequest{Address: from.String()})
+		if err != nil {
+			return nil, err
+		}
+		if nonces[i], err = strconv.ParseUint(acc.Nonce, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	sampler := newUsageSampler(b.conf.Pid)
+	start := time.Now()
+
+	confirmDone := make(chan struct{})
+	submitDone := make(chan struct{})
+	go func() {
+		b.confirmLoop(ctx, submitDone)
+		close(confirmDone)
+	}()
+
+	b.submit(ctx, nonces)
+	b.report.SubmitElapsed = time.Since(start)
+	close(submitDone)
+	<-confirmDone
+
+	b.report.Elapsed = time.Since(start)
+	b.report.Usage = sampler.usage()
+	b.report.summarize(b.latencies)
+	return b.report, nil
+}
+
+// submit sends ticks at the configured rate to a worker per sender, so the
+// nonces of each sender are submitted in order.
+func (b *Bench) submit(ctx context.Context, nonces []uint64) {
+	ticks := make([]chan uint64, len(b.conf.From))
+	wg := new(sync.WaitGroup)
+	for i := range b.conf.From {
+		ticks[i] = make(chan uint64, b.conf.Rate)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nonce := nonces[i]
+			for seq := range ticks[i] {
+				nonce++
+				if err := b.send(ctx, b.conf.From[i], nonce, seq); err != nil {
+					// the nonce is not consumed by a rejected transaction.
+					nonce--
+				}
+			}
+		}(i)
+	}
+
+	ticker := time.NewTicker(time.Second / time.Duration(b.conf.Rate))
+	defer ticker.Stop()
+	timer := time.NewTimer(b.conf.Duration)
+	defer timer.Stop()
+
+	seq := uint64(0)
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-timer.C:
+			break loop
+		case <-ticker.C:
+			select {
+			case ticks[seq%uint64(len(ticks))] <- seq:
+				seq++
+			default:
+				// the sender can't keep up with the rate.
+				b.mu.Lock()
+				b.report.Skipped++
+				b.mu.Unlock()
+			}
+		}
+	}
+	for _, ch := range ticks {
+		close(ch)
+	}
+	wg.Wait()
+}
+
+func (b *Bench) send(ctx context.Context, from *core.Address, nonce, seq uint64) error {
+	tx, err := b.newTransaction(from, nonce, seq)
+	if err == nil {
+		err = b.signer.SignTransaction(from, tx)
+	}
+	var data []byte
+	if err == nil {
+		var msg proto.Message
+		if msg, err = tx.ToProto(); err == nil {
+			data, err = proto.Marshal(msg)
+		}
+	}
+	if err == nil {
+		_, err = b.client.SendRawTransaction(ctx, &rpcpb.SendRawTransactionRequest{Data: data})
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.report.Submitted++
+	if err != nil {
+		b.report.Rejected++
+		logging.VLog().WithFields(logrus.Fields{
+			"from":  from,
+			"nonce": nonce,
+			"err":   err,
+		}).Debug("Failed to submit benchmark transaction.")
+		return err
+	}
+	b.pending[tx.Hash().String()] = time.Now()
+	return nil
+}
+
+// newTransaction returns the seq-th transaction of the mix.
+func (b *Bench) newTransaction(from *core.Address, nonce, seq uint64) (*core.Transaction, error) {
+	if seq%uint64(b.conf.Transfers+b.conf.Calls) < uint64(b.conf.Transfers) {
+		to := b.conf.To
+		if to == nil {
+			to = from
+		}
+		return core.NewTransaction(b.chainID, from, to, b.conf.Value, nonce, core.TxPayloadBinaryType, nil, b.conf.GasPrice, b.conf.GasLimit), nil
+	}
+	payload, err := core.NewCallPayload(b.conf.Function, b.conf.Args).ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return core.NewTransaction(b.chainID, from, b.conf.Contract, util.NewUint128(), nonce, core.TxPayloadCallType, payload, b.conf.GasPrice, b.conf.GasLimit), nil
+}
+
+// confirmLoop polls the receipts of pending transactions until all are
+// confirmed or the confirm timeout passes after the submission ends.
+func (b *Bench) confirmLoop(ctx context.Context, submitDone <-chan struct{}) {
+	ticker := time.NewTicker(b.conf.PollInterval)
+	defer ticker.Stop()
+
+	var deadline <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			b.expire()
+			return
+		case <-submitDone:
+			submitDone = nil
+			deadline = time.After(b.conf.ConfirmTimeout)
+		case <-deadline:
+			b.expire()
+			return
+		case <-ticker.C:
+			b.poll(ctx)
+			if submitDone == nil && b.pendingCount() == 0 {
+				return
+			}
+		}
+	}
+}
+
+func (b *Bench) poll(ctx context.Context) {
+	b.mu.Lock()
+	hashes := make([]string, 0, len(b.pending))
+	for hash := range b.pending {
+		hashes = append(hashes, hash)
+	}
+	b.mu.Unlock()
+
+	for _, hash := range hashes {
+		if _, err := b.client.GetTransactionReceipt(ctx, &rpcpb.GetTransactionByHashRequest{Hash: hash}); err != nil {
+			continue
+		}
+		now := time.Now()
+		b.mu.Lock()
+		if submitted, ok := b.pending[hash]; ok {
+			b.latencies = append(b.latencies, now.Sub(submitted))
+			delete(b.pending, hash)
+			b.report.Confirmed++
+		}
+		b.mu.Unlock()
+	}
+}
+
+func (b *Bench) pendingCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending)
+}
+
+func (b *Bench) expire() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.report.Unconfirmed += len(b.pending)
+	b.pending = make(map[string]time.Time)
+}
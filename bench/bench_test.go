@@ -0,0 +1,146 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package bench
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type mockClient struct {
+	rpcpb.ApiServiceClient
+
+	mu    sync.Mutex
+	txs   map[string]*corepb.Transaction
+	calls int
+}
+
+func (c *mockClient) GetNebState(ctx context.Context, in *rpcpb.NonParamsRequest, opts ...grpc.CallOption) (*rpcpb.GetNebStateResponse, error) {
+	return &rpcpb.GetNebStateResponse{ChainId: 100}, nil
+}
+
+func (c *mockClient) GetAccountState(ctx context.Context, in *rpcpb.GetAccountStateRequest, opts ...grpc.CallOption) (*rpcpb.GetAccountStateResponse, error) {
+	return &rpcpb.GetAccountStateResponse{Balance: "0", Nonce: "5"}, nil
+}
+
+func (c *mockClient) SendRawTransaction(ctx context.Context, in *rpcpb.SendRawTransactionRequest, opts ...grpc.CallOption) (*rpcpb.SendTransactionResponse, error) {
+	tx := new(corepb.Transaction)
+	if err := proto.Unmarshal(in.Data, tx); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if tx.Data.Type == core.TxPayloadCallType {
+		c.calls++
+	}
+	c.txs[byteutils.Hex(tx.Hash)] = tx
+	return &rpcpb.SendTransactionResponse{Txhash: byteutils.Hex(tx.Hash)}, nil
+}
+
+func (c *mockClient) GetTransactionReceipt(ctx context.Context, in *rpcpb.GetTransactionByHashRequest, opts ...grpc.CallOption) (*rpcpb.TransactionReceiptResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tx, ok := c.txs[in.Hash]
+	if !ok {
+		return nil, errors.New("transaction not found")
+	}
+	return &rpcpb.TransactionReceiptResponse{Hash: in.Hash, Nonce: tx.Nonce}, nil
+}
+
+type mockSigner struct {
+	signature keystore.Signature
+}
+
+func (s *mockSigner) SignTransaction(addr *core.Address, tx *core.Transaction) error {
+	return tx.Sign(s.signature)
+}
+
+func TestBench(t *testing.T) {
+	priv := secp256k1.GeneratePrivateKey()
+	pub, _ := priv.PublicKey().Encoded()
+	from, _ := core.NewAddressFromPublicKey(pub)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+
+	_, err := NewBench(&Config{Rate: 10, Transfers: 1}, nil, nil)
+	assert.Equal(t, ErrNoSender, err)
+	_, err = NewBench(&Config{From: []*core.Address{from}, Transfers: 1}, nil, nil)
+	assert.Equal(t, ErrInvalidRate, err)
+	_, err = NewBench(&Config{From: []*core.Address{from}, Rate: 10}, nil, nil)
+	assert.Equal(t, ErrInvalidMix, err)
+	_, err = NewBench(&Config{From: []*core.Address{from}, Rate: 10, Calls: 1}, nil, nil)
+	assert.Equal(t, ErrContractRequired, err)
+
+	client := &mockClient{txs: make(map[string]*corepb.Transaction)}
+	b, err := NewBench(&Config{
+		From:         []*core.Address{from},
+		Duration:     time.Second,
+		Rate:         40,
+		Transfers:    3,
+		Calls:        1,
+		Contract:     from,
+		Function:     "inc",
+		Args:         "[1]",
+		PollInterval: 50 * time.Millisecond,
+	}, client, &mockSigner{signature})
+	assert.Nil(t, err)
+
+	report, err := b.Run(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, report.Submitted > 0)
+	assert.Equal(t, 0, report.Rejected)
+	assert.Equal(t, report.Submitted, report.Confirmed)
+	assert.Equal(t, report.Submitted, len(client.txs))
+	assert.Equal(t, report.Submitted/4, client.calls)
+	assert.True(t, report.LatencyP50 <= report.LatencyP99)
+	assert.True(t, report.LatencyP99 <= report.LatencyMax)
+	assert.NotNil(t, report.Usage)
+
+	nonces := make(map[uint64]bool)
+	for _, tx := range client.txs {
+		nonces[tx.Nonce] = true
+	}
+	for i := 1; i <= report.Submitted; i++ {
+		assert.True(t, nonces[uint64(5+i)])
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i))
+	}
+	assert.Equal(t, time.Duration(50), percentile(sorted, 0.5))
+	assert.Equal(t, time.Duration(99), percentile(sorted, 0.99))
+	assert.Equal(t, time.Duration(1), percentile(sorted[:1], 0.99))
+}
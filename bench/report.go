@@ -0,0 +1,179 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package bench
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// clockTicks is the USER_HZ of /proc/<pid>/stat times, 100 on all supported platforms.
+const clockTicks = 100
+
+// Report is the result of a benchmark.
+type Report struct {
+	Senders     int
+	Submitted   int
+	Rejected    int
+	Skipped     int
+	Confirmed   int
+	Unconfirmed int
+
+	// SubmitElapsed is the time of submission, Elapsed includes waiting for confirmations.
+	SubmitElapsed time.Duration
+	Elapsed       time.Duration
+
+	// SubmitTPS is the accepted transactions per second, TPS is the confirmed transactions per second.
+	SubmitTPS float64
+	TPS       float64
+
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+	LatencyP99 time.Duration
+	LatencyMax time.Duration
+
+	Usage *Usage
+}
+
+// Usage is the resource usage of the sampled process during the benchmark.
+type Usage struct {
+	Pid     int
+	CPUTime time.Duration
+	// CPUPercent is the cpu time over the elapsed time.
+	CPUPercent float64
+	// MaxRSS is the peak resident memory in bytes.
+	MaxRSS uint64
+}
+
+func (r *Report) summarize(latencies []time.Duration) {
+	if seconds := r.SubmitElapsed.Seconds(); seconds > 0 {
+		r.SubmitTPS = float64(r.Submitted-r.Rejected) / seconds
+	}
+	if seconds := r.Elapsed.Seconds(); seconds > 0 {
+		r.TPS = float64(r.Confirmed) / seconds
+	}
+	if len(latencies) == 0 {
+		return
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	r.LatencyP50 = percentile(latencies, 0.5)
+	r.LatencyP90 = percentile(latencies, 0.9)
+	r.LatencyP99 = percentile(latencies, 0.99)
+	r.LatencyMax = latencies[len(latencies)-1]
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(p*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+func (r *Report) String() string {
+	s := fmt.Sprintf("senders: %d, submitted: %d, rejected: %d, skipped: %d, confirmed: %d, unconfirmed: %d\n",
+		r.Senders, r.Submitted, r.Rejected, r.Skipped, r.Confirmed, r.Unconfirmed)
+	s += fmt.Sprintf("elapsed: %v, submit tps: %.2f, confirmed tps: %.2f\n", r.Elapsed, r.SubmitTPS, r.TPS)
+	s += fmt.Sprintf("confirmation latency p50: %v, p90: %v, p99: %v, max: %v\n", r.LatencyP50, r.LatencyP90, r.LatencyP99, r.LatencyMax)
+	if r.Usage != nil {
+		s += fmt.Sprintf("process %d cpu: %v (%.1f%%), max rss: %d MB\n", r.Usage.Pid, r.Usage.CPUTime, r.Usage.CPUPercent, r.Usage.MaxRSS>>20)
+	}
+	return s
+}
+
+// usageSampler measures the cpu time and peak memory of a process from /proc.
+type usageSampler struct {
+	pid     int
+	start   time.Time
+	cpuTime time.Duration
+}
+
+func newUsageSampler(pid int) *usageSampler {
+	if pid <= 0 {
+		pid = syscall.Getpid()
+	}
+	s := &usageSampler{pid: pid, start: time.Now()}
+	s.cpuTime, _ = processCPUTime(pid)
+	return s
+}
+
+// usage returns the usage since the sampler created, nil if the process can't be sampled.
+func (s *usageSampler) usage() *Usage {
+	cpuTime, err := processCPUTime(s.pid)
+	if err != nil {
+		return nil
+	}
+	u := &Usage{Pid: s.pid, CPUTime: cpuTime - s.cpuTime}
+	if elapsed := time.Since(s.start); elapsed > 0 {
+		u.CPUPercent = 100 * u.CPUTime.Seconds() / elapsed.Seconds()
+	}
+	u.MaxRSS, _ = processMaxRSS(s.pid)
+	return u
+}
+
+// processCPUTime returns the user and system time of the process.
+func processCPUTime(pid int) (time.Duration, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+	// the command name may contain spaces, fields are counted after it.
+	stat := string(data)
+	fields := strings.Fields(stat[strings.LastIndex(stat, ")")+1:])
+	if len(fields) < 13 {
+		return 0, fmt.Errorf("malformed stat of process %d", pid)
+	}
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(utime+stime) * time.Second / clockTicks, nil
+}
+
+// processMaxRSS returns the peak resident memory of the process.
+func processMaxRSS(pid int) (uint64, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "VmHWM:") {
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				break
+			}
+			kb, err := strconv.ParseUint(fields[1], 10, 64)
+			return kb << 10, err
+		}
+	}
+	return 0, fmt.Errorf("no peak memory of process %d", pid)
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nebulasio/go-nebulas/util/audit"
+	"github.com/urfave/cli"
+)
+
+var (
+	auditCommand = cli.Command{
+		Name:     "audit",
+		Usage:    "Manage the audit log",
+		Category: "AUDIT COMMANDS",
+		Description: `
+Inspect the append-only audit log of signing and admin operations.`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "verify",
+				Usage:     "Verify the hash chain of an audit log",
+				ArgsUsage: "<auditLogPath>",
+				Action:    verifyAuditLog,
+				Description: `
+    neb audit verify logs/audit.log
+
+Verify that no entry of an exported audit log has been modified, removed or reordered.`,
+			},
+		},
+	}
+)
+
+func verifyAuditLog(ctx *cli.Context) error {
+	path := ctx.Args().First()
+	if len(path) == 0 {
+		FatalF("audit log path is required")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		FatalF("open audit log failed: %v", err)
+	}
+	defer f.Close()
+
+	last, err := audit.Verify(f)
+	if err != nil {
+		FatalF("verify audit log failed: %v", err)
+	}
+	if last == nil {
+		fmt.Println("audit log is empty.")
+		return nil
+	}
+	fmt.Printf("audit log verified, %d entries, last hash %s\n", last.Seq, last.Hash)
+	return nil
+}
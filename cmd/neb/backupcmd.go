@@ -0,0 +1,209 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/neblet"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/urfave/cli"
+)
+
+var (
+	backupCommand = cli.Command{
+		Name:     "backup",
+		Usage:    "Manage the backups of the chain storage",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Back up the levelDB of the chain into a directory, incrementally by default.`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "create",
+				Usage:     "Back up the chain storage, the node must be stopped",
+				ArgsUsage: "<backupDir>",
+				Action:    MergeFlags(createBackup),
+				Flags: []cli.Flag{
+					cli.BoolFlag{Name: "full", Usage: "read and hash all the files instead of the ones changed since the last backup"},
+				},
+				Description: `
+    neb backup create /mnt/backup/neb
+
+Copy the files of the chain datadir into the backup directory. Only the files
+changed since the last backup are copied, the others are shared with it.`,
+			},
+			{
+				Name:      "list",
+				Usage:     "List the backups in a directory",
+				ArgsUsage: "<backupDir>",
+				Action:    listBackups,
+			},
+			{
+				Name:      "verify",
+				Usage:     "Verify the files of a backup",
+				ArgsUsage: "<backupDir> [id]",
+				Action:    verifyBackup,
+				Description: `
+    neb backup verify /mnt/backup/neb
+
+Check the size and hash of all the files of the backup, the latest one by default.`,
+			},
+			{
+				Name:      "restore",
+				Usage:     "Restore a backup into an empty datadir",
+				ArgsUsage: "<backupDir> <id> <targetDir>",
+				Action:    restoreBackup,
+			},
+			{
+				Name:      "sign",
+				Usage:     "Sign a backup published as a snapshot for bootstrapping nodes",
+				ArgsUsage: "<backupDir> <id>",
+				Action:    MergeFlags(signBackup),
+				Flags: []cli.Flag{
+					cli.StringFlag{Name: "keyfile", Usage: "key file of the publisher"},
+					cli.StringFlag{Name: "passphrase", Usage: "passphrase of the key file, prompted if empty"},
+				},
+				Description: `
+    neb backup sign /mnt/backup/neb 1530000000000000000 --keyfile publisher.json
+
+Attest the tail held by the backup, written to manifests/<id>.sig. The backup
+directory is then served as is over https or from an S3 bucket, and a fresh
+node configured with storage.snapshot downloads and verifies it before syncing.`,
+			},
+		},
+	}
+)
+
+func createBackup(ctx *cli.Context) error {
+	backupDir := ctx.Args().First()
+	if len(backupDir) == 0 {
+		FatalF("backup directory is required")
+	}
+	conf := neblet.LoadConfig(config)
+	chainConfig(ctx, conf.Chain)
+
+	m, err := storage.Backup(conf.Chain.Datadir, backupDir, !ctx.Bool("full"))
+	if err != nil {
+		FatalF("backup failed: %v", err)
+	}
+	fmt.Printf("backup %s created, %d files, %d bytes, %d bytes copied\n", m.ID, len(m.Files), m.Size(), m.Copied)
+	return nil
+}
+
+func listBackups(ctx *cli.Context) error {
+	backupDir := ctx.Args().First()
+	if len(backupDir) == 0 {
+		FatalF("backup directory is required")
+	}
+	manifests, err := storage.ListBackups(backupDir)
+	if err != nil {
+		FatalF("list backups failed: %v", err)
+	}
+	for _, m := range manifests {
+		fmt.Printf("%s\t%s\t%d files\t%d bytes\n", m.ID, time.Unix(m.Created, 0).Format(time.RFC3339), len(m.Files), m.Size())
+	}
+	return nil
+}
+
+func verifyBackup(ctx *cli.Context) error {
+	backupDir := ctx.Args().First()
+	if len(backupDir) == 0 {
+		FatalF("backup directory is required")
+	}
+	m, err := storage.VerifyBackup(backupDir, ctx.Args().Get(1))
+	if err != nil {
+		FatalF("verify backup failed: %v", err)
+	}
+	fmt.Printf("backup %s verified, %d files, %d bytes\n", m.ID, len(m.Files), m.Size())
+	return nil
+}
+
+func restoreBackup(ctx *cli.Context) error {
+	if ctx.NArg() < 3 {
+		FatalF("backup directory, id and target directory are required")
+	}
+	m, err := storage.RestoreBackup(ctx.Args().Get(0), ctx.Args().Get(1), ctx.Args().Get(2))
+	if err != nil {
+		FatalF("restore backup failed: %v", err)
+	}
+	fmt.Printf("backup %s restored into %s\n", m.ID, ctx.Args().Get(2))
+	return nil
+}
+
+func signBackup(ctx *cli.Context) error {
+	if ctx.NArg() < 2 {
+		FatalF("backup directory and id are required")
+	}
+	backupDir, id := ctx.Args().Get(0), ctx.Args().Get(1)
+	if len(ctx.String("keyfile")) == 0 {
+		FatalF("key file is required")
+	}
+	passphrase := ctx.String("passphrase")
+	if len(passphrase) == 0 {
+		passphrase = getPassPhrase("Please input the passphrase of the key file", false)
+	}
+
+	// the tail is read from a restored copy of the backup.
+	tmp, err := ioutil.TempDir("", "neb-snapshot")
+	if err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	target := filepath.Join(tmp, "data.db")
+	m, err := storage.RestoreBackup(backupDir, id, target)
+	if err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	db, err := storage.NewDiskStorage(target)
+	if err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	attestation, err := core.NewSnapshotAttestation(db, m.ID, m.Digest())
+	db.Close()
+	if err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	addr, err := loadAndUnlockKey(neb, ctx.String("keyfile"), passphrase)
+	if err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	if err := neb.AccountManager().SignSnapshot(addr, attestation); err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	data, err := json.MarshalIndent(attestation, "", "  ")
+	if err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(backupDir, filepath.FromSlash(storage.BackupSignatureFile(m.ID))), data, 0644); err != nil {
+		FatalF("sign backup failed: %v", err)
+	}
+	fmt.Printf("backup %s signed by %s, height %d, tail %s\n", m.ID, addr, attestation.Height, attestation.Tail)
+	return nil
+}
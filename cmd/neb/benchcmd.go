@@ -0,0 +1,166 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/bench"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/rpc"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/urfave/cli"
+	"golang.org/x/net/context"
+)
+
+var (
+	benchCommand = cli.Command{
+		Action:    benchTransactions,
+		Name:      "bench",
+		Usage:     "Measure the tx throughput and confirmation latency of a node",
+		ArgsUsage: "<address>...",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "rpc", Value: "127.0.0.1:8684", Usage: "rpc address of the node"},
+			cli.StringFlag{Name: "passphrase", Usage: "passphrase of the senders, prompted if not set"},
+			cli.DurationFlag{Name: "duration", Value: bench.DefaultDuration, Usage: "duration of submitting transactions"},
+			cli.IntFlag{Name: "rate", Value: bench.DefaultRate, Usage: "transactions submitted per second"},
+			cli.StringFlag{Name: "mix", Value: "transfer:1", Usage: "weights of the tx mix, like \"transfer:80,call:20\""},
+			cli.StringFlag{Name: "to", Usage: "receiver of the transfers, the sender itself if not set"},
+			cli.StringFlag{Name: "value", Value: "0", Usage: "value of the transfers"},
+			cli.StringFlag{Name: "contract", Usage: "contract address of the calls"},
+			cli.StringFlag{Name: "function", Usage: "contract function of the calls"},
+			cli.StringFlag{Name: "args", Usage: "json args of the calls"},
+			cli.StringFlag{Name: "gaslimit", Value: "200000", Usage: "gas limit of the transactions"},
+			cli.DurationFlag{Name: "confirm-timeout", Value: bench.DefaultConfirmTimeout, Usage: "time to wait for confirmations after submission"},
+			cli.IntFlag{Name: "pid", Usage: "pid of a local node process to sample cpu and memory"},
+		},
+		Description: `
+    neb bench --duration 60s --rate 200 --mix transfer:80,call:20 --contract <address> --function inc --args "[1]" <address>
+
+Sign the tx mix with the accounts of the keydir in turn, submit them to the node at the rate,
+and report the submitted and confirmed tps, confirmation latency percentiles and resource usage.
+Run it against a dev chain, the transactions spend the balance of the senders.`,
+	}
+)
+
+func benchTransactions(ctx *cli.Context) error {
+	if len(ctx.Args()) == 0 {
+		FatalF("bench requires the sender addresses")
+	}
+	conf := &bench.Config{
+		Duration:       ctx.Duration("duration"),
+		Rate:           ctx.Int("rate"),
+		Function:       ctx.String("function"),
+		Args:           ctx.String("args"),
+		ConfirmTimeout: ctx.Duration("confirm-timeout"),
+		Pid:            ctx.Int("pid"),
+	}
+	var err error
+	if conf.Transfers, conf.Calls, err = parseTxMix(ctx.String("mix")); err != nil {
+		FatalF("bench failed: %v", err)
+	}
+	conf.Value = util.NewUint128FromString(ctx.String("value"))
+	conf.GasLimit = util.NewUint128FromString(ctx.String("gaslimit"))
+	if to := ctx.String("to"); len(to) > 0 {
+		if conf.To, err = core.AddressParse(to); err != nil {
+			FatalF("bench failed: invalid receiver %v", err)
+		}
+	}
+	if contract := ctx.String("contract"); len(contract) > 0 {
+		if conf.Contract, err = core.AddressParse(contract); err != nil {
+			FatalF("bench failed: invalid contract %v", err)
+		}
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		FatalF("bench failed: %v", err)
+	}
+	passphrase := ctx.String("passphrase")
+	if len(passphrase) == 0 {
+		passphrase = getPassPhrase("Passphrase of the senders:", false)
+	}
+	for _, v := range ctx.Args() {
+		addr, err := core.AddressParse(v)
+		if err != nil {
+			FatalF("bench failed: invalid sender %v", err)
+		}
+		if err := neb.AccountManager().Unlock(addr, []byte(passphrase), keystore.DefaultUnlockDuration); err != nil {
+			FatalF("bench failed: unlock %s %v", v, err)
+		}
+		conf.From = append(conf.From, addr)
+	}
+
+	conn, err := rpc.Dial(ctx.String("rpc"))
+	if err != nil {
+		FatalF("bench failed: %v", err)
+	}
+	defer conn.Close()
+
+	b, err := bench.NewBench(conf, rpcpb.NewApiServiceClient(conn), neb.AccountManager())
+	if err != nil {
+		FatalF("bench failed: %v", err)
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	fmt.Printf("benchmarking %s for %v at %d tx/s\n", ctx.String("rpc"), conf.Duration, conf.Rate)
+	report, err := b.Run(runCtx)
+	if err != nil {
+		FatalF("bench failed: %v", err)
+	}
+	fmt.Print(report)
+	return nil
+}
+
+// parseTxMix parses the weights like "transfer:80,call:20".
+func parseTxMix(mix string) (transfers, calls int, err error) {
+	for _, part := range strings.Split(mix, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), ":", 2)
+		weight := 1
+		if len(kv) == 2 {
+			if weight, err = strconv.Atoi(kv[1]); err != nil {
+				return 0, 0, fmt.Errorf("invalid weight of %s", kv[0])
+			}
+		}
+		switch kv[0] {
+		case "transfer":
+			transfers = weight
+		case "call":
+			calls = weight
+		default:
+			return 0, 0, fmt.Errorf("unknown tx type %s", kv[0])
+		}
+	}
+	return transfers, calls, nil
+}
@@ -19,12 +19,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"bufio"
 	"bytes"
 	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/urfave/cli"
 )
@@ -55,6 +62,19 @@ The genesis command for genesis dump or other commands.`,
 
 Dump the genesis config info.`,
 			},
+			{
+				Name:      "export",
+				Usage:     "export the state at a height into a new genesis",
+				ArgsUsage: "[height] [genesisPath]",
+				Action:    MergeFlags(exportGenesis),
+				Description: `
+    neb genesis export 10000 migrated.conf
+
+Export the balances, nonces, contract code and storage of all accounts and the
+candidates of the dynasty at the height (the tail by default) into a genesis file,
+which can be used by "neb init" to start a new chain. The genesis is printed if
+no path is given.`,
+			},
 		},
 	}
 
@@ -67,6 +87,48 @@ Dump the genesis config info.`,
 		Description: `
 Use "./neb dump 10" to dump 10 blocks before tail block.`,
 	}
+
+	replayCommand = cli.Command{
+		Action:    MergeFlags(replayBlocks),
+		Name:      "replay",
+		Usage:     "Re-execute a range of blocks from storage and compare the results",
+		ArgsUsage: "<fromHeight> [toHeight]",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+    neb replay 2 1000
+
+Re-execute the canonical blocks in the range on the state before them, compare the
+resulting state, txs, events and dpos roots with the stored blocks, and report the
+execution speed. The storage is not modified. The range ends at the tail by default.`,
+	}
+
+	exportCommand = cli.Command{
+		Action:    MergeFlags(exportChain),
+		Name:      "export",
+		Usage:     "Export a range of canonical blocks and the genesis into a file",
+		ArgsUsage: "<path> [fromHeight] [toHeight]",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+    neb export chain.bin 2 1000
+
+Write the genesis and the canonical blocks in the range into a file, which can be
+used by "neb import" to bootstrap a new node without syncing from the network.
+The range is from the block after the genesis to the tail by default.`,
+	}
+
+	importCommand = cli.Command{
+		Action:    MergeFlags(importChain),
+		Name:      "import",
+		Usage:     "Import the blocks of a file written by neb export",
+		ArgsUsage: "<path>",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+    neb import chain.bin
+
+Verify and store the blocks of the file, and move the tail to the highest one.
+The storage is initialized with the genesis of the file if it is empty, the file
+must have the same genesis otherwise.`,
+	}
 )
 
 func initGenesis(ctx *cli.Context) error {
@@ -115,6 +177,43 @@ func dumpGenesis(ctx *cli.Context) error {
 	return nil
 }
 
+func exportGenesis(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		FatalF("export genesis conf faild: %v", err)
+	}
+	if err := neb.Setup(); err != nil {
+		FatalF("export genesis conf faild: %v", err)
+	}
+
+	chain := neb.BlockChain()
+	block := chain.TailBlock()
+	if len(ctx.Args()) > 0 {
+		height, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+		if err != nil {
+			FatalF("export genesis conf faild: %v", err)
+		}
+		if block, err = chain.GetBlockByHeight(height); err != nil {
+			FatalF("export genesis conf faild: %v", err)
+		}
+	}
+
+	genesis, err := core.ExportGenesis(block)
+	if err != nil {
+		FatalF("export genesis conf faild: %v", err)
+	}
+	content := proto.MarshalTextString(genesis)
+	if len(ctx.Args()) < 2 {
+		fmt.Println(content)
+		return nil
+	}
+	if err := ioutil.WriteFile(ctx.Args().Get(1), []byte(content), 0644); err != nil {
+		FatalF("export genesis conf faild: %v", err)
+	}
+	fmt.Printf("export genesis at height %d success.\n", block.Height())
+	return nil
+}
+
 func dumpblock(ctx *cli.Context) error {
 	neb, err := makeNeb(ctx)
 	if err != nil {
@@ -128,6 +227,133 @@ func dumpblock(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	fmt.Printf("blockchain dump: %s\n", neb.BlockChain().Dump(count))
+	fmt.Print("blockchain dump: ")
+	if err := neb.BlockChain().Dump(context.Background(), os.Stdout, count); err != nil {
+		return err
+	}
+	fmt.Println()
+	return nil
+}
+
+func replayBlocks(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	if err := neb.Setup(); err != nil {
+		return err
+	}
+
+	chain := neb.BlockChain()
+	from, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return err
+	}
+	to := chain.TailBlock().Height()
+	if len(ctx.Args()) > 1 {
+		if to, err = strconv.ParseUint(ctx.Args().Get(1), 10, 64); err != nil {
+			return err
+		}
+	}
+
+	var (
+		blocks, txs, mismatched int
+		elapsed                 time.Duration
+	)
+	err = core.ReplayBlocks(chain, from, to, func(r *core.ReplayResult) {
+		blocks++
+		txs += r.Txs
+		elapsed += r.Elapsed
+		if !r.Matched() {
+			mismatched++
+			fmt.Printf("block %d %s mismatched roots: %v, receipts: %v\n", r.Height, r.Hash, r.Mismatches, r.Receipts)
+		}
+	})
+	if err != nil {
+		FatalF("replay blocks failed: %v", err)
+	}
+
+	fmt.Printf("replayed %d blocks, %d txs in %v", blocks, txs, elapsed)
+	if seconds := elapsed.Seconds(); seconds > 0 {
+		fmt.Printf(", %.2f blocks/s, %.2f txs/s", float64(blocks)/seconds, float64(txs)/seconds)
+	}
+	fmt.Println()
+	if mismatched > 0 {
+		FatalF("%d blocks mismatched", mismatched)
+	}
+	return nil
+}
+
+func exportChain(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	if err := neb.Setup(); err != nil {
+		return err
+	}
+	if len(ctx.Args()) < 1 {
+		FatalF("export chain failed: missing path")
+	}
+
+	chain := neb.BlockChain()
+	from, to := uint64(2), chain.TailBlock().Height()
+	if len(ctx.Args()) > 1 {
+		if from, err = strconv.ParseUint(ctx.Args().Get(1), 10, 64); err != nil {
+			return err
+		}
+	}
+	if len(ctx.Args()) > 2 {
+		if to, err = strconv.ParseUint(ctx.Args().Get(2), 10, 64); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.Create(ctx.Args().First())
+	if err != nil {
+		FatalF("export chain failed: %v", err)
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	if err := chain.Export(w, from, to); err != nil {
+		FatalF("export chain failed: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		FatalF("export chain failed: %v", err)
+	}
+	fmt.Printf("export blocks from %d to %d success.\n", from, to)
+	return nil
+}
+
+func importChain(ctx *cli.Context) error {
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		FatalF("import chain failed: %v", err)
+	}
+	defer file.Close()
+	header, err := core.ReadExportHeader(bufio.NewReader(file))
+	if err != nil {
+		FatalF("import chain failed: %v", err)
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	// the genesis of the export is only used to initialize an empty storage,
+	// the import checks it matches the genesis stored otherwise.
+	neb.SetGenesis(header.Genesis)
+	if err := neb.Setup(); err != nil {
+		FatalF("import chain failed: %v", err)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		FatalF("import chain failed: %v", err)
+	}
+	imported, err := neb.BlockChain().Import(bufio.NewReader(file))
+	if err != nil {
+		FatalF("import chain failed after %d blocks: %v", imported, err)
+	}
+	fmt.Printf("import %d blocks success, tail %d.\n", imported, neb.BlockChain().TailBlock().Height())
 	return nil
 }
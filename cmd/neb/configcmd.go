@@ -42,6 +42,24 @@ Manage neblas config, generate a default config file.`,
 				Description: `
 Generate a a default config file.`,
 			},
+			{
+				Name:   "env",
+				Usage:  "List the environment variables overriding the config fields",
+				Action: listConfigEnv,
+				Description: `
+List the environment variables overriding the config fields, generated from the
+config schema. The config file is overridden by them, and they by the --set
+flags, like --set chain.chain_id=1001. Repeated values are separated by commas.`,
+			},
+			{
+				Name:      "check",
+				Usage:     "Check a config file",
+				Action:    checkConfig,
+				ArgsUsage: "<filename>",
+				Description: `
+Check the config file, the one of --config if not given, and print each unknown
+field, wrong value and conflicting option found.`,
+			},
 		},
 	}
 )
@@ -57,3 +75,26 @@ func createDefaultConfig(ctx *cli.Context) error {
 	fmt.Printf("create default config %s\n", fileName)
 	return nil
 }
+
+func listConfigEnv(ctx *cli.Context) error {
+	for _, field := range neblet.ConfigFields() {
+		fmt.Printf("%-48s %-40s %s\n", field.Env, field.Path, field.Type)
+	}
+	return nil
+}
+
+func checkConfig(ctx *cli.Context) error {
+	fileName := ctx.Args().First()
+	if len(fileName) == 0 {
+		fileName = config
+	}
+	if len(fileName) == 0 {
+		fmt.Println("please give a config file arg!!!")
+		return nil
+	}
+	if _, err := neblet.ParseConfigFile(fileName); err != nil {
+		FatalF("invalid config %s:\n%v", fileName, err)
+	}
+	fmt.Printf("config %s is valid\n", fileName)
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/urfave/cli"
+)
+
+var (
+	dbCommand = cli.Command{
+		Name:     "db",
+		Usage:    "Maintain the chain storage",
+		Category: "BLOCKCHAIN COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Name:   "reindex",
+				Usage:  "Rebuild the derived indexes from the stored blocks, the node must be stopped",
+				Action: MergeFlags(reindex),
+				Flags: []cli.Flag{
+					cli.BoolFlag{Name: "height", Usage: "rebuild the height index of the canonical chain"},
+				},
+				Description: `
+    neb db reindex --height
+
+Rebuild the height index by walking the stored blocks from the tail back to the
+genesis, instead of a full resync when only the index is corrupted. An interrupted
+reindex resumes from its last checkpoint.`,
+			},
+		},
+	}
+)
+
+func reindex(ctx *cli.Context) error {
+	if !ctx.Bool("height") {
+		FatalF("index to rebuild is required, e.g. --height")
+	}
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	if err := neb.Setup(); err != nil {
+		FatalF("reindex failed: %v", err)
+	}
+
+	err = core.ReindexHeight(neb.BlockChain(), func(done, total uint64) {
+		fmt.Printf("reindexed %d/%d blocks\n", done, total)
+	})
+	if err != nil {
+		FatalF("reindex failed: %v", err)
+	}
+	fmt.Println("height index rebuilt.")
+	return nil
+}
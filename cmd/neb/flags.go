@@ -19,7 +19,10 @@
 package main
 
 import (
+	"strings"
+
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/neblet/profile"
 	"github.com/urfave/cli"
 )
 
@@ -32,6 +35,18 @@ var (
 		Destination: &config,
 	}
 
+	// ConfigSetFlag config field overrides
+	ConfigSetFlag = cli.StringSliceFlag{
+		Name:  "set",
+		Usage: "override a config field, like chain.chain_id=1001, multi-value support.",
+	}
+
+	// NetworkProfileFlag network bundled in the binary
+	NetworkProfileFlag = cli.StringFlag{
+		Name:  "network",
+		Usage: "join a network bundled in the binary, " + strings.Join(profile.Names(), ", "),
+	}
+
 	// NetworkSeedFlag network seed
 	NetworkSeedFlag = cli.StringSliceFlag{
 		Name:  "network.seed",
@@ -201,6 +216,12 @@ func networkConfig(ctx *cli.Context, cfg *nebletpb.NetworkConfig) {
 	}
 }
 
+func networkProfile(ctx *cli.Context, cfg *nebletpb.ChainConfig) {
+	if ctx.GlobalIsSet(NetworkProfileFlag.Name) {
+		cfg.Network = ctx.GlobalString(NetworkProfileFlag.Name)
+	}
+}
+
 func chainConfig(ctx *cli.Context, cfg *nebletpb.ChainConfig) {
 	if ctx.GlobalIsSet(ChainIDFlag.Name) {
 		cfg.ChainId = uint32(ctx.GlobalUint(ChainIDFlag.Name))
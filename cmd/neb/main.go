@@ -23,36 +23,40 @@ import (
 	"os"
 	"os/signal"
 	"sort"
-	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/neblet"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/version"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
 var (
-	version   string
-	commit    string
-	branch    string
-	compileAt string
-	config    string
+	config string
 )
 
 func main() {
+	// the node runs the contracts in this executable when sandboxed.
+	if nvm.IsSandboxProcess() {
+		if err := nvm.ServeSandbox(); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
 
 	app := cli.NewApp()
 	app.Action = neb
 	app.Name = "neb"
-	app.Version = fmt.Sprintf("%s, branch %s, commit %s", version, branch, commit)
-	timestamp, _ := strconv.ParseInt(compileAt, 10, 64)
-	app.Compiled = time.Unix(timestamp, 0)
+	info := version.Get()
+	app.Version = fmt.Sprintf("%s, branch %s, commit %s", info.Version, info.Branch, info.Commit)
+	app.Compiled = info.BuildDate
 	app.Usage = "the go-nebulas command line interface"
 	app.Copyright = "Copyright 2017-2018 The go-nebulas Authors"
 
-	app.Flags = append(app.Flags, ConfigFlag)
+	app.Flags = append(app.Flags, ConfigFlag, ConfigSetFlag, NetworkProfileFlag)
 	app.Flags = append(app.Flags, NetworkFlags...)
 	app.Flags = append(app.Flags, ChainFlags...)
 	app.Flags = append(app.Flags, RPCFlags...)
@@ -70,7 +74,15 @@ func main() {
 		licenseCommand,
 		configCommand,
 		blockDumpCommand,
+		replayCommand,
+		exportCommand,
+		importCommand,
+		benchCommand,
 		serializeCommand,
+		auditCommand,
+		backupCommand,
+		dbCommand,
+		testVectorsCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -127,13 +139,31 @@ func runNeb(n *neblet.Neblet) {
 
 func makeNeb(ctx *cli.Context) (*neblet.Neblet, error) {
 	conf := neblet.LoadConfig(config)
-	conf.App.Version = version
-
-	// load config from cli args
+	// the bundled network presets the chain, then env and cli args override it
+	if network, ok := neblet.LookupConfigEnv(os.Environ(), "chain.network"); ok {
+		if err := neblet.SetConfigField(conf, "chain.network", network); err != nil {
+			return nil, err
+		}
+	}
+	networkProfile(ctx, conf.Chain)
+	if err := neblet.ApplyNetworkProfile(conf); err != nil {
+		return nil, err
+	}
+	if err := neblet.ApplyConfigEnv(conf, os.Environ()); err != nil {
+		return nil, err
+	}
 	networkConfig(ctx, conf.Network)
 	chainConfig(ctx, conf.Chain)
 	rpcConfig(ctx, conf.Rpc)
 	statsConfig(ctx, conf.Stats)
+	if err := neblet.ApplyConfigOverrides(conf, ctx.GlobalStringSlice(ConfigSetFlag.Name)); err != nil {
+		return nil, err
+	}
+	conf.App.Version = version.Version
+
+	if err := neblet.ValidateConfig(conf); err != nil {
+		return nil, err
+	}
 
 	n, err := neblet.New(*conf)
 	if err != nil {
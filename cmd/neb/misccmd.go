@@ -22,8 +22,11 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
+	"time"
 
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/version"
 	"github.com/urfave/cli"
 )
 
@@ -50,9 +53,16 @@ func _version(ctx *cli.Context) error {
 		return err
 	}
 
-	fmt.Println("Version:", version)
-	if commit != "" {
-		fmt.Println("Git Commit:", commit)
+	info := version.Get()
+	fmt.Println("Version:", info.Version)
+	if info.Commit != "" {
+		fmt.Println("Git Commit:", info.Commit)
+	}
+	if !info.BuildDate.IsZero() {
+		fmt.Println("Build Date:", info.BuildDate.Format(time.RFC3339))
+	}
+	if len(info.Features) > 0 {
+		fmt.Println("Features:", strings.Join(info.Features, ","))
 	}
 	fmt.Println("Protocol Versions:", p2p.ProtocolID)
 	fmt.Println("Protocol ClientVersion:", p2p.ClientVersion)
@@ -0,0 +1,65 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/urfave/cli"
+)
+
+var (
+	testVectorsCommand = cli.Command{
+		Action:    exportTestVectors,
+		Name:      "testvectors",
+		Usage:     "Export the reference test vectors for cross-client testing",
+		ArgsUsage: "[outputPath]",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+    neb testvectors vectors.json
+
+Export the canonical encodings, hashes and state transitions of a set of reference
+transactions and blocks as JSON, printed if the output path is not given.`,
+	}
+)
+
+func exportTestVectors(ctx *cli.Context) error {
+	vectors, err := core.GenerateTestVectors()
+	if err != nil {
+		FatalF("generate test vectors failed: %v", err)
+	}
+	data, err := json.MarshalIndent(vectors, "", "    ")
+	if err != nil {
+		FatalF("generate test vectors failed: %v", err)
+	}
+
+	path := ctx.Args().First()
+	if len(path) == 0 {
+		fmt.Println(string(data))
+		return nil
+	}
+	if err := ioutil.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		FatalF("write test vectors failed: %v", err)
+	}
+	fmt.Printf("test vectors exported to %s\n", path)
+	return nil
+}
@@ -125,6 +125,11 @@ func (bt *BatchTrie) Prove(key []byte) (MerkleProof, error) {
 	return bt.trie.Prove(key)
 }
 
+// ProveAbsence proves the key is not in trie
+func (bt *BatchTrie) ProveAbsence(key []byte) (MerkleProof, error) {
+	return bt.trie.ProveAbsence(key)
+}
+
 // Verify whether the merkle proof from root to the associated node is right
 func (bt *BatchTrie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
 	return bt.trie.Verify(rootHash, key, proof)
@@ -140,6 +145,11 @@ func (bt *BatchTrie) Iterator(prefix []byte) (*Iterator, error) {
 	return bt.trie.Iterator(prefix)
 }
 
+// Diff visits the keys whose values differ from the BatchTrie to the other one
+func (bt *BatchTrie) Diff(to *BatchTrie, start []byte, visit DiffVisitor) error {
+	return Diff(bt.trie, to.trie, start, visit)
+}
+
 // BeginBatch to process a batch task
 func (bt *BatchTrie) BeginBatch() error {
 	if bt.batching {
@@ -0,0 +1,188 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"bytes"
+	"errors"
+)
+
+// DiffVisitor visits a key whose value differs between two tries in key order,
+// from is nil if the key is added and to is nil if it's deleted. Returning
+// false stops the diff.
+type DiffVisitor func(key, from, to []byte) bool
+
+// Diff visits the keys whose values differ between the tries from the key
+// start on, the sub tries shared by both are skipped without being loaded.
+func Diff(from, to *Trie, start []byte, visit DiffVisitor) error {
+	d := &differ{from: from, to: to, start: keyToRoute(start), visit: visit}
+	_, err := d.diff(from.rootHash, to.rootHash, []byte{})
+	return err
+}
+
+type differ struct {
+	from  *Trie
+	to    *Trie
+	start []byte
+	visit DiffVisitor
+}
+
+type leafEntry struct {
+	key   []byte
+	value []byte
+}
+
+// skipped returns if all the keys below the route are before the start.
+func (d *differ) skipped(route []byte) bool {
+	n := len(route)
+	if n > len(d.start) {
+		n = len(d.start)
+	}
+	return bytes.Compare(route[:n], d.start[:n]) < 0
+}
+
+func (d *differ) diff(fromHash, toHash []byte, route []byte) (bool, error) {
+	if bytes.Equal(fromHash, toHash) || d.skipped(route) {
+		return true, nil
+	}
+
+	var fromNode, toNode *node
+	var err error
+	if len(fromHash) > 0 {
+		if fromNode, err = d.from.fetchNode(fromHash); err != nil {
+			return false, err
+		}
+	}
+	if len(toHash) > 0 {
+		if toNode, err = d.to.fetchNode(toHash); err != nil {
+			return false, err
+		}
+	}
+
+	// descend the branches side by side, the keys of the other nodes are
+	// compared leaf by leaf.
+	if fromNode != nil && toNode != nil {
+		fromType, err := fromNode.Type()
+		if err != nil {
+			return false, err
+		}
+		toType, err := toNode.Type()
+		if err != nil {
+			return false, err
+		}
+		if fromType == branch && toType == branch {
+			for i := 0; i < 16; i++ {
+				childRoute := append(route[:len(route):len(route)], byte(i))
+				next, err := d.diff(fromNode.Val[i], toNode.Val[i], childRoute)
+				if err != nil || !next {
+					return next, err
+				}
+			}
+			return true, nil
+		}
+	}
+
+	fromLeaves, err := leaves(d.from, fromNode, route)
+	if err != nil {
+		return false, err
+	}
+	toLeaves, err := leaves(d.to, toNode, route)
+	if err != nil {
+		return false, err
+	}
+	return d.merge(fromLeaves, toLeaves), nil
+}
+
+// merge visits the differences of two sorted leaf lists.
+func (d *differ) merge(fromLeaves, toLeaves []*leafEntry) bool {
+	start := routeToKey(d.start)
+	i, j := 0, 0
+	for i < len(fromLeaves) || j < len(toLeaves) {
+		var key, from, to []byte
+		switch {
+		case j == len(toLeaves) || (i < len(fromLeaves) && bytes.Compare(fromLeaves[i].key, toLeaves[j].key) < 0):
+			key, from = fromLeaves[i].key, fromLeaves[i].value
+			i++
+		case i == len(fromLeaves) || bytes.Compare(fromLeaves[i].key, toLeaves[j].key) > 0:
+			key, to = toLeaves[j].key, toLeaves[j].value
+			j++
+		default:
+			key, from, to = toLeaves[j].key, fromLeaves[i].value, toLeaves[j].value
+			i++
+			j++
+			if bytes.Equal(from, to) {
+				continue
+			}
+		}
+		if bytes.Compare(key, start) < 0 {
+			continue
+		}
+		if !d.visit(key, from, to) {
+			return false
+		}
+	}
+	return true
+}
+
+// leaves returns all the leaves below the node, sorted by key as the branches
+// are walked in order.
+func leaves(t *Trie, n *node, route []byte) ([]*leafEntry, error) {
+	entries := []*leafEntry{}
+	if n == nil {
+		return entries, nil
+	}
+	var walk func(n *node, route []byte) error
+	walk = func(n *node, route []byte) error {
+		ty, err := n.Type()
+		if err != nil {
+			return err
+		}
+		switch ty {
+		case branch:
+			for i := 0; i < 16; i++ {
+				if len(n.Val[i]) == 0 {
+					continue
+				}
+				child, err := t.fetchNode(n.Val[i])
+				if err != nil {
+					return err
+				}
+				if err := walk(child, append(route[:len(route):len(route)], byte(i))); err != nil {
+					return err
+				}
+			}
+		case ext:
+			child, err := t.fetchNode(n.Val[2])
+			if err != nil {
+				return err
+			}
+			return walk(child, append(route[:len(route):len(route)], n.Val[1]...))
+		case leaf:
+			key := routeToKey(append(route[:len(route):len(route)], n.Val[1]...))
+			entries = append(entries, &leafEntry{key, n.Val[2]})
+		default:
+			return errors.New("unknown node type")
+		}
+		return nil
+	}
+	if err := walk(n, route); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
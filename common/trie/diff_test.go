@@ -0,0 +1,138 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type diffEntry struct {
+	key, from, to string
+}
+
+func collectDiff(t *testing.T, from, to *Trie, start []byte, limit int) []diffEntry {
+	entries := []diffEntry{}
+	err := Diff(from, to, start, func(key, fromVal, toVal []byte) bool {
+		entries = append(entries, diffEntry{byteutils.Hex(key), string(fromVal), string(toVal)})
+		return limit <= 0 || len(entries) < limit
+	})
+	assert.Nil(t, err)
+	return entries
+}
+
+func TestDiff(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	from, _ := NewTrie(nil, stor)
+	to, _ := NewTrie(nil, stor)
+
+	for _, k := range []string{"123450", "123350", "122450", "223350", "133350"} {
+		from.Put(hexKey(k), []byte("v"+k))
+		to.Put(hexKey(k), []byte("v"+k))
+	}
+	assert.Empty(t, collectDiff(t, from, to, nil, 0))
+
+	to.Put(hexKey("123350"), []byte("changed"))
+	to.Del(hexKey("223350"))
+	to.Put(hexKey("123351"), []byte("added"))
+
+	expected := []diffEntry{
+		{"123350", "v123350", "changed"},
+		{"123351", "", "added"},
+		{"223350", "v223350", ""},
+	}
+	assert.Equal(t, expected, collectDiff(t, from, to, nil, 0))
+	assert.Equal(t, expected[:2], collectDiff(t, from, to, nil, 2))
+	assert.Equal(t, expected[1:], collectDiff(t, from, to, hexKey("123351"), 0))
+
+	empty, _ := NewTrie(nil, stor)
+	assert.Equal(t, 5, len(collectDiff(t, empty, from, nil, 0)))
+	assert.Equal(t, 5, len(collectDiff(t, from, empty, nil, 0)))
+}
+
+func TestDiffRandom(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	from, _ := NewTrie(nil, stor)
+	values := make(map[string]string)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		k := fmt.Sprintf("%08x", r.Uint32())
+		values[k] = k
+		from.Put(hexKey(k), []byte(k))
+	}
+
+	to, _ := from.Clone()
+	changed := make(map[string]bool)
+	i := 0
+	for k := range values {
+		switch i % 10 {
+		case 0:
+			to.Put(hexKey(k), []byte("changed"))
+			changed[k] = true
+		case 1:
+			to.Del(hexKey(k))
+			changed[k] = true
+		}
+		i++
+	}
+	for i := 0; i < 20; i++ {
+		k := fmt.Sprintf("%08x", r.Uint32())
+		if _, ok := values[k]; !ok {
+			to.Put(hexKey(k), []byte(k))
+			changed[k] = true
+		}
+	}
+
+	entries := collectDiff(t, from, to, nil, 0)
+	assert.Equal(t, len(changed), len(entries))
+	for i, e := range entries {
+		assert.True(t, changed[e.key], e.key)
+		if i > 0 {
+			assert.True(t, entries[i-1].key < e.key)
+		}
+	}
+
+	// paging by the last key returns the same diff.
+	paged := []diffEntry{}
+	var start []byte
+	for {
+		page := collectDiff(t, from, to, start, 7)
+		for _, e := range page {
+			if len(paged) > 0 && paged[len(paged)-1].key == e.key {
+				continue
+			}
+			paged = append(paged, e)
+		}
+		if len(page) < 7 {
+			break
+		}
+		start = hexKey(page[len(page)-1].key)
+	}
+	assert.Equal(t, entries, paged)
+}
+
+func hexKey(s string) []byte {
+	k, _ := byteutils.FromHex(s)
+	return k
+}
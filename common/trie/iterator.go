@@ -31,11 +31,14 @@ var (
 type IteratorState struct {
 	node *node
 	pos  int
+	// route from the trie root to the node.
+	route []byte
 }
 
 // Iterator to traverse leaf node in a trie
 type Iterator struct {
 	stack []*IteratorState
+	key   []byte
 	value []byte
 	root  *Trie
 }
@@ -56,7 +59,7 @@ func validElementsInBranchNode(offset int, node *node) []int {
 
 // Iterator return an iterator
 func (t *Trie) Iterator(prefix []byte) (*Iterator, error) {
-	rootHash, err := t.getSubTrieWithMaxCommonPrefix(prefix)
+	rootHash, route, err := t.getSubTrieWithMaxCommonPrefix(prefix)
 	if err != nil {
 		return nil, err
 	}
@@ -71,53 +74,58 @@ func (t *Trie) Iterator(prefix []byte) (*Iterator, error) {
 	}
 	return &Iterator{
 		root:  t,
-		stack: []*IteratorState{&IteratorState{node, pos}},
+		stack: []*IteratorState{&IteratorState{node, pos, route}},
 		value: nil,
 	}, nil
 }
 
-func (t *Trie) getSubTrieWithMaxCommonPrefix(prefix []byte) ([]byte, error) {
+// getSubTrieWithMaxCommonPrefix returns the root of the sub trie with the
+// prefix, and the route from the trie root to it.
+func (t *Trie) getSubTrieWithMaxCommonPrefix(prefix []byte) ([]byte, []byte, error) {
 	curRootHash := t.rootHash
 	curRoute := keyToRoute(prefix)
+	route := []byte{}
 	for len(curRoute) > 0 {
 		rootNode, err := t.fetchNode(curRootHash)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		flag, err := rootNode.Type()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		switch flag {
 		case branch:
 			curRootHash = rootNode.Val[curRoute[0]]
+			route = append(route, curRoute[0])
 			curRoute = curRoute[1:]
 		case ext:
 			path := rootNode.Val[1]
 			next := rootNode.Val[2]
 			matchLen := prefixLen(path, curRoute)
 			if matchLen != len(path) && matchLen != len(curRoute) {
-				return nil, ErrNotFound
+				return nil, nil, ErrNotFound
 			}
 			curRootHash = next
+			route = append(route, path...)
 			curRoute = curRoute[matchLen:]
 		case leaf:
 			path := rootNode.Val[1]
 			matchLen := prefixLen(path, curRoute)
 			if matchLen != len(path) && matchLen != len(curRoute) {
-				return nil, ErrNotFound
+				return nil, nil, ErrNotFound
 			}
 			curRootHash = rootNode.Hash
 			curRoute = curRoute[matchLen:]
 		default:
-			return nil, errors.New("unknown node type")
+			return nil, nil, errors.New("unknown node type")
 		}
 	}
-	return curRootHash, nil
+	return curRootHash, route, nil
 }
 
-func (it *Iterator) push(node *node, pos int) {
-	it.stack = append(it.stack, &IteratorState{node, pos})
+func (it *Iterator) push(node *node, pos int, route []byte) {
+	it.stack = append(it.stack, &IteratorState{node, pos, route})
 }
 
 func (it *Iterator) pop() (*IteratorState, error) {
@@ -138,6 +146,7 @@ func (it *Iterator) Next() (bool, error) {
 	}
 	node := state.node
 	pos := state.pos
+	route := state.route
 	ty, err := node.Type()
 	for {
 		switch ty {
@@ -147,20 +156,23 @@ func (it *Iterator) Next() (bool, error) {
 				return false, errors.New("empty branch node")
 			}
 			if len(valid) > 1 {
-				it.push(node, valid[1])
+				it.push(node, valid[1], route)
 			}
+			route = append(route[:len(route):len(route)], byte(valid[0]))
 			node, err = it.root.fetchNode(node.Val[valid[0]])
 			if err != nil {
 				return false, err
 			}
 			ty, err = node.Type()
 		case ext:
+			route = append(route[:len(route):len(route)], node.Val[1]...)
 			node, err = it.root.fetchNode(node.Val[2])
 			if err != nil {
 				return false, err
 			}
 			ty, err = node.Type()
 		case leaf:
+			it.key = routeToKey(append(route[:len(route):len(route)], node.Val[1]...))
 			it.value = node.Val[2]
 			return true, nil
 		default:
@@ -170,6 +182,11 @@ func (it *Iterator) Next() (bool, error) {
 	}
 }
 
+// Key return current leaf node's key
+func (it *Iterator) Key() []byte {
+	return it.key
+}
+
 // Value return current leaf node's value
 func (it *Iterator) Value() []byte {
 	return it.value
@@ -103,14 +103,17 @@ func TestIterator1(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, next, true)
 	assert.Equal(t, it.Value(), []byte(names[2]))
+	assert.Equal(t, it.Key(), keys[2])
 	next, err = it.Next()
 	assert.Nil(t, err)
 	assert.Equal(t, next, true)
 	assert.Equal(t, it.Value(), []byte(names[1]))
+	assert.Equal(t, it.Key(), keys[1])
 	next, err = it.Next()
 	assert.Nil(t, err)
 	assert.Equal(t, next, true)
 	assert.Equal(t, it.Value(), []byte(names[0]))
+	assert.Equal(t, it.Key(), keys[0])
 	next, err = it.Next()
 	assert.Nil(t, err)
 	assert.Equal(t, next, false)
@@ -177,3 +180,29 @@ func TestIteratorEmpty(t *testing.T) {
 	assert.Nil(t, iter)
 	assert.Equal(t, err, storage.ErrKeyNotFound)
 }
+
+func TestIteratorKey(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, err := NewTrie(nil, storage)
+	assert.Nil(t, err)
+	values := map[string][]byte{}
+	for i := 0; i < 100; i++ {
+		key := hash.Sha3256([]byte{byte(i)})
+		values[byteutils.Hex(key)] = []byte{byte(i)}
+		_, err := tr.Put(key, []byte{byte(i)})
+		assert.Nil(t, err)
+	}
+
+	it, err := tr.Iterator(nil)
+	assert.Nil(t, err)
+	count := 0
+	next, err := it.Next()
+	for next {
+		assert.Nil(t, err)
+		assert.Equal(t, values[byteutils.Hex(it.Key())], it.Value())
+		count++
+		next, err = it.Next()
+	}
+	assert.Nil(t, err)
+	assert.Equal(t, len(values), count)
+}
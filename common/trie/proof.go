@@ -21,6 +21,8 @@ package trie
 import (
 	"bytes"
 	"errors"
+
+	"github.com/nebulasio/go-nebulas/storage"
 )
 
 // MerkleProof is a path from root to the proved node
@@ -76,46 +78,183 @@ func (t *Trie) Prove(key []byte) (MerkleProof, error) {
 
 // Verify whether the merkle proof from root to the associated node is right
 func (t *Trie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
+	_, err := t.verifyPath(rootHash, key, proof)
+	return err
+}
+
+// verifyPath walks the merkle proof from root along the route of the key and
+// returns the leaf node of the key, which must be the last node of the proof.
+func (t *Trie) verifyPath(rootHash []byte, key []byte, proof MerkleProof) ([][]byte, error) {
+	curRoute := keyToRoute(key)
+	wantHash := rootHash
+	for i, val := range proof {
+		n, err := t.createNode(val)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(wantHash, n.Hash) {
+			return nil, errors.New("wrong hash")
+		}
+		flag, err := n.Type()
+		if err != nil {
+			return nil, err
+		}
+		switch flag {
+		case branch:
+			if len(curRoute) == 0 {
+				return nil, errors.New("route ends in branch node")
+			}
+			wantHash = val[curRoute[0]]
+			curRoute = curRoute[1:]
+		case ext:
+			path := val[1]
+			if len(path) > len(curRoute) || !bytes.Equal(path, curRoute[:len(path)]) {
+				return nil, errors.New("wrong path")
+			}
+			wantHash = val[2]
+			curRoute = curRoute[len(path):]
+		case leaf:
+			if !bytes.Equal(val[1], curRoute) {
+				return nil, errors.New("wrong path")
+			}
+			if i != len(proof)-1 {
+				return nil, errors.New("proof continues after leaf node")
+			}
+			return val, nil
+		default:
+			return nil, errors.New("unknown node type")
+		}
+	}
+	return nil, errors.New("proof not end with leaf node")
+}
+
+// VerifyValue verify the merkle proof from root to the leaf node of the key,
+// and the value in the leaf node
+func VerifyValue(rootHash []byte, key []byte, value []byte, proof MerkleProof) error {
+	if len(proof) == 0 {
+		return ErrNotFound
+	}
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return err
+	}
+	t, err := NewTrie(nil, stor)
+	if err != nil {
+		return err
+	}
+	leafVal, err := t.verifyPath(rootHash, key, proof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(leafVal[2], value) {
+		return errors.New("wrong value")
+	}
+	return nil
+}
+
+// ProveAbsence proves the key is not in trie, MerkleProof is the path from
+// root to the node where the route of the key leaves the trie, empty for an
+// empty trie. It returns ErrFound if the key exists.
+func (t *Trie) ProveAbsence(key []byte) (MerkleProof, error) {
+	curRoute := keyToRoute(key)
+	curRootHash := t.rootHash
+	var proof MerkleProof
+	for len(curRootHash) > 0 {
+		rootNode, err := t.fetchNode(curRootHash)
+		if err != nil {
+			return nil, err
+		}
+		flag, err := rootNode.Type()
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, rootNode.Val)
+		switch flag {
+		case branch:
+			if len(curRoute) == 0 {
+				return nil, errors.New("route ends in branch node")
+			}
+			curRootHash = rootNode.Val[curRoute[0]]
+			curRoute = curRoute[1:]
+		case ext:
+			path := rootNode.Val[1]
+			if prefixLen(path, curRoute) != len(path) {
+				return proof, nil
+			}
+			curRootHash = rootNode.Val[2]
+			curRoute = curRoute[len(path):]
+		case leaf:
+			if !bytes.Equal(rootNode.Val[1], curRoute) {
+				return proof, nil
+			}
+			return nil, ErrFound
+		default:
+			return nil, errors.New("unknown node type")
+		}
+	}
+	return proof, nil
+}
+
+// VerifyAbsence verify the merkle proof from root to the node where the route
+// of the key leaves the trie, the key is not in the trie of the root.
+func VerifyAbsence(rootHash []byte, key []byte, proof MerkleProof) error {
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return err
+	}
+	t, err := NewTrie(nil, stor)
+	if err != nil {
+		return err
+	}
 	curRoute := keyToRoute(key)
-	length := len(proof)
 	wantHash := rootHash
-	for i := 0; i < length; i++ {
-		val := proof[i]
+	for i, val := range proof {
+		if len(wantHash) == 0 {
+			return errors.New("proof continues after empty node")
+		}
 		n, err := t.createNode(val)
 		if err != nil {
 			return err
 		}
-		proofHash := n.Hash
-		if !bytes.Equal(wantHash, proofHash) {
+		if !bytes.Equal(wantHash, n.Hash) {
 			return errors.New("wrong hash")
 		}
-		switch len(val) {
-		case 16: // Branch Node
+		flag, err := n.Type()
+		if err != nil {
+			return err
+		}
+		switch flag {
+		case branch:
+			if len(curRoute) == 0 {
+				return errors.New("route ends in branch node")
+			}
 			wantHash = val[curRoute[0]]
 			curRoute = curRoute[1:]
-			break
-		case 3: // Extension Node or Leaf Node
-			if val[0] == nil || len(val) == 0 {
-				return errors.New("unknown node type")
+		case ext:
+			path := val[1]
+			if prefixLen(path, curRoute) != len(path) {
+				return lastNode(i, proof)
 			}
-			if val[0][0] == byte(ext) {
-				extLen := len(val[1])
-				if !bytes.Equal(val[1], curRoute[:extLen]) {
-					return errors.New("wrong hash")
-				}
-				wantHash = val[2]
-				curRoute = curRoute[extLen:]
-				break
-			} else if val[0][0] == byte(leaf) {
-				if !bytes.Equal(val[1], curRoute) {
-					return errors.New("wrong hash")
-				}
-				return nil
+			wantHash = val[2]
+			curRoute = curRoute[len(path):]
+		case leaf:
+			if bytes.Equal(val[1], curRoute) {
+				return ErrFound
 			}
-			return errors.New("unknown node type")
+			return lastNode(i, proof)
 		default:
-			return errors.New("wrong node value, expect [16][]byte or [3][]byte, get [" + string(len(proofHash)) + "][]byte")
+			return errors.New("unknown node type")
 		}
 	}
+	if len(wantHash) != 0 {
+		return errors.New("proof ends before the route leaves the trie")
+	}
+	return nil
+}
+
+func lastNode(i int, proof MerkleProof) error {
+	if i != len(proof)-1 {
+		return errors.New("proof continues after the route leaves the trie")
+	}
 	return nil
 }
@@ -40,6 +40,7 @@ const (
 // Errors
 var (
 	ErrNotFound = storage.ErrKeyNotFound
+	ErrFound    = errors.New("key found in trie")
 )
 
 // Node in trie, three kinds,
@@ -77,7 +78,7 @@ func (n *node) Type() (ty, error) {
 	case 16: // Branch Node
 		return branch, nil
 	case 3: // Extension Node or Leaf Node
-		if n.Val[0] == nil {
+		if len(n.Val[0]) == 0 {
 			return unknown, errors.New("unknown node type")
 		}
 		return ty(n.Val[0][0]), nil
@@ -481,6 +482,15 @@ func keyToRoute(key []byte) []byte {
 	return route
 }
 
+func routeToKey(route []byte) []byte {
+	l := len(route) / 2
+	var key = make([]byte, l)
+	for i := 0; i < l; i++ {
+		key[i] = route[i*2]<<4 + route[i*2+1]
+	}
+	return key
+}
+
 func emptyBranchNode() *node {
 	empty := &node{Val: [][]byte{nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil}}
 	pb, _ := empty.ToProto()
@@ -192,6 +192,15 @@ func TestTrie_Operation(t *testing.T) {
 	if err := tr.Verify(tr.rootHash, addr1, proof); err != nil {
 		t.Errorf("1 Trie.Verify() %v", err.Error())
 	}
+	if err := VerifyValue(tr.rootHash, addr1, val11, proof); err != nil {
+		t.Errorf("1 VerifyValue() %v", err.Error())
+	}
+	if err := VerifyValue(tr.rootHash, addr1, val2, proof); err == nil {
+		t.Errorf("1 VerifyValue() accepts wrong value")
+	}
+	if err := VerifyValue(tr.rootHash, addr1, val11, proof[:len(proof)-1]); err == nil {
+		t.Errorf("1 VerifyValue() accepts proof without leaf")
+	}
 	// get node "1f345678e9"
 	checkVal1, _ := tr.Get(addr1)
 	if !reflect.DeepEqual(checkVal1, val11) {
@@ -238,3 +247,78 @@ func TestTrie_Operation(t *testing.T) {
 		t.Errorf("3 Trie.Del() = %v, want %v", nil, tr.rootHash)
 	}
 }
+
+func TestTrie_ProveAbsence(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, stor)
+	absent := []byte{0x1f, 0x34, 0x56, 0x78, 0xe0}
+	proof, err := tr.ProveAbsence(absent)
+	if err != nil || len(proof) != 0 {
+		t.Errorf("ProveAbsence() of empty trie = %v, %v", proof, err)
+	}
+	if err := VerifyAbsence(tr.rootHash, absent, proof); err != nil {
+		t.Errorf("VerifyAbsence() of empty trie %v", err)
+	}
+
+	present := []byte{0x1f, 0x34, 0x56, 0x78, 0xe9}
+	tr.Put(present, []byte("leaf 1"))
+	tr.Put([]byte{0x1f, 0x35, 0x56, 0x78, 0xe9}, []byte("leaf 2"))
+	tr.Put([]byte{0x1f, 0x55, 0x56, 0x78, 0xe9}, []byte("leaf 3"))
+	for _, key := range [][]byte{absent, {0x1f, 0x36, 0x56, 0x78, 0xe9}, {0x2f, 0x34, 0x56, 0x78, 0xe9}} {
+		proof, err := tr.ProveAbsence(key)
+		if err != nil {
+			t.Errorf("ProveAbsence(%v) %v", key, err)
+		}
+		if err := VerifyAbsence(tr.rootHash, key, proof); err != nil {
+			t.Errorf("VerifyAbsence(%v) %v", key, err)
+		}
+		if err := VerifyAbsence(tr.rootHash, key, proof[:len(proof)-1]); err == nil {
+			t.Errorf("VerifyAbsence(%v) accepts truncated proof", key)
+		}
+		if err := VerifyAbsence(hash.Sha3256(key), key, proof); err == nil {
+			t.Errorf("VerifyAbsence(%v) accepts wrong root", key)
+		}
+	}
+	if _, err := tr.ProveAbsence(present); err != ErrFound {
+		t.Errorf("ProveAbsence() of present key %v", err)
+	}
+	proof, _ = tr.Prove(present)
+	if err := VerifyAbsence(tr.rootHash, present, proof); err == nil {
+		t.Errorf("VerifyAbsence() accepts inclusion proof")
+	}
+}
+
+func TestVerifyValue(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, stor)
+	key := []byte{0x1f, 0x34, 0x56, 0x78, 0xe9}
+	tr.Put(key, []byte("leaf 1"))
+	tr.Put([]byte{0x1f, 0x35, 0x56, 0x78, 0xe9}, []byte("leaf 2"))
+	proof, _ := tr.Prove(key)
+	if err := VerifyValue(tr.rootHash, key, []byte("leaf 1"), proof); err != nil {
+		t.Errorf("VerifyValue() %v", err)
+	}
+	if err := VerifyValue(tr.rootHash, key, []byte("leaf 2"), proof); err == nil {
+		t.Errorf("VerifyValue() accepts wrong value")
+	}
+
+	// a leaf appended to a valid proof doesn't prove its value.
+	forged := append(proof[:len(proof):len(proof)], [][]byte{{byte(leaf)}, keyToRoute(key), []byte("forged")})
+	if err := VerifyValue(tr.rootHash, key, []byte("forged"), forged); err == nil {
+		t.Errorf("VerifyValue() accepts appended leaf")
+	}
+
+	// crafted nodes fail the proof without panic.
+	for _, val := range [][][]byte{
+		{{byte(ext)}, make([]byte, 64), hash.Sha3256(key)},
+		{{}, keyToRoute(key), []byte("forged")},
+	} {
+		n, err := tr.createNode(val)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyValue(n.Hash, key, []byte("forged"), MerkleProof{val}); err == nil {
+			t.Errorf("VerifyValue() accepts crafted node %v", val)
+		}
+	}
+}
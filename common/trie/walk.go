@@ -0,0 +1,69 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+// NodeVisitor is called with the hash of each node walked, and the value of
+// the leaf nodes, nil for the others. It returns whether to walk down the
+// children of the node.
+type NodeVisitor func(hash []byte, value []byte) (bool, error)
+
+// WalkNodes walks the nodes of the trie depth first from the root, so that a
+// garbage collector finds the nodes of a state. The subtrie of a node skipped
+// by the visitor is the same wherever the node is, being addressed by its hash.
+func (t *Trie) WalkNodes(visit NodeVisitor) error {
+	if len(t.rootHash) == 0 {
+		return nil
+	}
+	return t.walk(t.rootHash, visit)
+}
+
+func (t *Trie) walk(hash []byte, visit NodeVisitor) error {
+	n, err := t.fetchNode(hash)
+	if err != nil {
+		return err
+	}
+	ty, err := n.Type()
+	if err != nil {
+		return err
+	}
+
+	var value []byte
+	if ty == leaf {
+		value = n.Val[2]
+	}
+	down, err := visit(hash, value)
+	if err != nil || !down {
+		return err
+	}
+
+	switch ty {
+	case branch:
+		for _, child := range n.Val {
+			if len(child) == 0 {
+				continue
+			}
+			if err := t.walk(child, visit); err != nil {
+				return err
+			}
+		}
+	case ext:
+		return t.walk(n.Val[2], visit)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalkNodes(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, storage)
+	assert.Nil(t, tr.WalkNodes(func(hash, value []byte) (bool, error) {
+		t.Fatal("empty trie walked")
+		return true, nil
+	}))
+
+	names := []string{"123450", "123350", "122450", "223350", "133350"}
+	for _, v := range names {
+		key, _ := byteutils.FromHex(v)
+		tr.Put(key, []byte(v))
+	}
+
+	nodes := make(map[string]bool)
+	var values []string
+	assert.Nil(t, tr.WalkNodes(func(hash, value []byte) (bool, error) {
+		nodes[byteutils.Hex(hash)] = true
+		if value != nil {
+			values = append(values, string(value))
+		}
+		return true, nil
+	}))
+	sort.Strings(names)
+	sort.Strings(values)
+	assert.Equal(t, names, values)
+	for k := range nodes {
+		hash, _ := byteutils.FromHex(k)
+		_, err := storage.Get(hash)
+		assert.Nil(t, err)
+	}
+
+	// the children of a skipped node are not walked.
+	walked := 0
+	assert.Nil(t, tr.WalkNodes(func(hash, value []byte) (bool, error) {
+		walked++
+		return false, nil
+	}))
+	assert.Equal(t, 1, walked)
+
+	assert.Nil(t, storage.Del(tr.RootHash()))
+	assert.Equal(t, ErrNotFound, tr.WalkNodes(func(hash, value []byte) (bool, error) {
+		return true, nil
+	}))
+}
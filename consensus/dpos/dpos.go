@@ -33,7 +33,9 @@ import (
 	"github.com/nebulasio/go-nebulas/net/p2p"
 
 	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/crash"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/sched"
 	"github.com/sirupsen/logrus"
 )
 
@@ -43,6 +45,7 @@ var (
 	ErrMissingConfigForDpos = errors.New("missing configuration for Dpos")
 	ErrInvalidBlockProposer = errors.New("invalid block proposer")
 	ErrCannotMintBlockNow   = errors.New("cannot mint block now, waiting for sync over")
+	ErrTailAheadOfClock     = errors.New("tail block is ahead of the clock")
 )
 
 // Neblet interface breaks cycle import dependency and hides unused services.
@@ -55,7 +58,8 @@ type Neblet interface {
 
 // Dpos Delegate Proof-of-Stake
 type Dpos struct {
-	quitCh chan bool
+	quitCh  chan bool
+	reorgCh chan *reorgRequest
 
 	chain *core.BlockChain
 	nm    p2p.Manager
@@ -68,6 +72,9 @@ type Dpos struct {
 	dynastyInterval int64
 	txsPerBlock     int
 
+	// witness attaches the execution witness to the minted blocks.
+	witness bool
+
 	mining    bool
 	canMining bool
 }
@@ -75,7 +82,8 @@ type Dpos struct {
 // NewDpos create Dpos instance.
 func NewDpos(neblet Neblet) (*Dpos, error) {
 	p := &Dpos{
-		quitCh: make(chan bool, 5),
+		quitCh:  make(chan bool),
+		reorgCh: make(chan *reorgRequest, 1),
 
 		chain: neblet.BlockChain(),
 		nm:    neblet.NetManager(),
@@ -83,7 +91,7 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 
 		blockInterval:   core.BlockInterval,
 		dynastyInterval: core.DynastyInterval,
-		txsPerBlock:     2000,
+		txsPerBlock:     core.TxsPerBlock,
 
 		mining:    false,
 		canMining: false,
@@ -108,6 +116,7 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 	}
 	p.coinbase = coinbase
 	p.miner = miner
+	p.witness = config.Witness
 	return p, nil
 }
 
@@ -121,12 +130,13 @@ func (p *Dpos) Start() {
 func (p *Dpos) Stop() {
 	logging.CLog().Info("Stop dpos consensus.")
 	p.StopMining()
-	p.quitCh <- true
+	// closed, so the callers waiting on the block loop return too.
+	close(p.quitCh)
 }
 
 // StartMining start the consensus
 func (p *Dpos) StartMining(passphrase []byte) error {
-	if err := p.am.Unlock(p.miner, passphrase, keystore.YearUnlockDuration); err != nil {
+	if err := p.am.UnlockSigner(p.miner, passphrase); err != nil {
 		return err
 	}
 	p.mining = true
@@ -155,6 +165,8 @@ func less(a *core.Block, b *core.Block) bool {
 
 // do fork choice
 func (p *Dpos) forkChoice() {
+	defer crash.Recover("consensus.dpos.forkChoice", nil, nil)
+
 	bc := p.chain
 	tailBlock := bc.TailBlock()
 	detachedTailBlocks := bc.DetachedTailBlocks()
@@ -163,9 +175,18 @@ func (p *Dpos) forkChoice() {
 	newTailBlock := tailBlock
 
 	for _, v := range detachedTailBlocks {
-		if less(newTailBlock, v) {
-			newTailBlock = v
+		if !less(newTailBlock, v) {
+			continue
+		}
+		// a fork reverting more than the max reorg depth is never chosen.
+		if err := bc.CheckReorgDepth(v); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"tail": v,
+				"err":  err,
+			}).Debug("Skip a detached tail.")
+			continue
 		}
+		newTailBlock = v
 	}
 
 	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
@@ -291,7 +312,10 @@ func (p *Dpos) VerifyBlock(block *core.Block, parent *core.Block) error {
 	return nil
 }
 
-func (p *Dpos) mintBlock(now int64) error {
+func (p *Dpos) mintBlock(now int64) (err error) {
+	// a panic of minting skips the slot instead of stopping the validator.
+	defer crash.Recover("consensus.dpos.mintBlock", logrus.Fields{"now": now}, &err)
+
 	// check can do mining
 	if !p.mining || !p.canMining {
 		if !p.canMining {
@@ -305,6 +329,9 @@ func (p *Dpos) mintBlock(now int64) error {
 	// check proposer
 	tail := p.chain.TailBlock()
 	elapsedSecond := now - tail.Timestamp()
+	if elapsedSecond <= 0 {
+		return ErrTailAheadOfClock
+	}
 	context, err := tail.NextDynastyContext(elapsedSecond)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -334,6 +361,9 @@ func (p *Dpos) mintBlock(now int64) error {
 		"actual":   p.coinbase.String(),
 	}).Info("My turn to mint block")
 
+	// the rpc requests take the busy quota while the block is produced.
+	defer sched.Critical()()
+
 	// mint new block
 	block, err := core.NewBlock(p.chain.ChainID(), p.coinbase, tail)
 	if err != nil {
@@ -363,6 +393,17 @@ func (p *Dpos) mintBlock(now int64) error {
 		}).Error("Failed to sign new block")
 		return err
 	}
+	if p.witness {
+		witness, err := core.GenerateWitness(p.chain, block)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"err":   err,
+			}).Error("Failed to generate witness of new block")
+			return err
+		}
+		block.SetWitness(witness)
+	}
 	// broadcast it
 	err = p.chain.BlockPool().PushAndBroadcast(block)
 	if err != nil {
@@ -390,6 +431,12 @@ func (p *Dpos) blockLoop() {
 			p.mintBlock(now.Unix())
 		case <-p.chain.BlockPool().ReceivedLinkedBlockCh():
 			p.forkChoice()
+		case req := <-p.reorgCh:
+			if req.ctx.Err() != nil {
+				continue
+			}
+			tail, err := p.simulateReorg(req.depth)
+			req.result <- &reorgResult{tail: tail, err: err}
 		case <-p.quitCh:
 			logging.CLog().Info("Shutdowned Dpos Mining.")
 			return
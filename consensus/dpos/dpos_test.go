@@ -198,6 +198,8 @@ func (n MockNetManager) SendMsg(name string, msg []byte, target string) error {
 	return nil
 }
 
+func (n MockNetManager) ClosePeer(string, error) {}
+
 func (n MockNetManager) BroadcastNetworkID([]byte) {}
 
 func (n MockNetManager) BuildData([]byte, string) []byte { return nil }
@@ -0,0 +1,162 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxSimulatedReorgDepth is the max canonical blocks a simulated reorg reverts.
+const MaxSimulatedReorgDepth = 64
+
+// Errors of the reorg simulation
+var (
+	ErrInvalidReorgDepth  = errors.New("invalid reorg depth")
+	ErrNoUnlockedProposer = errors.New("no proposer of the next slots is unlocked")
+	ErrReorgNotAdopted    = errors.New("simulated fork not adopted")
+	ErrConsensusStopped   = errors.New("consensus stopped")
+)
+
+type reorgRequest struct {
+	ctx    context.Context
+	depth  uint64
+	result chan *reorgResult
+}
+
+type reorgResult struct {
+	tail *core.Block
+	err  error
+}
+
+// SimulateReorg builds a fork branching depth blocks below the tail, one
+// block longer than the canonical chain, and adopts it. The fork blocks are
+// empty, the transactions of the reverted blocks return to the pool. Each is
+// signed by the proposer of its slot, whose key must be unlocked on the node,
+// the slots of the locked proposers are skipped. As a slot is minted once,
+// the fork takes the slots after the tail and its tip is ahead of the clock,
+// the node mints again once the clock passes it. The fork is only pushed to
+// the local block pool, it's meant for the integration tests on a dev chain.
+// It returns the error of the ctx if it's done before the block loop builds
+// the fork, a request given up is dropped by the loop.
+func (p *Dpos) SimulateReorg(ctx context.Context, depth uint64) (*core.Block, error) {
+	req := &reorgRequest{ctx: ctx, depth: depth, result: make(chan *reorgResult, 1)}
+	// the fork is built by the block loop, not racing with the minted blocks.
+	select {
+	case p.reorgCh <- req:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.quitCh:
+		return nil, ErrConsensusStopped
+	}
+	select {
+	case result := <-req.result:
+		return result.tail, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.quitCh:
+		return nil, ErrConsensusStopped
+	}
+}
+
+func (p *Dpos) simulateReorg(depth uint64) (*core.Block, error) {
+	tail := p.chain.TailBlock()
+	if depth == 0 || depth > MaxSimulatedReorgDepth || depth >= tail.Height() {
+		return nil, ErrInvalidReorgDepth
+	}
+	if max := p.chain.MaxReorgDepth(); max > 0 && depth > max {
+		return nil, ErrInvalidReorgDepth
+	}
+	parent, err := p.chain.GetBlockByHeight(tail.Height() - depth)
+	if err != nil {
+		return nil, err
+	}
+
+	// a slot is minted once, the fork takes the slots after the tail.
+	after := tail.Timestamp()
+	for i := uint64(0); i <= depth; i++ {
+		block, err := p.mintForkBlock(parent, after)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.chain.BlockPool().Push(block); err != nil {
+			return nil, err
+		}
+		if parent = p.chain.GetBlock(block.Hash()); parent == nil {
+			return nil, core.ErrMissingParentBlock
+		}
+		after = parent.Timestamp()
+	}
+
+	p.forkChoice()
+	if !p.chain.TailBlock().Hash().Equals(parent.Hash()) {
+		return nil, ErrReorgNotAdopted
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"old tail": tail,
+		"new tail": parent,
+		"depth":    depth,
+	}).Warn("Adopted a simulated fork.")
+	return parent, nil
+}
+
+// mintForkBlock mints an empty block on the parent at the first slot after
+// the given timestamp with an unlocked proposer.
+func (p *Dpos) mintForkBlock(parent *core.Block, after int64) (*core.Block, error) {
+	first := (after-parent.Timestamp())/p.blockInterval + 1
+	// every proposer has a slot in a round.
+	for slot := first; slot < first+core.DynastySize; slot++ {
+		elapsedSecond := slot * p.blockInterval
+		context, err := parent.NextDynastyContext(elapsedSecond)
+		if err != nil {
+			return nil, err
+		}
+		if context.Proposer == nil {
+			continue
+		}
+		proposer, err := core.AddressParseFromBytes(context.Proposer)
+		if err != nil {
+			return nil, err
+		}
+		block, err := core.NewBlock(p.chain.ChainID(), p.coinbase, parent)
+		if err != nil {
+			return nil, err
+		}
+		if err := block.LoadDynastyContext(context); err != nil {
+			return nil, err
+		}
+		block.SetMiner(proposer)
+		if err := block.Seal(); err != nil {
+			return nil, err
+		}
+		if err := p.am.SignBlock(proposer, block); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"proposer": proposer.String(),
+				"err":      err,
+			}).Debug("Skip the slot of a locked proposer.")
+			continue
+		}
+		return block, nil
+	}
+	return nil, ErrNoUnlockedProposer
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDpos_SimulateReorg(t *testing.T) {
+	dpos, err := NewDpos(mockNeb())
+	assert.Nil(t, err)
+	var c MockConsensus
+	dpos.chain.SetConsensusHandler(c)
+	assert.Nil(t, dpos.StartMining([]byte("passphrase")))
+	dpos.SetCanMining(true)
+
+	// the miner proposes a slot of every round.
+	round := core.DynastySize * core.BlockInterval
+	for i := int64(1); i <= 3; i++ {
+		assert.Nil(t, dpos.mintBlock(i*round))
+		dpos.forkChoice()
+	}
+	tail := dpos.chain.TailBlock()
+	assert.Equal(t, uint64(4), tail.Height())
+
+	_, err = dpos.simulateReorg(0)
+	assert.Equal(t, ErrInvalidReorgDepth, err)
+	_, err = dpos.simulateReorg(tail.Height())
+	assert.Equal(t, ErrInvalidReorgDepth, err)
+
+	newTail, err := dpos.simulateReorg(2)
+	assert.Nil(t, err)
+	assert.Equal(t, tail.Height()+1, newTail.Height())
+	assert.Equal(t, newTail.Hash(), dpos.chain.TailBlock().Hash())
+
+	// the fork branches from the canonical block at the depth.
+	ancestor, err := dpos.chain.GetBlockByHeight(tail.Height() - 2)
+	assert.Nil(t, err)
+	fork := newTail
+	for fork.Height() > ancestor.Height()+1 {
+		fork = dpos.chain.GetBlock(fork.ParentHash())
+	}
+	assert.Equal(t, ancestor.Hash(), fork.ParentHash())
+
+	// the fork takes the slots after the tail, the node mints on it once the
+	// clock passes its tip.
+	assert.True(t, newTail.Timestamp() > tail.Timestamp())
+	assert.Equal(t, ErrTailAheadOfClock, dpos.mintBlock(newTail.Timestamp()))
+}
+
+func TestDpos_SimulateReorgNotRunning(t *testing.T) {
+	dpos, err := NewDpos(mockNeb())
+	assert.Nil(t, err)
+
+	// the block loop isn't started, the request waits until the ctx is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = dpos.SimulateReorg(ctx, 1)
+	assert.Equal(t, context.Canceled, err)
+
+	dpos.Stop()
+	_, err = dpos.SimulateReorg(context.Background(), 1)
+	assert.Equal(t, ErrConsensusStopped, err)
+}
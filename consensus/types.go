@@ -18,7 +18,11 @@
 
 package consensus
 
-import "github.com/nebulasio/go-nebulas/core"
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/core"
+)
 
 // EventType list
 const (
@@ -41,6 +45,9 @@ type Consensus interface {
 
 	VerifyBlock(block *core.Block, parent *core.Block) error
 	FastVerifyBlock(block *core.Block) error
+
+	// SimulateReorg builds and adopts a fork reverting depth canonical blocks.
+	SimulateReorg(ctx context.Context, depth uint64) (*core.Block, error)
 }
 
 // EventType of Events in Consensus State-Machine
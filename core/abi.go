@@ -0,0 +1,249 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxABIFunctions the max number of functions in the abi of a contract.
+const MaxABIFunctions = 256
+
+var abiListKey = []byte("abi")
+
+func contractABIKey(addr string) []byte {
+	return []byte("abi.contract." + addr)
+}
+
+// ABIFunction is a function of a contract and the names of its arguments.
+type ABIFunction struct {
+	Name string   `json:"name"`
+	Args []string `json:"args"`
+}
+
+// ContractABI is the interface of a contract registered by the operator.
+type ContractABI struct {
+	Functions []*ABIFunction `json:"functions"`
+}
+
+func (abi *ContractABI) verify() error {
+	if len(abi.Functions) == 0 || len(abi.Functions) > MaxABIFunctions {
+		return ErrInvalidContractABI
+	}
+	names := make(map[string]bool)
+	for _, f := range abi.Functions {
+		if f == nil || len(f.Name) == 0 || names[f.Name] {
+			return ErrInvalidContractABI
+		}
+		names[f.Name] = true
+	}
+	return nil
+}
+
+func (abi *ContractABI) function(name string) *ABIFunction {
+	for _, f := range abi.Functions {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// DecodedArg is an argument of a decoded call, the value is kept in json.
+type DecodedArg struct {
+	Name  string
+	Value string
+}
+
+// DecodedCall is the function call carried by a transaction to a contract
+// with a registered abi.
+type DecodedCall struct {
+	Function string
+	Args     []*DecodedArg
+}
+
+// String return the call in the form of "transfer(\"n1...\", 10)".
+func (call *DecodedCall) String() string {
+	values := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		values[i] = arg.Value
+	}
+	return call.Function + "(" + strings.Join(values, ", ") + ")"
+}
+
+// ABIRegistry keeps the abis of the contracts registered by the operator,
+// used to decode the call payloads of their transactions.
+type ABIRegistry struct {
+	bc *BlockChain
+
+	mu   sync.RWMutex
+	abis map[string]*ContractABI
+}
+
+// NewABIRegistry create a new ABIRegistry with the abis kept in storage.
+func NewABIRegistry(bc *BlockChain) *ABIRegistry {
+	r := &ABIRegistry{
+		bc:   bc,
+		abis: make(map[string]*ContractABI),
+	}
+	if err := r.load(); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load contract abis.")
+	}
+	return r
+}
+
+// Register set the abi of the contract, replacing the previous one.
+func (r *ABIRegistry) Register(addr *Address, abi *ContractABI) error {
+	if err := abi.verify(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(abi)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := addr.String()
+	if err := r.bc.storage.Put(contractABIKey(key), data); err != nil {
+		return err
+	}
+	if _, ok := r.abis[key]; !ok {
+		r.abis[key] = abi
+		if err := r.saveList(); err != nil {
+			delete(r.abis, key)
+			return err
+		}
+	}
+	r.abis[key] = abi
+	return nil
+}
+
+// Unregister remove the abi of the contract, return false if not found.
+func (r *ABIRegistry) Unregister(addr *Address) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := addr.String()
+	abi, ok := r.abis[key]
+	if !ok {
+		return false, nil
+	}
+	delete(r.abis, key)
+	if err := r.saveList(); err != nil {
+		r.abis[key] = abi
+		return false, err
+	}
+	if err := r.bc.storage.Del(contractABIKey(key)); err != nil && err != storage.ErrKeyNotFound {
+		return true, err
+	}
+	return true, nil
+}
+
+// ABI return the abi registered for the contract, nil if not found.
+func (r *ABIRegistry) ABI(addr *Address) *ContractABI {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.abis[addr.String()]
+}
+
+// DecodeCall return the call carried by the tx, nil if the tx isn't a call
+// to a contract with a registered abi or the function isn't in the abi.
+func (r *ABIRegistry) DecodeCall(tx *Transaction) *DecodedCall {
+	if tx.Type() != TxPayloadCallType {
+		return nil
+	}
+	abi := r.ABI(tx.to)
+	if abi == nil {
+		return nil
+	}
+	payload, err := LoadCallPayload(tx.Data())
+	if err != nil {
+		return nil
+	}
+	f := abi.function(payload.Function)
+	if f == nil {
+		return nil
+	}
+	values := []json.RawMessage{}
+	if len(payload.Args) > 0 {
+		if err := json.Unmarshal([]byte(payload.Args), &values); err != nil {
+			return nil
+		}
+	}
+	call := &DecodedCall{Function: f.Name, Args: make([]*DecodedArg, len(values))}
+	for i, v := range values {
+		arg := &DecodedArg{Value: string(v)}
+		// the args beyond the abi are kept without a name.
+		if i < len(f.Args) {
+			arg.Name = f.Args[i]
+		}
+		call.Args[i] = arg
+	}
+	return call
+}
+
+func (r *ABIRegistry) saveList() error {
+	keys := make([]string, 0, len(r.abis))
+	for key := range r.abis {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	return r.bc.storage.Put(abiListKey, data)
+}
+
+func (r *ABIRegistry) load() error {
+	data, err := r.bc.storage.Get(abiListKey)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	keys := []string{}
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		data, err := r.bc.storage.Get(contractABIKey(key))
+		if err != nil {
+			return err
+		}
+		abi := &ContractABI{}
+		if err := json.Unmarshal(data, abi); err != nil {
+			return err
+		}
+		r.abis[key] = abi
+	}
+	return nil
+}
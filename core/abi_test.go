@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestABIRegistry(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	r := bc.ABIRegistry()
+
+	tx := mockCallTransaction(bc.chainID, 1, "transfer", `["n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE", 10]`)
+	assert.Nil(t, r.DecodeCall(tx))
+
+	assert.Equal(t, ErrInvalidContractABI, r.Register(tx.to, &ContractABI{}))
+	dup := &ContractABI{Functions: []*ABIFunction{{Name: "f"}, {Name: "f"}}}
+	assert.Equal(t, ErrInvalidContractABI, r.Register(tx.to, dup))
+
+	abi := &ContractABI{Functions: []*ABIFunction{
+		{Name: "transfer", Args: []string{"to"}},
+		{Name: "balanceOf", Args: []string{"owner"}},
+	}}
+	assert.Nil(t, r.Register(tx.to, abi))
+
+	call := r.DecodeCall(tx)
+	assert.NotNil(t, call)
+	assert.Equal(t, "transfer", call.Function)
+	assert.Equal(t, 2, len(call.Args))
+	assert.Equal(t, "to", call.Args[0].Name)
+	assert.Equal(t, `"n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"`, call.Args[0].Value)
+	assert.Equal(t, "", call.Args[1].Name)
+	assert.Equal(t, `transfer("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE", 10)`, call.String())
+
+	// the functions not in the abi aren't decoded.
+	payload, _ := NewCallPayload("approve", "[]").ToBytes()
+	approve := NewTransaction(bc.chainID, tx.from, tx.to, util.NewUint128(), 2, TxPayloadCallType, payload, TransactionGasPrice, TransactionMaxGas)
+	assert.Nil(t, r.DecodeCall(approve))
+
+	// the abis are kept in storage.
+	loaded := NewABIRegistry(bc)
+	assert.Equal(t, abi, loaded.ABI(tx.to))
+
+	ok, err := r.Unregister(tx.to)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Nil(t, r.DecodeCall(tx))
+	ok, err = r.Unregister(tx.to)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, NewABIRegistry(bc).ABI(tx.to))
+}
@@ -19,9 +19,9 @@
 package core
 
 import (
-	"strings"
-
+	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
@@ -102,6 +102,23 @@ func NewAddressFromPublicKey(s []byte) (*Address, error) {
 	return NewAddress(hash[len(hash)-AddressDataLength:])
 }
 
+// RecoverSignerAddress return the address of the signer of data from the signature.
+func RecoverSignerAddress(alg keystore.Algorithm, data, sign []byte) (*Address, error) {
+	signature, err := crypto.NewSignature(alg)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := signature.RecoverPublic(data, sign)
+	if err != nil {
+		return nil, err
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return nil, err
+	}
+	return NewAddressFromPublicKey(pubdata)
+}
+
 // NewContractAddressFromHash return new contract address from bytes.
 func NewContractAddressFromHash(s []byte) (*Address, error) {
 	// TODO: contract address should not be the same with normal account address.
@@ -113,10 +130,7 @@ func NewContractAddressFromHash(s []byte) (*Address, error) {
 
 // AddressParse parse address string.
 func AddressParse(s string) (*Address, error) {
-	if strings.HasPrefix(s, "0x") {
-		s = s[2:]
-	}
-	r, err := byteutils.FromHex(s)
+	r, err := byteutils.ParseHex(s)
 	if err != nil {
 		return nil, ErrInvalidAddress
 	}
@@ -95,6 +95,30 @@ func TestParse(t *testing.T) {
 			nil,
 			true,
 		},
+		{
+			"prefixed",
+			args{"0xdf4d22611412132d3e9bd322f82e2940674ec1bc03b20e40"},
+			&Address{[]byte{223, 77, 34, 97, 20, 18, 19, 45, 62, 155, 211, 34, 248, 46, 41, 64, 103, 78, 193, 188, 3, 178, 14, 64}},
+			false,
+		},
+		{
+			"empty",
+			args{""},
+			nil,
+			true,
+		},
+		{
+			"prefix only",
+			args{"0x"},
+			nil,
+			true,
+		},
+		{
+			"odd digits",
+			args{"f4d22611412132d3e9bd322f82e2940674ec1bc03b20e40"},
+			nil,
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
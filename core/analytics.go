@@ -0,0 +1,346 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// AnalyticsDateLayout is the layout of the UTC dates of the daily aggregates.
+const AnalyticsDateLayout = "2006-01-02"
+
+// MaxAnalyticsDays the max number of days queried at once.
+const MaxAnalyticsDays = 366
+
+func analyticsDayKey(date string) []byte {
+	return []byte("analytics.day." + date)
+}
+
+// analyticsAddressKey keeps the count of the txs of an address in a day, the
+// address is active while it's not 0.
+func analyticsAddressKey(date string, addr string) []byte {
+	return []byte("analytics.addr." + date + "." + addr)
+}
+
+// DailyStats is the aggregate of the canonical blocks of a UTC day.
+type DailyStats struct {
+	Date            string `json:"date"`
+	Blocks          uint64 `json:"blocks"`
+	TxCount         uint64 `json:"tx_count"`
+	ActiveAddresses uint64 `json:"active_addresses"`
+	GasUsed         string `json:"gas_used"`
+	Fees            string `json:"fees"`
+}
+
+// Analytics maintains the daily aggregates of the blocks joining the
+// canonical chain while it runs, the ones of the reverted blocks are rolled
+// back.
+type Analytics struct {
+	bc *BlockChain
+
+	// mu makes the queries wait for a block being aggregated.
+	mu sync.RWMutex
+
+	eventCh chan *Event
+	quitCh  chan int
+}
+
+// EnableAnalytics creates the analytics of the chain.
+func (bc *BlockChain) EnableAnalytics() *Analytics {
+	bc.analytics = &Analytics{
+		bc:      bc,
+		eventCh: make(chan *Event, 1024),
+		quitCh:  make(chan int, 1),
+	}
+	return bc.analytics
+}
+
+// Analytics returns the analytics, nil if they aren't enabled.
+func (bc *BlockChain) Analytics() *Analytics {
+	return bc.analytics
+}
+
+// Start start analytics.
+func (an *Analytics) Start() {
+	logging.CLog().Info("Start Analytics.")
+
+	an.bc.eventEmitter.Register(TopicNewTailBlock, an.eventCh)
+	an.bc.eventEmitter.Register(TopicRevertBlock, an.eventCh)
+	go an.loop()
+}
+
+// Stop stop analytics.
+func (an *Analytics) Stop() {
+	logging.CLog().Info("Stop Analytics.")
+
+	an.bc.eventEmitter.Deregister(TopicNewTailBlock, an.eventCh)
+	an.bc.eventEmitter.Deregister(TopicRevertBlock, an.eventCh)
+	an.quitCh <- 0
+}
+
+func (an *Analytics) loop() {
+	logging.CLog().Info("Launched Analytics.")
+
+	for {
+		select {
+		case <-an.quitCh:
+			logging.CLog().Info("Shutdowned Analytics.")
+			return
+		case e := <-an.eventCh:
+			if err := an.handleBlock(e); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"event": e,
+					"err":   err,
+				}).Error("Failed to aggregate block analytics.")
+			}
+		}
+	}
+}
+
+func (an *Analytics) handleBlock(e *Event) error {
+	hash, err := byteutils.FromHex(e.Data)
+	if err != nil {
+		return err
+	}
+	block := an.bc.GetBlock(hash)
+	if block == nil {
+		return ErrMissingParentBlock
+	}
+	receipts, err := an.bc.getBlockReceipts(hash)
+	if err != nil && err != ErrReceiptNotFound {
+		return err
+	}
+	return an.aggregate(block, receipts, e.Topic == TopicRevertBlock)
+}
+
+// aggregate adds the block to the stats of its day, or subtracts it if it's
+// reverted. The blocks without receipts count no gas. The day is rebuilt from
+// the chain if subtracting the block would underflow its stats.
+func (an *Analytics) aggregate(block *Block, receipts []*TransactionReceipt, reverted bool) error {
+	an.mu.Lock()
+	defer an.mu.Unlock()
+
+	date := analyticsDate(block)
+	stats, err := an.get(date)
+	if err != nil {
+		return err
+	}
+	gasUsed := util.NewUint128FromString(stats.GasUsed)
+	fees := util.NewUint128FromString(stats.Fees)
+	if reverted && (stats.Blocks == 0 || stats.TxCount < uint64(len(block.transactions))) {
+		return an.rebuild(date, block)
+	}
+
+	batch := storage.NewBatch()
+	addrs := blockAddresses(block)
+	for i, tx := range block.transactions {
+		if i >= len(receipts) || receipts[i] == nil {
+			continue
+		}
+		gas := util.NewUint128FromString(receipts[i].GasUsed)
+		fee := new(big.Int).Mul(gas.Int, tx.gasPrice.Int)
+		if reverted {
+			if gasUsed.Cmp(gas.Int) < 0 || fees.Cmp(fee) < 0 {
+				return an.rebuild(date, block)
+			}
+			gasUsed.Sub(gasUsed.Int, gas.Int)
+			fees.Sub(fees.Int, fee)
+		} else {
+			gasUsed.Add(gasUsed.Int, gas.Int)
+			fees.Add(fees.Int, fee)
+		}
+	}
+	for addr, n := range addrs {
+		key := analyticsAddressKey(date, addr)
+		count, err := an.getCount(key)
+		if err != nil {
+			return err
+		}
+		if reverted {
+			if count < n || stats.ActiveAddresses == 0 {
+				return an.rebuild(date, block)
+			}
+			if count == n {
+				batch.Del(key)
+				stats.ActiveAddresses--
+				continue
+			}
+			count -= n
+		} else {
+			if count == 0 {
+				stats.ActiveAddresses++
+			}
+			count += n
+		}
+		batch.Put(key, byteutils.FromUint64(count))
+	}
+
+	if reverted {
+		stats.Blocks--
+		stats.TxCount -= uint64(len(block.transactions))
+	} else {
+		stats.Blocks++
+		stats.TxCount += uint64(len(block.transactions))
+	}
+	stats.GasUsed = gasUsed.String()
+	stats.Fees = fees.String()
+	return an.put(batch, stats)
+}
+
+// rebuild recomputes the stats of the day of the reverted block from its
+// ancestors, the blocks of the day still aggregated, e.g. when the stats miss
+// the block after a crash. The counts of the addresses of the reverted block
+// are reset, the ones of other addresses no longer active are left.
+func (an *Analytics) rebuild(date string, reverted *Block) error {
+	logging.VLog().WithFields(logrus.Fields{
+		"date":  date,
+		"block": reverted,
+	}).Warn("Rebuild inconsistent daily analytics from the chain.")
+
+	stats := &DailyStats{Date: date}
+	gasUsed, fees := util.NewUint128(), util.NewUint128()
+	counts := make(map[string]uint64)
+	for addr := range blockAddresses(reverted) {
+		counts[addr] = 0
+	}
+	for block := an.bc.GetBlock(reverted.ParentHash()); block != nil && !CheckGenesisBlock(block); block = an.bc.GetBlock(block.ParentHash()) {
+		if analyticsDate(block) != date {
+			break
+		}
+		receipts, err := an.bc.getBlockReceipts(block.Hash())
+		if err != nil && err != ErrReceiptNotFound {
+			return err
+		}
+		stats.Blocks++
+		stats.TxCount += uint64(len(block.transactions))
+		for i, tx := range block.transactions {
+			if i >= len(receipts) || receipts[i] == nil {
+				continue
+			}
+			gas := util.NewUint128FromString(receipts[i].GasUsed)
+			gasUsed.Add(gasUsed.Int, gas.Int)
+			fees.Add(fees.Int, new(big.Int).Mul(gas.Int, tx.gasPrice.Int))
+		}
+		for addr, n := range blockAddresses(block) {
+			counts[addr] += n
+		}
+	}
+
+	batch := storage.NewBatch()
+	for addr, count := range counts {
+		key := analyticsAddressKey(date, addr)
+		if count == 0 {
+			batch.Del(key)
+			continue
+		}
+		stats.ActiveAddresses++
+		batch.Put(key, byteutils.FromUint64(count))
+	}
+	stats.GasUsed = gasUsed.String()
+	stats.Fees = fees.String()
+	return an.put(batch, stats)
+}
+
+func (an *Analytics) put(batch *storage.Batch, stats *DailyStats) error {
+	value, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	batch.Put(analyticsDayKey(stats.Date), value)
+	return an.bc.storage.WriteBatch(batch)
+}
+
+func analyticsDate(block *Block) string {
+	return time.Unix(block.Timestamp(), 0).UTC().Format(AnalyticsDateLayout)
+}
+
+// blockAddresses returns the count of the txs of each address of the block.
+func blockAddresses(block *Block) map[string]uint64 {
+	addrs := make(map[string]uint64)
+	for _, tx := range block.transactions {
+		addrs[tx.from.String()]++
+		if !tx.to.Equals(tx.from) {
+			addrs[tx.to.String()]++
+		}
+	}
+	return addrs
+}
+
+func (an *Analytics) get(date string) (*DailyStats, error) {
+	value, err := an.bc.storage.Get(analyticsDayKey(date))
+	if err == storage.ErrKeyNotFound {
+		return &DailyStats{Date: date, GasUsed: "0", Fees: "0"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	stats := new(DailyStats)
+	if err := json.Unmarshal(value, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (an *Analytics) getCount(key []byte) (uint64, error) {
+	value, err := an.bc.storage.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// Daily returns the stats of the UTC days in [from, to], in the
+// AnalyticsDateLayout, the days without blocks are skipped.
+func (an *Analytics) Daily(from, to string) ([]*DailyStats, error) {
+	start, err := time.Parse(AnalyticsDateLayout, from)
+	if err != nil {
+		return nil, ErrInvalidAnalyticsRange
+	}
+	end, err := time.Parse(AnalyticsDateLayout, to)
+	if err != nil || end.Before(start) || end.Sub(start) >= MaxAnalyticsDays*24*time.Hour {
+		return nil, ErrInvalidAnalyticsRange
+	}
+
+	an.mu.RLock()
+	defer an.mu.RUnlock()
+
+	days := []*DailyStats{}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		stats, err := an.get(day.Format(AnalyticsDateLayout))
+		if err != nil {
+			return nil, err
+		}
+		if stats.Blocks > 0 {
+			days = append(days, stats)
+		}
+	}
+	return days, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalytics(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	assert.Nil(t, bc.Analytics())
+	an := bc.EnableAnalytics()
+	assert.Equal(t, an, bc.Analytics())
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000000))
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+	bc.storeBlockToStorage(bc.tailBlock)
+
+	to := mockAddress()
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), nonce, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, bc.txPool.Push(tx))
+	}
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(2)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Equal(t, 2, len(block.transactions))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+	assert.Nil(t, bc.SetTailBlock(block))
+
+	assert.Nil(t, an.handleBlock(&Event{Topic: TopicNewTailBlock, Data: block.Hash().String()}))
+	days, err := an.Daily("1970-01-01", "1970-01-02")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(days))
+	gas := util.NewUint128().Mul(block.transactions[0].GasCountOfTxBase().Int, util.NewUint128FromInt(2).Int)
+	fees := util.NewUint128().Mul(gas, TransactionGasPrice.Int)
+	assert.Equal(t, &DailyStats{
+		Date:            "1970-01-01",
+		Blocks:          1,
+		TxCount:         2,
+		ActiveAddresses: 2,
+		GasUsed:         gas.String(),
+		Fees:            fees.String(),
+	}, days[0])
+
+	// the reverted block is rolled back, the day without blocks is skipped.
+	assert.Nil(t, an.handleBlock(&Event{Topic: TopicRevertBlock, Data: block.Hash().String()}))
+	days, err = an.Daily("1970-01-01", "1970-01-01")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(days))
+	stats, err := an.get("1970-01-01")
+	assert.Nil(t, err)
+	assert.Equal(t, &DailyStats{Date: "1970-01-01", GasUsed: "0", Fees: "0"}, stats)
+
+	// the block reverted twice would underflow the stats, they are rebuilt
+	// from its ancestors instead.
+	assert.Nil(t, an.handleBlock(&Event{Topic: TopicRevertBlock, Data: block.Hash().String()}))
+	stats, err = an.get("1970-01-01")
+	assert.Nil(t, err)
+	assert.Equal(t, &DailyStats{Date: "1970-01-01", GasUsed: "0", Fees: "0"}, stats)
+	count, err := an.getCount(analyticsAddressKey("1970-01-01", to.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), count)
+
+	_, err = an.Daily("1970-01-02", "1970-01-01")
+	assert.Equal(t, ErrInvalidAnalyticsRange, err)
+	_, err = an.Daily("1970-01-01", "1971-01-02")
+	assert.Equal(t, ErrInvalidAnalyticsRange, err)
+	_, err = an.Daily("19700101", "1970-01-02")
+	assert.Equal(t, ErrInvalidAnalyticsRange, err)
+}
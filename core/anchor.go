@@ -0,0 +1,279 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// AnchorContract is the system contract recording the state roots of the child
+// chains anchored into the chain. The root of its storage is the anchors root
+// of the block header, so the anchors are provable with the header only.
+var AnchorContract, _ = NewContractAddressFromHash(hash.Sha3256([]byte("nebulas.anchor")))
+
+// Keys in the storage of the anchor contract.
+var (
+	anchorKeyPrefix         = []byte("anchor")
+	anchorOperatorKeyPrefix = []byte("operator")
+	anchorLatestKeyPrefix   = []byte("latest")
+)
+
+// AnchorPayload carry the state root of a child chain at a height, anchored
+// by the operator of the child chain only, at increasing heights. The operator
+// is registered by a member of the dynasty with the payload carrying Operator
+// instead of the height and the state root.
+type AnchorPayload struct {
+	ChainID   uint32
+	Height    uint64
+	StateRoot byteutils.Hash
+	Operator  string `json:",omitempty"`
+}
+
+// LoadAnchorPayload from bytes
+func LoadAnchorPayload(bytes []byte) (*AnchorPayload, error) {
+	payload := &AnchorPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewAnchorPayload with comments
+func NewAnchorPayload(chainID uint32, height uint64, stateRoot byteutils.Hash) *AnchorPayload {
+	return &AnchorPayload{
+		ChainID:   chainID,
+		Height:    height,
+		StateRoot: stateRoot,
+	}
+}
+
+// NewAnchorOperatorPayload registers the operator of the child chain
+func NewAnchorOperatorPayload(chainID uint32, operator string) *AnchorPayload {
+	return &AnchorPayload{
+		ChainID:  chainID,
+		Operator: operator,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *AnchorPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *AnchorPayload) BaseGasCount() *util.Uint128 {
+	return AnchorBaseGasCount
+}
+
+// Execute the anchor payload in tx
+func (payload *AnchorPayload) Execute(ctx *PayloadContext) (*util.Uint128, error) {
+	if payload.ChainID == ctx.block.ChainID() {
+		return ZeroGasCount, ErrInvalidAnchorPayload
+	}
+	if len(payload.Operator) > 0 {
+		return payload.registerOperator(ctx)
+	}
+	if payload.Height == 0 || len(payload.StateRoot) != BlockHashLength {
+		return ZeroGasCount, ErrInvalidAnchorPayload
+	}
+
+	contract := ctx.accState.GetOrCreateUserAccount(AnchorContract.Bytes())
+	operator, err := contract.Get(anchorOperatorKey(payload.ChainID))
+	if err == storage.ErrKeyNotFound {
+		return ZeroGasCount, ErrAnchorOperatorNotRegistered
+	}
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	if !byteutils.Equal(operator, ctx.tx.from.Bytes()) {
+		return ZeroGasCount, ErrNotAnchorOperator
+	}
+
+	latest, err := contract.Get(anchorLatestKey(payload.ChainID))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return ZeroGasCount, err
+	}
+	if err == nil && byteutils.Uint64(latest) >= payload.Height {
+		return ZeroGasCount, ErrStaleAnchor
+	}
+	if err := contract.Put(anchorLatestKey(payload.ChainID), byteutils.FromUint64(payload.Height)); err != nil {
+		return ZeroGasCount, err
+	}
+	if err := contract.Put(anchorKey(payload.ChainID, payload.Height), payload.StateRoot); err != nil {
+		return ZeroGasCount, err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":     ctx.block,
+		"tx":        ctx.tx,
+		"chainID":   payload.ChainID,
+		"height":    payload.Height,
+		"stateRoot": payload.StateRoot.String(),
+	}).Info("Child chain anchored.")
+	return ZeroGasCount, nil
+}
+
+// registerOperator registers the operator of the child chain, replacing the
+// former one if any.
+func (payload *AnchorPayload) registerOperator(ctx *PayloadContext) (*util.Uint128, error) {
+	operator, err := AddressParse(payload.Operator)
+	if err != nil || payload.Height != 0 || len(payload.StateRoot) != 0 {
+		return ZeroGasCount, ErrInvalidAnchorPayload
+	}
+	member, err := isDynastyMember(ctx, ctx.tx.from.Bytes())
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	if !member {
+		return ZeroGasCount, ErrNotAnchorRegistrar
+	}
+
+	contract := ctx.accState.GetOrCreateUserAccount(AnchorContract.Bytes())
+	if err := contract.Put(anchorOperatorKey(payload.ChainID), operator.Bytes()); err != nil {
+		return ZeroGasCount, err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":    ctx.block,
+		"tx":       ctx.tx,
+		"chainID":  payload.ChainID,
+		"operator": payload.Operator,
+	}).Info("Anchor operator registered.")
+	return ZeroGasCount, nil
+}
+
+// the keys are hashed, since all the keys of a trie have the same length.
+func anchorKey(chainID uint32, height uint64) []byte {
+	return hash.Sha3256(anchorKeyPrefix, byteutils.FromUint32(chainID), byteutils.FromUint64(height))
+}
+
+func anchorOperatorKey(chainID uint32) []byte {
+	return hash.Sha3256(anchorOperatorKeyPrefix, byteutils.FromUint32(chainID))
+}
+
+func anchorLatestKey(chainID uint32) []byte {
+	return hash.Sha3256(anchorLatestKeyPrefix, byteutils.FromUint32(chainID))
+}
+
+// anchorsTrieRoot return the root of the storage of the anchor contract, nil
+// before the first anchor and before the anchor fork.
+func (block *Block) anchorsTrieRoot() byteutils.Hash {
+	if !ForksOf(block.ChainID()).IsTxPayloadTypeActive(TxPayloadAnchorType, block.height) {
+		return nil
+	}
+	contract, err := block.accState.GetContractAccount(AnchorContract.Bytes())
+	if err != nil {
+		return nil
+	}
+	return contract.VarsHash()
+}
+
+// LatestAnchorHeight return the latest height of the child chain anchored at the block.
+func (block *Block) LatestAnchorHeight(chainID uint32) (uint64, error) {
+	contract, err := block.accState.GetContractAccount(AnchorContract.Bytes())
+	if err != nil {
+		return 0, ErrAnchorNotFound
+	}
+	latest, err := contract.Get(anchorLatestKey(chainID))
+	if err == storage.ErrKeyNotFound {
+		return 0, ErrAnchorNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(latest), nil
+}
+
+// GetAnchor return the state root of the child chain anchored at the height,
+// with its proof against the anchors root of the block if required.
+func (block *Block) GetAnchor(chainID uint32, height uint64, withProof bool) (byteutils.Hash, trie.MerkleProof, error) {
+	contract, err := block.accState.GetContractAccount(AnchorContract.Bytes())
+	if err != nil {
+		return nil, nil, ErrAnchorNotFound
+	}
+	key := anchorKey(chainID, height)
+	stateRoot, err := contract.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil, ErrAnchorNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !withProof {
+		return stateRoot, nil, nil
+	}
+	proof, err := contract.Prove(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stateRoot, proof, nil
+}
+
+// VerifyAnchor verify the state root of the child chain anchored at the height against the anchors root.
+func VerifyAnchor(anchorsRoot byteutils.Hash, chainID uint32, height uint64, stateRoot byteutils.Hash, proof trie.MerkleProof) error {
+	if err := trie.VerifyValue(anchorsRoot, anchorKey(chainID, height), stateRoot, proof); err != nil {
+		return ErrInvalidAnchorProof
+	}
+	return nil
+}
+
+// Exit proves an account of a child chain in its state anchored into the chain,
+// the base for withdrawing the balance of the account from the child chain.
+type Exit struct {
+	ChainID     uint32
+	Height      uint64
+	StateRoot   byteutils.Hash
+	AnchorProof trie.MerkleProof
+
+	// Account is the bytes of the account of the child chain, proved by
+	// AccountProof against the anchored state root.
+	Address      []byte
+	Account      []byte
+	AccountProof trie.MerkleProof
+}
+
+// VerifyExit verify the exit against the anchors root, return the account of the child chain.
+func VerifyExit(anchorsRoot byteutils.Hash, exit *Exit) (*corepb.Account, error) {
+	if err := VerifyAnchor(anchorsRoot, exit.ChainID, exit.Height, exit.StateRoot, exit.AnchorProof); err != nil {
+		return nil, err
+	}
+	return VerifyExitAccount(exit.StateRoot, exit.Address, exit.Account, exit.AccountProof)
+}
+
+// VerifyExitAccount verify the account of a child chain against its anchored state root.
+func VerifyExitAccount(stateRoot byteutils.Hash, addr []byte, account []byte, proof trie.MerkleProof) (*corepb.Account, error) {
+	if err := trie.VerifyValue(stateRoot, addr, account, proof); err != nil {
+		return nil, ErrInvalidAnchorProof
+	}
+	pbAcc := new(corepb.Account)
+	if err := proto.Unmarshal(account, pbAcc); err != nil {
+		return nil, ErrInvalidAnchorProof
+	}
+	return pbAcc, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func executeAnchor(block *Block, from *Address, payload *AnchorPayload) error {
+	data, _ := payload.ToBytes()
+	tx := NewTransaction(block.ChainID(), from, AnchorContract, util.NewUint128(), 1, TxPayloadAnchorType, data, TransactionGasPrice, util.NewUint128FromInt(200000))
+	ctx := NewPayloadContext(block, tx)
+	if err := ctx.BeginBatch(); err != nil {
+		return err
+	}
+	if _, err := payload.Execute(ctx); err != nil {
+		return err
+	}
+	ctx.Commit()
+	return nil
+}
+
+func TestAnchor(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	genesis := bc.genesisBlock
+	assert.Nil(t, genesis.AnchorsRoot())
+
+	operator := mockAddress()
+	other := mockAddress()
+	childChainID := bc.ChainID() + 1000
+	accounts, err := genesis.accState.Accounts()
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(accounts))
+	childRoot := genesis.StateRoot()
+
+	block, _ := bc.NewBlock(operator)
+	block.header.timestamp = BlockInterval
+	block.SetMiner(operator)
+	block.begin()
+
+	members, err := TraverseDynasty(block.dposContext.dynastyTrie)
+	assert.Nil(t, err)
+	registrar, err := AddressParseFromBytes(members[0])
+	assert.Nil(t, err)
+
+	assert.Equal(t, ErrInvalidAnchorPayload, executeAnchor(block, operator, NewAnchorPayload(bc.ChainID(), 1, childRoot)))
+	assert.Equal(t, ErrInvalidAnchorPayload, executeAnchor(block, operator, NewAnchorPayload(childChainID, 1, []byte("root"))))
+	assert.Equal(t, ErrAnchorOperatorNotRegistered, executeAnchor(block, operator, NewAnchorPayload(childChainID, 10, childRoot)))
+	assert.Equal(t, ErrNotAnchorRegistrar, executeAnchor(block, operator, NewAnchorOperatorPayload(childChainID, operator.String())))
+	assert.Equal(t, ErrInvalidAnchorPayload, executeAnchor(block, registrar, NewAnchorOperatorPayload(childChainID, "invalid")))
+	assert.Nil(t, executeAnchor(block, registrar, NewAnchorOperatorPayload(childChainID, operator.String())))
+	assert.Nil(t, executeAnchor(block, registrar, NewAnchorOperatorPayload(childChainID+1, other.String())))
+
+	assert.Nil(t, executeAnchor(block, operator, NewAnchorPayload(childChainID, 10, childRoot)))
+	assert.Equal(t, ErrNotAnchorOperator, executeAnchor(block, other, NewAnchorPayload(childChainID, 20, childRoot)))
+	assert.Equal(t, ErrStaleAnchor, executeAnchor(block, operator, NewAnchorPayload(childChainID, 10, childRoot)))
+	assert.Nil(t, executeAnchor(block, other, NewAnchorPayload(childChainID+1, 1, childRoot)))
+	block.commit()
+
+	// the anchors root is in the header from the anchor fork only.
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{TxPayloadTypes: map[string]uint64{TxPayloadAnchorType: block.height}}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+	assert.Nil(t, block.Seal())
+	assert.NotNil(t, block.AnchorsRoot())
+	assert.Nil(t, block.verifyState())
+
+	conf.Forks.TxPayloadTypes[TxPayloadAnchorType] = block.height + 1
+	RegisterForks(conf)
+	assert.Equal(t, ErrInvalidBlockAnchorsRoot, block.verifyState())
+	conf.Forks.TxPayloadTypes[TxPayloadAnchorType] = block.height
+	RegisterForks(conf)
+
+	latest, err := block.LatestAnchorHeight(childChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), latest)
+	_, err = block.LatestAnchorHeight(childChainID + 2)
+	assert.Equal(t, ErrAnchorNotFound, err)
+	_, _, err = block.GetAnchor(childChainID, 9, false)
+	assert.Equal(t, ErrAnchorNotFound, err)
+
+	stateRoot, proof, err := block.GetAnchor(childChainID, 10, true)
+	assert.Nil(t, err)
+	assert.Equal(t, childRoot, stateRoot)
+	assert.Nil(t, VerifyAnchor(block.AnchorsRoot(), childChainID, 10, stateRoot, proof))
+	assert.Equal(t, ErrInvalidAnchorProof, VerifyAnchor(block.AnchorsRoot(), childChainID, 11, stateRoot, proof))
+
+	// exit an account of the child chain in its anchored state.
+	acc := accounts[0]
+	accBytes, _ := acc.ToBytes()
+	accProof, err := genesis.accState.Prove(acc.Address())
+	assert.Nil(t, err)
+	exit := &Exit{
+		ChainID:      childChainID,
+		Height:       10,
+		StateRoot:    stateRoot,
+		AnchorProof:  proof,
+		Address:      acc.Address(),
+		Account:      accBytes,
+		AccountProof: accProof,
+	}
+	pbAcc, err := VerifyExit(block.AnchorsRoot(), exit)
+	assert.Nil(t, err)
+	balance, _ := acc.Balance().ToFixedSizeByteSlice()
+	assert.Equal(t, balance, pbAcc.Balance)
+
+	exit.Account = append([]byte{}, accBytes...)
+	exit.Account[len(exit.Account)-1]++
+	_, err = VerifyExit(block.AnchorsRoot(), exit)
+	assert.Equal(t, ErrInvalidAnchorProof, err)
+}
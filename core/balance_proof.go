@@ -0,0 +1,248 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MaxBalanceProofRange is the max blocks of a balance proof.
+const MaxBalanceProofRange = 1024
+
+// AccountProof proves the account of an address against the state root of a
+// block, an account not in the state has no bytes and a proof of absence.
+type AccountProof struct {
+	Height    uint64
+	BlockHash byteutils.Hash
+	StateRoot byteutils.Hash
+	Account   []byte
+	Proof     trie.MerkleProof
+}
+
+// BalanceChange is a transaction sent or received by an address, proved in
+// the txs root of its block and absent from the txs root of the parent. The
+// txs trie of a block holds the transactions of its ancestors too, the proof
+// of absence ties the transaction to the block.
+type BalanceChange struct {
+	Height        uint64
+	BlockHash     byteutils.Hash
+	ParentTxsRoot byteutils.Hash
+	TxsRoot       byteutils.Hash
+	// Tx is the proto bytes of the transaction, the value of its hash in the txs trie.
+	Tx           []byte
+	Proof        trie.MerkleProof
+	AbsenceProof trie.MerkleProof
+}
+
+// BalanceProof is the transactions of an address in the canonical blocks
+// after From up to To, with its account at both ends.
+//
+// Only the transactions sent by the address are proved complete, their nonces
+// cover the nonces of the end accounts. No index of the addresses is
+// committed to by the blocks, so the received ones are only proved included:
+// the auditor reconciles the balance of the end accounts with them, a gap
+// is a transfer not listed, like the ones of the contracts or the rewards of
+// the blocks minted by the address.
+type BalanceProof struct {
+	Address *Address
+	Start   *AccountProof
+	End     *AccountProof
+	Changes []*BalanceChange
+}
+
+// GetBalanceProof returns the balance proof of the address in the canonical
+// blocks after from up to to.
+func (bc *BlockChain) GetBalanceProof(addr *Address, from, to uint64) (*BalanceProof, error) {
+	if from == 0 || to <= from || to-from > MaxBalanceProofRange || to > bc.TailBlock().height {
+		return nil, ErrInvalidBalanceProofRange
+	}
+	if err := bc.CheckRangeAvailable(from, to); err != nil {
+		return nil, err
+	}
+	proof := &BalanceProof{Address: addr}
+	var err error
+	if proof.Start, err = bc.getAccountProof(addr, from); err != nil {
+		return nil, err
+	}
+	if proof.End, err = bc.getAccountProof(addr, to); err != nil {
+		return nil, err
+	}
+
+	for height := from + 1; height <= to; height++ {
+		header, err := bc.GetHeaderByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		// the blocks not involving the address aren't loaded.
+		if _, bloom, err := bc.GetBlockBloom(header.Hash()); err == nil && !bloom.Test(addr.Bytes()) {
+			continue
+		}
+		block := bc.GetBlock(header.Hash())
+		if block == nil {
+			return nil, ErrCannotFindBlockAtGivenHeight
+		}
+		var parent *Block
+		for _, tx := range block.transactions {
+			if !tx.from.Equals(addr) && !tx.to.Equals(addr) {
+				continue
+			}
+			if parent == nil {
+				if parent = bc.GetBlock(block.ParentHash()); parent == nil {
+					return nil, ErrMissingParentBlock
+				}
+			}
+			change := &BalanceChange{
+				Height:        block.height,
+				BlockHash:     block.Hash(),
+				ParentTxsRoot: parent.TxsRoot(),
+				TxsRoot:       block.TxsRoot(),
+			}
+			if change.Tx, err = block.txsTrie.Get(tx.hash); err != nil {
+				return nil, err
+			}
+			if change.Proof, err = block.txsTrie.Prove(tx.hash); err != nil {
+				return nil, err
+			}
+			if change.AbsenceProof, err = parent.txsTrie.ProveAbsence(tx.hash); err != nil {
+				return nil, err
+			}
+			proof.Changes = append(proof.Changes, change)
+		}
+	}
+	return proof, nil
+}
+
+func (bc *BlockChain) getAccountProof(addr *Address, height uint64) (*AccountProof, error) {
+	block, err := bc.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	proof := &AccountProof{
+		Height:    block.height,
+		BlockHash: block.Hash(),
+		StateRoot: block.StateRoot(),
+	}
+	if proof.Proof, err = block.accState.Prove(addr.address); err == trie.ErrNotFound {
+		if proof.Proof, err = block.accState.ProveAbsence(addr.address); err != nil {
+			return nil, err
+		}
+		return proof, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if proof.Account, err = block.accState.GetOrCreateUserAccount(addr.address).ToBytes(); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// verify returns the nonce of the account, 0 for an account proved not in
+// the state.
+func (p *AccountProof) verify(addr *Address) (uint64, error) {
+	if len(p.Account) == 0 {
+		if err := trie.VerifyAbsence(p.StateRoot, addr.address, p.Proof); err != nil {
+			return 0, ErrInvalidBalanceProof
+		}
+		return 0, nil
+	}
+	if err := trie.VerifyValue(p.StateRoot, addr.address, p.Account, p.Proof); err != nil {
+		return 0, ErrInvalidBalanceProof
+	}
+	pbAcc := new(corepb.Account)
+	if err := proto.Unmarshal(p.Account, pbAcc); err != nil {
+		return 0, ErrInvalidBalanceProof
+	}
+	return pbAcc.Nonce, nil
+}
+
+// verify returns the transaction, ErrInvalidBalanceProof if it isn't proved
+// in the txs root of the block and absent from the one of the parent.
+func (c *BalanceChange) verify(chainID uint32) (*Transaction, error) {
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(c.Tx, pbTx); err != nil {
+		return nil, ErrInvalidBalanceProof
+	}
+	tx := new(Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		return nil, ErrInvalidBalanceProof
+	}
+	if err := tx.VerifyIntegrity(chainID); err != nil {
+		return nil, ErrInvalidBalanceProof
+	}
+	if err := trie.VerifyValue(c.TxsRoot, tx.hash, c.Tx, c.Proof); err != nil {
+		return nil, ErrInvalidBalanceProof
+	}
+	if err := trie.VerifyAbsence(c.ParentTxsRoot, tx.hash, c.AbsenceProof); err != nil {
+		return nil, ErrInvalidBalanceProof
+	}
+	return tx, nil
+}
+
+// VerifyBalanceProof verifies the accounts and the transactions of the proof
+// against the roots it carries, which the auditor checks against the headers
+// of the blocks and their parents, e.g. from a header proof. It returns the
+// transactions, and ErrInvalidBalanceProof if a proof is invalid or the
+// transactions sent by the address are not all listed.
+func VerifyBalanceProof(chainID uint32, proof *BalanceProof) ([]*Transaction, error) {
+	if proof.Start == nil || proof.End == nil || proof.Start.Height >= proof.End.Height {
+		return nil, ErrInvalidBalanceProof
+	}
+	startNonce, err := proof.Start.verify(proof.Address)
+	if err != nil {
+		return nil, err
+	}
+	endNonce, err := proof.End.verify(proof.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*Transaction, 0, len(proof.Changes))
+	listed := make(map[string]bool)
+	nonce := startNonce
+	for _, change := range proof.Changes {
+		if change.Height <= proof.Start.Height || change.Height > proof.End.Height {
+			return nil, ErrInvalidBalanceProof
+		}
+		tx, err := change.verify(chainID)
+		if err != nil {
+			return nil, err
+		}
+		if listed[tx.hash.String()] {
+			return nil, ErrInvalidBalanceProof
+		}
+		listed[tx.hash.String()] = true
+		if tx.from.Equals(proof.Address) {
+			// the sent transactions are listed in the order of their nonces.
+			if tx.nonce != nonce+1 {
+				return nil, ErrInvalidBalanceProof
+			}
+			nonce = tx.nonce
+		} else if !tx.to.Equals(proof.Address) {
+			return nil, ErrInvalidBalanceProof
+		}
+		txs = append(txs, tx)
+	}
+	if nonce != endNonce {
+		return nil, ErrInvalidBalanceProof
+	}
+	return txs, nil
+}
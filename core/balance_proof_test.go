@@ -0,0 +1,140 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceProof(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// the sender gets the reward of the first block.
+	block0, _ := bc.NewBlock(from)
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(from)
+	block0.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	to := mockAddress()
+	other := mockAddress()
+	coinbase := &Address{[]byte("012345678901234567890011")}
+	nonce := uint64(0)
+	mint := func(n int, receivers ...*Address) {
+		for _, receiver := range receivers {
+			nonce++
+			tx := NewTransaction(bc.ChainID(), from, receiver, util.NewUint128FromInt(10), nonce, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+			assert.Nil(t, tx.Sign(signature))
+			assert.Nil(t, bc.txPool.Push(tx))
+		}
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(n)
+		block.CollectTransactions(len(receivers))
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Equal(t, len(receivers), len(block.transactions))
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+	mint(2, to, to)
+	mint(3, other)
+	tail := bc.TailBlock().height
+
+	_, err := bc.GetBalanceProof(from, block0.height, block0.height)
+	assert.Equal(t, ErrInvalidBalanceProofRange, err)
+	_, err = bc.GetBalanceProof(from, block0.height, tail+1)
+	assert.Equal(t, ErrInvalidBalanceProofRange, err)
+
+	// the sent transactions are complete.
+	proof, err := bc.GetBalanceProof(from, block0.height, tail)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(proof.Changes))
+	txs, err := VerifyBalanceProof(bc.ChainID(), proof)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(txs))
+	assert.Equal(t, other, txs[2].to)
+
+	changes := proof.Changes
+	proof.Changes = changes[:2]
+	_, err = VerifyBalanceProof(bc.ChainID(), proof)
+	assert.Equal(t, ErrInvalidBalanceProof, err)
+	proof.Changes = append(changes[:2:2], changes[0])
+	_, err = VerifyBalanceProof(bc.ChainID(), proof)
+	assert.Equal(t, ErrInvalidBalanceProof, err)
+	proof.Changes = changes
+
+	// the account of the receiver is proved not in the state before the
+	// range, the received transactions are proved included.
+	proof, err = bc.GetBalanceProof(to, 1, tail)
+	assert.Nil(t, err)
+	assert.Nil(t, proof.Start.Account)
+	assert.NotEmpty(t, proof.Start.Proof)
+	txs, err = VerifyBalanceProof(bc.ChainID(), proof)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(txs))
+
+	// the txs trie of the tail holds the transactions of the former blocks,
+	// which are not absent from the txs root of its parent.
+	moved := *proof.Changes[0]
+	tailBlock := bc.TailBlock()
+	moved.Height = tailBlock.height
+	moved.BlockHash = tailBlock.Hash()
+	moved.TxsRoot = tailBlock.TxsRoot()
+	moved.Proof, err = tailBlock.txsTrie.Prove(txs[0].hash)
+	assert.Nil(t, err)
+	assert.Nil(t, trie.VerifyValue(moved.TxsRoot, txs[0].hash, moved.Tx, moved.Proof))
+	moved.ParentTxsRoot = proof.Changes[0].TxsRoot
+	moved.AbsenceProof = proof.Changes[0].Proof
+	_, err = moved.verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidBalanceProof, err)
+
+	// an account in the state is not claimed absent.
+	end, err := bc.getAccountProof(to, tail)
+	assert.Nil(t, err)
+	proof.End.Account = nil
+	_, err = VerifyBalanceProof(bc.ChainID(), proof)
+	assert.Equal(t, ErrInvalidBalanceProof, err)
+	proof.End.Proof, _ = bc.TailBlock().accState.ProveAbsence(mockAddress().address)
+	_, err = VerifyBalanceProof(bc.ChainID(), proof)
+	assert.Equal(t, ErrInvalidBalanceProof, err)
+	proof.End = end
+	_, err = VerifyBalanceProof(bc.ChainID(), proof)
+	assert.Nil(t, err)
+}
@@ -19,8 +19,8 @@
 package core
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"time"
 
@@ -30,6 +30,8 @@ import (
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/sha3"
@@ -37,6 +39,9 @@ import (
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/crash"
+	"github.com/nebulasio/go-nebulas/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
@@ -48,8 +53,17 @@ var (
 	// value: 10^8 * 3% / (365*24*3600/5) * 10^18 ≈ 16 * 3% * 10*18 = 48 * 10^16
 	BlockReward = util.NewUint128FromBigInt(util.NewUint128().Mul(util.NewUint128FromInt(48).Int,
 		util.NewUint128().Exp(util.NewUint128FromInt(10).Int, util.NewUint128FromInt(16).Int, nil)))
+
+	// DefaultBlockGasLimit is the gas limit of a block exposed to the contracts
+	// before the block gas limit fork, a block holds at least one transaction
+	// of the max gas.
+	DefaultBlockGasLimit = TransactionMaxGas
 )
 
+// MaxGasSkippedTransactions is the count of the txs not fitting in the gas
+// left a block skips before it stops collecting.
+const MaxGasSkippedTransactions = 64
+
 // BlockHeader of a block
 type BlockHeader struct {
 	hash       byteutils.Hash
@@ -60,7 +74,9 @@ type BlockHeader struct {
 	txsRoot     byteutils.Hash
 	eventsRoot  byteutils.Hash
 	dposContext *corepb.DposContext
+	anchorsRoot byteutils.Hash
 
+	version   uint32
 	coinbase  *Address
 	nonce     uint64
 	timestamp int64
@@ -69,10 +85,20 @@ type BlockHeader struct {
 	// sign
 	alg  uint8
 	sign byteutils.Hash
+
+	// gasUsed is committed to by the v1 header, nil in the v0 header.
+	gasUsed *util.Uint128
 }
 
 // ToProto converts domain BlockHeader to proto BlockHeader
 func (b *BlockHeader) ToProto() (proto.Message, error) {
+	var gasUsed []byte
+	if b.gasUsed != nil {
+		var err error
+		if gasUsed, err = b.gasUsed.ToFixedSizeByteSlice(); err != nil {
+			return nil, err
+		}
+	}
 	return &corepb.BlockHeader{
 		Hash:        b.hash,
 		ParentHash:  b.parentHash,
@@ -80,33 +106,47 @@ func (b *BlockHeader) ToProto() (proto.Message, error) {
 		TxsRoot:     b.txsRoot,
 		EventsRoot:  b.eventsRoot,
 		DposContext: b.dposContext,
+		AnchorsRoot: b.anchorsRoot,
+		Version:     b.version,
 		Nonce:       b.nonce,
 		Coinbase:    b.coinbase.address,
 		Timestamp:   b.timestamp,
 		ChainId:     b.chainID,
 		Alg:         uint32(b.alg),
 		Sign:        b.sign,
+		GasUsed:     gasUsed,
 	}, nil
 }
 
 // FromProto converts proto BlockHeader to domain BlockHeader
 func (b *BlockHeader) FromProto(msg proto.Message) error {
-	if msg, ok := msg.(*corepb.BlockHeader); ok {
+	if msg, ok := msg.(*corepb.BlockHeader); ok && msg != nil && msg.DposContext != nil {
 		b.hash = msg.Hash
 		b.parentHash = msg.ParentHash
 		b.stateRoot = msg.StateRoot
 		b.txsRoot = msg.TxsRoot
 		b.eventsRoot = msg.EventsRoot
 		b.dposContext = msg.DposContext
+		b.anchorsRoot = msg.AnchorsRoot
+		b.version = msg.Version
 		b.nonce = msg.Nonce
 		b.coinbase = &Address{msg.Coinbase}
 		b.timestamp = msg.Timestamp
 		b.chainID = msg.ChainId
 		b.alg = uint8(msg.Alg)
 		b.sign = msg.Sign
+		b.gasUsed = nil
+		// the v0 header doesn't commit to the gas used, a relayed one is dropped.
+		if msg.Version >= BlockHeaderV1 && len(msg.GasUsed) > 0 {
+			gasUsed, err := util.NewUint128FromFixedSizeByteSlice(msg.GasUsed)
+			if err != nil {
+				return err
+			}
+			b.gasUsed = gasUsed
+		}
 		return nil
 	}
-	return errors.New("Protobuf message cannot be converted into BlockHeader")
+	return ErrInvalidProtoToBlockHeader
 }
 
 // Block structure
@@ -126,6 +166,14 @@ type Block struct {
 
 	storage      storage.Storage
 	eventEmitter *EventEmitter
+
+	witness *corepb.Witness
+
+	// receipts are the results of the transactions executed, by hash.
+	receipts map[byteutils.HexHash]*TransactionReceipt
+
+	// gasUsed is the gas used by the transactions executed so far.
+	gasUsed *util.Uint128
 }
 
 // ToProto converts domain Block into proto Block
@@ -141,16 +189,17 @@ func (block *Block) ToProto() (proto.Message, error) {
 			if tx, ok := tx.(*corepb.Transaction); ok {
 				txs = append(txs, tx)
 			} else {
-				return nil, errors.New("Protobuf message cannot be converted into Transaction")
+				return nil, ErrInvalidProtoToTransaction
 			}
 		}
 		return &corepb.Block{
 			Header:       header,
 			Transactions: txs,
 			Height:       block.height,
+			Witness:      block.witness,
 		}, nil
 	}
-	return nil, errors.New("Protobuf message cannot be converted into BlockHeader")
+	return nil, ErrInvalidProtoToBlockHeader
 }
 
 // FromProto converts proto Block to domain Block
@@ -168,9 +217,11 @@ func (block *Block) FromProto(msg proto.Message) error {
 			block.transactions = append(block.transactions, tx)
 		}
 		block.height = msg.Height
+		block.witness = msg.Witness
+		block.gasUsed = util.NewUint128()
 		return nil
 	}
-	return errors.New("Protobuf message cannot be converted into Block")
+	return ErrInvalidProtoToBlock
 }
 
 // SerializeTxByHash returns tx serialized bytes
@@ -208,6 +259,7 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 			nonce:       0,
 			timestamp:   time.Now().Unix(),
 			chainID:     chainID,
+			version:     ForksOf(chainID).BlockHeaderVersion(parent.height + 1),
 		},
 		transactions: make(Transactions, 0),
 		parenetBlock: parent,
@@ -220,10 +272,19 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 		sealed:       false,
 		storage:      parent.storage,
 		eventEmitter: parent.eventEmitter,
+		gasUsed:      util.NewUint128(),
 	}
 
 	block.begin()
-	block.rewardCoinbase()
+	if err := block.rewardCoinbase(); err != nil {
+		block.rollback()
+		return nil, err
+	}
+	// the messages are delivered as in execute, the miner gets the same state.
+	if err := block.deliverMessages(context.Background()); err != nil {
+		block.rollback()
+		return nil, err
+	}
 	block.commit()
 
 	return block, nil
@@ -312,6 +373,11 @@ func (block *Block) TxsRoot() byteutils.Hash {
 	return block.header.txsRoot
 }
 
+// AnchorsRoot return anchors root.
+func (block *Block) AnchorsRoot() byteutils.Hash {
+	return block.header.anchorsRoot
+}
+
 // Storage return storage.
 func (block *Block) Storage() storage.Storage {
 	return block.storage
@@ -329,14 +395,18 @@ func (block *Block) DposContext() *corepb.DposContext {
 
 // DposContextHash hash dpos context
 func (block *Block) DposContextHash() byteutils.Hash {
+	return hashDposContext(block.header.dposContext)
+}
+
+func hashDposContext(context *corepb.DposContext) byteutils.Hash {
 	hasher := sha3.New256()
 
-	hasher.Write(block.header.dposContext.DynastyRoot)
-	hasher.Write(block.header.dposContext.NextDynastyRoot)
-	hasher.Write(block.header.dposContext.DelegateRoot)
-	hasher.Write(block.header.dposContext.VoteRoot)
-	hasher.Write(block.header.dposContext.CandidateRoot)
-	hasher.Write(block.header.dposContext.MintCntRoot)
+	hasher.Write(context.DynastyRoot)
+	hasher.Write(context.NextDynastyRoot)
+	hasher.Write(context.DelegateRoot)
+	hasher.Write(context.VoteRoot)
+	hasher.Write(context.CandidateRoot)
+	hasher.Write(context.MintCntRoot)
 
 	return hasher.Sum(nil)
 }
@@ -358,6 +428,12 @@ func (block *Block) ParentBlock() (*Block, error) {
 	return parentBlock, nil
 }
 
+// GasUsed returns the gas used by the transactions of the block, nil for a
+// block with the v0 header.
+func (block *Block) GasUsed() *util.Uint128 {
+	return block.header.gasUsed
+}
+
 // Height return height
 func (block *Block) Height() uint64 {
 	return block.height
@@ -368,6 +444,21 @@ func (block *Block) Miner() *Address {
 	return block.miner
 }
 
+// Transactions return transactions in block
+func (block *Block) Transactions() Transactions {
+	return block.transactions
+}
+
+// Witness return the execution witness attached to the block, nil if none.
+func (block *Block) Witness() *corepb.Witness {
+	return block.witness
+}
+
+// SetWitness attach the execution witness to the block.
+func (block *Block) SetWitness(witness *corepb.Witness) {
+	block.witness = witness
+}
+
 // SetMiner return miner
 func (block *Block) SetMiner(miner *Address) {
 	block.miner = miner
@@ -379,6 +470,55 @@ func (block *Block) VerifyAddress(str string) bool {
 	return err == nil
 }
 
+// RecoverAddress returns the address of the signer of the hash, for the contracts.
+func (block *Block) RecoverAddress(alg keystore.Algorithm, hash, sign []byte) (string, error) {
+	addr, err := RecoverSignerAddress(alg, hash, sign)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+// RandomSeed returns the random seed of the block for the contracts, derived
+// from the parent block, the same on every node and known once the parent is.
+// It's predictable by anyone before the transactions of the block run, and
+// the miner of the parent picks it, so the contracts must not decide anything
+// of value, like a lottery winner, on it.
+func (block *Block) RandomSeed() byteutils.Hash {
+	return hash.Sha3256(block.ParentHash(), byteutils.FromUint64(block.height))
+}
+
+// GasLimit returns the max gas used by the transactions of the block, the one
+// of the block gas limit fork if active.
+func (block *Block) GasLimit() *util.Uint128 {
+	if limit := ForksOf(block.ChainID()).BlockGasLimitAt(block.height); limit != nil {
+		return limit
+	}
+	return DefaultBlockGasLimit
+}
+
+// ContextExtension returns the extended context of the block for the contracts, nil before the fork.
+func (block *Block) ContextExtension() *nvm.ContextBlockExtension {
+	if !ForksOf(block.ChainID()).IsContractContextActive(block.height) {
+		return nil
+	}
+	return &nvm.ContextBlockExtension{
+		ParentHash: block.ParentHash().String(),
+		GasLimit:   block.GasLimit().String(),
+		Random:     block.RandomSeed().String(),
+	}
+}
+
+// IsVMLimitsActive returns if the VM limits apply to the contracts run in the block.
+func (block *Block) IsVMLimitsActive() bool {
+	return ForksOf(block.ChainID()).IsVMLimitsActive(block.height)
+}
+
+// IsNumericPolicyActive returns if the numeric policy applies to the contracts run in the block.
+func (block *Block) IsNumericPolicyActive() bool {
+	return ForksOf(block.ChainID()).IsNumericPolicyActive(block.height)
+}
+
 // LinkParentBlock link parent block, return true if hash is the same; false otherwise.
 func (block *Block) LinkParentBlock(parentBlock *Block) error {
 	if block.ParentHash().Equals(parentBlock.Hash()) == false {
@@ -411,6 +551,15 @@ func (block *Block) LinkParentBlock(parentBlock *Block) error {
 	block.height = parentBlock.height + 1
 	block.eventEmitter = parentBlock.eventEmitter
 
+	if version := ForksOf(block.ChainID()).BlockHeaderVersion(block.height); block.header.version != version {
+		logging.VLog().WithFields(logrus.Fields{
+			"block":  block,
+			"expect": version,
+			"actual": block.header.version,
+		}).Error("Failed to check block header version.")
+		return ErrInvalidBlockHeaderVersion
+	}
+
 	logging.VLog().WithFields(logrus.Fields{
 		"parent": parentBlock,
 		"block":  block,
@@ -468,11 +617,25 @@ func (block *Block) CollectTransactions(n int) {
 	}
 
 	pool := block.txPool
+	gasLimit := ForksOf(block.header.chainID).BlockGasLimitAt(block.height)
 	var givebacks []*Transaction
+	skipped := 0
 	for !pool.Empty() && n > 0 {
+		// no tx fits in the gas left.
+		if gasLimit != nil && util.NewUint128().Add(block.gasUsed.Int, MinGasCountPerTransaction.Int).Cmp(gasLimit.Int) > 0 {
+			break
+		}
 		tx := pool.Pop()
+		// the tx which may not fit in the gas left is kept for the next blocks.
+		if gasLimit != nil && util.NewUint128().Add(block.gasUsed.Int, tx.gasLimit.Int).Cmp(gasLimit.Int) > 0 {
+			givebacks = append(givebacks, tx)
+			if skipped++; skipped >= MaxGasSkippedTransactions {
+				break
+			}
+			continue
+		}
 		block.begin()
-		giveback, err := block.executeTransaction(tx)
+		giveback, err := block.executeTransaction(context.Background(), tx)
 		if giveback {
 			givebacks = append(givebacks, tx)
 		}
@@ -493,6 +656,13 @@ func (block *Block) CollectTransactions(n int) {
 				"giveback": giveback,
 			}).Warn("invalid tx.")
 			block.rollback()
+			if crash.IsPanic(err) {
+				pool.markPanicked(tx)
+			}
+			// the tx replaced by a tx on chain is dropped out of the pool.
+			if err == ErrSmallTransactionNonce {
+				nonceTooLowTxCounter.Inc(1)
+			}
 		}
 	}
 	for _, tx := range givebacks {
@@ -532,6 +702,10 @@ func (block *Block) Seal() error {
 	if block.header.dposContext, err = block.dposContext.ToProto(); err != nil {
 		return err
 	}
+	block.header.anchorsRoot = block.anchorsTrieRoot()
+	if block.header.version >= BlockHeaderV1 {
+		block.header.gasUsed = block.gasUsed
+	}
 	block.header.hash = HashBlock(block)
 	block.sealed = true
 
@@ -554,7 +728,14 @@ func (block *Block) String() string {
 }
 
 // VerifyExecution execute the block and verify the execution result.
-func (block *Block) VerifyExecution(parent *Block, consensus Consensus) error {
+func (block *Block) VerifyExecution(parent *Block, consensus Consensus) (err error) {
+	ctx, span := tracing.Start(context.Background(), "core.block.verifyExecution",
+		attribute.Int64("height", int64(block.height)),
+		attribute.String("hash", block.Hash().String()),
+		attribute.Int("txs", len(block.transactions)),
+	)
+	defer func() { tracing.End(span, err) }()
+
 	// verify the block is acceptable by consensus
 	if err := consensus.VerifyBlock(block, parent); err != nil {
 		return err
@@ -563,7 +744,7 @@ func (block *Block) VerifyExecution(parent *Block, consensus Consensus) error {
 	block.begin()
 
 	start := time.Now().Unix()
-	if err := block.execute(); err != nil {
+	if err := block.execute(ctx); err != nil {
 		block.rollback()
 		return err
 	}
@@ -587,28 +768,21 @@ func (block *Block) triggerEvent() {
 
 	for _, v := range block.transactions {
 		var topic string
-		switch v.Type() {
-		case TxPayloadBinaryType:
-			topic = TopicSendTransaction
-		case TxPayloadDeployType:
-			topic = TopicDeploySmartContract
-		case TxPayloadCallType:
-			topic = TopicCallSmartContract
-		case TxPayloadDelegateType:
-			topic = TopicDelegate
-		case TxPayloadCandidateType:
-			topic = TopicCandidate
+		if t := LookupTxPayloadType(v.Type()); t != nil {
+			topic = t.Topic
 		}
 		data, err := json.Marshal(v)
 		event := &Event{
 			Topic: topic,
 			Data:  string(data),
+			ID:    blockEventID(block.Hash(), v.hash, 0),
 		}
 		block.eventEmitter.Trigger(event)
 
 		events, err := block.FetchEvents(v.hash)
 		if err != nil {
-			for _, e := range events {
+			for i, e := range events {
+				e.ID = blockEventID(block.Hash(), v.hash, i+1)
 				block.eventEmitter.Trigger(e)
 			}
 		}
@@ -618,6 +792,7 @@ func (block *Block) triggerEvent() {
 	e := &Event{
 		Topic: TopicLinkBlock,
 		Data:  string(blockData),
+		ID:    block.Hash().String(),
 	}
 	block.eventEmitter.Trigger(e)
 }
@@ -633,6 +808,15 @@ func (block *Block) VerifyIntegrity(chainID uint32, consensus Consensus) error {
 		return ErrInvalidChainID
 	}
 
+	// the header of a newer version can't be verified.
+	if block.header.version > MaxBlockHeaderVersion {
+		logging.VLog().WithFields(logrus.Fields{
+			"max":    MaxBlockHeaderVersion,
+			"actual": block.header.version,
+		}).Error("Failed to check block header version.")
+		return ErrUnsupportedBlockHeaderVersion
+	}
+
 	// verify block hash.
 	wantedHash := HashBlock(block)
 	if !wantedHash.Equals(block.Hash()) {
@@ -689,23 +873,45 @@ func (block *Block) verifyState() error {
 		return ErrInvalidBlockDposContextRoot
 	}
 
+	// verify anchors root.
+	if !byteutils.Equal(block.anchorsTrieRoot(), block.AnchorsRoot()) {
+		return ErrInvalidBlockAnchorsRoot
+	}
+
+	// verify gas used, committed to from the v1 header on.
+	if block.header.version >= BlockHeaderV1 {
+		if block.header.gasUsed == nil || block.header.gasUsed.Cmp(block.gasUsed.Int) != 0 {
+			return ErrInvalidBlockGasUsed
+		}
+	}
+
 	return nil
 }
 
 // Execute block and return result.
-func (block *Block) execute() error {
-	block.rewardCoinbase()
+func (block *Block) execute(ctx context.Context) error {
+	block.gasUsed = util.NewUint128()
+	if err := block.rewardCoinbase(); err != nil {
+		return err
+	}
+
+	if err := block.deliverMessages(ctx); err != nil {
+		return err
+	}
 
 	for _, tx := range block.transactions {
 		start := time.Now().Unix()
-		giveback, err := block.executeTransaction(tx)
-		if giveback {
+		giveback, err := block.executeTransaction(ctx, tx)
+		if giveback && block.txPool != nil {
 			err := block.txPool.Push(tx)
 			if err != nil {
 				return err
 			}
 		}
 		if err != nil {
+			if crash.IsPanic(err) && block.txPool != nil {
+				block.txPool.markPanicked(tx)
+			}
 			return err
 		}
 		end := time.Now().Unix()
@@ -819,7 +1025,7 @@ func (block *Block) recordMintCnt() error {
 	return nil
 }
 
-func (block *Block) rewardCoinbase() {
+func (block *Block) rewardCoinbase() error {
 	coinbaseAddr := block.header.coinbase.address
 	coinbaseAcc := block.accState.GetOrCreateUserAccount(coinbaseAddr)
 	coinbaseAcc.AddBalance(BlockReward)
@@ -827,6 +1033,7 @@ func (block *Block) rewardCoinbase() {
 		"coinbase": coinbaseAddr.Hex(),
 		"balance":  coinbaseAcc.Balance().Int64(),
 	}).Info("Rewarded the coinbase.")
+	return block.recordIssued(BlockReward)
 }
 
 // GetTransaction from txs Trie
@@ -882,12 +1089,13 @@ func (block *Block) checkTransaction(tx *Transaction) (giveback bool, err error)
 	return false, nil
 }
 
-func (block *Block) executeTransaction(tx *Transaction) (giveback bool, err error) {
+func (block *Block) executeTransaction(ctx context.Context, tx *Transaction) (giveback bool, err error) {
 	if giveback, err := block.checkTransaction(tx); err != nil {
 		return giveback, err
 	}
 
-	if _, err := tx.VerifyExecution(block); err != nil {
+	gas, err := tx.verifyExecution(ctx, block)
+	if err != nil {
 		return false, err
 	}
 
@@ -895,33 +1103,59 @@ func (block *Block) executeTransaction(tx *Transaction) (giveback bool, err erro
 		return false, err
 	}
 
+	gasUsed := util.NewUint128FromBigInt(util.NewUint128().Add(block.gasUsed.Int, gas.Int))
+	if limit := ForksOf(block.header.chainID).BlockGasLimitAt(block.height); limit != nil && gasUsed.Cmp(limit.Int) > 0 {
+		return false, ErrBlockGasLimitExceeded
+	}
+	block.gasUsed = gasUsed
 	return false, nil
 }
 
 // HashBlock return the hash of block.
 func HashBlock(block *Block) byteutils.Hash {
+	txHashes := make([]byteutils.Hash, len(block.transactions))
+	for i, tx := range block.transactions {
+		txHashes[i] = tx.Hash()
+	}
+	return hashBlockHeader(block.header, txHashes)
+}
+
+// hashBlockHeader returns the hash of a block by its header and the hashes
+// of its transactions, which is all a light client knows of the block.
+func hashBlockHeader(header *BlockHeader, txHashes []byteutils.Hash) byteutils.Hash {
 	hasher := sha3.New256()
 
-	hasher.Write(block.ParentHash())
-	hasher.Write(block.StateRoot())
-	hasher.Write(block.TxsRoot())
-	hasher.Write(block.EventsRoot())
-	hasher.Write(block.DposContextHash())
-	hasher.Write(byteutils.FromUint64(block.header.nonce))
-	hasher.Write(block.header.coinbase.address)
-	hasher.Write(byteutils.FromInt64(block.header.timestamp))
-	hasher.Write(byteutils.FromUint32(block.header.chainID))
+	hasher.Write(header.parentHash)
+	hasher.Write(header.stateRoot)
+	hasher.Write(header.txsRoot)
+	hasher.Write(header.eventsRoot)
+	hasher.Write(hashDposContext(header.dposContext))
+	// empty before the first anchor and the anchor fork, keeping the hash of former blocks.
+	hasher.Write(header.anchorsRoot)
+	hasher.Write(byteutils.FromUint64(header.nonce))
+	hasher.Write(header.coinbase.address)
+	hasher.Write(byteutils.FromInt64(header.timestamp))
+	hasher.Write(byteutils.FromUint32(header.chainID))
+	// the v0 header doesn't commit to its version, keeping the hash of former blocks.
+	if header.version >= BlockHeaderV1 {
+		hasher.Write(byteutils.FromUint32(header.version))
+		if header.gasUsed != nil {
+			if gasUsed, err := header.gasUsed.ToFixedSizeByteSlice(); err == nil {
+				hasher.Write(gasUsed)
+			}
+		}
+	}
 
-	for _, tx := range block.transactions {
-		hasher.Write(tx.Hash())
+	for _, hash := range txHashes {
+		hasher.Write(hash)
 	}
 
 	return hasher.Sum(nil)
 }
 
 // LoadBlockFromStorage return a block from storage
-func LoadBlockFromStorage(hash byteutils.Hash, storage storage.Storage, txPool *TransactionPool, eventEmitter *EventEmitter) (*Block, error) {
-	value, err := storage.Get(hash)
+func LoadBlockFromStorage(hash byteutils.Hash, stor storage.Storage, txPool *TransactionPool, eventEmitter *EventEmitter) (*Block, error) {
+	value, err := storage.WithNamespace(stor, storage.NamespaceBlocks).Get(hash)
 	if err != nil {
 		return nil, err
 	}
@@ -933,27 +1167,57 @@ func LoadBlockFromStorage(hash byteutils.Hash, storage storage.Storage, txPool *
 	if err = block.FromProto(pbBlock); err != nil {
 		return nil, err
 	}
-	block.accState, err = state.NewAccountState(block.StateRoot(), storage)
-	if err != nil {
+	if err = block.loadState(stor); err != nil {
 		return nil, err
 	}
-	block.txsTrie, err = trie.NewBatchTrie(block.TxsRoot(), storage)
-	if err != nil {
-		return nil, err
+	block.txPool = txPool
+	block.sealed = true
+	block.eventEmitter = eventEmitter
+	return block, nil
+}
+
+// Clone returns a copy of the sealed block over an overlay of its storage,
+// the changes of the copy never reach the block or the storage. The copy is
+// safe to execute transactions on while the block is used by the chain.
+func (block *Block) Clone() (*Block, error) {
+	if !block.sealed {
+		return nil, ErrCloneUnsealedBlock
 	}
-	block.eventsTrie, err = trie.NewBatchTrie(block.EventsRoot(), storage)
+	pbBlock, err := block.ToProto()
 	if err != nil {
 		return nil, err
 	}
-	if block.dposContext, err = NewDposContext(storage); err != nil {
+	clone := new(Block)
+	if err := clone.FromProto(pbBlock); err != nil {
 		return nil, err
 	}
-	if block.dposContext.FromProto(block.DposContext()) != nil {
+	if err := clone.loadState(storage.NewOverlayStorage(block.storage)); err != nil {
 		return nil, err
 	}
-	block.txPool = txPool
-	block.storage = storage
-	block.sealed = true
-	block.eventEmitter = eventEmitter
-	return block, nil
+	clone.txPool = block.txPool
+	clone.sealed = true
+	clone.eventEmitter = block.eventEmitter
+	return clone, nil
+}
+
+// loadState opens the states of the block at its roots in stor.
+func (block *Block) loadState(stor storage.Storage) error {
+	var err error
+	if block.accState, err = state.NewAccountState(block.StateRoot(), stor); err != nil {
+		return err
+	}
+	if block.txsTrie, err = trie.NewBatchTrie(block.TxsRoot(), stor); err != nil {
+		return err
+	}
+	if block.eventsTrie, err = trie.NewBatchTrie(block.EventsRoot(), stor); err != nil {
+		return err
+	}
+	if block.dposContext, err = NewDposContext(stor); err != nil {
+		return err
+	}
+	if err = block.dposContext.FromProto(block.DposContext()); err != nil {
+		return err
+	}
+	block.storage = stor
+	return nil
 }
@@ -0,0 +1,82 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	blockCacheHitCounter  = metrics.GetOrRegisterCounter("neb.block.cache.hit", nil)
+	blockCacheMissCounter = metrics.GetOrRegisterCounter("neb.block.cache.miss", nil)
+)
+
+// BlockCacheConfig is the sizes of the caches of the blocks loaded from the
+// storage, in entries.
+type BlockCacheConfig struct {
+	// Blocks is the size of the cache of the full blocks.
+	Blocks int
+	// Headers is the size of the cache of the headers, much lighter than the
+	// blocks, so more of them are kept.
+	Headers int
+}
+
+// DefaultBlockCacheConfig returns the default sizes of the block caches.
+func DefaultBlockCacheConfig() *BlockCacheConfig {
+	return &BlockCacheConfig{
+		Blocks:  1024,
+		Headers: 8192,
+	}
+}
+
+// SetBlockCacheConfig replaces the block caches with empty ones of the sizes.
+func (bc *BlockChain) SetBlockCacheConfig(conf *BlockCacheConfig) error {
+	blocks, err := lru.New(conf.Blocks)
+	if err != nil {
+		return err
+	}
+	headers, err := lru.New(conf.Headers)
+	if err != nil {
+		return err
+	}
+	bc.cachedBlocks, bc.cachedHeaders = blocks, headers
+	return nil
+}
+
+// cacheBlock adds the block and its header to the caches.
+func (bc *BlockChain) cacheBlock(block *Block) {
+	key := block.Hash().Hex()
+	bc.cachedBlocks.ContainsOrAdd(key, block)
+	bc.cachedHeaders.ContainsOrAdd(key, newChainHeader(block))
+}
+
+// refreshCachedBlock replaces the cached block of the same hash by the block
+// rewritten to the storage, a block not cached is left to be loaded.
+func (bc *BlockChain) refreshCachedBlock(block *Block) {
+	// a chain not built by NewBlockChain has no cache.
+	if bc.cachedBlocks == nil {
+		return
+	}
+	key := block.Hash().Hex()
+	if bc.cachedBlocks.Contains(key) {
+		bc.cachedBlocks.Add(key, block)
+		bc.cachedHeaders.Add(key, newChainHeader(block))
+	}
+}
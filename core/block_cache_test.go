@@ -0,0 +1,63 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCache(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+	assert.Nil(t, bc.SetTailBlock(block))
+
+	// a chain restarted loads the block from the storage once.
+	bc, _ = NewBlockChain(neb)
+	assert.Nil(t, bc.SetBlockCacheConfig(&BlockCacheConfig{Blocks: 2, Headers: 4}))
+	assert.False(t, bc.cachedBlocks.Contains(block.Hash().Hex()))
+	loaded := bc.GetBlock(block.Hash())
+	assert.Equal(t, block.Hash(), loaded.Hash())
+	assert.True(t, loaded == bc.GetBlock(block.Hash()))
+	v, ok := bc.cachedHeaders.Get(block.Hash().Hex())
+	assert.True(t, ok)
+	assert.Equal(t, block.Hash(), v.(*ChainHeader).Hash())
+	assert.Equal(t, block.Height(), v.(*ChainHeader).Height())
+
+	// the blocks missing in the storage aren't cached.
+	assert.Nil(t, bc.GetBlock(mockAddress().Bytes()))
+	assert.Equal(t, 1, bc.cachedBlocks.Len())
+
+	// a block rewritten to the storage replaces the cached one.
+	assert.Nil(t, bc.storeBlockToStorage(block))
+	assert.True(t, block == bc.GetBlock(block.Hash()))
+
+	assert.NotNil(t, bc.SetBlockCacheConfig(&BlockCacheConfig{Blocks: 0, Headers: 4}))
+}
@@ -19,9 +19,11 @@
 package core
 
 import (
+	"context"
 	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -31,19 +33,30 @@ import (
 	"github.com/nebulasio/go-nebulas/net/p2p"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/sched"
+	"github.com/nebulasio/go-nebulas/util/tracing"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // constants
 const (
 	NoSender = ""
+
+	// MaxRelayedBlockAge is the age in seconds of the signed timestamp over
+	// which a relayed new block is a replayed announcement, the old blocks
+	// are downloaded instead.
+	MaxRelayedBlockAge = DynastyInterval
 )
 
 // Errors in block
 var (
 	duplicatedBlockCounter = metrics.GetOrRegisterCounter("neb.block.duplicated", nil)
+	staleBlockCounter      = metrics.GetOrRegisterCounter("neb.block.stale", nil)
 	invalidBlockCounter    = metrics.GetOrRegisterCounter("neb.block.invalid", nil)
+	futureBlockCounter     = metrics.GetOrRegisterCounter("neb.block.future", nil)
+	doubleMintBlockCounter = metrics.GetOrRegisterCounter("neb.block.doubleminted", nil)
 	BlockExecutedTimer     = metrics.GetOrRegisterTimer("neb.block.executed", nil)
 	TxExecutedTimer        = metrics.GetOrRegisterTimer("neb.tx.executed", nil)
 )
@@ -63,6 +76,8 @@ type BlockPool struct {
 
 	nm p2p.Manager
 	mu sync.RWMutex
+
+	paused int32
 }
 
 type linkedBlock struct {
@@ -127,7 +142,31 @@ func (pool *BlockPool) Stop() {
 	pool.quitCh <- 0
 }
 
+// Pause stops accepting new blocks, e.g. when the disk is almost full.
+func (pool *BlockPool) Pause() {
+	if atomic.CompareAndSwapInt32(&pool.paused, 0, 1) {
+		logging.CLog().Warn("Pause BlockPool.")
+	}
+}
+
+// Resume accepting new blocks.
+func (pool *BlockPool) Resume() {
+	if atomic.CompareAndSwapInt32(&pool.paused, 1, 0) {
+		logging.CLog().Info("Resume BlockPool.")
+	}
+}
+
+// Paused returns whether the pool rejects new blocks.
+func (pool *BlockPool) Paused() bool {
+	return atomic.LoadInt32(&pool.paused) == 1
+}
+
 func (pool *BlockPool) handleBlock(msg net.Message) {
+	_, span := tracing.Start(context.Background(), "core.blockPool.handleBlock",
+		attribute.String("msgType", msg.MessageType()),
+	)
+	defer span.End()
+
 	if msg.MessageType() != MessageTypeNewBlock && msg.MessageType() != MessageTypeDownloadedBlockReply {
 		logging.VLog().WithFields(logrus.Fields{
 			"msgType": msg.MessageType(),
@@ -137,6 +176,15 @@ func (pool *BlockPool) handleBlock(msg net.Message) {
 		return
 	}
 
+	if err := CheckBlockEncoding(msg.Data().([]byte)); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to check block encoding.")
+		return
+	}
+
 	block := new(Block)
 	pbblock := new(corepb.Block)
 	if err := proto.Unmarshal(msg.Data().([]byte), pbblock); err != nil {
@@ -157,7 +205,19 @@ func (pool *BlockPool) handleBlock(msg net.Message) {
 	}
 
 	diff := time.Now().Unix() - block.Timestamp()
+	if msg.MessageType() == MessageTypeNewBlock && diff > MaxRelayedBlockAge {
+		staleBlockCounter.Inc(1)
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"diff":  diff,
+			"limit": MaxRelayedBlockAge,
+		}).Warn("Discard a stale block announcement.")
+		return
+	}
 	if msg.MessageType() == MessageTypeNewBlock && int64(math.Abs(float64(diff))) > AcceptedNetWorkDelay {
+		if diff < 0 {
+			futureBlockCounter.Inc(1)
+		}
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
 			"diff":  diff,
@@ -258,9 +318,9 @@ func (pool *BlockPool) loop() {
 			logging.CLog().Info("Shutdowned BlockPool.")
 			return
 		case msg := <-pool.receiveBlockMessageCh:
-			pool.handleBlock(msg)
+			p2p.HandleMessage(pool.nm, "core.blockPool.handleBlock", msg, pool.handleBlock)
 		case msg := <-pool.receiveDownloadBlockMessageCh:
-			pool.handleDownloadedBlock(msg)
+			p2p.ServeMessage(pool.nm, "core.blockPool.handleDownloadedBlock", p2p.ServeSmall, msg, pool.handleDownloadedBlock)
 		}
 	}
 }
@@ -354,6 +414,13 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 		"block": block,
 	}).Info("Try to push a new block.")
 
+	if pool.Paused() {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+		}).Warn("BlockPool is paused, drop the block.")
+		return ErrBlockPoolPaused
+	}
+
 	// verify non-dup block
 	if pool.cache.Contains(block.Hash().Hex()) ||
 		pool.bc.GetBlock(block.Hash()) != nil {
@@ -382,7 +449,7 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 	lb := newLinkedBlock(block, pool)
 
 	if preBlock, exist := pool.slot.Get(lb.block.Timestamp()); exist {
-		invalidBlockCounter.Inc(1)
+		doubleMintBlockCounter.Inc(1)
 		logging.VLog().WithFields(logrus.Fields{
 			"curBlock": lb.block,
 			"preBlock": preBlock.(*Block),
@@ -455,6 +522,12 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 		return ErrInvalidBlockCannotFindParentInLocalAndTryDownload
 	}
 
+	// the fork can't become canonical, drop it before executing it.
+	if err := bc.checkBranchPoint(parentBlock); err != nil {
+		cache.Remove(lb.hash.Hex())
+		return err
+	}
+
 	// found in BlockChain, then we can verify the state root, and tell the Consensus all the tails.
 	// performance depth-first search to verify state root, and get all tails.
 	allBlocks, tailBlocks, err := lb.travelToLinkAndReturnAllValidBlocks(parentBlock)
@@ -518,7 +591,10 @@ func (lb *linkedBlock) travelToLinkAndReturnAllValidBlocks(parentBlock *Block) (
 		return nil, nil, err
 	}
 
-	if err := lb.block.VerifyExecution(parentBlock, lb.pool.bc.ConsensusHandler()); err != nil {
+	done := sched.Critical()
+	err := lb.block.VerifyExecution(parentBlock, lb.pool.bc.ConsensusHandler())
+	done()
+	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": lb.block,
 			"err":   err,
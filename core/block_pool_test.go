@@ -52,6 +52,7 @@ func (c MockConsensus) VerifyBlock(block *Block, parent *Block) error {
 
 var (
 	received = []byte{}
+	closed   = ""
 )
 
 type MockNetManager struct{}
@@ -74,6 +75,10 @@ func (n MockNetManager) SendMsg(name string, msg []byte, target string) error {
 	return nil
 }
 
+func (n MockNetManager) ClosePeer(target string, reason error) {
+	closed = target
+}
+
 func (n MockNetManager) BroadcastNetworkID([]byte) {}
 
 func (n MockNetManager) BuildData([]byte, string) []byte { return nil }
@@ -286,6 +291,10 @@ func TestHandleBlock(t *testing.T) {
 	data, err = proto.Marshal(pbMsg)
 	msg = messages.NewBaseMessage(MessageTypeNewBlock, "from", data)
 	bc.bkPool.handleBlock(msg)
+	// the announcement of an old block is discarded, it's only downloaded.
+	assert.Nil(t, bc.GetBlock(block.Hash()))
+	msg = messages.NewBaseMessage(MessageTypeDownloadedBlockReply, "from", data)
+	bc.bkPool.handleBlock(msg)
 	assert.NotNil(t, bc.GetBlock(block.Hash()))
 
 	block, err = bc.NewBlock(from)
@@ -385,3 +394,42 @@ func TestHandleDownloadedBlock(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, received, data)
 }
+
+func TestBlockPool_Pause(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var n MockNetManager
+	bc.bkPool.RegisterInNetwork(n)
+	bc.SetConsensusHandler(&MockConsensus{neb.storage})
+	pool := bc.bkPool
+
+	block, err := NewBlock(bc.ChainID(), &Address{[]byte("012345678901234567890011")}, bc.tailBlock)
+	assert.Nil(t, err)
+
+	pool.Pause()
+	assert.True(t, pool.Paused())
+	assert.Equal(t, ErrBlockPoolPaused, pool.Push(block))
+	assert.Equal(t, 0, pool.cache.Len())
+
+	pool.Resume()
+	assert.False(t, pool.Paused())
+	assert.NotEqual(t, ErrBlockPoolPaused, pool.Push(block))
+}
+
+func TestBlockPool_HandleMessagePanic(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	var n MockNetManager
+	bc.bkPool.RegisterInNetwork(n)
+
+	// a message without data panics in handleBlock, the sender is closed.
+	closed = ""
+	msg := messages.NewBaseMessage(MessageTypeNewBlock, "peer", nil)
+	p2p.HandleMessage(bc.bkPool.nm, "core.blockPool.handleBlock", msg, bc.bkPool.handleBlock)
+	assert.Equal(t, "peer", closed)
+
+	closed = ""
+	msg = messages.NewBaseMessage(MessageTypeNewBlock, "peer", []byte{})
+	p2p.HandleMessage(bc.bkPool.nm, "core.blockPool.handleBlock", msg, bc.bkPool.handleBlock)
+	assert.Equal(t, "", closed)
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+// MaxBlockRange is the max blocks returned by GetBlocksByHeightRange, longer
+// ranges are paged through a BlockIterator.
+const MaxBlockRange = 1024
+
+// BlockIterator walks the canonical chain by height, loading one block at a
+// time from the height index.
+type BlockIterator struct {
+	bc    *BlockChain
+	next  uint64
+	to    uint64
+	block *Block
+}
+
+// IterateBlocksByHeight returns an iterator over the canonical blocks from
+// height from to to, both included.
+func (bc *BlockChain) IterateBlocksByHeight(from, to uint64) (*BlockIterator, error) {
+	if from == 0 || to < from {
+		return nil, ErrInvalidBlockRange
+	}
+	return &BlockIterator{
+		bc:   bc,
+		next: from,
+		to:   to,
+	}, nil
+}
+
+// Next loads the next block, it returns false at the end of the range or of
+// the chain, and an error if the chain was reorganized under the iterator.
+func (it *BlockIterator) Next() (bool, error) {
+	if it.next > it.to || it.next == 0 {
+		return false, nil
+	}
+	block, err := it.bc.GetBlockByHeight(it.next)
+	if err == ErrCannotFindBlockAtGivenHeight && it.next > it.bc.TailBlock().height {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if it.block != nil && !block.ParentHash().Equals(it.block.Hash()) {
+		return false, ErrNotBlockInCanonicalChain
+	}
+	it.block = block
+	it.next++
+	return true, nil
+}
+
+// Block returns the current block.
+func (it *BlockIterator) Block() *Block {
+	return it.block
+}
+
+// GetBlocksByHeightRange returns the canonical blocks from height from to to,
+// both included, stopping at the tail.
+func (bc *BlockChain) GetBlocksByHeightRange(from, to uint64) ([]*Block, error) {
+	if to >= from && to-from >= MaxBlockRange {
+		return nil, ErrInvalidBlockRange
+	}
+	it, err := bc.IterateBlocksByHeight(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*Block
+	for {
+		exist, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return blocks, nil
+		}
+		blocks = append(blocks, it.Block())
+	}
+}
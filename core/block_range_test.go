@@ -0,0 +1,73 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlocksByHeightRange(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	_, err := bc.GetBlocksByHeightRange(0, 2)
+	assert.Equal(t, ErrInvalidBlockRange, err)
+	_, err = bc.GetBlocksByHeightRange(3, 2)
+	assert.Equal(t, ErrInvalidBlockRange, err)
+	_, err = bc.GetBlocksByHeightRange(1, MaxBlockRange+1)
+	assert.Equal(t, ErrInvalidBlockRange, err)
+
+	// heights start at 1 with the genesis, the range stops at the tail.
+	result, err := bc.GetBlocksByHeightRange(2, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, len(blocks), len(result))
+	for i, v := range result {
+		assert.Equal(t, blocks[i].Hash(), v.Hash())
+	}
+
+	it, err := bc.IterateBlocksByHeight(1, 2)
+	assert.Nil(t, err)
+	exist, err := it.Next()
+	assert.Nil(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, bc.GenesisBlock().Hash(), it.Block().Hash())
+	exist, _ = it.Next()
+	assert.True(t, exist)
+	assert.Equal(t, blocks[0].Hash(), it.Block().Hash())
+	exist, err = it.Next()
+	assert.Nil(t, err)
+	assert.False(t, exist)
+}
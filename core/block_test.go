@@ -628,3 +628,194 @@ func TestBlockVerifyState(t *testing.T) {
 	block.header.stateRoot[0]++
 	assert.NotNil(t, block.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
 }
+
+func TestBlockGasUsed(t *testing.T) {
+	var cons MockConsensus
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(cons)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	assert.Nil(t, bc.tailBlock.GasUsed())
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{HeaderV1Height: 2}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000000))
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		tx := NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128FromInt(1), nonce, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, bc.txPool.Push(tx))
+	}
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(2)
+	block.SetMiner(coinbase)
+	assert.Nil(t, block.Seal())
+	assert.Equal(t, 2, len(block.transactions))
+	gas := util.NewUint128FromBigInt(util.NewUint128().Mul(block.transactions[0].GasCountOfTxBase().Int, util.NewUint128FromInt(2).Int))
+	assert.Equal(t, gas.String(), block.GasUsed().String())
+
+	received := func(gasUsed *util.Uint128) (*Block, error) {
+		msg, err := block.ToProto()
+		assert.Nil(t, err)
+		pbBlock := pb.Clone(msg).(*corepb.Block)
+		pbBlock.Header.GasUsed = nil
+		if gasUsed != nil {
+			pbBlock.Header.GasUsed, _ = gasUsed.ToFixedSizeByteSlice()
+		}
+		copied := new(Block)
+		assert.Nil(t, copied.FromProto(pbBlock))
+		assert.Nil(t, copied.LinkParentBlock(bc.tailBlock))
+		return copied, copied.VerifyExecution(bc.tailBlock, bc.ConsensusHandler())
+	}
+
+	// the gas used is committed to by the v1 header hash.
+	copied, err := received(gas)
+	assert.Nil(t, err)
+	assert.Equal(t, gas.String(), copied.GasUsed().String())
+	copied, _ = received(util.NewUint128FromInt(1))
+	assert.NotEqual(t, block.Hash(), HashBlock(copied))
+	_, err = received(nil)
+	assert.Equal(t, ErrInvalidBlockGasUsed, err)
+
+	// the v0 header doesn't carry it.
+	msg, err := block.header.ToProto()
+	assert.Nil(t, err)
+	msg.(*corepb.BlockHeader).Version = BlockHeaderV0
+	header := new(BlockHeader)
+	assert.Nil(t, header.FromProto(msg))
+	assert.Nil(t, header.gasUsed)
+}
+
+func TestBlock_Clone(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	tail := bc.TailBlock()
+	addr := mockAddress()
+
+	clone, err := tail.Clone()
+	assert.Nil(t, err)
+	assert.Equal(t, tail.Hash(), clone.Hash())
+	assert.Equal(t, tail.StateRoot(), clone.accState.RootHash())
+
+	// the changes of the clone never reach the block.
+	clone.begin()
+	clone.accState.GetOrCreateUserAccount(addr.Bytes()).AddBalance(util.NewUint128FromInt(100))
+	clone.commit()
+	assert.NotEqual(t, tail.StateRoot(), clone.accState.RootHash())
+	assert.Equal(t, tail.StateRoot(), tail.accState.RootHash())
+	assert.Equal(t, util.NewUint128(), tail.accState.GetOrCreateUserAccount(addr.Bytes()).Balance())
+
+	block, err := bc.NewBlock(&Address{[]byte("012345678901234567890000")})
+	assert.Nil(t, err)
+	_, err = block.Clone()
+	assert.Equal(t, ErrCloneUnsealedBlock, err)
+}
+
+func TestBlockGasLimit(t *testing.T) {
+	var cons MockConsensus
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(cons)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000000))
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		tx := NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128FromInt(1), nonce, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, bc.txPool.Push(tx))
+	}
+	full, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	full.header.timestamp = BlockInterval
+	full.CollectTransactions(2)
+	full.SetMiner(coinbase)
+	assert.Nil(t, full.Seal())
+	assert.Equal(t, 2, len(full.transactions))
+	full.ReturnTransactions()
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{BlockGasLimitHeight: full.height, BlockGasLimit: "210000"}
+	assert.Nil(t, CheckForks(conf))
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+	assert.Nil(t, ForksOf(bc.ChainID()).BlockGasLimitAt(full.height-1))
+
+	// the second tx may not fit in the gas left, it's kept in the pool.
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(2)
+	assert.Equal(t, 1, len(block.transactions))
+	assert.False(t, bc.txPool.Empty())
+
+	// no tx is popped once the gas left is below the gas of any tx.
+	pending := len(bc.txPool.all)
+	block.gasUsed = util.NewUint128FromInt(200000)
+	block.CollectTransactions(2)
+	assert.Equal(t, 1, len(block.transactions))
+	assert.Equal(t, pending, len(bc.txPool.all))
+
+	// the blocks using more gas than the limit are rejected.
+	conf.Forks.BlockGasLimit = "30000"
+	RegisterForks(conf)
+	msg, err := full.ToProto()
+	assert.Nil(t, err)
+	received := new(Block)
+	assert.Nil(t, received.FromProto(msg))
+	assert.Nil(t, received.LinkParentBlock(bc.tailBlock))
+	assert.Equal(t, ErrBlockGasLimitExceeded, received.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
+
+	// the txs which may never fit in a block are refused by the pool.
+	tx := NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128FromInt(1), 3, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Equal(t, ErrOutOfGasLimit, bc.txPool.Push(tx))
+
+	for _, limit := range []string{"", "0", "gas", "-1"} {
+		conf.Forks.BlockGasLimit = limit
+		assert.Equal(t, ErrInvalidBlockGasLimit, CheckForks(conf))
+	}
+}
+
+func TestBlock_FromProtoMalformed(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	msg, err := bc.tailBlock.ToProto()
+	assert.Nil(t, err)
+	pbBlock := msg.(*corepb.Block)
+
+	noHeader := *pbBlock
+	noHeader.Header = nil
+	assert.Equal(t, ErrInvalidProtoToBlockHeader, new(Block).FromProto(&noHeader))
+
+	noDposContext := *pbBlock
+	header := *pbBlock.Header
+	header.DposContext = nil
+	noDposContext.Header = &header
+	assert.Equal(t, ErrInvalidProtoToBlockHeader, new(Block).FromProto(&noDposContext))
+
+	noTxData := *pbBlock
+	noTxData.Transactions = []*corepb.Transaction{{Hash: []byte("hash")}}
+	assert.Equal(t, ErrInvalidProtoToTransaction, new(Block).FromProto(&noTxData))
+}
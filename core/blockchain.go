@@ -19,13 +19,17 @@
 package core
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -54,13 +58,51 @@ type BlockChain struct {
 	txPool           *TransactionPool
 	consensusHandler Consensus
 
-	cachedBlocks       *lru.Cache
-	detachedTailBlocks *lru.Cache
+	// cachedBlocks and cachedHeaders cache the blocks loaded from the storage
+	// and their headers, see BlockCacheConfig.
+	cachedBlocks  *lru.Cache
+	cachedHeaders *lru.Cache
+	forkTips      *forkTips
 
 	storage storage.Storage
 	neb     Neblet
 
-	eventEmitter *EventEmitter
+	// blockStorage and indexStorage are views of storage recording metrics under their own namespace.
+	blockStorage storage.Storage
+	indexStorage storage.Storage
+
+	eventEmitter  *EventEmitter
+	filterManager *FilterManager
+	watchList     *WatchList
+	depositLedger *DepositLedger
+	abiRegistry   *ABIRegistry
+
+	// heightIndexLock makes the height index, the tail and the verified floor
+	// change together, readers never see an index half way through a reorg.
+	heightIndexLock sync.Mutex
+	// heightIndexFloor is the lowest height from which up to the tail the
+	// height index has been verified against the canonical chain.
+	heightIndexFloor uint64
+
+	// initialSupply is the balance distributed in the genesis, summed once.
+	initialSupplyOnce sync.Once
+	initialSupply     *util.Uint128
+	initialSupplyErr  error
+
+	// estimates runs the gas estimations of the rpc.
+	estimates *EstimatePool
+
+	gasPrices *gasPriceOracle
+
+	// statePruner deletes the old states, nil if the pruning isn't enabled.
+	statePruner *StatePruner
+
+	// analytics maintains the daily aggregates, nil if they aren't enabled.
+	analytics *Analytics
+
+	// lib is the latest irreversible block, it only moves up along the canonical chain.
+	libLock sync.RWMutex
+	lib     *Block
 }
 
 const (
@@ -72,6 +114,12 @@ const (
 
 	// Tail Key in storage
 	Tail = "blockchain_tail"
+
+	// LIB Key of the latest irreversible block in storage
+	LIB = "blockchain_lib"
+
+	// heightIndexBatchSize is the number of headers verified under one hold of heightIndexLock.
+	heightIndexBatchSize = 1024
 )
 
 var (
@@ -94,18 +142,29 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		return nil, err
 	}
 
+	if err := CheckForks(neb.Genesis()); err != nil {
+		return nil, err
+	}
+	RegisterForks(neb.Genesis())
+
 	var bc = &BlockChain{
 		chainID:      neb.Genesis().Meta.ChainId,
 		genesis:      neb.Genesis(),
 		bkPool:       blockPool,
 		txPool:       txPool,
+		estimates:    NewEstimatePool(DefaultEstimatePoolConfig()),
+		gasPrices:    &gasPriceOracle{blocks: DefaultGasPriceBlocks},
 		storage:      neb.Storage(),
+		blockStorage: storage.WithNamespace(neb.Storage(), storage.NamespaceBlocks),
+		indexStorage: storage.WithNamespace(neb.Storage(), storage.NamespaceIndex),
 		neb:          neb,
 		eventEmitter: neb.EventEmitter(),
 	}
 
-	bc.cachedBlocks, _ = lru.New(1024)
-	bc.detachedTailBlocks, _ = lru.New(64)
+	if err := bc.SetBlockCacheConfig(DefaultBlockCacheConfig()); err != nil {
+		return nil, err
+	}
+	bc.forkTips = newForkTips(bc.indexStorage)
 
 	bc.genesisBlock, err = bc.loadGenesisFromStorage()
 	if err != nil {
@@ -121,16 +180,29 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		"token.distribution":     genesisConf.TokenDistribution,
 	}).Info("Genesis Configuration.")
 
-	bc.tailBlock, err = bc.loadTailFromStorage()
-	if err != nil {
+	// a process killed while writing may leave the tail and the index apart.
+	if err := bc.RepairIndex(); err != nil {
 		return nil, err
 	}
 	logging.CLog().WithFields(logrus.Fields{
 		"block": bc.tailBlock,
 	}).Info("Tail Block.")
 
+	bc.lib, err = bc.loadLIBFromStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bc.forkTips.load(bc.GetBlock); err != nil {
+		return nil, err
+	}
+
 	bc.bkPool.setBlockChain(bc)
 	bc.txPool.setBlockChain(bc)
+	bc.filterManager = NewFilterManager(bc)
+	bc.watchList = NewWatchList(bc)
+	bc.depositLedger = NewDepositLedger(bc)
+	bc.abiRegistry = NewABIRegistry(bc)
 
 	return bc, nil
 }
@@ -165,12 +237,44 @@ func (bc *BlockChain) EventEmitter() *EventEmitter {
 	return bc.eventEmitter
 }
 
-func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
+// FilterManager return the filterManager.
+func (bc *BlockChain) FilterManager() *FilterManager {
+	return bc.filterManager
+}
+
+// WatchList return the watchList.
+func (bc *BlockChain) WatchList() *WatchList {
+	return bc.watchList
+}
+
+// DepositLedger return the depositLedger.
+func (bc *BlockChain) DepositLedger() *DepositLedger {
+	return bc.depositLedger
+}
+
+// ABIRegistry return the abiRegistry.
+func (bc *BlockChain) ABIRegistry() *ABIRegistry {
+	return bc.abiRegistry
+}
+
+func (bc *BlockChain) revertBlocks(batch *storage.Batch, from *Block, to *Block) error {
 	reverted := to
 	var revertTimes int64
 	for revertTimes = 0; !reverted.Hash().Equals(from.Hash()); {
 		// TODO(roy): delete blocks from storage
 		reverted.ReturnTransactions()
+		if err := bc.unindexTransactions(batch, reverted); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": reverted,
+				"err":   err,
+			}).Warn("Failed to remove the transaction index of reverted block.")
+		}
+		if bc.eventEmitter != nil {
+			bc.eventEmitter.Trigger(&Event{
+				Topic: TopicRevertBlock,
+				Data:  reverted.Hash().String(),
+			})
+		}
 		logging.VLog().WithFields(logrus.Fields{
 			"block": reverted,
 		}).Warn("Succeed to revert block.")
@@ -189,12 +293,10 @@ func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
 	return nil
 }
 
-func (bc *BlockChain) buildIndexByBlockHeight(from *Block, to *Block) error {
+func (bc *BlockChain) buildIndexByBlockHeight(batch *storage.Batch, from *Block, to *Block) error {
 	for !to.Hash().Equals(from.Hash()) {
-		err := bc.storage.Put(byteutils.FromUint64(to.height), to.Hash())
-		if err != nil {
-			return err
-		}
+		batch.Put(byteutils.FromUint64(to.height), to.Hash())
+		bc.indexTransactions(batch, to)
 		to = bc.GetBlock(to.header.parentHash)
 		if to == nil {
 			return ErrMissingParentBlock
@@ -203,10 +305,20 @@ func (bc *BlockChain) buildIndexByBlockHeight(from *Block, to *Block) error {
 	return nil
 }
 
+// pruneIndexByBlockHeight removes the index in (from, to], which is left by the abandoned fork.
+func (bc *BlockChain) pruneIndexByBlockHeight(batch *storage.Batch, from uint64, to uint64) {
+	for height := from + 1; height <= to; height++ {
+		batch.Del(byteutils.FromUint64(height))
+	}
+}
+
 // SetTailBlock set tail block.
 func (bc *BlockChain) SetTailBlock(newTail *Block) error {
+	bc.heightIndexLock.Lock()
+	defer bc.heightIndexLock.Unlock()
+
 	oldTail := bc.tailBlock
-	ancestor, err := bc.FindCommonAncestorWithTail(newTail)
+	ancestor, err := bc.findCommonAncestorWithTail(context.Background(), newTail)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"target": newTail,
@@ -214,7 +326,13 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 		}).Error("Failed to find common ancestor with tail")
 		return err
 	}
-	if err := bc.revertBlocks(ancestor, oldTail); err != nil {
+	if err := bc.checkReorgDepth(ancestor); err != nil {
+		return err
+	}
+	// the index and the tail are committed together in a batch, a crash
+	// never leaves the index of a half switched fork.
+	batch := storage.NewBatch()
+	if err := bc.revertBlocks(batch, ancestor, oldTail); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"from":  ancestor,
 			"to":    oldTail,
@@ -223,7 +341,7 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 		// the errors can be skipped
 	}
 	// build index by block height
-	if err := bc.buildIndexByBlockHeight(ancestor, newTail); err != nil {
+	if err := bc.buildIndexByBlockHeight(batch, ancestor, newTail); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"from":  ancestor,
 			"to":    newTail,
@@ -231,72 +349,174 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 		}).Error("Failed to build index by block height.")
 		return err
 	}
+	// remove the index of abandoned fork higher than new tail
+	bc.pruneIndexByBlockHeight(batch, newTail.height, oldTail.height)
 	// record new tail
-	if err := bc.storeTailToStorage(newTail); err != nil {
+	batch.Put([]byte(Tail), newTail.Hash())
+	if err := bc.indexStorage.WriteBatch(batch); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":   newTail,
+			"writes": batch.Len(),
+			"err":    err,
+		}).Error("Failed to write the index of the new tail.")
 		return err
 	}
 	bc.tailBlock = newTail
+	// index in (ancestor, newTail] is rebuilt, the verified part below stays valid
+	if bc.heightIndexFloor > ancestor.height+1 {
+		bc.heightIndexFloor = ancestor.height + 1
+	}
 	blockHeightGauge.Update(int64(newTail.Height()))
 	blocktailHashGauge.Update(int64(byteutils.HashBytes(newTail.Hash())))
+	bc.updateLIB(newTail)
+	if !ancestor.Hash().Equals(oldTail.Hash()) {
+		bc.triggerReorgEvent(oldTail, newTail, ancestor)
+	}
+	bc.triggerNewTailEvents(ancestor, newTail)
 	return nil
 }
 
-// FindCommonAncestorWithTail return the block's common ancestor with current tail
-func (bc *BlockChain) FindCommonAncestorWithTail(block *Block) (*Block, error) {
-	tail := bc.TailBlock()
+// ReorgEvent is the data of a TopicReorg event, the txs of the reverted blocks
+// lose their confirmations, they may be packed again in the new fork.
+type ReorgEvent struct {
+	OldTail     string   `json:"old_tail"`
+	NewTail     string   `json:"new_tail"`
+	Ancestor    string   `json:"ancestor"`
+	RevertedTxs []string `json:"reverted_txs"`
+}
+
+// triggerReorgEvent triggers the switch of the canonical chain from oldTail to the fork of newTail.
+func (bc *BlockChain) triggerReorgEvent(oldTail, newTail, ancestor *Block) {
+	if bc.eventEmitter == nil {
+		return
+	}
+	e := &ReorgEvent{
+		OldTail:     oldTail.Hash().String(),
+		NewTail:     newTail.Hash().String(),
+		Ancestor:    ancestor.Hash().String(),
+		RevertedTxs: []string{},
+	}
+	for block := oldTail; block != nil && !block.Hash().Equals(ancestor.Hash()); block = bc.GetBlock(block.header.parentHash) {
+		for _, tx := range block.transactions {
+			e.RevertedTxs = append(e.RevertedTxs, tx.hash.String())
+		}
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"event": e,
+			"err":   err,
+		}).Error("Failed to marshal the reorg event.")
+		return
+	}
+	bc.eventEmitter.Trigger(&Event{
+		Topic: TopicReorg,
+		Data:  string(data),
+	})
+}
+
+// triggerNewTailEvents triggers the blocks in (from, to] joining the canonical chain in height order.
+func (bc *BlockChain) triggerNewTailEvents(from *Block, to *Block) {
+	if bc.eventEmitter == nil {
+		return
+	}
+	blocks := []*Block{}
+	for block := to; block != nil && !block.Hash().Equals(from.Hash()); block = bc.GetBlock(block.header.parentHash) {
+		blocks = append(blocks, block)
+	}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		bc.eventEmitter.Trigger(&Event{
+			Topic: TopicNewTailBlock,
+			Data:  blocks[i].Hash().String(),
+		})
+	}
+}
+
+// FindCommonAncestorWithTail return the block's common ancestor with current tail,
+// the search stops when ctx is done.
+func (bc *BlockChain) FindCommonAncestorWithTail(ctx context.Context, block *Block) (*Block, error) {
+	bc.heightIndexLock.Lock()
+	defer bc.heightIndexLock.Unlock()
+
+	return bc.findCommonAncestorWithTail(ctx, block)
+}
+
+func (bc *BlockChain) findCommonAncestorWithTail(ctx context.Context, block *Block) (*Block, error) {
 	// fast check if the block is an ancestor of current tail
-	if tail.height >= block.height {
-		localBlock := bc.GetBlockByHeight(block.height)
-		if localBlock != nil && localBlock.Hash().Equals(block.Hash()) {
+	if bc.tailBlock.height >= block.height {
+		local, err := bc.getHeaderByHeight(block.height)
+		if err == nil && local.Hash().Equals(block.Hash()) {
 			return block, nil
 		}
 	}
 	// check if the block can be found in local storage
-	// if existed, then find the common ancestor
-	target := bc.GetBlock(block.Hash())
-	if target == nil {
-		target = bc.GetBlock(block.ParentHash())
+	// if existed, then find the common ancestor walking the headers only
+	target, err := bc.GetBlockHeader(block.Hash())
+	if err != nil {
+		target, err = bc.GetBlockHeader(block.ParentHash())
 	}
-	if target == nil {
+	if err != nil {
 		return nil, ErrMissingParentBlock
 	}
-	for tail.Height() > target.Height() {
-		tail = bc.GetBlock(tail.header.parentHash)
-		if tail == nil {
+	tail := newChainHeader(bc.tailBlock)
+	parent := func(h *ChainHeader) (*ChainHeader, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		p, err := bc.GetBlockHeader(h.ParentHash())
+		if err != nil {
 			return nil, ErrMissingParentBlock
 		}
+		return p, nil
+	}
+	for tail.Height() > target.Height() {
+		if tail, err = parent(tail); err != nil {
+			return nil, err
+		}
 	}
 	for tail.Height() < target.Height() {
-		target = bc.GetBlock(target.header.parentHash)
-		if target == nil {
-			return nil, ErrMissingParentBlock
+		if target, err = parent(target); err != nil {
+			return nil, err
 		}
 	}
 	for !tail.Hash().Equals(target.Hash()) {
-		tail = bc.GetBlock(tail.header.parentHash)
-		target = bc.GetBlock(target.header.parentHash)
-		if tail == nil || target == nil {
-			return nil, ErrMissingParentBlock
+		if tail, err = parent(tail); err != nil {
+			return nil, err
 		}
+		if target, err = parent(target); err != nil {
+			return nil, err
+		}
+	}
+	ancestor := bc.GetBlock(target.Hash())
+	if ancestor == nil {
+		return nil, ErrMissingParentBlock
 	}
-	return target, nil
+	return ancestor, nil
 }
 
 // FetchDescendantInCanonicalChain return the subsequent blocks of the block
-func (bc *BlockChain) FetchDescendantInCanonicalChain(n int, block *Block) ([]*Block, error) {
+func (bc *BlockChain) FetchDescendantInCanonicalChain(ctx context.Context, n int, block *Block) ([]*Block, error) {
+	if err := bc.lockHeightIndex(block.height + 1); err != nil {
+		return nil, err
+	}
+	defer bc.heightIndexLock.Unlock()
+
 	// get tail in canonical chain
 	curHeight := block.height + 1
 	tailHeight := bc.tailBlock.height
 	index := uint64(0)
 	res := []*Block{}
 	for curHeight+index <= tailHeight && index < uint64(n) {
-		block := bc.GetBlockByHeight(curHeight + index)
-		if block == nil {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block, err := bc.getBlockByHeight(curHeight + index)
+		if err != nil {
 			logging.VLog().WithFields(logrus.Fields{
-				"err":    ErrCannotFindBlockAtGivenHeight,
+				"err":    err,
 				"height": strconv.Itoa(int(curHeight + index)),
 			}).Error("Failed to fetch descendant.")
-			return nil, ErrCannotFindBlockAtGivenHeight
+			return nil, err
 		}
 		res = append(res, block)
 		index++
@@ -334,13 +554,21 @@ func (bc *BlockChain) NewBlockFromParent(coinbase *Address, parentBlock *Block)
 	return NewBlock(bc.chainID, coinbase, parentBlock)
 }
 
-// PutVerifiedNewBlocks put verified new blocks and tails.
+// PutVerifiedNewBlocks put verified new blocks and tails, the blocks are
+// written in a batch.
 func (bc *BlockChain) putVerifiedNewBlocks(parent *Block, allBlocks, tailBlocks []*Block) error {
+	batch := storage.NewBatch()
 	for _, v := range allBlocks {
-		bc.cachedBlocks.ContainsOrAdd(v.Hash().Hex(), v)
-		if err := bc.storeBlockToStorage(v); err != nil {
+		if err := bc.putBlock(batch, v); err != nil {
 			return err
 		}
+	}
+	if err := bc.blockStorage.WriteBatch(batch); err != nil {
+		return err
+	}
+
+	for _, v := range allBlocks {
+		bc.cacheBlock(v)
 
 		logging.CLog().WithFields(logrus.Fields{
 			"block": v,
@@ -351,55 +579,142 @@ func (bc *BlockChain) putVerifiedNewBlocks(parent *Block, allBlocks, tailBlocks
 			txOnchainTimer.Update(time.Duration(time.Now().Unix() - tx.Timestamp()))
 		}
 	}
-	for _, v := range tailBlocks {
-		bc.detachedTailBlocks.ContainsOrAdd(v.Hash().Hex(), v)
+	return bc.forkTips.update(parent, tailBlocks)
+}
+
+// DetachedTailBlocks return the fork tips not expired, used by Fork Choice algorithm.
+func (bc *BlockChain) DetachedTailBlocks() []*Block {
+	tips, err := bc.forkTips.expire(bc.TailBlock(), bc.Checkpoint())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to persist fork tips.")
 	}
+	return tips
+}
 
-	bc.detachedTailBlocks.Remove(parent.Hash().Hex())
+// GetBlock return block of given hash from the block cache or the local
+// storage, the block loaded from the storage is cached.
+func (bc *BlockChain) GetBlock(hash byteutils.Hash) *Block {
+	if v, ok := bc.cachedBlocks.Get(hash.Hex()); ok {
+		blockCacheHitCounter.Inc(1)
+		return v.(*Block)
+	}
+	blockCacheMissCounter.Inc(1)
+	block, err := LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+	if err != nil {
+		return nil
+	}
+	bc.cacheBlock(block)
+	return block
+}
 
-	return nil
+// GetBlockByHeight return block in given height, the block is guaranteed in canonical chain.
+func (bc *BlockChain) GetBlockByHeight(height uint64) (*Block, error) {
+	if err := bc.lockHeightIndex(height); err != nil {
+		return nil, err
+	}
+	defer bc.heightIndexLock.Unlock()
+
+	return bc.getBlockByHeight(height)
 }
 
-// DetachedTailBlocks return detached tail blocks, used by Fork Choice algorithm.
-func (bc *BlockChain) DetachedTailBlocks() []*Block {
-	ret := make([]*Block, 0)
-	for _, k := range bc.detachedTailBlocks.Keys() {
-		v, _ := bc.detachedTailBlocks.Get(k)
-		if v != nil {
-			block := v.(*Block)
-			ret = append(ret, block)
-		}
+func (bc *BlockChain) getBlockByHeight(height uint64) (*Block, error) {
+	if height < bc.genesisBlock.height {
+		return nil, ErrInvalidBlockHeight
+	}
+	if height > bc.tailBlock.height {
+		return nil, ErrCannotFindBlockAtGivenHeight
+	}
+	if height < bc.heightIndexFloor {
+		return nil, ErrHeightIndexNotVerified
 	}
-	return ret
+	block := bc.getBlockFromHeightIndex(height)
+	if block == nil || block.height != height {
+		return nil, ErrCannotFindBlockAtGivenHeight
+	}
+	return block, nil
 }
 
-// GetBlock return block of given hash from local storage and detachedBlocks.
-func (bc *BlockChain) GetBlock(hash byteutils.Hash) *Block {
-	// TODO: get block from local storage.
-	v, _ := bc.cachedBlocks.Get(hash.Hex())
-	if v == nil {
-		block, err := LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+func (bc *BlockChain) getBlockFromHeightIndex(height uint64) *Block {
+	blockHash, err := bc.indexStorage.Get(byteutils.FromUint64(height))
+	if err != nil {
+		return nil
+	}
+	return bc.GetBlock(blockHash)
+}
+
+// lockHeightIndex takes heightIndexLock once the height index is verified
+// down to the height. The index is verified in batches of
+// heightIndexBatchSize headers and the lock is released between them, a deep
+// lookup doesn't stall the tail for the whole walk.
+func (bc *BlockChain) lockHeightIndex(height uint64) error {
+	bc.heightIndexLock.Lock()
+	for height < bc.heightIndexFloor && height <= bc.tailBlock.height && height >= bc.genesisBlock.height {
+		err := bc.lowerHeightIndexFloor(heightIndexBatchSize)
+		bc.heightIndexLock.Unlock()
 		if err != nil {
-			return nil
+			logging.VLog().WithFields(logrus.Fields{
+				"height": height,
+				"floor":  bc.heightIndexFloor,
+				"err":    err,
+			}).Error("Failed to verify height index.")
+			return err
 		}
-		return block
+		bc.heightIndexLock.Lock()
 	}
+	return nil
+}
 
-	block := v.(*Block)
-	return block
+// lowerHeightIndexFloor walks at most n headers down from the verified floor,
+// rewriting the index entries left by an abandoned fork and lowering the floor.
+func (bc *BlockChain) lowerHeightIndexFloor(n int) error {
+	var cur *ChainHeader
+	if bc.heightIndexFloor > bc.tailBlock.height {
+		cur = newChainHeader(bc.tailBlock)
+	} else {
+		cur = bc.getHeaderFromHeightIndex(bc.heightIndexFloor)
+		if cur == nil {
+			return ErrCannotFindBlockAtGivenHeight
+		}
+	}
+	for i := 0; ; i++ {
+		if !bc.checkHeightIndex(cur.Hash(), cur.height) {
+			if err := bc.indexStorage.Put(byteutils.FromUint64(cur.height), cur.Hash()); err != nil {
+				return err
+			}
+			logging.VLog().WithFields(logrus.Fields{
+				"height": cur.height,
+				"hash":   cur.Hash(),
+			}).Warn("Repaired stale height index.")
+		}
+		bc.heightIndexFloor = cur.height
+		if i >= n || cur.height <= bc.genesisBlock.height {
+			return nil
+		}
+		parent, err := bc.GetBlockHeader(cur.ParentHash())
+		if err != nil {
+			return ErrMissingParentBlock
+		}
+		cur = parent
+	}
 }
 
-// GetBlockByHeight return block in given height
-func (bc *BlockChain) GetBlockByHeight(height uint64) *Block {
-	blockHash, err := bc.storage.Get(byteutils.FromUint64(height))
+func (bc *BlockChain) checkHeightIndex(hash byteutils.Hash, height uint64) bool {
+	blockHash, err := bc.indexStorage.Get(byteutils.FromUint64(height))
 	if err != nil {
-		return nil
+		return false
 	}
-	return bc.GetBlock(blockHash)
+	return hash.Equals(blockHash)
 }
 
 // GetTransaction return transaction of given hash from local storage.
 func (bc *BlockChain) GetTransaction(hash byteutils.Hash) *Transaction {
+	if block, index, err := bc.GetTransactionLocation(hash); err == nil {
+		return block.transactions[index]
+	}
+	// the transactions of a chain synced before the index are found in the
+	// tail until it is reindexed.
 	// TODO: get transaction err handle.
 	tx, err := bc.tailBlock.GetTransaction(hash)
 	if err != nil {
@@ -408,87 +723,166 @@ func (bc *BlockChain) GetTransaction(hash byteutils.Hash) *Transaction {
 	return tx
 }
 
-// GasPrice returns the lowest transaction gas price.
-func (bc *BlockChain) GasPrice() *util.Uint128 {
-	gasPrice := TransactionMaxGasPrice
-	tailBlock := bc.tailBlock
-	for {
-		// if the block is genesis, stop find the parent block
-		if CheckGenesisBlock(tailBlock) {
-			break
-		}
+// GetAccountNextNonce returns the nonce of the next tx of the address, after
+// its txs on chain and the ones in the pool following them without a gap.
+func (bc *BlockChain) GetAccountNextNonce(addr *Address) (next uint64, onchain uint64) {
+	onchain = bc.TailBlock().GetNonce(addr.Bytes())
+	return bc.txPool.LastContiguousNonce(addr, onchain) + 1, onchain
+}
 
-		if len(tailBlock.transactions) > 0 {
-			break
-		}
-		tailBlock = bc.GetBlock(tailBlock.ParentHash())
-	}
+// SetEstimatePool sets the pool running the gas estimations.
+func (bc *BlockChain) SetEstimatePool(pool *EstimatePool) {
+	bc.estimates = pool
+}
 
-	if len(tailBlock.transactions) > 0 {
-		for _, tx := range tailBlock.transactions {
-			if tx.gasPrice.Cmp(gasPrice.Int) < 0 {
-				gasPrice = tx.gasPrice
-			}
-		}
-	} else {
-		// if no transactions have been submited, use the default gasPrice
-		gasPrice = TransactionGasPrice
+// EstimateGas returns the transaction gas cost, the execution is terminated
+// when ctx is done or the limits of the estimate pool are reached.
+func (bc *BlockChain) EstimateGas(ctx context.Context, tx *Transaction) (gas *util.Uint128, err error) {
+	err = bc.estimates.Run(ctx, func(ctx context.Context) error {
+		gas, err = bc.estimateGas(ctx, tx, bc.estimates.conf.MaxGas)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return gas, nil
+}
 
-	return gasPrice
+// estimateGas executes the tx on a clone of the tail block, the chain state
+// is never changed by the estimation and estimations run concurrently.
+func (bc *BlockChain) estimateGas(ctx context.Context, tx *Transaction, maxGas *util.Uint128) (*util.Uint128, error) {
+	gas, _, err := bc.executeOnTail(ctx, tx, maxGas)
+	return gas, err
 }
 
-// EstimateGas returns the transaction gas cost
-func (bc *BlockChain) EstimateGas(tx *Transaction) (*util.Uint128, error) {
+// executeOnTail executes the tx on a clone of the tail block at maxGas, the
+// sender is funded for the gas and the value. It returns the gas and the
+// receipt of the execution.
+func (bc *BlockChain) executeOnTail(ctx context.Context, tx *Transaction, maxGas *util.Uint128) (*util.Uint128, *TransactionReceipt, error) {
 	// update gas to max for estimate
-	tx.gasLimit = TransactionMaxGas
+	tx.gasLimit = maxGas
+
+	block, err := bc.TailBlock().Clone()
+	if err != nil {
+		return nil, nil, err
+	}
+	block.begin()
+	defer block.rollback()
 
-	bc.tailBlock.accState.BeginBatch()
-	fromAcc := bc.tailBlock.accState.GetOrCreateUserAccount(tx.from.address)
+	fromAcc := block.accState.GetOrCreateUserAccount(tx.from.address)
 	fromAcc.AddBalance(tx.MinBalanceRequired())
 	fromAcc.AddBalance(tx.value)
-	defer bc.tailBlock.accState.RollBack()
-	return tx.VerifyExecution(bc.tailBlock)
-}
-
-// Dump dump full chain.
-func (bc *BlockChain) Dump(count int) string {
-	rl := []string{}
-	block := bc.tailBlock
-	rl = append(rl, block.String())
-	for i := 1; i < count; i++ {
-		if !CheckGenesisBlock(block) {
-			block = bc.GetBlock(block.ParentHash())
-			rl = append(rl, block.String())
-		}
+
+	gas, err := tx.verifyExecution(ctx, block)
+	if err != nil {
+		return nil, nil, err
+	}
+	// a terminated execution is recorded as failure, the gas is meaningless
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
 	}
+	return gas, block.receipts[tx.hash.Hex()], nil
+}
 
-	rls := "[" + strings.Join(rl, ",") + "]"
-	return rls
+// SimulateResult is the outcome of a tx executed on the tail state.
+type SimulateResult struct {
+	// Result is the json of the value returned by the contract function
+	// called, empty for the other txs or if nothing is returned.
+	Result string
+	Gas    *util.Uint128
+	// Err is the error of a failed execution, empty if it succeeded.
+	Err string
 }
 
-func (bc *BlockChain) storeBlockToStorage(block *Block) error {
-	pbBlock, err := block.ToProto()
+// SimulateTransaction executes the tx on a clone of the tail block like
+// EstimateGas, and returns the value returned by the contract function called,
+// so the view functions of the contracts are read without a tx on chain. The
+// returned value is passed back by a few instructions more than the tx on
+// chain executes, which are counted in the gas.
+func (bc *BlockChain) SimulateTransaction(ctx context.Context, tx *Transaction) (*SimulateResult, error) {
+	result := &nvm.CallResult{}
+	var gas *util.Uint128
+	var receipt *TransactionReceipt
+	err := bc.estimates.Run(ctx, func(ctx context.Context) (err error) {
+		gas, receipt, err = bc.executeOnTail(nvm.NewResultContext(ctx, result), tx, bc.estimates.conf.MaxGas)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	simulated := &SimulateResult{Result: result.Value, Gas: gas}
+	if receipt != nil {
+		simulated.Err = receipt.Error
+	}
+	return simulated, nil
+}
+
+// ProfileGas estimates the gas of the tx like EstimateGas, and returns the
+// profile attributing the gas of the contract execution to its functions.
+func (bc *BlockChain) ProfileGas(ctx context.Context, tx *Transaction) (*util.Uint128, *nvm.Profiler, error) {
+	profiler := nvm.NewProfiler()
+	gas, err := bc.EstimateGas(nvm.NewProfilerContext(ctx, profiler), tx)
 	if err != nil {
+		return nil, nil, err
+	}
+	return gas, profiler, nil
+}
+
+// Dump writes the count blocks of the canonical chain down from the tail
+// to w as a json array, one block at a time.
+func (bc *BlockChain) Dump(ctx context.Context, w io.Writer, count int) error {
+	block := bc.TailBlock()
+	if _, err := io.WriteString(w, "["+block.String()); err != nil {
 		return err
 	}
-	value, err := proto.Marshal(pbBlock)
+	for i := 1; i < count && !CheckGenesisBlock(block); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if block = bc.GetBlock(block.ParentHash()); block == nil {
+			return ErrMissingParentBlock
+		}
+		if _, err := io.WriteString(w, ","+block.String()); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+func (bc *BlockChain) storeBlockToStorage(block *Block) error {
+	batch := storage.NewBatch()
+	if err := bc.putBlock(batch, block); err != nil {
+		return err
+	}
+	if err := bc.blockStorage.WriteBatch(batch); err != nil {
+		return err
+	}
+	bc.refreshCachedBlock(block)
+	return nil
+}
+
+// putBlock adds the writes of the block, its bloom and its receipts to the batch.
+func (bc *BlockChain) putBlock(batch *storage.Batch, block *Block) error {
+	pbBlock, err := block.ToProto()
 	if err != nil {
 		return err
 	}
-	err = bc.storage.Put(block.Hash(), value)
+	value, err := proto.Marshal(pbBlock)
 	if err != nil {
 		return err
 	}
-	return nil
+	batch.Put(block.Hash(), value)
+	putBloom(batch, block)
+	return bc.putReceipts(batch, block)
 }
 
 func (bc *BlockChain) storeTailToStorage(block *Block) error {
-	return bc.storage.Put([]byte(Tail), block.Hash())
+	return bc.indexStorage.Put([]byte(Tail), block.Hash())
 }
 
 func (bc *BlockChain) loadTailFromStorage() (*Block, error) {
-	hash, err := bc.storage.Get([]byte(Tail))
+	hash, err := bc.indexStorage.Get([]byte(Tail))
 	if err != nil && err != storage.ErrKeyNotFound {
 		return nil, err
 	}
@@ -520,7 +914,7 @@ func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
 			return nil, err
 		}
 		heightKey := byteutils.FromUint64(genesis.height)
-		if err := bc.storage.Put(heightKey, genesis.Hash()); err != nil {
+		if err := bc.indexStorage.Put(heightKey, genesis.Hash()); err != nil {
 			return nil, err
 		}
 	} else {
@@ -19,6 +19,10 @@
 package core
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +30,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -147,26 +152,33 @@ func TestBlockChain_FindCommonAncestorWithTail(t *testing.T) {
 			coinbase: &Address{},
 		},
 	}
-	_, err := bc.FindCommonAncestorWithTail(BlockFromNetwork(test))
+	_, err := bc.FindCommonAncestorWithTail(context.Background(), BlockFromNetwork(test))
 	assert.Equal(t, err, ErrMissingParentBlock)
-	common1, err := bc.FindCommonAncestorWithTail(BlockFromNetwork(block1111))
+	common1, err := bc.FindCommonAncestorWithTail(context.Background(), BlockFromNetwork(block1111))
 	assert.Nil(t, err)
 	assert.Equal(t, BlockFromNetwork(common1), BlockFromNetwork(block0))
-	common2, err := bc.FindCommonAncestorWithTail(BlockFromNetwork(block221))
+	common2, err := bc.FindCommonAncestorWithTail(context.Background(), BlockFromNetwork(block221))
 	assert.Nil(t, err)
 	assert.Equal(t, BlockFromNetwork(common2), BlockFromNetwork(block12))
-	common3, err := bc.FindCommonAncestorWithTail(BlockFromNetwork(block222))
+	common3, err := bc.FindCommonAncestorWithTail(context.Background(), BlockFromNetwork(block222))
 	assert.Nil(t, err)
 	assert.Equal(t, BlockFromNetwork(common3), BlockFromNetwork(block222))
-	common4, err := bc.FindCommonAncestorWithTail(BlockFromNetwork(bc.tailBlock))
+	common4, err := bc.FindCommonAncestorWithTail(context.Background(), BlockFromNetwork(bc.tailBlock))
 	assert.Nil(t, err)
 	assert.Equal(t, BlockFromNetwork(common4), BlockFromNetwork(bc.tailBlock))
-	common5, err := bc.FindCommonAncestorWithTail(BlockFromNetwork(block12))
+	common5, err := bc.FindCommonAncestorWithTail(context.Background(), BlockFromNetwork(block12))
 	assert.Nil(t, err)
 	assert.Equal(t, BlockFromNetwork(common5), BlockFromNetwork(block12))
 
-	result := bc.Dump(4)
-	assert.Equal(t, result, "["+block222.String()+","+block12.String()+","+block0.String()+","+bc.genesisBlock.String()+"]")
+	result := new(bytes.Buffer)
+	assert.Nil(t, bc.Dump(context.Background(), result, 4))
+	assert.Equal(t, result.String(), "["+block222.String()+","+block12.String()+","+block0.String()+","+bc.genesisBlock.String()+"]")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bc.FindCommonAncestorWithTail(ctx, BlockFromNetwork(block1111))
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, context.Canceled, bc.Dump(ctx, new(bytes.Buffer), 4))
 
 }
 
@@ -206,22 +218,232 @@ func TestBlockChain_FetchDescendantInCanonicalChain(t *testing.T) {
 		bc.BlockPool().Push(block)
 		bc.SetTailBlock(block)
 	}
-	blocks24, _ := bc.FetchDescendantInCanonicalChain(3, blocks[0])
+	blocks24, _ := bc.FetchDescendantInCanonicalChain(context.Background(), 3, blocks[0])
 	assert.Equal(t, BlockFromNetwork(blocks24[0]), BlockFromNetwork(blocks[1]))
 	assert.Equal(t, BlockFromNetwork(blocks24[1]), BlockFromNetwork(blocks[2]))
 	assert.Equal(t, BlockFromNetwork(blocks24[2]), BlockFromNetwork(blocks[3]))
-	blocks46, _ := bc.FetchDescendantInCanonicalChain(10, blocks[2])
+	blocks46, _ := bc.FetchDescendantInCanonicalChain(context.Background(), 10, blocks[2])
 	assert.Equal(t, len(blocks46), 3)
 	assert.Equal(t, BlockFromNetwork(blocks46[0]), BlockFromNetwork(blocks[3]))
 	assert.Equal(t, BlockFromNetwork(blocks46[1]), BlockFromNetwork(blocks[4]))
 	assert.Equal(t, BlockFromNetwork(blocks46[2]), BlockFromNetwork(blocks[5]))
-	blocks13, _ := bc.FetchDescendantInCanonicalChain(3, bc.genesisBlock)
+	blocks13, _ := bc.FetchDescendantInCanonicalChain(context.Background(), 3, bc.genesisBlock)
 	assert.Equal(t, len(blocks13), 3)
-	blocks0, err0 := bc.FetchDescendantInCanonicalChain(3, blocks[5])
+	blocks0, err0 := bc.FetchDescendantInCanonicalChain(context.Background(), 3, blocks[5])
 	assert.Equal(t, len(blocks0), 0)
 	assert.Nil(t, err0)
 }
 
+func TestBlockChain_GetBlockByHeight(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	/*
+		genesis -- 1 - 2 - 3
+		        \_ fork
+	*/
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		bc.SetTailBlock(block)
+		blocks = append(blocks, block)
+	}
+	for i, v := range blocks {
+		block, err := bc.GetBlockByHeight(uint64(i + 2))
+		assert.Nil(t, err)
+		assert.Equal(t, v.Hash(), block.Hash())
+	}
+
+	fork, _ := bc.NewBlockFromParent(coinbase, bc.genesisBlock)
+	fork.header.timestamp = BlockInterval * 4
+	fork.CollectTransactions(0)
+	fork.SetMiner(coinbase)
+	fork.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(fork)))
+	assert.Nil(t, bc.SetTailBlock(fork))
+
+	// index of the abandoned fork higher than the tail is removed
+	block, err := bc.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	assert.Equal(t, fork.Hash(), block.Hash())
+	_, err = bc.GetBlockByHeight(3)
+	assert.Equal(t, ErrCannotFindBlockAtGivenHeight, err)
+	_, err = bc.GetBlockByHeight(0)
+	assert.Equal(t, ErrInvalidBlockHeight, err)
+
+	// a stale index left by an interrupted reorg isn't trusted until verified
+	assert.Nil(t, bc.SetTailBlock(blocks[2]))
+	assert.Nil(t, bc.storage.Put(byteutils.FromUint64(2), fork.Hash()))
+	bc.heightIndexFloor = bc.tailBlock.height + 1
+	_, err = bc.getBlockByHeight(2)
+	assert.Equal(t, ErrHeightIndexNotVerified, err)
+
+	// and is rewritten by the verification
+	block, err = bc.GetBlockByHeight(3)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[1].Hash(), block.Hash())
+	block, err = bc.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[0].Hash(), block.Hash())
+}
+
+func TestBlockChain_LowerHeightIndexFloor(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	parent := bc.genesisBlock
+	var blocks []*Block
+	for i := 0; i < 5; i++ {
+		block, _ := bc.NewBlockFromParent(coinbase, parent)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		blocks = append(blocks, block)
+		parent = block
+	}
+	assert.Equal(t, blocks[4].Hash(), bc.TailBlock().Hash())
+
+	// each batch walks a bounded number of headers
+	bc.heightIndexFloor = bc.tailBlock.height + 1
+	assert.Nil(t, bc.lowerHeightIndexFloor(2))
+	assert.Equal(t, bc.tailBlock.height-2, bc.heightIndexFloor)
+	assert.Nil(t, bc.lowerHeightIndexFloor(2))
+	assert.Equal(t, bc.tailBlock.height-4, bc.heightIndexFloor)
+
+	// the floor stops at the genesis
+	assert.Nil(t, bc.lowerHeightIndexFloor(heightIndexBatchSize))
+	assert.Equal(t, bc.genesisBlock.height, bc.heightIndexFloor)
+}
+
+func TestBlockChain_NewTailEvents(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	bc.eventEmitter.Start()
+	defer bc.eventEmitter.Stop()
+	ch := make(chan *Event, 16)
+	bc.eventEmitter.Register(TopicNewTailBlock, ch)
+
+	/*
+		genesis -- 1 - 2
+		        \_ fork
+	*/
+	parent := bc.genesisBlock
+	var blocks []*Block
+	for i := 0; i < 2; i++ {
+		block, _ := bc.NewBlockFromParent(coinbase, parent)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		blocks = append(blocks, block)
+		parent = block
+	}
+	fork, _ := bc.NewBlockFromParent(coinbase, bc.genesisBlock)
+	fork.header.timestamp = BlockInterval * 3
+	fork.CollectTransactions(0)
+	fork.SetMiner(coinbase)
+	fork.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(fork)))
+
+	// blocks joining the canonical chain are triggered in height order.
+	assert.Nil(t, bc.SetTailBlock(blocks[1]))
+	assert.Nil(t, bc.SetTailBlock(fork))
+	for _, v := range []*Block{blocks[0], blocks[1], fork} {
+		select {
+		case e := <-ch:
+			assert.Equal(t, v.Hash().String(), e.Data)
+		case <-time.After(time.Second):
+			t.Fatal("missing new tail event")
+		}
+	}
+}
+
+func TestBlockChain_ReorgEvent(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000000))
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+	bc.storeBlockToStorage(bc.tailBlock)
+	genesis := bc.tailBlock
+
+	tx := NewTransaction(bc.ChainID(), from, coinbase, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, bc.txPool.Push(tx))
+
+	bc.eventEmitter.Start()
+	defer bc.eventEmitter.Stop()
+	ch := make(chan *Event, 16)
+	bc.eventEmitter.Register(TopicReorg, ch)
+
+	/*
+		genesis -- 1(tx) - 2
+		        \_ fork
+	*/
+	parent := genesis
+	var blocks []*Block
+	for i := 0; i < 2; i++ {
+		block, _ := bc.NewBlockFromParent(coinbase, parent)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(1)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		blocks = append(blocks, block)
+		parent = block
+	}
+	assert.Equal(t, 1, len(blocks[0].transactions))
+	fork, _ := bc.NewBlockFromParent(coinbase, genesis)
+	fork.header.timestamp = BlockInterval * 3
+	fork.CollectTransactions(0)
+	fork.SetMiner(coinbase)
+	fork.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(fork)))
+
+	// extending the tail is not a reorg.
+	assert.Nil(t, bc.SetTailBlock(blocks[1]))
+	assert.Nil(t, bc.SetTailBlock(fork))
+	select {
+	case e := <-ch:
+		reorg := new(ReorgEvent)
+		assert.Nil(t, json.Unmarshal([]byte(e.Data), reorg))
+		assert.Equal(t, blocks[1].Hash().String(), reorg.OldTail)
+		assert.Equal(t, fork.Hash().String(), reorg.NewTail)
+		assert.Equal(t, genesis.Hash().String(), reorg.Ancestor)
+		assert.Equal(t, []string{tx.Hash().String()}, reorg.RevertedTxs)
+	case <-time.After(time.Second):
+		t.Fatal("missing reorg event")
+	}
+	select {
+	case e := <-ch:
+		t.Fatalf("unexpected reorg event %s", e.Data)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestBlockChain_EstimateGas(t *testing.T) {
 	priv := secp256k1.GeneratePrivateKey()
 	pubdata, _ := priv.PublicKey().Encoded()
@@ -234,8 +456,63 @@ func TestBlockChain_EstimateGas(t *testing.T) {
 	bc, _ := NewBlockChain(testNeb())
 	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(0), 1, TxPayloadBinaryType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
 
-	_, err = bc.EstimateGas(tx)
+	expected, err := bc.EstimateGas(context.Background(), tx)
+	assert.Nil(t, err)
+	// the estimation runs on a copy of the tail state.
+	assert.Equal(t, bc.TailBlock().StateRoot(), bc.TailBlock().accState.RootHash())
+
+	// the estimations run concurrently, each on its own copy.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(0), 1, TxPayloadBinaryType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
+			gas, err := bc.EstimateGas(context.Background(), tx)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, gas)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, bc.TailBlock().StateRoot(), bc.TailBlock().accState.RootHash())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bc.EstimateGas(ctx, tx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestBlockChain_SimulateTransaction(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	from := mockAddress()
+
+	// the sender is funded for the simulation like the estimation.
+	tx := NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	gas, err := bc.EstimateGas(context.Background(), tx)
 	assert.Nil(t, err)
+	result, err := bc.SimulateTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, "", result.Result)
+	assert.Equal(t, "", result.Err)
+	assert.Equal(t, gas, result.Gas)
+
+	// the failed execution is reported in the result.
+	payload, err := NewCallPayload("balanceOf", "").ToBytes()
+	assert.Nil(t, err)
+	tx = NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128(), 1, TxPayloadCallType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
+	result, err = bc.SimulateTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, "", result.Result)
+	assert.NotEqual(t, "", result.Err)
+
+	// the tail state is never changed by the simulation.
+	assert.Equal(t, bc.TailBlock().StateRoot(), bc.TailBlock().accState.RootHash())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bc.SimulateTransaction(ctx, tx)
+	assert.Equal(t, context.Canceled, err)
 }
 
 func TestTailBlock(t *testing.T) {
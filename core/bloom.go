@@ -0,0 +1,121 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// BloomBits is the bits of the bloom filter of the addresses involved in a
+// block, each address sets BloomHashes of them.
+const (
+	BloomBits   = 2048
+	BloomHashes = 3
+)
+
+// bloomPrefix is the prefix of the keys of the block blooms, the value is
+// the parent hash followed by the bloom, so the chain is walked down without
+// loading the block bodies.
+const bloomPrefix = "bloom_"
+
+func bloomKey(blockHash byteutils.Hash) []byte {
+	return append([]byte(bloomPrefix), blockHash...)
+}
+
+// Bloom is a bloom filter of the senders, recipients and deployed contracts
+// of the txs of a block. Test never misses an added address, it may match
+// one not added.
+type Bloom [BloomBits / 8]byte
+
+// Add adds the data to the bloom.
+func (b *Bloom) Add(data []byte) {
+	for _, bit := range bloomBits(data) {
+		b[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test returns false if the data was never added to the bloom.
+func (b *Bloom) Test(data []byte) bool {
+	for _, bit := range bloomBits(data) {
+		if b[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomBits takes the bits set by data from pairs of bytes of its hash.
+func bloomBits(data []byte) []uint {
+	h := hash.Sha3256(data)
+	bits := make([]uint, BloomHashes)
+	for i := range bits {
+		bits[i] = (uint(h[2*i])<<8 | uint(h[2*i+1])) % BloomBits
+	}
+	return bits
+}
+
+// blockBloom returns the bloom of the addresses involved in the block.
+func blockBloom(block *Block) *Bloom {
+	bloom := new(Bloom)
+	for _, tx := range block.transactions {
+		bloom.Add(tx.from.Bytes())
+		bloom.Add(tx.to.Bytes())
+		if tx.Type() == TxPayloadDeployType {
+			if contract, err := tx.GenerateContractAddress(); err == nil {
+				bloom.Add(contract.Bytes())
+			}
+		}
+	}
+	return bloom
+}
+
+// putBloom adds the write of the bloom of the block to the batch.
+func putBloom(batch *storage.Batch, block *Block) {
+	bloom := blockBloom(block)
+	batch.Put(bloomKey(block.Hash()), append(append([]byte{}, block.ParentHash()...), bloom[:]...))
+}
+
+// GetBlockBloom returns the parent hash and the address bloom of a block,
+// storage.ErrKeyNotFound for the blocks stored before the blooms.
+func (bc *BlockChain) GetBlockBloom(hash byteutils.Hash) (byteutils.Hash, *Bloom, error) {
+	value, err := bc.blockStorage.Get(bloomKey(hash))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(value) < len(Bloom{}) {
+		return nil, nil, ErrInvalidBloom
+	}
+	n := len(value) - len(Bloom{})
+	bloom := new(Bloom)
+	copy(bloom[:], value[n:])
+	return byteutils.Hash(value[:n]), bloom, nil
+}
+
+// mayInvolve returns false if none of the addresses is involved in the block
+// of the bloom.
+func (b *Bloom) mayInvolve(addrs []*Address) bool {
+	for _, addr := range addrs {
+		if b.Test(addr.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
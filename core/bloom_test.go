@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBloom(t *testing.T) {
+	bloom := new(Bloom)
+	added := []*Address{mockAddress(), mockAddress()}
+	for _, addr := range added {
+		bloom.Add(addr.Bytes())
+	}
+	for _, addr := range added {
+		assert.True(t, bloom.Test(addr.Bytes()))
+	}
+	assert.True(t, bloom.mayInvolve([]*Address{mockAddress(), added[1]}))
+	assert.False(t, new(Bloom).mayInvolve(added))
+	assert.False(t, bloom.mayInvolve(nil))
+}
+
+func TestGetBlockBloom(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000000))
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+	bc.storeBlockToStorage(bc.tailBlock)
+
+	to := mockAddress()
+	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, bc.txPool.Push(tx))
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(1)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Equal(t, 1, len(block.transactions))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+
+	parent, bloom, err := bc.GetBlockBloom(block.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, block.ParentHash(), parent)
+	assert.True(t, bloom.mayInvolve([]*Address{from}))
+	assert.True(t, bloom.mayInvolve([]*Address{to}))
+
+	_, _, err = bc.GetBlockBloom(mockAddress().Bytes())
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// StorageProof proves a value in the storage of a contract at a block.
+type StorageProof struct {
+	// Account is the bytes of the contract account, proved by AccountProof against the state root.
+	Account      []byte
+	AccountProof trie.MerkleProof
+
+	// StorageProof proves the value against the vars hash of the account.
+	StorageProof trie.MerkleProof
+}
+
+// GetContractStorage return the value of the key in the storage of the contract at the block,
+// the key is the one of LocalContractStorage, e.g. "totalSupply" or "@balances[addr]".
+func (block *Block) GetContractStorage(addr *Address, key string, withProof bool) ([]byte, *StorageProof, error) {
+	contract, err := block.accState.GetContractAccount(addr.address)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return nil, nil, ErrNotContractAccount
+	}
+	storageKey := nvm.HashStorageKey(key)
+	value, err := contract.Get(storageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !withProof {
+		return value, nil, nil
+	}
+
+	proof := new(StorageProof)
+	if proof.Account, err = contract.ToBytes(); err != nil {
+		return nil, nil, err
+	}
+	if proof.AccountProof, err = block.accState.Prove(addr.address); err != nil {
+		return nil, nil, err
+	}
+	if proof.StorageProof, err = contract.Prove(storageKey); err != nil {
+		return nil, nil, err
+	}
+	return value, proof, nil
+}
+
+// VerifyContractStorage verify the value of the key in the storage of the contract against the state root.
+func VerifyContractStorage(stateRoot byteutils.Hash, addr *Address, key string, value []byte, proof *StorageProof) error {
+	if err := trie.VerifyValue(stateRoot, addr.address, proof.Account, proof.AccountProof); err != nil {
+		return ErrInvalidStorageProof
+	}
+	pbAcc := new(corepb.Account)
+	if err := proto.Unmarshal(proof.Account, pbAcc); err != nil {
+		return ErrInvalidStorageProof
+	}
+	if err := trie.VerifyValue(pbAcc.VarsHash, nvm.HashStorageKey(key), value, proof.StorageProof); err != nil {
+		return ErrInvalidStorageProof
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_GetContractStorage(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	from := mockAddress()
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.begin()
+	deploy := mockDeployTransaction(bc.ChainID(), 1)
+	deploy.hash, err = HashTransaction(deploy)
+	assert.Nil(t, err)
+	contractAddr, err := deploy.GenerateContractAddress()
+	assert.Nil(t, err)
+	contract, err := block.accState.CreateContractAccount(contractAddr.address, deploy.hash)
+	assert.Nil(t, err)
+	assert.Nil(t, contract.Put(nvm.HashStorageKey("totalSupply"), []byte("1000")))
+	assert.Nil(t, contract.Put(nvm.HashStorageKey("@balances["+from.String()+"]"), []byte("10")))
+	block.accState.GetOrCreateUserAccount(from.address)
+	block.commit()
+	block.SetMiner(from)
+	assert.Nil(t, block.Seal())
+
+	value, proof, err := block.GetContractStorage(contractAddr, "totalSupply", false)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1000"), value)
+	assert.Nil(t, proof)
+
+	key := "@balances[" + from.String() + "]"
+	value, proof, err = block.GetContractStorage(contractAddr, key, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("10"), value)
+	assert.Nil(t, VerifyContractStorage(block.StateRoot(), contractAddr, key, value, proof))
+	assert.Equal(t, ErrInvalidStorageProof, VerifyContractStorage(block.StateRoot(), contractAddr, key, []byte("11"), proof))
+	assert.Equal(t, ErrInvalidStorageProof, VerifyContractStorage(block.StateRoot(), contractAddr, "totalSupply", value, proof))
+	assert.Equal(t, ErrInvalidStorageProof, VerifyContractStorage(bc.GenesisBlock().StateRoot(), contractAddr, key, value, proof))
+
+	_, _, err = block.GetContractStorage(contractAddr, "unknown", true)
+	assert.NotNil(t, err)
+	_, _, err = block.GetContractStorage(from, "totalSupply", true)
+	assert.Equal(t, ErrNotContractAccount, err)
+}
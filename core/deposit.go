@@ -0,0 +1,402 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxDeriveDepositAddresses the max number of deposit addresses derived at once.
+const MaxDeriveDepositAddresses = 1000
+
+var depositKeysKey = []byte("deposit.xpubs")
+
+func depositKeyKey(xpub string) []byte {
+	return []byte("deposit.xpub." + xpub)
+}
+
+func depositCreditsKey(addr string) []byte {
+	return []byte("deposit.credits." + addr)
+}
+
+// DepositAddress is an address derived from an extended public key.
+type DepositAddress struct {
+	Address string `json:"address"`
+	Index   uint32 `json:"index"`
+	// Received is the sum of the credits of the address.
+	Received string `json:"received"`
+}
+
+// DepositCredit is an incoming transfer to a deposit address executed in
+// the canonical chain.
+type DepositCredit struct {
+	Address   string `json:"address"`
+	Index     uint32 `json:"index"`
+	From      string `json:"from"`
+	Amount    string `json:"amount"`
+	TxHash    string `json:"tx_hash"`
+	BlockHash string `json:"block_hash"`
+	Height    uint64 `json:"height"`
+}
+
+type depositKey struct {
+	xpub *secp256k1.ExtendedPublicKey
+	// Next is the index of the next address derived.
+	Next uint32 `json:"next"`
+}
+
+type depositOwner struct {
+	xpub  string
+	index uint32
+}
+
+// DepositLedger derives the deposit addresses of the extended public keys
+// registered by exchanges, adds them to the watch list and credits their
+// incoming transfers. No private key is kept on the node.
+type DepositLedger struct {
+	bc *BlockChain
+
+	mu        sync.RWMutex
+	keys      map[string]*depositKey
+	addresses map[string]*depositOwner
+
+	eventCh chan *Event
+	quitCh  chan int
+}
+
+// NewDepositLedger create a new DepositLedger with the keys kept in storage.
+func NewDepositLedger(bc *BlockChain) *DepositLedger {
+	dl := &DepositLedger{
+		bc:        bc,
+		keys:      make(map[string]*depositKey),
+		addresses: make(map[string]*depositOwner),
+		eventCh:   make(chan *Event, 1024),
+		quitCh:    make(chan int, 1),
+	}
+	if err := dl.load(); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load deposit ledger.")
+	}
+	return dl
+}
+
+// Start start deposit ledger.
+func (dl *DepositLedger) Start() {
+	logging.CLog().Info("Start DepositLedger.")
+
+	dl.bc.eventEmitter.Register(TopicNewTailBlock, dl.eventCh)
+	dl.bc.eventEmitter.Register(TopicRevertBlock, dl.eventCh)
+	go dl.loop()
+}
+
+// Stop stop deposit ledger.
+func (dl *DepositLedger) Stop() {
+	logging.CLog().Info("Stop DepositLedger.")
+
+	dl.bc.eventEmitter.Deregister(TopicNewTailBlock, dl.eventCh)
+	dl.bc.eventEmitter.Deregister(TopicRevertBlock, dl.eventCh)
+	dl.quitCh <- 0
+}
+
+func (dl *DepositLedger) loop() {
+	logging.CLog().Info("Launched DepositLedger.")
+
+	for {
+		select {
+		case <-dl.quitCh:
+			logging.CLog().Info("Shutdowned DepositLedger.")
+			return
+		case e := <-dl.eventCh:
+			if err := dl.handleBlock(e); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"event": e,
+					"err":   err,
+				}).Error("Failed to credit deposits of block.")
+			}
+		}
+	}
+}
+
+func (dl *DepositLedger) handleBlock(e *Event) error {
+	hash, err := byteutils.FromHex(e.Data)
+	if err != nil {
+		return err
+	}
+	block := dl.bc.GetBlock(hash)
+	if block == nil {
+		return ErrMissingParentBlock
+	}
+	removed := e.Topic == TopicRevertBlock
+
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	for _, tx := range block.transactions {
+		owner, ok := dl.addresses[tx.to.String()]
+		if !ok || tx.value.Sign() == 0 {
+			continue
+		}
+		credits, err := dl.getCredits(tx.to.String())
+		if err != nil {
+			return err
+		}
+		if removed {
+			kept := []*DepositCredit{}
+			for _, c := range credits {
+				if c.TxHash != tx.hash.String() || c.BlockHash != block.Hash().String() {
+					kept = append(kept, c)
+				}
+			}
+			credits = kept
+		} else {
+			success, err := isTxExecutionSuccess(block, tx)
+			if err != nil {
+				return err
+			}
+			if !success {
+				continue
+			}
+			credits = append(credits, &DepositCredit{
+				Address:   tx.to.String(),
+				Index:     owner.index,
+				From:      tx.from.String(),
+				Amount:    tx.value.String(),
+				TxHash:    tx.hash.String(),
+				BlockHash: block.Hash().String(),
+				Height:    block.height,
+			})
+		}
+		if err := dl.putCredits(tx.to.String(), credits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isTxExecutionSuccess(block *Block, tx *Transaction) (bool, error) {
+	events, err := block.FetchEvents(tx.hash)
+	if err != nil {
+		return false, err
+	}
+	for _, e := range events {
+		if e.Topic == TopicExecuteTxSuccess {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Derive derive the next count addresses of the extended public key and add
+// them to the watch list, the key is registered by its first derivation.
+func (dl *DepositLedger) Derive(xpub string, count uint32) ([]*DepositAddress, error) {
+	if count == 0 || count > MaxDeriveDepositAddresses {
+		return nil, ErrInvalidDepositCount
+	}
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	key, ok := dl.keys[xpub]
+	if !ok {
+		parsed, err := secp256k1.ParseExtendedPublicKey(xpub)
+		if err != nil {
+			return nil, err
+		}
+		key = &depositKey{xpub: parsed}
+	}
+
+	addresses := []*DepositAddress{}
+	next := key.Next
+	for uint32(len(addresses)) < count {
+		if next >= secp256k1.HardenedKeyStart {
+			return nil, secp256k1.ErrHardenedDerivation
+		}
+		index := next
+		next++
+		child, err := key.xpub.Child(index)
+		if err == secp256k1.ErrInvalidChildKey {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		addr, err := NewAddressFromPublicKey(child.PublicKey())
+		if err != nil {
+			return nil, err
+		}
+		if err := dl.bc.watchList.Watch(addr); err != nil {
+			return nil, err
+		}
+		dl.addresses[addr.String()] = &depositOwner{xpub: xpub, index: index}
+		addresses = append(addresses, &DepositAddress{Address: addr.String(), Index: index, Received: "0"})
+	}
+
+	key.Next = next
+	if err := dl.putKey(xpub, key); err != nil {
+		return nil, err
+	}
+	if !ok {
+		dl.keys[xpub] = key
+		if err := dl.saveKeys(); err != nil {
+			delete(dl.keys, xpub)
+			return nil, err
+		}
+	}
+	return addresses, nil
+}
+
+// Deposits return the derived addresses of the extended public key, and
+// their credits in blocks from the height.
+func (dl *DepositLedger) Deposits(xpub string, fromHeight uint64) ([]*DepositAddress, []*DepositCredit, error) {
+	dl.mu.RLock()
+	defer dl.mu.RUnlock()
+
+	key, ok := dl.keys[xpub]
+	if !ok {
+		return nil, nil, ErrDepositKeyNotFound
+	}
+	addresses := []*DepositAddress{}
+	credits := []*DepositCredit{}
+	for index := uint32(0); index < key.Next; index++ {
+		child, err := key.xpub.Child(index)
+		if err == secp256k1.ErrInvalidChildKey {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		addr, err := NewAddressFromPublicKey(child.PublicKey())
+		if err != nil {
+			return nil, nil, err
+		}
+		addrCredits, err := dl.getCredits(addr.String())
+		if err != nil {
+			return nil, nil, err
+		}
+		received := util.NewUint128()
+		for _, c := range addrCredits {
+			received.Add(received.Int, util.NewUint128FromString(c.Amount).Int)
+			if c.Height >= fromHeight {
+				credits = append(credits, c)
+			}
+		}
+		addresses = append(addresses, &DepositAddress{Address: addr.String(), Index: index, Received: received.String()})
+	}
+	sort.SliceStable(credits, func(i, j int) bool {
+		return credits[i].Height < credits[j].Height
+	})
+	return addresses, credits, nil
+}
+
+func (dl *DepositLedger) getCredits(addr string) ([]*DepositCredit, error) {
+	credits := []*DepositCredit{}
+	data, err := dl.bc.storage.Get(depositCreditsKey(addr))
+	if err == storage.ErrKeyNotFound {
+		return credits, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &credits); err != nil {
+		return nil, err
+	}
+	return credits, nil
+}
+
+func (dl *DepositLedger) putCredits(addr string, credits []*DepositCredit) error {
+	data, err := json.Marshal(credits)
+	if err != nil {
+		return err
+	}
+	return dl.bc.storage.Put(depositCreditsKey(addr), data)
+}
+
+func (dl *DepositLedger) putKey(xpub string, key *depositKey) error {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	return dl.bc.storage.Put(depositKeyKey(xpub), data)
+}
+
+func (dl *DepositLedger) saveKeys() error {
+	xpubs := make([]string, 0, len(dl.keys))
+	for xpub := range dl.keys {
+		xpubs = append(xpubs, xpub)
+	}
+	sort.Strings(xpubs)
+	data, err := json.Marshal(xpubs)
+	if err != nil {
+		return err
+	}
+	return dl.bc.storage.Put(depositKeysKey, data)
+}
+
+func (dl *DepositLedger) load() error {
+	data, err := dl.bc.storage.Get(depositKeysKey)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	xpubs := []string{}
+	if err := json.Unmarshal(data, &xpubs); err != nil {
+		return err
+	}
+	for _, xpub := range xpubs {
+		parsed, err := secp256k1.ParseExtendedPublicKey(xpub)
+		if err != nil {
+			return err
+		}
+		key := &depositKey{xpub: parsed}
+		data, err := dl.bc.storage.Get(depositKeyKey(xpub))
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, key); err != nil {
+			return err
+		}
+		for index := uint32(0); index < key.Next; index++ {
+			child, err := parsed.Child(index)
+			if err == secp256k1.ErrInvalidChildKey {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			addr, err := NewAddressFromPublicKey(child.PublicKey())
+			if err != nil {
+				return err
+			}
+			dl.addresses[addr.String()] = &depositOwner{xpub: xpub, index: index}
+		}
+		dl.keys[xpub] = key
+	}
+	return nil
+}
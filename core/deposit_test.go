@@ -0,0 +1,131 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitDeposits(t *testing.T, dl *DepositLedger, xpub string, count int) []*DepositCredit {
+	for i := 0; i < 100; i++ {
+		_, credits, err := dl.Deposits(xpub, 0)
+		assert.Nil(t, err)
+		if len(credits) == count {
+			return credits
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	return nil
+}
+
+func TestDepositLedger(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	bc.eventEmitter.Start()
+	defer bc.eventEmitter.Stop()
+	dl := bc.DepositLedger()
+	dl.Start()
+	defer dl.Stop()
+
+	xpub := "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+	_, err := dl.Derive(xpub, 0)
+	assert.Equal(t, ErrInvalidDepositCount, err)
+	_, err = dl.Derive("xpub", 1)
+	assert.Equal(t, secp256k1.ErrInvalidExtendedKey, err)
+	_, _, err = dl.Deposits(xpub, 0)
+	assert.Equal(t, ErrDepositKeyNotFound, err)
+
+	addresses, err := dl.Derive(xpub, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(addresses))
+	more, err := dl.Derive(xpub, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), more[0].Index)
+
+	parsed, _ := secp256k1.ParseExtendedPublicKey(xpub)
+	child, _ := parsed.Child(1)
+	deposit, _ := NewAddressFromPublicKey(child.PublicKey())
+	assert.Equal(t, deposit.String(), addresses[1].Address)
+	assert.Equal(t, 3, len(bc.WatchList().Watched()))
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block0, _ := bc.NewBlock(from)
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(from)
+	block0.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	tx := NewTransaction(bc.ChainID(), from, deposit, util.NewUint128FromInt(10), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.Sign(signature)
+	assert.Nil(t, bc.txPool.Push(tx))
+
+	/*
+		genesis -- 0 -- 1
+		             \_ 2
+	*/
+	coinbase := &Address{[]byte("012345678901234567890011")}
+	block1, _ := bc.NewBlock(coinbase)
+	block1.header.timestamp = BlockInterval * 2
+	block1.CollectTransactions(1)
+	block1.SetMiner(coinbase)
+	block1.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block1)))
+	assert.Nil(t, bc.SetTailBlock(block1))
+
+	credits := waitDeposits(t, dl, xpub, 1)
+	assert.Equal(t, 1, len(credits))
+	assert.Equal(t, deposit.String(), credits[0].Address)
+	assert.Equal(t, uint32(1), credits[0].Index)
+	assert.Equal(t, from.String(), credits[0].From)
+	assert.Equal(t, "10", credits[0].Amount)
+	assert.Equal(t, tx.Hash().String(), credits[0].TxHash)
+	assert.Equal(t, block1.height, credits[0].Height)
+	addresses, _, err = NewDepositLedger(bc).Deposits(xpub, block1.height+1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(addresses))
+	assert.Equal(t, "10", addresses[1].Received)
+
+	// the credit of the reverted block is removed.
+	block2, _ := bc.NewBlockFromParent(coinbase, block0)
+	block2.header.timestamp = BlockInterval * 3
+	block2.SetMiner(coinbase)
+	block2.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block2)))
+	assert.Nil(t, bc.SetTailBlock(block2))
+
+	assert.Equal(t, 0, len(waitDeposits(t, dl, xpub, 0)))
+}
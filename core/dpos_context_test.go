@@ -19,6 +19,7 @@
 package core
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -102,12 +103,12 @@ func TestBlock_ElectNewDynasty(t *testing.T) {
 	delegatePayload := NewDelegatePayload(DelegateAction, v.String())
 	bytes, _ := delegatePayload.ToBytes()
 	tx := NewTransaction(0, kickout, kickout, util.NewUint128FromInt(1), 1, TxPayloadDelegateType, bytes, TransactionGasPrice, util.NewUint128FromInt(200000))
-	_, err := block.executeTransaction(tx)
+	_, err := block.executeTransaction(context.Background(), tx)
 	assert.Nil(t, err)
 	candidatePayload := NewCandidatePayload(LogoutAction)
 	bytes, _ = candidatePayload.ToBytes()
 	tx = NewTransaction(0, kickout, kickout, util.NewUint128FromInt(1), 2, TxPayloadCandidateType, bytes, TransactionGasPrice, util.NewUint128FromInt(200000))
-	_, err = block.executeTransaction(tx)
+	_, err = block.executeTransaction(context.Background(), tx)
 	assert.Nil(t, err)
 	block.commit()
 	context, err := block.NextDynastyContext(DynastyInterval)
@@ -0,0 +1,133 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	estimateRunningGauge   = metrics.GetOrRegisterGauge("neb.estimate.running", nil)
+	estimateRejectedMeter  = metrics.GetOrRegisterMeter("neb.estimate.rejected", nil)
+	estimateTimeoutCounter = metrics.GetOrRegisterCounter("neb.estimate.timeout", nil)
+)
+
+// EstimatePoolConfig is the limits of the gas estimations.
+type EstimatePoolConfig struct {
+	// Workers is the number of estimations executed at the same time.
+	Workers int
+	// Queue is the number of estimations waiting for a worker, the others
+	// are refused.
+	Queue int
+	// Timeout terminates an estimation, including its wait for a worker.
+	Timeout time.Duration
+	// MaxGas is the gas limit of an estimation.
+	MaxGas *util.Uint128
+	// MemoryLimit is the memory limit of the contract execution.
+	MemoryLimit uint64
+}
+
+// DefaultEstimatePoolConfig returns the limits keeping half of the CPUs for
+// the block production.
+func DefaultEstimatePoolConfig() *EstimatePoolConfig {
+	workers := runtime.NumCPU() / 2
+	if workers < 1 {
+		workers = 1
+	}
+	return &EstimatePoolConfig{
+		Workers:     workers,
+		Queue:       64,
+		Timeout:     5 * time.Second,
+		MaxGas:      TransactionMaxGas,
+		MemoryLimit: nvm.DefaultLimitsOfTotalMemorySize,
+	}
+}
+
+// EstimatePool bounds the gas estimations run for the rpc, a burst of them
+// waits for the workers or is refused instead of taking the CPU of the block
+// production.
+type EstimatePool struct {
+	conf    *EstimatePoolConfig
+	slots   chan struct{}
+	pending int32
+}
+
+// NewEstimatePool returns a pool of the config, the unset limits are the defaults.
+func NewEstimatePool(conf *EstimatePoolConfig) *EstimatePool {
+	def := DefaultEstimatePoolConfig()
+	c := *conf
+	if c.Workers <= 0 {
+		c.Workers = def.Workers
+	}
+	if c.Queue < 0 {
+		c.Queue = 0
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = def.Timeout
+	}
+	if c.MaxGas == nil || c.MaxGas.Cmp(util.NewUint128().Int) == 0 || c.MaxGas.Cmp(TransactionMaxGas.Int) > 0 {
+		c.MaxGas = def.MaxGas
+	}
+	if c.MemoryLimit == 0 || c.MemoryLimit > def.MemoryLimit {
+		c.MemoryLimit = def.MemoryLimit
+	}
+	return &EstimatePool{
+		conf:  &c,
+		slots: make(chan struct{}, c.Workers),
+	}
+}
+
+// Run runs fn on a worker within the time and memory limits, it returns
+// ErrEstimatePoolBusy if the queue is full.
+func (p *EstimatePool) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	if int(atomic.AddInt32(&p.pending, 1)) > p.conf.Workers+p.conf.Queue {
+		atomic.AddInt32(&p.pending, -1)
+		estimateRejectedMeter.Mark(1)
+		return ErrEstimatePoolBusy
+	}
+	defer atomic.AddInt32(&p.pending, -1)
+
+	ctx, cancel := context.WithTimeout(ctx, p.conf.Timeout)
+	defer cancel()
+
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		estimateTimeoutCounter.Inc(1)
+		return ctx.Err()
+	}
+	estimateRunningGauge.Update(int64(len(p.slots)))
+	defer func() {
+		<-p.slots
+		estimateRunningGauge.Update(int64(len(p.slots)))
+	}()
+
+	err := fn(nvm.NewMemoryLimitContext(ctx, p.conf.MemoryLimit))
+	if ctx.Err() == context.DeadlineExceeded {
+		estimateTimeoutCounter.Inc(1)
+	}
+	return err
+}
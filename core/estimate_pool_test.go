@@ -0,0 +1,66 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimatePool(t *testing.T) {
+	pool := NewEstimatePool(&EstimatePoolConfig{Workers: 1, Queue: 1, Timeout: 100 * time.Millisecond, MemoryLimit: 1000})
+	assert.Equal(t, TransactionMaxGas, pool.conf.MaxGas)
+
+	// the worker is busy, one estimation waits and the next is refused.
+	started, release := make(chan bool), make(chan bool)
+	go pool.Run(context.Background(), func(ctx context.Context) error {
+		assert.Equal(t, uint64(1000), nvm.MemoryLimitFromContext(ctx))
+		started <- true
+		<-release
+		return nil
+	})
+	<-started
+	waited := make(chan error)
+	go func() {
+		waited <- pool.Run(context.Background(), func(ctx context.Context) error { return nil })
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, ErrEstimatePoolBusy, pool.Run(context.Background(), func(ctx context.Context) error { return nil }))
+
+	// the wait for a worker is bounded by the timeout.
+	assert.Equal(t, context.DeadlineExceeded, <-waited)
+	close(release)
+
+	assert.Nil(t, pool.Run(context.Background(), func(ctx context.Context) error { return nil }))
+}
+
+func TestEstimatePoolDefaults(t *testing.T) {
+	pool := NewEstimatePool(&EstimatePoolConfig{MaxGas: util.NewUint128FromString("")})
+	def := DefaultEstimatePoolConfig()
+	assert.Equal(t, def.Workers, pool.conf.Workers)
+	assert.Equal(t, def.Timeout, pool.conf.Timeout)
+	assert.Equal(t, TransactionMaxGas, pool.conf.MaxGas)
+	assert.Equal(t, nvm.DefaultLimitsOfTotalMemorySize, pool.conf.MemoryLimit)
+	assert.Equal(t, nvm.DefaultLimitsOfTotalMemorySize, nvm.MemoryLimitFromContext(context.Background()))
+}
@@ -19,9 +19,15 @@
 package core
 
 import (
+	"encoding/json"
+	"strconv"
 	"sync"
+	"sync/atomic"
 
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
 	"github.com/sirupsen/logrus"
 )
 
@@ -42,20 +48,191 @@ const (
 	// TopicCandidate the topic of candidate.
 	TopicCandidate = "chain.candidate"
 
+	// TopicAnchor the topic of anchoring child chain state root.
+	TopicAnchor = "chain.anchor"
+
+	// TopicDeployLibrary the topic of deploy a library.
+	TopicDeployLibrary = "chain.deployLibrary"
+
+	// TopicPauseVote the topic of a vote to pause a contract.
+	TopicPauseVote = "chain.pauseVote"
+
+	// TopicContractPaused the topic of a contract paused by the votes of the dynasty.
+	TopicContractPaused = "chain.contractPaused"
+
+	// TopicDeliverMessage the topic of delivering a message sent by a contract.
+	TopicDeliverMessage = "chain.deliverMessage"
+
 	// TopicLinkBlock the topic of link a block.
 	TopicLinkBlock = "chain.linkBlock"
 
+	// TopicNewTailBlock the topic of a block joining the canonical chain, the data is its hash.
+	TopicNewTailBlock = "chain.newTailBlock"
+
+	// TopicRevertBlock the topic of a block reverted from the canonical chain, the data is its hash.
+	TopicRevertBlock = "chain.revertBlock"
+
+	// TopicReorg the topic of the canonical chain switching to a fork, the data is a ReorgEvent.
+	TopicReorg = "chain.reorg"
+
 	// TopicExecuteTxFailed the topic of execute a transaction failed.
 	TopicExecuteTxFailed = "chain.executeTxFailed"
 
 	// TopicExecuteTxSuccess the topic of execute a transaction success.
 	TopicExecuteTxSuccess = "chain.executeTxSuccess"
+
+	// TopicWatchedAddress the topic of the activity of an address in the watch list.
+	TopicWatchedAddress = "chain.watchedAddress"
+
+	// TopicResourceWarning the topic of a node resource crossing its warning threshold.
+	TopicResourceWarning = "node.resourceWarning"
+
+	// TopicResourcePaused the topic of pausing sync and block acceptance on resource exhaustion.
+	TopicResourcePaused = "node.resourcePaused"
+
+	// TopicResourceResumed the topic of resuming sync and block acceptance.
+	TopicResourceResumed = "node.resourceResumed"
+
+	// TopicEventsDropped the topic telling a subscriber the events dropped for
+	// it, the data is their count. It's never triggered, only sent by the rpc.
+	TopicEventsDropped = "node.eventsDropped"
 )
 
 // Event event structure.
 type Event struct {
 	Topic string
 	Data  string
+
+	// ID identifies an event of a block, <block>/<tx>/<index>, empty for the
+	// others. A subscriber catching up skips the events it received already.
+	// It's only set when triggered, never in the events of the block.
+	ID string `json:",omitempty"`
+}
+
+// blockEventID returns the id of the index-th event of the tx of the block,
+// the tx itself is the event 0.
+func blockEventID(block, tx byteutils.Hash, index int) string {
+	return block.String() + "/" + tx.String() + "/" + strconv.Itoa(index)
+}
+
+// DropPolicy decides which event is dropped when the queue of a subscriber is full.
+type DropPolicy int
+
+const (
+	// DropNewest drops the incoming event.
+	DropNewest DropPolicy = iota
+
+	// DropOldest drops the oldest queued event to make room for the incoming one.
+	DropOldest
+)
+
+// DefaultSubscriberQueueSize the default number of events queued for a subscriber.
+const DefaultSubscriberQueueSize = 1024
+
+// MaxCatchUpEvents is the max dropped events kept in storage for a
+// subscriber, the oldest are removed for the new ones.
+const MaxCatchUpEvents = 10000
+
+var (
+	eventDroppedCounter = metrics.GetOrRegisterCounter("neb.event.dropped", nil)
+
+	eventCatchUpPrefix = []byte("event_catchup_")
+)
+
+// ParseDropPolicy parse the drop policy in config, "newest" or "oldest".
+func ParseDropPolicy(policy string) (DropPolicy, error) {
+	switch policy {
+	case "", "newest":
+		return DropNewest, nil
+	case "oldest":
+		return DropOldest, nil
+	}
+	return DropNewest, ErrInvalidDropPolicy
+}
+
+// SubscriberConfig the queue config of a subscriber.
+type SubscriberConfig struct {
+	// QueueSize is the number of events queued for the subscriber, 0 means DefaultSubscriberQueueSize.
+	QueueSize int
+
+	// Policy decides which event is dropped when the queue is full.
+	Policy DropPolicy
+
+	// CatchUp keeps the dropped events in the storage of the emitter, they are read by CatchUp.
+	CatchUp bool
+}
+
+// eventSubscriber delivers events to a chan from its own queue,
+// so that a slow subscriber never blocks the emitter and the others.
+type eventSubscriber struct {
+	id     uint64
+	ch     chan *Event
+	config *SubscriberConfig
+	topics int
+
+	mu    sync.Mutex
+	queue []*Event
+	// catch-up events are kept in storage in [first, next).
+	first uint64
+	next  uint64
+
+	dropped  uint64
+	notifyCh chan struct{}
+	quitCh   chan struct{}
+}
+
+func (sub *eventSubscriber) push(e *Event) (dropped *Event) {
+	sub.mu.Lock()
+	if len(sub.queue) < sub.config.QueueSize {
+		sub.queue = append(sub.queue, e)
+	} else if sub.config.Policy == DropOldest {
+		dropped = sub.queue[0]
+		sub.queue = append(sub.queue[1:], e)
+	} else {
+		dropped = e
+	}
+	sub.mu.Unlock()
+
+	select {
+	case sub.notifyCh <- struct{}{}:
+	default:
+	}
+	return dropped
+}
+
+func (sub *eventSubscriber) pop() *Event {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if len(sub.queue) == 0 {
+		return nil
+	}
+	e := sub.queue[0]
+	sub.queue = sub.queue[1:]
+	return e
+}
+
+func (sub *eventSubscriber) loop() {
+	for {
+		select {
+		case <-sub.quitCh:
+			return
+		case <-sub.notifyCh:
+		}
+		for e := sub.pop(); e != nil; e = sub.pop() {
+			select {
+			case sub.ch <- e:
+			case <-sub.quitCh:
+				return
+			}
+		}
+	}
+}
+
+func catchUpKey(id, seq uint64) []byte {
+	key := append([]byte{}, eventCatchUpPrefix...)
+	key = append(key, byteutils.FromUint64(id)...)
+	return append(key, byteutils.FromUint64(seq)...)
 }
 
 // EventEmitter provide event functionality for Nebulas.
@@ -64,18 +241,40 @@ type EventEmitter struct {
 	eventCh   chan *Event
 	quitCh    chan int
 	size      int
+
+	mu          sync.Mutex
+	subscribers map[chan *Event]*eventSubscriber
+	lastID      uint64
+	config      *SubscriberConfig
+	storage     storage.Storage
 }
 
 // NewEventEmitter return new EventEmitter.
 func NewEventEmitter(size int) *EventEmitter {
 	return &EventEmitter{
-		eventSubs: new(sync.Map),
-		eventCh:   make(chan *Event, size),
-		quitCh:    make(chan int, 1),
-		size:      size,
+		eventSubs:   new(sync.Map),
+		eventCh:     make(chan *Event, size),
+		quitCh:      make(chan int, 1),
+		size:        size,
+		subscribers: make(map[chan *Event]*eventSubscriber),
+		config:      &SubscriberConfig{QueueSize: DefaultSubscriberQueueSize},
 	}
 }
 
+// SetSubscriberConfig set the config of subscribers registered by Register afterwards.
+func (emitter *EventEmitter) SetSubscriberConfig(config *SubscriberConfig) {
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+	emitter.config = config
+}
+
+// SetCatchUpStorage set the storage keeping dropped events of the subscribers catching up.
+func (emitter *EventEmitter) SetCatchUpStorage(stor storage.Storage) {
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+	emitter.storage = stor
+}
+
 // Start start emitter.
 func (emitter *EventEmitter) Start() {
 	logging.CLog().WithFields(logrus.Fields{
@@ -92,6 +291,13 @@ func (emitter *EventEmitter) Stop() {
 	}).Info("Stop EventEmitter.")
 
 	emitter.quitCh <- 1
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+	for ch, sub := range emitter.subscribers {
+		close(sub.quitCh)
+		delete(emitter.subscribers, ch)
+	}
 }
 
 // Trigger trigger event.
@@ -105,14 +311,47 @@ func (emitter *EventEmitter) Trigger(e *Event) {
 
 // Register register event chan.
 func (emitter *EventEmitter) Register(topic string, ch chan *Event) error {
+	emitter.mu.Lock()
+	config := emitter.config
+	emitter.mu.Unlock()
+
+	return emitter.RegisterWithConfig(topic, ch, config)
+}
 
+// RegisterWithConfig register event chan with its own queue config,
+// the config is ignored if the chan is registered in other topics already.
+func (emitter *EventEmitter) RegisterWithConfig(topic string, ch chan *Event, config *SubscriberConfig) error {
 	v, ok := emitter.eventSubs.Load(topic)
 	if !ok {
 		v, _ = emitter.eventSubs.LoadOrStore(topic, new(sync.Map))
 	}
-
 	m, _ := v.(*sync.Map)
-	m.Store(ch, true)
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	if _, ok := m.Load(ch); ok {
+		return nil
+	}
+	sub, ok := emitter.subscribers[ch]
+	if !ok {
+		conf := *config
+		if conf.QueueSize <= 0 {
+			conf.QueueSize = DefaultSubscriberQueueSize
+		}
+		emitter.lastID++
+		sub = &eventSubscriber{
+			id:       emitter.lastID,
+			ch:       ch,
+			config:   &conf,
+			notifyCh: make(chan struct{}, 1),
+			quitCh:   make(chan struct{}),
+		}
+		emitter.subscribers[ch] = sub
+		go sub.loop()
+	}
+	sub.topics++
+	m.Store(ch, sub)
 
 	return nil
 }
@@ -125,11 +364,118 @@ func (emitter *EventEmitter) Deregister(topic string, ch chan *Event) error {
 		return nil
 	}
 	m, _ := v.(*sync.Map)
+
+	emitter.mu.Lock()
+	defer emitter.mu.Unlock()
+
+	s, ok := m.Load(ch)
+	if !ok {
+		return nil
+	}
 	m.Delete(ch)
 
+	sub := s.(*eventSubscriber)
+	sub.topics--
+	if sub.topics > 0 {
+		return nil
+	}
+	close(sub.quitCh)
+	delete(emitter.subscribers, ch)
+
+	if emitter.storage != nil {
+		sub.mu.Lock()
+		defer sub.mu.Unlock()
+		for ; sub.first < sub.next; sub.first++ {
+			if err := emitter.storage.Del(catchUpKey(sub.id, sub.first)); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// Dropped return the number of events dropped for the chan.
+func (emitter *EventEmitter) Dropped(ch chan *Event) uint64 {
+	emitter.mu.Lock()
+	sub, ok := emitter.subscribers[ch]
+	emitter.mu.Unlock()
+
+	if !ok {
+		return 0
+	}
+	return atomic.LoadUint64(&sub.dropped)
+}
+
+// CatchUp return and remove the events dropped for the chan and kept in storage,
+// the subscriber must be registered with SubscriberConfig.CatchUp.
+func (emitter *EventEmitter) CatchUp(ch chan *Event) ([]*Event, error) {
+	emitter.mu.Lock()
+	sub, ok := emitter.subscribers[ch]
+	stor := emitter.storage
+	emitter.mu.Unlock()
+
+	events := []*Event{}
+	if !ok || stor == nil {
+		return events, nil
+	}
+
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	for ; sub.first < sub.next; sub.first++ {
+		key := catchUpKey(sub.id, sub.first)
+		value, err := stor.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		event := new(Event)
+		if err := json.Unmarshal(value, event); err != nil {
+			return nil, err
+		}
+		if err := stor.Del(key); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (emitter *EventEmitter) drop(sub *eventSubscriber, e *Event) {
+	atomic.AddUint64(&sub.dropped, 1)
+	eventDroppedCounter.Inc(1)
+
+	emitter.mu.Lock()
+	stor := emitter.storage
+	emitter.mu.Unlock()
+	if !sub.config.CatchUp || stor == nil {
+		return
+	}
+
+	value, err := json.Marshal(e)
+	if err == nil {
+		sub.mu.Lock()
+		select {
+		case <-sub.quitCh:
+			// deregistered, its catch-up events are removed already.
+		default:
+			if err = stor.Put(catchUpKey(sub.id, sub.next), value); err == nil {
+				sub.next++
+			}
+			for err == nil && sub.next-sub.first > MaxCatchUpEvents {
+				if err = stor.Del(catchUpKey(sub.id, sub.first)); err == nil {
+					sub.first++
+				}
+			}
+		}
+		sub.mu.Unlock()
+	}
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"topic": e.Topic,
+			"err":   err,
+		}).Debug("Failed to keep dropped event for catch-up.")
+	}
+}
+
 func (emitter *EventEmitter) loop() {
 	logging.CLog().Info("Launched EventEmitter.")
 
@@ -148,7 +494,10 @@ func (emitter *EventEmitter) loop() {
 
 			m, _ := v.(*sync.Map)
 			m.Range(func(key, value interface{}) bool {
-				key.(chan *Event) <- e
+				sub := value.(*eventSubscriber)
+				if dropped := sub.push(e); dropped != nil {
+					emitter.drop(sub, dropped)
+				}
 				return true
 			})
 		}
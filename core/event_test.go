@@ -19,12 +19,15 @@
 package core
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -194,3 +197,103 @@ func TestEventEmitterDeregister(t *testing.T) {
 	ch := make(chan *Event, 1)
 	assert.Nil(t, emitter.Deregister("wow", ch))
 }
+
+func TestEventEmitterSlowSubscriber(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	emitter := NewEventEmitter(1024)
+	emitter.SetCatchUpStorage(stor)
+	emitter.Start()
+	defer emitter.Stop()
+
+	topic := "chain.topic.01"
+	// nobody reads the slow chans.
+	newest := make(chan *Event)
+	oldest := make(chan *Event)
+	assert.Nil(t, emitter.RegisterWithConfig(topic, newest, &SubscriberConfig{QueueSize: 2, Policy: DropNewest, CatchUp: true}))
+	assert.Nil(t, emitter.RegisterWithConfig(topic, oldest, &SubscriberConfig{QueueSize: 2, Policy: DropOldest}))
+	fast := register(emitter, topic)
+
+	for i := 0; i < 10; i++ {
+		emitter.Trigger(&Event{Topic: topic, Data: fmt.Sprintf("%d", i)})
+		if i == 0 {
+			// let the slow subscribers take the first event and block on sending it.
+			time.Sleep(time.Millisecond * 100)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		select {
+		case e := <-fast:
+			assert.Equal(t, fmt.Sprintf("%d", i), e.Data)
+		case <-time.After(time.Second):
+			t.Fatal("fast subscriber blocked by slow ones")
+		}
+	}
+
+	// the first event is taken by the loop of the subscriber, two are queued, the others dropped.
+	assert.Equal(t, uint64(7), emitter.Dropped(newest))
+	assert.Equal(t, uint64(7), emitter.Dropped(oldest))
+	assert.Equal(t, "0", (<-newest).Data)
+	assert.Equal(t, "1", (<-newest).Data)
+	assert.Equal(t, "2", (<-newest).Data)
+	assert.Equal(t, "0", (<-oldest).Data)
+	assert.Equal(t, "8", (<-oldest).Data)
+	assert.Equal(t, "9", (<-oldest).Data)
+
+	events, err := emitter.CatchUp(newest)
+	assert.Nil(t, err)
+	assert.Equal(t, 7, len(events))
+	for i, e := range events {
+		assert.Equal(t, fmt.Sprintf("%d", i+3), e.Data)
+	}
+	events, err = emitter.CatchUp(newest)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(events))
+
+	// dropped events without catch-up are not kept.
+	events, err = emitter.CatchUp(oldest)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(events))
+}
+
+func TestEventEmitterCatchUpBound(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	emitter := NewEventEmitter(1024)
+	emitter.SetCatchUpStorage(stor)
+
+	topic := "chain.topic.01"
+	ch := make(chan *Event)
+	assert.Nil(t, emitter.RegisterWithConfig(topic, ch, &SubscriberConfig{QueueSize: 1, CatchUp: true}))
+	sub := emitter.subscribers[ch]
+	for i := 0; i < MaxCatchUpEvents+5; i++ {
+		emitter.drop(sub, &Event{Topic: topic, Data: fmt.Sprintf("%d", i), ID: fmt.Sprintf("id%d", i)})
+	}
+
+	// the oldest are removed.
+	_, err := stor.Get(catchUpKey(sub.id, 4))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	events, err := emitter.CatchUp(ch)
+	assert.Nil(t, err)
+	assert.Equal(t, MaxCatchUpEvents, len(events))
+	assert.Equal(t, "5", events[0].Data)
+	assert.Equal(t, "id5", events[0].ID)
+	assert.Nil(t, emitter.Deregister(topic, ch))
+}
+
+func TestEventJSON(t *testing.T) {
+	// the events of the blocks are recorded without id, their roots unchanged.
+	data, err := json.Marshal(&Event{Topic: "chain.topic.01", Data: "data"})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Topic":"chain.topic.01","Data":"data"}`, string(data))
+	assert.Equal(t, "01/02/3", blockEventID(byteutils.Hash{1}, byteutils.Hash{2}, 3))
+}
+
+func TestParseDropPolicy(t *testing.T) {
+	policy, err := ParseDropPolicy("")
+	assert.Nil(t, err)
+	assert.Equal(t, DropNewest, policy)
+	policy, err = ParseDropPolicy("oldest")
+	assert.Nil(t, err)
+	assert.Equal(t, DropOldest, policy)
+	_, err = ParseDropPolicy("random")
+	assert.Equal(t, ErrInvalidDropPolicy, err)
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// chain export format:
+// magic | version | chainID | genesis state root | from | to | genesis conf record |
+// block records | 0
+// a record is the uint32 length of the protobuf followed by the protobuf.
+const (
+	// ExportMagic starts a chain export.
+	ExportMagic = "NEBCHAIN"
+
+	// ExportVersion is the version of the chain export format.
+	ExportVersion = uint32(1)
+
+	// MaxExportRecordSize is the max size of a record in a chain export.
+	MaxExportRecordSize = 64 * 1024 * 1024
+)
+
+// ExportHeader is the header of a chain export.
+type ExportHeader struct {
+	Version     uint32
+	ChainID     uint32
+	GenesisRoot byteutils.Hash
+	From        uint64
+	To          uint64
+	Genesis     *corepb.Genesis
+}
+
+// Export writes the canonical blocks from height from to to, both included,
+// and the genesis to w.
+func (bc *BlockChain) Export(w io.Writer, from, to uint64) error {
+	if from < 2 || to < from || to > bc.TailBlock().height {
+		return ErrInvalidBlockRange
+	}
+	genesis, err := DumpGenesis(bc.storage)
+	if err != nil {
+		return err
+	}
+	header := &ExportHeader{
+		Version:     ExportVersion,
+		ChainID:     bc.chainID,
+		GenesisRoot: bc.genesisBlock.StateRoot(),
+		From:        from,
+		To:          to,
+		Genesis:     genesis,
+	}
+	if err := writeExportHeader(w, header); err != nil {
+		return err
+	}
+
+	it, err := bc.IterateBlocksByHeight(from, to)
+	if err != nil {
+		return err
+	}
+	for {
+		exist, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if !exist {
+			break
+		}
+		pbBlock, err := it.Block().ToProto()
+		if err != nil {
+			return err
+		}
+		if err := writeExportRecord(w, pbBlock); err != nil {
+			return err
+		}
+	}
+	return binary.Write(w, binary.BigEndian, uint32(0))
+}
+
+// Import reads a chain export of the same genesis from r, verifies and stores
+// its blocks, and moves the tail to the highest one. The blocks already on
+// the canonical chain are skipped. It returns the number of blocks imported.
+func (bc *BlockChain) Import(r io.Reader) (int, error) {
+	header, err := ReadExportHeader(r)
+	if err != nil {
+		return 0, err
+	}
+	if header.ChainID != bc.chainID || !header.GenesisRoot.Equals(bc.genesisBlock.StateRoot()) {
+		return 0, ErrExportGenesisMismatch
+	}
+
+	imported := 0
+	for {
+		pbBlock := new(corepb.Block)
+		exist, err := readExportRecord(r, pbBlock)
+		if err != nil {
+			return imported, err
+		}
+		if !exist {
+			break
+		}
+		block := new(Block)
+		if err := block.FromProto(pbBlock); err != nil {
+			return imported, err
+		}
+		if canonical, err := bc.GetBlockByHeight(block.height); err == nil && canonical.Hash().Equals(block.Hash()) {
+			continue
+		}
+		if bc.GetBlock(block.ParentHash()) == nil {
+			return imported, ErrMissingParentBlock
+		}
+		if err := bc.bkPool.Push(block); err != nil {
+			return imported, err
+		}
+		imported++
+
+		if block.height > bc.TailBlock().height {
+			if err := bc.SetTailBlock(bc.GetBlock(block.Hash())); err != nil {
+				return imported, err
+			}
+		}
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"from":     header.From,
+		"to":       header.To,
+		"imported": imported,
+		"tail":     bc.TailBlock(),
+	}).Info("Imported the chain export.")
+	return imported, nil
+}
+
+// ReadExportHeader reads the header of a chain export from r.
+func ReadExportHeader(r io.Reader) (*ExportHeader, error) {
+	magic := make([]byte, len(ExportMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != ExportMagic {
+		return nil, ErrInvalidChainExport
+	}
+	header := &ExportHeader{GenesisRoot: make([]byte, BlockHashLength)}
+	if err := binary.Read(r, binary.BigEndian, &header.Version); err != nil {
+		return nil, ErrInvalidChainExport
+	}
+	if header.Version != ExportVersion {
+		return nil, ErrUnsupportedExportVersion
+	}
+	if err := binary.Read(r, binary.BigEndian, &header.ChainID); err != nil {
+		return nil, ErrInvalidChainExport
+	}
+	if _, err := io.ReadFull(r, header.GenesisRoot); err != nil {
+		return nil, ErrInvalidChainExport
+	}
+	if err := binary.Read(r, binary.BigEndian, &header.From); err != nil {
+		return nil, ErrInvalidChainExport
+	}
+	if err := binary.Read(r, binary.BigEndian, &header.To); err != nil {
+		return nil, ErrInvalidChainExport
+	}
+	header.Genesis = new(corepb.Genesis)
+	if exist, err := readExportRecord(r, header.Genesis); err != nil || !exist {
+		return nil, ErrInvalidChainExport
+	}
+	return header, nil
+}
+
+func writeExportHeader(w io.Writer, header *ExportHeader) error {
+	if _, err := io.WriteString(w, ExportMagic); err != nil {
+		return err
+	}
+	for _, v := range []interface{}{header.Version, header.ChainID} {
+		if err := binary.Write(w, binary.BigEndian, v); err != nil {
+			return err
+		}
+	}
+	if _, err := w.Write(header.GenesisRoot); err != nil {
+		return err
+	}
+	for _, v := range []uint64{header.From, header.To} {
+		if err := binary.Write(w, binary.BigEndian, v); err != nil {
+			return err
+		}
+	}
+	return writeExportRecord(w, header.Genesis)
+}
+
+func writeExportRecord(w io.Writer, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// readExportRecord returns false at the end of the records.
+func readExportRecord(r io.Reader, msg proto.Message) (bool, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return false, ErrInvalidChainExport
+	}
+	if size == 0 {
+		return false, nil
+	}
+	if size > MaxExportRecordSize {
+		return false, ErrInvalidChainExport
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return false, ErrInvalidChainExport
+	}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return false, ErrInvalidChainExport
+	}
+	return true, nil
+}
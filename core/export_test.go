@@ -0,0 +1,92 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportImport(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	assert.Equal(t, ErrInvalidBlockRange, bc.Export(new(bytes.Buffer), 1, 4))
+	assert.Equal(t, ErrInvalidBlockRange, bc.Export(new(bytes.Buffer), 2, 5))
+
+	data := new(bytes.Buffer)
+	assert.Nil(t, bc.Export(data, 2, 4))
+	header, err := ReadExportHeader(bytes.NewReader(data.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, ExportVersion, header.Version)
+	assert.Equal(t, bc.GenesisBlock().StateRoot(), header.GenesisRoot)
+	assert.Equal(t, uint64(2), header.From)
+	assert.Equal(t, uint64(4), header.To)
+
+	other, _ := NewBlockChain(testNeb())
+	other.SetConsensusHandler(c)
+	imported, err := other.Import(bytes.NewReader(data.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, imported)
+	assert.Equal(t, blocks[2].Hash(), other.TailBlock().Hash())
+	for _, v := range blocks {
+		block, err := other.GetBlockByHeight(v.Height())
+		assert.Nil(t, err)
+		assert.Equal(t, v.Hash(), block.Hash())
+	}
+
+	// importing again skips the canonical blocks.
+	imported, err = other.Import(bytes.NewReader(data.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, imported)
+
+	neb := testNeb()
+	neb.genesis.Meta.ChainId++
+	mismatched, _ := NewBlockChain(neb)
+	_, err = mismatched.Import(bytes.NewReader(data.Bytes()))
+	assert.Equal(t, ErrExportGenesisMismatch, err)
+	neb = testNeb()
+	neb.genesis.TokenDistribution = neb.genesis.TokenDistribution[1:]
+	mismatched, _ = NewBlockChain(neb)
+	_, err = mismatched.Import(bytes.NewReader(data.Bytes()))
+	assert.Equal(t, ErrExportGenesisMismatch, err)
+
+	corrupted := data.Bytes()
+	corrupted[len(ExportMagic)+3] = 2
+	_, err = other.Import(bytes.NewReader(corrupted))
+	assert.Equal(t, ErrUnsupportedExportVersion, err)
+	_, err = other.Import(bytes.NewReader([]byte("NEB")))
+	assert.Equal(t, ErrInvalidChainExport, err)
+}
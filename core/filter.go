@@ -0,0 +1,385 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// FilterTimeout a filter not polled for the duration is removed.
+	FilterTimeout = 5 * time.Minute
+
+	// MaxFilterBlockRange the max number of blocks scanned by a log query.
+	MaxFilterBlockRange = 10000
+
+	// MaxFilterPendingLogs the max number of changes kept for a filter between polls,
+	// the oldest are dropped beyond it.
+	MaxFilterPendingLogs = 10000
+
+	// MaxFilters the max number of filters installed on the node.
+	MaxFilters = 4096
+
+	// MaxFiltersPerClient the max number of filters installed by a client.
+	MaxFiltersPerClient = 64
+)
+
+// LogFilter selects the events of transactions in a range of blocks.
+type LogFilter struct {
+	// Addresses match the sender or receiver of the transaction, empty matches all.
+	Addresses []*Address
+
+	// Topics match the topic of the event, empty matches all.
+	Topics []string
+
+	// FromHeight and ToHeight are the block range, ToHeight 0 means the tail.
+	FromHeight uint64
+	ToHeight   uint64
+}
+
+// Log is an event of a transaction in a block.
+type Log struct {
+	BlockHash byteutils.Hash
+	Height    uint64
+	TxHash    byteutils.Hash
+	Index     int
+	Topic     string
+	Data      string
+
+	// Removed is true if the block of the log is reverted from the canonical chain.
+	Removed bool
+}
+
+func (f *LogFilter) matchTx(tx *Transaction) bool {
+	if len(f.Addresses) == 0 {
+		return true
+	}
+	for _, addr := range f.Addresses {
+		if addr.Equals(tx.from) || addr.Equals(tx.to) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *LogFilter) matchTopic(topic string) bool {
+	if len(f.Topics) == 0 {
+		return true
+	}
+	for _, v := range f.Topics {
+		if v == topic {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *LogFilter) inRange(height uint64) bool {
+	return height >= f.FromHeight && (f.ToHeight == 0 || height <= f.ToHeight)
+}
+
+func (f *LogFilter) blockLogs(block *Block, removed bool) ([]*Log, error) {
+	logs := []*Log{}
+	for _, tx := range block.transactions {
+		if !f.matchTx(tx) {
+			continue
+		}
+		events, err := block.FetchEvents(tx.hash)
+		if err != nil {
+			return nil, err
+		}
+		for i, e := range events {
+			if !f.matchTopic(e.Topic) {
+				continue
+			}
+			logs = append(logs, &Log{
+				BlockHash: block.Hash(),
+				Height:    block.height,
+				TxHash:    tx.hash,
+				Index:     i,
+				Topic:     e.Topic,
+				Data:      e.Data,
+				Removed:   removed,
+			})
+		}
+	}
+	return logs, nil
+}
+
+type filter struct {
+	crit     *LogFilter
+	client   string
+	logs     []*Log
+	lastPoll time.Time
+}
+
+// FilterManager keeps the filters created by clients and the logs changed since their last poll,
+// the logs of reverted blocks are reported again with Removed.
+type FilterManager struct {
+	bc *BlockChain
+
+	mu      sync.Mutex
+	filters map[string]*filter
+	clients map[string]int
+
+	eventCh chan *Event
+	quitCh  chan int
+}
+
+// NewFilterManager create a new FilterManager.
+func NewFilterManager(bc *BlockChain) *FilterManager {
+	return &FilterManager{
+		bc:      bc,
+		filters: make(map[string]*filter),
+		clients: make(map[string]int),
+		eventCh: make(chan *Event, 1024),
+		quitCh:  make(chan int, 1),
+	}
+}
+
+// Start start filter manager.
+func (fm *FilterManager) Start() {
+	logging.CLog().Info("Start FilterManager.")
+
+	fm.bc.eventEmitter.Register(TopicNewTailBlock, fm.eventCh)
+	fm.bc.eventEmitter.Register(TopicRevertBlock, fm.eventCh)
+	go fm.loop()
+}
+
+// Stop stop filter manager.
+func (fm *FilterManager) Stop() {
+	logging.CLog().Info("Stop FilterManager.")
+
+	fm.bc.eventEmitter.Deregister(TopicNewTailBlock, fm.eventCh)
+	fm.bc.eventEmitter.Deregister(TopicRevertBlock, fm.eventCh)
+	fm.quitCh <- 0
+}
+
+func (fm *FilterManager) loop() {
+	logging.CLog().Info("Launched FilterManager.")
+
+	ticker := time.NewTicker(FilterTimeout / 5)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-fm.quitCh:
+			logging.CLog().Info("Shutdowned FilterManager.")
+			return
+		case <-ticker.C:
+			fm.expire()
+		case e := <-fm.eventCh:
+			if err := fm.handleBlock(e); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"event": e,
+					"err":   err,
+				}).Error("Failed to collect logs of block for filters.")
+			}
+		}
+	}
+}
+
+func (fm *FilterManager) handleBlock(e *Event) error {
+	hash, err := byteutils.FromHex(e.Data)
+	if err != nil {
+		return err
+	}
+	block := fm.bc.GetBlock(hash)
+	if block == nil {
+		return ErrMissingParentBlock
+	}
+	removed := e.Topic == TopicRevertBlock
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	for _, f := range fm.filters {
+		if !f.crit.inRange(block.height) {
+			continue
+		}
+		logs, err := f.crit.blockLogs(block, removed)
+		if err != nil {
+			return err
+		}
+		f.logs = append(f.logs, logs...)
+		if len(f.logs) > MaxFilterPendingLogs {
+			f.logs = f.logs[len(f.logs)-MaxFilterPendingLogs:]
+		}
+	}
+	return nil
+}
+
+func (fm *FilterManager) expire() {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	for id, f := range fm.filters {
+		if time.Since(f.lastPoll) > FilterTimeout {
+			fm.remove(id, f)
+		}
+	}
+}
+
+// remove removes the filter, it's called with mu held.
+func (fm *FilterManager) remove(id string, f *filter) {
+	delete(fm.filters, id)
+	if fm.clients[f.client]--; fm.clients[f.client] <= 0 {
+		delete(fm.clients, f.client)
+	}
+}
+
+// NewFilter create a filter of the client and return its id, the logs of
+// blocks joining or leaving the canonical chain afterwards are returned by
+// FilterChanges. At most MaxFilters are installed, MaxFiltersPerClient by a
+// client.
+func (fm *FilterManager) NewFilter(crit *LogFilter, client string) (string, error) {
+	if crit.ToHeight != 0 && crit.FromHeight > crit.ToHeight {
+		return "", ErrInvalidFilterRange
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	if len(fm.filters) >= MaxFilters || fm.clients[client] >= MaxFiltersPerClient {
+		return "", ErrTooManyFilters
+	}
+	fm.filters[byteutils.Hex(id)] = &filter{
+		crit:     crit,
+		client:   client,
+		logs:     []*Log{},
+		lastPoll: time.Now(),
+	}
+	fm.clients[client]++
+	return byteutils.Hex(id), nil
+}
+
+// UninstallFilter remove the filter, return false if not found.
+func (fm *FilterManager) UninstallFilter(id string) bool {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	f, ok := fm.filters[id]
+	if !ok {
+		return false
+	}
+	fm.remove(id, f)
+	return true
+}
+
+// FilterChanges return the logs changed since the last poll of the filter.
+func (fm *FilterManager) FilterChanges(id string) ([]*Log, error) {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	f, ok := fm.filters[id]
+	if !ok {
+		return nil, ErrFilterNotFound
+	}
+	logs := f.logs
+	f.logs = []*Log{}
+	f.lastPoll = time.Now()
+	return logs, nil
+}
+
+// FilterLogs return all logs matching the filter in the canonical chain.
+func (fm *FilterManager) FilterLogs(id string) ([]*Log, error) {
+	fm.mu.Lock()
+	f, ok := fm.filters[id]
+	if ok {
+		f.lastPoll = time.Now()
+	}
+	fm.mu.Unlock()
+
+	if !ok {
+		return nil, ErrFilterNotFound
+	}
+	return fm.GetLogs(f.crit)
+}
+
+// GetLogs return the logs matching the filter in the canonical chain,
+// the range is at most MaxFilterBlockRange blocks.
+func (fm *FilterManager) GetLogs(crit *LogFilter) ([]*Log, error) {
+	tail := fm.bc.TailBlock()
+	from, to := crit.FromHeight, crit.ToHeight
+	if from < 1 {
+		from = 1
+	}
+	if to == 0 || to > tail.height {
+		to = tail.height
+	}
+	logs := []*Log{}
+	if from > to {
+		return logs, nil
+	}
+	if to-from >= MaxFilterBlockRange {
+		return nil, ErrInvalidFilterRange
+	}
+
+	block := tail
+	if to < tail.height {
+		var err error
+		if block, err = fm.bc.GetBlockByHeight(to); err != nil {
+			return nil, err
+		}
+	}
+	// walk down through parents, so that the logs come from one chain even if the tail switches meanwhile.
+	// the parents are read from the blooms, the blocks not involving the
+	// addresses of the filter are never loaded.
+	hashes := make([]byteutils.Hash, to-from+1)
+	blooms := make([]*Bloom, len(hashes))
+	hash := block.Hash()
+	for i := len(hashes) - 1; i >= 0; i-- {
+		hashes[i] = hash
+		parent, bloom, err := fm.bc.GetBlockBloom(hash)
+		if err == storage.ErrKeyNotFound {
+			b := fm.bc.GetBlock(hash)
+			if b == nil {
+				return nil, ErrMissingParentBlock
+			}
+			parent = b.ParentHash()
+		} else if err != nil {
+			return nil, err
+		}
+		blooms[i] = bloom
+		hash = parent
+	}
+	for i, hash := range hashes {
+		if len(crit.Addresses) > 0 && blooms[i] != nil && !blooms[i].mayInvolve(crit.Addresses) {
+			continue
+		}
+		block := fm.bc.GetBlock(hash)
+		if block == nil {
+			return nil, ErrMissingParentBlock
+		}
+		blockLogs, err := crit.blockLogs(block, false)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, blockLogs...)
+	}
+	return logs, nil
+}
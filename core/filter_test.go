@@ -0,0 +1,157 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitFilterChanges(t *testing.T, fm *FilterManager, id string) []*Log {
+	for i := 0; i < 100; i++ {
+		logs, err := fm.FilterChanges(id)
+		assert.Nil(t, err)
+		if len(logs) > 0 {
+			return logs
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	return nil
+}
+
+func TestFilterManager(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	bc.eventEmitter.Start()
+	defer bc.eventEmitter.Stop()
+	fm := bc.FilterManager()
+	fm.Start()
+	defer fm.Stop()
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block0, _ := bc.NewBlock(from)
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(from)
+	block0.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	_, err := fm.NewFilter(&LogFilter{FromHeight: 5, ToHeight: 2}, "client")
+	assert.Equal(t, ErrInvalidFilterRange, err)
+	id, err := fm.NewFilter(&LogFilter{Addresses: []*Address{from}, Topics: []string{TopicExecuteTxSuccess}}, "client")
+	assert.Nil(t, err)
+	other, err := fm.NewFilter(&LogFilter{Addresses: []*Address{mockAddress()}}, "client")
+	assert.Nil(t, err)
+
+	tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.Sign(signature)
+	assert.Nil(t, bc.txPool.Push(tx))
+
+	/*
+		genesis -- 0 -- 1
+		             \_ 2
+	*/
+	coinbase := &Address{[]byte("012345678901234567890011")}
+	block1, _ := bc.NewBlock(coinbase)
+	block1.header.timestamp = BlockInterval * 2
+	block1.CollectTransactions(1)
+	block1.SetMiner(coinbase)
+	block1.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block1)))
+	assert.Nil(t, bc.SetTailBlock(block1))
+
+	logs := waitFilterChanges(t, fm, id)
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, tx.Hash(), logs[0].TxHash)
+	assert.Equal(t, block1.Hash(), logs[0].BlockHash)
+	assert.Equal(t, TopicExecuteTxSuccess, logs[0].Topic)
+	assert.False(t, logs[0].Removed)
+
+	queried, err := fm.FilterLogs(id)
+	assert.Nil(t, err)
+	assert.Equal(t, logs, queried)
+	queried, err = fm.FilterLogs(other)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(queried))
+
+	// the logs of the reverted block are reported again as removed.
+	block2, _ := bc.NewBlockFromParent(coinbase, block0)
+	block2.header.timestamp = BlockInterval * 3
+	block2.SetMiner(coinbase)
+	block2.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block2)))
+	assert.Nil(t, bc.SetTailBlock(block2))
+
+	removed := waitFilterChanges(t, fm, id)
+	assert.Equal(t, 1, len(removed))
+	assert.Equal(t, tx.Hash(), removed[0].TxHash)
+	assert.True(t, removed[0].Removed)
+	queried, err = fm.FilterLogs(id)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(queried))
+
+	assert.True(t, fm.UninstallFilter(id))
+	assert.False(t, fm.UninstallFilter(id))
+	_, err = fm.FilterChanges(id)
+	assert.Equal(t, ErrFilterNotFound, err)
+}
+
+func TestFilterManager_Limits(t *testing.T) {
+	fm := NewFilterManager(nil)
+
+	var ids []string
+	for i := 0; i < MaxFiltersPerClient; i++ {
+		id, err := fm.NewFilter(&LogFilter{}, "client")
+		assert.Nil(t, err)
+		ids = append(ids, id)
+	}
+	_, err := fm.NewFilter(&LogFilter{}, "client")
+	assert.Equal(t, ErrTooManyFilters, err)
+	_, err = fm.NewFilter(&LogFilter{}, "other")
+	assert.Nil(t, err)
+
+	// an uninstalled filter frees a place for its client.
+	assert.True(t, fm.UninstallFilter(ids[0]))
+	_, err = fm.NewFilter(&LogFilter{}, "client")
+	assert.Nil(t, err)
+
+	for i := 0; len(fm.filters) < MaxFilters; i++ {
+		_, err := fm.NewFilter(&LogFilter{}, fmt.Sprintf("client%d", i/MaxFiltersPerClient))
+		assert.Nil(t, err)
+	}
+	_, err = fm.NewFilter(&LogFilter{}, "new")
+	assert.Equal(t, ErrTooManyFilters, err)
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Forks are the heights from which the new consensus rules of a chain apply,
+// a fork at height 0 is not scheduled.
+type Forks struct {
+	// ContractContextHeight is the height from which the contracts get the
+	// extended block and transaction context.
+	ContractContextHeight uint64
+	// SupplyHeight is the height from which the issued and burned supply is
+	// tracked in the state.
+	SupplyHeight uint64
+	// FeeBurnHeight is the height from which FeeBurnPercent of the transaction
+	// fees are burned instead of paid to the block producer.
+	FeeBurnHeight  uint64
+	FeeBurnPercent uint32
+	// TxPayloadTypes are the heights from which the registered tx payload
+	// types not built in are accepted, by type name.
+	TxPayloadTypes map[string]uint64
+	// HeaderV1Height is the height from which the blocks have the v1 header.
+	HeaderV1Height uint64
+	// BlockGasLimitHeight is the height from which the gas used by the
+	// transactions of a block must not exceed BlockGasLimit.
+	BlockGasLimitHeight uint64
+	BlockGasLimit       *util.Uint128
+	// MessageHeight is the height from which the contracts send messages
+	// delivered in the later blocks.
+	MessageHeight uint64
+	// VMLimitsHeight is the height from which the VM limits the call depth,
+	// the heap and the size of the objects put in the contract storage.
+	VMLimitsHeight uint64
+	// NumericPolicyHeight is the height from which the contracts deployed must
+	// not use float literals nor the nondeterministic Math functions, which are
+	// disabled in the execution.
+	NumericPolicyHeight uint64
+}
+
+var (
+	chainForks     = make(map[uint32]*Forks)
+	chainForksLock = sync.RWMutex{}
+)
+
+// RegisterForks registers the forks of the chain in the genesis config, the
+// blocks of the chain are executed by the rules active at their height.
+func RegisterForks(conf *corepb.Genesis) {
+	forks := &Forks{}
+	if f := conf.GetForks(); f != nil {
+		forks.ContractContextHeight = f.ContractContextHeight
+		forks.SupplyHeight = f.SupplyHeight
+		forks.FeeBurnHeight = f.FeeBurnHeight
+		forks.FeeBurnPercent = f.FeeBurnPercent
+		forks.TxPayloadTypes = f.TxPayloadTypes
+		forks.HeaderV1Height = f.HeaderV1Height
+		forks.MessageHeight = f.MessageHeight
+		forks.VMLimitsHeight = f.VmLimitsHeight
+		forks.NumericPolicyHeight = f.NumericPolicyHeight
+		if limit, err := parseBlockGasLimit(f.BlockGasLimit); err == nil && f.BlockGasLimitHeight > 0 {
+			forks.BlockGasLimitHeight = f.BlockGasLimitHeight
+			forks.BlockGasLimit = limit
+		}
+	}
+
+	chainForksLock.Lock()
+	defer chainForksLock.Unlock()
+	chainForks[conf.GetMeta().GetChainId()] = forks
+}
+
+// CheckForks returns ErrInvalidFeeBurn if the fee burn fork of the genesis
+// config is scheduled before the supply fork or burns more than the fees,
+// ErrInvalidTxPayloadType if it schedules a tx payload type not registered,
+// and ErrInvalidBlockGasLimit if the block gas limit fork has no valid limit.
+func CheckForks(conf *corepb.Genesis) error {
+	f := conf.GetForks()
+	for name := range f.GetTxPayloadTypes() {
+		if LookupTxPayloadType(name) == nil {
+			return ErrInvalidTxPayloadType
+		}
+	}
+	if f.GetBlockGasLimitHeight() > 0 {
+		if _, err := parseBlockGasLimit(f.GetBlockGasLimit()); err != nil {
+			return err
+		}
+	}
+	if f.GetFeeBurnHeight() == 0 {
+		return nil
+	}
+	if f.GetFeeBurnPercent() > 100 || f.GetSupplyHeight() == 0 || f.GetSupplyHeight() > f.GetFeeBurnHeight() {
+		return ErrInvalidFeeBurn
+	}
+	return nil
+}
+
+func parseBlockGasLimit(s string) (*util.Uint128, error) {
+	limit, ok := new(big.Int).SetString(s, 10)
+	if !ok || limit.Sign() <= 0 || util.NewUint128FromBigInt(limit).Validate() != nil {
+		return nil, ErrInvalidBlockGasLimit
+	}
+	return util.NewUint128FromBigInt(limit), nil
+}
+
+// ForksOf returns the forks of the chain, none scheduled if not registered.
+func ForksOf(chainID uint32) *Forks {
+	chainForksLock.RLock()
+	defer chainForksLock.RUnlock()
+
+	if forks, ok := chainForks[chainID]; ok {
+		return forks
+	}
+	return &Forks{}
+}
+
+func isForkActive(forkHeight, height uint64) bool {
+	return forkHeight > 0 && height >= forkHeight
+}
+
+// IsContractContextActive returns if the contracts get the extended context at the height.
+func (f *Forks) IsContractContextActive(height uint64) bool {
+	return isForkActive(f.ContractContextHeight, height)
+}
+
+// IsSupplyActive returns if the supply is tracked in the state at the height.
+func (f *Forks) IsSupplyActive(height uint64) bool {
+	return isForkActive(f.SupplyHeight, height)
+}
+
+// IsMessageActive returns if the contracts send and the blocks deliver messages at the height.
+func (f *Forks) IsMessageActive(height uint64) bool {
+	return isForkActive(f.MessageHeight, height)
+}
+
+// IsVMLimitsActive returns if the VM limits the call depth, the heap and the storage objects at the height.
+func (f *Forks) IsVMLimitsActive(height uint64) bool {
+	return isForkActive(f.VMLimitsHeight, height)
+}
+
+// IsNumericPolicyActive returns if the contracts are checked by the numeric policy at the height.
+func (f *Forks) IsNumericPolicyActive(height uint64) bool {
+	return isForkActive(f.NumericPolicyHeight, height)
+}
+
+// IsFeeBurnActive returns if a part of the transaction fees is burned at the height.
+func (f *Forks) IsFeeBurnActive(height uint64) bool {
+	return isForkActive(f.FeeBurnHeight, height)
+}
+
+// SplitFee returns the part of the fee burned and the part paid to the block
+// producer at the height.
+func (f *Forks) SplitFee(height uint64, fee *util.Uint128) (burned, paid *util.Uint128) {
+	if !f.IsFeeBurnActive(height) || f.FeeBurnPercent == 0 {
+		return util.NewUint128(), fee
+	}
+	b := new(big.Int).Mul(fee.Int, big.NewInt(int64(f.FeeBurnPercent)))
+	b.Div(b, big.NewInt(100))
+	return util.NewUint128FromBigInt(b), util.NewUint128FromBigInt(new(big.Int).Sub(fee.Int, b))
+}
+
+// BlockGasLimitAt returns the max gas used by the transactions of the block
+// at the height, nil if there is no limit.
+func (f *Forks) BlockGasLimitAt(height uint64) *util.Uint128 {
+	if !isForkActive(f.BlockGasLimitHeight, height) {
+		return nil
+	}
+	return f.BlockGasLimit
+}
+
+// IsTxPayloadTypeActive returns if the tx payload type is accepted at the
+// height, the types not registered are never accepted.
+func (f *Forks) IsTxPayloadTypeActive(name string, height uint64) bool {
+	t := LookupTxPayloadType(name)
+	if t == nil {
+		return false
+	}
+	return t.Builtin || isForkActive(f.TxPayloadTypes[name], height)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForks(t *testing.T) {
+	assert.False(t, ForksOf(0xffff).IsContractContextActive(100))
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{ContractContextHeight: 10}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	forks := ForksOf(conf.Meta.ChainId)
+	assert.False(t, forks.IsContractContextActive(9))
+	assert.True(t, forks.IsContractContextActive(10))
+	assert.True(t, forks.IsContractContextActive(11))
+}
+
+func TestContextExtension(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	from := mockAddress()
+	block, _ := bc.NewBlock(from)
+	assert.Nil(t, block.ContextExtension())
+
+	tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.hash, _ = HashTransaction(tx)
+	assert.Nil(t, convertNvmTx(NewPayloadContext(block, tx)).Index)
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{ContractContextHeight: block.Height()}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	ext := block.ContextExtension()
+	assert.Equal(t, block.ParentHash().String(), ext.ParentHash)
+	assert.Equal(t, DefaultBlockGasLimit.String(), ext.GasLimit)
+	assert.Equal(t, byteutils.Hash(hash.Sha3256(block.ParentHash(), byteutils.FromUint64(block.Height()))).String(), ext.Random)
+
+	// a tx being collected is appended after the txs of the block.
+	ctx := NewPayloadContext(block, tx)
+	assert.Equal(t, int64(0), *convertNvmTx(ctx).Index)
+	block.transactions = append(block.transactions, mockTransaction(bc.ChainID(), 0, TxPayloadBinaryType, nil), tx)
+	assert.Equal(t, int64(1), *convertNvmTx(ctx).Index)
+
+	ctx.message = true
+	assert.Equal(t, int64(-1), *convertNvmTx(ctx).Index)
+}
+
+func TestFeeBurn(t *testing.T) {
+	conf := MockGenesisConf()
+	assert.Nil(t, CheckForks(conf))
+	conf.Forks = &corepb.GenesisForks{FeeBurnHeight: 10, FeeBurnPercent: 30}
+	assert.Equal(t, ErrInvalidFeeBurn, CheckForks(conf))
+	conf.Forks.SupplyHeight = 11
+	assert.Equal(t, ErrInvalidFeeBurn, CheckForks(conf))
+	conf.Forks.SupplyHeight = 10
+	assert.Nil(t, CheckForks(conf))
+	conf.Forks.FeeBurnPercent = 101
+	assert.Equal(t, ErrInvalidFeeBurn, CheckForks(conf))
+
+	forks := &Forks{FeeBurnHeight: 10, FeeBurnPercent: 30}
+	burned, paid := forks.SplitFee(9, util.NewUint128FromInt(1001))
+	assert.Equal(t, util.NewUint128(), burned)
+	assert.Equal(t, util.NewUint128FromInt(1001), paid)
+	burned, paid = forks.SplitFee(10, util.NewUint128FromInt(1001))
+	assert.Equal(t, util.NewUint128FromInt(300), burned)
+	assert.Equal(t, util.NewUint128FromInt(701), paid)
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ForkTipsKey is the key of the hashes of the fork tips in storage.
+	ForkTipsKey = "blockchain_fork_tips"
+
+	// ForkTipMaxAge is the max seconds a fork tip is behind the tail before
+	// it expires.
+	ForkTipMaxAge = 2 * DynastyInterval
+
+	// ForkTipMaxDepth is the max blocks a fork tip is below the tail before
+	// it expires.
+	ForkTipMaxDepth = 1024
+)
+
+var (
+	forkTipsGauge        = metrics.GetOrRegisterGauge("neb.block.forktips", nil)
+	forkTipsExpiredMeter = metrics.GetOrRegisterMeter("neb.block.forktips.expired", nil)
+)
+
+// forkTips is the set of the tips of the forks known to the chain, the
+// candidates of the fork choice. Unlike a cache, a tip only leaves the set
+// when a block extends it or it expires by age or height, and the set is
+// persisted to survive restarts.
+type forkTips struct {
+	mu   sync.Mutex
+	tips map[byteutils.HexHash]*Block
+	stor storage.Storage
+}
+
+func newForkTips(stor storage.Storage) *forkTips {
+	return &forkTips{
+		tips: make(map[byteutils.HexHash]*Block),
+		stor: stor,
+	}
+}
+
+// load restores the tips persisted, the blocks not found are dropped.
+func (ft *forkTips) load(getBlock func(byteutils.Hash) *Block) error {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+
+	value, err := ft.stor.Get([]byte(ForkTipsKey))
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for i := 0; i+len(GenesisHash) <= len(value); i += len(GenesisHash) {
+		hash := byteutils.Hash(value[i : i+len(GenesisHash)])
+		if block := getBlock(hash); block != nil {
+			ft.tips[hash.Hex()] = block
+		}
+	}
+	forkTipsGauge.Update(int64(len(ft.tips)))
+	return nil
+}
+
+// update adds the new tips and removes their parent, which is no longer a tip.
+func (ft *forkTips) update(parent *Block, tips []*Block) error {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+
+	for _, v := range tips {
+		ft.tips[v.Hash().Hex()] = v
+	}
+	delete(ft.tips, parent.Hash().Hex())
+	return ft.persist()
+}
+
+// expire removes the tips too old or too low compared to the tail, but the
+// tail, and returns the remaining ones.
+func (ft *forkTips) expire(tail *Block, checkpoint uint64) ([]*Block, error) {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+
+	expired := 0
+	for k, v := range ft.tips {
+		if v.Hash().Equals(tail.Hash()) {
+			continue
+		}
+		if tail.Timestamp()-v.Timestamp() > ForkTipMaxAge ||
+			v.Height()+ForkTipMaxDepth < tail.Height() || v.Height() < checkpoint {
+			logging.VLog().WithFields(logrus.Fields{
+				"tip":  v,
+				"tail": tail,
+			}).Debug("Expire a fork tip.")
+			delete(ft.tips, k)
+			expired++
+		}
+	}
+
+	tips := make([]*Block, 0, len(ft.tips))
+	for _, v := range ft.tips {
+		tips = append(tips, v)
+	}
+	if expired == 0 {
+		return tips, nil
+	}
+	forkTipsExpiredMeter.Mark(int64(expired))
+	return tips, ft.persist()
+}
+
+func (ft *forkTips) persist() error {
+	forkTipsGauge.Update(int64(len(ft.tips)))
+	value := make([]byte, 0, len(ft.tips)*len(GenesisHash))
+	for _, v := range ft.tips {
+		value = append(value, v.Hash()...)
+	}
+	return ft.stor.Put([]byte(ForkTipsKey), value)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForkTips(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	newBlock := func(parent *Block, timestamp int64) *Block {
+		block, _ := bc.NewBlockFromParent(coinbase, parent)
+		block.header.timestamp = timestamp
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		return block
+	}
+
+	/*
+		genesis -- 1 - 2 - 3
+		             \_ fork
+	*/
+	block1 := newBlock(bc.TailBlock(), BlockInterval)
+	assert.Nil(t, bc.SetTailBlock(block1))
+	block2 := newBlock(block1, BlockInterval*2)
+	fork := newBlock(block1, BlockInterval*3)
+	assert.Nil(t, bc.SetTailBlock(block2))
+	assert.Equal(t, 2, len(bc.DetachedTailBlocks()))
+
+	// the tips survive a restart.
+	bc, _ = NewBlockChain(neb)
+	bc.SetConsensusHandler(c)
+	tips := bc.DetachedTailBlocks()
+	assert.Equal(t, 2, len(tips))
+	for _, v := range tips {
+		assert.True(t, v.Hash().Equals(block2.Hash()) || v.Hash().Equals(fork.Hash()))
+	}
+
+	// the fork expires once the tail is too far ahead, the tail never does.
+	tail := &Block{
+		header: &BlockHeader{hash: block2.Hash(), timestamp: fork.Timestamp() + ForkTipMaxAge + 1},
+		height: block2.Height(),
+	}
+	tips, err := bc.forkTips.expire(tail, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tips))
+	assert.Equal(t, block2.Hash(), tips[0].Hash())
+
+	bc, _ = NewBlockChain(neb)
+	assert.Equal(t, 1, len(bc.DetachedTailBlocks()))
+
+	tail.header.timestamp = block2.Timestamp()
+	tail.height = fork.Height() + ForkTipMaxDepth + 1
+	bc.forkTips.update(block1, []*Block{fork})
+	tips, _ = bc.forkTips.expire(tail, 0)
+	assert.Equal(t, 1, len(tips))
+}
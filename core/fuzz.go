@@ -0,0 +1,187 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+//go:build gofuzz
+// +build gofuzz
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// The entry points below are go-fuzz harnesses of the inputs a node receives
+// from the network. They are only built with the gofuzz tag by go-fuzz-build,
+// run one with
+//
+//	make fuzz FUZZ=FuzzBlock
+//
+// crashers are written to fuzz/FuzzBlock/crashers.
+// Each returns 1 when the input decoded, so go-fuzz prefers it, and 0 otherwise.
+// A panic of any of them is a crasher.
+
+const fuzzChainID = 100
+
+var (
+	fuzzSigner keystore.Signature
+	fuzzFrom   *Address
+	fuzzPool   *TransactionPool
+)
+
+func init() {
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, err := priv.PublicKey().Encoded()
+	if err != nil {
+		panic(err)
+	}
+	if fuzzFrom, err = NewAddressFromPublicKey(pubdata); err != nil {
+		panic(err)
+	}
+	if fuzzSigner, err = crypto.NewSignature(keystore.SECP256K1); err != nil {
+		panic(err)
+	}
+	fuzzSigner.InitSign(priv)
+
+	fuzzPool, _ = NewTransactionPool(1024)
+	fuzzPool.setBlockChain(&BlockChain{chainID: fuzzChainID})
+}
+
+// FuzzTransaction decodes a tx as received by the tx pool and verifies it.
+func FuzzTransaction(data []byte) int {
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(data, pbTx); err != nil {
+		return 0
+	}
+	tx := new(Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		return 0
+	}
+	_ = tx.String()
+	tx.VerifyIntegrity(tx.chainID)
+	tx.LoadPayload()
+	if _, err := tx.ToProto(); err != nil {
+		panic(err)
+	}
+	return 1
+}
+
+// FuzzBlock decodes a block as received by the block pool and verifies it.
+func FuzzBlock(data []byte) int {
+	pbBlock := new(corepb.Block)
+	if err := proto.Unmarshal(data, pbBlock); err != nil {
+		return 0
+	}
+	block := new(Block)
+	if err := block.FromProto(pbBlock); err != nil {
+		return 0
+	}
+	_ = block.String()
+	block.VerifyIntegrity(block.header.chainID, fuzzConsensus{})
+	if _, err := block.ToProto(); err != nil {
+		panic(err)
+	}
+	return 1
+}
+
+// FuzzRecover recovers the signer of a hash, the first 32 bytes of data, from
+// the signature in the rest of data.
+func FuzzRecover(data []byte) int {
+	if len(data) < 32 {
+		return 0
+	}
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		panic(err)
+	}
+	pub, err := signature.RecoverPublic(data[:32], data[32:])
+	if err != nil {
+		return 0
+	}
+	if _, err := pub.Encoded(); err != nil {
+		panic(err)
+	}
+	signature.Verify(data[:32], data[32:])
+	return 1
+}
+
+// FuzzTxPool pushes a decoded tx into the tx pool, then pushes it again signed
+// by a known key, so the checks after the signature are reached as well.
+func FuzzTxPool(data []byte) int {
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(data, pbTx); err != nil {
+		return 0
+	}
+	tx := new(Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		return 0
+	}
+	fuzzPool.Push(tx)
+
+	tx.chainID = fuzzChainID
+	tx.from = fuzzFrom
+	if err := tx.Sign(fuzzSigner); err != nil {
+		return 1
+	}
+	if err := fuzzPool.Push(tx); err == nil && fuzzPool.Pop() == nil {
+		panic("empty pool after a push")
+	}
+	return 1
+}
+
+// FuzzParseAddress parses an address of user input, a parsed address must
+// parse back from its string.
+func FuzzParseAddress(data []byte) int {
+	addr, err := AddressParse(string(data))
+	if err != nil {
+		return 0
+	}
+	again, err := AddressParse(addr.String())
+	if err != nil || !again.Equals(addr) {
+		panic("address doesn't parse back from its string")
+	}
+	return 1
+}
+
+// FuzzParseHash parses a hash of user input, a parsed hash must parse back
+// from its string.
+func FuzzParseHash(data []byte) int {
+	hash, err := byteutils.ParseHash(string(data))
+	if err != nil {
+		return 0
+	}
+	again, err := byteutils.ParseHash(hash.String())
+	if err != nil || !again.Equals(hash) {
+		panic("hash doesn't parse back from its string")
+	}
+	return 1
+}
+
+type fuzzConsensus struct{}
+
+func (c fuzzConsensus) VerifyBlock(block *Block, parent *Block) error {
+	return nil
+}
+
+func (c fuzzConsensus) FastVerifyBlock(block *Block) error {
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+const (
+	// DefaultGasPriceBlocks is the number of recent blocks sampled by the gas
+	// price oracle by default.
+	DefaultGasPriceBlocks = 20
+
+	// DefaultGasPricePercentile is the percentile of the samples returned by GasPrice.
+	DefaultGasPricePercentile = 50
+)
+
+// gasPriceOracle suggests a gas price from the lowest gas price of each of
+// the recent non empty blocks, which a single block or sender can't move
+// far. The samples are kept until the tail changes.
+type gasPriceOracle struct {
+	mu      sync.Mutex
+	blocks  int
+	tail    byteutils.Hash
+	samples []*util.Uint128
+}
+
+// SetGasPriceBlocks sets the number of recent blocks sampled by the gas
+// price oracle, DefaultGasPriceBlocks if 0.
+func (bc *BlockChain) SetGasPriceBlocks(n int) {
+	bc.gasPrices.mu.Lock()
+	defer bc.gasPrices.mu.Unlock()
+	if n <= 0 {
+		n = DefaultGasPriceBlocks
+	}
+	bc.gasPrices.blocks = n
+	bc.gasPrices.tail = nil
+}
+
+// GasPrice returns the median gas price suggested by the oracle.
+func (bc *BlockChain) GasPrice() *util.Uint128 {
+	return bc.SuggestGasPrice(DefaultGasPricePercentile)
+}
+
+// SuggestGasPrice returns the percentile of the lowest gas prices of the
+// recent non empty blocks, TransactionGasPrice if they are all empty.
+func (bc *BlockChain) SuggestGasPrice(percentile uint32) *util.Uint128 {
+	if percentile > 100 {
+		percentile = 100
+	}
+	samples := bc.gasPriceSamples()
+	if len(samples) == 0 {
+		return TransactionGasPrice
+	}
+	return samples[(len(samples)-1)*int(percentile)/100]
+}
+
+// gasPriceSamples returns the lowest gas price of each of the recent non
+// empty blocks, sorted.
+func (bc *BlockChain) gasPriceSamples() []*util.Uint128 {
+	o := bc.gasPrices
+	tail := bc.TailBlock()
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.tail.Equals(tail.Hash()) {
+		return o.samples
+	}
+
+	samples := []*util.Uint128{}
+	block := tail
+	for i := 0; i < o.blocks && block != nil && !CheckGenesisBlock(block); i++ {
+		var lowest *util.Uint128
+		for _, tx := range block.transactions {
+			if lowest == nil || tx.gasPrice.Cmp(lowest.Int) < 0 {
+				lowest = tx.gasPrice
+			}
+		}
+		if lowest != nil {
+			samples = append(samples, lowest)
+		}
+		block = bc.GetBlock(block.ParentHash())
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Cmp(samples[j].Int) < 0
+	})
+	o.tail = tail.Hash()
+	o.samples = samples
+	return samples
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestGasPrice(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	assert.Equal(t, TransactionGasPrice, bc.SuggestGasPrice(90))
+
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, err := ks.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	nonce := uint64(0)
+	mint := func(prices ...int64) {
+		block, err := bc.NewBlock(from)
+		assert.Nil(t, err)
+		for _, price := range prices {
+			nonce++
+			tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("nas"), util.NewUint128FromInt(price), util.NewUint128FromInt(200000))
+			tx.Sign(signature)
+			block.transactions = append(block.transactions, tx)
+		}
+		block.miner = from
+		block.Seal()
+		block.Sign(signature)
+		bc.SetTailBlock(block)
+		bc.storeBlockToStorage(block)
+	}
+
+	// a block is sampled by its lowest gas price, the empty ones are skipped.
+	mint(1000, 9000000)
+	mint(2000)
+	mint()
+	mint(3000, 4000)
+	mint(5000)
+	assert.Equal(t, util.NewUint128FromInt(1000), bc.SuggestGasPrice(0))
+	assert.Equal(t, util.NewUint128FromInt(2000), bc.GasPrice())
+	assert.Equal(t, util.NewUint128FromInt(3000), bc.SuggestGasPrice(70))
+	assert.Equal(t, util.NewUint128FromInt(5000), bc.SuggestGasPrice(100))
+	assert.Equal(t, util.NewUint128FromInt(5000), bc.SuggestGasPrice(200))
+
+	// only the recent blocks are sampled.
+	bc.SetGasPriceBlocks(3)
+	assert.Equal(t, util.NewUint128FromInt(3000), bc.SuggestGasPrice(0))
+
+	// the samples follow the tail.
+	mint(8000)
+	assert.Equal(t, util.NewUint128FromInt(5000), bc.GasPrice())
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -88,6 +89,7 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 		storage:     chain.storage,
 		height:      1,
 		sealed:      false,
+		gasUsed:     util.NewUint128(),
 	}
 
 	context, err := GenesisDynastyContext(chain.storage, conf)
@@ -111,6 +113,16 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 		acc := genesisBlock.accState.GetOrCreateUserAccount(addr.address)
 		acc.AddBalance(util.NewUint128FromString(v.Value))
 	}
+	// add accounts exported from another chain
+	for _, v := range conf.Accounts {
+		if err := genesisBlock.loadGenesisAccount(v); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": v.Address,
+				"err":     err,
+			}).Error("Existed invalid account in genesis accounts.")
+			return nil, err
+		}
+	}
 	genesisBlock.commit()
 
 	if err := genesisBlock.Seal(); err != nil {
@@ -125,6 +137,55 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 	return genesisBlock, nil
 }
 
+func (block *Block) loadGenesisAccount(conf *corepb.GenesisAccount) error {
+	addr, err := AddressParse(conf.Address)
+	if err != nil {
+		return err
+	}
+
+	var acc state.Account
+	if len(conf.BirthTx) > 0 {
+		// the deploy tx is kept, calls of the contract load the source from it.
+		txBytes, err := byteutils.FromHex(conf.BirthTx)
+		if err != nil {
+			return err
+		}
+		pbTx := new(corepb.Transaction)
+		if err := proto.Unmarshal(txBytes, pbTx); err != nil {
+			return err
+		}
+		tx := new(Transaction)
+		if err := tx.FromProto(pbTx); err != nil {
+			return err
+		}
+		if _, err := block.txsTrie.Put(tx.hash, txBytes); err != nil {
+			return err
+		}
+		if acc, err = block.accState.CreateContractAccount(addr.address, tx.hash); err != nil {
+			return err
+		}
+	} else {
+		acc = block.accState.GetOrCreateUserAccount(addr.address)
+	}
+
+	acc.AddBalance(util.NewUint128FromString(conf.Balance))
+	acc.SetNonce(conf.Nonce)
+	for _, item := range conf.Storage {
+		key, err := byteutils.FromHex(item.Key)
+		if err != nil {
+			return err
+		}
+		value, err := byteutils.FromHex(item.Value)
+		if err != nil {
+			return err
+		}
+		if err := acc.Put(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // CheckGenesisBlock if a block is a genesis block
 func CheckGenesisBlock(block *Block) bool {
 	if block == nil {
@@ -136,6 +197,53 @@ func CheckGenesisBlock(block *Block) bool {
 	return false
 }
 
+// CheckGenesisConf returns ErrGenesisConfNotMatch if the genesis block in the
+// storage is not the one built from the genesis conf of the chain.
+func (bc *BlockChain) CheckGenesisConf() error {
+	genesis, err := NewGenesisBlock(bc.genesis, bc)
+	if err != nil {
+		return err
+	}
+	if !sameGenesis(genesis, bc.genesisBlock) {
+		return ErrGenesisConfNotMatch
+	}
+	return nil
+}
+
+// sameGenesis returns if the genesis blocks hold the same state, all the
+// genesis blocks share the same hash.
+func sameGenesis(a, b *Block) bool {
+	return a.ChainID() == b.ChainID() &&
+		a.StateRoot().Equals(b.StateRoot()) &&
+		a.DposContextHash().Equals(b.DposContextHash())
+}
+
+// CheckTail returns an error if the tail block can't be loaded from the
+// storage with its parent and its state.
+func (bc *BlockChain) CheckTail() error {
+	tail, err := bc.loadTailFromStorage()
+	if err != nil {
+		return err
+	}
+	if !tail.Hash().Equals(bc.tailBlock.Hash()) {
+		return ErrInvalidTail
+	}
+	if _, err := state.NewAccountState(tail.StateRoot(), bc.storage); err != nil {
+		return err
+	}
+	if CheckGenesisBlock(tail) {
+		return nil
+	}
+	parent, err := LoadBlockFromStorage(tail.ParentHash(), bc.storage, bc.txPool, bc.eventEmitter)
+	if err != nil {
+		return ErrMissingParentBlock
+	}
+	if parent.height+1 != tail.height {
+		return ErrInvalidTail
+	}
+	return nil
+}
+
 // DumpGenesis return the configuration of the genesis block in the storage
 func DumpGenesis(stor storage.Storage) (*corepb.Genesis, error) {
 	genesis, err := LoadBlockFromStorage(GenesisHash, stor, nil, nil)
@@ -170,3 +278,77 @@ func DumpGenesis(stor storage.Storage) (*corepb.Genesis, error) {
 		TokenDistribution: distribution,
 	}, nil
 }
+
+// ExportGenesis return a genesis configuration holding the state of a block:
+// the balance, nonce, contract and storage of every account. A chain started
+// from it continues from that state, with the dynasty of the block as genesis
+// dynasty. The state of a block never changes, the export is consistent while
+// the chain goes on.
+func ExportGenesis(block *Block) (*corepb.Genesis, error) {
+	dynasty, err := TraverseDynasty(block.dposContext.dynastyTrie)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := TraverseDynasty(block.dposContext.candidateTrie)
+	if err != nil {
+		return nil, err
+	}
+	// current dynasty goes first so that it is elected again at genesis.
+	bootstrap := []string{}
+	members := make(map[string]bool)
+	for _, v := range dynasty {
+		bootstrap = append(bootstrap, v.String())
+		members[v.String()] = true
+	}
+	for _, v := range candidates {
+		if !members[v.String()] {
+			bootstrap = append(bootstrap, v.String())
+		}
+	}
+
+	accounts, err := block.accState.Accounts()
+	if err != nil {
+		return nil, err
+	}
+	exported := []*corepb.GenesisAccount{}
+	for _, v := range accounts {
+		acc := &corepb.GenesisAccount{
+			Address: v.Address().String(),
+			Balance: v.Balance().String(),
+			Nonce:   v.Nonce(),
+		}
+		if len(v.BirthPlace()) > 0 {
+			txBytes, err := block.txsTrie.Get(v.BirthPlace())
+			if err != nil {
+				return nil, err
+			}
+			acc.BirthTx = byteutils.Hex(txBytes)
+		}
+		iter, err := v.Iterator(nil)
+		if err != nil && err != storage.ErrKeyNotFound {
+			return nil, err
+		}
+		if err == nil {
+			exist, err := iter.Next()
+			for exist {
+				acc.Storage = append(acc.Storage, &corepb.GenesisStorageItem{
+					Key:   byteutils.Hex(iter.Key()),
+					Value: byteutils.Hex(iter.Value()),
+				})
+				exist, err = iter.Next()
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		exported = append(exported, acc)
+	}
+
+	return &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: block.ChainID()},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{Dynasty: bootstrap},
+		},
+		Accounts: exported,
+	}, nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
@@ -98,7 +99,7 @@ func TestNewGenesisBlock(t *testing.T) {
 	conf := MockGenesisConf()
 	storage, err := storage.NewMemoryStorage()
 	assert.Nil(t, err)
-	chain := &BlockChain{storage: storage}
+	chain := &BlockChain{storage: storage, blockStorage: storage}
 	genesis, err := NewGenesisBlock(conf, chain)
 	assert.Nil(t, chain.storeBlockToStorage(genesis))
 	assert.Nil(t, err)
@@ -151,3 +152,70 @@ func TestNewGenesisBlock(t *testing.T) {
 	assert.Equal(t, dumpConf.Consensus.Dpos.Dynasty, conf.Consensus.Dpos.Dynasty)
 	assert.Equal(t, dumpConf.TokenDistribution, conf.TokenDistribution)
 }
+
+func TestExportGenesis(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	from := mockAddress()
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.begin()
+	user := block.accState.GetOrCreateUserAccount(from.address)
+	user.AddBalance(util.NewUint128FromInt(100))
+	user.IncrNonce()
+	deploy := mockDeployTransaction(bc.ChainID(), 2)
+	deploy.hash, err = HashTransaction(deploy)
+	assert.Nil(t, err)
+	assert.Nil(t, block.acceptTransaction(deploy))
+	contractAddr, err := deploy.GenerateContractAddress()
+	assert.Nil(t, err)
+	contract, err := block.accState.CreateContractAccount(contractAddr.address, deploy.hash)
+	assert.Nil(t, err)
+	assert.Nil(t, contract.Put([]byte("name"), []byte("NebulasToken")))
+	assert.Nil(t, contract.Put([]byte("totalSupply"), []byte("1000000000")))
+	block.commit()
+	block.SetMiner(from)
+	assert.Nil(t, block.Seal())
+
+	conf, err := ExportGenesis(block)
+	assert.Nil(t, err)
+	assert.Equal(t, bc.ChainID(), conf.Meta.ChainId)
+	assert.Len(t, conf.Consensus.Dpos.Dynasty, len(MockDynasty))
+	assert.Subset(t, MockDynasty, conf.Consensus.Dpos.Dynasty)
+
+	// a chain started from the exported genesis has the same accounts.
+	neb := testNeb()
+	neb.genesis = conf
+	exported, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	genesis := exported.GenesisBlock()
+	dynasty, err := TraverseDynasty(block.dposContext.dynastyTrie)
+	assert.Nil(t, err)
+	got, err := TraverseDynasty(genesis.dposContext.dynastyTrie)
+	assert.Nil(t, err)
+	assert.Equal(t, dynasty, got)
+	for _, addr := range []*Address{from, contractAddr} {
+		acc, err := block.accState.GetContractAccount(addr.address)
+		assert.Nil(t, err)
+		got, err := genesis.accState.GetContractAccount(addr.address)
+		assert.Nil(t, err)
+		assert.Equal(t, acc.Balance(), got.Balance())
+		assert.Equal(t, acc.Nonce(), got.Nonce())
+		assert.Equal(t, acc.BirthPlace(), got.BirthPlace())
+		assert.Equal(t, acc.VarsHash(), got.VarsHash())
+	}
+	contract, err = genesis.accState.GetContractAccount(contractAddr.address)
+	assert.Nil(t, err)
+	name, err := contract.Get([]byte("name"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("NebulasToken"), name)
+	birthTx, err := genesis.GetTransaction(contract.BirthPlace())
+	assert.Nil(t, err)
+	assert.Equal(t, deploy.data, birthTx.data)
+
+	// exporting the genesis again gives the same accounts.
+	again, err := ExportGenesis(genesis)
+	assert.Nil(t, err)
+	assert.Equal(t, conf.Accounts, again.Accounts)
+}
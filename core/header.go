@@ -0,0 +1,189 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// the fields of corepb.Block read without its transactions.
+const (
+	pbBlockHeaderField = 1
+	pbBlockHeightField = 3
+)
+
+// ChainHeader is the header of a stored block with its height, loaded without
+// the transactions of the block.
+type ChainHeader struct {
+	header *BlockHeader
+	height uint64
+}
+
+func newChainHeader(block *Block) *ChainHeader {
+	return &ChainHeader{header: block.header, height: block.height}
+}
+
+// Hash returns the hash of the block.
+func (h *ChainHeader) Hash() byteutils.Hash {
+	return h.header.hash
+}
+
+// ParentHash returns the hash of the parent block.
+func (h *ChainHeader) ParentHash() byteutils.Hash {
+	return h.header.parentHash
+}
+
+// Height returns the height of the block.
+func (h *ChainHeader) Height() uint64 {
+	return h.height
+}
+
+// Timestamp returns the timestamp of the block.
+func (h *ChainHeader) Timestamp() int64 {
+	return h.header.timestamp
+}
+
+// Coinbase returns the coinbase of the block.
+func (h *ChainHeader) Coinbase() *Address {
+	return h.header.coinbase
+}
+
+// ChainID returns the chain id of the block.
+func (h *ChainHeader) ChainID() uint32 {
+	return h.header.chainID
+}
+
+// StateRoot returns the state root of the block.
+func (h *ChainHeader) StateRoot() byteutils.Hash {
+	return h.header.stateRoot
+}
+
+// GasUsed returns the gas used by the transactions of the block, nil if unknown.
+func (h *ChainHeader) GasUsed() *util.Uint128 {
+	return h.header.gasUsed
+}
+
+// ToProto converts the header to proto BlockHeader.
+func (h *ChainHeader) ToProto() (*corepb.BlockHeader, error) {
+	pbHeader, err := h.header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return pbHeader.(*corepb.BlockHeader), nil
+}
+
+// decodeChainHeader decodes the header and the height of a block stored as
+// corepb.Block, the transactions and the witness are skipped undecoded.
+func decodeChainHeader(data []byte) (*ChainHeader, error) {
+	var (
+		pbHeader *corepb.BlockHeader
+		height   uint64
+	)
+	for len(data) > 0 {
+		num, value, rest, err := nextProtoField(data)
+		if err != nil {
+			return nil, err
+		}
+		switch num {
+		case pbBlockHeaderField:
+			pbHeader = new(corepb.BlockHeader)
+			if err := proto.Unmarshal(value, pbHeader); err != nil {
+				return nil, err
+			}
+		case pbBlockHeightField:
+			height, _ = proto.DecodeVarint(value)
+		}
+		data = rest
+	}
+	header := new(BlockHeader)
+	if err := header.FromProto(pbHeader); err != nil {
+		return nil, err
+	}
+	return &ChainHeader{header: header, height: height}, nil
+}
+
+// GetBlockHeader returns the header of the block of given hash from the
+// caches or the local storage, the transactions of a stored block are not
+// decoded.
+func (bc *BlockChain) GetBlockHeader(hash byteutils.Hash) (*ChainHeader, error) {
+	key := hash.Hex()
+	if v, ok := bc.cachedHeaders.Get(key); ok {
+		blockCacheHitCounter.Inc(1)
+		return v.(*ChainHeader), nil
+	}
+	if v, ok := bc.cachedBlocks.Get(key); ok {
+		blockCacheHitCounter.Inc(1)
+		return newChainHeader(v.(*Block)), nil
+	}
+	blockCacheMissCounter.Inc(1)
+	value, err := storage.WithNamespace(bc.storage, storage.NamespaceBlocks).Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	header, err := decodeChainHeader(value)
+	if err != nil {
+		return nil, err
+	}
+	bc.cachedHeaders.Add(key, header)
+	return header, nil
+}
+
+// GetHeaderByHeight returns the header of the block in given height, the
+// block is guaranteed in canonical chain.
+func (bc *BlockChain) GetHeaderByHeight(height uint64) (*ChainHeader, error) {
+	if err := bc.lockHeightIndex(height); err != nil {
+		return nil, err
+	}
+	defer bc.heightIndexLock.Unlock()
+
+	return bc.getHeaderByHeight(height)
+}
+
+func (bc *BlockChain) getHeaderByHeight(height uint64) (*ChainHeader, error) {
+	if height < bc.genesisBlock.height {
+		return nil, ErrInvalidBlockHeight
+	}
+	if height > bc.tailBlock.height {
+		return nil, ErrCannotFindBlockAtGivenHeight
+	}
+	if height < bc.heightIndexFloor {
+		return nil, ErrHeightIndexNotVerified
+	}
+	header := bc.getHeaderFromHeightIndex(height)
+	if header == nil || header.height != height {
+		return nil, ErrCannotFindBlockAtGivenHeight
+	}
+	return header, nil
+}
+
+func (bc *BlockChain) getHeaderFromHeightIndex(height uint64) *ChainHeader {
+	blockHash, err := bc.indexStorage.Get(byteutils.FromUint64(height))
+	if err != nil {
+		return nil
+	}
+	header, err := bc.GetBlockHeader(blockHash)
+	if err != nil {
+		return nil
+	}
+	return header
+}
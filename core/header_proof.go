@@ -0,0 +1,188 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MaxHeaderProofRange is the max headers of a header proof.
+const MaxHeaderProofRange = 1024
+
+// ProvedHeader is the header of a block with the hashes of its transactions,
+// which the block hash commits to as well.
+type ProvedHeader struct {
+	Height   uint64
+	Header   *corepb.BlockHeader
+	TxHashes []byteutils.Hash
+}
+
+// HeaderBatch is the consecutive headers of a dynasty with its validators,
+// which rebuild the dynasty root, so that a light client verifies the headers
+// were signed by the dynasty without the state. The proofs of the validators
+// against the dynasty root let a client check a single validator.
+type HeaderBatch struct {
+	DynastyRoot byteutils.Hash
+	Validators  []byteutils.Hash
+	Proofs      []trie.MerkleProof
+	Headers     []*ProvedHeader
+}
+
+// GetHeaderProof returns the headers of the canonical chain from height
+// from to to, batched by dynasty.
+func (bc *BlockChain) GetHeaderProof(from, to uint64) ([]*HeaderBatch, error) {
+	if from == 0 || to < from || to-from >= MaxHeaderProofRange {
+		return nil, ErrInvalidHeaderRange
+	}
+
+	var batches []*HeaderBatch
+	var batch *HeaderBatch
+	for height := from; height <= to; height++ {
+		block, err := bc.GetBlockByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil || !batch.DynastyRoot.Equals(block.DposContext().DynastyRoot) {
+			if batch, err = newHeaderBatch(block); err != nil {
+				return nil, err
+			}
+			batches = append(batches, batch)
+		}
+
+		header, err := block.header.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		txHashes := make([]byteutils.Hash, len(block.transactions))
+		for i, tx := range block.transactions {
+			txHashes[i] = tx.Hash()
+		}
+		batch.Headers = append(batch.Headers, &ProvedHeader{
+			Height:   block.height,
+			Header:   header.(*corepb.BlockHeader),
+			TxHashes: txHashes,
+		})
+	}
+	return batches, nil
+}
+
+func newHeaderBatch(block *Block) (*HeaderBatch, error) {
+	validators, err := TraverseDynasty(block.dposContext.dynastyTrie)
+	if err != nil {
+		return nil, err
+	}
+	batch := &HeaderBatch{
+		DynastyRoot: block.DposContext().DynastyRoot,
+		Validators:  validators,
+	}
+	for _, v := range validators {
+		proof, err := block.dposContext.dynastyTrie.Prove(v)
+		if err != nil {
+			return nil, err
+		}
+		batch.Proofs = append(batch.Proofs, proof)
+	}
+	return batch, nil
+}
+
+// VerifyHeaderProof verifies the batches are a chain of headers following
+// the trusted parent header, each signed by a validator of its dynasty. The
+// dynasty of a header is the dynasty or the next dynasty of its parent, so
+// every dynasty is chained from the trusted one; a gap of a whole dynasty
+// without blocks can't be verified without the state. It returns the hash of
+// the last header.
+func VerifyHeaderProof(chainID uint32, parent *corepb.BlockHeader, batches []*HeaderBatch) (byteutils.Hash, error) {
+	if parent == nil || parent.DposContext == nil {
+		return nil, ErrInvalidHeaderProof
+	}
+	parentHash := byteutils.Hash(parent.Hash)
+	dynastyRoot, nextDynastyRoot := byteutils.Hash(parent.DposContext.DynastyRoot), byteutils.Hash(parent.DposContext.NextDynastyRoot)
+	var height uint64
+	for _, batch := range batches {
+		if len(batch.Headers) == 0 {
+			return nil, ErrInvalidHeaderProof
+		}
+		validators, err := verifyDynasty(batch.DynastyRoot, batch.Validators)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, proved := range batch.Headers {
+			header := new(BlockHeader)
+			if err := header.FromProto(proved.Header); err != nil {
+				return nil, ErrInvalidHeaderProof
+			}
+			if header.chainID != chainID || !batch.DynastyRoot.Equals(header.dposContext.DynastyRoot) {
+				return nil, ErrInvalidHeaderProof
+			}
+			if !batch.DynastyRoot.Equals(dynastyRoot) && !batch.DynastyRoot.Equals(nextDynastyRoot) {
+				return nil, ErrInvalidHeaderProof
+			}
+			if !header.parentHash.Equals(parentHash) {
+				return nil, ErrInvalidHeaderProof
+			}
+			if height > 0 && proved.Height != height+1 {
+				return nil, ErrInvalidHeaderProof
+			}
+
+			hash := hashBlockHeader(header, proved.TxHashes)
+			if !hash.Equals(header.hash) {
+				return nil, ErrInvalidHeaderProof
+			}
+			signer, err := RecoverSignerAddress(keystore.Algorithm(header.alg), hash, header.sign)
+			if err != nil || !validators[byteutils.Hex(signer.Bytes())] {
+				return nil, ErrInvalidHeaderProof
+			}
+			parentHash, height = hash, proved.Height
+			dynastyRoot, nextDynastyRoot = header.dposContext.DynastyRoot, header.dposContext.NextDynastyRoot
+		}
+	}
+	return parentHash, nil
+}
+
+// verifyDynasty returns the validators if they rebuild the dynasty root, the
+// dynasty trie maps a validator to itself.
+func verifyDynasty(root byteutils.Hash, validators []byteutils.Hash) (map[string]bool, error) {
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	dynasty, err := trie.NewTrie(nil, stor)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]bool)
+	for _, v := range validators {
+		if set[byteutils.Hex(v)] {
+			return nil, ErrInvalidHeaderProof
+		}
+		set[byteutils.Hex(v)] = true
+		if _, err := dynasty.Put(v, v); err != nil {
+			return nil, err
+		}
+	}
+	if !root.Equals(dynasty.RootHash()) {
+		return nil, ErrInvalidHeaderProof
+	}
+	return set, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetHeaderProof(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+
+	_, err := bc.GetHeaderProof(0, 1)
+	assert.Equal(t, ErrInvalidHeaderRange, err)
+	_, err = bc.GetHeaderProof(2, 1)
+	assert.Equal(t, ErrInvalidHeaderRange, err)
+	_, err = bc.GetHeaderProof(1, MaxHeaderProofRange+1)
+	assert.Equal(t, ErrInvalidHeaderRange, err)
+	_, err = bc.GetHeaderProof(1, 2)
+	assert.Equal(t, ErrCannotFindBlockAtGivenHeight, err)
+
+	batches, err := bc.GetHeaderProof(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(batches))
+	validators, _ := TraverseDynasty(bc.genesisBlock.dposContext.dynastyTrie)
+	assert.Equal(t, validators, batches[0].Validators)
+	for i, v := range batches[0].Validators {
+		assert.Nil(t, trie.VerifyValue(batches[0].DynastyRoot, v, v, batches[0].Proofs[i]))
+	}
+	assert.Equal(t, []byte(bc.genesisBlock.Hash()), batches[0].Headers[0].Header.Hash)
+}
+
+func TestVerifyHeaderProof(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	// a dynasty of a validator whose key is known.
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	validator, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+
+	stor, _ := storage.NewMemoryStorage()
+	dynasty, _ := trie.NewBatchTrie(nil, stor)
+	dynasty.Put(validator.Bytes(), validator.Bytes())
+	proof, _ := dynasty.Prove(validator.Bytes())
+
+	parent := bc.tailBlock
+	batch := &HeaderBatch{
+		DynastyRoot: dynasty.RootHash(),
+		Validators:  []byteutils.Hash{validator.Bytes()},
+		Proofs:      []trie.MerkleProof{proof},
+	}
+	for i := uint64(1); i <= 2; i++ {
+		block, _ := NewBlock(bc.ChainID(), validator, parent)
+		block.header.timestamp = parent.header.timestamp + BlockInterval
+		block.SetMiner(validator)
+		block.Seal()
+		block.header.dposContext.DynastyRoot = batch.DynastyRoot
+		block.header.hash = HashBlock(block)
+		assert.Nil(t, block.Sign(signature))
+
+		header, _ := block.header.ToProto()
+		batch.Headers = append(batch.Headers, &ProvedHeader{
+			Height: block.height,
+			Header: header.(*corepb.BlockHeader),
+		})
+		parent = block
+	}
+
+	// the dynasty is the next one of the trusted parent.
+	pbParent, _ := bc.tailBlock.header.ToProto()
+	trusted := *pbParent.(*corepb.BlockHeader)
+	trusted.DposContext = &corepb.DposContext{
+		DynastyRoot:     bc.tailBlock.header.dposContext.DynastyRoot,
+		NextDynastyRoot: dynasty.RootHash(),
+	}
+	last, err := VerifyHeaderProof(bc.ChainID(), &trusted, []*HeaderBatch{batch})
+	assert.Nil(t, err)
+	assert.Equal(t, parent.Hash(), last)
+
+	_, err = VerifyHeaderProof(bc.ChainID(), nil, []*HeaderBatch{batch})
+	assert.Equal(t, ErrInvalidHeaderProof, err)
+	pbLast, _ := parent.header.ToProto()
+	_, err = VerifyHeaderProof(bc.ChainID(), pbLast.(*corepb.BlockHeader), []*HeaderBatch{batch})
+	assert.Equal(t, ErrInvalidHeaderProof, err)
+	_, err = VerifyHeaderProof(bc.ChainID()+1, &trusted, []*HeaderBatch{batch})
+	assert.Equal(t, ErrInvalidHeaderProof, err)
+
+	// a dynasty not chained from the trusted parent.
+	untrusted := trusted
+	untrusted.DposContext = &corepb.DposContext{
+		DynastyRoot:     trusted.DposContext.DynastyRoot,
+		NextDynastyRoot: trusted.DposContext.DynastyRoot,
+	}
+	_, err = VerifyHeaderProof(bc.ChainID(), &untrusted, []*HeaderBatch{batch})
+	assert.Equal(t, ErrInvalidHeaderProof, err)
+
+	// the validators must rebuild the dynasty root.
+	batch.Validators = append(batch.Validators, mockAddress().Bytes())
+	_, err = VerifyHeaderProof(bc.ChainID(), &trusted, []*HeaderBatch{batch})
+	assert.Equal(t, ErrInvalidHeaderProof, err)
+	batch.Validators = batch.Validators[:1]
+
+	// a header changed after it was signed.
+	batch.Headers[1].Header.Timestamp++
+	_, err = VerifyHeaderProof(bc.ChainID(), &trusted, []*HeaderBatch{batch})
+	assert.Equal(t, ErrInvalidHeaderProof, err)
+	batch.Headers[1].Header.Timestamp--
+
+	// a header signed out of the dynasty.
+	outsider, _ := crypto.NewSignature(keystore.SECP256K1)
+	outsider.InitSign(secp256k1.GeneratePrivateKey())
+	batch.Headers[1].Header.Sign, _ = outsider.Sign(batch.Headers[1].Header.Hash)
+	_, err = VerifyHeaderProof(bc.ChainID(), &trusted, []*HeaderBatch{batch})
+	assert.Equal(t, ErrInvalidHeaderProof, err)
+}
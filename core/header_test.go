@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlockHeader(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	// the header decoded alone matches the full block.
+	pbBlock, err := blocks[1].ToProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(pbBlock)
+	assert.Nil(t, err)
+	header, err := decodeChainHeader(data)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[1].Hash(), header.Hash())
+	assert.Equal(t, blocks[1].ParentHash(), header.ParentHash())
+	assert.Equal(t, blocks[1].Height(), header.Height())
+	assert.Equal(t, blocks[1].Timestamp(), header.Timestamp())
+	assert.Equal(t, blocks[1].Coinbase(), header.Coinbase())
+	_, err = decodeChainHeader(data[:len(data)-1])
+	assert.NotNil(t, err)
+
+	// a chain restarted loads the headers without caching the blocks.
+	bc, _ = NewBlockChain(neb)
+	assert.Nil(t, bc.SetBlockCacheConfig(DefaultBlockCacheConfig()))
+	header, err = bc.GetBlockHeader(blocks[0].Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[0].Height(), header.Height())
+	assert.False(t, bc.cachedBlocks.Contains(blocks[0].Hash().Hex()))
+	assert.True(t, bc.cachedHeaders.Contains(blocks[0].Hash().Hex()))
+	_, err = bc.GetBlockHeader(mockAddress().Bytes())
+	assert.NotNil(t, err)
+
+	header, err = bc.GetHeaderByHeight(blocks[2].Height())
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[2].Hash(), header.Hash())
+	header, err = bc.GetHeaderByHeight(1)
+	assert.Nil(t, err)
+	assert.Equal(t, bc.GenesisBlock().Hash(), header.Hash())
+	_, err = bc.GetHeaderByHeight(blocks[2].Height() + 1)
+	assert.Equal(t, ErrCannotFindBlockAtGivenHeight, err)
+	_, err = bc.GetHeaderByHeight(0)
+	assert.Equal(t, ErrInvalidBlockHeight, err)
+
+}
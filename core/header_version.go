@@ -0,0 +1,141 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// Block header versions.
+const (
+	// BlockHeaderV0 is the header before versioning.
+	BlockHeaderV0 = uint32(0)
+	// BlockHeaderV1 commits to its version in the block hash.
+	BlockHeaderV1 = uint32(1)
+
+	// MaxBlockHeaderVersion is the newest header version the node verifies.
+	MaxBlockHeaderVersion = BlockHeaderV1
+
+	// MinOptionalHeaderField is the first field number of the optional header
+	// fields, ignored by the nodes that don't know them.
+	MinOptionalHeaderField = 1000
+)
+
+// blockHeaderFields are the numbers of the header fields the node knows.
+var blockHeaderFields = func() map[uint64]bool {
+	fields := make(map[uint64]bool)
+	for _, prop := range proto.GetProperties(reflect.TypeOf(corepb.BlockHeader{})).Prop {
+		if prop.Tag > 0 {
+			fields[uint64(prop.Tag)] = true
+		}
+	}
+	return fields
+}()
+
+// CheckBlockEncoding returns ErrUnknownCriticalHeaderField if the header of
+// the encoded block has a critical field the node doesn't know, the fields
+// dropped by the decoding would make the block verified without them.
+func CheckBlockEncoding(data []byte) error {
+	var header []byte
+	for len(data) > 0 {
+		num, value, rest, err := nextProtoField(data)
+		if err != nil {
+			return err
+		}
+		if num == 1 {
+			header = value
+		}
+		data = rest
+	}
+	for len(header) > 0 {
+		num, _, rest, err := nextProtoField(header)
+		if err != nil {
+			return err
+		}
+		if num < MinOptionalHeaderField && !blockHeaderFields[num] {
+			return ErrUnknownCriticalHeaderField
+		}
+		header = rest
+	}
+	return nil
+}
+
+// CheckEmbeddedBlockEncoding checks the encoding of the blocks embedded in
+// the field of the encoded message, e.g. the blocks of NetBlocks, as
+// CheckBlockEncoding.
+func CheckEmbeddedBlockEncoding(data []byte, field uint64) error {
+	for len(data) > 0 {
+		num, value, rest, err := nextProtoField(data)
+		if err != nil {
+			return err
+		}
+		if num == field {
+			if err := CheckBlockEncoding(value); err != nil {
+				return err
+			}
+		}
+		data = rest
+	}
+	return nil
+}
+
+// nextProtoField decodes the first field of the encoded message, the value of
+// a length-delimited field is without its length.
+func nextProtoField(data []byte) (num uint64, value []byte, rest []byte, err error) {
+	key, n := proto.DecodeVarint(data)
+	if n == 0 || key>>3 == 0 {
+		return 0, nil, nil, ErrInvalidProtoToBlock
+	}
+	data = data[n:]
+
+	size := 0
+	switch key & 7 {
+	case proto.WireVarint:
+		if _, size = proto.DecodeVarint(data); size == 0 {
+			return 0, nil, nil, ErrInvalidProtoToBlock
+		}
+	case proto.WireFixed64:
+		size = 8
+	case proto.WireBytes:
+		l, n := proto.DecodeVarint(data)
+		if n == 0 || l > uint64(len(data)-n) {
+			return 0, nil, nil, ErrInvalidProtoToBlock
+		}
+		data, size = data[n:], int(l)
+	case proto.WireFixed32:
+		size = 4
+	default:
+		return 0, nil, nil, ErrInvalidProtoToBlock
+	}
+	if size > len(data) {
+		return 0, nil, nil, ErrInvalidProtoToBlock
+	}
+	return key >> 3, data[:size], data[size:], nil
+}
+
+// BlockHeaderVersion returns the version of the headers at the height.
+func (f *Forks) BlockHeaderVersion(height uint64) uint32 {
+	if isForkActive(f.HeaderV1Height, height) {
+		return BlockHeaderV1
+	}
+	return BlockHeaderV0
+}
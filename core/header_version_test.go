@@ -0,0 +1,118 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBlockEncoding(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	coinbase := mockAddress()
+	block, _ := bc.NewBlock(coinbase)
+	block.SetMiner(coinbase)
+	block.Seal()
+	pbBlock, _ := block.ToProto()
+	pbHeader := pbBlock.(*corepb.Block).Header
+
+	encode := func(extra []byte) []byte {
+		header, _ := proto.Marshal(pbHeader)
+		header = append(header, extra...)
+		pbBlock.(*corepb.Block).Header = nil
+		data, _ := proto.Marshal(pbBlock)
+		pbBlock.(*corepb.Block).Header = pbHeader
+		data = append(data, proto.EncodeVarint(1<<3|proto.WireBytes)...)
+		data = append(data, proto.EncodeVarint(uint64(len(header)))...)
+		return append(data, header...)
+	}
+	field := func(num uint64) []byte {
+		return append(proto.EncodeVarint(num<<3|proto.WireVarint), 1)
+	}
+
+	assert.Nil(t, CheckBlockEncoding(encode(nil)))
+	assert.Nil(t, CheckBlockEncoding(encode(field(MinOptionalHeaderField+1))))
+	assert.Equal(t, ErrUnknownCriticalHeaderField, CheckBlockEncoding(encode(field(99))))
+	assert.Equal(t, ErrInvalidProtoToBlock, CheckBlockEncoding(encode([]byte{0x0a, 0x05})))
+
+	// the blocks embedded in the sync messages.
+	embed := func(blocks ...[]byte) []byte {
+		data, _ := proto.Marshal(&corepb.NetBlocks{From: "peer", Batch: 1})
+		for _, b := range blocks {
+			data = append(data, proto.EncodeVarint(3<<3|proto.WireBytes)...)
+			data = append(data, proto.EncodeVarint(uint64(len(b)))...)
+			data = append(data, b...)
+		}
+		return data
+	}
+	assert.Nil(t, CheckEmbeddedBlockEncoding(embed(encode(nil), encode(field(MinOptionalHeaderField+1))), 3))
+	assert.Equal(t, ErrUnknownCriticalHeaderField, CheckEmbeddedBlockEncoding(embed(encode(nil), encode(field(99))), 3))
+
+	// the optional fields are dropped by the decoding.
+	decoded := new(corepb.Block)
+	assert.Nil(t, proto.Unmarshal(encode(field(MinOptionalHeaderField+1)), decoded))
+	assert.Equal(t, block.Hash(), HashBlock(mustBlockFromProto(t, decoded)))
+}
+
+func mustBlockFromProto(t *testing.T, pbBlock *corepb.Block) *Block {
+	block := new(Block)
+	assert.Nil(t, block.FromProto(pbBlock))
+	return block
+}
+
+func TestBlockHeaderVersion(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	coinbase := mockAddress()
+	block, _ := bc.NewBlock(coinbase)
+	assert.Equal(t, BlockHeaderV0, block.header.version)
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{HeaderV1Height: 2}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	// the version is committed in the hash from v1 on.
+	v0 := HashBlock(block)
+	block.header.version = BlockHeaderV1
+	assert.NotEqual(t, v0, HashBlock(block))
+
+	block, _ = bc.NewBlock(coinbase)
+	assert.Equal(t, BlockHeaderV1, block.header.version)
+	block.header.timestamp = BlockInterval
+	block.SetMiner(coinbase)
+	block.Seal()
+
+	linked, _ := mockBlockFromNetwork(block)
+	assert.Nil(t, linked.LinkParentBlock(bc.tailBlock))
+	linked, _ = mockBlockFromNetwork(block)
+	linked.header.version = BlockHeaderV0
+	assert.Equal(t, ErrInvalidBlockHeaderVersion, linked.LinkParentBlock(bc.tailBlock))
+
+	linked.header.version = MaxBlockHeaderVersion + 1
+	assert.Equal(t, ErrUnsupportedBlockHeaderVersion, linked.VerifyIntegrity(bc.ChainID(), c))
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+var libHeightGauge = metrics.GetOrRegisterGauge("neb.block.lib", nil)
+
+// LIB returns the latest irreversible block, the highest canonical block
+// confirmed by ConfirmSize distinct miners of its dynasty, or the checkpoint
+// of the max reorg depth if it's higher. No fork reverting it becomes the tail.
+func (bc *BlockChain) LIB() *Block {
+	bc.libLock.RLock()
+	defer bc.libLock.RUnlock()
+	return bc.lib
+}
+
+// IsFinal returns true if the block is in the canonical chain at or below the
+// latest irreversible block, it can't be reverted by a fork.
+func (bc *BlockChain) IsFinal(block *Block) bool {
+	if block.height > bc.LIB().height {
+		return false
+	}
+	canonical, err := bc.GetBlockByHeight(block.height)
+	return err == nil && canonical.Hash().Equals(block.Hash())
+}
+
+// updateLIB moves the lib up to the highest block of the new tail's chain
+// confirmed irreversible, it's called with the new tail set.
+func (bc *BlockChain) updateLIB(tail *Block) {
+	lib := bc.LIB()
+
+	// a block is confirmed when it and the blocks after it in the same
+	// dynasty are minted by ConfirmSize distinct miners.
+	miners := make(map[string]bool)
+	dynasty := int64(-1)
+	for block := tail; block != nil && block.height > lib.height; block = bc.GetBlock(block.header.parentHash) {
+		if d := block.Timestamp() / DynastyInterval; d != dynasty {
+			dynasty = d
+			miners = make(map[string]bool)
+		}
+		if miner := blockMiner(block); miner != nil {
+			miners[miner.String()] = true
+		}
+		if len(miners) >= ConfirmSize {
+			lib = block
+			break
+		}
+	}
+	if checkpoint := bc.Checkpoint(); checkpoint > lib.height {
+		if block, err := bc.getBlockByHeight(checkpoint); err == nil {
+			lib = block
+		}
+	}
+	if lib == bc.LIB() {
+		return
+	}
+
+	if err := bc.indexStorage.Put([]byte(LIB), lib.Hash()); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"lib": lib,
+			"err": err,
+		}).Error("Failed to store the latest irreversible block.")
+		return
+	}
+	bc.libLock.Lock()
+	bc.lib = lib
+	bc.libLock.Unlock()
+	libHeightGauge.Update(int64(lib.height))
+	logging.VLog().WithFields(logrus.Fields{
+		"lib":  lib,
+		"tail": tail,
+	}).Info("Update the latest irreversible block.")
+}
+
+// blockMiner returns the miner of the block, recovered from its signature for
+// a block loaded from the storage, whose miner isn't set, nil if unsigned.
+func blockMiner(block *Block) *Address {
+	if block.miner != nil {
+		return block.miner
+	}
+	miner, err := RecoverSignerAddress(keystore.Algorithm(block.header.alg), block.Hash(), block.header.sign)
+	if err != nil {
+		return nil
+	}
+	return miner
+}
+
+func (bc *BlockChain) loadLIBFromStorage() (*Block, error) {
+	hash, err := bc.indexStorage.Get([]byte(LIB))
+	if err == storage.ErrKeyNotFound {
+		return bc.genesisBlock, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lib := bc.GetBlock(hash)
+	if lib == nil {
+		return nil, ErrMissingParentBlock
+	}
+	return lib, nil
+}
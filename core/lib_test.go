@@ -0,0 +1,122 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLIB(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	assert.Equal(t, bc.genesisBlock.Hash(), bc.LIB().Hash())
+
+	/*
+		genesis -- 1 - 2 - 3 - 4 - 5 - 6
+		        \_ fork
+	*/
+	var blocks []*Block
+	for i := 0; i < 6; i++ {
+		miner := &Address{[]byte(fmt.Sprintf("01234567890123456789000%d", i%ConfirmSize))}
+		block, _ := bc.NewBlock(miner)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(miner)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	fork, _ := bc.NewBlockFromParent(blocks[0].miner, bc.genesisBlock)
+	fork.header.timestamp = BlockInterval * 7
+	fork.CollectTransactions(0)
+	fork.SetMiner(blocks[0].miner)
+	fork.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(fork)))
+
+	// 2 is the highest block followed by ConfirmSize distinct miners.
+	assert.Equal(t, blocks[1].Hash(), bc.LIB().Hash())
+	assert.True(t, bc.IsFinal(blocks[0]))
+	assert.True(t, bc.IsFinal(blocks[1]))
+	assert.False(t, bc.IsFinal(blocks[2]))
+	assert.False(t, bc.IsFinal(fork))
+
+	assert.Equal(t, ErrRevertIrreversibleBlock, bc.SetTailBlock(fork))
+	assert.Equal(t, blocks[5].Hash(), bc.TailBlock().Hash())
+
+	// the lib is kept over restarts.
+	bc, _ = NewBlockChain(neb)
+	assert.Equal(t, blocks[1].Hash(), bc.LIB().Hash())
+}
+
+func TestLIB_Checkpoint(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Consensus.Dpos.MaxReorgDepth = 2
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 4; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	// a single miner never confirms a block, the checkpoint is irreversible.
+	assert.Equal(t, bc.Checkpoint(), bc.LIB().Height())
+	assert.Equal(t, blocks[1].Hash(), bc.LIB().Hash())
+}
+
+func TestLIB_BlockMiner(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	miner, _ := NewAddressFromPublicKey(pubdata)
+	block, _ := bc.NewBlock(miner)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(0)
+	block.SetMiner(miner)
+	block.Seal()
+	assert.Equal(t, miner, blockMiner(block))
+
+	// a block loaded from the storage has no miner, unless recovered.
+	block.SetMiner(nil)
+	assert.Nil(t, blockMiner(block))
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	assert.Nil(t, block.Sign(signature))
+	assert.True(t, miner.Equals(blockMiner(block)))
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// LibraryContract is the system contract storing the libraries shared by the
+// contracts. A source is stored once whatever the count of its versions.
+var LibraryContract, _ = NewContractAddressFromHash(hash.Sha3256([]byte("nebulas.library")))
+
+// Keys in the storage of the library contract.
+var (
+	librarySourceKeyPrefix  = []byte("source")
+	libraryVersionKeyPrefix = []byte("version")
+	libraryOwnerKeyPrefix   = []byte("owner")
+)
+
+var (
+	libraryNamePattern    = regexp.MustCompile("^[A-Za-z][A-Za-z0-9_]{0,63}$")
+	libraryVersionPattern = regexp.MustCompile("^[0-9]+\\.[0-9]+\\.[0-9]+$")
+	libraryHashPattern    = regexp.MustCompile("^[0-9a-f]{64}$")
+)
+
+// LibraryPayload carry the JavaScript source of a library version. The first
+// sender deploying a library name becomes its owner, the only one allowed to
+// deploy its later versions. A version is pinned to its source once deployed.
+type LibraryPayload struct {
+	Name    string
+	Version string
+	Source  string
+}
+
+// LoadLibraryPayload from bytes
+func LoadLibraryPayload(bytes []byte) (*LibraryPayload, error) {
+	payload := &LibraryPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewLibraryPayload with name, version & source
+func NewLibraryPayload(name, version, source string) *LibraryPayload {
+	return &LibraryPayload{
+		Name:    name,
+		Version: version,
+		Source:  source,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *LibraryPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *LibraryPayload) BaseGasCount() *util.Uint128 {
+	return LibraryBaseGasCount
+}
+
+// Execute the library payload in tx, deploy a library version
+func (payload *LibraryPayload) Execute(ctx *PayloadContext) (*util.Uint128, error) {
+	if !libraryNamePattern.MatchString(payload.Name) || !libraryVersionPattern.MatchString(payload.Version) || len(payload.Source) == 0 {
+		return ZeroGasCount, ErrInvalidLibraryPayload
+	}
+	if err := nvm.CheckNumericPolicy(payload.Source); err != nil {
+		return ZeroGasCount, err
+	}
+
+	contract := ctx.accState.GetOrCreateUserAccount(LibraryContract.Bytes())
+	sender := ctx.tx.from.Bytes()
+	owner, err := contract.Get(libraryOwnerKey(payload.Name))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return ZeroGasCount, err
+	}
+	if err == storage.ErrKeyNotFound {
+		if err := contract.Put(libraryOwnerKey(payload.Name), sender); err != nil {
+			return ZeroGasCount, err
+		}
+	} else if !byteutils.Equal(owner, sender) {
+		return ZeroGasCount, ErrNotLibraryOwner
+	}
+
+	if _, err := contract.Get(libraryVersionKey(payload.Name, payload.Version)); err != storage.ErrKeyNotFound {
+		if err == nil {
+			return ZeroGasCount, ErrLibraryVersionExists
+		}
+		return ZeroGasCount, err
+	}
+
+	libHash := byteutils.Hash(hash.Sha3256([]byte(payload.Source)))
+	if _, err := contract.Get(librarySourceKey(libHash)); err == storage.ErrKeyNotFound {
+		if err := contract.Put(librarySourceKey(libHash), []byte(payload.Source)); err != nil {
+			return ZeroGasCount, err
+		}
+	} else if err != nil {
+		return ZeroGasCount, err
+	}
+	if err := contract.Put(libraryVersionKey(payload.Name, payload.Version), libHash); err != nil {
+		return ZeroGasCount, err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":   ctx.block,
+		"tx":      ctx.tx,
+		"name":    payload.Name,
+		"version": payload.Version,
+		"hash":    libHash.Hex(),
+	}).Info("Library deployed.")
+	return ZeroGasCount, nil
+}
+
+// the keys are hashed, since all the keys of a trie have the same length.
+func librarySourceKey(libHash byteutils.Hash) []byte {
+	return hash.Sha3256(librarySourceKeyPrefix, libHash)
+}
+
+func libraryVersionKey(name, version string) []byte {
+	return hash.Sha3256(libraryVersionKeyPrefix, []byte(name), []byte("@"), []byte(version))
+}
+
+func libraryOwnerKey(name string) []byte {
+	return hash.Sha3256(libraryOwnerKeyPrefix, []byte(name))
+}
+
+// LoadLibrary return the hash and the source of the library referenced by
+// the hex hash of its source, or by its name and version as "name@version".
+func LoadLibrary(accState state.AccountState, ref string) (byteutils.Hash, string, error) {
+	contract, err := accState.GetContractAccount(LibraryContract.Bytes())
+	if err != nil {
+		return nil, "", ErrLibraryNotFound
+	}
+
+	var libHash byteutils.Hash
+	if libraryHashPattern.MatchString(ref) {
+		libHash, err = byteutils.FromHex(ref)
+		if err != nil {
+			return nil, "", ErrLibraryNotFound
+		}
+	} else {
+		idx := strings.LastIndex(ref, "@")
+		if idx < 0 {
+			return nil, "", ErrLibraryNotFound
+		}
+		libHash, err = contract.Get(libraryVersionKey(ref[:idx], ref[idx+1:]))
+		if err == storage.ErrKeyNotFound {
+			return nil, "", ErrLibraryNotFound
+		}
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	source, err := contract.Get(librarySourceKey(libHash))
+	if err == storage.ErrKeyNotFound {
+		return nil, "", ErrLibraryNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return libHash, string(source), nil
+}
+
+// LoadLibrary return the source of the library required by a contract executed in the block.
+func (block *Block) LoadLibrary(accState state.AccountState, ref string) (string, error) {
+	_, source, err := LoadLibrary(accState, ref)
+	return source, err
+}
+
+// GetLibrary return the hash and the source of the library deployed at the block.
+func (block *Block) GetLibrary(ref string) (byteutils.Hash, string, error) {
+	return LoadLibrary(block.accState, ref)
+}
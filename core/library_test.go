@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func executeLibrary(block *Block, from *Address, payload *LibraryPayload) error {
+	data, _ := payload.ToBytes()
+	tx := NewTransaction(block.ChainID(), from, LibraryContract, util.NewUint128(), 1, TxPayloadLibraryType, data, TransactionGasPrice, util.NewUint128FromInt(200000))
+	ctx := NewPayloadContext(block, tx)
+	if err := ctx.BeginBatch(); err != nil {
+		return err
+	}
+	if _, err := payload.Execute(ctx); err != nil {
+		return err
+	}
+	ctx.Commit()
+	return nil
+}
+
+func TestLibrary(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	owner := mockAddress()
+	other := mockAddress()
+	source := "module.exports = { add: function (a, b) { return a + b; } };"
+	sourceV2 := "module.exports = { add: function (a, b) { return b + a; } };"
+
+	block, _ := bc.NewBlock(owner)
+	block.header.timestamp = BlockInterval
+	block.SetMiner(owner)
+	block.begin()
+
+	_, _, err := block.GetLibrary("SafeMath@1.0.0")
+	assert.Equal(t, ErrLibraryNotFound, err)
+
+	assert.Equal(t, ErrInvalidLibraryPayload, executeLibrary(block, owner, NewLibraryPayload("1Math", "1.0.0", source)))
+	assert.Equal(t, ErrInvalidLibraryPayload, executeLibrary(block, owner, NewLibraryPayload("SafeMath", "1.0", source)))
+	assert.Equal(t, ErrInvalidLibraryPayload, executeLibrary(block, owner, NewLibraryPayload("SafeMath", "1.0.0", "")))
+	assert.Equal(t, nvm.ErrFloatLiteralNotAllowed, executeLibrary(block, owner, NewLibraryPayload("SafeMath", "1.0.0", "var a = 0.5;")))
+	assert.Nil(t, executeLibrary(block, owner, NewLibraryPayload("SafeMath", "1.0.0", source)))
+	assert.Equal(t, ErrLibraryVersionExists, executeLibrary(block, owner, NewLibraryPayload("SafeMath", "1.0.0", sourceV2)))
+	assert.Equal(t, ErrNotLibraryOwner, executeLibrary(block, other, NewLibraryPayload("SafeMath", "1.1.0", sourceV2)))
+	assert.Nil(t, executeLibrary(block, owner, NewLibraryPayload("SafeMath", "1.1.0", sourceV2)))
+	assert.Nil(t, executeLibrary(block, owner, NewLibraryPayload("SafeMath", "1.1.1", source)))
+	assert.Nil(t, executeLibrary(block, other, NewLibraryPayload("Utils", "0.1.0", source)))
+	block.commit()
+
+	libHash := byteutils.Hash(hash.Sha3256([]byte(source)))
+	for _, ref := range []string{"SafeMath@1.0.0", "SafeMath@1.1.1", "Utils@0.1.0", libHash.String()} {
+		h, s, err := block.GetLibrary(ref)
+		assert.Nil(t, err, ref)
+		assert.Equal(t, libHash, h, ref)
+		assert.Equal(t, source, s, ref)
+	}
+	h, s, err := block.GetLibrary("SafeMath@1.1.0")
+	assert.Nil(t, err)
+	assert.Equal(t, byteutils.Hash(hash.Sha3256([]byte(sourceV2))), h)
+	assert.Equal(t, sourceV2, s)
+
+	s, err = block.LoadLibrary(block.accState, "@"+libHash.String())
+	assert.Equal(t, ErrLibraryNotFound, err)
+	_, _, err = block.GetLibrary("SafeMath@2.0.0")
+	assert.Equal(t, ErrLibraryNotFound, err)
+	_, _, err = block.GetLibrary("SafeMath")
+	assert.Equal(t, ErrLibraryNotFound, err)
+}
@@ -0,0 +1,277 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/crash"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MessageQueueContract is the system contract queueing the messages sent by
+// contracts to others, holding the gas prepaid for their delivery.
+var MessageQueueContract, _ = NewContractAddressFromHash(hash.Sha3256([]byte("nebulas.messages")))
+
+// MaxMessagesPerBlock is the max count of messages delivered in a block, the
+// others wait for the next blocks in the order they were sent.
+const MaxMessagesPerBlock = 64
+
+// Keys in the storage of the message queue contract.
+var (
+	messageKeyPrefix = []byte("message")
+	messageHeadKey   = hash.Sha3256([]byte("head"))
+	messageTailKey   = hash.Sha3256([]byte("tail"))
+)
+
+// Message is a call of a contract sent by another contract, delivered at the
+// beginning of a later block with the gas prepaid by the sender.
+type Message struct {
+	From     byteutils.Hash
+	To       byteutils.Hash
+	Function string
+	Args     string
+	GasPrice string
+	GasLimit string
+
+	// Height is the height of the block the message was sent in.
+	Height uint64
+}
+
+// the keys are hashed, since all the keys of a trie have the same length.
+func messageKey(seq uint64) []byte {
+	return hash.Sha3256(messageKeyPrefix, byteutils.FromUint64(seq))
+}
+
+func messageCounter(queue state.Account, key []byte) (uint64, error) {
+	value, err := queue.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// EnqueueMessage queue a message sent by the contract at the height, the gas
+// of its delivery is prepaid by the contract and held by the queue.
+func EnqueueMessage(accState state.AccountState, height uint64, from byteutils.Hash, to string, function, args string, gasPrice, gasLimit *util.Uint128) error {
+	toAddr, err := AddressParse(to)
+	if err != nil {
+		return err
+	}
+	if len(function) == 0 ||
+		gasPrice.Cmp(TransactionGasPrice.Int) < 0 ||
+		gasLimit.Cmp(MinGasCountPerTransaction.Int) < 0 || gasLimit.Cmp(TransactionMaxGas.Int) > 0 {
+		return ErrInvalidMessage
+	}
+
+	prepaid := util.NewUint128FromBigInt(util.NewUint128().Mul(gasPrice.Int, gasLimit.Int))
+	sender := accState.GetOrCreateUserAccount(from)
+	if err := sender.SubBalance(prepaid); err != nil {
+		return ErrInsufficientBalance
+	}
+	queue := accState.GetOrCreateUserAccount(MessageQueueContract.Bytes())
+	queue.AddBalance(prepaid)
+
+	tail, err := messageCounter(queue, messageTailKey)
+	if err != nil {
+		return err
+	}
+	msg := &Message{
+		From:     from,
+		To:       toAddr.Bytes(),
+		Function: function,
+		Args:     args,
+		GasPrice: gasPrice.String(),
+		GasLimit: gasLimit.String(),
+		Height:   height,
+	}
+	bytes, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := queue.Put(messageKey(tail), bytes); err != nil {
+		return err
+	}
+	return queue.Put(messageTailKey, byteutils.FromUint64(tail+1))
+}
+
+// SendMessage queue a message sent by a contract executed in the block,
+// ErrMessageNotActive before the message fork.
+func (block *Block) SendMessage(accState state.AccountState, from byteutils.Hash, to string, function, args string, gasPrice, gasLimit *util.Uint128) error {
+	if !ForksOf(block.ChainID()).IsMessageActive(block.height) {
+		return ErrMessageNotActive
+	}
+	return EnqueueMessage(accState, block.height, from, to, function, args, gasPrice, gasLimit)
+}
+
+// PendingMessages return the count of messages waiting for delivery after the block.
+func (block *Block) PendingMessages() (uint64, error) {
+	queue, err := block.accState.GetContractAccount(MessageQueueContract.Bytes())
+	if err != nil {
+		return 0, nil
+	}
+	head, err := messageCounter(queue, messageHeadKey)
+	if err != nil {
+		return 0, err
+	}
+	tail, err := messageCounter(queue, messageTailKey)
+	if err != nil {
+		return 0, err
+	}
+	return tail - head, nil
+}
+
+// deliverMessages deliver the messages sent in former blocks in the order they
+// were sent, before the transactions of the block are executed. Nothing is
+// delivered before the message fork.
+func (block *Block) deliverMessages(ctx context.Context) error {
+	if !ForksOf(block.ChainID()).IsMessageActive(block.height) {
+		return nil
+	}
+	queue, err := block.accState.GetContractAccount(MessageQueueContract.Bytes())
+	if err != nil {
+		// no message sent yet.
+		return nil
+	}
+	head, err := messageCounter(queue, messageHeadKey)
+	if err != nil {
+		return err
+	}
+	tail, err := messageCounter(queue, messageTailKey)
+	if err != nil {
+		return err
+	}
+	for count := 0; head < tail && count < MaxMessagesPerBlock; count++ {
+		bytes, err := queue.Get(messageKey(head))
+		if err != nil {
+			return err
+		}
+		msg := new(Message)
+		if err := json.Unmarshal(bytes, msg); err != nil {
+			return err
+		}
+		if err := block.deliverMessage(ctx, head, msg); err != nil {
+			return err
+		}
+
+		// the state is replaced by the delivery.
+		queue = block.accState.GetOrCreateUserAccount(MessageQueueContract.Bytes())
+		if err := queue.Del(messageKey(head)); err != nil {
+			return err
+		}
+		head++
+		if err := queue.Put(messageHeadKey, byteutils.FromUint64(head)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliverMessage execute the message as a call transaction of the sender
+// without signature, paying the gas used to the coinbase from the prepaid gas
+// and refunding the rest to the sender. Only a panic fails the block.
+func (block *Block) deliverMessage(ctx context.Context, seq uint64, msg *Message) error {
+	from, err := AddressParseFromBytes(msg.From)
+	if err != nil {
+		return err
+	}
+	to, err := AddressParseFromBytes(msg.To)
+	if err != nil {
+		return err
+	}
+	payload := NewCallPayload(msg.Function, msg.Args)
+	data, err := payload.ToBytes()
+	if err != nil {
+		return err
+	}
+	gasPrice := util.NewUint128FromString(msg.GasPrice)
+	gasLimit := util.NewUint128FromString(msg.GasLimit)
+	parent, err := block.ParentBlock()
+	if err != nil {
+		return err
+	}
+	tx := NewTransaction(block.ChainID(), from, to, util.NewUint128(), seq, TxPayloadCallType, data, gasPrice, gasLimit)
+	// the miner delivers the messages before the timestamp of the block is set.
+	tx.timestamp = parent.Timestamp()
+	if tx.hash, err = HashTransaction(tx); err != nil {
+		return err
+	}
+
+	gas := tx.GasCountOfTxBase()
+	if gasLimit.Cmp(gas.Int) < 0 {
+		gas, err = gasLimit, ErrOutOfGasLimit
+	} else {
+		payloadCtx := NewPayloadContext(block, tx)
+		payloadCtx.execCtx = ctx
+		payloadCtx.message = true
+		if err := payloadCtx.BeginBatch(); err != nil {
+			return err
+		}
+		var gasExecution *util.Uint128
+		gasExecution, err = executePayload(payload, payloadCtx)
+		if crash.IsPanic(err) {
+			payloadCtx.RollBack()
+			return err
+		}
+		if err != nil {
+			payloadCtx.RollBack()
+		} else {
+			payloadCtx.Commit()
+		}
+		gas.Add(gas.Int, gasExecution.Int)
+		if gasLimit.Cmp(gas.Int) < 0 {
+			gas = gasLimit
+		}
+	}
+
+	prepaid := util.NewUint128FromBigInt(util.NewUint128().Mul(gasPrice.Int, gasLimit.Int))
+	cost := util.NewUint128FromBigInt(util.NewUint128().Mul(gasPrice.Int, gas.Int))
+	queue := block.accState.GetOrCreateUserAccount(MessageQueueContract.Bytes())
+	if err := queue.SubBalance(prepaid); err != nil {
+		return err
+	}
+	if err := block.payFee(block.accState.GetOrCreateUserAccount(block.CoinbaseHash()), cost); err != nil {
+		return err
+	}
+	block.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(util.NewUint128FromBigInt(util.NewUint128().Sub(prepaid.Int, cost.Int)))
+
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+			"seq":   seq,
+			"from":  from,
+			"to":    to,
+			"gas":   gas.String(),
+		}).Error("Failed to deliver message.")
+		executeTxErrCounter.Inc(1)
+	}
+	tx.triggerEvent(TopicDeliverMessage, block, err)
+	return nil
+}
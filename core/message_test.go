@@ -0,0 +1,118 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessage(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	coinbase := mockAddress()
+	sender := mockAddress()
+	receiver := mockAddress()
+	gasLimit := util.NewUint128FromInt(100000)
+	prepaid := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, gasLimit.Int))
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.SetMiner(coinbase)
+	block.begin()
+	pending, err := block.PendingMessages()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), pending)
+
+	// no message is sent before the fork.
+	assert.Equal(t, ErrMessageNotActive, block.SendMessage(block.accState, sender.Bytes(), receiver.String(), "save", "[]", TransactionGasPrice, gasLimit))
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{MessageHeight: block.height}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	assert.Equal(t, ErrInsufficientBalance, block.SendMessage(block.accState, sender.Bytes(), receiver.String(), "save", "[]", TransactionGasPrice, gasLimit))
+	block.accState.GetOrCreateUserAccount(sender.Bytes()).AddBalance(prepaid)
+	assert.Equal(t, ErrInvalidAddress, block.SendMessage(block.accState, sender.Bytes(), "0x00", "save", "[]", TransactionGasPrice, gasLimit))
+	assert.Equal(t, ErrInvalidMessage, block.SendMessage(block.accState, sender.Bytes(), receiver.String(), "", "[]", TransactionGasPrice, gasLimit))
+	assert.Equal(t, ErrInvalidMessage, block.SendMessage(block.accState, sender.Bytes(), receiver.String(), "save", "[]", TransactionGasPrice, util.NewUint128FromInt(1)))
+	assert.Nil(t, block.SendMessage(block.accState, sender.Bytes(), receiver.String(), "save", "[]", TransactionGasPrice, gasLimit))
+	block.commit()
+
+	pending, err = block.PendingMessages()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), pending)
+	assert.Equal(t, "0", block.GetBalance(sender.Bytes()).String())
+	assert.Equal(t, prepaid.String(), block.GetBalance(MessageQueueContract.Bytes()).String())
+
+	// the receiver is not a contract, the delivery fails consuming the base gas.
+	coinbaseBalance := block.GetBalance(coinbase.Bytes())
+	block.begin()
+	assert.Nil(t, block.deliverMessages(context.Background()))
+	block.commit()
+
+	pending, err = block.PendingMessages()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), pending)
+	assert.Equal(t, "0", block.GetBalance(MessageQueueContract.Bytes()).String())
+
+	payload, _ := NewCallPayload("save", "[]").ToBytes()
+	gas := NewTransaction(bc.ChainID(), sender, receiver, util.NewUint128(), 0, TxPayloadCallType, payload, TransactionGasPrice, gasLimit).GasCountOfTxBase()
+	cost := util.NewUint128().Mul(TransactionGasPrice.Int, gas.Int)
+	assert.Equal(t, util.NewUint128().Sub(prepaid.Int, cost).String(), block.GetBalance(sender.Bytes()).String())
+	assert.Equal(t, util.NewUint128().Add(coinbaseBalance.Int, cost).String(), block.GetBalance(coinbase.Bytes()).String())
+}
+
+func TestMessage_DeliveredByMiner(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{MessageHeight: 2}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	coinbase := mockAddress()
+	sender := mockAddress()
+	gasLimit := util.NewUint128FromInt(100000)
+	prepaid := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, gasLimit.Int))
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.SetMiner(coinbase)
+	block.begin()
+	block.accState.GetOrCreateUserAccount(sender.Bytes()).AddBalance(prepaid)
+	assert.Nil(t, block.SendMessage(block.accState, sender.Bytes(), mockAddress().String(), "save", "[]", TransactionGasPrice, gasLimit))
+	block.commit()
+	assert.Nil(t, block.Seal())
+
+	// the miner of the next block delivers the message as its verifiers do.
+	next, err := NewBlock(bc.ChainID(), coinbase, block)
+	assert.Nil(t, err)
+	pending, err := next.PendingMessages()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), pending)
+	assert.Equal(t, "0", next.GetBalance(MessageQueueContract.Bytes()).String())
+}
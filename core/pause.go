@@ -0,0 +1,275 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// PauseContract is the system contract recording the votes of the dynasty to
+// pause an exploited contract, and the height until which it is paused.
+var PauseContract, _ = NewContractAddressFromHash(hash.Sha3256([]byte("nebulas.pause")))
+
+// MaxPauseBlocks is the longest pause of a contract, about a day.
+const MaxPauseBlocks uint64 = 17280
+
+// PauseVoteExpiryBlocks is the count of blocks the votes of a round count for
+// since its first vote, about an hour. The votes not reaching the quorum by
+// then expire, and the next vote starts a new round.
+const PauseVoteExpiryBlocks uint64 = 720
+
+// Keys in the storage of the pause contract.
+var (
+	pauseUntilKeyPrefix    = []byte("until")
+	pauseRoundKeyPrefix    = []byte("round")
+	pauseVoteKeyPrefix     = []byte("vote")
+	pauseVotesKeyPrefix    = []byte("votes")
+	pauseDurationKeyPrefix = []byte("duration")
+	pauseStartKeyPrefix    = []byte("start")
+)
+
+// PausePayload carry the vote of a dynasty member to pause a contract for a
+// count of blocks. The contract is paused when two thirds of the dynasty
+// voted, for the shortest duration voted, and the calls to it are rejected.
+type PausePayload struct {
+	Contract string
+	Blocks   uint64
+}
+
+// PauseEvent is the data of the events of the pause votes.
+type PauseEvent struct {
+	Contract string `json:"contract"`
+	Voter    string `json:"voter"`
+	Blocks   uint64 `json:"blocks"`
+	Votes    uint64 `json:"votes"`
+	Quorum   uint64 `json:"quorum"`
+	// Expires is the height from which the votes of the round don't count.
+	Expires uint64 `json:"expires"`
+	// Until is the height until which the contract is paused, 0 until the quorum is reached.
+	Until uint64 `json:"until"`
+}
+
+// LoadPausePayload from bytes
+func LoadPausePayload(bytes []byte) (*PausePayload, error) {
+	payload := &PausePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewPausePayload with contract & blocks
+func NewPausePayload(contract string, blocks uint64) *PausePayload {
+	return &PausePayload{
+		Contract: contract,
+		Blocks:   blocks,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *PausePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *PausePayload) BaseGasCount() *util.Uint128 {
+	return PauseBaseGasCount
+}
+
+// Execute the pause payload in tx, vote to pause a contract
+func (payload *PausePayload) Execute(ctx *PayloadContext) (*util.Uint128, error) {
+	addr, err := AddressParse(payload.Contract)
+	if err != nil || payload.Blocks == 0 || payload.Blocks > MaxPauseBlocks {
+		return ZeroGasCount, ErrInvalidPausePayload
+	}
+	if _, err := ctx.accState.GetContractAccount(addr.Bytes()); err != nil {
+		return ZeroGasCount, ErrInvalidPausePayload
+	}
+
+	voter := ctx.tx.from.Bytes()
+	dynasty, err := TraverseDynasty(ctx.dposContext.dynastyTrie)
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	if !inDynasty(dynasty, voter) {
+		return ZeroGasCount, ErrNotPauseVoter
+	}
+
+	contract := ctx.accState.GetOrCreateUserAccount(PauseContract.Bytes())
+	round, err := getPauseUint64(contract, pauseKey(pauseRoundKeyPrefix, addr))
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	roundKey := byteutils.FromUint64(round)
+	start, err := getPauseUint64(contract, pauseKey(pauseStartKeyPrefix, addr, roundKey))
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	if start > 0 && ctx.block.height >= start+PauseVoteExpiryBlocks {
+		// the votes of the round expired, the vote starts a new round.
+		round++
+		roundKey = byteutils.FromUint64(round)
+		start = 0
+		if err := contract.Put(pauseKey(pauseRoundKeyPrefix, addr), roundKey); err != nil {
+			return ZeroGasCount, err
+		}
+	}
+	if start == 0 {
+		start = ctx.block.height
+		if err := contract.Put(pauseKey(pauseStartKeyPrefix, addr, roundKey), byteutils.FromUint64(start)); err != nil {
+			return ZeroGasCount, err
+		}
+	}
+	if _, err := contract.Get(pauseKey(pauseVoteKeyPrefix, addr, roundKey, voter)); err != storage.ErrKeyNotFound {
+		if err == nil {
+			return ZeroGasCount, ErrDuplicatedPauseVote
+		}
+		return ZeroGasCount, err
+	}
+	votes, err := getPauseUint64(contract, pauseKey(pauseVotesKeyPrefix, addr, roundKey))
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	blocks, err := getPauseUint64(contract, pauseKey(pauseDurationKeyPrefix, addr, roundKey))
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	if blocks == 0 || payload.Blocks < blocks {
+		blocks = payload.Blocks
+	}
+	votes++
+
+	event := &PauseEvent{
+		Contract: addr.String(),
+		Voter:    ctx.tx.from.String(),
+		Blocks:   payload.Blocks,
+		Votes:    votes,
+		Quorum:   pauseQuorum(len(dynasty)),
+		Expires:  start + PauseVoteExpiryBlocks,
+	}
+	if votes >= event.Quorum {
+		event.Until = ctx.block.height + blocks
+	}
+
+	if err := contract.Put(pauseKey(pauseVoteKeyPrefix, addr, roundKey, voter), byteutils.FromUint64(payload.Blocks)); err != nil {
+		return ZeroGasCount, err
+	}
+	if err := contract.Put(pauseKey(pauseVotesKeyPrefix, addr, roundKey), byteutils.FromUint64(votes)); err != nil {
+		return ZeroGasCount, err
+	}
+	if err := contract.Put(pauseKey(pauseDurationKeyPrefix, addr, roundKey), byteutils.FromUint64(blocks)); err != nil {
+		return ZeroGasCount, err
+	}
+	if event.Until > 0 {
+		// the quorum is reached, the next votes start a new round.
+		if err := contract.Put(pauseKey(pauseUntilKeyPrefix, addr), byteutils.FromUint64(event.Until)); err != nil {
+			return ZeroGasCount, err
+		}
+		if err := contract.Put(pauseKey(pauseRoundKeyPrefix, addr), byteutils.FromUint64(round+1)); err != nil {
+			return ZeroGasCount, err
+		}
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return ZeroGasCount, err
+	}
+	topic := TopicPauseVote
+	if event.Until > 0 {
+		topic = TopicContractPaused
+	}
+	if err := ctx.block.RecordEvent(ctx.tx.hash, topic, string(data)); err != nil {
+		return ZeroGasCount, err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":    ctx.block,
+		"tx":       ctx.tx,
+		"contract": event.Contract,
+		"votes":    event.Votes,
+		"quorum":   event.Quorum,
+		"until":    event.Until,
+	}).Info("Contract pause voted.")
+	return ZeroGasCount, nil
+}
+
+// pauseQuorum is the count of votes pausing a contract, two thirds of the dynasty.
+func pauseQuorum(dynastySize int) uint64 {
+	return uint64(dynastySize*2/3 + 1)
+}
+
+// the keys are hashed, since all the keys of a trie have the same length.
+func pauseKey(prefix []byte, addr *Address, args ...[]byte) []byte {
+	return hash.Sha3256(append([][]byte{prefix, addr.Bytes()}, args...)...)
+}
+
+func getPauseUint64(contract state.Account, key []byte) (uint64, error) {
+	value, err := contract.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// IsContractPaused returns if the calls to the contract are rejected in the
+// block at the height, the contract is paused until the height voted.
+func (block *Block) IsContractPaused(addr *Address, height uint64) bool {
+	return isContractPaused(block.accState, addr, height)
+}
+
+func isContractPaused(accState state.AccountState, addr *Address, height uint64) bool {
+	contract, err := accState.GetContractAccount(PauseContract.Bytes())
+	if err != nil {
+		return false
+	}
+	until, err := getPauseUint64(contract, pauseKey(pauseUntilKeyPrefix, addr))
+	if err != nil {
+		return false
+	}
+	return height < until
+}
+
+// isDynastyMember returns if the address is in the dynasty of the block.
+func isDynastyMember(ctx *PayloadContext, addr byteutils.Hash) (bool, error) {
+	dynasty, err := TraverseDynasty(ctx.dposContext.dynastyTrie)
+	if err != nil {
+		return false, err
+	}
+	return inDynasty(dynasty, addr), nil
+}
+
+func inDynasty(dynasty []byteutils.Hash, addr byteutils.Hash) bool {
+	for _, v := range dynasty {
+		if v.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
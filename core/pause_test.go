@@ -0,0 +1,100 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func executePause(block *Block, from *Address, payload *PausePayload) error {
+	data, _ := payload.ToBytes()
+	tx := NewTransaction(block.ChainID(), from, PauseContract, util.NewUint128(), 1, TxPayloadPauseType, data, TransactionGasPrice, util.NewUint128FromInt(200000))
+	ctx := NewPayloadContext(block, tx)
+	if err := ctx.BeginBatch(); err != nil {
+		return err
+	}
+	if _, err := payload.Execute(ctx); err != nil {
+		return err
+	}
+	ctx.Commit()
+	return nil
+}
+
+func TestPause(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	from := mockAddress()
+	block, _ := bc.NewBlock(from)
+	block.header.timestamp = BlockInterval
+	block.SetMiner(from)
+	block.begin()
+
+	target := mockAddress()
+	contract, _ := NewContractAddressFromHash(target.Bytes())
+	_, err := block.accState.CreateContractAccount(contract.Bytes(), nil)
+	assert.Nil(t, err)
+
+	members, err := TraverseDynasty(block.dposContext.dynastyTrie)
+	assert.Nil(t, err)
+	voters := []*Address{}
+	for _, member := range members {
+		voter, err := AddressParseFromBytes(member)
+		assert.Nil(t, err)
+		voters = append(voters, voter)
+	}
+	quorum := int(pauseQuorum(len(voters)))
+	assert.True(t, quorum > 1 && quorum <= len(voters))
+
+	assert.Equal(t, ErrInvalidPausePayload, executePause(block, voters[0], NewPausePayload("invalid", 10)))
+	assert.Equal(t, ErrInvalidPausePayload, executePause(block, voters[0], NewPausePayload(target.String(), 10)))
+	assert.Equal(t, ErrInvalidPausePayload, executePause(block, voters[0], NewPausePayload(contract.String(), 0)))
+	assert.Equal(t, ErrInvalidPausePayload, executePause(block, voters[0], NewPausePayload(contract.String(), MaxPauseBlocks+1)))
+	assert.Equal(t, ErrNotPauseVoter, executePause(block, from, NewPausePayload(contract.String(), 10)))
+
+	for i := 0; i < quorum-1; i++ {
+		assert.Nil(t, executePause(block, voters[i], NewPausePayload(contract.String(), uint64(20-i))))
+		assert.False(t, block.IsContractPaused(contract, block.height))
+	}
+	assert.Equal(t, ErrDuplicatedPauseVote, executePause(block, voters[0], NewPausePayload(contract.String(), 10)))
+
+	// the quorum pauses the contract for the shortest duration voted.
+	assert.Nil(t, executePause(block, voters[quorum-1], NewPausePayload(contract.String(), 100)))
+	until := block.height + uint64(20-(quorum-2))
+	assert.True(t, block.IsContractPaused(contract, block.height))
+	assert.True(t, block.IsContractPaused(contract, until-1))
+	assert.False(t, block.IsContractPaused(contract, until))
+	assert.False(t, block.IsContractPaused(target, block.height))
+
+	// the votes after the pause start a new round.
+	assert.Nil(t, executePause(block, voters[0], NewPausePayload(contract.String(), 10)))
+
+	// the votes expire when the round doesn't reach the quorum in time.
+	block.height += PauseVoteExpiryBlocks - 1
+	assert.Equal(t, ErrDuplicatedPauseVote, executePause(block, voters[0], NewPausePayload(contract.String(), 10)))
+	block.height++
+	for i := 0; i < quorum-1; i++ {
+		assert.Nil(t, executePause(block, voters[i], NewPausePayload(contract.String(), 10)))
+	}
+	assert.False(t, block.IsContractPaused(contract, block.height))
+}
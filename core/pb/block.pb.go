@@ -14,6 +14,8 @@ It has these top-level messages:
 	DposContext
 	BlockHeader
 	Block
+	Witness
+	WitnessEntry
 	NetBlocks
 	NetBlock
 	DownloadBlock
@@ -280,6 +282,17 @@ type BlockHeader struct {
 	TxsRoot     []byte       `protobuf:"bytes,10,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
 	EventsRoot  []byte       `protobuf:"bytes,11,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
 	DposContext *DposContext `protobuf:"bytes,12,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
+	// Root of the storage of the anchor contract, i.e. the state roots of the
+	// child chains anchored, empty before the first anchor.
+	AnchorsRoot []byte `protobuf:"bytes,13,opt,name=anchors_root,json=anchorsRoot,proto3" json:"anchors_root,omitempty"`
+	// Version of the header rules, 0 before the header v1 fork. The fields
+	// numbered below 1000 are critical, a node rejects a header with one it
+	// doesn't know; the fields from 1000 on are optional and ignored by the
+	// nodes that don't know them.
+	Version uint32 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// Gas used by the transactions of the block, a uint128 in 16 bytes. Set and
+	// committed to by the hash from the v1 header on.
+	GasUsed []byte `protobuf:"bytes,1000,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *BlockHeader) Reset()                    { *m = BlockHeader{} }
@@ -371,10 +384,33 @@ func (m *BlockHeader) GetDposContext() *DposContext {
 	return nil
 }
 
+func (m *BlockHeader) GetAnchorsRoot() []byte {
+	if m != nil {
+		return m.AnchorsRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetGasUsed() []byte {
+	if m != nil {
+		return m.GasUsed
+	}
+	return nil
+}
+
 type Block struct {
 	Header       *BlockHeader   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions" json:"transactions,omitempty"`
 	Height       uint64         `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Execution witness, optional.
+	Witness *Witness `protobuf:"bytes,4,opt,name=witness" json:"witness,omitempty"`
 }
 
 func (m *Block) Reset()                    { *m = Block{} }
@@ -403,6 +439,55 @@ func (m *Block) GetHeight() uint64 {
 	return 0
 }
 
+func (m *Block) GetWitness() *Witness {
+	if m != nil {
+		return m.Witness
+	}
+	return nil
+}
+
+// Witness is the trie nodes and the parent block read when executing a block,
+// enough to verify the block without the state.
+type Witness struct {
+	Entries []*WitnessEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *Witness) Reset()                    { *m = Witness{} }
+func (m *Witness) String() string            { return proto.CompactTextString(m) }
+func (*Witness) ProtoMessage()               {}
+func (*Witness) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
+
+func (m *Witness) GetEntries() []*WitnessEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type WitnessEntry struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *WitnessEntry) Reset()                    { *m = WitnessEntry{} }
+func (m *WitnessEntry) String() string            { return proto.CompactTextString(m) }
+func (*WitnessEntry) ProtoMessage()               {}
+func (*WitnessEntry) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
+
+func (m *WitnessEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *WitnessEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type NetBlocks struct {
 	From   string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	Batch  uint64   `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
@@ -412,7 +497,7 @@ type NetBlocks struct {
 func (m *NetBlocks) Reset()                    { *m = NetBlocks{} }
 func (m *NetBlocks) String() string            { return proto.CompactTextString(m) }
 func (*NetBlocks) ProtoMessage()               {}
-func (*NetBlocks) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
+func (*NetBlocks) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
 
 func (m *NetBlocks) GetFrom() string {
 	if m != nil {
@@ -444,7 +529,7 @@ type NetBlock struct {
 func (m *NetBlock) Reset()                    { *m = NetBlock{} }
 func (m *NetBlock) String() string            { return proto.CompactTextString(m) }
 func (*NetBlock) ProtoMessage()               {}
-func (*NetBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
+func (*NetBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
 
 func (m *NetBlock) GetFrom() string {
 	if m != nil {
@@ -475,7 +560,7 @@ type DownloadBlock struct {
 func (m *DownloadBlock) Reset()                    { *m = DownloadBlock{} }
 func (m *DownloadBlock) String() string            { return proto.CompactTextString(m) }
 func (*DownloadBlock) ProtoMessage()               {}
-func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{10} }
 
 func (m *DownloadBlock) GetHash() []byte {
 	if m != nil {
@@ -498,6 +583,8 @@ func init() {
 	proto.RegisterType((*DposContext)(nil), "corepb.DposContext")
 	proto.RegisterType((*BlockHeader)(nil), "corepb.BlockHeader")
 	proto.RegisterType((*Block)(nil), "corepb.Block")
+	proto.RegisterType((*Witness)(nil), "corepb.Witness")
+	proto.RegisterType((*WitnessEntry)(nil), "corepb.WitnessEntry")
 	proto.RegisterType((*NetBlocks)(nil), "corepb.NetBlocks")
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
@@ -506,51 +593,57 @@ func init() {
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 722 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0x96, 0x93, 0x38, 0x3f, 0x63, 0xa7, 0xe7, 0x9c, 0x3d, 0x15, 0x72, 0xf9, 0x51, 0x83, 0xab,
-	0x4a, 0x11, 0x48, 0xbd, 0x28, 0x88, 0x5e, 0x43, 0x73, 0x51, 0x24, 0x84, 0x2a, 0x8b, 0x1b, 0x24,
-	0x24, 0x6b, 0x63, 0x2f, 0x89, 0x85, 0xb3, 0x6b, 0x79, 0xa7, 0x25, 0x79, 0x00, 0x1e, 0x80, 0xf7,
-	0xe0, 0xb9, 0x90, 0x78, 0x0b, 0xb4, 0xb3, 0x1b, 0xc7, 0xa1, 0xbd, 0xe1, 0x6e, 0xe7, 0xfb, 0x66,
-	0x77, 0x67, 0xbe, 0xf9, 0x76, 0x21, 0x98, 0x97, 0x2a, 0xfb, 0x72, 0x56, 0xd5, 0x0a, 0x15, 0xeb,
-	0x67, 0xaa, 0x16, 0xd5, 0x3c, 0xfe, 0xee, 0xc1, 0xe0, 0x75, 0x96, 0xa9, 0x1b, 0x89, 0x2c, 0x82,
-	0x01, 0xcf, 0xf3, 0x5a, 0x68, 0x1d, 0x79, 0x13, 0x6f, 0x1a, 0x26, 0xdb, 0xd0, 0x30, 0x73, 0x5e,
-	0x72, 0x99, 0x89, 0xa8, 0x63, 0x19, 0x17, 0xb2, 0x43, 0xf0, 0xa5, 0x32, 0x78, 0x77, 0xe2, 0x4d,
-	0x7b, 0x89, 0x0d, 0xd8, 0x23, 0x18, 0xdd, 0xf2, 0x5a, 0xa7, 0x4b, 0xae, 0x97, 0x51, 0x8f, 0x76,
-	0x0c, 0x0d, 0x70, 0xc5, 0xf5, 0x92, 0x1d, 0x43, 0x30, 0x2f, 0x6a, 0x5c, 0xa6, 0x55, 0xc9, 0x33,
-	0x11, 0xf9, 0x44, 0x03, 0x41, 0xd7, 0x06, 0x89, 0x5f, 0x42, 0x6f, 0xc6, 0x91, 0x33, 0x06, 0x3d,
-	0xdc, 0x54, 0x82, 0x8a, 0x19, 0x25, 0xb4, 0x36, 0x95, 0x54, 0x7c, 0x53, 0x2a, 0x9e, 0x6f, 0x2b,
-	0x71, 0x61, 0xfc, 0xa3, 0x03, 0xc1, 0x87, 0x9a, 0x4b, 0xcd, 0x33, 0x2c, 0x94, 0x34, 0xbb, 0xe9,
-	0x7a, 0xdb, 0x0a, 0xad, 0x0d, 0xf6, 0xb9, 0x56, 0x2b, 0xb7, 0x95, 0xd6, 0xec, 0x00, 0x3a, 0xa8,
-	0xa8, 0xfc, 0x30, 0xe9, 0xa0, 0x32, 0x1d, 0xdd, 0xf2, 0xf2, 0x46, 0xb8, 0xba, 0x6d, 0xb0, 0xeb,
-	0xd3, 0x6f, 0xf7, 0xf9, 0x18, 0x46, 0x58, 0xac, 0x84, 0x46, 0xbe, 0xaa, 0xa2, 0xfe, 0xc4, 0x9b,
-	0x76, 0x93, 0x1d, 0xc0, 0x26, 0xd0, 0xcb, 0x39, 0xf2, 0x68, 0x30, 0xf1, 0xa6, 0xc1, 0x79, 0x78,
-	0x66, 0x25, 0x3f, 0x33, 0xbd, 0x25, 0xc4, 0xb0, 0x23, 0x18, 0x66, 0x4b, 0x5e, 0xc8, 0xb4, 0xc8,
-	0xa3, 0xe1, 0xc4, 0x9b, 0x8e, 0x93, 0x01, 0xc5, 0x6f, 0x73, 0x23, 0xe1, 0x82, 0xeb, 0xb4, 0xaa,
-	0x8b, 0x4c, 0x44, 0x23, 0x2b, 0xe1, 0x82, 0xeb, 0x6b, 0x13, 0x6f, 0xc9, 0xb2, 0x58, 0x15, 0x18,
-	0x41, 0x43, 0xbe, 0x33, 0x31, 0xfb, 0x17, 0xba, 0xbc, 0x5c, 0x44, 0x01, 0x9d, 0x67, 0x96, 0xa6,
-	0x6d, 0x5d, 0x2c, 0x64, 0x14, 0xda, 0xb6, 0xcd, 0x3a, 0xfe, 0xe5, 0x41, 0x30, 0xab, 0x94, 0xbe,
-	0x54, 0x12, 0xc5, 0x1a, 0xd9, 0x53, 0x08, 0xf3, 0x8d, 0xe4, 0x1a, 0x37, 0x69, 0xad, 0x14, 0x3a,
-	0xd9, 0x02, 0x87, 0x25, 0x4a, 0x21, 0x7b, 0x06, 0xff, 0x49, 0xb1, 0xc6, 0x74, 0x2f, 0xcf, 0x4a,
-	0xf9, 0x8f, 0x21, 0x66, 0xad, 0xdc, 0x13, 0x18, 0xe7, 0xa2, 0x14, 0x0b, 0x8e, 0xc2, 0xe6, 0x59,
-	0x81, 0xc3, 0x2d, 0x48, 0x49, 0xa7, 0x70, 0x90, 0x71, 0x99, 0x17, 0x79, 0x93, 0x65, 0x35, 0x1f,
-	0x37, 0x28, 0xa5, 0x19, 0x37, 0xa9, 0x6d, 0x86, 0xef, 0xdc, 0xa4, 0x1c, 0x19, 0xc3, 0x78, 0x55,
-	0x48, 0x4c, 0x33, 0x89, 0x36, 0xa1, 0x6f, 0x0b, 0x37, 0xe0, 0xa5, 0x44, 0x93, 0x13, 0xff, 0xec,
-	0x40, 0xf0, 0xc6, 0x98, 0xff, 0x4a, 0xf0, 0x5c, 0xd4, 0xf7, 0x5a, 0xe3, 0x18, 0x82, 0x8a, 0xd7,
-	0x42, 0xa2, 0x35, 0xad, 0x6d, 0x0b, 0x2c, 0x44, 0xb6, 0xbd, 0xdf, 0xe9, 0x0f, 0x61, 0x98, 0xa9,
-	0x42, 0xce, 0xb9, 0xde, 0x1a, 0xa6, 0x89, 0xf7, 0xdd, 0xe1, 0xff, 0xe9, 0x8e, 0xf6, 0xec, 0xfb,
-	0xfb, 0xb3, 0x77, 0x13, 0x1c, 0xdc, 0x9d, 0xe0, 0x70, 0x37, 0x41, 0xf6, 0x04, 0x40, 0x63, 0xa3,
-	0x9c, 0xb5, 0xc8, 0x88, 0x10, 0x12, 0xe6, 0x08, 0x86, 0xb8, 0xd6, 0x96, 0xb4, 0x16, 0x19, 0xe0,
-	0x5a, 0x13, 0x75, 0x0c, 0x81, 0xb8, 0x15, 0x12, 0x1d, 0x1b, 0xd8, 0x5e, 0x2d, 0x44, 0x09, 0xaf,
-	0x20, 0xcc, 0x2b, 0xa5, 0xd3, 0xcc, 0x9a, 0x83, 0x8c, 0x13, 0x9c, 0xff, 0xdf, 0x38, 0x78, 0xe7,
-	0x9b, 0x24, 0xc8, 0x77, 0x41, 0xfc, 0xcd, 0x03, 0x9f, 0x84, 0x66, 0xcf, 0xa1, 0xbf, 0x24, 0xb1,
-	0x49, 0xe4, 0xd6, 0xde, 0xd6, 0x1c, 0x12, 0x97, 0xc2, 0x2e, 0x20, 0xc4, 0xdd, 0xcb, 0xd5, 0x51,
-	0x67, 0xd2, 0x6d, 0x6f, 0x69, 0xbd, 0xea, 0x64, 0x2f, 0x91, 0x3d, 0x30, 0xb7, 0x14, 0x8b, 0x25,
-	0xba, 0xa1, 0xb8, 0x28, 0xfe, 0x04, 0xa3, 0xf7, 0x02, 0xe9, 0x2a, 0xdd, 0x3c, 0x7a, 0xf7, 0x8d,
-	0xd0, 0xa3, 0x3f, 0x04, 0x7f, 0xce, 0x31, 0xb3, 0x73, 0xee, 0x25, 0x36, 0x60, 0xa7, 0xd0, 0xa7,
-	0x3f, 0x52, 0x47, 0x5d, 0xaa, 0x60, 0xbc, 0x57, 0x74, 0xe2, 0xc8, 0xf8, 0x23, 0x0c, 0xb7, 0xa7,
-	0xff, 0xc5, 0xe1, 0x27, 0xe0, 0xd3, 0x7e, 0x2a, 0xf5, 0xce, 0xd9, 0x96, 0x8b, 0x2f, 0x60, 0x3c,
-	0x53, 0x5f, 0xa5, 0xf9, 0xd0, 0x9a, 0xf3, 0xef, 0xfb, 0xc5, 0xc8, 0x0c, 0x9d, 0x9d, 0x19, 0xe6,
-	0x7d, 0xfa, 0xd6, 0x5f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x27, 0xd4, 0x2d, 0xe5, 0x05,
-	0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdb, 0x8e, 0xe3, 0x44,
+	0x10, 0x95, 0x73, 0x73, 0x52, 0x76, 0x66, 0xa1, 0x59, 0xa1, 0x5e, 0x2e, 0x9a, 0xe0, 0xd5, 0x4a,
+	0x01, 0xa4, 0x3c, 0x2c, 0x68, 0x57, 0x3c, 0xc2, 0x04, 0x69, 0x90, 0x10, 0x1a, 0x59, 0x20, 0x84,
+	0x84, 0x64, 0x75, 0xec, 0x26, 0x6e, 0x8d, 0xd3, 0x6d, 0xb9, 0x6b, 0x32, 0xc9, 0x67, 0xf0, 0x1f,
+	0x3c, 0xf0, 0x07, 0xfc, 0x0e, 0xfc, 0x05, 0xea, 0x8b, 0x1d, 0x67, 0x66, 0x5e, 0xf6, 0xad, 0xeb,
+	0x9c, 0xaa, 0x72, 0x5d, 0x4e, 0x2a, 0x10, 0x6d, 0x2a, 0x95, 0xdf, 0xae, 0xea, 0x46, 0xa1, 0x22,
+	0x93, 0x5c, 0x35, 0xbc, 0xde, 0x24, 0x7f, 0x06, 0x10, 0x7e, 0x9b, 0xe7, 0xea, 0x4e, 0x22, 0xa1,
+	0x10, 0xb2, 0xa2, 0x68, 0xb8, 0xd6, 0x34, 0x58, 0x04, 0xcb, 0x38, 0x6d, 0x4d, 0xc3, 0x6c, 0x58,
+	0xc5, 0x64, 0xce, 0xe9, 0xc0, 0x31, 0xde, 0x24, 0xcf, 0x61, 0x2c, 0x95, 0xc1, 0x87, 0x8b, 0x60,
+	0x39, 0x4a, 0x9d, 0x41, 0x3e, 0x86, 0xd9, 0x9e, 0x35, 0x3a, 0x2b, 0x99, 0x2e, 0xe9, 0xc8, 0x46,
+	0x4c, 0x0d, 0x70, 0xcd, 0x74, 0x49, 0x2e, 0x21, 0xda, 0x88, 0x06, 0xcb, 0xac, 0xae, 0x58, 0xce,
+	0xe9, 0xd8, 0xd2, 0x60, 0xa1, 0x1b, 0x83, 0x24, 0x5f, 0xc3, 0x68, 0xcd, 0x90, 0x11, 0x02, 0x23,
+	0x3c, 0xd6, 0xdc, 0x16, 0x33, 0x4b, 0xed, 0xdb, 0x54, 0x52, 0xb3, 0x63, 0xa5, 0x58, 0xd1, 0x56,
+	0xe2, 0xcd, 0xe4, 0xaf, 0x01, 0x44, 0x3f, 0x37, 0x4c, 0x6a, 0x96, 0xa3, 0x50, 0xd2, 0x44, 0xdb,
+	0xcf, 0xbb, 0x56, 0xec, 0xdb, 0x60, 0x7f, 0x34, 0x6a, 0xe7, 0x43, 0xed, 0x9b, 0x5c, 0xc0, 0x00,
+	0x95, 0x2d, 0x3f, 0x4e, 0x07, 0xa8, 0x4c, 0x47, 0x7b, 0x56, 0xdd, 0x71, 0x5f, 0xb7, 0x33, 0x4e,
+	0x7d, 0x8e, 0xfb, 0x7d, 0x7e, 0x02, 0x33, 0x14, 0x3b, 0xae, 0x91, 0xed, 0x6a, 0x3a, 0x59, 0x04,
+	0xcb, 0x61, 0x7a, 0x02, 0xc8, 0x02, 0x46, 0x05, 0x43, 0x46, 0xc3, 0x45, 0xb0, 0x8c, 0x5e, 0xc7,
+	0x2b, 0x37, 0xf2, 0x95, 0xe9, 0x2d, 0xb5, 0x0c, 0x79, 0x01, 0xd3, 0xbc, 0x64, 0x42, 0x66, 0xa2,
+	0xa0, 0xd3, 0x45, 0xb0, 0x9c, 0xa7, 0xa1, 0xb5, 0x7f, 0x28, 0xcc, 0x08, 0xb7, 0x4c, 0x67, 0x75,
+	0x23, 0x72, 0x4e, 0x67, 0x6e, 0x84, 0x5b, 0xa6, 0x6f, 0x8c, 0xdd, 0x92, 0x95, 0xd8, 0x09, 0xa4,
+	0xd0, 0x91, 0x3f, 0x1a, 0x9b, 0xbc, 0x07, 0x43, 0x56, 0x6d, 0x69, 0x64, 0xf3, 0x99, 0xa7, 0x69,
+	0x5b, 0x8b, 0xad, 0xa4, 0xb1, 0x6b, 0xdb, 0xbc, 0x93, 0xff, 0x02, 0x88, 0xd6, 0xb5, 0xd2, 0x57,
+	0x4a, 0x22, 0x3f, 0x20, 0xf9, 0x0c, 0xe2, 0xe2, 0x28, 0x99, 0xc6, 0x63, 0xd6, 0x28, 0x85, 0x7e,
+	0x6c, 0x91, 0xc7, 0x52, 0xa5, 0x90, 0x7c, 0x01, 0xef, 0x4b, 0x7e, 0xc0, 0xec, 0xcc, 0xcf, 0x8d,
+	0xf2, 0x99, 0x21, 0xd6, 0x3d, 0xdf, 0x97, 0x30, 0x2f, 0x78, 0xc5, 0xb7, 0x0c, 0xb9, 0xf3, 0x73,
+	0x03, 0x8e, 0x5b, 0xd0, 0x3a, 0xbd, 0x82, 0x8b, 0x9c, 0xc9, 0x42, 0x14, 0x9d, 0x97, 0x9b, 0xf9,
+	0xbc, 0x43, 0xad, 0x9b, 0x51, 0x93, 0x6a, 0x3d, 0xc6, 0x5e, 0x4d, 0xca, 0x93, 0x09, 0xcc, 0x77,
+	0x42, 0x62, 0x96, 0x4b, 0x74, 0x0e, 0x13, 0x57, 0xb8, 0x01, 0xaf, 0x24, 0x1a, 0x9f, 0xe4, 0x9f,
+	0x21, 0x44, 0xdf, 0x19, 0xf1, 0x5f, 0x73, 0x56, 0xf0, 0xe6, 0x49, 0x69, 0x5c, 0x42, 0x54, 0xb3,
+	0x86, 0x4b, 0x74, 0xa2, 0x75, 0x6d, 0x81, 0x83, 0xac, 0x6c, 0x9f, 0x56, 0xfa, 0x47, 0x30, 0xcd,
+	0x95, 0x90, 0x1b, 0xa6, 0x5b, 0xc1, 0x74, 0xf6, 0xb9, 0x3a, 0xc6, 0x0f, 0xd5, 0xd1, 0xdf, 0xfd,
+	0xe4, 0x7c, 0xf7, 0x7e, 0x83, 0xe1, 0xe3, 0x0d, 0x4e, 0x4f, 0x1b, 0x24, 0x9f, 0x02, 0x68, 0xec,
+	0x26, 0xe7, 0x24, 0x32, 0xb3, 0x88, 0x1d, 0xcc, 0x0b, 0x98, 0xe2, 0x41, 0x3b, 0xd2, 0x49, 0x24,
+	0xc4, 0x83, 0xb6, 0xd4, 0x25, 0x44, 0x7c, 0xcf, 0x25, 0x7a, 0x36, 0x72, 0xbd, 0x3a, 0xc8, 0x3a,
+	0xbc, 0x81, 0xb8, 0xa8, 0x95, 0xce, 0x72, 0x27, 0x0e, 0x2b, 0x9c, 0xe8, 0xf5, 0x07, 0x9d, 0x82,
+	0x4f, 0xba, 0x49, 0xa3, 0xe2, 0x5c, 0x44, 0x4c, 0xe6, 0xa5, 0x6a, 0x7c, 0xe6, 0xb9, 0xdb, 0x85,
+	0xc7, 0x6c, 0x6a, 0x0a, 0xe1, 0x9e, 0x37, 0x5a, 0x28, 0x49, 0x2f, 0x5c, 0xd7, 0xde, 0x34, 0xa3,
+	0x34, 0xa2, 0xbe, 0xd3, 0xbc, 0xa0, 0xff, 0x86, 0xae, 0xe2, 0x2d, 0xd3, 0xbf, 0x68, 0x5e, 0x24,
+	0x7f, 0x07, 0x30, 0xb6, 0x1b, 0x24, 0x5f, 0xc2, 0xa4, 0xb4, 0x5b, 0xa4, 0xc1, 0x79, 0x51, 0xbd,
+	0x05, 0xa7, 0xde, 0x85, 0xbc, 0x85, 0x18, 0x4f, 0x27, 0x41, 0xd3, 0xc1, 0x62, 0xd8, 0x0f, 0xe9,
+	0x9d, 0x8b, 0xf4, 0xcc, 0x91, 0x7c, 0x68, 0xbe, 0x22, 0xb6, 0x25, 0xfa, 0x6d, 0x7b, 0x8b, 0x7c,
+	0x0e, 0xe1, 0xbd, 0x40, 0x69, 0x4e, 0xe4, 0xc8, 0x7e, 0xfe, 0x59, 0x9b, 0xeb, 0x57, 0x07, 0xa7,
+	0x2d, 0x9f, 0x7c, 0x03, 0xa1, 0xc7, 0xc8, 0x0a, 0x42, 0x2e, 0xb1, 0x11, 0xdc, 0x1c, 0x56, 0x53,
+	0xc1, 0xf3, 0x07, 0x51, 0xdf, 0x4b, 0x6c, 0x8e, 0x69, 0xeb, 0x94, 0xbc, 0x81, 0xb8, 0x4f, 0x18,
+	0x3d, 0xdc, 0xf2, 0xa3, 0x97, 0xab, 0x79, 0x9e, 0x8e, 0xd4, 0xa0, 0x77, 0xa4, 0x92, 0xdf, 0x61,
+	0xf6, 0x13, 0x47, 0x3b, 0x08, 0xdd, 0xdd, 0x3a, 0x7f, 0x3d, 0xcd, 0xdb, 0x84, 0x6d, 0x18, 0xe6,
+	0x4e, 0xde, 0xa3, 0xd4, 0x19, 0xe4, 0x15, 0x4c, 0xec, 0x5f, 0x83, 0xa6, 0x43, 0x5b, 0xdd, 0xfc,
+	0x6c, 0xa4, 0xa9, 0x27, 0x93, 0xdf, 0x60, 0xda, 0x66, 0x7f, 0x87, 0xe4, 0x2f, 0x61, 0x6c, 0xe3,
+	0xed, 0x20, 0x1f, 0xe5, 0x76, 0x5c, 0xf2, 0x16, 0xe6, 0x6b, 0x75, 0x2f, 0xcd, 0x1d, 0xef, 0xf2,
+	0x3f, 0x75, 0xbc, 0xed, 0x6f, 0x60, 0x70, 0xfa, 0x0d, 0x6c, 0x26, 0xf6, 0xdf, 0xec, 0xab, 0xff,
+	0x07, 0x00, 0xc2, 0xf1, 0xea, 0x1c, 0xdc, 0x06, 0x00, 0x00,
 }
@@ -10,9 +10,12 @@ It is generated from these files:
 It has these top-level messages:
 	Genesis
 	GenesisMeta
+	GenesisForks
 	GenesisConsensus
 	GenesisConsensusDpos
 	GenesisTokenDistribution
+	GenesisAccount
+	GenesisStorageItem
 */
 package corepb
 
@@ -39,6 +42,10 @@ type Genesis struct {
 	// genesis token distribution address
 	// map<string, string> token_distribution = 3;
 	TokenDistribution []*GenesisTokenDistribution `protobuf:"bytes,3,rep,name=token_distribution,json=tokenDistribution" json:"token_distribution,omitempty"`
+	// accounts exported from the state of another chain, with nonce, contract and storage.
+	Accounts []*GenesisAccount `protobuf:"bytes,4,rep,name=accounts" json:"accounts,omitempty"`
+	// heights from which the new consensus rules apply.
+	Forks *GenesisForks `protobuf:"bytes,5,opt,name=forks" json:"forks,omitempty"`
 }
 
 func (m *Genesis) Reset()                    { *m = Genesis{} }
@@ -67,6 +74,20 @@ func (m *Genesis) GetTokenDistribution() []*GenesisTokenDistribution {
 	return nil
 }
 
+func (m *Genesis) GetAccounts() []*GenesisAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *Genesis) GetForks() *GenesisForks {
+	if m != nil {
+		return m.Forks
+	}
+	return nil
+}
+
 type GenesisMeta struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -84,6 +105,124 @@ func (m *GenesisMeta) GetChainId() uint32 {
 	return 0
 }
 
+type GenesisForks struct {
+	// height from which the contracts get the extended block and transaction
+	// context, 0 if not scheduled.
+	ContractContextHeight uint64 `protobuf:"varint,1,opt,name=contract_context_height,json=contractContextHeight,proto3" json:"contract_context_height,omitempty"`
+	// height from which the issued and burned supply is tracked in the
+	// state, 0 if not scheduled.
+	SupplyHeight uint64 `protobuf:"varint,2,opt,name=supply_height,json=supplyHeight,proto3" json:"supply_height,omitempty"`
+	// height from which fee_burn_percent of the transaction fees are burned
+	// instead of paid to the block producer, 0 if not scheduled. It must not
+	// be before the supply fork, the burned fees are recorded in the supply.
+	FeeBurnHeight uint64 `protobuf:"varint,3,opt,name=fee_burn_height,json=feeBurnHeight,proto3" json:"fee_burn_height,omitempty"`
+	// percentage of the transaction fees burned from the fee burn fork on.
+	FeeBurnPercent uint32 `protobuf:"varint,4,opt,name=fee_burn_percent,json=feeBurnPercent,proto3" json:"fee_burn_percent,omitempty"`
+	// heights from which the registered tx payload types not built in are
+	// accepted, by type name. A type not scheduled is rejected.
+	TxPayloadTypes map[string]uint64 `protobuf:"bytes,5,rep,name=tx_payload_types,json=txPayloadTypes" json:"tx_payload_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// height from which the blocks have the v1 header, committing to its
+	// version in the block hash, 0 if not scheduled.
+	HeaderV1Height uint64 `protobuf:"varint,6,opt,name=header_v1_height,json=headerV1Height,proto3" json:"header_v1_height,omitempty"`
+	// height from which the gas used by the transactions of a block must not
+	// exceed block_gas_limit, 0 if not scheduled.
+	BlockGasLimitHeight uint64 `protobuf:"varint,7,opt,name=block_gas_limit_height,json=blockGasLimitHeight,proto3" json:"block_gas_limit_height,omitempty"`
+	// max gas used by the transactions of a block from the block gas limit
+	// fork on, in decimal.
+	BlockGasLimit string `protobuf:"bytes,8,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
+	// height from which the messages sent by contracts are delivered in the
+	// later blocks, 0 if not scheduled.
+	MessageHeight uint64 `protobuf:"varint,9,opt,name=message_height,json=messageHeight,proto3" json:"message_height,omitempty"`
+	// height from which the VM limits the call depth, the heap and the
+	// size of the objects put in the contract storage, 0 if not scheduled.
+	VmLimitsHeight uint64 `protobuf:"varint,10,opt,name=vm_limits_height,json=vmLimitsHeight,proto3" json:"vm_limits_height,omitempty"`
+	// height from which the contracts deployed must not use float literals nor
+	// the nondeterministic Math functions, disabled in the execution, 0 if not scheduled.
+	NumericPolicyHeight uint64 `protobuf:"varint,11,opt,name=numeric_policy_height,json=numericPolicyHeight,proto3" json:"numeric_policy_height,omitempty"`
+}
+
+func (m *GenesisForks) Reset()                    { *m = GenesisForks{} }
+func (m *GenesisForks) String() string            { return proto.CompactTextString(m) }
+func (*GenesisForks) ProtoMessage()               {}
+func (*GenesisForks) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{2} }
+
+func (m *GenesisForks) GetContractContextHeight() uint64 {
+	if m != nil {
+		return m.ContractContextHeight
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetSupplyHeight() uint64 {
+	if m != nil {
+		return m.SupplyHeight
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetFeeBurnHeight() uint64 {
+	if m != nil {
+		return m.FeeBurnHeight
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetFeeBurnPercent() uint32 {
+	if m != nil {
+		return m.FeeBurnPercent
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetTxPayloadTypes() map[string]uint64 {
+	if m != nil {
+		return m.TxPayloadTypes
+	}
+	return nil
+}
+
+func (m *GenesisForks) GetHeaderV1Height() uint64 {
+	if m != nil {
+		return m.HeaderV1Height
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetBlockGasLimitHeight() uint64 {
+	if m != nil {
+		return m.BlockGasLimitHeight
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetBlockGasLimit() string {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return ""
+}
+
+func (m *GenesisForks) GetMessageHeight() uint64 {
+	if m != nil {
+		return m.MessageHeight
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetVmLimitsHeight() uint64 {
+	if m != nil {
+		return m.VmLimitsHeight
+	}
+	return 0
+}
+
+func (m *GenesisForks) GetNumericPolicyHeight() uint64 {
+	if m != nil {
+		return m.NumericPolicyHeight
+	}
+	return 0
+}
+
 type GenesisConsensus struct {
 	// ChainID.
 	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos" json:"dpos,omitempty"`
@@ -92,7 +231,7 @@ type GenesisConsensus struct {
 func (m *GenesisConsensus) Reset()                    { *m = GenesisConsensus{} }
 func (m *GenesisConsensus) String() string            { return proto.CompactTextString(m) }
 func (*GenesisConsensus) ProtoMessage()               {}
-func (*GenesisConsensus) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{2} }
+func (*GenesisConsensus) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{3} }
 
 func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 	if m != nil {
@@ -104,12 +243,16 @@ func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
+	// max canonical blocks a fork may revert to become the tail, 0 for no
+	// limit. The blocks branching below the tail height minus it are
+	// rejected.
+	MaxReorgDepth uint64 `protobuf:"varint,2,opt,name=max_reorg_depth,json=maxReorgDepth,proto3" json:"max_reorg_depth,omitempty"`
 }
 
 func (m *GenesisConsensusDpos) Reset()                    { *m = GenesisConsensusDpos{} }
 func (m *GenesisConsensusDpos) String() string            { return proto.CompactTextString(m) }
 func (*GenesisConsensusDpos) ProtoMessage()               {}
-func (*GenesisConsensusDpos) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{3} }
+func (*GenesisConsensusDpos) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
 
 func (m *GenesisConsensusDpos) GetDynasty() []string {
 	if m != nil {
@@ -118,6 +261,13 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+func (m *GenesisConsensusDpos) GetMaxReorgDepth() uint64 {
+	if m != nil {
+		return m.MaxReorgDepth
+	}
+	return 0
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -126,7 +276,7 @@ type GenesisTokenDistribution struct {
 func (m *GenesisTokenDistribution) Reset()                    { *m = GenesisTokenDistribution{} }
 func (m *GenesisTokenDistribution) String() string            { return proto.CompactTextString(m) }
 func (*GenesisTokenDistribution) ProtoMessage()               {}
-func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{5} }
 
 func (m *GenesisTokenDistribution) GetAddress() string {
 	if m != nil {
@@ -142,33 +292,136 @@ func (m *GenesisTokenDistribution) GetValue() string {
 	return ""
 }
 
+type GenesisAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce   uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// hex encoded deploy transaction of a contract account, empty for user accounts.
+	BirthTx string `protobuf:"bytes,4,opt,name=birth_tx,json=birthTx,proto3" json:"birth_tx,omitempty"`
+	// contract storage.
+	Storage []*GenesisStorageItem `protobuf:"bytes,5,rep,name=storage" json:"storage,omitempty"`
+}
+
+func (m *GenesisAccount) Reset()                    { *m = GenesisAccount{} }
+func (m *GenesisAccount) String() string            { return proto.CompactTextString(m) }
+func (*GenesisAccount) ProtoMessage()               {}
+func (*GenesisAccount) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{6} }
+
+func (m *GenesisAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisAccount) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *GenesisAccount) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *GenesisAccount) GetBirthTx() string {
+	if m != nil {
+		return m.BirthTx
+	}
+	return ""
+}
+
+func (m *GenesisAccount) GetStorage() []*GenesisStorageItem {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+type GenesisStorageItem struct {
+	// hex encoded key and value.
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *GenesisStorageItem) Reset()                    { *m = GenesisStorageItem{} }
+func (m *GenesisStorageItem) String() string            { return proto.CompactTextString(m) }
+func (*GenesisStorageItem) ProtoMessage()               {}
+func (*GenesisStorageItem) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{7} }
+
+func (m *GenesisStorageItem) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GenesisStorageItem) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "corepb.Genesis")
 	proto.RegisterType((*GenesisMeta)(nil), "corepb.GenesisMeta")
+	proto.RegisterType((*GenesisForks)(nil), "corepb.GenesisForks")
 	proto.RegisterType((*GenesisConsensus)(nil), "corepb.GenesisConsensus")
 	proto.RegisterType((*GenesisConsensusDpos)(nil), "corepb.GenesisConsensusDpos")
 	proto.RegisterType((*GenesisTokenDistribution)(nil), "corepb.GenesisTokenDistribution")
+	proto.RegisterType((*GenesisAccount)(nil), "corepb.GenesisAccount")
+	proto.RegisterType((*GenesisStorageItem)(nil), "corepb.GenesisStorageItem")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0x65, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0x28, 0x79, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x05, 0x11, 0x82, 0xef, 0x23, 0x6d, 0x2e, 0x1a, 0xd4, 0xa4,
-	0xf4, 0xa6, 0xc2, 0x7e, 0x9b, 0x7f, 0x4e, 0x96, 0xae, 0x38, 0x8a, 0x7b, 0x3c, 0x39, 0x5f, 0x2e,
-	0xe7, 0xdc, 0x0b, 0x8b, 0x37, 0xb4, 0x48, 0x86, 0xca, 0xb6, 0x73, 0xde, 0xb1, 0x79, 0xe3, 0x3a,
-	0x6c, 0x6b, 0xf1, 0x13, 0x41, 0xf2, 0x30, 0x38, 0xec, 0x06, 0x66, 0x5f, 0xe8, 0x15, 0x8f, 0xf2,
-	0xa8, 0xc8, 0xd6, 0x97, 0xe5, 0x80, 0x94, 0x7b, 0xfb, 0x19, 0xbd, 0x92, 0x01, 0x60, 0x77, 0x90,
-	0x36, 0xce, 0x12, 0x5a, 0xea, 0x89, 0x9f, 0x04, 0x9a, 0x4f, 0xe8, 0xfb, 0xd1, 0x97, 0x7f, 0x28,
-	0x7b, 0x01, 0xe6, 0xdd, 0x07, 0xda, 0x8d, 0x36, 0xe4, 0x3b, 0x53, 0xf7, 0xde, 0x38, 0xcb, 0xe3,
-	0x3c, 0x2e, 0xb2, 0x75, 0x3e, 0x19, 0xf0, 0xba, 0x03, 0xab, 0x03, 0x4e, 0x5e, 0xf8, 0xe9, 0x93,
-	0x28, 0x20, 0x3b, 0x48, 0xc7, 0xae, 0xe0, 0xac, 0x79, 0x57, 0xc6, 0x6e, 0x8c, 0x0e, 0x25, 0x16,
-	0x32, 0x09, 0xfa, 0x51, 0x8b, 0x0a, 0xce, 0xa7, 0xc9, 0xd8, 0x0a, 0x66, 0xba, 0x75, 0xb4, 0xef,
-	0x7b, 0x7d, 0xac, 0x41, 0xd5, 0x3a, 0x92, 0x81, 0x14, 0x2b, 0x58, 0xfe, 0xe7, 0x32, 0x0e, 0x89,
-	0xde, 0x5a, 0x45, 0x7e, 0xcb, 0xa3, 0x3c, 0x2e, 0x52, 0x39, 0x4a, 0xf1, 0x04, 0xfc, 0x58, 0xa1,
-	0xdd, 0x2f, 0xa5, 0x75, 0x87, 0x34, 0x44, 0x48, 0xe5, 0x28, 0xd9, 0x12, 0x4e, 0xbf, 0xd5, 0x67,
-	0x8f, 0x61, 0xb9, 0xa9, 0x1c, 0x44, 0x3d, 0x0f, 0xa7, 0xbb, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0xf4, 0x0e, 0x3f, 0xcb, 0x01, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x85, 0x94, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x95, 0x43, 0x9b, 0x64, 0xda, 0x84, 0xb0, 0x3d, 0x60, 0x2a, 0x2e, 0x2a, 0x23, 0x20,
+	0xe2, 0x22, 0xa2, 0x2d, 0x42, 0x08, 0x71, 0x53, 0x1a, 0x28, 0x45, 0x20, 0x2a, 0x13, 0x21, 0xee,
+	0xac, 0xb5, 0xbd, 0x4d, 0xac, 0xd8, 0x5e, 0x6b, 0x77, 0x1d, 0x25, 0xcf, 0xc2, 0x23, 0xf0, 0x52,
+	0x3c, 0x0a, 0x7b, 0x72, 0xda, 0x98, 0x16, 0xee, 0x3c, 0xf3, 0x7f, 0x3b, 0x99, 0xf9, 0x77, 0x27,
+	0xd0, 0x9d, 0x90, 0x8c, 0xf0, 0x98, 0x0f, 0x73, 0x46, 0x05, 0x45, 0x9b, 0x21, 0x65, 0x24, 0x0f,
+	0xdc, 0x9f, 0x75, 0x68, 0x9d, 0x1b, 0x05, 0x3d, 0x83, 0x66, 0x4a, 0x04, 0x76, 0x6a, 0x87, 0xb5,
+	0xc1, 0xd6, 0xf1, 0xce, 0xd0, 0x20, 0x43, 0x2b, 0x7f, 0x91, 0x92, 0xa7, 0x01, 0xf4, 0x0a, 0x3a,
+	0x21, 0xcd, 0x38, 0xc9, 0x78, 0xc1, 0x9d, 0xba, 0xa6, 0x9d, 0x0a, 0x7d, 0x56, 0xea, 0xde, 0x35,
+	0x8a, 0xbe, 0x02, 0x12, 0x74, 0x46, 0x32, 0x3f, 0x8a, 0xb9, 0x60, 0x71, 0x50, 0x88, 0x98, 0x66,
+	0x4e, 0xe3, 0xb0, 0x21, 0x0b, 0x1c, 0x56, 0x0a, 0x8c, 0x15, 0x38, 0xba, 0xc1, 0x79, 0xf7, 0x45,
+	0x35, 0x85, 0x8e, 0xa1, 0x8d, 0xc3, 0x90, 0x16, 0x99, 0xe0, 0x4e, 0x53, 0x97, 0xd9, 0xaf, 0x94,
+	0x39, 0x35, 0xb2, 0xb7, 0xe2, 0xd0, 0x73, 0xd8, 0xb8, 0xa2, 0x6c, 0xc6, 0x9d, 0x0d, 0xdd, 0xf8,
+	0x6e, 0xe5, 0xc0, 0x07, 0xa5, 0x79, 0x06, 0x71, 0x07, 0xb0, 0x75, 0x63, 0x7a, 0xf4, 0x10, 0xda,
+	0xe1, 0x14, 0xc7, 0x99, 0x1f, 0x47, 0xda, 0xa4, 0xae, 0xd7, 0xd2, 0xf1, 0x45, 0xe4, 0xfe, 0x6e,
+	0xc2, 0xf6, 0xcd, 0x0a, 0xd2, 0xa3, 0x07, 0x72, 0x70, 0xc1, 0x70, 0x28, 0x7c, 0xf5, 0x41, 0x16,
+	0xc2, 0x9f, 0x92, 0x78, 0x32, 0x15, 0xfa, 0x68, 0xd3, 0xdb, 0x2b, 0xe5, 0x33, 0xa3, 0x7e, 0xd4,
+	0x22, 0x7a, 0x0c, 0x5d, 0x5e, 0xe4, 0x79, 0xb2, 0x2c, 0xe9, 0xba, 0xa6, 0xb7, 0x4d, 0xd2, 0x42,
+	0x4f, 0xe1, 0xde, 0x15, 0x21, 0x7e, 0x50, 0xb0, 0xac, 0xc4, 0x1a, 0x1a, 0xeb, 0xca, 0xf4, 0x3b,
+	0x99, 0xb5, 0xdc, 0x00, 0xfa, 0x2b, 0x2e, 0x27, 0x2c, 0x24, 0x99, 0x90, 0x3e, 0xa9, 0xc6, 0x7b,
+	0x16, 0xbc, 0x34, 0x59, 0xe4, 0x41, 0x5f, 0x2c, 0xfc, 0x1c, 0x2f, 0x13, 0x8a, 0x23, 0x5f, 0x2c,
+	0x73, 0xa2, 0x0c, 0x52, 0x8e, 0x0e, 0x6e, 0x33, 0x68, 0x38, 0x5e, 0x5c, 0x1a, 0x76, 0xac, 0xd0,
+	0xf7, 0x72, 0x92, 0xa5, 0xd7, 0x13, 0x6b, 0x49, 0xf5, 0xeb, 0x53, 0x82, 0x23, 0xc2, 0xfc, 0xf9,
+	0x51, 0xd9, 0xe6, 0xa6, 0x6e, 0xb3, 0x67, 0xf2, 0xdf, 0x8f, 0x6c, 0x9f, 0x27, 0xb0, 0x1f, 0x24,
+	0x34, 0x9c, 0xf9, 0x13, 0xcc, 0xfd, 0x24, 0x4e, 0xe3, 0x95, 0x57, 0x2d, 0xcd, 0xef, 0x68, 0xf5,
+	0x1c, 0xf3, 0xcf, 0x4a, 0xbb, 0x36, 0xa1, 0x72, 0xc8, 0x69, 0x4b, 0xba, 0xe3, 0x75, 0xd7, 0x68,
+	0xf4, 0x04, 0x7a, 0x29, 0xe1, 0x1c, 0x4f, 0x48, 0x59, 0xb4, 0x63, 0xbc, 0xb2, 0xd9, 0x6b, 0xaf,
+	0xe6, 0xa9, 0xa9, 0xc3, 0x4b, 0x10, 0x4c, 0xb7, 0xf3, 0x54, 0x57, 0xe2, 0x96, 0x3c, 0x86, 0xbd,
+	0xac, 0x48, 0x09, 0x8b, 0x43, 0x3f, 0xa7, 0x49, 0x1c, 0xae, 0xae, 0x6a, 0xcb, 0x34, 0x6b, 0xc5,
+	0x4b, 0xad, 0x99, 0x33, 0x07, 0xa7, 0xb0, 0x73, 0x8b, 0x65, 0xa8, 0x0f, 0x8d, 0x19, 0x59, 0xea,
+	0x17, 0xd1, 0xf1, 0xd4, 0x27, 0xda, 0x85, 0x8d, 0x39, 0x4e, 0x0a, 0x62, 0xef, 0xdd, 0x04, 0x6f,
+	0xea, 0xaf, 0x6b, 0xee, 0x08, 0xfa, 0xd5, 0xe5, 0x42, 0x2f, 0xa0, 0x19, 0xe5, 0x94, 0xdb, 0x95,
+	0x7d, 0x74, 0xd7, 0x12, 0x8e, 0x24, 0xe3, 0x69, 0xd2, 0xfd, 0x01, 0xbb, 0xb7, 0xa9, 0xc8, 0x81,
+	0x56, 0xb4, 0xcc, 0x30, 0x17, 0xaa, 0x9b, 0x86, 0xec, 0xa6, 0x0c, 0x95, 0xcf, 0x29, 0x5e, 0xf8,
+	0x8c, 0x50, 0x36, 0xf1, 0x23, 0x92, 0x8b, 0xa9, 0xed, 0xad, 0x2b, 0xd3, 0x9e, 0xca, 0x8e, 0x54,
+	0xd2, 0xfd, 0x04, 0xce, 0x5d, 0xbb, 0xab, 0xaa, 0xe3, 0x28, 0x62, 0xd2, 0x70, 0x3b, 0x6b, 0x19,
+	0xae, 0xcf, 0xdb, 0xb1, 0xf3, 0xba, 0xbf, 0x6a, 0xd0, 0x5b, 0xdf, 0xe0, 0x7f, 0x94, 0x90, 0x4a,
+	0x80, 0x13, 0x9c, 0x85, 0x65, 0x91, 0x32, 0x54, 0xc5, 0x33, 0xaa, 0xf2, 0x66, 0x3b, 0x4c, 0xa0,
+	0xd6, 0x38, 0x88, 0x99, 0x98, 0xfa, 0x62, 0xa1, 0xb7, 0x41, 0x1d, 0x50, 0xf1, 0x78, 0x81, 0x5e,
+	0x42, 0x8b, 0x0b, 0xca, 0xe4, 0xab, 0xb0, 0xaf, 0xff, 0xa0, 0x62, 0xe9, 0x37, 0xa3, 0x5e, 0x08,
+	0x92, 0x7a, 0x25, 0xea, 0xbe, 0x05, 0xf4, 0xb7, 0xfc, 0xbf, 0xbb, 0x2d, 0x67, 0x0d, 0x36, 0xf5,
+	0x3f, 0xf2, 0xc9, 0x1f, 0x66, 0x5a, 0xa2, 0x0e, 0xa2, 0x05, 0x00, 0x00,
 }
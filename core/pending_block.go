@@ -0,0 +1,108 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// PendingTransaction is a tx the producer would pack in the next block.
+type PendingTransaction struct {
+	Tx *Transaction
+	// Gas is the gas used by the tx executed after the txs before it.
+	Gas *util.Uint128
+}
+
+// PendingBlock is what the producer would pack in the next block if it were
+// produced now.
+type PendingBlock struct {
+	Height     uint64
+	ParentHash byteutils.Hash
+	// Transactions are in the order packed.
+	Transactions []*PendingTransaction
+	GasUsed      *util.Uint128
+	GasLimit     *util.Uint128
+	// PoolSize is the count of the txs in the pool the block is selected from.
+	PoolSize int
+}
+
+// PendingBlock selects the txs of the next block from the pool as the
+// producer does, on a clone of the tail block and without taking them out of
+// the pool. It runs on the estimation workers since it executes the txs.
+func (bc *BlockChain) PendingBlock(ctx context.Context) (pending *PendingBlock, err error) {
+	err = bc.estimates.Run(ctx, func(ctx context.Context) error {
+		pending, err = bc.pendingBlock(ctx, TxsPerBlock)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+func (bc *BlockChain) pendingBlock(ctx context.Context, n int) (*PendingBlock, error) {
+	parent, err := bc.TailBlock().Clone()
+	if err != nil {
+		return nil, err
+	}
+	// the fees go to the coinbase, which doesn't change the selection but
+	// for the txs of the coinbase itself.
+	block, err := NewBlock(bc.chainID, parent.Coinbase(), parent)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := bc.txPool.Pending()
+	pending := &PendingBlock{
+		Height:       block.height,
+		ParentHash:   parent.Hash(),
+		Transactions: []*PendingTransaction{},
+		GasLimit:     block.GasLimit(),
+		PoolSize:     len(txs),
+	}
+	gasLimit := ForksOf(bc.chainID).BlockGasLimitAt(block.height)
+	for _, tx := range txs {
+		if n == 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if gasLimit != nil && util.NewUint128().Add(block.gasUsed.Int, tx.gasLimit.Int).Cmp(gasLimit.Int) > 0 {
+			continue
+		}
+		gasUsed := block.gasUsed
+		block.begin()
+		if _, err := block.executeTransaction(ctx, tx); err != nil {
+			block.rollback()
+			continue
+		}
+		block.commit()
+		pending.Transactions = append(pending.Transactions, &PendingTransaction{
+			Tx:  tx,
+			Gas: util.NewUint128FromBigInt(util.NewUint128().Sub(block.gasUsed.Int, gasUsed.Int)),
+		})
+		n--
+	}
+	pending.GasUsed = block.gasUsed
+	return pending, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_PendingBlock(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(util.NewUint128FromInt(1000000000000000000))
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+
+	// the tx after the nonce gap is not packed.
+	var txs []*Transaction
+	for _, nonce := range []uint64{2, 1, 4} {
+		tx := NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128FromInt(1), nonce, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, bc.txPool.Push(tx))
+		txs = append(txs, tx)
+	}
+
+	pending, err := bc.PendingBlock(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, bc.TailBlock().Height()+1, pending.Height)
+	assert.Equal(t, bc.TailBlock().Hash(), pending.ParentHash)
+	assert.Equal(t, 3, pending.PoolSize)
+	assert.Equal(t, 2, len(pending.Transactions))
+	assert.Equal(t, txs[1].Hash(), pending.Transactions[0].Tx.Hash())
+	assert.Equal(t, txs[0].Hash(), pending.Transactions[1].Tx.Hash())
+	gasUsed := util.NewUint128().Add(pending.Transactions[0].Gas.Int, pending.Transactions[1].Gas.Int)
+	assert.Equal(t, 0, gasUsed.Cmp(pending.GasUsed.Int))
+	assert.True(t, pending.Transactions[0].Gas.Cmp(MinGasCountPerTransaction.Int) >= 0)
+
+	// neither the pool nor the tail state is changed.
+	assert.Equal(t, 3, len(bc.txPool.Pending()))
+	assert.Equal(t, bc.TailBlock().StateRoot(), bc.TailBlock().accState.RootHash())
+
+	// the txs are limited as in a produced block.
+	pending, err = bc.pendingBlock(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(pending.Transactions))
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import metrics "github.com/rcrowley/go-metrics"
+
+// Reasons the pools refuse a tx or a block, the keys of PoolStats. The new
+// blocks ahead of the local clock are counted as future_timestamp but still
+// accepted, consensus decides on their timestamps.
+const (
+	RejectDuplicate       = "duplicate"
+	RejectInvalid         = "invalid"
+	RejectBadSignature    = "bad_signature"
+	RejectNonceTooLow     = "nonce_too_low"
+	RejectUnderpriced     = "underpriced"
+	RejectOutOfGasLimit   = "out_of_gas_limit"
+	RejectPanicked        = "panicked"
+	RejectPausedContract  = "paused_contract"
+	RejectStale           = "stale"
+	RejectFutureTimestamp = "future_timestamp"
+	RejectDoubleMint      = "double_mint"
+)
+
+// PoolStats is the count of the txs and the blocks pending in the pools, and
+// of the ones the pools refused by reason since the node started.
+type PoolStats struct {
+	PendingTxs    int
+	PendingBlocks int
+	TxRejected    map[string]int64
+	BlockRejected map[string]int64
+}
+
+// PoolStats returns the admission stats of the tx pool and the block pool.
+func (bc *BlockChain) PoolStats() *PoolStats {
+	bc.txPool.mu.RLock()
+	pendingTxs := len(bc.txPool.all)
+	bc.txPool.mu.RUnlock()
+
+	return &PoolStats{
+		PendingTxs:    pendingTxs,
+		PendingBlocks: bc.bkPool.cache.Len(),
+		TxRejected: counts(map[string]metrics.Counter{
+			RejectDuplicate:      duplicateTxCounter,
+			RejectInvalid:        invalidTxCounter,
+			RejectBadSignature:   badSignatureTxCounter,
+			RejectNonceTooLow:    nonceTooLowTxCounter,
+			RejectUnderpriced:    belowGasPriceTxCounter,
+			RejectOutOfGasLimit:  outOfGasLimitTxCounter,
+			RejectPanicked:       panickedTxCounter,
+			RejectPausedContract: pausedContractTxCounter,
+		}),
+		BlockRejected: counts(map[string]metrics.Counter{
+			RejectDuplicate:       duplicatedBlockCounter,
+			RejectInvalid:         invalidBlockCounter,
+			RejectStale:           staleBlockCounter,
+			RejectFutureTimestamp: futureBlockCounter,
+			RejectDoubleMint:      doubleMintBlockCounter,
+		}),
+	}
+}
+
+func counts(counters map[string]metrics.Counter) map[string]int64 {
+	m := make(map[string]int64, len(counters))
+	for reason, counter := range counters {
+		m[reason] = counter.Count()
+	}
+	return m
+}
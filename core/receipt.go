@@ -0,0 +1,147 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// receiptsPrefix is the prefix of the keys of the receipts of the
+// transactions of a block, in the order of the transactions.
+const receiptsPrefix = "receipts_"
+
+func receiptsKey(blockHash byteutils.Hash) []byte {
+	return append([]byte(receiptsPrefix), blockHash...)
+}
+
+// Statuses of a transaction receipt.
+const (
+	ReceiptStatusFailed  = uint32(0)
+	ReceiptStatusSuccess = uint32(1)
+)
+
+// TransactionReceipt is the result of the execution of a transaction in
+// its canonical block.
+type TransactionReceipt struct {
+	TxHash    string `json:"tx_hash"`
+	BlockHash string `json:"block_hash,omitempty"`
+	Height    uint64 `json:"height,omitempty"`
+	Index     uint32 `json:"index"`
+
+	Status  uint32 `json:"status"`
+	GasUsed string `json:"gas_used"`
+	// ContractAddress is the address of the contract deployed by the
+	// transaction, empty for the other types.
+	ContractAddress string   `json:"contract_address,omitempty"`
+	Events          []*Event `json:"events"`
+	// Error is the error of a failed execution.
+	Error string `json:"error,omitempty"`
+}
+
+// recordReceipt records the result of the execution of the transaction,
+// after its events.
+func (block *Block) recordReceipt(tx *Transaction, gas *util.Uint128, err error) {
+	receipt := &TransactionReceipt{
+		TxHash:  tx.hash.String(),
+		Status:  ReceiptStatusSuccess,
+		GasUsed: gas.String(),
+	}
+	if err != nil {
+		receipt.Status = ReceiptStatusFailed
+		receipt.Error = err.Error()
+	} else if tx.Type() == TxPayloadDeployType {
+		if addr, err := tx.GenerateContractAddress(); err == nil {
+			receipt.ContractAddress = addr.String()
+		}
+	}
+	if events, err := block.FetchEvents(tx.hash); err == nil {
+		receipt.Events = events
+	}
+
+	if block.receipts == nil {
+		block.receipts = make(map[byteutils.HexHash]*TransactionReceipt)
+	}
+	block.receipts[tx.hash.Hex()] = receipt
+}
+
+// putReceipts stores the receipts of the transactions of the executed
+// block, the blocks loaded from storage have none.
+func (bc *BlockChain) putReceipts(batch *storage.Batch, block *Block) error {
+	if len(block.receipts) == 0 {
+		return nil
+	}
+	receipts := make([]*TransactionReceipt, len(block.transactions))
+	for i, tx := range block.transactions {
+		receipts[i] = block.receipts[tx.hash.Hex()]
+	}
+	value, err := json.Marshal(receipts)
+	if err != nil {
+		return err
+	}
+	batch.Put(receiptsKey(block.Hash()), value)
+	return nil
+}
+
+// GetTransactionReceipt returns the receipt of a transaction in the
+// canonical chain.
+func (bc *BlockChain) GetTransactionReceipt(hash byteutils.Hash) (*TransactionReceipt, error) {
+	block, index, err := bc.GetTransactionLocation(hash)
+	if err == ErrTransactionNotIndexed {
+		return nil, ErrReceiptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := bc.getBlockReceipts(block.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if int(index) >= len(receipts) || receipts[index] == nil || receipts[index].TxHash != hash.String() {
+		return nil, ErrReceiptNotFound
+	}
+
+	receipt := receipts[index]
+	receipt.BlockHash = block.Hash().String()
+	receipt.Height = block.Height()
+	receipt.Index = index
+	return receipt, nil
+}
+
+// getBlockReceipts returns the receipts of the transactions of a block in
+// their order, ErrReceiptNotFound if it has none.
+func (bc *BlockChain) getBlockReceipts(blockHash byteutils.Hash) ([]*TransactionReceipt, error) {
+	value, err := bc.blockStorage.Get(receiptsKey(blockHash))
+	if err == storage.ErrKeyNotFound {
+		// the blocks stored before the receipts have none.
+		return nil, ErrReceiptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var receipts []*TransactionReceipt
+	if err := json.Unmarshal(value, &receipts); err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
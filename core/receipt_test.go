@@ -0,0 +1,87 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTransactionReceipt(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2000000).Int))
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(balance)
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+	bc.storeBlockToStorage(bc.tailBlock)
+
+	// the second tx transfers more than the balance left, its execution fails.
+	ok := NewTransaction(bc.ChainID(), from, coinbase, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	ok.Sign(signature)
+	failed := NewTransaction(bc.ChainID(), from, coinbase, balance, 2, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	failed.Sign(signature)
+	assert.Nil(t, bc.txPool.Push(ok))
+	assert.Nil(t, bc.txPool.Push(failed))
+
+	_, err := bc.GetTransactionReceipt(ok.Hash())
+	assert.Equal(t, ErrReceiptNotFound, err)
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(2)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Equal(t, 2, len(block.transactions))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+	assert.Nil(t, bc.SetTailBlock(block))
+
+	receipt, err := bc.GetTransactionReceipt(ok.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, ok.Hash().String(), receipt.TxHash)
+	assert.Equal(t, block.Hash().String(), receipt.BlockHash)
+	assert.Equal(t, block.Height(), receipt.Height)
+	assert.Equal(t, uint32(0), receipt.Index)
+	assert.Equal(t, ReceiptStatusSuccess, receipt.Status)
+	assert.Equal(t, ok.GasCountOfTxBase().String(), receipt.GasUsed)
+	assert.Empty(t, receipt.Error)
+	assert.Equal(t, 1, len(receipt.Events))
+	assert.Equal(t, TopicExecuteTxSuccess, receipt.Events[0].Topic)
+
+	receipt, err = bc.GetTransactionReceipt(failed.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), receipt.Index)
+	assert.Equal(t, ReceiptStatusFailed, receipt.Status)
+	assert.Equal(t, ErrInsufficientBalance.Error(), receipt.Error)
+	assert.Equal(t, TopicExecuteTxFailed, receipt.Events[0].Topic)
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ReindexCursor is the key of the progress of an unfinished height reindex,
+	// the tail hash it started from followed by the hash of the next block to index.
+	ReindexCursor = "reindex_height_cursor"
+
+	reindexCheckpointInterval = 1000
+)
+
+// ReindexHeight rebuilds the height and transaction indexes of the canonical
+// chain by walking the stored block bodies from the tail back to the genesis,
+// and removes the height index above the tail. An interrupted reindex resumes from its last checkpoint, unless
+// the tail has changed. progress is called at every checkpoint.
+func ReindexHeight(bc *BlockChain, progress func(done, total uint64)) error {
+	bc.heightIndexLock.Lock()
+	defer bc.heightIndexLock.Unlock()
+
+	tail := bc.TailBlock()
+	total := tail.Height()
+	hash := tail.Hash()
+	if cursor, err := bc.indexStorage.Get([]byte(ReindexCursor)); err == nil &&
+		len(cursor) > len(hash) && byteutils.Equal(cursor[:len(hash)], hash) {
+		hash = cursor[len(hash):]
+		logging.CLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"next": hash.String(),
+		}).Info("Resuming height reindex.")
+	}
+
+	// the index above the tail is left by an abandoned fork.
+	for height := total + 1; ; height++ {
+		key := byteutils.FromUint64(height)
+		if _, err := bc.indexStorage.Get(key); err == storage.ErrKeyNotFound {
+			break
+		} else if err != nil {
+			return err
+		}
+		if err := bc.indexStorage.Del(key); err != nil {
+			return err
+		}
+	}
+
+	var done uint64
+	for {
+		value, err := bc.blockStorage.Get(hash)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"hash": hash.String(),
+				"err":  err,
+			}).Error("Failed to load block body.")
+			return ErrMissingParentBlock
+		}
+		pbBlock := new(corepb.Block)
+		if err := proto.Unmarshal(value, pbBlock); err != nil {
+			return err
+		}
+		batch := storage.NewBatch()
+		batch.Put(byteutils.FromUint64(pbBlock.Height), hash)
+		for i, tx := range pbBlock.Transactions {
+			putTxIndex(batch, tx.Hash, hash, uint32(i))
+		}
+		if err := bc.indexStorage.WriteBatch(batch); err != nil {
+			return err
+		}
+		done = total - pbBlock.Height + 1
+		if hash.Equals(GenesisHash) {
+			break
+		}
+
+		hash = pbBlock.Header.ParentHash
+		if done%reindexCheckpointInterval == 0 {
+			cursor := append(append([]byte{}, tail.Hash()...), hash...)
+			if err := bc.indexStorage.Put([]byte(ReindexCursor), cursor); err != nil {
+				return err
+			}
+			if progress != nil {
+				progress(done, total)
+			}
+		}
+	}
+
+	if err := bc.indexStorage.Del([]byte(ReindexCursor)); err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	bc.heightIndexFloor = bc.genesisBlock.height
+	if progress != nil {
+		progress(done, total)
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":   tail,
+		"blocks": total,
+	}).Info("Rebuilt height index.")
+	return nil
+}
+
+// RepairIndex checks the tail, the height index and the stored blocks are
+// consistent, a process killed while writing them may leave them apart. The
+// height index between the tail and its last indexed ancestor is rebuilt and
+// the index above the tail is removed. If the blocks of the tail are missing,
+// the tail rolls back to the last consistent height of the index.
+func (bc *BlockChain) RepairIndex() error {
+	bc.heightIndexLock.Lock()
+	defer bc.heightIndexLock.Unlock()
+
+	var tail, anchor *Block
+	stored, err := bc.loadTailFromStorage()
+	if err == nil {
+		tail, anchor = stored, bc.findIndexedAncestor(stored)
+	} else {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to load tail block.")
+	}
+	if anchor == nil {
+		tail = bc.lastConsistentBlock()
+		anchor = tail
+	}
+
+	batch := storage.NewBatch()
+	if err := bc.buildIndexByBlockHeight(batch, anchor, tail); err != nil {
+		return err
+	}
+	for height := tail.height + 1; ; height++ {
+		key := byteutils.FromUint64(height)
+		if _, err := bc.indexStorage.Get(key); err == storage.ErrKeyNotFound {
+			break
+		} else if err != nil {
+			return err
+		}
+		batch.Del(key)
+	}
+	// the irreversible block lost with the rolled back blocks is replaced by the tail.
+	lib, err := bc.loadLIBFromStorage()
+	if err != nil || lib.height > tail.height {
+		batch.Put([]byte(LIB), tail.Hash())
+		lib = tail
+	}
+
+	if batch.Len() > 0 || stored == nil || !stored.Hash().Equals(tail.Hash()) {
+		batch.Put([]byte(Tail), tail.Hash())
+		if err := bc.indexStorage.WriteBatch(batch); err != nil {
+			return err
+		}
+		logging.CLog().WithFields(logrus.Fields{
+			"stored": stored,
+			"tail":   tail,
+			"anchor": anchor,
+			"writes": batch.Len(),
+		}).Warn("Repaired inconsistent tail and height index.")
+	}
+
+	bc.tailBlock = tail
+	// the index under the anchor is verified on use.
+	bc.heightIndexFloor = anchor.height
+	bc.libLock.Lock()
+	if bc.lib != nil && bc.lib.height > lib.height {
+		bc.lib = lib
+	}
+	bc.libLock.Unlock()
+	return nil
+}
+
+// findIndexedAncestor returns the nearest ancestor of the block, or the block
+// itself, found by the height index with its parent stored, nil if a block
+// under it is missing.
+func (bc *BlockChain) findIndexedAncestor(block *Block) *Block {
+	for {
+		parent := bc.GetBlock(block.header.parentHash)
+		if bc.checkHeightIndex(block.Hash(), block.height) && (parent != nil || CheckGenesisBlock(block)) {
+			return block
+		}
+		if parent == nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"block": block,
+			}).Warn("Found tail block with missing ancestors.")
+			return nil
+		}
+		block = parent
+	}
+}
+
+// lastConsistentBlock walks the height index up from the latest irreversible
+// block while the indexed blocks are stored and linked to their parents.
+func (bc *BlockChain) lastConsistentBlock() *Block {
+	cur, err := bc.loadLIBFromStorage()
+	if err != nil || !bc.checkHeightIndex(cur.Hash(), cur.height) {
+		cur = bc.genesisBlock
+	}
+	for {
+		next := bc.getBlockFromHeightIndex(cur.height + 1)
+		if next == nil || !next.ParentHash().Equals(cur.Hash()) {
+			return cur
+		}
+		cur = next
+	}
+}
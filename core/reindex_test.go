@@ -0,0 +1,131 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReindexHeight(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	assert.Equal(t, uint64(4), bc.TailBlock().Height())
+
+	// an interrupted reindex resumes from its cursor.
+	assert.Nil(t, bc.indexStorage.Del(byteutils.FromUint64(2)))
+	assert.Nil(t, bc.indexStorage.Put(byteutils.FromUint64(3), blocks[2].Hash()))
+	assert.Nil(t, bc.indexStorage.Put(byteutils.FromUint64(5), blocks[2].Hash()))
+	cursor := append(append([]byte{}, bc.TailBlock().Hash()...), blocks[0].Hash()...)
+	assert.Nil(t, bc.indexStorage.Put([]byte(ReindexCursor), cursor))
+
+	var done, total uint64
+	assert.Nil(t, ReindexHeight(bc, func(d, t uint64) { done, total = d, t }))
+	assert.Equal(t, uint64(4), done)
+	assert.Equal(t, uint64(4), total)
+	_, err := bc.indexStorage.Get([]byte(ReindexCursor))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	_, err = bc.indexStorage.Get(byteutils.FromUint64(5))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	hash, _ := bc.indexStorage.Get(byteutils.FromUint64(2))
+	assert.Equal(t, []byte(blocks[0].Hash()), hash)
+	hash, _ = bc.indexStorage.Get(byteutils.FromUint64(3))
+	assert.Equal(t, []byte(blocks[2].Hash()), hash)
+
+	// a full reindex fixes all the heights.
+	assert.Nil(t, ReindexHeight(bc, nil))
+	for i, v := range blocks {
+		block, err := bc.GetBlockByHeight(uint64(i + 2))
+		assert.Nil(t, err)
+		assert.Equal(t, v.Hash(), block.Hash())
+	}
+	block, err := bc.GetBlockByHeight(1)
+	assert.Nil(t, err)
+	assert.Equal(t, bc.genesisBlock.Hash(), block.Hash())
+}
+
+func TestRepairIndex(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 4; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	// the missing index under the tail is rebuilt, the one above is removed.
+	assert.Nil(t, bc.indexStorage.Del(byteutils.FromUint64(4)))
+	assert.Nil(t, bc.indexStorage.Del(byteutils.FromUint64(5)))
+	assert.Nil(t, bc.indexStorage.Put(byteutils.FromUint64(6), blocks[0].Hash()))
+	assert.Nil(t, bc.indexStorage.Put(byteutils.FromUint64(7), blocks[1].Hash()))
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[3].Hash(), bc.TailBlock().Hash())
+	for i, v := range blocks {
+		block, err := bc.GetBlockByHeight(uint64(i + 2))
+		assert.Nil(t, err)
+		assert.Equal(t, v.Hash(), block.Hash())
+	}
+	for _, height := range []uint64{6, 7} {
+		_, err = bc.indexStorage.Get(byteutils.FromUint64(height))
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+	}
+
+	// the tail rolls back to the last consistent height if its block is missing.
+	assert.Nil(t, bc.blockStorage.Del(blocks[3].Hash()))
+	bc, err = NewBlockChain(neb)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[2].Hash(), bc.TailBlock().Hash())
+	hash, _ := bc.indexStorage.Get([]byte(Tail))
+	assert.Equal(t, []byte(blocks[2].Hash()), hash)
+	_, err = bc.indexStorage.Get(byteutils.FromUint64(5))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Nil(t, bc.CheckTail())
+
+	// a consistent chain is left as is.
+	assert.Nil(t, bc.RepairIndex())
+	assert.Equal(t, blocks[2].Hash(), bc.TailBlock().Hash())
+}
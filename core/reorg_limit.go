@@ -0,0 +1,100 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	reorgRejectedCounter   = metrics.GetOrRegisterCounter("neb.block.reorg.rejected", nil)
+	belowCheckpointCounter = metrics.GetOrRegisterCounter("neb.block.checkpoint.rejected", nil)
+)
+
+// MaxReorgDepth returns the max canonical blocks a fork may revert to become
+// the tail, 0 for no limit.
+func (bc *BlockChain) MaxReorgDepth() uint64 {
+	return bc.genesis.GetConsensus().GetDpos().GetMaxReorgDepth()
+}
+
+// Checkpoint returns the height of the canonical chain no fork may branch
+// below, 0 if none.
+func (bc *BlockChain) Checkpoint() uint64 {
+	depth := bc.MaxReorgDepth()
+	tail := bc.TailBlock().height
+	if depth == 0 || tail <= depth {
+		return 0
+	}
+	return tail - depth
+}
+
+// checkBranchPoint rejects the blocks whose parent is below the checkpoint,
+// they can only become canonical by reverting more than the max reorg depth.
+func (bc *BlockChain) checkBranchPoint(parent *Block) error {
+	if checkpoint := bc.Checkpoint(); parent.height < checkpoint {
+		belowCheckpointCounter.Inc(1)
+		logging.VLog().WithFields(logrus.Fields{
+			"parent":     parent,
+			"checkpoint": checkpoint,
+		}).Warn("Reject a block branching below the checkpoint.")
+		return ErrBlockBelowCheckpoint
+	}
+	return nil
+}
+
+// CheckReorgDepth returns ErrReorgTooDeep if setting the block as tail would
+// revert more canonical blocks than the max reorg depth, ErrRevertIrreversibleBlock
+// if it would revert the latest irreversible block.
+func (bc *BlockChain) CheckReorgDepth(newTail *Block) error {
+	bc.heightIndexLock.Lock()
+	defer bc.heightIndexLock.Unlock()
+
+	ancestor, err := bc.findCommonAncestorWithTail(context.Background(), newTail)
+	if err != nil {
+		return err
+	}
+	return bc.checkReorgDepth(ancestor)
+}
+
+func (bc *BlockChain) checkReorgDepth(ancestor *Block) error {
+	depth := bc.MaxReorgDepth()
+	if depth > 0 && bc.tailBlock.height-ancestor.height > depth {
+		reorgRejectedCounter.Inc(1)
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":     bc.tailBlock,
+			"ancestor": ancestor,
+			"max":      depth,
+		}).Warn("Reject a reorg deeper than the max.")
+		return ErrReorgTooDeep
+	}
+	if lib := bc.LIB(); ancestor.height < lib.height {
+		reorgRejectedCounter.Inc(1)
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":     bc.tailBlock,
+			"ancestor": ancestor,
+			"lib":      lib,
+		}).Warn("Reject a reorg reverting the latest irreversible block.")
+		return ErrRevertIrreversibleBlock
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReorgLimit(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Consensus.Dpos.MaxReorgDepth = 2
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	/*
+		genesis -- 1 - 2 - 3
+		        |        \_ fork2
+		        \_ fork0
+	*/
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	assert.Equal(t, uint64(2), bc.Checkpoint())
+
+	// branching from the checkpoint reverts 2 blocks.
+	fork2, _ := bc.NewBlockFromParent(coinbase, blocks[0])
+	fork2.header.timestamp = BlockInterval * 5
+	fork2.CollectTransactions(0)
+	fork2.SetMiner(coinbase)
+	fork2.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(fork2)))
+	assert.Nil(t, bc.CheckReorgDepth(fork2))
+
+	fork0, _ := bc.NewBlockFromParent(coinbase, bc.genesisBlock)
+	fork0.header.timestamp = BlockInterval * 6
+	fork0.CollectTransactions(0)
+	fork0.SetMiner(coinbase)
+	fork0.Seal()
+	assert.Equal(t, ErrBlockBelowCheckpoint, bc.BlockPool().Push(BlockFromNetwork(fork0)))
+
+	// a fork accepted before the tail moved on can't be set as tail.
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval * 7
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+	assert.Nil(t, bc.SetTailBlock(block))
+	assert.Equal(t, ErrReorgTooDeep, bc.CheckReorgDepth(fork2))
+	assert.Equal(t, ErrReorgTooDeep, bc.SetTailBlock(fork2))
+	assert.Equal(t, block.Hash(), bc.TailBlock().Hash())
+}
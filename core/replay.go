@@ -0,0 +1,173 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Roots compared when replaying blocks.
+const (
+	RootState       = "state"
+	RootTxs         = "txs"
+	RootEvents      = "events"
+	RootDposContext = "dposContext"
+	// RootGasUsed is the gas used of the header, compared when stored.
+	RootGasUsed = "gasUsed"
+)
+
+// ReplayResult is the result of re-executing a block.
+type ReplayResult struct {
+	Height  uint64
+	Hash    byteutils.Hash
+	Txs     int
+	Elapsed time.Duration
+	// Mismatches are the roots differing from the stored block.
+	Mismatches []string
+	// Receipts are the transactions whose events differ from the stored block.
+	Receipts []byteutils.Hash
+}
+
+// Matched returns whether the replayed block has the same roots as the stored one.
+func (r *ReplayResult) Matched() bool {
+	return len(r.Mismatches) == 0
+}
+
+// ReplayBlocks re-executes the canonical blocks in [from, to] on the state of
+// the block before from, comparing the resulting roots and events with the
+// stored blocks. All changes are kept in memory, the storage is not modified.
+func ReplayBlocks(bc *BlockChain, from, to uint64, onResult func(*ReplayResult)) error {
+	if from < 2 || from > to || to > bc.TailBlock().Height() {
+		return ErrInvalidReplayRange
+	}
+
+	overlay := storage.NewOverlayStorage(bc.storage)
+	emitter := NewEventEmitter(1024)
+	emitter.Start()
+	defer emitter.Stop()
+
+	load := func(height uint64) (*Block, error) {
+		block, err := bc.GetBlockByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		return LoadBlockFromStorage(block.Hash(), overlay, bc.txPool, emitter)
+	}
+
+	parent, err := load(from - 1)
+	if err != nil {
+		return err
+	}
+	if err := bc.CheckStateAvailable(parent); err != nil {
+		return err
+	}
+	for height := from; height <= to; height++ {
+		stored, err := load(height)
+		if err != nil {
+			return err
+		}
+		block, result, err := replayBlock(stored, parent, bc.ConsensusHandler())
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": stored,
+				"err":   err,
+			}).Error("Failed to replay block.")
+			return err
+		}
+		if onResult != nil {
+			onResult(result)
+		}
+		parent = block
+	}
+	return nil
+}
+
+// replayBlock executes a copy of the stored block on the parent.
+func replayBlock(stored, parent *Block, consensus Consensus) (*Block, *ReplayResult, error) {
+	pbBlock, err := stored.ToProto()
+	if err != nil {
+		return nil, nil, err
+	}
+	block := new(Block)
+	if err := block.FromProto(proto.Clone(pbBlock)); err != nil {
+		return nil, nil, err
+	}
+	if err := block.LinkParentBlock(parent); err != nil {
+		return nil, nil, err
+	}
+	if err := consensus.VerifyBlock(block, parent); err != nil {
+		return nil, nil, err
+	}
+
+	result := &ReplayResult{Height: block.height, Hash: block.Hash(), Txs: len(block.transactions)}
+	start := time.Now()
+	block.begin()
+	if err := block.execute(context.Background()); err != nil {
+		block.rollback()
+		return nil, nil, err
+	}
+	block.commit()
+	result.Elapsed = time.Since(start)
+
+	if !byteutils.Equal(block.accState.RootHash(), stored.StateRoot()) {
+		result.Mismatches = append(result.Mismatches, RootState)
+	}
+	if !byteutils.Equal(block.txsTrie.RootHash(), stored.TxsRoot()) {
+		result.Mismatches = append(result.Mismatches, RootTxs)
+	}
+	if !byteutils.Equal(block.eventsTrie.RootHash(), stored.EventsRoot()) {
+		result.Mismatches = append(result.Mismatches, RootEvents)
+		for _, tx := range block.transactions {
+			if !sameEvents(block, stored, tx.hash) {
+				result.Receipts = append(result.Receipts, tx.hash)
+			}
+		}
+	}
+	if !byteutils.Equal(block.dposContext.RootHash(), stored.DposContextHash()) {
+		result.Mismatches = append(result.Mismatches, RootDposContext)
+	}
+	if gasUsed := stored.GasUsed(); gasUsed != nil && gasUsed.Cmp(block.gasUsed.Int) != 0 {
+		result.Mismatches = append(result.Mismatches, RootGasUsed)
+	}
+	return block, result, nil
+}
+
+func sameEvents(a, b *Block, txHash byteutils.Hash) bool {
+	ea, err := a.FetchEvents(txHash)
+	if err != nil {
+		return false
+	}
+	eb, err := b.FetchEvents(txHash)
+	if err != nil || len(ea) != len(eb) {
+		return false
+	}
+	for i := range ea {
+		if ea[i].Topic != eb[i].Topic || ea[i].Data != eb[i].Data {
+			return false
+		}
+	}
+	return true
+}
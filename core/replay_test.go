@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayBlocks(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		bc.SetTailBlock(block)
+		blocks = append(blocks, block)
+	}
+
+	assert.Equal(t, ErrInvalidReplayRange, ReplayBlocks(bc, 1, 3, nil))
+	assert.Equal(t, ErrInvalidReplayRange, ReplayBlocks(bc, 3, 2, nil))
+	assert.Equal(t, ErrInvalidReplayRange, ReplayBlocks(bc, 2, 5, nil))
+
+	var results []*ReplayResult
+	collect := func(r *ReplayResult) { results = append(results, r) }
+	assert.Nil(t, ReplayBlocks(bc, 2, 4, collect))
+	assert.Equal(t, 3, len(results))
+	for i, r := range results {
+		assert.True(t, r.Matched())
+		assert.Equal(t, uint64(i+2), r.Height)
+		assert.Equal(t, blocks[i].Hash(), r.Hash)
+	}
+
+	// corrupt the state root of the stored block at height 3
+	stateRoot := blocks[1].header.stateRoot
+	blocks[1].header.stateRoot = byteutils.Hash(make([]byte, 32))
+	assert.Nil(t, bc.storeBlockToStorage(blocks[1]))
+	blocks[1].header.stateRoot = stateRoot
+
+	results = nil
+	assert.Nil(t, ReplayBlocks(bc, 2, 4, collect))
+	assert.Equal(t, 3, len(results))
+	assert.True(t, results[0].Matched())
+	assert.Equal(t, []string{RootState}, results[1].Mismatches)
+	assert.True(t, results[2].Matched())
+}
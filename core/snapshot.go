@@ -0,0 +1,208 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// SnapshotAttestation is signed by the publisher of a snapshot, a backup of
+// the storage of a node, binding the files of the backup to the tail they hold.
+// It's published beside the backup manifest, as manifests/<id>.sig.
+type SnapshotAttestation struct {
+	ChainID uint32 `json:"chain_id"`
+
+	// ID and Digest are the id and the digest of the backup manifest.
+	ID     string `json:"id"`
+	Digest string `json:"digest"`
+
+	Height uint64 `json:"height"`
+	Tail   string `json:"tail"`
+
+	Alg       uint8  `json:"alg"`
+	Signature string `json:"signature"`
+}
+
+// NewSnapshotAttestation returns the unsigned attestation of the backup of the storage.
+func NewSnapshotAttestation(stor storage.Storage, id, digest string) (*SnapshotAttestation, error) {
+	tailHash, err := storage.WithNamespace(stor, storage.NamespaceIndex).Get([]byte(Tail))
+	if err != nil {
+		return nil, err
+	}
+	tail, err := LoadBlockFromStorage(tailHash, stor, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotAttestation{
+		ChainID: tail.ChainID(),
+		ID:      id,
+		Digest:  digest,
+		Height:  tail.Height(),
+		Tail:    tail.Hash().String(),
+	}, nil
+}
+
+// Hash returns the hash signed by the publisher.
+func (a *SnapshotAttestation) Hash() byteutils.Hash {
+	return hash.Sha3256(
+		byteutils.FromUint32(a.ChainID),
+		[]byte(a.ID), []byte{0},
+		[]byte(a.Digest), []byte{0},
+		byteutils.FromUint64(a.Height),
+		[]byte(a.Tail),
+	)
+}
+
+// Sign sign the attestation.
+func (a *SnapshotAttestation) Sign(signature keystore.Signature) error {
+	sign, err := signature.Sign(a.Hash())
+	if err != nil {
+		return err
+	}
+	a.Alg = uint8(signature.Algorithm())
+	a.Signature = byteutils.Hex(sign)
+	return nil
+}
+
+// VerifySigner returns ErrInvalidSnapshotSignature if the attestation isn't
+// signed by the signer.
+func (a *SnapshotAttestation) VerifySigner(signer *Address) error {
+	sign, err := byteutils.FromHex(a.Signature)
+	if err != nil {
+		return ErrInvalidSnapshotSignature
+	}
+	addr, err := RecoverSignerAddress(keystore.Algorithm(a.Alg), a.Hash(), sign)
+	if err != nil || !addr.Equals(signer) {
+		return ErrInvalidSnapshotSignature
+	}
+	return nil
+}
+
+// VerifySnapshotStorage checks the storage unpacked from a snapshot holds the
+// attested tail, chained by parent hashes down to the genesis built from the
+// genesis conf of the chain. Every block of the chain must be the one indexed
+// at its height, and go through the checkpoints, the hashes of the blocks at
+// their heights, at or below the tail. The states of the blocks are trusted
+// as the signer of the attestation.
+func VerifySnapshotStorage(stor storage.Storage, a *SnapshotAttestation, genesis *corepb.Genesis, checkpoints map[uint64]byteutils.Hash) error {
+	index := storage.WithNamespace(stor, storage.NamespaceIndex)
+	value, err := index.Get([]byte(Tail))
+	tailHash := byteutils.Hash(value)
+	if err != nil || tailHash.String() != a.Tail {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":     tailHash.String(),
+			"attested": a.Tail,
+			"err":      err,
+		}).Error("Snapshot tail differs from the attested one.")
+		return ErrSnapshotMismatch
+	}
+
+	blocks := storage.WithNamespace(stor, storage.NamespaceBlocks)
+	blockHash := tailHash
+	for height := a.Height; height > 0; height-- {
+		block, err := loadSnapshotBlock(blocks, blockHash)
+		if err != nil || block.height != height || block.ChainID() != a.ChainID {
+			logging.CLog().WithFields(logrus.Fields{
+				"height": height,
+				"hash":   blockHash.String(),
+				"err":    err,
+			}).Error("Snapshot chain is broken.")
+			return ErrSnapshotMismatch
+		}
+		indexed, err := index.Get(byteutils.FromUint64(height))
+		if err != nil || !blockHash.Equals(indexed) {
+			logging.CLog().WithFields(logrus.Fields{
+				"height": height,
+				"hash":   blockHash.String(),
+				"err":    err,
+			}).Error("Snapshot index differs from its chain.")
+			return ErrSnapshotMismatch
+		}
+		if checkpoint, ok := checkpoints[height]; ok && !checkpoint.Equals(blockHash) {
+			logging.CLog().WithFields(logrus.Fields{
+				"height":     height,
+				"checkpoint": checkpoint.String(),
+				"hash":       blockHash.String(),
+			}).Error("Snapshot chain misses a checkpoint.")
+			return ErrSnapshotMismatch
+		}
+		if height == 1 {
+			if !blockHash.Equals(GenesisHash) {
+				return ErrSnapshotMismatch
+			}
+			return verifySnapshotGenesis(stor, genesis)
+		}
+		// the genesis hash is a constant, any other block must hash to its key.
+		if !HashBlock(block).Equals(blockHash) {
+			return ErrSnapshotMismatch
+		}
+		blockHash = block.ParentHash()
+	}
+	return ErrSnapshotMismatch
+}
+
+// loadSnapshotBlock returns the block without its state, the walk down the
+// chain of a snapshot only needs the headers.
+func loadSnapshotBlock(blocks storage.Storage, hash byteutils.Hash) (*Block, error) {
+	value, err := blocks.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	pbBlock := new(corepb.Block)
+	if err := proto.Unmarshal(value, pbBlock); err != nil {
+		return nil, err
+	}
+	block := new(Block)
+	if err := block.FromProto(pbBlock); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// verifySnapshotGenesis returns ErrSnapshotMismatch if the genesis block of
+// the snapshot is not the one built from the genesis conf.
+func verifySnapshotGenesis(stor storage.Storage, conf *corepb.Genesis) error {
+	block, err := LoadBlockFromStorage(GenesisHash, stor, nil, nil)
+	if err != nil {
+		return ErrSnapshotMismatch
+	}
+	mem, err := storage.NewMemoryStorage()
+	if err != nil {
+		return err
+	}
+	genesis, err := NewGenesisBlock(conf, &BlockChain{storage: mem})
+	if err != nil {
+		return err
+	}
+	if !sameGenesis(genesis, block) {
+		logging.CLog().WithFields(logrus.Fields{
+			"stateRoot": block.StateRoot().String(),
+			"expected":  genesis.StateRoot().String(),
+		}).Error("Snapshot genesis differs from the genesis conf.")
+		return ErrSnapshotMismatch
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotAttestation(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	a, err := NewSnapshotAttestation(bc.storage, "1", "digest")
+	assert.Nil(t, err)
+	assert.Equal(t, bc.ChainID(), a.ChainID)
+	assert.Equal(t, uint64(4), a.Height)
+	assert.Equal(t, blocks[2].Hash().String(), a.Tail)
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	signer, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	assert.Nil(t, a.Sign(signature))
+	assert.Nil(t, a.VerifySigner(signer))
+	assert.Equal(t, ErrInvalidSnapshotSignature, a.VerifySigner(mockAddress()))
+	tampered := *a
+	tampered.Height = 3
+	assert.Equal(t, ErrInvalidSnapshotSignature, tampered.VerifySigner(signer))
+
+	checkpoints := map[uint64]byteutils.Hash{
+		2: blocks[0].Hash(),
+		4: blocks[2].Hash(),
+		// above the tail, synced later.
+		100: mockAddress().Bytes(),
+	}
+	assert.Nil(t, VerifySnapshotStorage(bc.storage, a, bc.genesis, checkpoints))
+	checkpoints[3] = blocks[0].Hash()
+	assert.Equal(t, ErrSnapshotMismatch, VerifySnapshotStorage(bc.storage, a, bc.genesis, checkpoints))
+	assert.Equal(t, ErrSnapshotMismatch, VerifySnapshotStorage(bc.storage, &tampered, bc.genesis, nil))
+	tampered = *a
+	tampered.Tail = blocks[1].Hash().String()
+	assert.Equal(t, ErrSnapshotMismatch, VerifySnapshotStorage(bc.storage, &tampered, bc.genesis, nil))
+
+	// the chain ends at the genesis of another conf.
+	other := MockGenesisConf()
+	other.TokenDistribution = other.TokenDistribution[1:]
+	assert.Equal(t, ErrSnapshotMismatch, VerifySnapshotStorage(bc.storage, a, other, nil))
+
+	// a block of the chain is missing.
+	blocksStorage := storage.WithNamespace(bc.storage, storage.NamespaceBlocks)
+	assert.Nil(t, blocksStorage.Del(blocks[1].Hash()))
+	assert.Equal(t, ErrSnapshotMismatch, VerifySnapshotStorage(bc.storage, a, bc.genesis, nil))
+}
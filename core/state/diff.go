@@ -0,0 +1,135 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package state
+
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// AccountDiff is an account changed between two account states, From or To is
+// nil if the account doesn't exist in that state.
+type AccountDiff struct {
+	Address byteutils.Hash
+	From    Account
+	To      Account
+	// StorageKeys are the changed keys of the account storage.
+	StorageKeys [][]byte
+	// StorageTruncated is true if more storage keys changed than listed.
+	StorageTruncated bool
+}
+
+// DiffAccountStates returns at most limit accounts changed from the state root
+// from to the root to in address order, starting at the address start, with at
+// most maxKeys changed storage keys each. The next address to start at is
+// returned, nil if no accounts are left. The walk stops with the error of ctx
+// once it is done.
+func DiffAccountStates(ctx context.Context, from, to byteutils.Hash, stor storage.Storage, start byteutils.Hash, limit, maxKeys int) ([]*AccountDiff, byteutils.Hash, error) {
+	fromTrie, err := trie.NewBatchTrie(from, stor)
+	if err != nil {
+		return nil, nil, err
+	}
+	toTrie, err := trie.NewBatchTrie(to, stor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diffs := []*AccountDiff{}
+	var next byteutils.Hash
+	var visitErr error
+	err = fromTrie.Diff(toTrie, start, func(key, fromVal, toVal []byte) bool {
+		if visitErr = ctx.Err(); visitErr != nil {
+			return false
+		}
+		if len(diffs) == limit {
+			next = key
+			return false
+		}
+		diff := &AccountDiff{Address: key}
+		if diff.From, visitErr = accountFromBytes(fromVal, stor); visitErr != nil {
+			return false
+		}
+		if diff.To, visitErr = accountFromBytes(toVal, stor); visitErr != nil {
+			return false
+		}
+		if visitErr = diffStorage(ctx, diff, stor, maxKeys); visitErr != nil {
+			return false
+		}
+		diffs = append(diffs, diff)
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if visitErr != nil {
+		return nil, nil, visitErr
+	}
+	return diffs, next, nil
+}
+
+func accountFromBytes(bytes []byte, stor storage.Storage) (Account, error) {
+	if bytes == nil {
+		return nil, nil
+	}
+	acc := new(account)
+	if err := acc.FromBytes(bytes, stor); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+func diffStorage(ctx context.Context, diff *AccountDiff, stor storage.Storage, maxKeys int) error {
+	var fromVars, toVars byteutils.Hash
+	if diff.From != nil {
+		fromVars = diff.From.VarsHash()
+	}
+	if diff.To != nil {
+		toVars = diff.To.VarsHash()
+	}
+	if fromVars.Equals(toVars) {
+		return nil
+	}
+
+	fromTrie, err := trie.NewBatchTrie(fromVars, stor)
+	if err != nil {
+		return err
+	}
+	toTrie, err := trie.NewBatchTrie(toVars, stor)
+	if err != nil {
+		return err
+	}
+	err = fromTrie.Diff(toTrie, nil, func(key, from, to []byte) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		if len(diff.StorageKeys) == maxKeys {
+			diff.StorageTruncated = true
+			return false
+		}
+		diff.StorageKeys = append(diff.StorageKeys, key)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.Err()
+}
@@ -19,7 +19,6 @@
 package state
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
@@ -28,14 +27,15 @@ import (
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/errcode"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
 // Errors
 var (
-	ErrBalanceInsufficient = errors.New("cannot subtract a value which is bigger than current balance")
-	ErrAccountNotFound     = errors.New("cannot found account in storage")
+	ErrBalanceInsufficient = errcode.New(errcode.ModuleState, 2001, "cannot subtract a value which is bigger than current balance", false)
+	ErrAccountNotFound     = errcode.New(errcode.ModuleState, 2002, "cannot found account in storage", false)
 )
 
 // account info in state Trie
@@ -143,6 +143,11 @@ func (acc *account) IncrNonce() {
 	acc.nonce++
 }
 
+// SetNonce of an account, used by the genesis only
+func (acc *account) SetNonce(nonce uint64) {
+	acc.nonce = nonce
+}
+
 // AddBalance to an account
 func (acc *account) AddBalance(value *util.Uint128) {
 	acc.balance.Add(acc.balance.Int, value.Int)
@@ -192,6 +197,11 @@ func (acc *account) String() string {
 	)
 }
 
+// Prove the key in account's storage
+func (acc *account) Prove(key []byte) (trie.MerkleProof, error) {
+	return acc.variables.Prove(key)
+}
+
 // AccountState manage account state in Block
 type accountState struct {
 	stateTrie    *trie.BatchTrie
@@ -288,6 +298,16 @@ func (as *accountState) CreateContractAccount(addr []byte, birthPlace []byte) (A
 	return acc, nil
 }
 
+// Prove the account of the addr in the committed state
+func (as *accountState) Prove(addr []byte) (trie.MerkleProof, error) {
+	return as.stateTrie.Prove(addr)
+}
+
+// ProveAbsence proves the addr has no account in the committed state
+func (as *accountState) ProveAbsence(addr []byte) (trie.MerkleProof, error) {
+	return as.stateTrie.ProveAbsence(addr)
+}
+
 func (as *accountState) Accounts() ([]Account, error) {
 	accounts := []Account{}
 	iter, err := as.stateTrie.Iterator(nil)
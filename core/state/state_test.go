@@ -19,6 +19,7 @@
 package state
 
 import (
+	"context"
 	"testing"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
@@ -70,3 +71,54 @@ func TestAccountState(t *testing.T) {
 	as.RollBack()
 	assert.Equal(t, as.RootHash(), asClone.RootHash())
 }
+
+func TestDiffAccountStates(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	as, _ := NewAccountState(nil, stor)
+	as.BeginBatch()
+	acc1 := as.GetOrCreateUserAccount([]byte("accAddr1"))
+	acc1.AddBalance(util.NewUint128FromInt(16))
+	acc1.Put([]byte("var0"), []byte("value0"))
+	as.GetOrCreateUserAccount([]byte("accAddr2")).AddBalance(util.NewUint128FromInt(8))
+	as.Commit()
+	root1 := as.RootHash()
+
+	as.BeginBatch()
+	acc1 = as.GetOrCreateUserAccount([]byte("accAddr1"))
+	acc1.IncrNonce()
+	acc1.Put([]byte("var0"), []byte("value1"))
+	acc1.Put([]byte("var1"), []byte("value1"))
+	as.GetOrCreateUserAccount([]byte("accAddr3")).AddBalance(util.NewUint128FromInt(4))
+	as.Commit()
+	root2 := as.RootHash()
+
+	diffs, next, err := DiffAccountStates(context.Background(), root1, root2, stor, nil, 10, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+	assert.Equal(t, 2, len(diffs))
+	assert.Equal(t, []byte("accAddr1"), []byte(diffs[0].Address))
+	assert.Equal(t, uint64(0), diffs[0].From.Nonce())
+	assert.Equal(t, uint64(1), diffs[0].To.Nonce())
+	assert.Equal(t, [][]byte{[]byte("var0"), []byte("var1")}, diffs[0].StorageKeys)
+	assert.False(t, diffs[0].StorageTruncated)
+	assert.Equal(t, []byte("accAddr3"), []byte(diffs[1].Address))
+	assert.Nil(t, diffs[1].From)
+	assert.Equal(t, util.NewUint128FromInt(4), diffs[1].To.Balance())
+
+	diffs, next, err = DiffAccountStates(context.Background(), root1, root2, stor, nil, 1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(diffs))
+	assert.Equal(t, [][]byte{[]byte("var0")}, diffs[0].StorageKeys)
+	assert.True(t, diffs[0].StorageTruncated)
+	assert.Equal(t, []byte("accAddr3"), []byte(next))
+
+	diffs, next, err = DiffAccountStates(context.Background(), root1, root2, stor, next, 1, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+	assert.Equal(t, []byte("accAddr3"), []byte(diffs[0].Address))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = DiffAccountStates(ctx, root1, root2, stor, nil, 10, 10)
+	assert.Equal(t, context.Canceled, err)
+}
@@ -19,6 +19,7 @@
 package state
 
 import (
+	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -27,6 +28,7 @@ import (
 // Iterator Variables in Account Storage
 type Iterator interface {
 	Next() (bool, error)
+	Key() []byte
 	Value() []byte
 }
 
@@ -46,12 +48,14 @@ type Account interface {
 	FromBytes(bytes []byte, storage storage.Storage) error
 
 	IncrNonce()
+	SetNonce(nonce uint64)
 	AddBalance(value *util.Uint128)
 	SubBalance(value *util.Uint128) error
 	Put(key []byte, value []byte) error
 	Get(key []byte) ([]byte, error)
 	Del(key []byte) error
 	Iterator(prefix []byte) (Iterator, error)
+	Prove(key []byte) (trie.MerkleProof, error)
 }
 
 // AccountState Interface
@@ -68,4 +72,6 @@ type AccountState interface {
 	GetOrCreateUserAccount(addr []byte) Account
 	GetContractAccount(addr []byte) (Account, error)
 	CreateContractAccount(addr []byte, birthPlace []byte) (Account, error)
+	Prove(addr []byte) (trie.MerkleProof, error)
+	ProveAbsence(addr []byte) (trie.MerkleProof, error)
 }
@@ -0,0 +1,63 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Limits of a page of state diff
+const (
+	// MaxStateDiffAccounts the max number of accounts in a page.
+	MaxStateDiffAccounts = 1000
+	// MaxStateDiffStorageKeys the max number of storage keys listed of an account.
+	MaxStateDiffStorageKeys = 100
+)
+
+// StateDiff returns at most limit accounts changed from the state at the height
+// from to the one at the height to in the canonical chain, starting at the
+// address start. The address of the next page is returned, nil on the last page.
+// The walk of the states stops once ctx is done.
+func (bc *BlockChain) StateDiff(ctx context.Context, from, to uint64, start byteutils.Hash, limit int) ([]*state.AccountDiff, byteutils.Hash, error) {
+	if from == 0 || from >= to {
+		return nil, nil, ErrInvalidStateDiffRange
+	}
+	if limit <= 0 || limit > MaxStateDiffAccounts {
+		limit = MaxStateDiffAccounts
+	}
+
+	fromBlock, err := bc.GetBlockByHeight(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	toBlock, err := bc.GetBlockByHeight(to)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := bc.CheckStateAvailable(fromBlock); err != nil {
+		return nil, nil, err
+	}
+	if err := bc.CheckStateAvailable(toBlock); err != nil {
+		return nil, nil, err
+	}
+	return state.DiffAccountStates(ctx, fromBlock.StateRoot(), toBlock.StateRoot(), bc.storage, start, limit, MaxStateDiffStorageKeys)
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_StateDiff(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	coinbase := &Address{[]byte("012345678901234567890011")}
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+	assert.Nil(t, bc.SetTailBlock(block))
+
+	_, _, err := bc.StateDiff(context.Background(), 2, 1, nil, 10)
+	assert.Equal(t, ErrInvalidStateDiffRange, err)
+	_, _, err = bc.StateDiff(context.Background(), 1, 3, nil, 10)
+	assert.Equal(t, ErrCannotFindBlockAtGivenHeight, err)
+
+	diffs, next, err := bc.StateDiff(context.Background(), 1, 2, nil, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+	found := false
+	for _, diff := range diffs {
+		if diff.Address.Equals(coinbase.address) {
+			found = true
+			assert.Nil(t, diff.From)
+			assert.Equal(t, BlockReward, diff.To.Balance())
+		}
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,508 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// StatePrunedKey is the key of the height up to which the states were
+	// pruned, followed by the checkpoint interval kept, in storage.
+	StatePrunedKey = "state_pruned_height"
+
+	// MinPruneKeepRecent is the min recent block states the config may
+	// keep, so that a short reorg still finds the state of its branch point.
+	MinPruneKeepRecent = 128
+
+	// DefaultPruneInterval is the default time between two prune rounds.
+	DefaultPruneInterval = 10 * time.Minute
+
+	// MaxPruneHeights is the max block states pruned in a round, a node
+	// catching up prunes a long chain over several rounds.
+	MaxPruneHeights = 10000
+)
+
+var (
+	statePrunedNodesCounter = metrics.GetOrRegisterCounter("neb.state.pruned.nodes", nil)
+	statePrunedHeightGauge  = metrics.GetOrRegisterGauge("neb.state.pruned.height", nil)
+	statePruneTimer         = metrics.GetOrRegisterTimer("neb.state.prune", nil)
+)
+
+// PruneConfig is the config of the state pruning.
+type PruneConfig struct {
+	// KeepRecent is the block states kept under the tail, 0 for
+	// MinPruneKeepRecent.
+	KeepRecent uint64
+	// CheckpointInterval keeps the states of the heights multiple of it, 0
+	// keeps none but the genesis.
+	CheckpointInterval uint64
+	// Interval is the time between two prune rounds.
+	Interval time.Duration
+}
+
+// StatePruner deletes the trie nodes of the world states of the old blocks,
+// keeping the recent ones, the checkpoints and the genesis. It marks the
+// nodes of the states kept, in a scratch LevelDB dropped after the round so
+// the marks don't grow the memory with the storage, then sweeps the nodes of
+// the pruned states not marked. The nodes written while a round runs are never deleted, they may
+// belong to a new block sharing them with a pruned state.
+//
+// The headers, transactions and events of the pruned blocks are kept, as are
+// the root nodes of their tries so the blocks still load, only their states
+// can't be read anymore.
+type StatePruner struct {
+	bc    *BlockChain
+	conf  *PruneConfig
+	guard *storage.GuardedStorage
+
+	mu       sync.Mutex
+	pruned   uint64
+	interval uint64
+
+	quitCh chan int
+}
+
+// EnableStatePruning creates the state pruner of the chain. The guard wraps
+// the storage under the chain, it records the nodes written while pruning,
+// nil if nothing else writes to the storage, e.g. in tests.
+func (bc *BlockChain) EnableStatePruning(conf *PruneConfig, guard *storage.GuardedStorage) (*StatePruner, error) {
+	pruned, interval, err := loadStatePruned(bc.storage)
+	if err != nil {
+		return nil, err
+	}
+	if pruned > 0 && interval != conf.CheckpointInterval {
+		logging.CLog().WithFields(logrus.Fields{
+			"pruned":   pruned,
+			"interval": interval,
+			"conf":     conf.CheckpointInterval,
+		}).Error("The checkpoint interval can't change once states are pruned.")
+		return nil, ErrPruneIntervalChanged
+	}
+	if conf.Interval <= 0 {
+		conf.Interval = DefaultPruneInterval
+	}
+
+	sp := &StatePruner{
+		bc:       bc,
+		conf:     conf,
+		guard:    guard,
+		pruned:   pruned,
+		interval: conf.CheckpointInterval,
+		quitCh:   make(chan int, 1),
+	}
+	statePrunedHeightGauge.Update(int64(pruned))
+	bc.statePruner = sp
+	return sp, nil
+}
+
+// StatePruner returns the state pruner, nil if the pruning isn't enabled.
+func (bc *BlockChain) StatePruner() *StatePruner {
+	return bc.statePruner
+}
+
+// CheckStateAvailable returns ErrStatePruned if the state of the block was
+// pruned.
+func (bc *BlockChain) CheckStateAvailable(block *Block) error {
+	if bc.statePruner == nil {
+		return nil
+	}
+	return bc.statePruner.checkAvailable(block.height)
+}
+
+// CheckRangeAvailable returns ErrStatePruned if a block from the height up to
+// to was pruned, checked before walking a range of blocks.
+func (bc *BlockChain) CheckRangeAvailable(from, to uint64) error {
+	if bc.statePruner == nil {
+		return nil
+	}
+	for height := from; height <= to; height++ {
+		if err := bc.statePruner.checkAvailable(height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sp *StatePruner) checkAvailable(height uint64) error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	if height > sp.pruned || sp.isCheckpoint(height) {
+		return nil
+	}
+	return ErrStatePruned
+}
+
+func (sp *StatePruner) isCheckpoint(height uint64) bool {
+	return height <= 1 || (sp.interval > 0 && height%sp.interval == 0)
+}
+
+// PrunedHeight returns the height up to which the states were pruned.
+func (sp *StatePruner) PrunedHeight() uint64 {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	return sp.pruned
+}
+
+// Start start state pruner.
+func (sp *StatePruner) Start() {
+	logging.CLog().Info("Start StatePruner.")
+	go sp.loop()
+}
+
+// Stop stop state pruner.
+func (sp *StatePruner) Stop() {
+	logging.CLog().Info("Stop StatePruner.")
+	sp.quitCh <- 0
+}
+
+func (sp *StatePruner) loop() {
+	logging.CLog().Info("Launched StatePruner.")
+
+	ticker := time.NewTicker(sp.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sp.quitCh:
+			logging.CLog().Info("Shutdowned StatePruner.")
+			return
+		case <-ticker.C:
+			if _, err := sp.Prune(); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"err": err,
+				}).Error("Failed to prune states.")
+			}
+		}
+	}
+}
+
+// keepRecent returns the block states kept under the tail, never fewer than
+// a reorg may revert.
+func (sp *StatePruner) keepRecent() uint64 {
+	keep := sp.conf.KeepRecent
+	if keep == 0 {
+		keep = MinPruneKeepRecent
+	}
+	if depth := sp.bc.MaxReorgDepth(); keep < depth {
+		keep = depth
+	}
+	return keep
+}
+
+// Prune runs a prune round and returns the nodes deleted.
+func (sp *StatePruner) Prune() (int, error) {
+	sp.mu.Lock()
+	from := sp.pruned + 1
+	sp.mu.Unlock()
+	if from < 2 {
+		from = 2
+	}
+
+	tail := sp.bc.TailBlock()
+	keep := sp.keepRecent()
+	if tail.height <= keep+1 {
+		return 0, nil
+	}
+	target := tail.height - keep
+	if target < from {
+		return 0, nil
+	}
+	if target-from >= MaxPruneHeights {
+		target = from + MaxPruneHeights - 1
+	}
+
+	start := time.Now()
+	if sp.guard != nil {
+		sp.guard.Guard()
+		defer sp.guard.Unguard()
+	}
+
+	live, err := newPruneMarks()
+	if err != nil {
+		return 0, err
+	}
+	defer live.close()
+	if err := sp.mark(live, tail, target); err != nil {
+		return 0, err
+	}
+
+	// the root nodes of the pruned blocks are kept so they still load, their
+	// states are swept from the roots down.
+	var blocks []*Block
+	roots := make(map[string]bool)
+	for h := from; h <= target; h++ {
+		block, err := sp.bc.GetBlockByHeight(h)
+		if err != nil {
+			return 0, err
+		}
+		for _, root := range blockRoots(block) {
+			roots[byteutils.Hex(root)] = true
+		}
+		if !sp.isCheckpoint(h) {
+			blocks = append(blocks, block)
+		}
+	}
+
+	garbage, err := sp.sweep(blocks, live, roots)
+	if err != nil {
+		return 0, err
+	}
+
+	// the pruned height is persisted first, a round interrupted while
+	// deleting leaves unreachable nodes, never a state read half deleted.
+	if err := storeStatePruned(sp.bc.storage, target, sp.interval); err != nil {
+		return 0, err
+	}
+	sp.mu.Lock()
+	sp.pruned = target
+	sp.mu.Unlock()
+	statePrunedHeightGauge.Update(int64(target))
+
+	deleted := 0
+	for _, key := range garbage {
+		ok, err := sp.del(key)
+		if err != nil {
+			return deleted, err
+		}
+		if ok {
+			deleted++
+		}
+	}
+	statePrunedNodesCounter.Inc(int64(deleted))
+	statePruneTimer.UpdateSince(start)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"from":    from,
+		"to":      target,
+		"tail":    tail,
+		"deleted": deleted,
+		"elapsed": time.Since(start),
+	}).Info("Pruned states.")
+	return deleted, nil
+}
+
+func (sp *StatePruner) del(key []byte) (bool, error) {
+	if sp.guard != nil {
+		return sp.guard.DelUnwritten(key)
+	}
+	if err := sp.bc.storage.Del(key); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// mark marks the nodes of the states kept: the genesis, the checkpoints up
+// to the target, the canonical chain above it and the forks above it.
+func (sp *StatePruner) mark(live *pruneMarks, tail *Block, target uint64) error {
+	visit := func(hash []byte, value []byte) (bool, error) {
+		marked, err := live.has(hash)
+		if err != nil || marked {
+			return false, err
+		}
+		return true, live.add(hash)
+	}
+
+	if err := walkBlockState(sp.bc.genesisBlock, sp.bc.storage, visit); err != nil {
+		return err
+	}
+	if sp.interval > 0 {
+		for h := sp.interval; h <= target; h += sp.interval {
+			block, err := sp.bc.GetBlockByHeight(h)
+			if err != nil {
+				return err
+			}
+			if err := walkBlockState(block, sp.bc.storage, visit); err != nil {
+				return err
+			}
+		}
+	}
+
+	tips := append(sp.bc.DetachedTailBlocks(), tail)
+	for _, tip := range tips {
+		for block := tip; block != nil && block.height > target; block = sp.bc.GetBlock(block.ParentHash()) {
+			if err := walkBlockState(block, sp.bc.storage, visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pruneMarks is the set of the nodes marked in a prune round, in a scratch
+// LevelDB removed once closed.
+type pruneMarks struct {
+	dir string
+	db  *storage.DiskStorage
+}
+
+func newPruneMarks() (*pruneMarks, error) {
+	dir, err := ioutil.TempDir("", "neb-prune-marks")
+	if err != nil {
+		return nil, err
+	}
+	db, err := storage.NewDiskStorage(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return &pruneMarks{dir: dir, db: db}, nil
+}
+
+func (m *pruneMarks) has(hash []byte) (bool, error) {
+	_, err := m.db.Get(hash)
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (m *pruneMarks) add(hash []byte) error {
+	return m.db.Put(hash, []byte{1})
+}
+
+func (m *pruneMarks) close() {
+	if err := m.db.Close(); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"dir": m.dir,
+			"err": err,
+		}).Error("Failed to close prune marks.")
+	}
+	os.RemoveAll(m.dir)
+}
+
+// sweep returns the nodes of the states of the blocks neither live nor
+// roots.
+func (sp *StatePruner) sweep(blocks []*Block, live *pruneMarks, roots map[string]bool) ([][]byte, error) {
+	seen := make(map[string]bool)
+	var garbage [][]byte
+	visit := func(hash []byte, value []byte) (bool, error) {
+		key := byteutils.Hex(hash)
+		if seen[key] {
+			return false, nil
+		}
+		seen[key] = true
+		marked, err := live.has(hash)
+		if err != nil || marked {
+			return false, err
+		}
+		if !roots[key] {
+			garbage = append(garbage, hash)
+		}
+		return true, nil
+	}
+
+	for _, block := range blocks {
+		err := walkBlockState(block, sp.bc.storage, visit)
+		if err == storage.ErrKeyNotFound {
+			// left by a round interrupted while deleting.
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+			}).Debug("Skip a state already pruned.")
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return garbage, nil
+}
+
+// blockRoots returns the roots of the tries of the block.
+func blockRoots(block *Block) []byteutils.Hash {
+	dpos := block.DposContext()
+	roots := []byteutils.Hash{
+		block.StateRoot(), block.TxsRoot(), block.EventsRoot(),
+		dpos.DynastyRoot, dpos.NextDynastyRoot, dpos.DelegateRoot,
+		dpos.CandidateRoot, dpos.VoteRoot, dpos.MintCntRoot,
+	}
+	var kept []byteutils.Hash
+	for _, root := range roots {
+		if len(root) > 0 {
+			kept = append(kept, root)
+		}
+	}
+	return kept
+}
+
+// walkBlockState walks the nodes of the tries of the block, and of the
+// storages of the accounts.
+func walkBlockState(block *Block, stor storage.Storage, visit trie.NodeVisitor) error {
+	for _, root := range blockRoots(block) {
+		t, err := trie.NewTrie(root, stor)
+		if err != nil {
+			return err
+		}
+		isState := root.Equals(block.StateRoot())
+		err = t.WalkNodes(func(hash []byte, value []byte) (bool, error) {
+			down, err := visit(hash, value)
+			if err != nil || !down || !isState || value == nil {
+				return down, err
+			}
+			acc := new(corepb.Account)
+			if err := proto.Unmarshal(value, acc); err != nil {
+				return false, err
+			}
+			if len(acc.VarsHash) == 0 {
+				return true, nil
+			}
+			vars, err := trie.NewTrie(acc.VarsHash, stor)
+			if err != nil {
+				return false, err
+			}
+			return true, vars.WalkNodes(visit)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadStatePruned(stor storage.Storage) (uint64, uint64, error) {
+	value, err := stor.Get([]byte(StatePrunedKey))
+	if err == storage.ErrKeyNotFound {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(value) != 16 {
+		return 0, 0, ErrInvalidPrunedIndex
+	}
+	return byteutils.Uint64(value[:8]), byteutils.Uint64(value[8:]), nil
+}
+
+func storeStatePruned(stor storage.Storage, height, interval uint64) error {
+	value := append(byteutils.FromUint64(height), byteutils.FromUint64(interval)...)
+	return stor.Put([]byte(StatePrunedKey), value)
+}
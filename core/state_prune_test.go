@@ -0,0 +1,96 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatePruner(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	for i := 0; i < 20; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+	tail := bc.TailBlock()
+	assert.Equal(t, uint64(21), tail.Height())
+
+	pruner, err := bc.EnableStatePruning(&PruneConfig{KeepRecent: 4, CheckpointInterval: 5}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, pruner, bc.StatePruner())
+
+	deleted, err := pruner.Prune()
+	assert.Nil(t, err)
+	assert.True(t, deleted > 0)
+	assert.Equal(t, uint64(17), pruner.PrunedHeight())
+
+	exists := func(hash []byte, value []byte) (bool, error) { return true, nil }
+	for h := uint64(1); h <= tail.Height(); h++ {
+		block, err := bc.GetBlockByHeight(h)
+		assert.Nil(t, err)
+		if h > 1 && h <= 17 && h%5 != 0 {
+			assert.Equal(t, ErrStatePruned, bc.CheckStateAvailable(block))
+			assert.Equal(t, storage.ErrKeyNotFound, walkBlockState(block, bc.storage, exists), "height %d", h)
+			continue
+		}
+		assert.Nil(t, bc.CheckStateAvailable(block))
+		assert.Nil(t, walkBlockState(block, bc.storage, exists), "height %d", h)
+	}
+
+	// nothing left to prune until the tail moves on.
+	deleted, err = pruner.Prune()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, deleted)
+
+	_, err = bc.EnableStatePruning(&PruneConfig{KeepRecent: 4, CheckpointInterval: 10}, nil)
+	assert.Equal(t, ErrPruneIntervalChanged, err)
+	pruner, err = bc.EnableStatePruning(&PruneConfig{KeepRecent: 8, CheckpointInterval: 5}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(17), pruner.PrunedHeight())
+}
+
+func TestPruneMarks(t *testing.T) {
+	marks, err := newPruneMarks()
+	assert.Nil(t, err)
+	marked, err := marks.has([]byte("node"))
+	assert.Nil(t, err)
+	assert.False(t, marked)
+	assert.Nil(t, marks.add([]byte("node")))
+	marked, err = marks.has([]byte("node"))
+	assert.Nil(t, err)
+	assert.True(t, marked)
+
+	marks.close()
+	_, err = os.Stat(marks.dir)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// SupplyContract is the system contract recording the rewards issued and the
+// fees burned since genesis, from the supply fork on.
+var SupplyContract, _ = NewContractAddressFromHash(hash.Sha3256([]byte("nebulas.supply")))
+
+// Keys in the storage of the supply contract.
+var (
+	supplyIssuedKey = hash.Sha3256([]byte("issued"))
+	supplyBurnedKey = hash.Sha3256([]byte("burned"))
+)
+
+// SupplyInfo is the supply of the chain after a block.
+type SupplyInfo struct {
+	Height uint64
+	// Initial is the balance distributed in the genesis.
+	Initial *util.Uint128
+	// Issued is the sum of the block rewards.
+	Issued *util.Uint128
+	// Burned is the sum of the burned fees.
+	Burned *util.Uint128
+	// Total is Initial + Issued - Burned.
+	Total *util.Uint128
+	// Tracked is true if the supply is recorded in the state, before the supply
+	// fork it's derived from the constant block reward.
+	Tracked bool
+}
+
+// untrackedIssued returns the rewards issued up to the height before the
+// supply fork, every block but the genesis got the constant reward.
+func untrackedIssued(height uint64) *util.Uint128 {
+	if height <= 1 {
+		return util.NewUint128()
+	}
+	return util.NewUint128FromBigInt(new(big.Int).Mul(BlockReward.Int, new(big.Int).SetUint64(height-1)))
+}
+
+func supplyCounter(acc state.Account, key []byte) (*util.Uint128, error) {
+	value, err := acc.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return util.NewUint128(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return util.NewUint128FromFixedSizeByteSlice(value)
+}
+
+// addSupply adds the value to the supply counter of the key in the block
+// state, the rewards issued before the fork are counted at its first block.
+func (block *Block) addSupply(key []byte, value *util.Uint128) error {
+	acc, err := block.accState.GetContractAccount(SupplyContract.Bytes())
+	if err != nil {
+		acc = block.accState.GetOrCreateUserAccount(SupplyContract.Bytes())
+		if err := putSupplyCounter(acc, supplyIssuedKey, untrackedIssued(block.height-1)); err != nil {
+			return err
+		}
+	}
+	counter, err := supplyCounter(acc, key)
+	if err != nil {
+		return err
+	}
+	return putSupplyCounter(acc, key, util.NewUint128FromBigInt(new(big.Int).Add(counter.Int, value.Int)))
+}
+
+func putSupplyCounter(acc state.Account, key []byte, value *util.Uint128) error {
+	bytes, err := value.ToFixedSizeByteSlice()
+	if err != nil {
+		return err
+	}
+	return acc.Put(key, bytes)
+}
+
+// recordIssued records the reward issued in the block.
+func (block *Block) recordIssued(reward *util.Uint128) error {
+	if !ForksOf(block.ChainID()).IsSupplyActive(block.height) {
+		return nil
+	}
+	return block.addSupply(supplyIssuedKey, reward)
+}
+
+// recordBurned records the fee burned in the block.
+func (block *Block) recordBurned(fee *util.Uint128) error {
+	if !ForksOf(block.ChainID()).IsSupplyActive(block.height) {
+		return nil
+	}
+	return block.addSupply(supplyBurnedKey, fee)
+}
+
+// InitialSupply returns the balance distributed in the genesis of the chain.
+func (bc *BlockChain) InitialSupply() (*util.Uint128, error) {
+	bc.initialSupplyOnce.Do(func() {
+		accounts, err := bc.genesisBlock.accState.Accounts()
+		if err != nil {
+			bc.initialSupplyErr = err
+			return
+		}
+		total := new(big.Int)
+		for _, acc := range accounts {
+			total.Add(total, acc.Balance().Int)
+		}
+		bc.initialSupply = util.NewUint128FromBigInt(total)
+	})
+	return bc.initialSupply, bc.initialSupplyErr
+}
+
+// SupplyInfo returns the supply of the chain after the block.
+func (bc *BlockChain) SupplyInfo(block *Block) (*SupplyInfo, error) {
+	initial, err := bc.InitialSupply()
+	if err != nil {
+		return nil, err
+	}
+	info := &SupplyInfo{
+		Height:  block.height,
+		Initial: initial,
+		Issued:  untrackedIssued(block.height),
+		Burned:  util.NewUint128(),
+	}
+	if acc, err := block.accState.GetContractAccount(SupplyContract.Bytes()); err == nil {
+		if info.Issued, err = supplyCounter(acc, supplyIssuedKey); err != nil {
+			return nil, err
+		}
+		if info.Burned, err = supplyCounter(acc, supplyBurnedKey); err != nil {
+			return nil, err
+		}
+		info.Tracked = true
+	}
+	total := new(big.Int).Add(info.Initial.Int, info.Issued.Int)
+	info.Total = util.NewUint128FromBigInt(total.Sub(total, info.Burned.Int))
+	return info, nil
+}
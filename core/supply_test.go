@@ -0,0 +1,104 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupplyInfo(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	initial := new(big.Int)
+	for _, v := range bc.genesis.TokenDistribution {
+		initial.Add(initial, util.NewUint128FromString(v.Value).Int)
+	}
+	rewards := func(n int64) *util.Uint128 {
+		return util.NewUint128FromBigInt(new(big.Int).Mul(BlockReward.Int, big.NewInt(n)))
+	}
+
+	info, err := bc.SupplyInfo(bc.GenesisBlock())
+	assert.Nil(t, err)
+	assert.Equal(t, initial, info.Initial.Int)
+	assert.Equal(t, initial, info.Total.Int)
+	assert.Equal(t, util.NewUint128(), info.Issued)
+
+	coinbase := &Address{[]byte("012345678901234567890011")}
+	block2, _ := bc.NewBlock(coinbase)
+	block2.header.timestamp = BlockInterval
+	block2.SetMiner(coinbase)
+	block2.Seal()
+
+	info, err = bc.SupplyInfo(block2)
+	assert.Nil(t, err)
+	assert.False(t, info.Tracked)
+	assert.Equal(t, rewards(1), info.Issued)
+
+	// the rewards issued before the fork are counted at its first block.
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{SupplyHeight: 3}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	block3, _ := bc.NewBlockFromParent(coinbase, block2)
+	block3.header.timestamp = BlockInterval * 2
+	block3.begin()
+	assert.Nil(t, block3.recordBurned(util.NewUint128FromInt(100)))
+	block3.commit()
+	block3.SetMiner(coinbase)
+	block3.Seal()
+
+	info, err = bc.SupplyInfo(block3)
+	assert.Nil(t, err)
+	assert.True(t, info.Tracked)
+	assert.Equal(t, rewards(2), info.Issued)
+	assert.Equal(t, util.NewUint128FromInt(100), info.Burned)
+	total := new(big.Int).Add(initial, rewards(2).Int)
+	assert.Equal(t, total.Sub(total, big.NewInt(100)), info.Total.Int)
+}
+
+func TestSupplyFeeBurned(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{SupplyHeight: 2, FeeBurnHeight: 2, FeeBurnPercent: 40}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	coinbase := &Address{[]byte("012345678901234567890011")}
+	block, _ := bc.NewBlock(coinbase)
+	block.begin()
+	acc := block.accState.GetOrCreateUserAccount([]byte("012345678901234567890022"))
+	assert.Nil(t, block.payFee(acc, util.NewUint128FromInt(1000)))
+	block.commit()
+
+	assert.Equal(t, util.NewUint128FromInt(600), acc.Balance())
+	info, err := bc.SupplyInfo(block)
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128FromInt(400), info.Burned)
+}
@@ -0,0 +1,413 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// TestVectorsVersion is the version of the test vectors format.
+const TestVectorsVersion = 1
+
+const (
+	testVectorsChainID  = uint32(100)
+	testVectorsAccounts = DynastySize + 2
+	testVectorsBalance  = "1000000000000000000000"
+	// testVectorsTimestamp is the timestamp of the first reference block.
+	testVectorsTimestamp = int64(1510000000)
+
+	testVectorsContract = `"use strict";
+var Counter = function() {
+	LocalContractStorage.defineProperty(this, "count");
+};
+Counter.prototype = {
+	init: function(count) {
+		this.count = count;
+	},
+	inc: function(n) {
+		this.count += n;
+		return this.count;
+	}
+};
+module.exports = Counter;`
+)
+
+// TestVectors are the reference data of the canonical encodings, hashes and
+// state transitions, so other client implementations can check compatibility.
+// All keys are derived from fixed seeds, the output is the same on every run.
+type TestVectors struct {
+	Version      int                  `json:"version"`
+	ChainID      uint32               `json:"chain_id"`
+	Accounts     []*AccountVector     `json:"accounts"`
+	Genesis      *BlockVector         `json:"genesis"`
+	Transactions []*TransactionVector `json:"transactions"`
+	Blocks       []*BlockVector       `json:"blocks"`
+}
+
+// AccountVector is a reference key pair and its address.
+type AccountVector struct {
+	PrivateKey string `json:"private_key"`
+	PublicKey  string `json:"public_key"`
+	Address    string `json:"address"`
+}
+
+// TransactionVector is a reference transaction with its canonical encoding, hash and signature.
+type TransactionVector struct {
+	Name        string `json:"name"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Value       string `json:"value"`
+	Nonce       uint64 `json:"nonce"`
+	Timestamp   int64  `json:"timestamp"`
+	ChainID     uint32 `json:"chain_id"`
+	GasPrice    string `json:"gas_price"`
+	GasLimit    string `json:"gas_limit"`
+	PayloadType string `json:"payload_type"`
+	Payload     string `json:"payload"`
+	Hash        string `json:"hash"`
+	Alg         uint8  `json:"alg"`
+	Signature   string `json:"signature"`
+	// Encoded is the protobuf encoding of the signed transaction.
+	Encoded string `json:"encoded"`
+}
+
+// AccountStateVector is the state of an account.
+type AccountStateVector struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+	Nonce   uint64 `json:"nonce"`
+}
+
+// BlockVector is a reference block with its roots, hash and the state
+// transition of the touched accounts.
+type BlockVector struct {
+	Height          uint64                `json:"height"`
+	ParentHash      string                `json:"parent_hash"`
+	Coinbase        string                `json:"coinbase"`
+	Miner           string                `json:"miner"`
+	Timestamp       int64                 `json:"timestamp"`
+	Nonce           uint64                `json:"nonce"`
+	StateRoot       string                `json:"state_root"`
+	TxsRoot         string                `json:"txs_root"`
+	EventsRoot      string                `json:"events_root"`
+	DposContextHash string                `json:"dpos_context_hash"`
+	Transactions    []string              `json:"transactions"`
+	Hash            string                `json:"hash"`
+	Alg             uint8                 `json:"alg,omitempty"`
+	Signature       string                `json:"signature,omitempty"`
+	PreState        []*AccountStateVector `json:"pre_state,omitempty"`
+	PostState       []*AccountStateVector `json:"post_state"`
+	// Encoded is the protobuf encoding of the signed block.
+	Encoded string `json:"encoded"`
+}
+
+type testVectorsNeb struct {
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *EventEmitter
+}
+
+func (n *testVectorsNeb) Genesis() *corepb.Genesis    { return n.genesis }
+func (n *testVectorsNeb) Storage() storage.Storage    { return n.storage }
+func (n *testVectorsNeb) EventEmitter() *EventEmitter { return n.emitter }
+func (n *testVectorsNeb) StartSync()                  {}
+
+type testVectorsKey struct {
+	priv keystore.PrivateKey
+	addr *Address
+}
+
+func testVectorsKeys() ([]*testVectorsKey, error) {
+	keys := make([]*testVectorsKey, testVectorsAccounts)
+	for i := range keys {
+		priv := new(secp256k1.PrivateKey)
+		if err := priv.Decode(hash.Sha3256([]byte(fmt.Sprintf("nebulas test vectors account %d", i)))); err != nil {
+			return nil, err
+		}
+		pub, err := priv.PublicKey().Encoded()
+		if err != nil {
+			return nil, err
+		}
+		addr, err := NewAddressFromPublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = &testVectorsKey{priv: priv, addr: addr}
+	}
+	return keys, nil
+}
+
+func (k *testVectorsKey) sign(tx *Transaction) error {
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		return err
+	}
+	if err := signature.InitSign(k.priv); err != nil {
+		return err
+	}
+	return tx.Sign(signature)
+}
+
+func (k *testVectorsKey) signBlock(block *Block) error {
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		return err
+	}
+	if err := signature.InitSign(k.priv); err != nil {
+		return err
+	}
+	return block.Sign(signature)
+}
+
+// GenerateTestVectors builds the reference chain in memory and exports its vectors.
+func GenerateTestVectors() (*TestVectors, error) {
+	keys, err := testVectorsKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := &TestVectors{Version: TestVectorsVersion, ChainID: testVectorsChainID}
+	genesis := &corepb.Genesis{
+		Meta:      &corepb.GenesisMeta{ChainId: testVectorsChainID},
+		Consensus: &corepb.GenesisConsensus{Dpos: &corepb.GenesisConsensusDpos{}},
+	}
+	for i, key := range keys {
+		pub, err := key.priv.PublicKey().Encoded()
+		if err != nil {
+			return nil, err
+		}
+		priv, err := key.priv.Encoded()
+		if err != nil {
+			return nil, err
+		}
+		vectors.Accounts = append(vectors.Accounts, &AccountVector{
+			PrivateKey: byteutils.Hex(priv),
+			PublicKey:  byteutils.Hex(pub),
+			Address:    key.addr.String(),
+		})
+		if i < DynastySize {
+			genesis.Consensus.Dpos.Dynasty = append(genesis.Consensus.Dpos.Dynasty, key.addr.String())
+		}
+		genesis.TokenDistribution = append(genesis.TokenDistribution, &corepb.GenesisTokenDistribution{
+			Address: key.addr.String(),
+			Value:   testVectorsBalance,
+		})
+	}
+
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	chain, err := NewBlockChain(&testVectorsNeb{genesis: genesis, storage: stor, emitter: NewEventEmitter(1024)})
+	if err != nil {
+		return nil, err
+	}
+	if vectors.Genesis, err = blockVector(chain.genesisBlock, nil, addressesOf(keys)); err != nil {
+		return nil, err
+	}
+
+	alice, bob := keys[DynastySize], keys[DynastySize+1]
+	contract, err := NewContractAddressFromHash(hash.Sha3256(alice.addr.Bytes(), byteutils.FromUint64(3)))
+	if err != nil {
+		return nil, err
+	}
+	deploy, err := NewDeployPayload(testVectorsContract, "js", "[1]").ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	call, err := NewCallPayload("inc", "[2]").ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := util.NewUint128FromInt(200000)
+
+	// the reference transactions, each block packs the transactions of its batch.
+	batches := [][]*Transaction{
+		{
+			testVectorsTransaction(alice, bob, 1000, 1, TxPayloadBinaryType, nil, gasLimit, 0),
+			testVectorsTransaction(alice, bob, 0, 2, TxPayloadBinaryType, []byte("nebulas"), gasLimit, 1),
+			testVectorsTransaction(bob, alice, 1, 1, TxPayloadBinaryType, nil, gasLimit, 2),
+		},
+		{
+			testVectorsTransaction(alice, alice, 0, 3, TxPayloadDeployType, deploy, gasLimit, 0),
+			testVectorsTransaction(bob, &testVectorsKey{addr: contract}, 0, 2, TxPayloadCallType, call, gasLimit, 1),
+		},
+	}
+	names := [][]string{
+		{"transfer", "binary payload", "transfer back"},
+		{"deploy contract", "call contract"},
+	}
+
+	parent := chain.genesisBlock
+	for i, batch := range batches {
+		from := keys[i%DynastySize]
+		block, err := NewBlock(testVectorsChainID, from.addr, parent)
+		if err != nil {
+			return nil, err
+		}
+		block.header.timestamp = testVectorsTimestamp + int64(i)*BlockInterval
+		block.SetMiner(from.addr)
+
+		touched := []*Address{from.addr}
+		for j, tx := range batch {
+			signer := alice
+			if tx.from.Equals(bob.addr) {
+				signer = bob
+			}
+			if err := signer.sign(tx); err != nil {
+				return nil, err
+			}
+			block.begin()
+			if _, err := block.executeTransaction(context.Background(), tx); err != nil {
+				block.rollback()
+				return nil, fmt.Errorf("transaction %q: %v", names[i][j], err)
+			}
+			block.commit()
+			block.transactions = append(block.transactions, tx)
+			touched = append(touched, tx.from, tx.to)
+
+			v, err := transactionVector(names[i][j], tx)
+			if err != nil {
+				return nil, err
+			}
+			vectors.Transactions = append(vectors.Transactions, v)
+		}
+		if err := block.Seal(); err != nil {
+			return nil, err
+		}
+		if err := from.signBlock(block); err != nil {
+			return nil, err
+		}
+
+		v, err := blockVector(block, parent, touched)
+		if err != nil {
+			return nil, err
+		}
+		vectors.Blocks = append(vectors.Blocks, v)
+		parent = block
+	}
+	return vectors, nil
+}
+
+// testVectorsTransaction returns an unsigned transaction timestamped at the offset of the first block.
+func testVectorsTransaction(from, to *testVectorsKey, value int64, nonce uint64, payloadType string, payload []byte, gasLimit *util.Uint128, offset int64) *Transaction {
+	tx := NewTransaction(testVectorsChainID, from.addr, to.addr, util.NewUint128FromInt(value), nonce, payloadType, payload, TransactionGasPrice, gasLimit)
+	tx.timestamp = testVectorsTimestamp + offset
+	return tx
+}
+
+func addressesOf(keys []*testVectorsKey) []*Address {
+	addrs := make([]*Address, len(keys))
+	for i, key := range keys {
+		addrs[i] = key.addr
+	}
+	return addrs
+}
+
+func transactionVector(name string, tx *Transaction) (*TransactionVector, error) {
+	pbTx, err := tx.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := proto.Marshal(pbTx)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionVector{
+		Name:        name,
+		From:        tx.from.String(),
+		To:          tx.to.String(),
+		Value:       tx.value.String(),
+		Nonce:       tx.nonce,
+		Timestamp:   tx.timestamp,
+		ChainID:     tx.chainID,
+		GasPrice:    tx.gasPrice.String(),
+		GasLimit:    tx.gasLimit.String(),
+		PayloadType: tx.data.Type,
+		Payload:     byteutils.Hex(tx.data.Payload),
+		Hash:        tx.hash.String(),
+		Alg:         tx.alg,
+		Signature:   byteutils.Hex(tx.sign),
+		Encoded:     byteutils.Hex(encoded),
+	}, nil
+}
+
+// blockVector exports the block, with the states of the touched accounts before and after it.
+func blockVector(block, parent *Block, touched []*Address) (*BlockVector, error) {
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := proto.Marshal(pbBlock)
+	if err != nil {
+		return nil, err
+	}
+	v := &BlockVector{
+		Height:          block.height,
+		ParentHash:      block.ParentHash().String(),
+		Coinbase:        block.Coinbase().String(),
+		Miner:           block.Miner().String(),
+		Timestamp:       block.Timestamp(),
+		Nonce:           block.Nonce(),
+		StateRoot:       block.StateRoot().String(),
+		TxsRoot:         block.TxsRoot().String(),
+		EventsRoot:      block.EventsRoot().String(),
+		DposContextHash: block.DposContextHash().String(),
+		Transactions:    []string{},
+		Hash:            block.Hash().String(),
+		Alg:             block.Alg(),
+		Signature:       byteutils.Hex(block.Signature()),
+		Encoded:         byteutils.Hex(encoded),
+	}
+	for _, tx := range block.transactions {
+		v.Transactions = append(v.Transactions, tx.hash.String())
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range touched {
+		if seen[addr.String()] {
+			continue
+		}
+		seen[addr.String()] = true
+		if parent != nil {
+			v.PreState = append(v.PreState, accountStateVector(parent, addr))
+		}
+		v.PostState = append(v.PostState, accountStateVector(block, addr))
+	}
+	return v, nil
+}
+
+func accountStateVector(block *Block, addr *Address) *AccountStateVector {
+	return &AccountStateVector{
+		Address: addr.String(),
+		Balance: block.GetBalance(addr.Bytes()).String(),
+		Nonce:   block.GetNonce(addr.Bytes()),
+	}
+}
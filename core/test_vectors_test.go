@@ -0,0 +1,66 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTestVectors(t *testing.T) {
+	vectors, err := GenerateTestVectors()
+	assert.Nil(t, err)
+	assert.Equal(t, testVectorsAccounts, len(vectors.Accounts))
+	assert.Equal(t, 5, len(vectors.Transactions))
+	assert.Equal(t, 2, len(vectors.Blocks))
+
+	again, err := GenerateTestVectors()
+	assert.Nil(t, err)
+	assert.Equal(t, vectors, again)
+
+	for _, v := range vectors.Transactions {
+		data, err := byteutils.FromHex(v.Encoded)
+		assert.Nil(t, err)
+		pbTx := new(corepb.Transaction)
+		assert.Nil(t, proto.Unmarshal(data, pbTx))
+		tx := new(Transaction)
+		assert.Nil(t, tx.FromProto(pbTx))
+		assert.Nil(t, tx.VerifyIntegrity(vectors.ChainID), v.Name)
+		assert.Equal(t, v.Hash, tx.Hash().String())
+	}
+
+	parent := vectors.Genesis
+	for _, v := range vectors.Blocks {
+		data, err := byteutils.FromHex(v.Encoded)
+		assert.Nil(t, err)
+		pbBlock := new(corepb.Block)
+		assert.Nil(t, proto.Unmarshal(data, pbBlock))
+		block := new(Block)
+		assert.Nil(t, block.FromProto(pbBlock))
+		assert.Nil(t, block.VerifyIntegrity(vectors.ChainID, MockConsensus{}))
+		assert.Equal(t, parent.Hash, v.ParentHash)
+		assert.Equal(t, len(v.Transactions), len(block.transactions))
+		assert.Equal(t, len(v.PreState), len(v.PostState))
+		parent = v
+	}
+}
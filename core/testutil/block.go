@@ -0,0 +1,176 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package testutil
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// BlockBuilder builds a signed block out of a chain, its roots are random,
+// so it's only valid for encoding, hashing and signature checks.
+type BlockBuilder struct {
+	f           *Fixture
+	miner       *Account
+	parentHash  []byte
+	height      uint64
+	timestamp   int64
+	txs         []*corepb.Transaction
+	roots       [9][]byte
+	corruptions []Corruption
+}
+
+// Block returns a builder of an empty block at height 2 mined by a new account.
+func (f *Fixture) Block() *BlockBuilder {
+	b := &BlockBuilder{
+		f:          f,
+		miner:      f.Account(),
+		parentHash: f.Hash(),
+		height:     2,
+		timestamp:  f.tick(core.BlockInterval),
+	}
+	for i := range b.roots {
+		b.roots[i] = f.Hash()
+	}
+	return b
+}
+
+// Miner sets the miner, which is the coinbase and signer.
+func (b *BlockBuilder) Miner(miner *Account) *BlockBuilder {
+	b.miner = miner
+	return b
+}
+
+// Parent sets the parent hash and the height following the parent.
+func (b *BlockBuilder) Parent(parent *core.Block) *BlockBuilder {
+	b.parentHash = parent.Hash()
+	b.height = parent.Height() + 1
+	return b
+}
+
+// Timestamp sets the timestamp.
+func (b *BlockBuilder) Timestamp(timestamp int64) *BlockBuilder {
+	b.timestamp = timestamp
+	return b
+}
+
+// Transactions adds the transactions.
+func (b *BlockBuilder) Transactions(txs ...*core.Transaction) *BlockBuilder {
+	for _, tx := range txs {
+		msg, err := tx.ToProto()
+		if err != nil {
+			panic(err)
+		}
+		b.txs = append(b.txs, msg.(*corepb.Transaction))
+	}
+	return b
+}
+
+// Corrupt makes the block invalid.
+func (b *BlockBuilder) Corrupt(corruptions ...Corruption) *BlockBuilder {
+	for _, c := range corruptions {
+		if c == CorruptTransaction {
+			b.txs = append(b.txs, b.f.Tx().Corrupt(CorruptSignature).Proto())
+			continue
+		}
+		b.corruptions = append(b.corruptions, c)
+	}
+	return b
+}
+
+// Proto returns the signed block in proto.
+func (b *BlockBuilder) Proto() *corepb.Block {
+	chainID := b.f.chainID
+	for _, c := range b.corruptions {
+		if c == CorruptChainID {
+			chainID++
+		}
+	}
+
+	pbBlock := &corepb.Block{
+		Header: &corepb.BlockHeader{
+			ParentHash: b.parentHash,
+			Coinbase:   b.miner.Address.Bytes(),
+			Timestamp:  b.timestamp,
+			ChainId:    chainID,
+			StateRoot:  b.roots[0],
+			TxsRoot:    b.roots[1],
+			EventsRoot: b.roots[2],
+			DposContext: &corepb.DposContext{
+				DynastyRoot:     b.roots[3],
+				NextDynastyRoot: b.roots[4],
+				DelegateRoot:    b.roots[5],
+				CandidateRoot:   b.roots[6],
+				VoteRoot:        b.roots[7],
+				MintCntRoot:     b.roots[8],
+			},
+		},
+		Transactions: b.txs,
+		Height:       b.height,
+	}
+
+	block := new(core.Block)
+	if err := block.FromProto(pbBlock); err != nil {
+		panic(err)
+	}
+	pbBlock.Header.Hash = core.HashBlock(block)
+	block = new(core.Block)
+	if err := block.FromProto(pbBlock); err != nil {
+		panic(err)
+	}
+	if err := block.Sign(b.miner.Signature()); err != nil {
+		panic(err)
+	}
+	msg, err := block.ToProto()
+	if err != nil {
+		panic(err)
+	}
+	pbBlock = msg.(*corepb.Block)
+
+	for _, c := range b.corruptions {
+		switch c {
+		case CorruptHash:
+			pbBlock.Header.Hash = flipped(pbBlock.Header.Hash)
+		case CorruptSignature:
+			pbBlock.Header.Sign = flipped(pbBlock.Header.Sign)
+		case CorruptAlg:
+			pbBlock.Header.Alg = 0xff
+		}
+	}
+	return pbBlock
+}
+
+// Build returns the signed block.
+func (b *BlockBuilder) Build() *core.Block {
+	block := new(core.Block)
+	if err := block.FromProto(b.Proto()); err != nil {
+		panic(err)
+	}
+	return block
+}
+
+// Bytes returns the encoded signed block.
+func (b *BlockBuilder) Bytes() []byte {
+	data, err := proto.Marshal(b.Proto())
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
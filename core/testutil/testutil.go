@@ -0,0 +1,141 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package testutil builds deterministic accounts, transactions and blocks from
+// a seed, including invalid and edge-case variants, so tests and fuzzers of
+// different packages share the same fixtures.
+package testutil
+
+import (
+	"math/rand"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+)
+
+const (
+	// DefaultChainID is the chain id of the fixtures.
+	DefaultChainID = uint32(100)
+	// BaseTimestamp is the timestamp of the first fixture.
+	BaseTimestamp = int64(1510000000)
+)
+
+// Corruption is a way to make a fixture invalid.
+type Corruption int
+
+// Corruptions of fixtures.
+const (
+	// CorruptHash changes the hash, so it doesn't match the content.
+	CorruptHash Corruption = iota
+	// CorruptSignature changes the signature, so it doesn't recover the signer.
+	CorruptSignature
+	// CorruptChainID signs the fixture for another chain.
+	CorruptChainID
+	// CorruptAlg sets an unsupported signature algorithm.
+	CorruptAlg
+	// CorruptTransaction includes a transaction with invalid signature into a block.
+	CorruptTransaction
+)
+
+// Fixture generates the fixtures of a seed, the same seed gives the same
+// fixtures in the same order.
+type Fixture struct {
+	rand    *rand.Rand
+	chainID uint32
+	now     int64
+}
+
+// New returns the fixture generator of the seed.
+func New(seed int64) *Fixture {
+	return &Fixture{
+		rand:    rand.New(rand.NewSource(seed)),
+		chainID: DefaultChainID,
+		now:     BaseTimestamp,
+	}
+}
+
+// ChainID returns the chain id of the fixtures.
+func (f *Fixture) ChainID() uint32 {
+	return f.chainID
+}
+
+// Bytes returns n pseudo random bytes.
+func (f *Fixture) Bytes(n int) []byte {
+	data := make([]byte, n)
+	f.rand.Read(data)
+	return data
+}
+
+// Hash returns a pseudo random 32 bytes hash.
+func (f *Fixture) Hash() []byte {
+	return f.Bytes(32)
+}
+
+// tick returns the next timestamp.
+func (f *Fixture) tick(seconds int64) int64 {
+	f.now += seconds
+	return f.now
+}
+
+// Account is a key pair with its address.
+type Account struct {
+	PrivateKey keystore.PrivateKey
+	Address    *core.Address
+}
+
+// Account returns a new account.
+func (f *Fixture) Account() *Account {
+	for {
+		priv := new(secp256k1.PrivateKey)
+		if err := priv.Decode(f.Bytes(32)); err != nil {
+			continue
+		}
+		pub, err := priv.PublicKey().Encoded()
+		if err != nil {
+			continue
+		}
+		addr, err := core.NewAddressFromPublicKey(pub)
+		if err != nil {
+			continue
+		}
+		return &Account{PrivateKey: priv, Address: addr}
+	}
+}
+
+// Accounts returns n new accounts.
+func (f *Fixture) Accounts(n int) []*Account {
+	accounts := make([]*Account, n)
+	for i := range accounts {
+		accounts[i] = f.Account()
+	}
+	return accounts
+}
+
+// Signature returns the signature initialized with the account key.
+func (a *Account) Signature() keystore.Signature {
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		panic(err)
+	}
+	if err := signature.InitSign(a.PrivateKey); err != nil {
+		panic(err)
+	}
+	return signature
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package testutil
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConsensus struct{}
+
+func (c mockConsensus) VerifyBlock(block *core.Block, parent *core.Block) error { return nil }
+func (c mockConsensus) FastVerifyBlock(block *core.Block) error                 { return nil }
+
+func TestFixture_Deterministic(t *testing.T) {
+	a, b := New(42), New(42)
+	assert.Equal(t, a.Account().Address, b.Account().Address)
+	assert.Equal(t, a.Tx().Bytes(), b.Tx().Bytes())
+	assert.Equal(t, a.Block().Bytes(), b.Block().Bytes())
+	assert.NotEqual(t, New(1).Account().Address, New(2).Account().Address)
+
+	builder := New(7).Block()
+	assert.Equal(t, builder.Bytes(), builder.Bytes())
+}
+
+func TestFixture_Transactions(t *testing.T) {
+	f := New(1)
+	assert.Nil(t, f.Tx().Build().VerifyIntegrity(f.ChainID()))
+
+	assert.Equal(t, core.ErrInvalidTransactionHash, f.Tx().Corrupt(CorruptHash).Build().VerifyIntegrity(f.ChainID()))
+	assert.NotNil(t, f.Tx().Corrupt(CorruptSignature).Build().VerifyIntegrity(f.ChainID()))
+	assert.Equal(t, core.ErrInvalidChainID, f.Tx().Corrupt(CorruptChainID).Build().VerifyIntegrity(f.ChainID()))
+	assert.NotNil(t, f.Tx().Corrupt(CorruptAlg).Build().VerifyIntegrity(f.ChainID()))
+
+	from := f.Account()
+	for _, v := range f.EdgeTransactions(from) {
+		assert.Nil(t, v.Tx.VerifyIntegrity(f.ChainID()), v.Name)
+		assert.Equal(t, from.Address, v.Tx.From(), v.Name)
+	}
+}
+
+func TestFixture_Blocks(t *testing.T) {
+	f := New(1)
+	parent := f.Block().Build()
+	block := f.Block().Parent(parent).Transactions(f.Tx().Build(), f.Tx().Build()).Build()
+	assert.Nil(t, block.VerifyIntegrity(f.ChainID(), mockConsensus{}))
+	assert.Equal(t, parent.Hash(), block.ParentHash())
+	assert.Equal(t, parent.Height()+1, block.Height())
+
+	assert.Equal(t, core.ErrInvalidBlockHash, f.Block().Corrupt(CorruptHash).Build().VerifyIntegrity(f.ChainID(), mockConsensus{}))
+	assert.Equal(t, core.ErrInvalidChainID, f.Block().Corrupt(CorruptChainID).Build().VerifyIntegrity(f.ChainID(), mockConsensus{}))
+	assert.NotNil(t, f.Block().Corrupt(CorruptTransaction).Build().VerifyIntegrity(f.ChainID(), mockConsensus{}))
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package testutil
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// MaxUint128 is the max value of uint128 fields.
+var MaxUint128 = util.NewUint128FromString("340282366920938463463374607431768211455")
+
+// TxBuilder builds a signed transaction.
+type TxBuilder struct {
+	f           *Fixture
+	from        *Account
+	to          *core.Address
+	value       *util.Uint128
+	nonce       uint64
+	timestamp   int64
+	payloadType string
+	payload     []byte
+	gasPrice    *util.Uint128
+	gasLimit    *util.Uint128
+	corruptions []Corruption
+}
+
+// Tx returns a builder of a transfer from a new account to another new account,
+// with nonce 1 and the next timestamp.
+func (f *Fixture) Tx() *TxBuilder {
+	return &TxBuilder{
+		f:           f,
+		from:        f.Account(),
+		to:          f.Account().Address,
+		value:       util.NewUint128FromInt(f.rand.Int63n(1000000) + 1),
+		nonce:       1,
+		timestamp:   f.tick(1),
+		payloadType: core.TxPayloadBinaryType,
+		gasPrice:    core.TransactionGasPrice,
+		gasLimit:    util.NewUint128FromInt(200000),
+	}
+}
+
+// From sets the sender.
+func (b *TxBuilder) From(from *Account) *TxBuilder {
+	b.from = from
+	return b
+}
+
+// To sets the receiver.
+func (b *TxBuilder) To(to *core.Address) *TxBuilder {
+	b.to = to
+	return b
+}
+
+// Value sets the value.
+func (b *TxBuilder) Value(value *util.Uint128) *TxBuilder {
+	b.value = value
+	return b
+}
+
+// Nonce sets the nonce.
+func (b *TxBuilder) Nonce(nonce uint64) *TxBuilder {
+	b.nonce = nonce
+	return b
+}
+
+// Timestamp sets the timestamp.
+func (b *TxBuilder) Timestamp(timestamp int64) *TxBuilder {
+	b.timestamp = timestamp
+	return b
+}
+
+// Payload sets the payload.
+func (b *TxBuilder) Payload(payloadType string, payload []byte) *TxBuilder {
+	b.payloadType = payloadType
+	b.payload = payload
+	return b
+}
+
+// Gas sets the gas price and limit.
+func (b *TxBuilder) Gas(price, limit *util.Uint128) *TxBuilder {
+	b.gasPrice = price
+	b.gasLimit = limit
+	return b
+}
+
+// Corrupt makes the transaction invalid.
+func (b *TxBuilder) Corrupt(corruptions ...Corruption) *TxBuilder {
+	b.corruptions = append(b.corruptions, corruptions...)
+	return b
+}
+
+// Proto returns the signed transaction in proto.
+func (b *TxBuilder) Proto() *corepb.Transaction {
+	chainID := b.f.chainID
+	for _, c := range b.corruptions {
+		if c == CorruptChainID {
+			chainID++
+		}
+	}
+
+	tx := core.NewTransaction(chainID, b.from.Address, b.to, b.value, b.nonce, b.payloadType, b.payload, b.gasPrice, b.gasLimit)
+	msg, err := tx.ToProto()
+	if err != nil {
+		panic(err)
+	}
+	pbTx := msg.(*corepb.Transaction)
+	pbTx.Timestamp = b.timestamp
+	if err := tx.FromProto(pbTx); err != nil {
+		panic(err)
+	}
+	if err := tx.Sign(b.from.Signature()); err != nil {
+		panic(err)
+	}
+	if msg, err = tx.ToProto(); err != nil {
+		panic(err)
+	}
+	pbTx = msg.(*corepb.Transaction)
+
+	for _, c := range b.corruptions {
+		switch c {
+		case CorruptHash:
+			pbTx.Hash = flipped(pbTx.Hash)
+		case CorruptSignature:
+			pbTx.Sign = flipped(pbTx.Sign)
+		case CorruptAlg:
+			pbTx.Alg = 0xff
+		}
+	}
+	return pbTx
+}
+
+// Build returns the signed transaction.
+func (b *TxBuilder) Build() *core.Transaction {
+	tx := new(core.Transaction)
+	if err := tx.FromProto(b.Proto()); err != nil {
+		panic(err)
+	}
+	return tx
+}
+
+// Bytes returns the encoded signed transaction.
+func (b *TxBuilder) Bytes() []byte {
+	data, err := proto.Marshal(b.Proto())
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// NamedTransaction is an edge-case transaction with its name.
+type NamedTransaction struct {
+	Name string
+	Tx   *core.Transaction
+}
+
+// EdgeTransactions returns valid transactions of the sender at the bounds of
+// the fields, all with nonce 1.
+func (f *Fixture) EdgeTransactions(from *Account) []*NamedTransaction {
+	builders := []struct {
+		name string
+		b    *TxBuilder
+	}{
+		{"zero value", f.Tx().From(from).Value(util.NewUint128())},
+		{"max value", f.Tx().From(from).Value(MaxUint128)},
+		{"self transfer", f.Tx().From(from).To(from.Address)},
+		{"max gas", f.Tx().From(from).Gas(core.TransactionMaxGasPrice, core.TransactionMaxGas)},
+		{"empty payload", f.Tx().From(from).Payload(core.TxPayloadBinaryType, []byte{})},
+		{"binary payload", f.Tx().From(from).Payload(core.TxPayloadBinaryType, f.Bytes(1024))},
+		{"zero timestamp", f.Tx().From(from).Timestamp(0)},
+		{"max nonce", f.Tx().From(from).Nonce(^uint64(0))},
+	}
+	txs := make([]*NamedTransaction, len(builders))
+	for i, v := range builders {
+		if v.name != "max nonce" {
+			v.b.Nonce(1)
+		}
+		txs[i] = &NamedTransaction{Name: v.name, Tx: v.b.Build()}
+	}
+	return txs
+}
+
+// flipped returns a copy of data with the first bit flipped.
+func flipped(data []byte) []byte {
+	if len(data) == 0 {
+		return []byte{1}
+	}
+	v := make([]byte, len(data))
+	copy(v, data)
+	v[0] ^= 0x01
+	return v
+}
@@ -19,7 +19,7 @@
 package core
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"time"
 
@@ -28,14 +28,16 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
-	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/crash"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/tracing"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
@@ -56,10 +58,19 @@ var (
 	// GasCountPerByte per byte of data attached to a transaction gas cost
 	GasCountPerByte = util.NewUint128FromInt(1)
 
+	// GasScheduleVersion is bumped whenever the gas costs above or of the nvm change.
+	GasScheduleVersion = uint32(1)
+
 	// DelegateBaseGasCount is base gas count of delegate transaction
 	DelegateBaseGasCount = util.NewUint128FromInt(20000)
 	// CandidateBaseGasCount is base gas count of candidate transaction
 	CandidateBaseGasCount = util.NewUint128FromInt(20000)
+	// AnchorBaseGasCount is base gas count of anchor transaction
+	AnchorBaseGasCount = util.NewUint128FromInt(20000)
+	// LibraryBaseGasCount is base gas count of library transaction
+	LibraryBaseGasCount = util.NewUint128FromInt(20000)
+	// PauseBaseGasCount is base gas count of pause transaction
+	PauseBaseGasCount = util.NewUint128FromInt(20000)
 	// ZeroGasCount is zero gas count
 	ZeroGasCount = util.NewUint128()
 
@@ -157,7 +168,7 @@ func (tx *Transaction) ToProto() (proto.Message, error) {
 
 // FromProto converts proto Tx into domain Tx
 func (tx *Transaction) FromProto(msg proto.Message) error {
-	if msg, ok := msg.(*corepb.Transaction); ok {
+	if msg, ok := msg.(*corepb.Transaction); ok && msg != nil && msg.Data != nil {
 		tx.hash = msg.Hash
 		tx.from = &Address{msg.From}
 		tx.to = &Address{msg.To}
@@ -184,7 +195,7 @@ func (tx *Transaction) FromProto(msg proto.Message) error {
 		tx.sign = msg.Sign
 		return nil
 	}
-	return errors.New("Protobug Message cannot be converted into Transaction")
+	return ErrInvalidProtoToTransaction
 }
 
 func (tx *Transaction) String() string {
@@ -275,30 +286,31 @@ func (tx *Transaction) DataLen() int {
 
 // LoadPayload returns tx's payload
 func (tx *Transaction) LoadPayload() (TxPayload, error) {
-	// execute payload
-	var (
-		payload TxPayload
-		err     error
-	)
-	switch tx.data.Type {
-	case TxPayloadBinaryType:
-		payload, err = LoadBinaryPayload(tx.data.Payload)
-	case TxPayloadDeployType:
-		payload, err = LoadDeployPayload(tx.data.Payload)
-	case TxPayloadCallType:
-		payload, err = LoadCallPayload(tx.data.Payload)
-	case TxPayloadCandidateType:
-		payload, err = LoadCandidatePayload(tx.data.Payload)
-	case TxPayloadDelegateType:
-		payload, err = LoadDelegatePayload(tx.data.Payload)
-	default:
-		err = ErrInvalidTxPayloadType
-	}
-	return payload, err
+	t := LookupTxPayloadType(tx.data.Type)
+	if t == nil {
+		return nil, ErrInvalidTxPayloadType
+	}
+	return t.Load(tx.data.Payload)
 }
 
 // VerifyExecution transaction and return result.
 func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
+	return tx.verifyExecution(context.Background(), block)
+}
+
+// verifyExecution transaction, the payload execution is terminated when ctx is done.
+func (tx *Transaction) verifyExecution(ctx context.Context, block *Block) (gas *util.Uint128, err error) {
+	ctx, span := tracing.Start(ctx, "core.tx.verifyExecution",
+		attribute.String("hash", tx.hash.String()),
+		attribute.String("type", tx.Type()),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	// the types not active at the height are rejected by consensus.
+	if !ForksOf(block.ChainID()).IsTxPayloadTypeActive(tx.data.Type, block.height) {
+		return util.NewUint128(), ErrInvalidTxPayloadType
+	}
+
 	// check balance.
 	fromAcc := block.accState.GetOrCreateUserAccount(tx.from.address)
 	toAcc := block.accState.GetOrCreateUserAccount(tx.to.address)
@@ -324,14 +336,18 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		}).Error("Failed to load payload.")
 		executeTxErrCounter.Inc(1)
 
-		tx.gasConsumption(fromAcc, coinbaseAcc, gasUsed)
+		if err := tx.gasConsumption(block, fromAcc, coinbaseAcc, gasUsed); err != nil {
+			return util.NewUint128(), err
+		}
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		block.recordReceipt(tx, gasUsed, err)
 		return gasUsed, nil
 	}
 
-	ctx := NewPayloadContext(block, tx)
+	payloadCtx := NewPayloadContext(block, tx)
+	payloadCtx.execCtx = ctx
 
-	err = ctx.BeginBatch()
+	err = payloadCtx.BeginBatch()
 	if err != nil {
 		return util.NewUint128(), err
 	}
@@ -345,21 +361,30 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		}).Error("Failed to check base gas used.")
 		executeTxErrCounter.Inc(1)
 
-		tx.gasConsumption(fromAcc, coinbaseAcc, tx.gasLimit)
+		if err := tx.gasConsumption(block, fromAcc, coinbaseAcc, tx.gasLimit); err != nil {
+			return util.NewUint128(), err
+		}
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		block.recordReceipt(tx, tx.gasLimit, ErrOutOfGasLimit)
 		return tx.gasLimit, nil
 	}
 
 	// execute smart contract and sub the calcute gas.
-	gasExecution, err := payload.Execute(ctx)
+	gasExecution, err := executePayload(payload, payloadCtx)
+	if crash.IsPanic(err) {
+		// the state changed before the panic is dropped with the tx.
+		payloadCtx.RollBack()
+		executeTxErrCounter.Inc(1)
+		return util.NewUint128(), err
+	}
 	if err != nil {
-		ctx.RollBack()
+		payloadCtx.RollBack()
 	} else {
-		ctx.Commit()
+		payloadCtx.Commit()
 	}
 
 	// gas = tx.GasCountOfTxBase() +  gasExecution
-	gas := util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
+	gas = util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
 
 	logging.VLog().WithFields(logrus.Fields{
 		"tx":           tx,
@@ -370,7 +395,9 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		"gasLimited":   tx.gasLimit.String(),
 	}).Info("Transaction execution statics.")
 
-	tx.gasConsumption(fromAcc, coinbaseAcc, gas)
+	if err := tx.gasConsumption(block, fromAcc, coinbaseAcc, gas); err != nil {
+		return util.NewUint128(), err
+	}
 
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -383,6 +410,7 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 		executeTxErrCounter.Inc(1)
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		block.recordReceipt(tx, gas, err)
 	} else {
 		if fromAcc.Balance().Cmp(tx.value.Int) < 0 {
 			logging.VLog().WithFields(logrus.Fields{
@@ -393,6 +421,7 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 			executeTxErrCounter.Inc(1)
 			tx.triggerEvent(TopicExecuteTxFailed, block, ErrInsufficientBalance)
+			block.recordReceipt(tx, gas, ErrInsufficientBalance)
 		} else {
 			// accept the transaction
 			fromAcc.SubBalance(tx.value)
@@ -401,16 +430,37 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 			executeTxCounter.Inc(1)
 			// record tx execution success event
 			tx.triggerEvent(TopicExecuteTxSuccess, block, nil)
+			block.recordReceipt(tx, gas, nil)
 		}
 	}
 
 	return gas, nil
 }
 
-func (tx *Transaction) gasConsumption(from, coinbase state.Account, gas *util.Uint128) {
+// executePayload executes the payload, a panic of the VM is recovered into an error.
+func executePayload(payload TxPayload, ctx *PayloadContext) (gas *util.Uint128, err error) {
+	defer crash.Recover("core.tx.executePayload", logrus.Fields{
+		"block": ctx.block,
+		"tx":    ctx.tx,
+	}, &err)
+	return payload.Execute(ctx)
+}
+
+func (tx *Transaction) gasConsumption(block *Block, from, coinbase state.Account, gas *util.Uint128) error {
 	gasCost := util.NewUint128().Mul(tx.GasPrice().Int, gas.Int)
 	from.SubBalance(util.NewUint128FromBigInt(gasCost))
-	coinbase.AddBalance(util.NewUint128FromBigInt(gasCost))
+	return block.payFee(coinbase, util.NewUint128FromBigInt(gasCost))
+}
+
+// payFee pays the fee to the block producer, from the fee burn fork on a part
+// of it is burned and recorded in the supply.
+func (block *Block) payFee(coinbase state.Account, fee *util.Uint128) error {
+	burned, paid := ForksOf(block.ChainID()).SplitFee(block.height, fee)
+	coinbase.AddBalance(paid)
+	if burned.Cmp(util.NewUint128().Int) == 0 {
+		return nil
+	}
+	return block.recordBurned(burned)
 }
 
 func (tx *Transaction) triggerEvent(topic string, block *Block, err error) {
@@ -476,19 +526,7 @@ func (tx *Transaction) VerifyIntegrity(chainID uint32) error {
 }
 
 func (tx *Transaction) verifySign() error {
-	signature, err := crypto.NewSignature(keystore.Algorithm(tx.alg))
-	if err != nil {
-		return err
-	}
-	pub, err := signature.RecoverPublic(tx.hash, tx.sign)
-	if err != nil {
-		return err
-	}
-	pubdata, err := pub.Encoded()
-	if err != nil {
-		return err
-	}
-	addr, err := NewAddressFromPublicKey(pubdata)
+	addr, err := RecoverSignerAddress(keystore.Algorithm(tx.alg), tx.hash, tx.sign)
 	if err != nil {
 		return err
 	}
@@ -60,6 +60,10 @@ func (payload *CallPayload) BaseGasCount() *util.Uint128 {
 
 // Execute the call payload in tx, call a function
 func (payload *CallPayload) Execute(context *PayloadContext) (*util.Uint128, error) {
+	if isContractPaused(context.accState, context.tx.to, context.block.height) {
+		return util.NewUint128(), ErrContractPaused
+	}
+
 	ctx, deployPayload, err := generateCallContext(context)
 	if err != nil {
 		return util.NewUint128(), err
@@ -69,7 +73,10 @@ func (payload *CallPayload) Execute(context *PayloadContext) (*util.Uint128, err
 	defer engine.Dispose()
 
 	//add gas limit and memory use limit
-	engine.SetExecutionLimits(context.tx.PayloadGasLimit(payload).Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
+	engine.SetExecutionLimits(context.tx.PayloadGasLimit(payload).Uint64(), nvm.MemoryLimitFromContext(context.execCtx))
+	engine.SetCancelContext(context.execCtx)
+	engine.SetProfiler(nvm.ProfilerFromContext(context.execCtx))
+	engine.SetResult(nvm.ResultFromContext(context.execCtx))
 
 	err = engine.Call(deployPayload.Source, deployPayload.SourceType, payload.Function, payload.Args)
 	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), err
@@ -91,6 +98,6 @@ func generateCallContext(ctx *PayloadContext) (*nvm.Context, *DeployPayload, err
 		return nil, nil, err
 	}
 
-	nvmctx := nvm.NewContext(ctx.block, convertNvmTx(ctx.tx), owner, contract, ctx.accState)
+	nvmctx := nvm.NewContext(ctx.block, convertNvmTx(ctx), owner, contract, ctx.accState)
 	return nvmctx, deploy, nil
 }
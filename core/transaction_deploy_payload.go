@@ -70,7 +70,9 @@ func (payload *DeployPayload) Execute(ctx *PayloadContext) (*util.Uint128, error
 	engine := nvm.NewV8Engine(nvmctx)
 	defer engine.Dispose()
 
-	engine.SetExecutionLimits(ctx.tx.PayloadGasLimit(payload).Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
+	engine.SetExecutionLimits(ctx.tx.PayloadGasLimit(payload).Uint64(), nvm.MemoryLimitFromContext(ctx.execCtx))
+	engine.SetCancelContext(ctx.execCtx)
+	engine.SetProfiler(nvm.ProfilerFromContext(ctx.execCtx))
 
 	// Deploy and Init.
 	err = engine.DeployAndInit(payload.Source, payload.SourceType, payload.Args)
@@ -87,11 +89,12 @@ func generateDeployContext(ctx *PayloadContext) (*nvm.Context, error) {
 	if err != nil {
 		return nil, err
 	}
-	nvmctx := nvm.NewContext(ctx.block, convertNvmTx(ctx.tx), owner, contract, ctx.accState)
+	nvmctx := nvm.NewContext(ctx.block, convertNvmTx(ctx), owner, contract, ctx.accState)
 	return nvmctx, nil
 }
 
-func convertNvmTx(tx *Transaction) *nvm.ContextTransaction {
+func convertNvmTx(ctx *PayloadContext) *nvm.ContextTransaction {
+	tx := ctx.tx
 	ctxTx := &nvm.ContextTransaction{
 		From:      tx.from.String(),
 		To:        tx.to.String(),
@@ -102,5 +105,9 @@ func convertNvmTx(tx *Transaction) *nvm.ContextTransaction {
 		GasPrice:  tx.GasPrice().String(),
 		GasLimit:  tx.GasLimit().String(),
 	}
+	if ForksOf(ctx.block.ChainID()).IsContractContextActive(ctx.block.height) {
+		index := ctx.TxIndex()
+		ctxTx.Index = &index
+	}
 	return ctxTx
 }
@@ -18,7 +18,11 @@
 
 package core
 
-import "github.com/nebulasio/go-nebulas/core/state"
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+)
 
 // PayloadContext transaction payload context
 type PayloadContext struct {
@@ -27,11 +31,17 @@ type PayloadContext struct {
 
 	accState    state.AccountState
 	dposContext *DposContext
+
+	// execCtx terminates the payload execution when done.
+	execCtx context.Context
+
+	// message is true for a message delivered before the transactions of the block.
+	message bool
 }
 
 // NewPayloadContext returns new payloadcontxt
 func NewPayloadContext(block *Block, tx *Transaction) *PayloadContext {
-	ctx := &PayloadContext{block: block, tx: tx}
+	ctx := &PayloadContext{block: block, tx: tx, execCtx: context.Background()}
 	return ctx
 }
 
@@ -45,6 +55,21 @@ func (ctx *PayloadContext) Transaction() *Transaction {
 	return ctx.tx
 }
 
+// TxIndex returns the index of the tx in the block, -1 for a message. The
+// txs are executed in order, a tx being collected is appended after its
+// execution.
+func (ctx *PayloadContext) TxIndex() int64 {
+	if ctx.message {
+		return -1
+	}
+	for i, tx := range ctx.block.transactions {
+		if tx.hash.Equals(ctx.tx.hash) {
+			return int64(i)
+		}
+	}
+	return int64(len(ctx.block.transactions))
+}
+
 // BeginBatch begin a batch task
 func (ctx *PayloadContext) BeginBatch() (err error) {
 	ctx.accState, err = ctx.block.accState.Clone()
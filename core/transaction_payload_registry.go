@@ -0,0 +1,103 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// TxPayloadLoader loads the payload of a transaction from its bytes, the
+// validation of the payload is done by the loader and its Execute.
+type TxPayloadLoader func(bytes []byte) (TxPayload, error)
+
+// TxPayloadType is a registered type of transaction payload.
+type TxPayloadType struct {
+	Name string
+	Load TxPayloadLoader
+	// Topic is the event triggered for the transactions of the type when
+	// their block is linked, none if empty.
+	Topic string
+	// Builtin types are accepted from genesis, the others are rejected until
+	// the height scheduled for them in the genesis forks.
+	Builtin bool
+}
+
+var (
+	txPayloadTypes     = make(map[string]*TxPayloadType)
+	txPayloadTypesLock = sync.RWMutex{}
+)
+
+func init() {
+	for _, t := range []*TxPayloadType{
+		{Name: TxPayloadBinaryType, Load: func(b []byte) (TxPayload, error) { return LoadBinaryPayload(b) }, Topic: TopicSendTransaction},
+		{Name: TxPayloadDeployType, Load: func(b []byte) (TxPayload, error) { return LoadDeployPayload(b) }, Topic: TopicDeploySmartContract},
+		{Name: TxPayloadCallType, Load: func(b []byte) (TxPayload, error) { return LoadCallPayload(b) }, Topic: TopicCallSmartContract},
+		{Name: TxPayloadCandidateType, Load: func(b []byte) (TxPayload, error) { return LoadCandidatePayload(b) }, Topic: TopicCandidate},
+		{Name: TxPayloadDelegateType, Load: func(b []byte) (TxPayload, error) { return LoadDelegatePayload(b) }, Topic: TopicDelegate},
+	} {
+		t.Builtin = true
+		RegisterTxPayloadType(t)
+	}
+	// the types added later are scheduled by the genesis forks.
+	for _, t := range []*TxPayloadType{
+		{Name: TxPayloadAnchorType, Load: func(b []byte) (TxPayload, error) { return LoadAnchorPayload(b) }, Topic: TopicAnchor},
+		{Name: TxPayloadLibraryType, Load: func(b []byte) (TxPayload, error) { return LoadLibraryPayload(b) }, Topic: TopicDeployLibrary},
+		{Name: TxPayloadPauseType, Load: func(b []byte) (TxPayload, error) { return LoadPausePayload(b) }, Topic: TopicPauseVote},
+	} {
+		RegisterTxPayloadType(t)
+	}
+}
+
+// RegisterTxPayloadType registers a type of transaction payload, it panics if
+// the name is registered twice. New types register in the init of their
+// package and are activated by the tx_payload_types of the genesis forks.
+func RegisterTxPayloadType(t *TxPayloadType) {
+	txPayloadTypesLock.Lock()
+	defer txPayloadTypesLock.Unlock()
+
+	if t.Name == "" || t.Load == nil {
+		panic("core: invalid tx payload type")
+	}
+	if _, ok := txPayloadTypes[t.Name]; ok {
+		panic(fmt.Sprintf("core: tx payload type %s registered twice", t.Name))
+	}
+	txPayloadTypes[t.Name] = t
+}
+
+// LookupTxPayloadType returns the registered type of the name, nil if unknown.
+func LookupTxPayloadType(name string) *TxPayloadType {
+	txPayloadTypesLock.RLock()
+	defer txPayloadTypesLock.RUnlock()
+	return txPayloadTypes[name]
+}
+
+// TxPayloadTypeNames returns the names of the registered types, sorted.
+func TxPayloadTypeNames() []string {
+	txPayloadTypesLock.RLock()
+	defer txPayloadTypesLock.RUnlock()
+
+	names := make([]string, 0, len(txPayloadTypes))
+	for name := range txPayloadTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxPayloadTypeRegistry(t *testing.T) {
+	assert.Equal(t, TopicCallSmartContract, LookupTxPayloadType(TxPayloadCallType).Topic)
+	assert.Nil(t, LookupTxPayloadType("governance"))
+	assert.Panics(t, func() {
+		RegisterTxPayloadType(&TxPayloadType{Name: TxPayloadCallType, Load: func(b []byte) (TxPayload, error) { return LoadCallPayload(b) }})
+	})
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{TxPayloadTypes: map[string]uint64{"governance": 10}}
+	assert.Equal(t, ErrInvalidTxPayloadType, CheckForks(conf))
+
+	RegisterTxPayloadType(&TxPayloadType{Name: "governance", Load: func(b []byte) (TxPayload, error) { return LoadBinaryPayload(b) }})
+	defer func() {
+		txPayloadTypesLock.Lock()
+		delete(txPayloadTypes, "governance")
+		txPayloadTypesLock.Unlock()
+	}()
+	assert.Contains(t, TxPayloadTypeNames(), "governance")
+	assert.Nil(t, CheckForks(conf))
+
+	forks := &Forks{TxPayloadTypes: conf.Forks.TxPayloadTypes}
+	assert.True(t, forks.IsTxPayloadTypeActive(TxPayloadBinaryType, 1))
+	// the types added after genesis are scheduled like the others.
+	for _, name := range []string{TxPayloadAnchorType, TxPayloadLibraryType, TxPayloadPauseType} {
+		assert.False(t, LookupTxPayloadType(name).Builtin)
+		assert.False(t, forks.IsTxPayloadTypeActive(name, 100))
+	}
+	assert.False(t, forks.IsTxPayloadTypeActive("unknown", 100))
+	assert.False(t, forks.IsTxPayloadTypeActive("governance", 9))
+	assert.True(t, forks.IsTxPayloadTypeActive("governance", 10))
+}
+
+func TestInactiveTxPayloadTypeRejected(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	RegisterTxPayloadType(&TxPayloadType{Name: "multisig", Load: func(b []byte) (TxPayload, error) { return LoadBinaryPayload(b) }})
+	defer func() {
+		txPayloadTypesLock.Lock()
+		delete(txPayloadTypes, "multisig")
+		txPayloadTypesLock.Unlock()
+	}()
+
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, "multisig", nil, TransactionGasPrice, TransactionMaxGas)
+	assert.Nil(t, tx.Sign(signature))
+	assert.Equal(t, ErrInvalidTxPayloadType, bc.txPool.Push(tx))
+
+	block, _ := bc.NewBlock(from)
+	_, err := tx.VerifyExecution(block)
+	assert.Equal(t, ErrInvalidTxPayloadType, err)
+
+	conf := MockGenesisConf()
+	conf.Forks = &corepb.GenesisForks{TxPayloadTypes: map[string]uint64{"multisig": block.Height()}}
+	RegisterForks(conf)
+	defer RegisterForks(MockGenesisConf())
+
+	assert.Nil(t, bc.txPool.Push(tx))
+	block.begin()
+	_, err = tx.VerifyExecution(block)
+	assert.Nil(t, err)
+	block.rollback()
+}
@@ -19,9 +19,13 @@
 package core
 
 import (
+	"context"
+	"math/big"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/nebulasio/go-nebulas/common/pdeque"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/net"
@@ -29,17 +33,49 @@ import (
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/tracing"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
-	invalidTxCounter       = metrics.GetOrRegisterCounter("txpool_invalid", nil)
-	duplicateTxCounter     = metrics.GetOrRegisterCounter("txpool_duplicate", nil)
-	belowGasPriceTxCounter = metrics.GetOrRegisterCounter("txpool_below_gas_price", nil)
-	outOfGasLimitTxCounter = metrics.GetOrRegisterCounter("txpool_out_of_gas_limit", nil)
+	invalidTxCounter        = metrics.GetOrRegisterCounter("txpool_invalid", nil)
+	badSignatureTxCounter   = metrics.GetOrRegisterCounter("txpool_bad_signature", nil)
+	nonceTooLowTxCounter    = metrics.GetOrRegisterCounter("txpool_nonce_too_low", nil)
+	duplicateTxCounter      = metrics.GetOrRegisterCounter("txpool_duplicate", nil)
+	belowGasPriceTxCounter  = metrics.GetOrRegisterCounter("txpool_below_gas_price", nil)
+	outOfGasLimitTxCounter  = metrics.GetOrRegisterCounter("txpool_out_of_gas_limit", nil)
+	panickedTxCounter       = metrics.GetOrRegisterCounter("txpool_panicked", nil)
+	pausedContractTxCounter = metrics.GetOrRegisterCounter("txpool_paused_contract", nil)
 )
 
+// PanickedTxCacheSize is the number of txs panicked in execution the pool refuses.
+const PanickedTxCacheSize = 1024
+
+// GasPriceHistogramBuckets is the number of buckets of the gas price histogram,
+// the lowest price of each bucket doubles the previous one.
+const GasPriceHistogramBuckets = 16
+
+// GasPriceBucket is the count of the txs in the pool with a gas price from
+// MinGasPrice up to the MinGasPrice of the next bucket.
+type GasPriceBucket struct {
+	MinGasPrice *util.Uint128
+	Count       int
+}
+
+// MempoolStats is the congestion of the pool.
+type MempoolStats struct {
+	TxCount int
+	// Size is the bytes of the txs in the pool.
+	Size      int
+	Histogram []*GasPriceBucket
+
+	// BlocksToInclusion estimates the count of blocks until a tx at the queried
+	// gas price is packed, by the count of txs taken out of the pool before it.
+	BlocksToInclusion int
+}
+
 // TransactionPool cache txs, is thread safe
 type TransactionPool struct {
 	receivedMessageCh chan net.Message
@@ -50,6 +86,14 @@ type TransactionPool struct {
 	all   map[byteutils.HexHash]*Transaction
 	bc    *BlockChain
 
+	// panicked are the hashes of txs which panicked in execution.
+	panicked *lru.Cache
+
+	// local are the txs submitted to the node, rebroadcast until on chain.
+	local *localTxs
+
+	relayer *txRelay
+
 	nm p2p.Manager
 	mu sync.RWMutex
 
@@ -73,12 +117,19 @@ func less(a interface{}, b interface{}) bool {
 
 // NewTransactionPool create a new TransactionPool
 func NewTransactionPool(size int) (*TransactionPool, error) {
+	panicked, err := lru.New(PanickedTxCacheSize)
+	if err != nil {
+		return nil, err
+	}
 	txPool := &TransactionPool{
 		receivedMessageCh: make(chan net.Message, size),
 		quitCh:            make(chan int, 1),
 		size:              size,
 		cache:             pdeque.NewPriorityDeque(less),
 		all:               make(map[byteutils.HexHash]*Transaction),
+		panicked:          panicked,
+		local:             newLocalTxs(),
+		relayer:           newTxRelay(DefaultTxRelayConfig()),
 		gasPrice:          TransactionGasPrice,
 		gasLimit:          TransactionMaxGas,
 	}
@@ -99,6 +150,16 @@ func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) {
 	}
 }
 
+// GasPrice returns the lowest gasPrice accepted by the pool.
+func (pool *TransactionPool) GasPrice() *util.Uint128 {
+	return pool.gasPrice
+}
+
+// GasLimit returns the maximum gasLimit accepted by the pool.
+func (pool *TransactionPool) GasLimit() *util.Uint128 {
+	return pool.gasLimit
+}
+
 // RegisterInNetwork register message subscriber in network.
 func (pool *TransactionPool) RegisterInNetwork(nm p2p.Manager) {
 	nm.Register(net.NewSubscriber(pool, pool.receivedMessageCh, MessageTypeNewTx))
@@ -132,57 +193,74 @@ func (pool *TransactionPool) loop() {
 		"size": pool.size,
 	}).Info("Launched TransactionPool.")
 
+	rebroadcastTicker := time.NewTicker(RebroadcastInterval)
+	defer rebroadcastTicker.Stop()
+
+	var relayTick <-chan time.Time
+	if interval := pool.relayer.interval(); interval > 0 {
+		relayTicker := time.NewTicker(interval)
+		defer relayTicker.Stop()
+		relayTick = relayTicker.C
+	}
+
 	for {
 		select {
+		case now := <-relayTick:
+			pool.flushRelay(now)
+		case now := <-rebroadcastTicker.C:
+			pool.rebroadcast(now)
 		case <-pool.quitCh:
 			logging.CLog().WithFields(logrus.Fields{
 				"size": pool.size,
 			}).Info("Shutdowned TransactionPool.")
 			return
 		case msg := <-pool.receivedMessageCh:
-			if msg.MessageType() != MessageTypeNewTx {
-				logging.VLog().WithFields(logrus.Fields{
-					"messageType": msg.MessageType(),
-					"message":     msg,
-					"err":         "not new tx msg",
-				}).Warn("Received unregistered message.")
-				continue
-			}
+			p2p.HandleMessage(pool.nm, "core.txPool.handleTx", msg, pool.handleTx)
+		}
+	}
+}
 
-			tx := new(Transaction)
-			pbTx := new(corepb.Transaction)
-			if err := proto.Unmarshal(msg.Data().([]byte), pbTx); err != nil {
-				logging.VLog().WithFields(logrus.Fields{
-					"msgType": msg.MessageType(),
-					"msg":     msg,
-					"err":     err,
-				}).Error("Failed to unmarshal data.")
-				continue
-			}
-			if err := tx.FromProto(pbTx); err != nil {
-				logging.VLog().WithFields(logrus.Fields{
-					"msgType": msg.MessageType(),
-					"msg":     msg,
-					"err":     err,
-				}).Error("Failed to recover a tx from proto data.")
-				continue
-			}
+func (pool *TransactionPool) handleTx(msg net.Message) {
+	if msg.MessageType() != MessageTypeNewTx {
+		logging.VLog().WithFields(logrus.Fields{
+			"messageType": msg.MessageType(),
+			"message":     msg,
+			"err":         "not new tx msg",
+		}).Warn("Received unregistered message.")
+		return
+	}
 
-			logging.VLog().WithFields(logrus.Fields{
-				"tx":   tx,
-				"type": msg.MessageType(),
-			}).Info("Received a new tx.")
-
-			if err := pool.PushAndRelay(tx); err != nil {
-				logging.VLog().WithFields(logrus.Fields{
-					"func":        "TxPool.loop",
-					"messageType": msg.MessageType(),
-					"transaction": tx,
-					"err":         err,
-				}).Error("Failed to push a tx into tx pool.")
-				continue
-			}
-		}
+	tx := new(Transaction)
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(msg.Data().([]byte), pbTx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to unmarshal data.")
+		return
+	}
+	if err := tx.FromProto(pbTx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to recover a tx from proto data.")
+		return
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"tx":   tx,
+		"type": msg.MessageType(),
+	}).Info("Received a new tx.")
+
+	if err := pool.PushAndRelay(tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"func":        "TxPool.loop",
+			"messageType": msg.MessageType(),
+			"transaction": tx,
+			"err":         err,
+		}).Error("Failed to push a tx into tx pool.")
 	}
 }
 
@@ -198,16 +276,37 @@ func (pool *TransactionPool) PushAndRelay(tx *Transaction) error {
 	if err := pool.Push(tx); err != nil {
 		return err
 	}
-	pool.nm.Relay(MessageTypeNewTx, tx)
+	pool.relay(tx)
 	return nil
 }
 
-// PushAndBroadcast push tx into pool and broadcast it
-func (pool *TransactionPool) PushAndBroadcast(tx *Transaction) error {
-	if err := pool.Push(tx); err != nil {
+// PushAndBroadcast push tx into pool and broadcast it, the steps are traced as children of ctx.
+func (pool *TransactionPool) PushAndBroadcast(ctx context.Context, tx *Transaction) error {
+	_, span := tracing.Start(ctx, "core.txPool.push", attribute.String("hash", tx.hash.String()))
+	err := pool.Push(tx)
+	tracing.End(span, err)
+	if err != nil {
 		return err
 	}
-	pool.nm.Broadcast(MessageTypeNewTx, tx)
+
+	_, span = tracing.Start(ctx, "net.broadcast", attribute.String("msgType", MessageTypeNewTx))
+	pool.relay(tx)
+	span.End()
+	return nil
+}
+
+// PushAndBroadcastLocal push a tx signed by the node or submitted by its admin
+// into pool and broadcast it, the tx is rebroadcast until it is on chain.
+func (pool *TransactionPool) PushAndBroadcastLocal(ctx context.Context, tx *Transaction) error {
+	if err := pool.PushAndBroadcast(ctx, tx); err != nil {
+		return err
+	}
+	if !pool.local.track(tx, time.Now()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"tx":    tx,
+			"limit": MaxLocalTxs,
+		}).Warn("Too many local txs pending, the tx is not rebroadcast.")
+	}
 	return nil
 }
 
@@ -218,6 +317,12 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 		return ErrDuplicatedTransaction
 	}
 
+	// refuse the tx panicked in execution, it's not retried
+	if pool.panicked.Contains(tx.hash.Hex()) {
+		panickedTxCounter.Inc(1)
+		return ErrPanickedTransaction
+	}
+
 	// if tx's gasPrice below the pool config lowest gasPrice, return ErrBelowGasPrice
 	if tx.gasPrice.Cmp(pool.gasPrice.Int) < 0 {
 		belowGasPriceTxCounter.Inc(1)
@@ -230,10 +335,40 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 
 	// verify hash & sign of tx
 	if err := tx.VerifyIntegrity(pool.bc.chainID); err != nil {
-		invalidTxCounter.Inc(1)
+		if err == ErrInvalidChainID || err == ErrInvalidTransactionHash {
+			invalidTxCounter.Inc(1)
+		} else {
+			badSignatureTxCounter.Inc(1)
+		}
 		return err
 	}
 
+	// refuse the tx whose nonce is already used on chain
+	if tail := pool.bc.TailBlock(); tail != nil && tx.nonce <= tail.GetNonce(tx.from.address) {
+		nonceTooLowTxCounter.Inc(1)
+		return ErrSmallTransactionNonce
+	}
+
+	// refuse the tx payload types not accepted in the next block
+	if tail := pool.bc.TailBlock(); tail != nil && !ForksOf(pool.bc.chainID).IsTxPayloadTypeActive(tx.Type(), tail.height+1) {
+		invalidTxCounter.Inc(1)
+		return ErrInvalidTxPayloadType
+	}
+
+	// refuse the tx which may not fit in the next block
+	if tail := pool.bc.TailBlock(); tail != nil {
+		if limit := ForksOf(pool.bc.chainID).BlockGasLimitAt(tail.height + 1); limit != nil && tx.gasLimit.Cmp(limit.Int) > 0 {
+			outOfGasLimitTxCounter.Inc(1)
+			return ErrOutOfGasLimit
+		}
+	}
+
+	// refuse the calls to a contract paused in the next block
+	if tail := pool.bc.TailBlock(); tx.Type() == TxPayloadCallType && tail != nil && tail.IsContractPaused(tx.to, tail.height+1) {
+		pausedContractTxCounter.Inc(1)
+		return ErrContractPaused
+	}
+
 	// cache the verified tx
 	pool.cache.Insert(tx)
 	pool.all[tx.hash.Hex()] = tx
@@ -245,6 +380,11 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 	return nil
 }
 
+// markPanicked makes the pool refuse a tx panicked in execution.
+func (pool *TransactionPool) markPanicked(tx *Transaction) {
+	pool.panicked.Add(tx.hash.Hex(), true)
+}
+
 // Pop a transaction from pool
 func (pool *TransactionPool) Pop() *Transaction {
 	pool.mu.Lock()
@@ -261,6 +401,76 @@ func (pool *TransactionPool) pop() *Transaction {
 	return nil
 }
 
+// Pending returns the txs in the pool in the order Pop takes them out.
+func (pool *TransactionPool) Pending() []*Transaction {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	cache := pdeque.NewPriorityDeque(less)
+	for _, tx := range pool.all {
+		cache.Insert(tx)
+	}
+	txs := make([]*Transaction, 0, cache.Len())
+	for cache.Len() > 0 {
+		txs = append(txs, cache.PopMin().(*Transaction))
+	}
+	return txs
+}
+
+// Stats return the congestion of the pool, and the blocks until a tx at the gas price is packed.
+func (pool *TransactionPool) Stats(gasPrice *util.Uint128) *MempoolStats {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	stats := &MempoolStats{
+		TxCount:   len(pool.all),
+		Histogram: make([]*GasPriceBucket, GasPriceHistogramBuckets),
+	}
+	bound := util.NewUint128FromBigInt(pool.gasPrice.Int)
+	for i := range stats.Histogram {
+		stats.Histogram[i] = &GasPriceBucket{MinGasPrice: bound}
+		bound = util.NewUint128FromBigInt(new(big.Int).Lsh(bound.Int, 1))
+	}
+
+	ahead := 0
+	for _, tx := range pool.all {
+		if pbTx, err := tx.ToProto(); err == nil {
+			stats.Size += proto.Size(pbTx)
+		}
+		for i := len(stats.Histogram) - 1; i >= 0; i-- {
+			if tx.gasPrice.Cmp(stats.Histogram[i].MinGasPrice.Int) >= 0 {
+				stats.Histogram[i].Count++
+				break
+			}
+		}
+		// the pool is ordered as less, by ascending gas price of the senders,
+		// the txs at the same price are taken as before.
+		if tx.gasPrice.Cmp(gasPrice.Int) <= 0 {
+			ahead++
+		}
+	}
+	stats.BlocksToInclusion = ahead/TxsPerBlock + 1
+	return stats
+}
+
+// LastContiguousNonce returns the highest nonce of the txs of the address in
+// the pool following the nonce without a gap, the nonce if there is none.
+func (pool *TransactionPool) LastContiguousNonce(addr *Address, nonce uint64) uint64 {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	nonces := make(map[uint64]bool)
+	for _, tx := range pool.all {
+		if tx.from.Equals(addr) && tx.nonce > nonce {
+			nonces[tx.nonce] = true
+		}
+	}
+	for nonces[nonce+1] {
+		nonce++
+	}
+	return nonce
+}
+
 // Empty return if the pool is empty
 func (pool *TransactionPool) Empty() bool {
 	pool.mu.Lock()
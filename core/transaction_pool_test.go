@@ -27,6 +27,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/crash"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -122,6 +123,8 @@ func TestGasConfig(t *testing.T) {
 	txPool.SetGasConfig(util.NewUint128FromInt(1), util.NewUint128FromInt(1))
 	assert.Equal(t, txPool.gasPrice, util.NewUint128FromInt(1))
 	assert.Equal(t, txPool.gasLimit, util.NewUint128FromInt(1))
+	assert.Equal(t, txPool.GasPrice(), util.NewUint128FromInt(1))
+	assert.Equal(t, txPool.GasLimit(), util.NewUint128FromInt(1))
 }
 
 func TestPushTxs(t *testing.T) {
@@ -155,3 +158,154 @@ func TestPushTxs(t *testing.T) {
 	assert.Equal(t, txPool.push(txs[0]), ErrBelowGasPrice)
 	assert.Equal(t, txPool.push(txs[1]), ErrOutOfGasLimit)
 }
+
+type panicPayload struct{}
+
+func (p panicPayload) ToBytes() ([]byte, error)    { return nil, nil }
+func (p panicPayload) BaseGasCount() *util.Uint128 { return util.NewUint128() }
+func (p panicPayload) Execute(ctx *PayloadContext) (*util.Uint128, error) {
+	panic("vm crashed")
+}
+
+func TestTransactionPool_Panicked(t *testing.T) {
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(3)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+
+	tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	_, err = executePayload(panicPayload{}, NewPayloadContext(block, tx))
+	assert.True(t, crash.IsPanic(err))
+
+	txPool.markPanicked(tx)
+	assert.Equal(t, ErrPanickedTransaction, txPool.Push(tx))
+}
+
+func TestTransactionPool_Stats(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(TxsPerBlock * 2)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+
+	stats := txPool.Stats(TransactionGasPrice)
+	assert.Equal(t, 0, stats.TxCount)
+	assert.Equal(t, GasPriceHistogramBuckets, len(stats.Histogram))
+	assert.Equal(t, TransactionGasPrice, stats.Histogram[0].MinGasPrice)
+	assert.Equal(t, 1, stats.BlocksToInclusion)
+
+	highPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(3).Int))
+	for i := 0; i < TxsPerBlock+1; i++ {
+		gasPrice := TransactionGasPrice
+		if i == 0 {
+			gasPrice = highPrice
+		}
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), uint64(i+1), TxPayloadBinaryType, []byte("data"), gasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, txPool.Push(tx))
+	}
+
+	stats = txPool.Stats(TransactionGasPrice)
+	assert.Equal(t, TxsPerBlock+1, stats.TxCount)
+	assert.True(t, stats.Size > 0)
+	assert.Equal(t, TxsPerBlock, stats.Histogram[0].Count)
+	assert.Equal(t, 1, stats.Histogram[1].Count)
+	assert.Equal(t, 2, stats.BlocksToInclusion)
+	assert.Equal(t, 2, txPool.Stats(highPrice).BlocksToInclusion)
+}
+
+func TestGetAccountNextNonce(t *testing.T) {
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	bc, _ := NewBlockChain(testNeb())
+	next, onchain := bc.GetAccountNextNonce(from)
+	assert.Equal(t, uint64(1), next)
+	assert.Equal(t, uint64(0), onchain)
+
+	push := func(nonce uint64) {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, bc.TransactionPool().Push(tx))
+	}
+	push(1)
+	push(2)
+	push(4)
+	// the tx after the gap is not counted.
+	next, onchain = bc.GetAccountNextNonce(from)
+	assert.Equal(t, uint64(3), next)
+	assert.Equal(t, uint64(0), onchain)
+
+	push(3)
+	next, _ = bc.GetAccountNextNonce(from)
+	assert.Equal(t, uint64(5), next)
+
+	// the txs of other accounts don't count.
+	next, _ = bc.GetAccountNextNonce(mockAddress())
+	assert.Equal(t, uint64(1), next)
+}
+
+func TestTransactionPool_Rejections(t *testing.T) {
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	bc, _ := NewBlockChain(testNeb())
+	pool := bc.TransactionPool()
+	before := bc.PoolStats().TxRejected
+
+	newTx := func(nonce uint64, gasPrice *util.Uint128) *Transaction {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), gasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		return tx
+	}
+	tx := newTx(1, TransactionGasPrice)
+	assert.Nil(t, pool.Push(tx))
+	assert.Equal(t, ErrDuplicatedTransaction, pool.Push(tx))
+	assert.Equal(t, ErrBelowGasPrice, pool.Push(newTx(2, util.NewUint128FromInt(1))))
+
+	// signed by another account than the sender.
+	other, _ := ks.GetUnlocked(mockAddress().String())
+	otherSignature, _ := crypto.NewSignature(keystore.SECP256K1)
+	otherSignature.InitSign(other.(keystore.PrivateKey))
+	forged := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 3, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, forged.Sign(otherSignature))
+	assert.Equal(t, ErrInvalidTransactionSigner, pool.Push(forged))
+
+	// the tx of a nonce already on chain is refused.
+	assert.Equal(t, ErrSmallTransactionNonce, pool.Push(newTx(0, TransactionGasPrice)))
+
+	stats := bc.PoolStats()
+	assert.Equal(t, 1, stats.PendingTxs)
+	for reason, want := range map[string]int64{
+		RejectDuplicate:    1,
+		RejectNonceTooLow:  1,
+		RejectUnderpriced:  1,
+		RejectBadSignature: 1,
+		RejectInvalid:      0,
+	} {
+		assert.Equal(t, want, stats.TxRejected[reason]-before[reason], reason)
+	}
+}
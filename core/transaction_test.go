@@ -363,3 +363,20 @@ func TestTransaction_VerifyExecution(t *testing.T) {
 	}
 
 }
+
+func TestTransaction_FromProtoMalformed(t *testing.T) {
+	msg, err := mockNormalTransaction(1, 1).ToProto()
+	assert.Nil(t, err)
+	pbTx := msg.(*corepb.Transaction)
+
+	var nilTx *corepb.Transaction
+	assert.Equal(t, ErrInvalidProtoToTransaction, new(Transaction).FromProto(nilTx))
+
+	noData := *pbTx
+	noData.Data = nil
+	assert.Equal(t, ErrInvalidProtoToTransaction, new(Transaction).FromProto(&noData))
+
+	shortValue := *pbTx
+	shortValue.Value = shortValue.Value[:8]
+	assert.NotNil(t, new(Transaction).FromProto(&shortValue))
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// txIndexPrefix is the prefix of the keys of the transaction index, which
+// maps the hash of a transaction to the hash of its canonical block followed
+// by its index in the block.
+const txIndexPrefix = "txindex_"
+
+func txIndexKey(hash byteutils.Hash) []byte {
+	return append([]byte(txIndexPrefix), hash...)
+}
+
+// indexTransactions records the transactions of a block joining the
+// canonical chain.
+func (bc *BlockChain) indexTransactions(batch *storage.Batch, block *Block) {
+	for i, tx := range block.transactions {
+		putTxIndex(batch, tx.hash, block.Hash(), uint32(i))
+	}
+}
+
+func putTxIndex(batch *storage.Batch, hash, blockHash byteutils.Hash, index uint32) {
+	value := append(append([]byte{}, blockHash...), byteutils.FromUint32(index)...)
+	batch.Put(txIndexKey(hash), value)
+}
+
+// unindexTransactions removes the transactions of a block reverted from the
+// canonical chain, unless they are indexed in another block.
+func (bc *BlockChain) unindexTransactions(batch *storage.Batch, block *Block) error {
+	for _, tx := range block.transactions {
+		key := txIndexKey(tx.hash)
+		value, err := bc.indexStorage.Get(key)
+		if err == storage.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !byteutils.Equal(value[:len(value)-4], block.Hash()) {
+			continue
+		}
+		batch.Del(key)
+	}
+	return nil
+}
+
+// GetTransactionLocation returns the canonical block of a transaction and
+// its index in the block.
+func (bc *BlockChain) GetTransactionLocation(hash byteutils.Hash) (*Block, uint32, error) {
+	value, err := bc.indexStorage.Get(txIndexKey(hash))
+	if err == storage.ErrKeyNotFound {
+		return nil, 0, ErrTransactionNotIndexed
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(value) <= 4 {
+		return nil, 0, ErrTransactionNotIndexed
+	}
+	blockHash, index := byteutils.Hash(value[:len(value)-4]), byteutils.Uint32(value[len(value)-4:])
+
+	block := bc.GetBlock(blockHash)
+	if block == nil || int(index) >= len(block.transactions) || !block.transactions[index].hash.Equals(hash) {
+		return nil, 0, ErrTransactionNotIndexed
+	}
+	// an entry left by an interrupted reorg isn't trusted.
+	if err := bc.lockHeightIndex(block.height); err != nil {
+		return nil, 0, ErrTransactionNotIndexed
+	}
+	defer bc.heightIndexLock.Unlock()
+	canonical, err := bc.getBlockByHeight(block.height)
+	if err != nil || !canonical.Hash().Equals(blockHash) {
+		return nil, 0, ErrTransactionNotIndexed
+	}
+	return block, index, nil
+}
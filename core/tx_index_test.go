@@ -0,0 +1,101 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionIndex(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2000000).Int))
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(balance)
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+	bc.storeBlockToStorage(bc.tailBlock)
+	genesis := bc.tailBlock
+
+	/*
+		genesis -- 1(tx)
+		        \_ fork1 - fork2
+	*/
+	tx := NewTransaction(bc.ChainID(), from, coinbase, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.Sign(signature)
+	assert.Nil(t, bc.txPool.Push(tx))
+
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(1)
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Equal(t, 1, len(block.transactions))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+	assert.Nil(t, bc.SetTailBlock(block))
+
+	located, index, err := bc.GetTransactionLocation(tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, block.Hash(), located.Hash())
+	assert.Equal(t, uint32(0), index)
+	assert.Equal(t, tx.Hash(), bc.GetTransaction(tx.Hash()).Hash())
+
+	// the index is removed when the block is reverted.
+	parent := genesis
+	for i := 0; i < 2; i++ {
+		fork, _ := bc.NewBlockFromParent(coinbase, parent)
+		fork.header.timestamp = BlockInterval * int64(i+2)
+		fork.CollectTransactions(0)
+		fork.SetMiner(coinbase)
+		fork.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(fork)))
+		parent = fork
+	}
+	assert.Nil(t, bc.SetTailBlock(parent))
+	_, _, err = bc.GetTransactionLocation(tx.Hash())
+	assert.Equal(t, ErrTransactionNotIndexed, err)
+	assert.Nil(t, bc.GetTransaction(tx.Hash()))
+
+	assert.Nil(t, bc.SetTailBlock(block))
+	_, _, err = bc.GetTransactionLocation(tx.Hash())
+	assert.Nil(t, err)
+
+	// a chain indexed before the transaction index is rebuilt by the reindex.
+	assert.Nil(t, bc.indexStorage.Del(txIndexKey(tx.Hash())))
+	_, _, err = bc.GetTransactionLocation(tx.Hash())
+	assert.Equal(t, ErrTransactionNotIndexed, err)
+	assert.Nil(t, ReindexHeight(bc, nil))
+	located, _, err = bc.GetTransactionLocation(tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, block.Hash(), located.Hash())
+}
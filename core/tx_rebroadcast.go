@@ -0,0 +1,205 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// RebroadcastInterval is the interval the local txs not on chain are broadcast again.
+	RebroadcastInterval = 30 * time.Second
+
+	// LocalTxExpiry is how long a local tx is rebroadcast before it is given up.
+	LocalTxExpiry = 3 * time.Hour
+
+	// LocalTxStatusCacheSize is the number of the statuses of the local txs
+	// confirmed or expired kept for queries.
+	LocalTxStatusCacheSize = 1024
+
+	// MaxLocalTxs is the max number of the local txs rebroadcast at once, the
+	// ones submitted beyond are broadcast once.
+	MaxLocalTxs = 4096
+)
+
+// Broadcast statuses of a local tx.
+const (
+	BroadcastPending   = "pending"
+	BroadcastConfirmed = "confirmed"
+	BroadcastExpired   = "expired"
+)
+
+var (
+	rebroadcastTxCounter  = metrics.GetOrRegisterCounter("txpool_rebroadcast", nil)
+	expiredLocalTxCounter = metrics.GetOrRegisterCounter("txpool_local_expired", nil)
+	localTxGauge          = metrics.GetOrRegisterGauge("txpool_local_pending", nil)
+)
+
+// BroadcastStatus is the broadcast status of a tx submitted to the node.
+type BroadcastStatus struct {
+	Hash          byteutils.Hash
+	Status        string
+	Submitted     time.Time
+	LastBroadcast time.Time
+	Broadcasts    int
+	// BlockHeight is the height of the block including the confirmed tx.
+	BlockHeight uint64
+}
+
+// localTxs tracks the txs signed by the node or submitted by its admin, which
+// are rebroadcast until they are on the canonical chain, since the first
+// broadcast may be lost.
+type localTxs struct {
+	mu      sync.Mutex
+	pending map[byteutils.HexHash]*localTx
+	done    *lru.Cache
+}
+
+type localTx struct {
+	tx     *Transaction
+	status BroadcastStatus
+}
+
+func newLocalTxs() *localTxs {
+	done, _ := lru.New(LocalTxStatusCacheSize)
+	return &localTxs{
+		pending: make(map[byteutils.HexHash]*localTx),
+		done:    done,
+	}
+}
+
+// track returns false if too many local txs are pending.
+func (l *localTxs) track(tx *Transaction, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.pending[tx.hash.Hex()]; ok {
+		return true
+	}
+	if len(l.pending) >= MaxLocalTxs {
+		return false
+	}
+	l.pending[tx.hash.Hex()] = &localTx{
+		tx: tx,
+		status: BroadcastStatus{
+			Hash:          tx.hash,
+			Status:        BroadcastPending,
+			Submitted:     now,
+			LastBroadcast: now,
+			Broadcasts:    1,
+		},
+	}
+	localTxGauge.Update(int64(len(l.pending)))
+	return true
+}
+
+func (l *localTxs) list() []*localTx {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	txs := make([]*localTx, 0, len(l.pending))
+	for _, v := range l.pending {
+		txs = append(txs, v)
+	}
+	return txs
+}
+
+func (l *localTxs) broadcasted(v *localTx, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	v.status.LastBroadcast = now
+	v.status.Broadcasts++
+}
+
+func (l *localTxs) finish(v *localTx, status string, height uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	v.status.Status = status
+	v.status.BlockHeight = height
+	delete(l.pending, v.tx.hash.Hex())
+	l.done.Add(v.tx.hash.Hex(), v.status)
+	localTxGauge.Update(int64(len(l.pending)))
+}
+
+func (l *localTxs) get(hash byteutils.Hash) (*BroadcastStatus, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if v, ok := l.pending[hash.Hex()]; ok {
+		status := v.status
+		return &status, true
+	}
+	if v, ok := l.done.Get(hash.Hex()); ok {
+		status := v.(BroadcastStatus)
+		return &status, true
+	}
+	return nil, false
+}
+
+// BroadcastStatus returns the broadcast status of a tx submitted to the node.
+func (pool *TransactionPool) BroadcastStatus(hash byteutils.Hash) (*BroadcastStatus, error) {
+	status, ok := pool.local.get(hash)
+	if !ok {
+		return nil, ErrTransactionNotTracked
+	}
+	return status, nil
+}
+
+// rebroadcast broadcasts again the local txs not on the canonical chain for
+// a RebroadcastInterval, and stops tracking the ones confirmed or expired.
+func (pool *TransactionPool) rebroadcast(now time.Time) {
+	for _, v := range pool.local.list() {
+		if block, _, err := pool.bc.GetTransactionLocation(v.tx.hash); err == nil {
+			pool.local.finish(v, BroadcastConfirmed, block.height)
+			continue
+		}
+		if now.Sub(v.status.Submitted) > LocalTxExpiry {
+			logging.VLog().WithFields(logrus.Fields{
+				"tx":         v.tx,
+				"broadcasts": v.status.Broadcasts,
+			}).Warn("Give up rebroadcasting an expired local tx.")
+			pool.local.finish(v, BroadcastExpired, 0)
+			expiredLocalTxCounter.Inc(1)
+			continue
+		}
+		if now.Sub(v.status.LastBroadcast) < RebroadcastInterval {
+			continue
+		}
+
+		// the tx left the pool in a block which was reverted is pushed again.
+		if err := pool.Push(v.tx); err != nil && err != ErrDuplicatedTransaction {
+			logging.VLog().WithFields(logrus.Fields{
+				"tx":  v.tx,
+				"err": err,
+			}).Debug("Failed to push a local tx again.")
+		}
+		pool.send(v.tx)
+		pool.local.broadcasted(v, now)
+		rebroadcastTxCounter.Inc(1)
+	}
+}
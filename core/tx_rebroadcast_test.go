@@ -0,0 +1,112 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebroadcast(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	var n MockNetManager
+	bc.txPool.RegisterInNetwork(n)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(priv)
+	bc.tailBlock.begin()
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2000000).Int))
+	bc.tailBlock.accState.GetOrCreateUserAccount(from.Bytes()).AddBalance(balance)
+	bc.tailBlock.header.stateRoot = bc.tailBlock.accState.RootHash()
+	bc.tailBlock.commit()
+	bc.storeBlockToStorage(bc.tailBlock)
+
+	tx1 := NewTransaction(bc.ChainID(), from, coinbase, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx1.Sign(signature)
+	tx2 := NewTransaction(bc.ChainID(), from, coinbase, util.NewUint128FromInt(1), 3, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx2.Sign(signature)
+
+	_, err := bc.txPool.BroadcastStatus(tx1.Hash())
+	assert.Equal(t, ErrTransactionNotTracked, err)
+	assert.Nil(t, bc.txPool.PushAndBroadcastLocal(context.Background(), tx1))
+	assert.Nil(t, bc.txPool.PushAndBroadcastLocal(context.Background(), tx2))
+	status, err := bc.txPool.BroadcastStatus(tx1.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, BroadcastPending, status.Status)
+	assert.Equal(t, 1, status.Broadcasts)
+
+	// not broadcast again before the interval.
+	submitted := status.Submitted
+	bc.txPool.rebroadcast(submitted.Add(time.Second))
+	status, _ = bc.txPool.BroadcastStatus(tx1.Hash())
+	assert.Equal(t, 1, status.Broadcasts)
+
+	// the tx is pushed again once packed in a block not on chain.
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(1)
+	assert.Equal(t, 1, len(block.transactions))
+	bc.txPool.rebroadcast(submitted.Add(RebroadcastInterval))
+	status, _ = bc.txPool.BroadcastStatus(tx1.Hash())
+	assert.Equal(t, BroadcastPending, status.Status)
+	assert.Equal(t, 2, status.Broadcasts)
+	assert.NotNil(t, bc.txPool.all[tx1.Hash().Hex()])
+
+	block.SetMiner(coinbase)
+	block.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+	assert.Nil(t, bc.SetTailBlock(block))
+	bc.txPool.rebroadcast(submitted.Add(RebroadcastInterval * 2))
+	status, _ = bc.txPool.BroadcastStatus(tx1.Hash())
+	assert.Equal(t, BroadcastConfirmed, status.Status)
+	assert.Equal(t, block.Height(), status.BlockHeight)
+	assert.Equal(t, 2, status.Broadcasts)
+
+	// the tx with a nonce gap never makes it into a block.
+	bc.txPool.rebroadcast(submitted.Add(LocalTxExpiry + time.Second))
+	status, _ = bc.txPool.BroadcastStatus(tx2.Hash())
+	assert.Equal(t, BroadcastExpired, status.Status)
+	assert.Equal(t, 0, len(bc.txPool.local.list()))
+}
+
+func TestLocalTxs_Limit(t *testing.T) {
+	local := newLocalTxs()
+	now := time.Now()
+	for i := 0; i < MaxLocalTxs; i++ {
+		tx := &Transaction{hash: byteutils.FromUint64(uint64(i))}
+		assert.True(t, local.track(tx, now))
+	}
+	assert.True(t, local.track(&Transaction{hash: byteutils.FromUint64(0)}, now))
+	assert.False(t, local.track(&Transaction{hash: byteutils.FromUint64(MaxLocalTxs)}, now))
+	assert.Equal(t, MaxLocalTxs, len(local.list()))
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// MinRelayTick is the interval the delayed txs are checked when they are not batched.
+const MinRelayTick = 100 * time.Millisecond
+
+var relayedTxCounter = metrics.GetOrRegisterCounter("txpool_relayed", nil)
+
+// TxRelayConfig is the gossip policy of the txs sent by the pool.
+type TxRelayConfig struct {
+	// Fanout is the number of random peers a tx is sent to, 0 for all peers.
+	Fanout int
+	// BatchInterval is the interval the queued txs are sent, 0 sends them at once.
+	BatchInterval time.Duration
+	// MaxDelay is the max random delay before a tx is sent, so that the first
+	// peers seeing it can't tell it came from this node.
+	MaxDelay time.Duration
+}
+
+// DefaultTxRelayConfig sends the txs to all peers at once.
+func DefaultTxRelayConfig() *TxRelayConfig {
+	return &TxRelayConfig{}
+}
+
+type relayItem struct {
+	tx  *Transaction
+	due time.Time
+}
+
+// txRelay queues the txs to send until their delay is over.
+type txRelay struct {
+	mu    sync.Mutex
+	conf  *TxRelayConfig
+	queue []*relayItem
+}
+
+func newTxRelay(conf *TxRelayConfig) *txRelay {
+	return &txRelay{conf: conf}
+}
+
+// interval is the interval the queue is flushed, 0 if the txs are not queued.
+func (r *txRelay) interval() time.Duration {
+	if r.conf.BatchInterval > 0 {
+		return r.conf.BatchInterval
+	}
+	if r.conf.MaxDelay > 0 {
+		return MinRelayTick
+	}
+	return 0
+}
+
+func (r *txRelay) enqueue(tx *Transaction, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	due := now
+	if r.conf.MaxDelay > 0 {
+		due = due.Add(time.Duration(rand.Int63n(int64(r.conf.MaxDelay) + 1)))
+	}
+	r.queue = append(r.queue, &relayItem{tx: tx, due: due})
+}
+
+// due removes and returns the txs whose delay is over.
+func (r *txRelay) due(now time.Time) []*Transaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var txs []*Transaction
+	queue := r.queue[:0]
+	for _, v := range r.queue {
+		if v.due.After(now) {
+			queue = append(queue, v)
+		} else {
+			txs = append(txs, v.tx)
+		}
+	}
+	r.queue = queue
+	return txs
+}
+
+// SetRelayConfig sets the gossip policy of the txs, before the pool starts.
+func (pool *TransactionPool) SetRelayConfig(conf *TxRelayConfig) {
+	pool.relayer = newTxRelay(conf)
+}
+
+// relay sends the tx to the peers, after a random delay if configured.
+func (pool *TransactionPool) relay(tx *Transaction) {
+	if pool.relayer.interval() == 0 {
+		pool.send(tx)
+		return
+	}
+	pool.relayer.enqueue(tx, time.Now())
+}
+
+func (pool *TransactionPool) flushRelay(now time.Time) {
+	for _, tx := range pool.relayer.due(now) {
+		pool.send(tx)
+	}
+}
+
+// send sends the tx to Fanout random peers, or all peers, the private peers
+// of a sentry or a validator always get it.
+func (pool *TransactionPool) send(tx *Transaction) {
+	relayedTxCounter.Inc(1)
+	node := pool.nm.Node()
+	if pool.relayer.conf.Fanout <= 0 || node == nil {
+		pool.nm.Relay(MessageTypeNewTx, tx)
+		return
+	}
+	if node.GetSynchronizing() {
+		return
+	}
+
+	var peers, targets []string
+	node.GetStream().Range(func(key, value interface{}) bool {
+		if node.IsPrivatePeer(key.(string)) {
+			targets = append(targets, key.(string))
+		} else {
+			peers = append(peers, key.(string))
+		}
+		return true
+	})
+	if len(peers) <= pool.relayer.conf.Fanout {
+		pool.nm.Relay(MessageTypeNewTx, tx)
+		return
+	}
+
+	pbTx, err := tx.ToProto()
+	if err != nil {
+		return
+	}
+	data, err := proto.Marshal(pbTx)
+	if err != nil {
+		return
+	}
+	for _, i := range rand.Perm(len(peers))[:pool.relayer.conf.Fanout] {
+		targets = append(targets, peers[i])
+	}
+	for _, peer := range targets {
+		if err := pool.nm.SendMsg(MessageTypeNewTx, data, peer); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"tx":   tx,
+				"peer": peer,
+				"err":  err,
+			}).Debug("Failed to send a tx to a peer.")
+		}
+	}
+}
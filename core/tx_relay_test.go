@@ -0,0 +1,53 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxRelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), newTxRelay(DefaultTxRelayConfig()).interval())
+	assert.Equal(t, MinRelayTick, newTxRelay(&TxRelayConfig{MaxDelay: time.Second}).interval())
+	assert.Equal(t, time.Second, newTxRelay(&TxRelayConfig{BatchInterval: time.Second, MaxDelay: time.Minute}).interval())
+
+	// batched txs are sent at the next flush.
+	relay := newTxRelay(&TxRelayConfig{BatchInterval: time.Second})
+	now := time.Now()
+	tx1, tx2 := mockTransaction(0, 1, TxPayloadBinaryType, nil), mockTransaction(0, 2, TxPayloadBinaryType, nil)
+	relay.enqueue(tx1, now)
+	relay.enqueue(tx2, now)
+	assert.Equal(t, []*Transaction{tx1, tx2}, relay.due(now))
+	assert.Equal(t, 0, len(relay.due(now)))
+
+	// delayed txs are sent once their delay is over.
+	relay = newTxRelay(&TxRelayConfig{MaxDelay: time.Second})
+	for i := 0; i < 10; i++ {
+		relay.enqueue(mockTransaction(0, 1, TxPayloadBinaryType, nil), now)
+	}
+	for _, v := range relay.queue {
+		assert.False(t, v.due.Before(now))
+		assert.False(t, v.due.After(now.Add(time.Second)))
+	}
+	assert.Equal(t, 10, len(relay.due(now.Add(time.Second))))
+	assert.Equal(t, 0, len(relay.queue))
+}
@@ -19,12 +19,12 @@
 package core
 
 import (
-	"errors"
 	"strconv"
 
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/errcode"
 )
 
 // Payload Types
@@ -34,60 +34,133 @@ const (
 	TxPayloadCallType      = "call"
 	TxPayloadDelegateType  = "delegate"
 	TxPayloadCandidateType = "candidate"
+	TxPayloadAnchorType    = "anchor"
+	TxPayloadLibraryType   = "library"
+	TxPayloadPauseType     = "pause"
 )
 
-// Error Types
+// Error Types, the codes are exposed to clients and must not be changed.
 var (
-	ErrInvalidTxPayloadType                              = errors.New("invalid transaction data payload type")
-	ErrInvalidBlockCannotFindParentInLocal               = errors.New("invalid block received, download its parent from others")
-	ErrCannotFindBlockAtGivenHeight                      = errors.New("cannot find a block at given height which is less than tail block's height")
-	ErrLinkToWrongParentBlock                            = errors.New("link the block to a block who is not its parent")
-	ErrInvalidContractAddress                            = errors.New("invalid contract address")
-	ErrInsufficientBalance                               = errors.New("insufficient balance")
-	ErrBelowGasPrice                                     = errors.New("below the gas price")
-	ErrOutOfGasLimit                                     = errors.New("out of gas limit")
-	ErrTxExecutionFailed                                 = errors.New("transaction execution failed")
-	ErrInvalidSignature                                  = errors.New("invalid transaction signature")
-	ErrInvalidTransactionHash                            = errors.New("invalid transaction hash")
-	ErrMissingParentBlock                                = errors.New("cannot find the block's parent block in storage")
-	ErrTooFewCandidates                                  = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
-	ErrNotBlockForgTime                                  = errors.New("now is not time to forg block")
-	ErrInvalidBlockHash                                  = errors.New("invalid block hash")
-	ErrInvalidBlockStateRoot                             = errors.New("invalid block state root hash")
-	ErrInvalidBlockTxsRoot                               = errors.New("invalid block txs root hash")
-	ErrInvalidBlockEventsRoot                            = errors.New("invalid block events root hash")
-	ErrInvalidBlockDposContextRoot                       = errors.New("invalid block dpos context root hash")
-	ErrInvalidChainID                                    = errors.New("invalid transaction chainID")
-	ErrDuplicatedTransaction                             = errors.New("duplicated transaction")
-	ErrSmallTransactionNonce                             = errors.New("cannot accept a transaction with smaller nonce")
-	ErrLargeTransactionNonce                             = errors.New("cannot accept a transaction with too bigger nonce")
-	ErrDuplicatedBlock                                   = errors.New("duplicated block")
-	ErrDoubleBlockMinted                                 = errors.New("double block minted")
-	ErrInvalidAddress                                    = errors.New("address: invalid address")
-	ErrInvalidAddressDataLength                          = errors.New("address: invalid address data length")
-	ErrDoubleSealBlock                                   = errors.New("cannot seal a block twice")
-	ErrInvalidCandidatePayloadAction                     = errors.New("invalid transaction candidate payload action")
-	ErrInvalidDelegatePayloadAction                      = errors.New("invalid transaction vote payload action")
-	ErrInvalidDelegateToNonCandidate                     = errors.New("cannot delegate to non-candidate")
-	ErrInvalidUnDelegateFromNonDelegatee                 = errors.New("cannot un-delegate from non-delegatee")
-	ErrInvalidBaseAndNextDynastyID                       = errors.New("cannot kickout from baseDynastyID to nextDynastyID if nextDynastyID <= baseDynastyID")
-	ErrInitialDynastyNotEnough                           = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
-	ErrInvalidTransactionSigner                          = errors.New("transaction recover public key address not equal to from")
-	ErrNotBlockInCanonicalChain                          = errors.New("cannot find the block in canonical chain")
-	ErrCloneAccountState                                 = errors.New("Failed to clone account state")
-	ErrCloneTxsState                                     = errors.New("Failed to clone txs state")
-	ErrCloneDynastyTrie                                  = errors.New("Failed to clone dynasty trie")
-	ErrCloneNextDynastyTrie                              = errors.New("Failed to clone next dynasty trie")
-	ErrCloneDelegateTrie                                 = errors.New("Failed to clone delegate trie")
-	ErrCloneCandidatesTrie                               = errors.New("Failed to clone candidates trie")
-	ErrCloneVoteTrie                                     = errors.New("Failed to clone vote trie")
-	ErrCloneMintCntTrie                                  = errors.New("Failed to clone mint count trie")
-	ErrCloneEventsState                                  = errors.New("Failed to clone events state")
-	ErrGenerateNextDynastyContext                        = errors.New("Failed to generate next dynasty context")
-	ErrLoadNextDynastyContext                            = errors.New("Failed to load next dynasty context")
-	ErrGenesisConfNotMatch                               = errors.New("Failed to load genesis from sotrage, different with genesis conf")
-	ErrInvalidBlockCannotFindParentInLocalAndTryDownload = errors.New("invalid block received, download its parent from others")
-	ErrInvalidBlockCannotFindParentInLocalAndTrySync     = errors.New("invalid block received, sync its parent from others")
+	ErrInvalidTxPayloadType                              = errcode.New(errcode.ModuleCore, 1001, "invalid transaction data payload type", false)
+	ErrInvalidBlockCannotFindParentInLocal               = errcode.New(errcode.ModuleCore, 1002, "invalid block received, download its parent from others", true)
+	ErrCannotFindBlockAtGivenHeight                      = errcode.New(errcode.ModuleCore, 1003, "cannot find a block at given height which is less than tail block's height", true)
+	ErrLinkToWrongParentBlock                            = errcode.New(errcode.ModuleCore, 1004, "link the block to a block who is not its parent", false)
+	ErrInvalidContractAddress                            = errcode.New(errcode.ModuleCore, 1005, "invalid contract address", false)
+	ErrInsufficientBalance                               = errcode.New(errcode.ModuleCore, 1006, "insufficient balance", false)
+	ErrBelowGasPrice                                     = errcode.New(errcode.ModuleCore, 1007, "below the gas price", false)
+	ErrOutOfGasLimit                                     = errcode.New(errcode.ModuleCore, 1008, "out of gas limit", false)
+	ErrTxExecutionFailed                                 = errcode.New(errcode.ModuleCore, 1009, "transaction execution failed", false)
+	ErrInvalidSignature                                  = errcode.New(errcode.ModuleCore, 1010, "invalid transaction signature", false)
+	ErrInvalidTransactionHash                            = errcode.New(errcode.ModuleCore, 1011, "invalid transaction hash", false)
+	ErrMissingParentBlock                                = errcode.New(errcode.ModuleCore, 1012, "cannot find the block's parent block in storage", true)
+	ErrTooFewCandidates                                  = errcode.New(errcode.ModuleCore, 1013, "the size of candidates in consensus is un-safe, should be greater than or equal "+strconv.Itoa(SafeSize), false)
+	ErrNotBlockForgTime                                  = errcode.New(errcode.ModuleCore, 1014, "now is not time to forg block", true)
+	ErrInvalidBlockHash                                  = errcode.New(errcode.ModuleCore, 1015, "invalid block hash", false)
+	ErrInvalidBlockStateRoot                             = errcode.New(errcode.ModuleCore, 1016, "invalid block state root hash", false)
+	ErrInvalidBlockTxsRoot                               = errcode.New(errcode.ModuleCore, 1017, "invalid block txs root hash", false)
+	ErrInvalidBlockEventsRoot                            = errcode.New(errcode.ModuleCore, 1018, "invalid block events root hash", false)
+	ErrInvalidBlockDposContextRoot                       = errcode.New(errcode.ModuleCore, 1019, "invalid block dpos context root hash", false)
+	ErrInvalidChainID                                    = errcode.New(errcode.ModuleCore, 1020, "invalid transaction chainID", false)
+	ErrDuplicatedTransaction                             = errcode.New(errcode.ModuleCore, 1021, "duplicated transaction", false)
+	ErrSmallTransactionNonce                             = errcode.New(errcode.ModuleCore, 1022, "cannot accept a transaction with smaller nonce", false)
+	ErrLargeTransactionNonce                             = errcode.New(errcode.ModuleCore, 1023, "cannot accept a transaction with too bigger nonce", true)
+	ErrDuplicatedBlock                                   = errcode.New(errcode.ModuleCore, 1024, "duplicated block", false)
+	ErrDoubleBlockMinted                                 = errcode.New(errcode.ModuleCore, 1025, "double block minted", false)
+	ErrInvalidAddress                                    = errcode.New(errcode.ModuleCore, 1026, "address: invalid address", false)
+	ErrInvalidAddressDataLength                          = errcode.New(errcode.ModuleCore, 1027, "address: invalid address data length", false)
+	ErrDoubleSealBlock                                   = errcode.New(errcode.ModuleCore, 1028, "cannot seal a block twice", false)
+	ErrInvalidCandidatePayloadAction                     = errcode.New(errcode.ModuleCore, 1029, "invalid transaction candidate payload action", false)
+	ErrInvalidDelegatePayloadAction                      = errcode.New(errcode.ModuleCore, 1030, "invalid transaction vote payload action", false)
+	ErrInvalidDelegateToNonCandidate                     = errcode.New(errcode.ModuleCore, 1031, "cannot delegate to non-candidate", false)
+	ErrInvalidUnDelegateFromNonDelegatee                 = errcode.New(errcode.ModuleCore, 1032, "cannot un-delegate from non-delegatee", false)
+	ErrInvalidBaseAndNextDynastyID                       = errcode.New(errcode.ModuleCore, 1033, "cannot kickout from baseDynastyID to nextDynastyID if nextDynastyID <= baseDynastyID", false)
+	ErrInitialDynastyNotEnough                           = errcode.New(errcode.ModuleCore, 1034, "the size of initial dynasty in genesis block is un-safe, should be greater than or equal "+strconv.Itoa(SafeSize), false)
+	ErrInvalidTransactionSigner                          = errcode.New(errcode.ModuleCore, 1035, "transaction recover public key address not equal to from", false)
+	ErrNotBlockInCanonicalChain                          = errcode.New(errcode.ModuleCore, 1036, "cannot find the block in canonical chain", true)
+	ErrCloneAccountState                                 = errcode.New(errcode.ModuleCore, 1037, "Failed to clone account state", false)
+	ErrCloneTxsState                                     = errcode.New(errcode.ModuleCore, 1038, "Failed to clone txs state", false)
+	ErrCloneDynastyTrie                                  = errcode.New(errcode.ModuleCore, 1039, "Failed to clone dynasty trie", false)
+	ErrCloneNextDynastyTrie                              = errcode.New(errcode.ModuleCore, 1040, "Failed to clone next dynasty trie", false)
+	ErrCloneDelegateTrie                                 = errcode.New(errcode.ModuleCore, 1041, "Failed to clone delegate trie", false)
+	ErrCloneCandidatesTrie                               = errcode.New(errcode.ModuleCore, 1042, "Failed to clone candidates trie", false)
+	ErrCloneVoteTrie                                     = errcode.New(errcode.ModuleCore, 1043, "Failed to clone vote trie", false)
+	ErrCloneMintCntTrie                                  = errcode.New(errcode.ModuleCore, 1044, "Failed to clone mint count trie", false)
+	ErrCloneEventsState                                  = errcode.New(errcode.ModuleCore, 1045, "Failed to clone events state", false)
+	ErrGenerateNextDynastyContext                        = errcode.New(errcode.ModuleCore, 1046, "Failed to generate next dynasty context", false)
+	ErrLoadNextDynastyContext                            = errcode.New(errcode.ModuleCore, 1047, "Failed to load next dynasty context", false)
+	ErrGenesisConfNotMatch                               = errcode.New(errcode.ModuleCore, 1048, "Failed to load genesis from sotrage, different with genesis conf", false)
+	ErrInvalidBlockCannotFindParentInLocalAndTryDownload = errcode.New(errcode.ModuleCore, 1049, "invalid block received, download its parent from others", true)
+	ErrInvalidBlockCannotFindParentInLocalAndTrySync     = errcode.New(errcode.ModuleCore, 1050, "invalid block received, sync its parent from others", true)
+	ErrInvalidProtoToBlock                               = errcode.New(errcode.ModuleCore, 1051, "protobuf message cannot be converted into Block", false)
+	ErrInvalidProtoToBlockHeader                         = errcode.New(errcode.ModuleCore, 1052, "protobuf message cannot be converted into BlockHeader", false)
+	ErrInvalidProtoToTransaction                         = errcode.New(errcode.ModuleCore, 1053, "protobuf message cannot be converted into Transaction", false)
+	ErrBlockPoolPaused                                   = errcode.New(errcode.ModuleCore, 1054, "block pool is paused", true)
+	ErrInvalidReplayRange                                = errcode.New(errcode.ModuleCore, 1055, "invalid block range to replay", false)
+	ErrPanickedTransaction                               = errcode.New(errcode.ModuleCore, 1056, "transaction panicked in execution before", false)
+	ErrInvalidDropPolicy                                 = errcode.New(errcode.ModuleCore, 1057, "invalid drop policy of event subscribers", false)
+	ErrFilterNotFound                                    = errcode.New(errcode.ModuleCore, 1058, "filter not found", false)
+	ErrInvalidFilterRange                                = errcode.New(errcode.ModuleCore, 1059, "invalid block range of filter", false)
+	ErrNotContractAccount                                = errcode.New(errcode.ModuleCore, 1060, "account is not a contract", false)
+	ErrInvalidStorageProof                               = errcode.New(errcode.ModuleCore, 1061, "invalid proof of contract storage", false)
+	ErrInvalidWitness                                    = errcode.New(errcode.ModuleCore, 1062, "invalid execution witness of block", false)
+	ErrInvalidAnchorPayload                              = errcode.New(errcode.ModuleCore, 1063, "invalid anchor payload", false)
+	ErrNotAnchorOperator                                 = errcode.New(errcode.ModuleCore, 1064, "sender is not the anchor operator of the child chain", false)
+	ErrStaleAnchor                                       = errcode.New(errcode.ModuleCore, 1065, "anchor height is not above the latest one of the child chain", false)
+	ErrAnchorNotFound                                    = errcode.New(errcode.ModuleCore, 1066, "anchor not found", false)
+	ErrInvalidAnchorProof                                = errcode.New(errcode.ModuleCore, 1067, "invalid proof of anchor or exit", false)
+	ErrInvalidBlockAnchorsRoot                           = errcode.New(errcode.ModuleCore, 1068, "invalid block anchors root hash", false)
+	ErrInvalidMessage                                    = errcode.New(errcode.ModuleCore, 1069, "invalid message sent by contract", false)
+	ErrInvalidLibraryPayload                             = errcode.New(errcode.ModuleCore, 1070, "invalid library payload", false)
+	ErrNotLibraryOwner                                   = errcode.New(errcode.ModuleCore, 1071, "sender is not the owner of the library", false)
+	ErrLibraryVersionExists                              = errcode.New(errcode.ModuleCore, 1072, "library version already deployed", false)
+	ErrLibraryNotFound                                   = errcode.New(errcode.ModuleCore, 1073, "library not found", false)
+	ErrInvalidPausePayload                               = errcode.New(errcode.ModuleCore, 1074, "invalid pause payload", false)
+	ErrNotPauseVoter                                     = errcode.New(errcode.ModuleCore, 1075, "sender is not a member of the dynasty", false)
+	ErrDuplicatedPauseVote                               = errcode.New(errcode.ModuleCore, 1076, "sender already voted to pause the contract", false)
+	ErrContractPaused                                    = errcode.New(errcode.ModuleCore, 1077, "contract is paused", false)
+	ErrTooManyWatchedAddresses                           = errcode.New(errcode.ModuleCore, 1078, "too many watched addresses", false)
+	ErrInvalidDepositCount                               = errcode.New(errcode.ModuleCore, 1079, "invalid count of deposit addresses", false)
+	ErrDepositKeyNotFound                                = errcode.New(errcode.ModuleCore, 1080, "extended public key of deposits not found", false)
+	ErrInvalidTail                                       = errcode.New(errcode.ModuleCore, 1081, "tail block inconsistent with storage", false)
+	ErrInvalidStateDiffRange                             = errcode.New(errcode.ModuleCore, 1082, "invalid block range of state diff", false)
+	ErrInvalidFeeBurn                                    = errcode.New(errcode.ModuleCore, 1083, "fee burn must be at most 100 percent and not before the supply fork", false)
+	ErrUnknownCriticalHeaderField                        = errcode.New(errcode.ModuleCore, 1084, "block header has an unknown critical field", false)
+	ErrUnsupportedBlockHeaderVersion                     = errcode.New(errcode.ModuleCore, 1085, "block header version is newer than supported", false)
+	ErrInvalidBlockHeaderVersion                         = errcode.New(errcode.ModuleCore, 1086, "block header version not scheduled at the height", false)
+	ErrEstimatePoolBusy                                  = errcode.New(errcode.ModuleCore, 1087, "too many gas estimations, retry later", true)
+	ErrInvalidHeaderRange                                = errcode.New(errcode.ModuleCore, 1088, "invalid header range", false)
+	ErrInvalidHeaderProof                                = errcode.New(errcode.ModuleCore, 1089, "invalid header proof", false)
+	ErrReorgTooDeep                                      = errcode.New(errcode.ModuleCore, 1090, "fork reverts more blocks than the max reorg depth", false)
+	ErrBlockBelowCheckpoint                              = errcode.New(errcode.ModuleCore, 1091, "block branches from below the checkpoint", false)
+	ErrTransactionNotIndexed                             = errcode.New(errcode.ModuleCore, 1092, "transaction not found in the index", false)
+	ErrInvalidBlockRange                                 = errcode.New(errcode.ModuleCore, 1093, "invalid block height range", false)
+	ErrInvalidChainExport                                = errcode.New(errcode.ModuleCore, 1094, "invalid chain export", false)
+	ErrUnsupportedExportVersion                          = errcode.New(errcode.ModuleCore, 1095, "unsupported chain export version", false)
+	ErrExportGenesisMismatch                             = errcode.New(errcode.ModuleCore, 1096, "chain export has another genesis", false)
+	ErrTransactionNotTracked                             = errcode.New(errcode.ModuleCore, 1097, "transaction not submitted to this node", false)
+	ErrStatePruned                                       = errcode.New(errcode.ModuleCore, 1098, "state of block pruned", false)
+	ErrPruneIntervalChanged                              = errcode.New(errcode.ModuleCore, 1099, "checkpoint interval of pruned state changed", false)
+	ErrInvalidPrunedIndex                                = errcode.New(errcode.ModuleCore, 1100, "invalid pruned state index", false)
+	ErrReceiptNotFound                                   = errcode.New(errcode.ModuleCore, 1101, "transaction receipt not found", false)
+	ErrRevertIrreversibleBlock                           = errcode.New(errcode.ModuleCore, 1102, "fork reverts the latest irreversible block", false)
+	ErrInvalidBloom                                      = errcode.New(errcode.ModuleCore, 1103, "invalid block bloom", false)
+	ErrInvalidAnalyticsRange                             = errcode.New(errcode.ModuleCore, 1104, "invalid analytics date range", false)
+	ErrAnalyticsDisabled                                 = errcode.New(errcode.ModuleCore, 1105, "analytics not enabled", false)
+	ErrInvalidSnapshotSignature                          = errcode.New(errcode.ModuleCore, 1106, "snapshot not signed by the configured signer", false)
+	ErrSnapshotMismatch                                  = errcode.New(errcode.ModuleCore, 1107, "snapshot inconsistent with its attestation or the checkpoints", false)
+	ErrInvalidBlockGasUsed                               = errcode.New(errcode.ModuleCore, 1108, "invalid block gas used", false)
+	ErrInvalidContractABI                                = errcode.New(errcode.ModuleCore, 1109, "invalid contract abi", false)
+	ErrInvalidBlockGasLimit                              = errcode.New(errcode.ModuleCore, 1110, "invalid block gas limit", false)
+	ErrBlockGasLimitExceeded                             = errcode.New(errcode.ModuleCore, 1111, "block gas limit exceeded", false)
+	ErrInvalidBalanceProofRange                          = errcode.New(errcode.ModuleCore, 1112, "invalid balance proof range", false)
+	ErrInvalidBalanceProof                               = errcode.New(errcode.ModuleCore, 1113, "invalid balance proof", false)
+	ErrCloneUnsealedBlock                                = errcode.New(errcode.ModuleCore, 1114, "cannot clone an unsealed block", false)
+	ErrHeightIndexNotVerified                            = errcode.New(errcode.ModuleCore, 1115, "height index isn't verified at given height", true)
+	ErrMessageNotActive                                  = errcode.New(errcode.ModuleCore, 1116, "messages aren't active at the block height", false)
+	ErrAnchorOperatorNotRegistered                       = errcode.New(errcode.ModuleCore, 1117, "anchor operator of the child chain is not registered", false)
+	ErrNotAnchorRegistrar                                = errcode.New(errcode.ModuleCore, 1118, "sender is not in the dynasty to register the anchor operator", false)
+	ErrTooManyFilters                                    = errcode.New(errcode.ModuleCore, 1119, "too many filters installed", false)
+	ErrInvalidBlockHeight                                = errcode.New(errcode.ModuleCore, 1120, "block height is below the genesis block", false)
 )
 
 // Default gas count
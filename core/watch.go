@@ -0,0 +1,431 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// MaxWatchedAddresses the max number of addresses in the watch list.
+	MaxWatchedAddresses = 100000
+
+	// WatchWebhookTimeout the timeout of posting an activity to the webhook.
+	WatchWebhookTimeout = 5 * time.Second
+
+	// WatchWebhookWorkers the number of workers posting the activities.
+	WatchWebhookWorkers = 4
+
+	// WatchWebhookQueueSize the max number of activities waiting to be
+	// posted, the ones beyond are dropped.
+	WatchWebhookQueueSize = 1024
+)
+
+// the addresses are kept in numbered slots, an address is added or removed by
+// writing a few keys whatever the size of the list.
+var watchListCountKey = []byte("watchlist.count")
+
+func watchListSlotKey(slot int) []byte {
+	return []byte("watchlist.slot." + strconv.Itoa(slot))
+}
+
+func watchedAddressKey(addr string) []byte {
+	return []byte("watchlist.address." + addr)
+}
+
+// WatchedAddress is the state of an address in the watch list, the counts
+// are the activity since the address was added.
+type WatchedAddress struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+	Nonce   uint64 `json:"nonce"`
+	TxCount uint64 `json:"tx_count"`
+	// Height is the height of the last block with transactions of the address.
+	Height uint64 `json:"height"`
+}
+
+// WatchActivity is the data of the event and webhook posted when the
+// transactions of a block change a watched address.
+type WatchActivity struct {
+	WatchedAddress
+	BlockHash string   `json:"block_hash"`
+	TxHashes  []string `json:"tx_hashes"`
+	// Removed is true if the block is reverted from the canonical chain.
+	Removed bool `json:"removed"`
+}
+
+// WatchList keeps the balance and the transaction activity of the addresses
+// registered by the operator, incrementally updated by the blocks joining or
+// leaving the canonical chain. Every change is emitted as a TopicWatchedAddress
+// event and posted to the webhook if set.
+type WatchList struct {
+	bc *BlockChain
+
+	mu        sync.RWMutex
+	addresses map[string]*WatchedAddress
+	// slots are the addresses by slot, and slot the slot of each address.
+	slots []string
+	slot  map[string]int
+
+	webhook string
+	client  *http.Client
+	hookCh  chan *watchHook
+
+	eventCh chan *Event
+	quitCh  chan int
+	stopCh  chan struct{}
+}
+
+type watchHook struct {
+	url  string
+	data []byte
+}
+
+// NewWatchList create a new WatchList with the addresses kept in storage.
+func NewWatchList(bc *BlockChain) *WatchList {
+	wl := &WatchList{
+		bc:        bc,
+		addresses: make(map[string]*WatchedAddress),
+		slot:      make(map[string]int),
+		client:    &http.Client{Timeout: WatchWebhookTimeout},
+		hookCh:    make(chan *watchHook, WatchWebhookQueueSize),
+		eventCh:   make(chan *Event, 1024),
+		quitCh:    make(chan int, 1),
+		stopCh:    make(chan struct{}),
+	}
+	if err := wl.load(); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load watch list.")
+	}
+	return wl
+}
+
+// SetWebhook set the url the activities are posted to, empty disables it.
+func (wl *WatchList) SetWebhook(url string) {
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+	wl.webhook = url
+}
+
+// Start start watch list.
+func (wl *WatchList) Start() {
+	logging.CLog().Info("Start WatchList.")
+
+	wl.bc.eventEmitter.Register(TopicNewTailBlock, wl.eventCh)
+	wl.bc.eventEmitter.Register(TopicRevertBlock, wl.eventCh)
+	go wl.loop()
+	for i := 0; i < WatchWebhookWorkers; i++ {
+		go wl.postLoop()
+	}
+}
+
+// Stop stop watch list.
+func (wl *WatchList) Stop() {
+	logging.CLog().Info("Stop WatchList.")
+
+	wl.bc.eventEmitter.Deregister(TopicNewTailBlock, wl.eventCh)
+	wl.bc.eventEmitter.Deregister(TopicRevertBlock, wl.eventCh)
+	wl.quitCh <- 0
+	close(wl.stopCh)
+}
+
+func (wl *WatchList) loop() {
+	logging.CLog().Info("Launched WatchList.")
+
+	for {
+		select {
+		case <-wl.quitCh:
+			logging.CLog().Info("Shutdowned WatchList.")
+			return
+		case e := <-wl.eventCh:
+			if err := wl.handleBlock(e); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"event": e,
+					"err":   err,
+				}).Error("Failed to update watch list with block.")
+			}
+		}
+	}
+}
+
+func (wl *WatchList) handleBlock(e *Event) error {
+	hash, err := byteutils.FromHex(e.Data)
+	if err != nil {
+		return err
+	}
+	// the blocks not involving any watched address aren't loaded.
+	if _, bloom, err := wl.bc.GetBlockBloom(hash); err == nil && !bloom.mayInvolve(wl.watchedAddresses()) {
+		return nil
+	}
+	block := wl.bc.GetBlock(hash)
+	if block == nil {
+		return ErrMissingParentBlock
+	}
+	removed := e.Topic == TopicRevertBlock
+	// the state after a reverted block is the one of the new tail.
+	state := block
+	if removed {
+		state = wl.bc.TailBlock()
+	}
+
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	if len(wl.addresses) == 0 {
+		return nil
+	}
+	activities := make(map[string]*WatchActivity)
+	order := []string{}
+	touch := func(addr *Address, tx *Transaction) {
+		key := addr.String()
+		w, ok := wl.addresses[key]
+		if !ok {
+			return
+		}
+		a, ok := activities[key]
+		if !ok {
+			a = &WatchActivity{BlockHash: block.Hash().String(), TxHashes: []string{}, Removed: removed}
+			activities[key] = a
+			order = append(order, key)
+		}
+		if n := len(a.TxHashes); n > 0 && a.TxHashes[n-1] == tx.hash.String() {
+			return
+		}
+		a.TxHashes = append(a.TxHashes, tx.hash.String())
+		if removed {
+			if w.TxCount > 0 {
+				w.TxCount--
+			}
+		} else {
+			w.TxCount++
+			w.Height = block.height
+		}
+	}
+	for _, tx := range block.transactions {
+		touch(tx.from, tx)
+		touch(tx.to, tx)
+	}
+
+	for _, key := range order {
+		w := wl.addresses[key]
+		addr, _ := AddressParse(key)
+		w.Balance = state.GetBalance(addr.Bytes()).String()
+		w.Nonce = state.GetNonce(addr.Bytes())
+		if err := wl.put(w); err != nil {
+			return err
+		}
+
+		a := activities[key]
+		a.WatchedAddress = *w
+		data, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		wl.bc.eventEmitter.Trigger(&Event{
+			Topic: TopicWatchedAddress,
+			Data:  string(data),
+		})
+		if len(wl.webhook) > 0 {
+			wl.enqueue(&watchHook{url: wl.webhook, data: data})
+		}
+	}
+	return nil
+}
+
+// enqueue queues the activity to the webhook workers, or drops it if the
+// webhook can't keep up.
+func (wl *WatchList) enqueue(hook *watchHook) {
+	select {
+	case wl.hookCh <- hook:
+	default:
+		logging.VLog().WithFields(logrus.Fields{
+			"url":  hook.url,
+			"size": WatchWebhookQueueSize,
+		}).Warn("Webhook queue is full, drop watch activity.")
+	}
+}
+
+func (wl *WatchList) postLoop() {
+	for {
+		select {
+		case <-wl.stopCh:
+			return
+		case hook := <-wl.hookCh:
+			wl.post(hook.url, hook.data)
+		}
+	}
+}
+
+func (wl *WatchList) post(url string, data []byte) {
+	resp, err := wl.client.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"url": url,
+			"err": err,
+		}).Warn("Failed to post watch activity to webhook.")
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		logging.VLog().WithFields(logrus.Fields{
+			"url":    url,
+			"status": resp.Status,
+		}).Warn("Webhook refused watch activity.")
+	}
+}
+
+// Watch add the address to the watch list, its balance is taken from the tail.
+func (wl *WatchList) Watch(addr *Address) error {
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	key := addr.String()
+	if _, ok := wl.addresses[key]; ok {
+		return nil
+	}
+	if len(wl.addresses) >= MaxWatchedAddresses {
+		return ErrTooManyWatchedAddresses
+	}
+	tail := wl.bc.TailBlock()
+	w := &WatchedAddress{
+		Address: key,
+		Balance: tail.GetBalance(addr.Bytes()).String(),
+		Nonce:   tail.GetNonce(addr.Bytes()),
+	}
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	slot := len(wl.slots)
+	batch := storage.NewBatch()
+	batch.Put(watchedAddressKey(key), data)
+	batch.Put(watchListSlotKey(slot), []byte(key))
+	batch.Put(watchListCountKey, byteutils.FromUint64(uint64(slot+1)))
+	if err := wl.bc.storage.WriteBatch(batch); err != nil {
+		return err
+	}
+	wl.addresses[key] = w
+	wl.slots = append(wl.slots, key)
+	wl.slot[key] = slot
+	return nil
+}
+
+// Unwatch remove the address from the watch list, return false if not found.
+func (wl *WatchList) Unwatch(addr *Address) (bool, error) {
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	key := addr.String()
+	if _, ok := wl.addresses[key]; !ok {
+		return false, nil
+	}
+	// the last address moves to the slot of the removed one.
+	slot, last := wl.slot[key], len(wl.slots)-1
+	batch := storage.NewBatch()
+	if slot != last {
+		batch.Put(watchListSlotKey(slot), []byte(wl.slots[last]))
+	}
+	batch.Del(watchListSlotKey(last))
+	batch.Put(watchListCountKey, byteutils.FromUint64(uint64(last)))
+	batch.Del(watchedAddressKey(key))
+	if err := wl.bc.storage.WriteBatch(batch); err != nil {
+		return false, err
+	}
+	wl.slots[slot] = wl.slots[last]
+	wl.slot[wl.slots[slot]] = slot
+	wl.slots = wl.slots[:last]
+	delete(wl.slot, key)
+	delete(wl.addresses, key)
+	return true, nil
+}
+
+// Watched return the addresses in the watch list, ordered by address.
+func (wl *WatchList) Watched() []*WatchedAddress {
+	wl.mu.RLock()
+	defer wl.mu.RUnlock()
+
+	addresses := make([]*WatchedAddress, 0, len(wl.addresses))
+	for _, w := range wl.addresses {
+		copied := *w
+		addresses = append(addresses, &copied)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Address < addresses[j].Address
+	})
+	return addresses
+}
+
+func (wl *WatchList) watchedAddresses() []*Address {
+	wl.mu.RLock()
+	defer wl.mu.RUnlock()
+
+	addrs := make([]*Address, 0, len(wl.addresses))
+	for key := range wl.addresses {
+		if addr, err := AddressParse(key); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+func (wl *WatchList) put(w *WatchedAddress) error {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	return wl.bc.storage.Put(watchedAddressKey(w.Address), data)
+}
+
+func (wl *WatchList) load() error {
+	count, err := wl.bc.storage.Get(watchListCountKey)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for slot := 0; slot < int(byteutils.Uint64(count)); slot++ {
+		key, err := wl.bc.storage.Get(watchListSlotKey(slot))
+		if err != nil {
+			return err
+		}
+		w := &WatchedAddress{Address: string(key)}
+		if data, err := wl.bc.storage.Get(watchedAddressKey(w.Address)); err == nil {
+			if err := json.Unmarshal(data, w); err != nil {
+				return err
+			}
+		}
+		wl.addresses[w.Address] = w
+		wl.slots = append(wl.slots, w.Address)
+		wl.slot[w.Address] = slot
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitWatchActivity(t *testing.T, ch chan *Event) *WatchActivity {
+	select {
+	case e := <-ch:
+		activity := &WatchActivity{}
+		assert.Nil(t, json.Unmarshal([]byte(e.Data), activity))
+		return activity
+	case <-time.After(time.Second):
+		t.Fatal("no watch activity")
+	}
+	return nil
+}
+
+func TestWatchList(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	bc.eventEmitter.Start()
+	defer bc.eventEmitter.Stop()
+	wl := bc.WatchList()
+	wl.Start()
+	defer wl.Stop()
+
+	posted := make(chan []byte, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		posted <- data
+	}))
+	defer server.Close()
+	wl.SetWebhook(server.URL)
+
+	activityCh := make(chan *Event, 4)
+	bc.eventEmitter.Register(TopicWatchedAddress, activityCh)
+	defer bc.eventEmitter.Deregister(TopicWatchedAddress, activityCh)
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block0, _ := bc.NewBlock(from)
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(from)
+	block0.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	to := mockAddress()
+	assert.Nil(t, wl.Watch(to))
+	assert.Nil(t, wl.Watch(to))
+	watched := wl.Watched()
+	assert.Equal(t, 1, len(watched))
+	assert.Equal(t, "0", watched[0].Balance)
+
+	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(10), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.Sign(signature)
+	assert.Nil(t, bc.txPool.Push(tx))
+
+	/*
+		genesis -- 0 -- 1
+		             \_ 2
+	*/
+	coinbase := &Address{[]byte("012345678901234567890011")}
+	block1, _ := bc.NewBlock(coinbase)
+	block1.header.timestamp = BlockInterval * 2
+	block1.CollectTransactions(1)
+	block1.SetMiner(coinbase)
+	block1.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block1)))
+	assert.Nil(t, bc.SetTailBlock(block1))
+
+	activity := waitWatchActivity(t, activityCh)
+	assert.Equal(t, to.String(), activity.Address)
+	assert.Equal(t, "10", activity.Balance)
+	assert.Equal(t, uint64(1), activity.TxCount)
+	assert.Equal(t, block1.height, activity.Height)
+	assert.Equal(t, block1.Hash().String(), activity.BlockHash)
+	assert.Equal(t, []string{tx.Hash().String()}, activity.TxHashes)
+	assert.False(t, activity.Removed)
+
+	select {
+	case data := <-posted:
+		hooked := &WatchActivity{}
+		assert.Nil(t, json.Unmarshal(data, hooked))
+		assert.Equal(t, activity, hooked)
+	case <-time.After(time.Second):
+		t.Fatal("no webhook post")
+	}
+
+	// the activity of the reverted block is reported again as removed.
+	block2, _ := bc.NewBlockFromParent(coinbase, block0)
+	block2.header.timestamp = BlockInterval * 3
+	block2.SetMiner(coinbase)
+	block2.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block2)))
+	assert.Nil(t, bc.SetTailBlock(block2))
+
+	activity = waitWatchActivity(t, activityCh)
+	assert.True(t, activity.Removed)
+	assert.Equal(t, "0", activity.Balance)
+	assert.Equal(t, uint64(0), activity.TxCount)
+
+	// the watch list is kept in storage.
+	reloaded := NewWatchList(bc)
+	assert.Equal(t, wl.Watched(), reloaded.Watched())
+
+	removed, err := wl.Unwatch(to)
+	assert.Nil(t, err)
+	assert.True(t, removed)
+	removed, err = wl.Unwatch(to)
+	assert.Nil(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, 0, len(NewWatchList(bc).Watched()))
+}
+
+func TestWatchList_Slots(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	wl := bc.WatchList()
+
+	addrs := []*Address{mockAddress(), mockAddress(), mockAddress()}
+	for _, addr := range addrs {
+		assert.Nil(t, wl.Watch(addr))
+	}
+
+	// the last address moves to the slot of the removed one.
+	removed, err := wl.Unwatch(addrs[0])
+	assert.Nil(t, err)
+	assert.True(t, removed)
+	assert.Equal(t, []string{addrs[2].String(), addrs[1].String()}, wl.slots)
+
+	reloaded := NewWatchList(bc)
+	assert.Equal(t, wl.slots, reloaded.slots)
+	assert.Equal(t, wl.Watched(), reloaded.Watched())
+
+	// the activities beyond the queue are dropped rather than blocking the list.
+	for i := 0; i < WatchWebhookQueueSize+1; i++ {
+		wl.enqueue(&watchHook{url: "http://127.0.0.1", data: []byte("{}")})
+	}
+	assert.Equal(t, WatchWebhookQueueSize, len(wl.hookCh))
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// GenerateWitness return the execution witness of the block, i.e. the parent block
+// and all the trie nodes read when executing the block on its parent, which is
+// enough to verify the block's execution without the state.
+func GenerateWitness(bc *BlockChain, block *Block) (*corepb.Witness, error) {
+	rec := storage.NewRecordingStorage(storage.NewOverlayStorage(bc.storage))
+	parent, err := LoadBlockFromStorage(block.ParentHash(), rec, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := executeWitnessBlock(block, parent, bc.ConsensusHandler()); err != nil {
+		return nil, err
+	}
+
+	reads := rec.Reads()
+	keys := make([]string, 0, len(reads))
+	for k := range reads {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	witness := &corepb.Witness{}
+	for _, k := range keys {
+		key, err := byteutils.FromHex(k)
+		if err != nil {
+			return nil, err
+		}
+		witness.Entries = append(witness.Entries, &corepb.WitnessEntry{Key: key, Value: reads[k]})
+	}
+	return witness, nil
+}
+
+// VerifyWitness execute the block on the entries of the witness only, and check
+// the result roots with the block's. Every entry but the parent block must be a
+// trie node keyed by its hash.
+func VerifyWitness(block *Block, witness *corepb.Witness, consensus Consensus) error {
+	if witness == nil {
+		return ErrInvalidWitness
+	}
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return err
+	}
+	for _, entry := range witness.Entries {
+		if !byteutils.Equal(entry.Key, block.ParentHash()) &&
+			!byteutils.Equal(entry.Key, hash.Sha3256(entry.Value)) {
+			return ErrInvalidWitness
+		}
+		if err := stor.Put(entry.Key, entry.Value); err != nil {
+			return err
+		}
+	}
+
+	parent, err := LoadBlockFromStorage(block.ParentHash(), stor, nil, nil)
+	if err != nil {
+		return ErrInvalidWitness
+	}
+	if !HashBlock(parent).Equals(block.ParentHash()) {
+		return ErrInvalidWitness
+	}
+	return executeWitnessBlock(block, parent, consensus)
+}
+
+// executeWitnessBlock executes a copy of the block on the parent and verify the state.
+func executeWitnessBlock(block, parent *Block, consensus Consensus) error {
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return err
+	}
+	copied := new(Block)
+	if err := copied.FromProto(proto.Clone(pbBlock)); err != nil {
+		return err
+	}
+	if err := copied.LinkParentBlock(parent); err != nil {
+		return err
+	}
+	if err := consensus.VerifyBlock(copied, parent); err != nil {
+		return err
+	}
+
+	copied.begin()
+	if err := copied.execute(context.Background()); err != nil {
+		copied.rollback()
+		return err
+	}
+	if err := copied.verifyState(); err != nil {
+		copied.rollback()
+		return err
+	}
+	copied.commit()
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWitness(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block0, _ := bc.NewBlock(from)
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(from)
+	block0.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx.Sign(signature)
+	assert.Nil(t, bc.txPool.Push(tx))
+
+	block1, _ := bc.NewBlock(from)
+	block1.header.timestamp = BlockInterval * 2
+	block1.CollectTransactions(1)
+	block1.SetMiner(from)
+	assert.Nil(t, block1.Seal())
+	assert.Equal(t, 1, len(block1.transactions))
+
+	witness, err := GenerateWitness(bc, block1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(witness.Entries))
+	block1.SetWitness(witness)
+
+	// the witness is carried with the block.
+	pbBlock, err := block1.ToProto()
+	assert.Nil(t, err)
+	received := new(Block)
+	assert.Nil(t, received.FromProto(pbBlock))
+	assert.Nil(t, VerifyWitness(received, received.Witness(), c))
+
+	tampered := proto.Clone(witness).(*corepb.Witness)
+	tampered.Entries[len(tampered.Entries)-1].Value = []byte("nas")
+	assert.Equal(t, ErrInvalidWitness, VerifyWitness(received, tampered, c))
+
+	missing := &corepb.Witness{}
+	for _, entry := range witness.Entries {
+		if !byteutils.Equal(entry.Key, block1.ParentHash()) {
+			missing.Entries = append(missing.Entries, entry)
+		}
+	}
+	assert.Equal(t, len(witness.Entries)-1, len(missing.Entries))
+	assert.NotNil(t, VerifyWitness(received, missing, c))
+	assert.Equal(t, ErrInvalidWitness, VerifyWitness(received, nil, c))
+}
@@ -114,3 +114,32 @@ func TestSign(t *testing.T) {
 		}
 	}
 }
+
+func TestMalformedSignature(t *testing.T) {
+	priv := NewECDSAPrivateKey()
+	msg := hash.Sha3256([]byte("malformed signature"))
+	sig, err := Sign(msg, priv)
+	if err != nil {
+		t.Fatalf("sign failed:%s", err)
+	}
+
+	for recid := byte(4); recid != 0; recid++ {
+		bad := append([]byte{}, sig...)
+		bad[64] = recid
+		if _, err := RecoverECDSAPublicKey(msg, bad); err != ErrInvalidSignature {
+			t.Errorf("recover with recid %d, err = %v, want %v", recid, err, ErrInvalidSignature)
+		}
+	}
+	for _, n := range []int{0, 1, 63, 64, 66} {
+		bad := make([]byte, n)
+		copy(bad, sig)
+		if _, err := RecoverECDSAPublicKey(msg, bad); err != ErrInvalidSignature {
+			t.Errorf("recover with %d bytes, err = %v, want %v", n, err, ErrInvalidSignature)
+		}
+	}
+	for _, n := range []int{0, 1, 63} {
+		if _, err := Verify(msg, sig[:n], &priv.PublicKey); err != ErrInvalidSignature {
+			t.Errorf("verify with %d bytes, err = %v, want %v", n, err, ErrInvalidSignature)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secp256k1
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1/bitelliptic"
+)
+
+// HardenedKeyStart is the index of the first hardened child key, which
+// can not be derived from an extended public key.
+const HardenedKeyStart uint32 = 0x80000000
+
+var (
+	// ErrInvalidExtendedKey the string is not a serialized extended public key.
+	ErrInvalidExtendedKey = errors.New("invalid extended public key")
+
+	// ErrHardenedDerivation a hardened child is derived from an extended public key.
+	ErrHardenedDerivation = errors.New("cannot derive hardened child from extended public key")
+
+	// ErrInvalidChildKey the child key at the index is invalid, the next index should be used.
+	ErrInvalidChildKey = errors.New("invalid child key, use the next index")
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	// version bytes of the mainnet and testnet extended public keys, xpub and tpub.
+	xpubVersion = []byte{0x04, 0x88, 0xb2, 0x1e}
+	tpubVersion = []byte{0x04, 0x35, 0x87, 0xcf}
+)
+
+// ExtendedPublicKey is a BIP32 extended public key, the public keys of its
+// non hardened children are derived without any private key.
+type ExtendedPublicKey struct {
+	version           []byte
+	depth             byte
+	parentFingerprint []byte
+	childNumber       uint32
+	chainCode         []byte
+	// key is the compressed public key.
+	key []byte
+}
+
+// ParseExtendedPublicKey parses a base58check serialized extended public key.
+func ParseExtendedPublicKey(s string) (*ExtendedPublicKey, error) {
+	data, err := base58Decode(s)
+	if err != nil || len(data) != 82 {
+		return nil, ErrInvalidExtendedKey
+	}
+	payload, checksum := data[:78], data[78:]
+	if !bytes.Equal(hash.Sha256(hash.Sha256(payload))[:4], checksum) {
+		return nil, ErrInvalidExtendedKey
+	}
+	version := payload[:4]
+	if !bytes.Equal(version, xpubVersion) && !bytes.Equal(version, tpubVersion) {
+		return nil, ErrInvalidExtendedKey
+	}
+	key := payload[45:78]
+	if _, _, err := decompressPoint(key); err != nil {
+		return nil, err
+	}
+	return &ExtendedPublicKey{
+		version:           version,
+		depth:             payload[4],
+		parentFingerprint: payload[5:9],
+		childNumber:       binary.BigEndian.Uint32(payload[9:13]),
+		chainCode:         payload[13:45],
+		key:               key,
+	}, nil
+}
+
+// Child derives the extended public key of the non hardened child at the index.
+func (k *ExtendedPublicKey) Child(index uint32) (*ExtendedPublicKey, error) {
+	if index >= HardenedKeyStart {
+		return nil, ErrHardenedDerivation
+	}
+	data := make([]byte, 37)
+	copy(data, k.key)
+	binary.BigEndian.PutUint32(data[33:], index)
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	curve := bitelliptic.S256()
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(curve.N) >= 0 {
+		return nil, ErrInvalidChildKey
+	}
+	px, py, err := decompressPoint(k.key)
+	if err != nil {
+		return nil, err
+	}
+	ilx, ily := curve.ScalarBaseMult(sum[:32])
+	x, y := curve.Add(ilx, ily, px, py)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, ErrInvalidChildKey
+	}
+	return &ExtendedPublicKey{
+		version:           k.version,
+		depth:             k.depth + 1,
+		parentFingerprint: hash.Ripemd160(hash.Sha256(k.key))[:4],
+		childNumber:       index,
+		chainCode:         sum[32:],
+		key:               compressPoint(x, y),
+	}, nil
+}
+
+// PublicKey returns the uncompressed public key, as the addresses are derived from.
+func (k *ExtendedPublicKey) PublicKey() []byte {
+	x, y, _ := decompressPoint(k.key)
+	return bitelliptic.S256().Marshal(x, y)
+}
+
+// String returns the base58check serialization of the key.
+func (k *ExtendedPublicKey) String() string {
+	payload := make([]byte, 0, 82)
+	payload = append(payload, k.version...)
+	payload = append(payload, k.depth)
+	payload = append(payload, k.parentFingerprint...)
+	childNumber := make([]byte, 4)
+	binary.BigEndian.PutUint32(childNumber, k.childNumber)
+	payload = append(payload, childNumber...)
+	payload = append(payload, k.chainCode...)
+	payload = append(payload, k.key...)
+	payload = append(payload, hash.Sha256(hash.Sha256(payload))[:4]...)
+	return base58Encode(payload)
+}
+
+func compressPoint(x, y *big.Int) []byte {
+	key := make([]byte, 33)
+	key[0] = 0x02 + byte(y.Bit(0))
+	readBits(x, key[1:])
+	return key
+}
+
+// decompressPoint recovers y from y^2 = x^3 + 7, as p = 3 mod 4 the square root is (x^3 + 7)^((p+1)/4).
+func decompressPoint(key []byte) (*big.Int, *big.Int, error) {
+	if len(key) != 33 || (key[0] != 0x02 && key[0] != 0x03) {
+		return nil, nil, ErrInvalidExtendedKey
+	}
+	curve := bitelliptic.S256()
+	x := new(big.Int).SetBytes(key[1:])
+	if x.Cmp(curve.P) >= 0 {
+		return nil, nil, ErrInvalidExtendedKey
+	}
+	y2 := new(big.Int).Exp(x, big.NewInt(3), curve.P)
+	y2.Add(y2, curve.B)
+	y2.Mod(y2, curve.P)
+	exp := new(big.Int).Add(curve.P, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	y := new(big.Int).Exp(y2, exp, curve.P)
+	if y.Bit(0) != uint(key[0]&1) {
+		y.Sub(curve.P, y)
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil, ErrInvalidExtendedKey
+	}
+	return x, y, nil
+}
+
+func base58Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range []byte(s) {
+		i := bytes.IndexByte([]byte(base58Alphabet), c)
+		if i < 0 {
+			return nil, ErrInvalidExtendedKey
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(i)))
+	}
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+func base58Encode(data []byte) string {
+	n := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	out := []byte{}
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
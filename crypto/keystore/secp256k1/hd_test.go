@@ -0,0 +1,66 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendedPublicKey(t *testing.T) {
+	// test vector 1 of BIP32, m/0H -> m/0H/1 and m/0H/1/2H -> m/0H/1/2H/2.
+	tests := []struct {
+		parent string
+		index  uint32
+		child  string
+	}{
+		{
+			"xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+			1,
+			"xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+		},
+		{
+			"xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5",
+			2,
+			"xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV",
+		},
+	}
+	for _, tt := range tests {
+		parent, err := ParseExtendedPublicKey(tt.parent)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.parent, parent.String())
+		child, err := parent.Child(tt.index)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.child, child.String())
+
+		pub, err := ToECDSAPublicKey(child.PublicKey())
+		assert.Nil(t, err)
+		assert.True(t, S256().IsOnCurve(pub.X, pub.Y))
+		assert.Equal(t, compressPoint(pub.X, pub.Y), child.key)
+
+		_, err = parent.Child(HardenedKeyStart)
+		assert.Equal(t, ErrHardenedDerivation, err)
+	}
+
+	_, err := ParseExtendedPublicKey("xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwR")
+	assert.Equal(t, ErrInvalidExtendedKey, err)
+	_, err = ParseExtendedPublicKey("0xinvalid")
+	assert.Equal(t, ErrInvalidExtendedKey, err)
+}
@@ -85,7 +85,8 @@ func RecoverECDSAPublicKey(msg []byte, signature []byte) (*ecdsa.PublicKey, erro
 	if len(msg) != 32 {
 		return nil, ErrInvalidMsgLen
 	}
-	if len(signature) != 65 {
+	// libsecp256k1 aborts the process on a recovery id out of [0, 3].
+	if len(signature) != 65 || signature[64] > 3 {
 		return nil, ErrInvalidSignature
 	}
 	var (
@@ -144,6 +145,9 @@ func Verify(msg []byte, signature []byte, pub *ecdsa.PublicKey) (bool, error) {
 	if len(msg) != 32 {
 		return false, ErrInvalidMsgLen
 	}
+	if len(signature) < 64 {
+		return false, ErrInvalidSignature
+	}
 	pubdata, err := FromECDSAPublicKey(pub)
 	if err != nil {
 		return false, err
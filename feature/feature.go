@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package feature is the experimental subsystems of a node. They ship
+// disabled and are enabled per node by the features of the config, without
+// a rebuild.
+package feature
+
+import (
+	"errors"
+	"sort"
+)
+
+// Names of the experimental features.
+const (
+	// SnapshotSync bootstraps an empty datadir from the signed storage
+	// snapshot of storage.snapshot instead of syncing from genesis.
+	SnapshotSync = "snapshot_sync"
+
+	// DevMode enables the admin calls for the integration tests against a
+	// dev chain, like simulating a reorg.
+	DevMode = "dev_mode"
+)
+
+// ErrUnknownFeature the feature is not known to this binary.
+var ErrUnknownFeature = errors.New("unknown feature")
+
+// Feature is an experimental subsystem.
+type Feature struct {
+	Name        string
+	Description string
+}
+
+// known are the experimental features of this binary, a subsystem gated by
+// a flag registers it here.
+var known = map[string]*Feature{
+	DevMode: {
+		Name:        DevMode,
+		Description: "admin calls for the integration tests against a dev chain, like simulating a reorg",
+	},
+	SnapshotSync: {
+		Name:        SnapshotSync,
+		Description: "bootstrap an empty datadir from the signed snapshot of storage.snapshot",
+	},
+}
+
+// Known returns the experimental features of this binary by name.
+func Known() []*Feature {
+	features := make([]*Feature, 0, len(known))
+	for _, f := range known {
+		features = append(features, f)
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i].Name < features[j].Name })
+	return features
+}
+
+// Flags is the features enabled on a node, a nil Flags has none enabled.
+type Flags struct {
+	enabled map[string]bool
+}
+
+// New returns the flags of the enabled features, ErrUnknownFeature if one is
+// not known.
+func New(enabled []string) (*Flags, error) {
+	f := &Flags{enabled: make(map[string]bool)}
+	for _, name := range enabled {
+		if _, ok := known[name]; !ok {
+			return nil, ErrUnknownFeature
+		}
+		f.enabled[name] = true
+	}
+	return f, nil
+}
+
+// Enabled returns whether the feature is enabled.
+func (f *Flags) Enabled(name string) bool {
+	return f != nil && f.enabled[name]
+}
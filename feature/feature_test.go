@@ -0,0 +1,48 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package feature
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlags(t *testing.T) {
+	var none *Flags
+	assert.False(t, none.Enabled(SnapshotSync))
+
+	f, err := New(nil)
+	assert.Nil(t, err)
+	assert.False(t, f.Enabled(SnapshotSync))
+
+	f, err = New([]string{SnapshotSync})
+	assert.Nil(t, err)
+	assert.True(t, f.Enabled(SnapshotSync))
+	assert.False(t, f.Enabled("wasm"))
+
+	_, err = New([]string{SnapshotSync, "wasm"})
+	assert.Equal(t, ErrUnknownFeature, err)
+
+	names := []string{}
+	for _, f := range Known() {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{DevMode, SnapshotSync}, names)
+}
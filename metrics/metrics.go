@@ -27,7 +27,6 @@ import (
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net/p2p"
 	metrics "github.com/rcrowley/go-metrics"
-	influxdb "github.com/vrischmann/go-metrics-influxdb"
 )
 
 const (
@@ -37,7 +36,8 @@ const (
 )
 
 var (
-	quitCh chan (bool)
+	quitCh    chan (bool)
+	reporters []Reporter
 )
 
 // Neblet interface breaks cycle import dependency.
@@ -46,8 +46,13 @@ type Neblet interface {
 	NetManager() p2p.Manager
 }
 
-// Start metrics monitor
-func Start(neb Neblet) {
+// Start metrics monitor, every configured reporter tags the metrics with chainID and nodeID.
+func Start(neb Neblet) error {
+	rs, err := NewReporters(neb.Config().Stats)
+	if err != nil {
+		return err
+	}
+
 	tags := make(map[string]string)
 	metricsConfig := neb.Config().Stats.MetricsTags
 	for _, v := range metricsConfig {
@@ -59,8 +64,20 @@ func Start(neb Neblet) {
 	}
 	tags[nodeID] = getSimpleNodeID(neb)
 	tags[chainID] = fmt.Sprintf("%d", neb.NetManager().Node().Config().ChainID)
+
+	for i, r := range rs {
+		if err := r.Start(metrics.DefaultRegistry, tags); err != nil {
+			for _, started := range rs[:i] {
+				started.Stop()
+			}
+			return err
+		}
+	}
+	reporters = rs
+
+	quitCh = make(chan bool, 1)
 	go collectSystemMetrics()
-	influxdb.InfluxDBWithTags(metrics.DefaultRegistry, duration, neb.Config().Stats.Influxdb.Host, neb.Config().Stats.Influxdb.Db, neb.Config().Stats.Influxdb.User, neb.Config().Stats.Influxdb.Password, tags)
+	return nil
 }
 
 func getSimpleNodeID(neb Neblet) string {
@@ -100,5 +117,12 @@ func collectSystemMetrics() {
 
 // Stop metrics monitor
 func Stop() {
-	quitCh <- true
+	for _, r := range reporters {
+		r.Stop()
+	}
+	reporters = nil
+
+	if quitCh != nil {
+		quitCh <- true
+	}
 }
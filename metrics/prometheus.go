@@ -0,0 +1,179 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	prometheusPath = "/metrics"
+)
+
+var (
+	quantiles = []float64{0.5, 0.75, 0.95, 0.99}
+)
+
+// PrometheusReporter exposes metrics in the prometheus text format on an http endpoint.
+type PrometheusReporter struct {
+	listen   string
+	listener net.Listener
+	server   *http.Server
+}
+
+// NewPrometheusReporter create a prometheus reporter listening on the given address.
+func NewPrometheusReporter(listen string) *PrometheusReporter {
+	return &PrometheusReporter{listen: listen}
+}
+
+// Start serving the scrape endpoint.
+func (r *PrometheusReporter) Start(registry metrics.Registry, tags map[string]string) error {
+	listener, err := net.Listen("tcp", r.listen)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"listen": r.listen,
+			"err":    err,
+		}).Error("Failed to listen prometheus endpoint.")
+		return err
+	}
+
+	labels := formatLabels(tags)
+	mux := http.NewServeMux()
+	mux.HandleFunc(prometheusPath, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		writePrometheus(w, registry, labels)
+	})
+	r.listener = listener
+	r.server = &http.Server{Handler: mux}
+
+	go func() {
+		if err := r.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Prometheus endpoint stopped.")
+		}
+	}()
+
+	logging.CLog().WithFields(logrus.Fields{
+		"listen": listener.Addr().String(),
+	}).Info("Started prometheus endpoint.")
+	return nil
+}
+
+// Stop serving the scrape endpoint.
+func (r *PrometheusReporter) Stop() {
+	if r.server != nil {
+		r.server.Close()
+	}
+}
+
+// writePrometheus writes all metrics of registry in the prometheus text exposition format.
+func writePrometheus(w io.Writer, registry metrics.Registry, labels string) {
+	names := make([]string, 0)
+	all := make(map[string]interface{})
+	registry.Each(func(name string, i interface{}) {
+		names = append(names, name)
+		all[name] = i
+	})
+	sort.Strings(names)
+
+	buf := new(bytes.Buffer)
+	for _, name := range names {
+		n := sanitizeName(name)
+		switch m := all[name].(type) {
+		case metrics.Counter:
+			writeSample(buf, n, "counter", labels, float64(m.Count()))
+		case metrics.Gauge:
+			writeSample(buf, n, "gauge", labels, float64(m.Value()))
+		case metrics.GaugeFloat64:
+			writeSample(buf, n, "gauge", labels, m.Value())
+		case metrics.Meter:
+			s := m.Snapshot()
+			writeSample(buf, n+"_total", "counter", labels, float64(s.Count()))
+			writeSample(buf, n+"_rate1m", "gauge", labels, s.Rate1())
+		case metrics.Timer:
+			s := m.Snapshot()
+			writeSummary(buf, n, labels, s.Percentiles(quantiles), s.Sum(), s.Count())
+		case metrics.Histogram:
+			s := m.Snapshot()
+			writeSummary(buf, n, labels, s.Percentiles(quantiles), s.Sum(), s.Count())
+		}
+	}
+	w.Write(buf.Bytes())
+}
+
+func writeSample(buf *bytes.Buffer, name, typ, labels string, value float64) {
+	fmt.Fprintf(buf, "# TYPE %s %s\n", name, typ)
+	fmt.Fprintf(buf, "%s%s %v\n", name, labels, value)
+}
+
+func writeSummary(buf *bytes.Buffer, name, labels string, ps []float64, sum int64, count int64) {
+	fmt.Fprintf(buf, "# TYPE %s summary\n", name)
+	for i, q := range quantiles {
+		fmt.Fprintf(buf, "%s%s %v\n", name, withLabel(labels, "quantile", fmt.Sprintf("%v", q)), ps[i])
+	}
+	fmt.Fprintf(buf, "%s_sum%s %d\n", name, labels, sum)
+	fmt.Fprintf(buf, "%s_count%s %d\n", name, labels, count)
+}
+
+// formatLabels renders tags as a sorted prometheus label set, e.g. {chainID="100",nodeID="abcdef"}.
+func formatLabels(tags map[string]string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", sanitizeName(k), tags[k]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func withLabel(labels, key, value string) string {
+	pair := fmt.Sprintf("%s=%q", key, value)
+	if len(labels) == 0 {
+		return "{" + pair + "}"
+	}
+	return labels[:len(labels)-1] + "," + pair + "}"
+}
+
+// sanitizeName maps a go-metrics name like neb.net.packets.in to a valid prometheus name.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
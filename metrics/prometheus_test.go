@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatLabels(t *testing.T) {
+	assert.Equal(t, "", formatLabels(nil))
+	assert.Equal(t, `{chainID="100",nodeID="abcdef"}`, formatLabels(map[string]string{nodeID: "abcdef", chainID: "100"}))
+	assert.Equal(t, `{a="1",quantile="0.5"}`, withLabel(`{a="1"}`, "quantile", "0.5"))
+	assert.Equal(t, `{quantile="0.5"}`, withLabel("", "quantile", "0.5"))
+	assert.Equal(t, "neb_net_packets_in_tx_1", sanitizeName("neb.net.packets.in.tx-1"))
+}
+
+func TestWritePrometheus(t *testing.T) {
+	registry := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter("neb.counter", registry).Inc(3)
+	metrics.GetOrRegisterGauge("neb.gauge", registry).Update(7)
+	metrics.GetOrRegisterMeter("neb.meter", registry).Mark(2)
+	metrics.GetOrRegisterTimer("neb.timer", registry).Update(10)
+
+	buf := new(bytes.Buffer)
+	writePrometheus(buf, registry, formatLabels(map[string]string{chainID: "100"}))
+	out := buf.String()
+
+	assert.Contains(t, out, "# TYPE neb_counter counter\nneb_counter{chainID=\"100\"} 3\n")
+	assert.Contains(t, out, "# TYPE neb_gauge gauge\nneb_gauge{chainID=\"100\"} 7\n")
+	assert.Contains(t, out, "neb_meter_total{chainID=\"100\"} 2\n")
+	assert.Contains(t, out, "# TYPE neb_timer summary\n")
+	assert.Contains(t, out, "neb_timer{chainID=\"100\",quantile=\"0.99\"} 10\n")
+	assert.Contains(t, out, "neb_timer_count{chainID=\"100\"} 1\n")
+}
+
+func TestPrometheusReporter(t *testing.T) {
+	registry := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter("neb.counter", registry).Inc(1)
+
+	r := NewPrometheusReporter("127.0.0.1:0")
+	assert.Nil(t, r.Start(registry, map[string]string{nodeID: "abcdef"}))
+	defer r.Stop()
+
+	resp, err := http.Get("http://" + r.listener.Addr().String() + prometheusPath)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Contains(t, string(body), "neb_counter{nodeID=\"abcdef\"} 1\n")
+}
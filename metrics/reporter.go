@@ -0,0 +1,101 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+	influxdb "github.com/vrischmann/go-metrics-influxdb"
+)
+
+// Errors
+var (
+	ErrUnknownReportingModule = errors.New("unknown metrics reporting module")
+	ErrMissingReporterConfig  = errors.New("missing metrics reporter config")
+)
+
+// Reporter pushes or exposes the metrics of a registry to a monitoring backend.
+// Every metric it reports carries the given tags.
+type Reporter interface {
+	Start(r metrics.Registry, tags map[string]string) error
+	Stop()
+}
+
+// NewReporters returns the reporters enabled in stats config,
+// influxdb is used when no reporting module is configured.
+func NewReporters(conf *nebletpb.StatsConfig) ([]Reporter, error) {
+	modules := conf.ReportingModule
+	if len(modules) == 0 {
+		modules = []nebletpb.StatsConfig_ReportingModule{nebletpb.StatsConfig_Influxdb}
+	}
+
+	reporters := make([]Reporter, 0, len(modules))
+	for _, module := range modules {
+		reporter, err := newReporter(conf, module)
+		if err != nil {
+			return nil, err
+		}
+		reporters = append(reporters, reporter)
+	}
+	return reporters, nil
+}
+
+func newReporter(conf *nebletpb.StatsConfig, module nebletpb.StatsConfig_ReportingModule) (Reporter, error) {
+	switch module {
+	case nebletpb.StatsConfig_Influxdb:
+		if conf.Influxdb == nil {
+			return nil, ErrMissingReporterConfig
+		}
+		return &influxdbReporter{conf: conf.Influxdb}, nil
+	case nebletpb.StatsConfig_Prometheus:
+		if conf.Prometheus == nil {
+			return nil, ErrMissingReporterConfig
+		}
+		return NewPrometheusReporter(conf.Prometheus.Listen), nil
+	case nebletpb.StatsConfig_Statsd:
+		if conf.Statsd == nil {
+			return nil, ErrMissingReporterConfig
+		}
+		return NewStatsdReporter(conf.Statsd.Address, conf.Statsd.Prefix, duration), nil
+	default:
+		logging.VLog().WithFields(logrus.Fields{
+			"module": module,
+		}).Error("Unknown metrics reporting module.")
+		return nil, ErrUnknownReportingModule
+	}
+}
+
+// influxdbReporter pushes metrics to influxdb every interval.
+type influxdbReporter struct {
+	conf *nebletpb.InfluxdbConfig
+}
+
+// Start the influxdb reporter, the underlying client can't be stopped,
+// it lives as long as the process.
+func (r *influxdbReporter) Start(registry metrics.Registry, tags map[string]string) error {
+	go influxdb.InfluxDBWithTags(registry, duration, r.conf.Host, r.conf.Db, r.conf.User, r.conf.Password, tags)
+	return nil
+}
+
+// Stop the influxdb reporter.
+func (r *influxdbReporter) Stop() {}
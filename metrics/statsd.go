@@ -0,0 +1,203 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// statsdMaxPacketSize keeps a datagram within a common ethernet MTU.
+	statsdMaxPacketSize = 1432
+)
+
+// StatsdReporter pushes metrics to a statsd daemon over udp every interval,
+// tags are sent in the dogstatsd "|#k:v" extension.
+type StatsdReporter struct {
+	address  string
+	prefix   string
+	interval time.Duration
+
+	conn       net.Conn
+	tags       string
+	lastCounts map[string]int64
+	quitCh     chan bool
+}
+
+// NewStatsdReporter create a statsd reporter.
+func NewStatsdReporter(address, prefix string, interval time.Duration) *StatsdReporter {
+	if interval <= 0 {
+		interval = duration
+	}
+	return &StatsdReporter{
+		address:    address,
+		prefix:     prefix,
+		interval:   interval,
+		lastCounts: make(map[string]int64),
+		quitCh:     make(chan bool, 1),
+	}
+}
+
+// Start pushing metrics.
+func (r *StatsdReporter) Start(registry metrics.Registry, tags map[string]string) error {
+	conn, err := net.Dial("udp", r.address)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"address": r.address,
+			"err":     err,
+		}).Error("Failed to dial statsd.")
+		return err
+	}
+	r.conn = conn
+	r.tags = formatStatsdTags(tags)
+
+	go r.loop(registry)
+	return nil
+}
+
+// Stop pushing metrics.
+func (r *StatsdReporter) Stop() {
+	r.quitCh <- true
+}
+
+func (r *StatsdReporter) loop(registry metrics.Registry) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	defer r.conn.Close()
+
+	for {
+		select {
+		case <-r.quitCh:
+			return
+		case <-ticker.C:
+			for _, packet := range r.packets(registry) {
+				if _, err := r.conn.Write(packet); err != nil {
+					logging.VLog().WithFields(logrus.Fields{
+						"err": err,
+					}).Debug("Failed to send metrics to statsd.")
+					break
+				}
+			}
+		}
+	}
+}
+
+// packets renders the registry into datagrams no larger than statsdMaxPacketSize.
+func (r *StatsdReporter) packets(registry metrics.Registry) [][]byte {
+	lines := r.lines(registry)
+	packets := make([][]byte, 0)
+	buf := new(bytes.Buffer)
+	for _, line := range lines {
+		if buf.Len() > 0 && buf.Len()+len(line)+1 > statsdMaxPacketSize {
+			packets = append(packets, buf.Bytes())
+			buf = new(bytes.Buffer)
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(line)
+	}
+	if buf.Len() > 0 {
+		packets = append(packets, buf.Bytes())
+	}
+	return packets
+}
+
+func (r *StatsdReporter) lines(registry metrics.Registry) []string {
+	names := make([]string, 0)
+	all := make(map[string]interface{})
+	registry.Each(func(name string, i interface{}) {
+		names = append(names, name)
+		all[name] = i
+	})
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		n := r.prefix + name
+		switch m := all[name].(type) {
+		case metrics.Counter:
+			lines = append(lines, r.line(n, r.delta(name, m.Count()), "c"))
+		case metrics.Gauge:
+			lines = append(lines, r.line(n, m.Value(), "g"))
+		case metrics.GaugeFloat64:
+			lines = append(lines, r.line(n, m.Value(), "g"))
+		case metrics.Meter:
+			s := m.Snapshot()
+			lines = append(lines, r.line(n, r.delta(name, s.Count()), "c"))
+			lines = append(lines, r.line(n+".rate1m", s.Rate1(), "g"))
+		case metrics.Timer:
+			s := m.Snapshot()
+			lines = append(lines, r.line(n+".count", r.delta(name, s.Count()), "c"))
+			lines = append(lines, r.percentileLines(n, s.Percentiles(quantiles))...)
+		case metrics.Histogram:
+			s := m.Snapshot()
+			lines = append(lines, r.line(n+".count", r.delta(name, s.Count()), "c"))
+			lines = append(lines, r.percentileLines(n, s.Percentiles(quantiles))...)
+		}
+	}
+	return lines
+}
+
+// delta returns the increase of a monotonic count since last report,
+// statsd counters are aggregated by the daemon.
+func (r *StatsdReporter) delta(name string, count int64) int64 {
+	d := count - r.lastCounts[name]
+	r.lastCounts[name] = count
+	return d
+}
+
+func (r *StatsdReporter) percentileLines(name string, ps []float64) []string {
+	lines := make([]string, 0, len(ps))
+	for i, q := range quantiles {
+		lines = append(lines, r.line(fmt.Sprintf("%s.p%d", name, int(q*100)), ps[i], "g"))
+	}
+	return lines
+}
+
+func (r *StatsdReporter) line(name string, value interface{}, typ string) string {
+	return fmt.Sprintf("%s:%v|%s%s", name, value, typ, r.tags)
+}
+
+// formatStatsdTags renders tags as a sorted dogstatsd tag suffix, e.g. |#chainID:100,nodeID:abcdef.
+func formatStatsdTags(tags map[string]string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+":"+tags[k])
+	}
+	return "|#" + strings.Join(pairs, ",")
+}
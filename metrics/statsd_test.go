@@ -0,0 +1,80 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsdReporter_Lines(t *testing.T) {
+	registry := metrics.NewRegistry()
+	counter := metrics.GetOrRegisterCounter("neb.counter", registry)
+	counter.Inc(3)
+	metrics.GetOrRegisterGauge("neb.gauge", registry).Update(7)
+
+	r := NewStatsdReporter("127.0.0.1:8125", "test.", time.Second)
+	r.tags = formatStatsdTags(map[string]string{nodeID: "abcdef", chainID: "100"})
+
+	assert.Equal(t, []string{
+		"test.neb.counter:3|c|#chainID:100,nodeID:abcdef",
+		"test.neb.gauge:7|g|#chainID:100,nodeID:abcdef",
+	}, r.lines(registry))
+
+	// counters are reported as deltas since last report.
+	counter.Inc(2)
+	assert.Equal(t, "test.neb.counter:2|c|#chainID:100,nodeID:abcdef", r.lines(registry)[0])
+}
+
+func TestStatsdReporter_Packets(t *testing.T) {
+	registry := metrics.NewRegistry()
+	for i := 0; i < 100; i++ {
+		metrics.GetOrRegisterGauge(strings.Repeat("g", 20)+string(rune('a'+i%26))+string(rune('a'+i/26)), registry).Update(1)
+	}
+	r := NewStatsdReporter("127.0.0.1:8125", "", time.Second)
+	packets := r.packets(registry)
+	assert.True(t, len(packets) > 1)
+	for _, p := range packets {
+		assert.True(t, len(p) <= statsdMaxPacketSize)
+	}
+}
+
+func TestStatsdReporter_Start(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	registry := metrics.NewRegistry()
+	metrics.GetOrRegisterGauge("neb.gauge", registry).Update(7)
+
+	r := NewStatsdReporter(conn.LocalAddr().String(), "", 10*time.Millisecond)
+	assert.Nil(t, r.Start(registry, map[string]string{nodeID: "abcdef"}))
+	defer r.Stop()
+
+	buf := make([]byte, statsdMaxPacketSize)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "neb.gauge:7|g|#nodeID:abcdef", string(buf[:n]))
+}
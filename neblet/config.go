@@ -23,7 +23,10 @@ import (
 	"os"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/neblet/profile"
 	"github.com/nebulasio/go-nebulas/util/logging"
 )
 
@@ -55,6 +58,41 @@ func LoadConfig(file string) *nebletpb.Config {
 	return pb
 }
 
+// ApplyNetworkProfile presets the chain id, the genesis and the seeds of the
+// network bundled in the binary named by chain.network, if any.
+func ApplyNetworkProfile(conf *nebletpb.Config) error {
+	if conf.Chain == nil || len(conf.Chain.Network) == 0 {
+		return nil
+	}
+	p, err := profile.Get(conf.Chain.Network)
+	if err != nil {
+		return err
+	}
+
+	conf.Chain.ChainId = p.ChainID
+	conf.Chain.Genesis = ""
+	if conf.Network == nil {
+		conf.Network = &nebletpb.NetworkConfig{}
+	}
+	if len(p.Seeds) > 0 {
+		conf.Network.Seed = p.Seeds
+	}
+	return nil
+}
+
+// loadGenesis loads the genesis conf of the file, or of the bundled network
+// when no file is given.
+func loadGenesis(conf *nebletpb.ChainConfig) (*corepb.Genesis, error) {
+	if len(conf.Genesis) == 0 && len(conf.Network) > 0 {
+		p, err := profile.Get(conf.Network)
+		if err != nil {
+			return nil, err
+		}
+		return p.Genesis()
+	}
+	return core.LoadGenesisConf(conf.Genesis)
+}
+
 func defaultConfig() string {
 	content := `
 	network {
@@ -1,10 +1,13 @@
 package neblet
 
 import (
+	"context"
 	"errors"
 	"sync"
 
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/cmd/console"
@@ -12,16 +15,26 @@ import (
 	"github.com/nebulasio/go-nebulas/consensus/dpos"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/feature"
 	"github.com/nebulasio/go-nebulas/metrics"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/rpc"
+	"github.com/nebulasio/go-nebulas/selfcheck"
 	"github.com/nebulasio/go-nebulas/storage"
 	nsync "github.com/nebulasio/go-nebulas/sync"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/audit"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/crash"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/secret"
+	"github.com/nebulasio/go-nebulas/util/tracing"
+	"github.com/nebulasio/go-nebulas/watchdog"
 	m "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
@@ -56,6 +69,10 @@ type Neblet struct {
 
 	syncManager *nsync.Manager
 
+	watchdog *watchdog.Watchdog
+
+	compactionScheduler *storage.CompactionScheduler
+
 	apiServer rpc.Server
 
 	managementServer rpc.Server
@@ -64,6 +81,14 @@ type Neblet struct {
 
 	eventEmitter *core.EventEmitter
 
+	// hosted is true for the chains hosted by another neblet in the process.
+	hosted   bool
+	registry *Registry
+
+	diagnostics *selfcheck.Report
+
+	features *feature.Flags
+
 	running bool
 }
 
@@ -71,10 +96,13 @@ type Neblet struct {
 func New(config nebletpb.Config) (*Neblet, error) {
 	var err error
 	n := &Neblet{config: config}
-	n.genesis, err = core.LoadGenesisConf(config.Chain.Genesis)
+	n.genesis, err = loadGenesis(config.Chain)
 	if err != nil {
 		return nil, err
 	}
+	if n.features, err = feature.New(config.Features); err != nil {
+		return nil, err
+	}
 	n.accountManager = account.NewManager(n)
 	return n, nil
 }
@@ -83,26 +111,146 @@ func New(config nebletpb.Config) (*Neblet, error) {
 func (n *Neblet) Setup() error {
 	var err error
 	//var err error
+	if !n.hosted {
+		if n.config.App != nil && len(n.config.App.AuditLog) > 0 {
+			if err = audit.Init(n.config.App.AuditLog); err != nil {
+				return err
+			}
+		}
+		panicReportDir := os.TempDir()
+		if n.config.App != nil && len(n.config.App.PanicReportDir) > 0 {
+			panicReportDir = n.config.App.PanicReportDir
+		}
+		if err = crash.Init(panicReportDir); err != nil {
+			return err
+		}
+		if n.config.Nvm != nil && n.config.Nvm.Sandbox {
+			if err = nvm.EnableSandbox(); err != nil {
+				return err
+			}
+		}
+	}
+	n.checkPorts()
 	n.netService, err = p2p.NewNetService(n)
 	if err != nil {
 		return err
 	}
-	n.storage, err = storage.NewDiskStorage(n.config.Chain.Datadir)
+	if err = n.bootstrapSnapshot(); err != nil {
+		return err
+	}
+	diskStorage, err := storage.NewDiskStorage(n.config.Chain.Datadir)
 	// storage, err := storage.NewMemoryStorage()
 	if err != nil {
 		return err
 	}
+	n.storage = diskStorage
+	n.compactionScheduler, err = storage.NewCompactionScheduler(diskStorage, n.config.Storage.GetCompactionAt())
+	if err != nil {
+		return err
+	}
+	// the state pruner must see every write, whichever view it goes through.
+	var guard *storage.GuardedStorage
+	pruneConf := n.pruneConfig()
+	if pruneConf != nil {
+		guard = storage.NewGuardedStorage(n.storage)
+		n.storage = guard
+	}
+	if !n.hosted && n.config.Stats != nil && n.config.Stats.Tracing != nil && n.config.Stats.Tracing.Enable {
+		tracingConf := n.config.Stats.Tracing
+		if err = tracing.Setup("neb", tracingConf.JaegerEndpoint, tracingConf.SampleRatio,
+			attribute.Int64("chainID", int64(n.config.Chain.ChainId))); err != nil {
+			return err
+		}
+		if tracingConf.TraceStorage {
+			n.storage = storage.NewTracedStorage(n.storage)
+		}
+	}
+	if n.config.Stats != nil && n.config.Stats.EnableMetrics {
+		// storage not written through a namespaced view is state, i.e. trie nodes.
+		n.storage = storage.NewMeteredStorage(n.storage, storage.NamespaceState)
+	}
 	if err = n.checkSchemeVersion(n.storage); err != nil {
 		return err
 	}
 	n.eventEmitter = core.NewEventEmitter(1024)
+	if eventConf := n.config.Event; eventConf != nil {
+		policy, err := core.ParseDropPolicy(eventConf.DropPolicy)
+		if err != nil {
+			return err
+		}
+		n.eventEmitter.SetSubscriberConfig(&core.SubscriberConfig{
+			QueueSize: int(eventConf.QueueSize),
+			Policy:    policy,
+			CatchUp:   eventConf.CatchUp,
+		})
+		if eventConf.CatchUp {
+			n.eventEmitter.SetCatchUpStorage(n.storage)
+		}
+	}
 	n.blockChain, err = core.NewBlockChain(n)
 	if err != nil {
 		return err
 	}
+	n.selfCheck()
+	if storageConf := n.config.Storage; storageConf != nil && (storageConf.BlockCache > 0 || storageConf.HeaderCache > 0) {
+		cacheConf := core.DefaultBlockCacheConfig()
+		if storageConf.BlockCache > 0 {
+			cacheConf.Blocks = int(storageConf.BlockCache)
+		}
+		if storageConf.HeaderCache > 0 {
+			cacheConf.Headers = int(storageConf.HeaderCache)
+		}
+		if err = n.blockChain.SetBlockCacheConfig(cacheConf); err != nil {
+			return err
+		}
+	}
+	if pruneConf != nil {
+		if _, err = n.blockChain.EnableStatePruning(&core.PruneConfig{
+			KeepRecent:         pruneConf.KeepRecent,
+			CheckpointInterval: pruneConf.CheckpointInterval,
+			Interval:           time.Duration(pruneConf.Interval) * time.Second,
+		}, guard); err != nil {
+			return err
+		}
+	}
+	if n.config.Chain.Analytics {
+		n.blockChain.EnableAnalytics()
+	}
+	n.blockChain.SetGasPriceBlocks(int(n.config.Chain.GasPriceBlocks))
 	gasPrice := util.NewUint128FromString(n.config.Chain.GasPrice)
 	gasLimit := util.NewUint128FromString(n.config.Chain.GasLimit)
 	n.blockChain.TransactionPool().SetGasConfig(gasPrice, gasLimit)
+	if netConf := n.config.Network; netConf != nil && netConf.TxRelay != nil {
+		n.blockChain.TransactionPool().SetRelayConfig(&core.TxRelayConfig{
+			Fanout:        int(netConf.TxRelay.Fanout),
+			BatchInterval: time.Duration(netConf.TxRelay.BatchIntervalMs) * time.Millisecond,
+			MaxDelay:      time.Duration(netConf.TxRelay.MaxDelayMs) * time.Millisecond,
+		})
+	}
+
+	if rpcConf := n.config.Rpc; rpcConf != nil && rpcConf.Estimate != nil {
+		n.blockChain.SetEstimatePool(core.NewEstimatePool(&core.EstimatePoolConfig{
+			Workers:     int(rpcConf.Estimate.Workers),
+			Queue:       int(rpcConf.Estimate.Queue),
+			Timeout:     time.Duration(rpcConf.Estimate.TimeoutMs) * time.Millisecond,
+			MaxGas:      util.NewUint128FromString(rpcConf.Estimate.MaxGas),
+			MemoryLimit: rpcConf.Estimate.MemoryLimit,
+		}))
+	}
+
+	if watchConf := n.config.Watch; watchConf != nil {
+		watchList := n.blockChain.WatchList()
+		watchList.SetWebhook(watchConf.Webhook)
+		for _, v := range watchConf.Addresses {
+			addr, err := core.AddressParse(v)
+			if err != nil {
+				return err
+			}
+			if err := watchList.Watch(addr); err != nil {
+				return err
+			}
+		}
+	}
 
 	n.blockChain.BlockPool().RegisterInNetwork(n.netService)
 	n.blockChain.TransactionPool().RegisterInNetwork(n.netService)
@@ -116,8 +264,16 @@ func (n *Neblet) Setup() error {
 	// start sync service
 	n.syncManager = nsync.NewManager(n.blockChain, n.consensus, n.netService)
 
+	// the rpc of the hosted chains is served by the primary one.
+	if n.hosted {
+		return nil
+	}
 	n.apiServer = rpc.NewAPIServer(n)
-	return nil
+
+	if n.config.Watchdog != nil && n.config.Watchdog.Enable {
+		n.watchdog = watchdog.NewWatchdog(n.config.Watchdog, n.config.Chain.Datadir, n.eventEmitter, n.blockChain.BlockPool(), n.syncManager, n.apiServer)
+	}
+	return n.setupChains()
 }
 
 // Start starts the services of the neblet.
@@ -132,8 +288,13 @@ func (n *Neblet) Start() error {
 	}
 	n.running = true
 
-	if n.config.Stats.EnableMetrics {
-		go metrics.Start(n)
+	if !n.hosted && n.config.Stats.EnableMetrics {
+		if err := metrics.Start(n); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to start metrics.")
+			return err
+		}
 	}
 
 	// start.
@@ -141,19 +302,49 @@ func (n *Neblet) Start() error {
 		return err
 	}
 
-	go n.apiServer.Start()
-	go n.apiServer.RunGateway()
+	if n.apiServer != nil {
+		go n.apiServer.Start()
+		go n.apiServer.RunGateway()
+	}
 
 	n.blockChain.BlockPool().Start()
 	n.blockChain.TransactionPool().Start()
 	n.eventEmitter.Start()
+	n.blockChain.FilterManager().Start()
+	n.blockChain.WatchList().Start()
+	n.blockChain.DepositLedger().Start()
 	n.syncManager.Start()
 
+	if n.watchdog != nil {
+		n.watchdog.Start()
+	}
+
+	n.compactionScheduler.Start()
+	if pruner := n.blockChain.StatePruner(); pruner != nil {
+		pruner.Start()
+	}
+	if analytics := n.blockChain.Analytics(); analytics != nil {
+		analytics.Start()
+	}
+
 	// start consensus
 	n.consensus.Start()
 	if n.config.Chain.StartMine {
 		// prompt for passphrase of miner
 		passphrase := n.config.Chain.Passphrase
+		if len(passphrase) == 0 {
+			secret, err := passphraseFromSecretProvider(n.config.Chain.PassphraseSecret)
+			if err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"err": err,
+				}).Error("Failed to retrieve the miner passphrase from secret provider.")
+				return err
+			}
+			passphrase = secret
+		}
+		if len(passphrase) == 0 {
+			passphrase = signingPassphraseFromEnv(n.config.Chain.SigningPassphraseEnv)
+		}
 		if len(passphrase) == 0 {
 
 			fmt.Println("***********************************************")
@@ -170,6 +361,14 @@ func (n *Neblet) Start() error {
 		}
 	}
 
+	if n.registry != nil {
+		for _, hosted := range n.registry.hosted() {
+			if err := hosted.Start(); err != nil {
+				return err
+			}
+		}
+	}
+
 	nebstartGauge.Update(1)
 	// TODO: error handling
 	return nil
@@ -182,6 +381,22 @@ func (n *Neblet) Stop() error {
 
 	logging.VLog().Info("Stopping neblet...")
 
+	if n.registry != nil {
+		for _, hosted := range n.registry.hosted() {
+			hosted.Stop()
+		}
+	}
+
+	if n.watchdog != nil {
+		n.watchdog.Stop()
+		n.watchdog = nil
+	}
+
+	if n.compactionScheduler != nil {
+		n.compactionScheduler.Stop()
+		n.compactionScheduler = nil
+	}
+
 	if n.consensus != nil {
 		n.consensus.Stop()
 		n.consensus = nil
@@ -189,6 +404,15 @@ func (n *Neblet) Stop() error {
 
 	if n.blockChain != nil {
 		n.blockChain.BlockPool().Stop()
+		n.blockChain.FilterManager().Stop()
+		n.blockChain.WatchList().Stop()
+		n.blockChain.DepositLedger().Stop()
+		if pruner := n.blockChain.StatePruner(); pruner != nil {
+			pruner.Stop()
+		}
+		if analytics := n.blockChain.Analytics(); analytics != nil {
+			analytics.Stop()
+		}
 		n.blockChain = nil
 	}
 
@@ -212,8 +436,22 @@ func (n *Neblet) Stop() error {
 		n.managementServer = nil
 	}
 
-	if n.config.Stats.EnableMetrics {
-		metrics.Stop()
+	if !n.hosted {
+		if n.config.Stats.EnableMetrics {
+			metrics.Stop()
+		}
+
+		if err := audit.Close(); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to close audit log.")
+		}
+
+		if err := tracing.Shutdown(); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to shutdown tracing.")
+		}
 	}
 
 	n.accountManager = nil
@@ -238,6 +476,15 @@ func (n *Neblet) Config() nebletpb.Config {
 	return n.config
 }
 
+// pruneConfig returns the state pruning config, nil unless storage.prune is
+// set, the node keeps all states by default.
+func (n *Neblet) pruneConfig() *nebletpb.PruneConfig {
+	if n.config.Chain.Archive {
+		return nil
+	}
+	return n.config.Storage.GetPrune()
+}
+
 // Storage returns storage reference.
 func (n *Neblet) Storage() storage.Storage {
 	return n.storage
@@ -246,6 +493,12 @@ func (n *Neblet) Storage() storage.Storage {
 // StartSync starts sync
 func (n *Neblet) StartSync() {
 	n.syncManager.Start()
+
+	if n.watchdog != nil {
+		n.watchdog.Start()
+	}
+
+	n.compactionScheduler.Start()
 }
 
 // BlockChain returns block chain reference.
@@ -273,6 +526,63 @@ func (n *Neblet) Consensus() consensus.Consensus {
 	return n.consensus
 }
 
+// Registry returns the registry of the chains hosted in the process, nil for a hosted chain.
+func (n *Neblet) Registry() *Registry {
+	return n.registry
+}
+
+// Chain returns the neblet of the chain hosted in the process.
+func (n *Neblet) Chain(chainID uint32) (rpc.Neblet, bool) {
+	if n.registry == nil {
+		return n, chainID == n.config.Chain.ChainId
+	}
+	hosted, ok := n.registry.Get(chainID)
+	if !ok {
+		return nil, false
+	}
+	return hosted, true
+}
+
+// passphraseFromSecretProvider retrieves the passphrase from the configured secret provider,
+// empty if no provider is configured.
+func passphraseFromSecretProvider(conf *nebletpb.SecretConfig) (string, error) {
+	provider, err := secret.NewProvider(conf)
+	if err != nil || provider == nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), secret.DefaultTimeout)
+	defer cancel()
+	passphrase, err := provider.Secret(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(passphrase), nil
+}
+
+// signingPassphraseFromEnv reads the signing key passphrase from the environment,
+// the variable is cleared so that child processes never see it.
+func signingPassphraseFromEnv(name string) string {
+	if len(name) == 0 {
+		name = account.DefaultSigningPassphraseEnv
+	}
+	passphrase, ok := os.LookupEnv(name)
+	if !ok {
+		return ""
+	}
+	os.Unsetenv(name)
+	return passphrase
+}
+
+// CompactionScheduler returns storage compaction scheduler reference.
+func (n *Neblet) CompactionScheduler() *storage.CompactionScheduler {
+	return n.compactionScheduler
+}
+
+// Features returns the experimental features enabled on the node.
+func (n *Neblet) Features() *feature.Flags {
+	return n.features
+}
+
 // checks if the storage scheme version is compatiable
 func (n *Neblet) checkSchemeVersion(stor storage.Storage) error {
 	version, err := stor.Get(storageSchemeVersionKey)
@@ -0,0 +1,242 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+// ConfigEnvPrefix is the prefix of the environment variables overriding the
+// config fields, e.g. NEB_CHAIN_CHAIN_ID overrides chain.chain_id.
+const ConfigEnvPrefix = "NEB_"
+
+// Errors of config overrides
+var (
+	ErrUnknownConfigField = errors.New("unknown config field")
+	ErrInvalidOverride    = errors.New("invalid config override, should be field=value")
+)
+
+// ConfigField is a config field able to be overridden by an environment
+// variable or the --set flag. Repeated values are separated by commas.
+// Repeated messages like rpc.tenants can only be set in the config file.
+type ConfigField struct {
+	Path string
+	Env  string
+	Type string
+}
+
+// ConfigFields returns all the overridable config fields, derived from the
+// config schema.
+func ConfigFields() []*ConfigField {
+	fields := []*ConfigField{}
+	collectConfigFields(reflect.TypeOf(nebletpb.Config{}), "", &fields)
+	return fields
+}
+
+func collectConfigFields(t reflect.Type, prefix string, fields *[]*ConfigField) {
+	props := proto.GetProperties(t)
+	for i := 0; i < t.NumField(); i++ {
+		prop := props.Prop[i]
+		if len(prop.OrigName) == 0 {
+			continue
+		}
+		path := prefix + prop.OrigName
+		ft := t.Field(i).Type
+		if ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct {
+			collectConfigFields(ft.Elem(), path+".", fields)
+			continue
+		}
+		typ, ok := configFieldType(ft, prop)
+		if !ok {
+			continue
+		}
+		*fields = append(*fields, &ConfigField{Path: path, Env: configEnvName(path), Type: typ})
+	}
+}
+
+func configFieldType(t reflect.Type, prop *proto.Properties) (string, bool) {
+	if t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		typ, ok := configFieldType(t.Elem(), prop)
+		return "[]" + typ, ok
+	}
+	if len(prop.Enum) > 0 {
+		names := []string{}
+		for name := range proto.EnumValueMap(prop.Enum) {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return strings.Join(names, "|"), true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int32, reflect.Int64,
+		reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return t.Kind().String(), true
+	}
+	return "", false
+}
+
+func configEnvName(path string) string {
+	return ConfigEnvPrefix + strings.ToUpper(strings.Replace(path, ".", "_", -1))
+}
+
+// ApplyConfigEnv overrides the config fields by the environment variables in
+// the "key=value" form of os.Environ().
+func ApplyConfigEnv(conf *nebletpb.Config, environ []string) error {
+	env := configEnv(environ)
+	for _, field := range ConfigFields() {
+		value, ok := env[field.Env]
+		if !ok {
+			continue
+		}
+		if err := SetConfigField(conf, field.Path, value); err != nil {
+			return fmt.Errorf("%s: %v", field.Env, err)
+		}
+	}
+	return nil
+}
+
+// LookupConfigEnv returns the value of the environment variable overriding
+// the config field, e.g. the network to resolve before the other overrides.
+func LookupConfigEnv(environ []string, path string) (string, bool) {
+	value, ok := configEnv(environ)[configEnvName(path)]
+	return value, ok
+}
+
+func configEnv(environ []string) map[string]string {
+	env := make(map[string]string)
+	for _, kv := range environ {
+		if i := strings.Index(kv, "="); i > 0 && strings.HasPrefix(kv, ConfigEnvPrefix) {
+			env[kv[:i]] = kv[i+1:]
+		}
+	}
+	return env
+}
+
+// ApplyConfigOverrides overrides the config fields by "field=value" settings,
+// e.g. "chain.chain_id=1001".
+func ApplyConfigOverrides(conf *nebletpb.Config, overrides []string) error {
+	for _, override := range overrides {
+		i := strings.Index(override, "=")
+		if i <= 0 {
+			return fmt.Errorf("%q: %v", override, ErrInvalidOverride)
+		}
+		if err := SetConfigField(conf, override[:i], override[i+1:]); err != nil {
+			return fmt.Errorf("%q: %v", override, err)
+		}
+	}
+	return nil
+}
+
+// SetConfigField sets the config field of the path, like "chain.chain_id",
+// creating the missing sections.
+func SetConfigField(conf *nebletpb.Config, path string, value string) error {
+	v := reflect.ValueOf(conf).Elem()
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		props := proto.GetProperties(v.Type())
+		index := -1
+		for j := 0; j < v.NumField(); j++ {
+			if props.Prop[j].OrigName == name {
+				index = j
+				break
+			}
+		}
+		if index < 0 {
+			return ErrUnknownConfigField
+		}
+		field := v.Field(index)
+		if i == len(names)-1 {
+			return setConfigValue(field, props.Prop[index], value)
+		}
+		if field.Kind() != reflect.Ptr || field.Type().Elem().Kind() != reflect.Struct {
+			return ErrUnknownConfigField
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		v = field.Elem()
+	}
+	return ErrUnknownConfigField
+}
+
+func setConfigValue(field reflect.Value, prop *proto.Properties, value string) error {
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		values := []string{}
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); len(v) > 0 {
+				values = append(values, v)
+			}
+		}
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, v := range values {
+			if err := setConfigValue(slice.Index(i), prop, v); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	}
+	if len(prop.Enum) > 0 {
+		n, ok := proto.EnumValueMap(prop.Enum)[value]
+		if !ok {
+			return fmt.Errorf("invalid %s: %q", prop.Enum, value)
+		}
+		field.SetInt(int64(n))
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid bool: %q", value)
+		}
+		field.SetBool(b)
+	case reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q", field.Kind(), value)
+		}
+		field.SetInt(n)
+	case reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q", field.Kind(), value)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q", field.Kind(), value)
+		}
+		field.SetFloat(f)
+	default:
+		return ErrUnknownConfigField
+	}
+	return nil
+}
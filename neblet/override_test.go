@@ -0,0 +1,86 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFields(t *testing.T) {
+	fields := make(map[string]*ConfigField)
+	for _, field := range ConfigFields() {
+		fields[field.Path] = field
+	}
+	assert.Equal(t, &ConfigField{Path: "chain.chain_id", Env: "NEB_CHAIN_CHAIN_ID", Type: "uint32"}, fields["chain.chain_id"])
+	assert.Equal(t, "[]string", fields["rpc.http_listen"].Type)
+	assert.Equal(t, "[]Influxdb|Prometheus|Statsd", fields["stats.reporting_module"].Type)
+	assert.Equal(t, "NEB_CHAIN_PASSPHRASE_SECRET_VAULT_TOKEN_ENV", fields["chain.passphrase_secret.vault.token_env"].Env)
+	assert.Nil(t, fields["rpc.tenants"])
+}
+
+func TestApplyConfigOverrides(t *testing.T) {
+	conf := &nebletpb.Config{
+		Chain: &nebletpb.ChainConfig{ChainId: 100, Datadir: "data.db"},
+	}
+	environ := []string{
+		"NEB_CHAIN_CHAIN_ID=1001",
+		"NEB_RPC_HTTP_LISTEN=0.0.0.0:8685, 0.0.0.0:8686",
+		"NEB_STATS_REPORTING_MODULE=Prometheus,Statsd",
+		"NEB_STATS_TRACING_SAMPLE_RATIO=0.5",
+		"NEB_SIGNING_PASSPHRASE=passphrase",
+		"HOME=/root",
+	}
+	assert.Nil(t, ApplyConfigEnv(conf, environ))
+	assert.Equal(t, uint32(1001), conf.Chain.ChainId)
+	assert.Equal(t, "data.db", conf.Chain.Datadir)
+	assert.Equal(t, []string{"0.0.0.0:8685", "0.0.0.0:8686"}, conf.Rpc.HttpListen)
+	assert.Equal(t, []nebletpb.StatsConfig_ReportingModule{nebletpb.StatsConfig_Prometheus, nebletpb.StatsConfig_Statsd}, conf.Stats.ReportingModule)
+	assert.Equal(t, 0.5, conf.Stats.Tracing.SampleRatio)
+
+	assert.Nil(t, ApplyConfigOverrides(conf, []string{"chain.chain_id=1002", "chain.start_mine=true", "rpc.http_listen="}))
+	assert.Equal(t, uint32(1002), conf.Chain.ChainId)
+	assert.True(t, conf.Chain.StartMine)
+	assert.Equal(t, []string{}, conf.Rpc.HttpListen)
+
+	assert.NotNil(t, ApplyConfigEnv(conf, []string{"NEB_CHAIN_CHAIN_ID=main"}))
+	assert.NotNil(t, ApplyConfigOverrides(conf, []string{"chain.start_mine"}))
+	assert.NotNil(t, ApplyConfigOverrides(conf, []string{"chain.pruning=true"}))
+	assert.NotNil(t, ApplyConfigOverrides(conf, []string{"rpc.tenants=a"}))
+	assert.NotNil(t, ApplyConfigOverrides(conf, []string{"stats.reporting_module=Graphite"}))
+}
+
+func TestApplyConfigEnvAfterProfile(t *testing.T) {
+	conf := &nebletpb.Config{Chain: &nebletpb.ChainConfig{ChainId: 1}}
+	environ := []string{"NEB_CHAIN_NETWORK=devnet", "NEB_NETWORK_SEED=/ip4/127.0.0.1/tcp/8680/ipfs/seed"}
+
+	network, ok := LookupConfigEnv(environ, "chain.network")
+	assert.True(t, ok)
+	assert.Nil(t, SetConfigField(conf, "chain.network", network))
+	assert.Nil(t, ApplyNetworkProfile(conf))
+	assert.Equal(t, uint32(100), conf.Chain.ChainId)
+
+	assert.Nil(t, ApplyConfigEnv(conf, environ))
+	assert.Equal(t, []string{"/ip4/127.0.0.1/tcp/8680/ipfs/seed"}, conf.Network.Seed)
+
+	_, ok = LookupConfigEnv(environ, "chain.chain_id")
+	assert.False(t, ok)
+}
@@ -10,11 +10,32 @@ It is generated from these files:
 It has these top-level messages:
 	Config
 	NetworkConfig
+	TxRelayConfig
 	ChainConfig
 	RPCConfig
+	GrpcTransportConfig
+	HttpTransportConfig
+	EstimateConfig
+	HttpCorsConfig
+	TenantConfig
 	AppConfig
+	SecretConfig
+	VaultSecretConfig
+	AwsKmsSecretConfig
+	GcpKmsSecretConfig
+	TxPolicyConfig
+	StorageConfig
+	SnapshotConfig
+	PruneConfig
+	WatchdogConfig
+	EventConfig
+	WatchConfig
+	NvmConfig
 	MiscConfig
 	StatsConfig
+	TracingConfig
+	PrometheusConfig
+	StatsdConfig
 	InfluxdbConfig
 */
 package nebletpb
@@ -34,25 +55,61 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// Secret providers.
+type SecretConfig_Provider int32
+
+const (
+	SecretConfig_None   SecretConfig_Provider = 0
+	SecretConfig_Vault  SecretConfig_Provider = 1
+	SecretConfig_AwsKms SecretConfig_Provider = 2
+	SecretConfig_GcpKms SecretConfig_Provider = 3
+)
+
+var SecretConfig_Provider_name = map[int32]string{
+	0: "None",
+	1: "Vault",
+	2: "AwsKms",
+	3: "GcpKms",
+}
+var SecretConfig_Provider_value = map[string]int32{
+	"None":   0,
+	"Vault":  1,
+	"AwsKms": 2,
+	"GcpKms": 3,
+}
+
+func (x SecretConfig_Provider) String() string {
+	return proto.EnumName(SecretConfig_Provider_name, int32(x))
+}
+func (SecretConfig_Provider) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorConfig, []int{11, 0}
+}
+
 // Reporting modules.
 type StatsConfig_ReportingModule int32
 
 const (
-	StatsConfig_Influxdb StatsConfig_ReportingModule = 0
+	StatsConfig_Influxdb   StatsConfig_ReportingModule = 0
+	StatsConfig_Prometheus StatsConfig_ReportingModule = 1
+	StatsConfig_Statsd     StatsConfig_ReportingModule = 2
 )
 
 var StatsConfig_ReportingModule_name = map[int32]string{
 	0: "Influxdb",
+	1: "Prometheus",
+	2: "Statsd",
 }
 var StatsConfig_ReportingModule_value = map[string]int32{
-	"Influxdb": 0,
+	"Influxdb":   0,
+	"Prometheus": 1,
+	"Statsd":     2,
 }
 
 func (x StatsConfig_ReportingModule) String() string {
 	return proto.EnumName(StatsConfig_ReportingModule_name, int32(x))
 }
 func (StatsConfig_ReportingModule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorConfig, []int{6, 0}
+	return fileDescriptorConfig, []int{24, 0}
 }
 
 // Neblet global configurations.
@@ -69,6 +126,25 @@ type Config struct {
 	Misc *MiscConfig `protobuf:"bytes,101,opt,name=misc" json:"misc,omitempty"`
 	// App Config.
 	App *AppConfig `protobuf:"bytes,102,opt,name=app" json:"app,omitempty"`
+	// Watchdog config.
+	Watchdog *WatchdogConfig `protobuf:"bytes,103,opt,name=watchdog" json:"watchdog,omitempty"`
+	// Storage config.
+	Storage *StorageConfig `protobuf:"bytes,104,opt,name=storage" json:"storage,omitempty"`
+	// Policy of transactions signed with local keys.
+	TxPolicy *TxPolicyConfig `protobuf:"bytes,105,opt,name=tx_policy,json=txPolicy" json:"tx_policy,omitempty"`
+	// Event emitter config.
+	Event *EventConfig `protobuf:"bytes,106,opt,name=event" json:"event,omitempty"`
+	// Config files of the other chains hosted in this process, each with its own
+	// chain id, datadir and p2p listen. Their rpc is served by this node, selected
+	// by the chain id of the requests.
+	Chains []string `protobuf:"bytes,107,rep,name=chains" json:"chains,omitempty"`
+	// Addresses watched by the node.
+	Watch *WatchConfig `protobuf:"bytes,108,opt,name=watch" json:"watch,omitempty"`
+	// Contract VM config.
+	Nvm *NvmConfig `protobuf:"bytes,109,opt,name=nvm" json:"nvm,omitempty"`
+	// Experimental features enabled on this node, all disabled by default.
+	// Listed with their state by the admin rpc getFeatures.
+	Features []string `protobuf:"bytes,110,rep,name=features" json:"features,omitempty"`
 }
 
 func (m *Config) Reset()                    { *m = Config{} }
@@ -118,6 +194,62 @@ func (m *Config) GetApp() *AppConfig {
 	return nil
 }
 
+func (m *Config) GetWatchdog() *WatchdogConfig {
+	if m != nil {
+		return m.Watchdog
+	}
+	return nil
+}
+
+func (m *Config) GetStorage() *StorageConfig {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *Config) GetTxPolicy() *TxPolicyConfig {
+	if m != nil {
+		return m.TxPolicy
+	}
+	return nil
+}
+
+func (m *Config) GetEvent() *EventConfig {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *Config) GetChains() []string {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *Config) GetWatch() *WatchConfig {
+	if m != nil {
+		return m.Watch
+	}
+	return nil
+}
+
+func (m *Config) GetNvm() *NvmConfig {
+	if m != nil {
+		return m.Nvm
+	}
+	return nil
+}
+
+func (m *Config) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 type NetworkConfig struct {
 	// Neb seed node address.
 	Seed []string `protobuf:"bytes,1,rep,name=seed" json:"seed,omitempty"`
@@ -127,6 +259,18 @@ type NetworkConfig struct {
 	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
 	// Network ID
 	NetworkId uint32 `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Discover the nodes of the local network by mDNS, which lets a dev
+	// cluster on one LAN find each other without seeds.
+	Mdns bool `protobuf:"varint,5,opt,name=mdns,proto3" json:"mdns,omitempty"`
+	// Gossip policy of the transactions, sent to all peers at once if not set.
+	TxRelay *TxRelayConfig `protobuf:"bytes,6,opt,name=tx_relay,json=txRelay" json:"tx_relay,omitempty"`
+	// Role of the node in a sentry topology, empty for a public node.
+	// "validator" connects to its private peers only, which are its sentries.
+	// "sentry" joins the public network and keeps its private peers, the
+	// validators behind it, connected and unknown to the other peers.
+	Mode string `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Addresses of the private peers as /ip4/<ip>/tcp/<port>/ipfs/<id>.
+	PrivatePeers []string `protobuf:"bytes,8,rep,name=private_peers,json=privatePeers" json:"private_peers,omitempty"`
 }
 
 func (m *NetworkConfig) Reset()                    { *m = NetworkConfig{} }
@@ -162,11 +306,78 @@ func (m *NetworkConfig) GetNetworkId() uint32 {
 	return 0
 }
 
+func (m *NetworkConfig) GetMdns() bool {
+	if m != nil {
+		return m.Mdns
+	}
+	return false
+}
+
+func (m *NetworkConfig) GetTxRelay() *TxRelayConfig {
+	if m != nil {
+		return m.TxRelay
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetPrivatePeers() []string {
+	if m != nil {
+		return m.PrivatePeers
+	}
+	return nil
+}
+
+type TxRelayConfig struct {
+	// Peers a transaction is sent to, chosen at random, 0 means all peers.
+	Fanout uint32 `protobuf:"varint,1,opt,name=fanout,proto3" json:"fanout,omitempty"`
+	// Milliseconds the transactions to send are batched, 0 sends them at once.
+	BatchIntervalMs uint32 `protobuf:"varint,2,opt,name=batch_interval_ms,json=batchIntervalMs,proto3" json:"batch_interval_ms,omitempty"`
+	// Max milliseconds a transaction is delayed at random before sent, so that
+	// the first peers seeing it can't tell it came from this node.
+	MaxDelayMs uint32 `protobuf:"varint,3,opt,name=max_delay_ms,json=maxDelayMs,proto3" json:"max_delay_ms,omitempty"`
+}
+
+func (m *TxRelayConfig) Reset()                    { *m = TxRelayConfig{} }
+func (m *TxRelayConfig) String() string            { return proto.CompactTextString(m) }
+func (*TxRelayConfig) ProtoMessage()               {}
+func (*TxRelayConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{2} }
+
+func (m *TxRelayConfig) GetFanout() uint32 {
+	if m != nil {
+		return m.Fanout
+	}
+	return 0
+}
+
+func (m *TxRelayConfig) GetBatchIntervalMs() uint32 {
+	if m != nil {
+		return m.BatchIntervalMs
+	}
+	return 0
+}
+
+func (m *TxRelayConfig) GetMaxDelayMs() uint32 {
+	if m != nil {
+		return m.MaxDelayMs
+	}
+	return 0
+}
+
 type ChainConfig struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// genesis conf file path
 	Genesis string `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	// Network bundled in the binary, mainnet, testnet or devnet, whose genesis
+	// is used when genesis is empty.
+	Network string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
 	// Data dir.
 	Datadir string `protobuf:"bytes,11,opt,name=datadir,proto3" json:"datadir,omitempty"`
 	// Key dir.
@@ -179,18 +390,34 @@ type ChainConfig struct {
 	Miner string `protobuf:"bytes,22,opt,name=miner,proto3" json:"miner,omitempty"`
 	// Passphrase.
 	Passphrase string `protobuf:"bytes,23,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Dir of the block signing key, kept apart from the wallet keys in keydir.
+	// When set, the miner key only signs blocks and is never usable through account APIs.
+	SigningKeydir string `protobuf:"bytes,27,opt,name=signing_keydir,json=signingKeydir,proto3" json:"signing_keydir,omitempty"`
+	// Environment variable holding the signing key passphrase at boot, default NEB_SIGNING_PASSPHRASE.
+	SigningPassphraseEnv string `protobuf:"bytes,28,opt,name=signing_passphrase_env,json=signingPassphraseEnv,proto3" json:"signing_passphrase_env,omitempty"`
+	// Secret provider of the miner passphrase, used when passphrase is empty.
+	PassphraseSecret *SecretConfig `protobuf:"bytes,29,opt,name=passphrase_secret,json=passphraseSecret" json:"passphrase_secret,omitempty"`
 	// Lowest GasPrice.
 	GasPrice string `protobuf:"bytes,24,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 	// Max GasLimit.
 	GasLimit string `protobuf:"bytes,25,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// Supported signature cipher list. ["ECC_SECP256K1"]
 	SignatureCiphers []string `protobuf:"bytes,26,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers,omitempty"`
+	// Attach execution witnesses to the minted blocks for stateless verification.
+	Witness bool `protobuf:"varint,30,opt,name=witness,proto3" json:"witness,omitempty"`
+	// Keep the world states of all blocks, e.g. for explorers, whatever
+	// storage.prune. The states are all kept unless storage.prune is set.
+	Archive bool `protobuf:"varint,31,opt,name=archive,proto3" json:"archive,omitempty"`
+	// Maintain the daily aggregates of the chain, queried by the rpc.
+	Analytics bool `protobuf:"varint,32,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	// Number of recent blocks sampled by the gas price oracle, 20 if 0.
+	GasPriceBlocks uint32 `protobuf:"varint,33,opt,name=gas_price_blocks,json=gasPriceBlocks,proto3" json:"gas_price_blocks,omitempty"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
 func (m *ChainConfig) String() string            { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()               {}
-func (*ChainConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{2} }
+func (*ChainConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{3} }
 
 func (m *ChainConfig) GetChainId() uint32 {
 	if m != nil {
@@ -206,6 +433,13 @@ func (m *ChainConfig) GetGenesis() string {
 	return ""
 }
 
+func (m *ChainConfig) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
 func (m *ChainConfig) GetDatadir() string {
 	if m != nil {
 		return m.Datadir
@@ -248,6 +482,27 @@ func (m *ChainConfig) GetPassphrase() string {
 	return ""
 }
 
+func (m *ChainConfig) GetSigningKeydir() string {
+	if m != nil {
+		return m.SigningKeydir
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetSigningPassphraseEnv() string {
+	if m != nil {
+		return m.SigningPassphraseEnv
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetPassphraseSecret() *SecretConfig {
+	if m != nil {
+		return m.PassphraseSecret
+	}
+	return nil
+}
+
 func (m *ChainConfig) GetGasPrice() string {
 	if m != nil {
 		return m.GasPrice
@@ -269,6 +524,34 @@ func (m *ChainConfig) GetSignatureCiphers() []string {
 	return nil
 }
 
+func (m *ChainConfig) GetWitness() bool {
+	if m != nil {
+		return m.Witness
+	}
+	return false
+}
+
+func (m *ChainConfig) GetArchive() bool {
+	if m != nil {
+		return m.Archive
+	}
+	return false
+}
+
+func (m *ChainConfig) GetAnalytics() bool {
+	if m != nil {
+		return m.Analytics
+	}
+	return false
+}
+
+func (m *ChainConfig) GetGasPriceBlocks() uint32 {
+	if m != nil {
+		return m.GasPriceBlocks
+	}
+	return 0
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen,omitempty"`
@@ -276,12 +559,30 @@ type RPCConfig struct {
 	HttpListen []string `protobuf:"bytes,2,rep,name=http_listen,json=httpListen" json:"http_listen,omitempty"`
 	// Enabled HTTP modules.["api", "admin"]
 	HttpModule []string `protobuf:"bytes,3,rep,name=http_module,json=httpModule" json:"http_module,omitempty"`
+	// Tenants sharing the rpc, requests must carry a tenant api key once any is configured.
+	Tenants []*TenantConfig `protobuf:"bytes,4,rep,name=tenants" json:"tenants,omitempty"`
+	// CORS of the HTTP gateway, any origin is allowed if not set.
+	HttpCors *HttpCorsConfig `protobuf:"bytes,5,opt,name=http_cors,json=httpCors" json:"http_cors,omitempty"`
+	// Limits of the gas estimations, the defaults if not set.
+	Estimate *EstimateConfig `protobuf:"bytes,6,opt,name=estimate" json:"estimate,omitempty"`
+	// Percent of GOMAXPROCS taken by the api requests at most, default to 75.
+	CpuQuota uint32 `protobuf:"varint,7,opt,name=cpu_quota,json=cpuQuota,proto3" json:"cpu_quota,omitempty"`
+	// Percent of GOMAXPROCS taken by the api requests while blocks are produced
+	// or verified, default to 25.
+	BusyCpuQuota uint32 `protobuf:"varint,8,opt,name=busy_cpu_quota,json=busyCpuQuota,proto3" json:"busy_cpu_quota,omitempty"`
+	// Milliseconds an api request runs including its wait, the deadline of the
+	// client applies if sooner. Default to 30000.
+	RequestTimeoutMs uint32 `protobuf:"varint,9,opt,name=request_timeout_ms,json=requestTimeoutMs,proto3" json:"request_timeout_ms,omitempty"`
+	// Connections and messages of the rpc listeners, the defaults if not set.
+	Grpc *GrpcTransportConfig `protobuf:"bytes,10,opt,name=grpc" json:"grpc,omitempty"`
+	// Connections of the http listeners including the websocket upgrades, the defaults if not set.
+	Http *HttpTransportConfig `protobuf:"bytes,11,opt,name=http" json:"http,omitempty"`
 }
 
 func (m *RPCConfig) Reset()                    { *m = RPCConfig{} }
 func (m *RPCConfig) String() string            { return proto.CompactTextString(m) }
 func (*RPCConfig) ProtoMessage()               {}
-func (*RPCConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{3} }
+func (*RPCConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{4} }
 
 func (m *RPCConfig) GetRpcListen() []string {
 	if m != nil {
@@ -304,111 +605,1094 @@ func (m *RPCConfig) GetHttpModule() []string {
 	return nil
 }
 
-type AppConfig struct {
-	LogLevel          string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
-	LogFile           string `protobuf:"bytes,2,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
-	EnableCrashReport bool   `protobuf:"varint,3,opt,name=enable_crash_report,json=enableCrashReport,proto3" json:"enable_crash_report,omitempty"`
-	CrashReportUrl    string `protobuf:"bytes,4,opt,name=crash_report_url,json=crashReportUrl,proto3" json:"crash_report_url,omitempty"`
-	Version           string `protobuf:"bytes,100,opt,name=version,proto3" json:"version,omitempty"`
+func (m *RPCConfig) GetTenants() []*TenantConfig {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
 }
 
-func (m *AppConfig) Reset()                    { *m = AppConfig{} }
-func (m *AppConfig) String() string            { return proto.CompactTextString(m) }
-func (*AppConfig) ProtoMessage()               {}
-func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{4} }
+func (m *RPCConfig) GetHttpCors() *HttpCorsConfig {
+	if m != nil {
+		return m.HttpCors
+	}
+	return nil
+}
 
-func (m *AppConfig) GetLogLevel() string {
+func (m *RPCConfig) GetEstimate() *EstimateConfig {
 	if m != nil {
-		return m.LogLevel
+		return m.Estimate
 	}
-	return ""
+	return nil
 }
 
-func (m *AppConfig) GetLogFile() string {
+func (m *RPCConfig) GetCpuQuota() uint32 {
 	if m != nil {
-		return m.LogFile
+		return m.CpuQuota
 	}
-	return ""
+	return 0
 }
 
-func (m *AppConfig) GetEnableCrashReport() bool {
+func (m *RPCConfig) GetBusyCpuQuota() uint32 {
 	if m != nil {
-		return m.EnableCrashReport
+		return m.BusyCpuQuota
 	}
-	return false
+	return 0
 }
 
-func (m *AppConfig) GetCrashReportUrl() string {
+func (m *RPCConfig) GetRequestTimeoutMs() uint32 {
 	if m != nil {
-		return m.CrashReportUrl
+		return m.RequestTimeoutMs
 	}
-	return ""
+	return 0
 }
 
-func (m *AppConfig) GetVersion() string {
+func (m *RPCConfig) GetGrpc() *GrpcTransportConfig {
 	if m != nil {
-		return m.Version
+		return m.Grpc
 	}
-	return ""
+	return nil
 }
 
-type MiscConfig struct {
-	// Default encryption ciper when create new keystore file.
-	DefaultKeystoreFileCiper string `protobuf:"bytes,1,opt,name=default_keystore_file_ciper,json=defaultKeystoreFileCiper,proto3" json:"default_keystore_file_ciper,omitempty"`
+func (m *RPCConfig) GetHttp() *HttpTransportConfig {
+	if m != nil {
+		return m.Http
+	}
+	return nil
 }
 
-func (m *MiscConfig) Reset()                    { *m = MiscConfig{} }
-func (m *MiscConfig) String() string            { return proto.CompactTextString(m) }
-func (*MiscConfig) ProtoMessage()               {}
-func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+type GrpcTransportConfig struct {
+	// Max bytes of a received message, default to 4MB.
+	MaxRecvMsgSize uint32 `protobuf:"varint,1,opt,name=max_recv_msg_size,json=maxRecvMsgSize,proto3" json:"max_recv_msg_size,omitempty"`
+	// Max bytes of a sent message, also received by the http gateway. Default to 4MB.
+	MaxSendMsgSize uint32 `protobuf:"varint,2,opt,name=max_send_msg_size,json=maxSendMsgSize,proto3" json:"max_send_msg_size,omitempty"`
+	// Connections accepted by a listener at the same time, 0 means unlimited.
+	MaxConnections uint32 `protobuf:"varint,3,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// Milliseconds a connection stays without any stream before closed, 0 means forever.
+	MaxConnectionIdleMs uint32 `protobuf:"varint,4,opt,name=max_connection_idle_ms,json=maxConnectionIdleMs,proto3" json:"max_connection_idle_ms,omitempty"`
+	// Milliseconds without activity before the server pings the client, default to 7200000.
+	KeepaliveTimeMs uint32 `protobuf:"varint,5,opt,name=keepalive_time_ms,json=keepaliveTimeMs,proto3" json:"keepalive_time_ms,omitempty"`
+	// Milliseconds to wait for the ping ack before the connection is closed, default to 20000.
+	KeepaliveTimeoutMs uint32 `protobuf:"varint,6,opt,name=keepalive_timeout_ms,json=keepaliveTimeoutMs,proto3" json:"keepalive_timeout_ms,omitempty"`
+	// Milliseconds the clients wait between pings at least, the connection of a
+	// client pinging more often is closed. Default to 300000.
+	KeepaliveMinTimeMs uint32 `protobuf:"varint,7,opt,name=keepalive_min_time_ms,json=keepaliveMinTimeMs,proto3" json:"keepalive_min_time_ms,omitempty"`
+	// Whether the clients can ping while there is no stream.
+	KeepalivePermitWithoutStream bool `protobuf:"varint,8,opt,name=keepalive_permit_without_stream,json=keepalivePermitWithoutStream,proto3" json:"keepalive_permit_without_stream,omitempty"`
+}
 
-func (m *MiscConfig) GetDefaultKeystoreFileCiper() string {
+func (m *GrpcTransportConfig) Reset()                    { *m = GrpcTransportConfig{} }
+func (m *GrpcTransportConfig) String() string            { return proto.CompactTextString(m) }
+func (*GrpcTransportConfig) ProtoMessage()               {}
+func (*GrpcTransportConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+
+func (m *GrpcTransportConfig) GetMaxRecvMsgSize() uint32 {
 	if m != nil {
-		return m.DefaultKeystoreFileCiper
+		return m.MaxRecvMsgSize
 	}
-	return ""
+	return 0
 }
 
-type StatsConfig struct {
-	// Enable metrics or not.
-	EnableMetrics   bool                          `protobuf:"varint,1,opt,name=enable_metrics,json=enableMetrics,proto3" json:"enable_metrics,omitempty"`
-	ReportingModule []StatsConfig_ReportingModule `protobuf:"varint,2,rep,packed,name=reporting_module,json=reportingModule,enum=nebletpb.StatsConfig_ReportingModule" json:"reporting_module,omitempty"`
-	// Influxdb config.
-	Influxdb    *InfluxdbConfig `protobuf:"bytes,11,opt,name=influxdb" json:"influxdb,omitempty"`
-	MetricsTags []string        `protobuf:"bytes,12,rep,name=metrics_tags,json=metricsTags" json:"metrics_tags,omitempty"`
+func (m *GrpcTransportConfig) GetMaxSendMsgSize() uint32 {
+	if m != nil {
+		return m.MaxSendMsgSize
+	}
+	return 0
 }
 
-func (m *StatsConfig) Reset()                    { *m = StatsConfig{} }
-func (m *StatsConfig) String() string            { return proto.CompactTextString(m) }
-func (*StatsConfig) ProtoMessage()               {}
-func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+func (m *GrpcTransportConfig) GetMaxConnections() uint32 {
+	if m != nil {
+		return m.MaxConnections
+	}
+	return 0
+}
 
-func (m *StatsConfig) GetEnableMetrics() bool {
+func (m *GrpcTransportConfig) GetMaxConnectionIdleMs() uint32 {
 	if m != nil {
-		return m.EnableMetrics
+		return m.MaxConnectionIdleMs
+	}
+	return 0
+}
+
+func (m *GrpcTransportConfig) GetKeepaliveTimeMs() uint32 {
+	if m != nil {
+		return m.KeepaliveTimeMs
+	}
+	return 0
+}
+
+func (m *GrpcTransportConfig) GetKeepaliveTimeoutMs() uint32 {
+	if m != nil {
+		return m.KeepaliveTimeoutMs
+	}
+	return 0
+}
+
+func (m *GrpcTransportConfig) GetKeepaliveMinTimeMs() uint32 {
+	if m != nil {
+		return m.KeepaliveMinTimeMs
+	}
+	return 0
+}
+
+func (m *GrpcTransportConfig) GetKeepalivePermitWithoutStream() bool {
+	if m != nil {
+		return m.KeepalivePermitWithoutStream
 	}
 	return false
 }
 
-func (m *StatsConfig) GetReportingModule() []StatsConfig_ReportingModule {
+type HttpTransportConfig struct {
+	// Milliseconds to read a request including its body, 0 means unlimited.
+	ReadTimeoutMs uint32 `protobuf:"varint,1,opt,name=read_timeout_ms,json=readTimeoutMs,proto3" json:"read_timeout_ms,omitempty"`
+	// Milliseconds to read the headers of a request, default to the read timeout.
+	ReadHeaderTimeoutMs uint32 `protobuf:"varint,2,opt,name=read_header_timeout_ms,json=readHeaderTimeoutMs,proto3" json:"read_header_timeout_ms,omitempty"`
+	// Milliseconds to write a response, 0 means unlimited. It also ends the
+	// streams and websocket connections, so leave it unset where clients subscribe.
+	WriteTimeoutMs uint32 `protobuf:"varint,3,opt,name=write_timeout_ms,json=writeTimeoutMs,proto3" json:"write_timeout_ms,omitempty"`
+	// Milliseconds a keep-alive connection waits for the next request, default to the read timeout.
+	IdleTimeoutMs uint32 `protobuf:"varint,4,opt,name=idle_timeout_ms,json=idleTimeoutMs,proto3" json:"idle_timeout_ms,omitempty"`
+	// Connections accepted by a listener at the same time, 0 means unlimited.
+	MaxConnections uint32 `protobuf:"varint,5,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// Max bytes of the request headers, default to 1MB.
+	MaxHeaderBytes uint32 `protobuf:"varint,6,opt,name=max_header_bytes,json=maxHeaderBytes,proto3" json:"max_header_bytes,omitempty"`
+}
+
+func (m *HttpTransportConfig) Reset()                    { *m = HttpTransportConfig{} }
+func (m *HttpTransportConfig) String() string            { return proto.CompactTextString(m) }
+func (*HttpTransportConfig) ProtoMessage()               {}
+func (*HttpTransportConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+
+func (m *HttpTransportConfig) GetReadTimeoutMs() uint32 {
 	if m != nil {
-		return m.ReportingModule
+		return m.ReadTimeoutMs
 	}
-	return nil
+	return 0
 }
 
-func (m *StatsConfig) GetInfluxdb() *InfluxdbConfig {
+func (m *HttpTransportConfig) GetReadHeaderTimeoutMs() uint32 {
 	if m != nil {
-		return m.Influxdb
+		return m.ReadHeaderTimeoutMs
 	}
-	return nil
+	return 0
 }
 
-func (m *StatsConfig) GetMetricsTags() []string {
+func (m *HttpTransportConfig) GetWriteTimeoutMs() uint32 {
 	if m != nil {
-		return m.MetricsTags
+		return m.WriteTimeoutMs
 	}
-	return nil
+	return 0
+}
+
+func (m *HttpTransportConfig) GetIdleTimeoutMs() uint32 {
+	if m != nil {
+		return m.IdleTimeoutMs
+	}
+	return 0
+}
+
+func (m *HttpTransportConfig) GetMaxConnections() uint32 {
+	if m != nil {
+		return m.MaxConnections
+	}
+	return 0
+}
+
+func (m *HttpTransportConfig) GetMaxHeaderBytes() uint32 {
+	if m != nil {
+		return m.MaxHeaderBytes
+	}
+	return 0
+}
+
+type EstimateConfig struct {
+	// Estimations executed at the same time, default to half of the CPUs.
+	Workers uint32 `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	// Estimations waiting for a worker, the others are refused. Default to 64.
+	Queue uint32 `protobuf:"varint,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Milliseconds an estimation runs including its wait, default to 5000.
+	TimeoutMs uint32 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Gas limit of an estimation, default to the max gas of a transaction.
+	MaxGas string `protobuf:"bytes,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+	// Memory limit in bytes of the contract execution, default to the limit of a transaction.
+	MemoryLimit uint64 `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+}
+
+func (m *EstimateConfig) Reset()                    { *m = EstimateConfig{} }
+func (m *EstimateConfig) String() string            { return proto.CompactTextString(m) }
+func (*EstimateConfig) ProtoMessage()               {}
+func (*EstimateConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
+
+func (m *EstimateConfig) GetWorkers() uint32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *EstimateConfig) GetQueue() uint32 {
+	if m != nil {
+		return m.Queue
+	}
+	return 0
+}
+
+func (m *EstimateConfig) GetTimeoutMs() uint32 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *EstimateConfig) GetMaxGas() string {
+	if m != nil {
+		return m.MaxGas
+	}
+	return ""
+}
+
+func (m *EstimateConfig) GetMemoryLimit() uint64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
+}
+
+type HttpCorsConfig struct {
+	// Allowed origins, like "https://dapp.example.com", "https://*.example.com" or "*".
+	AllowedOrigins []string `protobuf:"bytes,1,rep,name=allowed_origins,json=allowedOrigins" json:"allowed_origins,omitempty"`
+	// Allowed methods, default to ["GET", "HEAD", "POST", "PUT", "DELETE"].
+	AllowedMethods []string `protobuf:"bytes,2,rep,name=allowed_methods,json=allowedMethods" json:"allowed_methods,omitempty"`
+	// Allowed request headers, default to ["Content-Type", "Accept", "Authorization", "Grpc-Metadata-Neb-Api-Key"].
+	AllowedHeaders []string `protobuf:"bytes,3,rep,name=allowed_headers,json=allowedHeaders" json:"allowed_headers,omitempty"`
+	// Whether the response can be exposed when the request has credentials,
+	// requires allowed_origins without "*".
+	AllowCredentials bool `protobuf:"varint,4,opt,name=allow_credentials,json=allowCredentials,proto3" json:"allow_credentials,omitempty"`
+	// Seconds the preflight response can be cached, 0 means not set.
+	MaxAge uint32 `protobuf:"varint,5,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (m *HttpCorsConfig) Reset()                    { *m = HttpCorsConfig{} }
+func (m *HttpCorsConfig) String() string            { return proto.CompactTextString(m) }
+func (*HttpCorsConfig) ProtoMessage()               {}
+func (*HttpCorsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
+
+func (m *HttpCorsConfig) GetAllowedOrigins() []string {
+	if m != nil {
+		return m.AllowedOrigins
+	}
+	return nil
+}
+
+func (m *HttpCorsConfig) GetAllowedMethods() []string {
+	if m != nil {
+		return m.AllowedMethods
+	}
+	return nil
+}
+
+func (m *HttpCorsConfig) GetAllowedHeaders() []string {
+	if m != nil {
+		return m.AllowedHeaders
+	}
+	return nil
+}
+
+func (m *HttpCorsConfig) GetAllowCredentials() bool {
+	if m != nil {
+		return m.AllowCredentials
+	}
+	return false
+}
+
+func (m *HttpCorsConfig) GetMaxAge() uint32 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+type TenantConfig struct {
+	// Tenant name, used as namespace of the tenant usage metrics.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// API key, passed as "Authorization: Bearer <key>" header or "neb-api-key" metadata.
+	ApiKey string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Max requests per second, 0 means unlimited.
+	RateLimit float64 `protobuf:"fixed64,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// Max burst of requests, default to the rate limit.
+	Burst uint32 `protobuf:"varint,4,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Permitted methods, full names like "/rpcpb.ApiService/GetAccountState" or
+	// service prefixes like "/rpcpb.ApiService/", empty means all ApiService methods.
+	Methods []string `protobuf:"bytes,5,rep,name=methods" json:"methods,omitempty"`
+}
+
+func (m *TenantConfig) Reset()                    { *m = TenantConfig{} }
+func (m *TenantConfig) String() string            { return proto.CompactTextString(m) }
+func (*TenantConfig) ProtoMessage()               {}
+func (*TenantConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
+
+func (m *TenantConfig) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TenantConfig) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *TenantConfig) GetRateLimit() float64 {
+	if m != nil {
+		return m.RateLimit
+	}
+	return 0
+}
+
+func (m *TenantConfig) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+func (m *TenantConfig) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+type AppConfig struct {
+	LogLevel          string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	LogFile           string `protobuf:"bytes,2,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
+	EnableCrashReport bool   `protobuf:"varint,3,opt,name=enable_crash_report,json=enableCrashReport,proto3" json:"enable_crash_report,omitempty"`
+	CrashReportUrl    string `protobuf:"bytes,4,opt,name=crash_report_url,json=crashReportUrl,proto3" json:"crash_report_url,omitempty"`
+	// Path of the append-only audit log of signing and admin operations, empty disables it.
+	AuditLog string `protobuf:"bytes,5,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	// Directory of the reports of panics recovered in message handlers and VM executions, defaults to the temp dir.
+	PanicReportDir string `protobuf:"bytes,6,opt,name=panic_report_dir,json=panicReportDir,proto3" json:"panic_report_dir,omitempty"`
+	Version        string `protobuf:"bytes,100,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *AppConfig) Reset()                    { *m = AppConfig{} }
+func (m *AppConfig) String() string            { return proto.CompactTextString(m) }
+func (*AppConfig) ProtoMessage()               {}
+func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{10} }
+
+func (m *AppConfig) GetLogLevel() string {
+	if m != nil {
+		return m.LogLevel
+	}
+	return ""
+}
+
+func (m *AppConfig) GetLogFile() string {
+	if m != nil {
+		return m.LogFile
+	}
+	return ""
+}
+
+func (m *AppConfig) GetEnableCrashReport() bool {
+	if m != nil {
+		return m.EnableCrashReport
+	}
+	return false
+}
+
+func (m *AppConfig) GetCrashReportUrl() string {
+	if m != nil {
+		return m.CrashReportUrl
+	}
+	return ""
+}
+
+func (m *AppConfig) GetAuditLog() string {
+	if m != nil {
+		return m.AuditLog
+	}
+	return ""
+}
+
+func (m *AppConfig) GetPanicReportDir() string {
+	if m != nil {
+		return m.PanicReportDir
+	}
+	return ""
+}
+
+func (m *AppConfig) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type SecretConfig struct {
+	Provider SecretConfig_Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=nebletpb.SecretConfig_Provider" json:"provider,omitempty"`
+	Vault    *VaultSecretConfig    `protobuf:"bytes,2,opt,name=vault" json:"vault,omitempty"`
+	AwsKms   *AwsKmsSecretConfig   `protobuf:"bytes,3,opt,name=aws_kms,json=awsKms" json:"aws_kms,omitempty"`
+	GcpKms   *GcpKmsSecretConfig   `protobuf:"bytes,4,opt,name=gcp_kms,json=gcpKms" json:"gcp_kms,omitempty"`
+}
+
+func (m *SecretConfig) Reset()                    { *m = SecretConfig{} }
+func (m *SecretConfig) String() string            { return proto.CompactTextString(m) }
+func (*SecretConfig) ProtoMessage()               {}
+func (*SecretConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{11} }
+
+func (m *SecretConfig) GetProvider() SecretConfig_Provider {
+	if m != nil {
+		return m.Provider
+	}
+	return SecretConfig_None
+}
+
+func (m *SecretConfig) GetVault() *VaultSecretConfig {
+	if m != nil {
+		return m.Vault
+	}
+	return nil
+}
+
+func (m *SecretConfig) GetAwsKms() *AwsKmsSecretConfig {
+	if m != nil {
+		return m.AwsKms
+	}
+	return nil
+}
+
+func (m *SecretConfig) GetGcpKms() *GcpKmsSecretConfig {
+	if m != nil {
+		return m.GcpKms
+	}
+	return nil
+}
+
+type VaultSecretConfig struct {
+	// Vault address, e.g. https://vault.example.com:8200
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Path of the KV v2 secret, e.g. secret/data/neb/miner
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Field of the secret holding the passphrase, default passphrase.
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// Environment variable holding the vault token, default VAULT_TOKEN.
+	TokenEnv string `protobuf:"bytes,4,opt,name=token_env,json=tokenEnv,proto3" json:"token_env,omitempty"`
+}
+
+func (m *VaultSecretConfig) Reset()                    { *m = VaultSecretConfig{} }
+func (m *VaultSecretConfig) String() string            { return proto.CompactTextString(m) }
+func (*VaultSecretConfig) ProtoMessage()               {}
+func (*VaultSecretConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{12} }
+
+func (m *VaultSecretConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VaultSecretConfig) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *VaultSecretConfig) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *VaultSecretConfig) GetTokenEnv() string {
+	if m != nil {
+		return m.TokenEnv
+	}
+	return ""
+}
+
+type AwsKmsSecretConfig struct {
+	// AWS region, e.g. us-east-1
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Base64 ciphertext blob of the passphrase encrypted by KMS.
+	Ciphertext string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// KMS endpoint, default https://kms.<region>.amazonaws.com
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+}
+
+func (m *AwsKmsSecretConfig) Reset()                    { *m = AwsKmsSecretConfig{} }
+func (m *AwsKmsSecretConfig) String() string            { return proto.CompactTextString(m) }
+func (*AwsKmsSecretConfig) ProtoMessage()               {}
+func (*AwsKmsSecretConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{13} }
+
+func (m *AwsKmsSecretConfig) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *AwsKmsSecretConfig) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *AwsKmsSecretConfig) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+type GcpKmsSecretConfig struct {
+	// Crypto key name, e.g. projects/p/locations/global/keyRings/r/cryptoKeys/k
+	KeyName string `protobuf:"bytes,1,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	// Base64 ciphertext of the passphrase encrypted by the key.
+	Ciphertext string `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// KMS endpoint, default https://cloudkms.googleapis.com
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+}
+
+func (m *GcpKmsSecretConfig) Reset()                    { *m = GcpKmsSecretConfig{} }
+func (m *GcpKmsSecretConfig) String() string            { return proto.CompactTextString(m) }
+func (*GcpKmsSecretConfig) ProtoMessage()               {}
+func (*GcpKmsSecretConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{14} }
+
+func (m *GcpKmsSecretConfig) GetKeyName() string {
+	if m != nil {
+		return m.KeyName
+	}
+	return ""
+}
+
+func (m *GcpKmsSecretConfig) GetCiphertext() string {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return ""
+}
+
+func (m *GcpKmsSecretConfig) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+type TxPolicyConfig struct {
+	// Max value of a transaction, empty means no limit.
+	MaxValuePerTx string `protobuf:"bytes,1,opt,name=max_value_per_tx,json=maxValuePerTx,proto3" json:"max_value_per_tx,omitempty"`
+	// Max value sent by an address in a UTC day, empty means no limit.
+	MaxValuePerDay string `protobuf:"bytes,2,opt,name=max_value_per_day,json=maxValuePerDay,proto3" json:"max_value_per_day,omitempty"`
+	// Addresses transactions may be sent to, deploys included, empty means any.
+	DestinationAllowlist []string `protobuf:"bytes,3,rep,name=destination_allowlist,json=destinationAllowlist" json:"destination_allowlist,omitempty"`
+	// Deny calling smart contracts.
+	DenyContractCall bool `protobuf:"varint,4,opt,name=deny_contract_call,json=denyContractCall,proto3" json:"deny_contract_call,omitempty"`
+	// Deny deploying smart contracts.
+	DenyContractDeploy bool `protobuf:"varint,5,opt,name=deny_contract_deploy,json=denyContractDeploy,proto3" json:"deny_contract_deploy,omitempty"`
+}
+
+func (m *TxPolicyConfig) Reset()                    { *m = TxPolicyConfig{} }
+func (m *TxPolicyConfig) String() string            { return proto.CompactTextString(m) }
+func (*TxPolicyConfig) ProtoMessage()               {}
+func (*TxPolicyConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{15} }
+
+func (m *TxPolicyConfig) GetMaxValuePerTx() string {
+	if m != nil {
+		return m.MaxValuePerTx
+	}
+	return ""
+}
+
+func (m *TxPolicyConfig) GetMaxValuePerDay() string {
+	if m != nil {
+		return m.MaxValuePerDay
+	}
+	return ""
+}
+
+func (m *TxPolicyConfig) GetDestinationAllowlist() []string {
+	if m != nil {
+		return m.DestinationAllowlist
+	}
+	return nil
+}
+
+func (m *TxPolicyConfig) GetDenyContractCall() bool {
+	if m != nil {
+		return m.DenyContractCall
+	}
+	return false
+}
+
+func (m *TxPolicyConfig) GetDenyContractDeploy() bool {
+	if m != nil {
+		return m.DenyContractDeploy
+	}
+	return false
+}
+
+type StorageConfig struct {
+	// Off-peak local times of day "HH:MM" to compact the database, e.g. ["03:30"].
+	CompactionAt []string `protobuf:"bytes,1,rep,name=compaction_at,json=compactionAt" json:"compaction_at,omitempty"`
+	// Pruning of the world states of old blocks, opt-in, all the states are kept if unset.
+	Prune *PruneConfig `protobuf:"bytes,2,opt,name=prune" json:"prune,omitempty"`
+	// Snapshot downloaded by a node with an empty datadir before syncing.
+	Snapshot *SnapshotConfig `protobuf:"bytes,3,opt,name=snapshot" json:"snapshot,omitempty"`
+	// Blocks loaded from the database cached in memory, default 1024.
+	BlockCache uint32 `protobuf:"varint,4,opt,name=block_cache,json=blockCache,proto3" json:"block_cache,omitempty"`
+	// Block headers cached in memory, default 8192.
+	HeaderCache uint32 `protobuf:"varint,5,opt,name=header_cache,json=headerCache,proto3" json:"header_cache,omitempty"`
+}
+
+func (m *StorageConfig) Reset()                    { *m = StorageConfig{} }
+func (m *StorageConfig) String() string            { return proto.CompactTextString(m) }
+func (*StorageConfig) ProtoMessage()               {}
+func (*StorageConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{16} }
+
+func (m *StorageConfig) GetCompactionAt() []string {
+	if m != nil {
+		return m.CompactionAt
+	}
+	return nil
+}
+
+func (m *StorageConfig) GetPrune() *PruneConfig {
+	if m != nil {
+		return m.Prune
+	}
+	return nil
+}
+
+func (m *StorageConfig) GetSnapshot() *SnapshotConfig {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *StorageConfig) GetBlockCache() uint32 {
+	if m != nil {
+		return m.BlockCache
+	}
+	return 0
+}
+
+func (m *StorageConfig) GetHeaderCache() uint32 {
+	if m != nil {
+		return m.HeaderCache
+	}
+	return 0
+}
+
+type SnapshotConfig struct {
+	// https:// or s3://bucket/path url of a backup directory (neb backup) published as is.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Id of the backup.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Address of the publisher, signing manifests/<id>.sig (neb backup sign).
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *SnapshotConfig) Reset()                    { *m = SnapshotConfig{} }
+func (m *SnapshotConfig) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotConfig) ProtoMessage()               {}
+func (*SnapshotConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{17} }
+
+func (m *SnapshotConfig) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SnapshotConfig) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SnapshotConfig) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type PruneConfig struct {
+	// Block states kept under the tail, at least 128, default 128.
+	KeepRecent uint64 `protobuf:"varint,1,opt,name=keep_recent,json=keepRecent,proto3" json:"keep_recent,omitempty"`
+	// Keep the states of the heights multiple of it, 0 keeps none but the genesis.
+	CheckpointInterval uint64 `protobuf:"varint,2,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty"`
+	// Time between two prune rounds in seconds, default 600.
+	Interval uint32 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (m *PruneConfig) Reset()                    { *m = PruneConfig{} }
+func (m *PruneConfig) String() string            { return proto.CompactTextString(m) }
+func (*PruneConfig) ProtoMessage()               {}
+func (*PruneConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{18} }
+
+func (m *PruneConfig) GetKeepRecent() uint64 {
+	if m != nil {
+		return m.KeepRecent
+	}
+	return 0
+}
+
+func (m *PruneConfig) GetCheckpointInterval() uint64 {
+	if m != nil {
+		return m.CheckpointInterval
+	}
+	return 0
+}
+
+func (m *PruneConfig) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+type WatchdogConfig struct {
+	// Enable resource watchdog or not.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// Check interval in seconds, default 10.
+	Interval uint32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Warn when free disk space of datadir drops below, in MB. 0 disables the check.
+	DiskWarnMb uint64 `protobuf:"varint,3,opt,name=disk_warn_mb,json=diskWarnMb,proto3" json:"disk_warn_mb,omitempty"`
+	// Pause sync and block acceptance when free disk space drops below, in MB.
+	DiskPauseMb uint64 `protobuf:"varint,4,opt,name=disk_pause_mb,json=diskPauseMb,proto3" json:"disk_pause_mb,omitempty"`
+	// Warn when open file descriptors exceed this percent of the limit. 0 disables the check.
+	FdWarnPercent uint32 `protobuf:"varint,5,opt,name=fd_warn_percent,json=fdWarnPercent,proto3" json:"fd_warn_percent,omitempty"`
+	// Pause when open file descriptors exceed this percent of the limit.
+	FdPausePercent uint32 `protobuf:"varint,6,opt,name=fd_pause_percent,json=fdPausePercent,proto3" json:"fd_pause_percent,omitempty"`
+	// Warn when memory obtained from the OS exceeds, in MB. 0 disables the check.
+	MemoryWarnMb uint64 `protobuf:"varint,7,opt,name=memory_warn_mb,json=memoryWarnMb,proto3" json:"memory_warn_mb,omitempty"`
+	// Pause when memory obtained from the OS exceeds, in MB.
+	MemoryPauseMb uint64 `protobuf:"varint,8,opt,name=memory_pause_mb,json=memoryPauseMb,proto3" json:"memory_pause_mb,omitempty"`
+}
+
+func (m *WatchdogConfig) Reset()                    { *m = WatchdogConfig{} }
+func (m *WatchdogConfig) String() string            { return proto.CompactTextString(m) }
+func (*WatchdogConfig) ProtoMessage()               {}
+func (*WatchdogConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{19} }
+
+func (m *WatchdogConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *WatchdogConfig) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *WatchdogConfig) GetDiskWarnMb() uint64 {
+	if m != nil {
+		return m.DiskWarnMb
+	}
+	return 0
+}
+
+func (m *WatchdogConfig) GetDiskPauseMb() uint64 {
+	if m != nil {
+		return m.DiskPauseMb
+	}
+	return 0
+}
+
+func (m *WatchdogConfig) GetFdWarnPercent() uint32 {
+	if m != nil {
+		return m.FdWarnPercent
+	}
+	return 0
+}
+
+func (m *WatchdogConfig) GetFdPausePercent() uint32 {
+	if m != nil {
+		return m.FdPausePercent
+	}
+	return 0
+}
+
+func (m *WatchdogConfig) GetMemoryWarnMb() uint64 {
+	if m != nil {
+		return m.MemoryWarnMb
+	}
+	return 0
+}
+
+func (m *WatchdogConfig) GetMemoryPauseMb() uint64 {
+	if m != nil {
+		return m.MemoryPauseMb
+	}
+	return 0
+}
+
+type EventConfig struct {
+	// Events queued for each subscriber, default 1024.
+	QueueSize uint32 `protobuf:"varint,1,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// Event dropped when the queue of a subscriber is full, "newest" (default) or "oldest".
+	DropPolicy string `protobuf:"bytes,2,opt,name=drop_policy,json=dropPolicy,proto3" json:"drop_policy,omitempty"`
+	// Keep the dropped events in storage, up to 10000 per subscriber, replayed
+	// to the subscribe streams after a node.eventsDropped message.
+	CatchUp bool `protobuf:"varint,3,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"`
+}
+
+func (m *EventConfig) Reset()                    { *m = EventConfig{} }
+func (m *EventConfig) String() string            { return proto.CompactTextString(m) }
+func (*EventConfig) ProtoMessage()               {}
+func (*EventConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{20} }
+
+func (m *EventConfig) GetQueueSize() uint32 {
+	if m != nil {
+		return m.QueueSize
+	}
+	return 0
+}
+
+func (m *EventConfig) GetDropPolicy() string {
+	if m != nil {
+		return m.DropPolicy
+	}
+	return ""
+}
+
+func (m *EventConfig) GetCatchUp() bool {
+	if m != nil {
+		return m.CatchUp
+	}
+	return false
+}
+
+type WatchConfig struct {
+	// Addresses added to the watch list at start, besides the ones added by rpc.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	// Url the activities of the watched addresses are posted to as json.
+	Webhook string `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (m *WatchConfig) Reset()                    { *m = WatchConfig{} }
+func (m *WatchConfig) String() string            { return proto.CompactTextString(m) }
+func (*WatchConfig) ProtoMessage()               {}
+func (*WatchConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{21} }
+
+func (m *WatchConfig) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *WatchConfig) GetWebhook() string {
+	if m != nil {
+		return m.Webhook
+	}
+	return ""
+}
+
+type NvmConfig struct {
+	// Run the contracts in sandbox processes restricted from the files out of the
+	// engine lib, the network and running programs, instead of the node process.
+	Sandbox bool `protobuf:"varint,1,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+}
+
+func (m *NvmConfig) Reset()                    { *m = NvmConfig{} }
+func (m *NvmConfig) String() string            { return proto.CompactTextString(m) }
+func (*NvmConfig) ProtoMessage()               {}
+func (*NvmConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{22} }
+
+func (m *NvmConfig) GetSandbox() bool {
+	if m != nil {
+		return m.Sandbox
+	}
+	return false
+}
+
+type MiscConfig struct {
+	// Default encryption ciper when create new keystore file.
+	DefaultKeystoreFileCiper string `protobuf:"bytes,1,opt,name=default_keystore_file_ciper,json=defaultKeystoreFileCiper,proto3" json:"default_keystore_file_ciper,omitempty"`
+}
+
+func (m *MiscConfig) Reset()                    { *m = MiscConfig{} }
+func (m *MiscConfig) String() string            { return proto.CompactTextString(m) }
+func (*MiscConfig) ProtoMessage()               {}
+func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{23} }
+
+func (m *MiscConfig) GetDefaultKeystoreFileCiper() string {
+	if m != nil {
+		return m.DefaultKeystoreFileCiper
+	}
+	return ""
+}
+
+type StatsConfig struct {
+	// Enable metrics or not.
+	EnableMetrics   bool                          `protobuf:"varint,1,opt,name=enable_metrics,json=enableMetrics,proto3" json:"enable_metrics,omitempty"`
+	ReportingModule []StatsConfig_ReportingModule `protobuf:"varint,2,rep,packed,name=reporting_module,json=reportingModule,enum=nebletpb.StatsConfig_ReportingModule" json:"reporting_module,omitempty"`
+	// Influxdb config.
+	Influxdb    *InfluxdbConfig `protobuf:"bytes,11,opt,name=influxdb" json:"influxdb,omitempty"`
+	MetricsTags []string        `protobuf:"bytes,12,rep,name=metrics_tags,json=metricsTags" json:"metrics_tags,omitempty"`
+	// Tracing config.
+	Tracing *TracingConfig `protobuf:"bytes,13,opt,name=tracing" json:"tracing,omitempty"`
+	// Prometheus config.
+	Prometheus *PrometheusConfig `protobuf:"bytes,14,opt,name=prometheus" json:"prometheus,omitempty"`
+	// Statsd config.
+	Statsd *StatsdConfig `protobuf:"bytes,15,opt,name=statsd" json:"statsd,omitempty"`
+}
+
+func (m *StatsConfig) Reset()                    { *m = StatsConfig{} }
+func (m *StatsConfig) String() string            { return proto.CompactTextString(m) }
+func (*StatsConfig) ProtoMessage()               {}
+func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{24} }
+
+func (m *StatsConfig) GetEnableMetrics() bool {
+	if m != nil {
+		return m.EnableMetrics
+	}
+	return false
+}
+
+func (m *StatsConfig) GetReportingModule() []StatsConfig_ReportingModule {
+	if m != nil {
+		return m.ReportingModule
+	}
+	return nil
+}
+
+func (m *StatsConfig) GetInfluxdb() *InfluxdbConfig {
+	if m != nil {
+		return m.Influxdb
+	}
+	return nil
+}
+
+func (m *StatsConfig) GetMetricsTags() []string {
+	if m != nil {
+		return m.MetricsTags
+	}
+	return nil
+}
+
+func (m *StatsConfig) GetTracing() *TracingConfig {
+	if m != nil {
+		return m.Tracing
+	}
+	return nil
+}
+
+func (m *StatsConfig) GetPrometheus() *PrometheusConfig {
+	if m != nil {
+		return m.Prometheus
+	}
+	return nil
+}
+
+func (m *StatsConfig) GetStatsd() *StatsdConfig {
+	if m != nil {
+		return m.Statsd
+	}
+	return nil
+}
+
+type TracingConfig struct {
+	// Enable tracing or not.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// Jaeger collector endpoint, e.g. http://localhost:14268/api/traces
+	JaegerEndpoint string `protobuf:"bytes,2,opt,name=jaeger_endpoint,json=jaegerEndpoint,proto3" json:"jaeger_endpoint,omitempty"`
+	// Ratio of traces sampled in [0, 1], spans of a sampled remote parent are always recorded.
+	SampleRatio float64 `protobuf:"fixed64,3,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	// Record a span for each storage read, very verbose.
+	TraceStorage bool `protobuf:"varint,4,opt,name=trace_storage,json=traceStorage,proto3" json:"trace_storage,omitempty"`
+}
+
+func (m *TracingConfig) Reset()                    { *m = TracingConfig{} }
+func (m *TracingConfig) String() string            { return proto.CompactTextString(m) }
+func (*TracingConfig) ProtoMessage()               {}
+func (*TracingConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{25} }
+
+func (m *TracingConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *TracingConfig) GetJaegerEndpoint() string {
+	if m != nil {
+		return m.JaegerEndpoint
+	}
+	return ""
+}
+
+func (m *TracingConfig) GetSampleRatio() float64 {
+	if m != nil {
+		return m.SampleRatio
+	}
+	return 0
+}
+
+func (m *TracingConfig) GetTraceStorage() bool {
+	if m != nil {
+		return m.TraceStorage
+	}
+	return false
+}
+
+type PrometheusConfig struct {
+	// Listen address of the /metrics scrape endpoint, e.g. 127.0.0.1:8100
+	Listen string `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (m *PrometheusConfig) Reset()                    { *m = PrometheusConfig{} }
+func (m *PrometheusConfig) String() string            { return proto.CompactTextString(m) }
+func (*PrometheusConfig) ProtoMessage()               {}
+func (*PrometheusConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{26} }
+
+func (m *PrometheusConfig) GetListen() string {
+	if m != nil {
+		return m.Listen
+	}
+	return ""
+}
+
+type StatsdConfig struct {
+	// Statsd daemon address, e.g. 127.0.0.1:8125
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Prefix of every metric name.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (m *StatsdConfig) Reset()                    { *m = StatsdConfig{} }
+func (m *StatsdConfig) String() string            { return proto.CompactTextString(m) }
+func (*StatsdConfig) ProtoMessage()               {}
+func (*StatsdConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{27} }
+
+func (m *StatsdConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StatsdConfig) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
 }
 
 type InfluxdbConfig struct {
@@ -427,7 +1711,7 @@ type InfluxdbConfig struct {
 func (m *InfluxdbConfig) Reset()                    { *m = InfluxdbConfig{} }
 func (m *InfluxdbConfig) String() string            { return proto.CompactTextString(m) }
 func (*InfluxdbConfig) ProtoMessage()               {}
-func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
+func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{28} }
 
 func (m *InfluxdbConfig) GetHost() string {
 	if m != nil {
@@ -467,66 +1751,208 @@ func (m *InfluxdbConfig) GetPassword() string {
 func init() {
 	proto.RegisterType((*Config)(nil), "nebletpb.Config")
 	proto.RegisterType((*NetworkConfig)(nil), "nebletpb.NetworkConfig")
+	proto.RegisterType((*TxRelayConfig)(nil), "nebletpb.TxRelayConfig")
 	proto.RegisterType((*ChainConfig)(nil), "nebletpb.ChainConfig")
 	proto.RegisterType((*RPCConfig)(nil), "nebletpb.RPCConfig")
+	proto.RegisterType((*GrpcTransportConfig)(nil), "nebletpb.GrpcTransportConfig")
+	proto.RegisterType((*HttpTransportConfig)(nil), "nebletpb.HttpTransportConfig")
+	proto.RegisterType((*EstimateConfig)(nil), "nebletpb.EstimateConfig")
+	proto.RegisterType((*HttpCorsConfig)(nil), "nebletpb.HttpCorsConfig")
+	proto.RegisterType((*TenantConfig)(nil), "nebletpb.TenantConfig")
 	proto.RegisterType((*AppConfig)(nil), "nebletpb.AppConfig")
+	proto.RegisterType((*SecretConfig)(nil), "nebletpb.SecretConfig")
+	proto.RegisterType((*VaultSecretConfig)(nil), "nebletpb.VaultSecretConfig")
+	proto.RegisterType((*AwsKmsSecretConfig)(nil), "nebletpb.AwsKmsSecretConfig")
+	proto.RegisterType((*GcpKmsSecretConfig)(nil), "nebletpb.GcpKmsSecretConfig")
+	proto.RegisterType((*TxPolicyConfig)(nil), "nebletpb.TxPolicyConfig")
+	proto.RegisterType((*StorageConfig)(nil), "nebletpb.StorageConfig")
+	proto.RegisterType((*SnapshotConfig)(nil), "nebletpb.SnapshotConfig")
+	proto.RegisterType((*PruneConfig)(nil), "nebletpb.PruneConfig")
+	proto.RegisterType((*WatchdogConfig)(nil), "nebletpb.WatchdogConfig")
+	proto.RegisterType((*EventConfig)(nil), "nebletpb.EventConfig")
+	proto.RegisterType((*WatchConfig)(nil), "nebletpb.WatchConfig")
+	proto.RegisterType((*NvmConfig)(nil), "nebletpb.NvmConfig")
 	proto.RegisterType((*MiscConfig)(nil), "nebletpb.MiscConfig")
 	proto.RegisterType((*StatsConfig)(nil), "nebletpb.StatsConfig")
+	proto.RegisterType((*TracingConfig)(nil), "nebletpb.TracingConfig")
+	proto.RegisterType((*PrometheusConfig)(nil), "nebletpb.PrometheusConfig")
+	proto.RegisterType((*StatsdConfig)(nil), "nebletpb.StatsdConfig")
 	proto.RegisterType((*InfluxdbConfig)(nil), "nebletpb.InfluxdbConfig")
+	proto.RegisterEnum("nebletpb.SecretConfig_Provider", SecretConfig_Provider_name, SecretConfig_Provider_value)
 	proto.RegisterEnum("nebletpb.StatsConfig_ReportingModule", StatsConfig_ReportingModule_name, StatsConfig_ReportingModule_value)
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xae, 0xe4, 0xbf, 0xdd, 0x91, 0xac, 0x38, 0x8c, 0x93, 0x30, 0x09, 0xda, 0xb8, 0x0b, 0x18,
-	0x10, 0x10, 0x40, 0x40, 0xdd, 0x5e, 0x7b, 0x28, 0x04, 0x14, 0x30, 0x6c, 0x17, 0xc6, 0xb6, 0x3d,
-	0x2f, 0xa8, 0xdd, 0xd1, 0x8a, 0x30, 0xb5, 0x4b, 0x90, 0x94, 0x13, 0xa3, 0x97, 0xbe, 0x40, 0x9f,
-	0xa7, 0x2f, 0xd1, 0x87, 0x2a, 0x66, 0x96, 0x2b, 0xd9, 0x46, 0x6e, 0x9c, 0xef, 0xfb, 0x38, 0xe4,
-	0xcc, 0xc7, 0x21, 0x8c, 0xcb, 0xb6, 0x59, 0xea, 0x7a, 0x66, 0x5d, 0x1b, 0x5a, 0x91, 0x34, 0xb8,
-	0x30, 0x18, 0xec, 0x22, 0xfb, 0x67, 0x08, 0x87, 0x73, 0xa6, 0xc4, 0x0f, 0x70, 0xd4, 0x60, 0xf8,
-	0xdc, 0xba, 0x3b, 0x39, 0x38, 0x1b, 0x4c, 0x47, 0x17, 0x6f, 0x67, 0xbd, 0x6c, 0xf6, 0x5b, 0x47,
-	0x74, 0xca, 0xbc, 0xd7, 0x89, 0x4f, 0x70, 0x50, 0xae, 0x94, 0x6e, 0xe4, 0x90, 0x37, 0xbc, 0xde,
-	0x6d, 0x98, 0x13, 0x1c, 0xe5, 0x9d, 0x46, 0x9c, 0xc3, 0x9e, 0xb3, 0xa5, 0xdc, 0x63, 0xe9, 0xab,
-	0x9d, 0x34, 0xbf, 0x9d, 0x47, 0x21, 0xf1, 0x94, 0xd3, 0x07, 0x15, 0xbc, 0xac, 0x9e, 0xe7, 0xfc,
-	0x9d, 0xe0, 0x3e, 0x27, 0x6b, 0xc4, 0x14, 0xf6, 0xd7, 0xda, 0x97, 0x12, 0x59, 0x7b, 0xba, 0xd3,
-	0xde, 0x68, 0x5f, 0x46, 0x29, 0x2b, 0xe8, 0x74, 0x65, 0xad, 0x5c, 0x3e, 0x3f, 0xfd, 0x17, 0x6b,
-	0xfb, 0xd3, 0x95, 0xb5, 0xd9, 0x5f, 0x70, 0xfc, 0xa4, 0x56, 0x21, 0x60, 0xdf, 0x23, 0x56, 0x72,
-	0x70, 0xb6, 0x37, 0x4d, 0x73, 0x5e, 0x8b, 0x37, 0x70, 0x68, 0xb4, 0x0f, 0x48, 0x75, 0x13, 0x1a,
-	0x23, 0xf1, 0x11, 0x46, 0xd6, 0xe9, 0x7b, 0x15, 0xb0, 0xb8, 0xc3, 0x07, 0xae, 0x34, 0xcd, 0x21,
-	0x42, 0x57, 0xf8, 0x20, 0xbe, 0x05, 0x88, 0xad, 0x2b, 0x74, 0x25, 0xf7, 0xcf, 0x06, 0xd3, 0xe3,
-	0x3c, 0x8d, 0xc8, 0x65, 0x95, 0xfd, 0x37, 0x84, 0xd1, 0xa3, 0xc6, 0x89, 0x77, 0x90, 0x70, 0xeb,
-	0x48, 0x3c, 0x60, 0xf1, 0x11, 0xc7, 0x97, 0x95, 0x90, 0x70, 0x54, 0x63, 0x83, 0x5e, 0x7b, 0xee,
-	0x7d, 0x9a, 0xf7, 0x21, 0x31, 0x95, 0x0a, 0xaa, 0xd2, 0x4e, 0x8e, 0x3a, 0x26, 0x86, 0x74, 0xed,
-	0x3b, 0x7c, 0x20, 0x62, 0xcc, 0x44, 0x8c, 0xe8, 0x56, 0x3e, 0x28, 0x17, 0x8a, 0xb5, 0x6e, 0x50,
-	0x9e, 0x9e, 0x0d, 0xa6, 0x49, 0x9e, 0x32, 0x72, 0xa3, 0x1b, 0x14, 0xef, 0x21, 0x29, 0x5b, 0xdd,
-	0x2c, 0x94, 0x47, 0xf9, 0x9a, 0x37, 0x6e, 0x63, 0x71, 0x0a, 0x07, 0xb4, 0xc9, 0xc9, 0x37, 0x4c,
-	0x74, 0x81, 0xf8, 0x0e, 0xc0, 0x2a, 0xef, 0xed, 0xca, 0xd1, 0x9e, 0xb7, 0xb1, 0x0d, 0x5b, 0x44,
-	0x7c, 0x80, 0xb4, 0x56, 0xbe, 0xb0, 0x4e, 0x97, 0x28, 0x65, 0x97, 0xb2, 0x56, 0xfe, 0x96, 0xe2,
-	0x9e, 0x34, 0x7a, 0xad, 0x83, 0x7c, 0xb7, 0x25, 0xaf, 0x29, 0x16, 0x9f, 0xe0, 0xa5, 0xd7, 0x75,
-	0xa3, 0xc2, 0xc6, 0x61, 0x51, 0x6a, 0xbb, 0x42, 0xe7, 0xe5, 0x7b, 0x36, 0xe1, 0x64, 0x4b, 0xcc,
-	0x3b, 0x3c, 0x33, 0x90, 0x6e, 0xdf, 0x16, 0x15, 0xe9, 0x6c, 0x59, 0x44, 0xdf, 0x3a, 0x37, 0x53,
-	0x67, 0xcb, 0xeb, 0xad, 0x75, 0xab, 0x10, 0x6c, 0xf1, 0xc4, 0x57, 0x20, 0xe8, 0x99, 0x60, 0xdd,
-	0x56, 0x1b, 0x83, 0x72, 0x6f, 0x27, 0xb8, 0x61, 0x24, 0xfb, 0x77, 0x00, 0xe9, 0xf6, 0x31, 0x51,
-	0x15, 0xa6, 0xad, 0x0b, 0x83, 0xf7, 0x68, 0xd8, 0xbb, 0x34, 0x4f, 0x4c, 0x5b, 0x5f, 0x53, 0x4c,
-	0xbe, 0x12, 0xb9, 0xd4, 0x06, 0x7b, 0xf7, 0x4c, 0x5b, 0xff, 0xaa, 0x0d, 0x8a, 0x19, 0xbc, 0xc2,
-	0x46, 0x2d, 0x0c, 0x16, 0xa5, 0x53, 0x7e, 0x55, 0x38, 0xb4, 0xad, 0x0b, 0xfc, 0x94, 0x92, 0xfc,
-	0x65, 0x47, 0xcd, 0x89, 0xc9, 0x99, 0x10, 0x53, 0x38, 0x79, 0x2c, 0x2c, 0x36, 0xce, 0xf0, 0xbb,
-	0x4a, 0xf3, 0x49, 0xb9, 0x93, 0xfd, 0xe9, 0x0c, 0xbd, 0x8b, 0x7b, 0x74, 0x5e, 0xb7, 0x0d, 0x4f,
-	0x56, 0x9a, 0xf7, 0x61, 0x76, 0x05, 0xb0, 0x1b, 0x17, 0xf1, 0x33, 0x7c, 0xa8, 0x70, 0xa9, 0x36,
-	0x26, 0xd0, 0x23, 0xf6, 0xa1, 0x75, 0xc8, 0x37, 0xa5, 0x76, 0xa3, 0x8b, 0xb5, 0xc8, 0x28, 0xb9,
-	0x8a, 0x0a, 0xba, 0xfb, 0x9c, 0xf8, 0xec, 0xef, 0x21, 0x8c, 0x1e, 0x0d, 0xaa, 0x38, 0x87, 0x49,
-	0x2c, 0x68, 0x8d, 0xc1, 0xe9, 0xd2, 0x73, 0x86, 0x24, 0x3f, 0xee, 0xd0, 0x9b, 0x0e, 0x14, 0xb7,
-	0x70, 0xd2, 0x55, 0xa0, 0x9b, 0xba, 0xef, 0x31, 0x99, 0x30, 0xb9, 0x38, 0xff, 0xea, 0x07, 0x30,
-	0xcb, 0x7b, 0x75, 0xd7, 0xfe, 0xfc, 0x85, 0x7b, 0x0a, 0x88, 0x9f, 0x20, 0xd1, 0xcd, 0xd2, 0x6c,
-	0xbe, 0x54, 0x0b, 0x1e, 0x84, 0xd1, 0x85, 0xdc, 0x65, 0xba, 0x8c, 0x4c, 0x1c, 0xfd, 0xad, 0x52,
-	0x7c, 0x0f, 0xe3, 0x78, 0xcf, 0x22, 0xa8, 0xda, 0xcb, 0x31, 0xfb, 0x3c, 0x8a, 0xd8, 0x1f, 0xaa,
-	0xf6, 0xd9, 0x47, 0x78, 0xf1, 0xec, 0x70, 0x31, 0x86, 0xa4, 0xcf, 0x78, 0xf2, 0x4d, 0xf6, 0x05,
-	0x26, 0x4f, 0xf3, 0xd3, 0x27, 0xb2, 0x6a, 0x7d, 0x88, 0xcd, 0xe3, 0x35, 0x61, 0x6c, 0xed, 0x90,
-	0x07, 0x9b, 0xd7, 0x62, 0x02, 0xc3, 0x6a, 0x11, 0xff, 0x8d, 0x61, 0xb5, 0x20, 0xcd, 0xc6, 0xa3,
-	0x8b, 0x8e, 0xf2, 0x9a, 0xc6, 0x91, 0x46, 0xe9, 0x73, 0xeb, 0x2a, 0x79, 0xd0, 0x3d, 0xac, 0x3e,
-	0x5e, 0x1c, 0xf2, 0xf7, 0xfe, 0xe3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x77, 0x4a, 0x3c, 0x37,
-	0xee, 0x05, 0x00, 0x00,
+	// 2699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xcf, 0x92, 0x1b, 0xb7,
+	0xf1, 0x36, 0xf7, 0x2f, 0xd9, 0x5c, 0x72, 0x29, 0x68, 0xb5, 0x1e, 0x5b, 0xb2, 0xb5, 0x9e, 0x9f,
+	0x65, 0xef, 0xcf, 0x4e, 0x6d, 0x6c, 0xc9, 0xae, 0x54, 0xc5, 0x95, 0xaa, 0xc8, 0xd4, 0xc6, 0x56,
+	0x24, 0x2a, 0x9b, 0xd1, 0xda, 0x3e, 0x4e, 0x81, 0x33, 0x58, 0x12, 0xe6, 0xfc, 0x33, 0x00, 0x72,
+	0x49, 0xe7, 0x9a, 0x53, 0x5e, 0x20, 0xa9, 0xca, 0x29, 0x87, 0x5c, 0xf2, 0x0a, 0x79, 0x8a, 0x9c,
+	0x73, 0xf3, 0x5b, 0xa4, 0x2a, 0x95, 0x4a, 0x75, 0x03, 0x20, 0x87, 0x5c, 0x25, 0x97, 0xdc, 0xd8,
+	0x5f, 0x7f, 0x00, 0x1a, 0x0d, 0x4c, 0xe3, 0x03, 0x08, 0x07, 0x49, 0x59, 0x5c, 0xc9, 0xd1, 0x59,
+	0xa5, 0x4a, 0x53, 0xb2, 0x66, 0x21, 0x86, 0x99, 0x30, 0xd5, 0x30, 0xfc, 0x61, 0x07, 0xf6, 0xfa,
+	0xe4, 0x62, 0x1f, 0xc3, 0x7e, 0x21, 0xcc, 0x75, 0xa9, 0x26, 0x41, 0xe3, 0xa4, 0x71, 0xda, 0x7e,
+	0xf8, 0xfa, 0x99, 0xa7, 0x9d, 0xbd, 0xb0, 0x0e, 0xcb, 0x8c, 0x3c, 0x8f, 0x7d, 0x08, 0xbb, 0xc9,
+	0x98, 0xcb, 0x22, 0xd8, 0xa2, 0x06, 0x77, 0x56, 0x0d, 0xfa, 0x08, 0x3b, 0xba, 0xe5, 0xb0, 0x07,
+	0xb0, 0xad, 0xaa, 0x24, 0xd8, 0x26, 0xea, 0xed, 0x15, 0x35, 0xba, 0xe8, 0x3b, 0x22, 0xfa, 0xb1,
+	0x4f, 0x6d, 0xb8, 0xd1, 0x41, 0xba, 0xd9, 0xe7, 0x4b, 0x84, 0x7d, 0x9f, 0xc4, 0x61, 0xa7, 0xb0,
+	0x93, 0x4b, 0x9d, 0x04, 0x82, 0xb8, 0x47, 0x2b, 0xee, 0x40, 0xea, 0xc4, 0x51, 0x89, 0x81, 0xa3,
+	0xf3, 0xaa, 0x0a, 0xae, 0x36, 0x47, 0x7f, 0x5c, 0x55, 0x7e, 0x74, 0x5e, 0x55, 0xec, 0x13, 0x68,
+	0x5e, 0x73, 0x93, 0x8c, 0xd3, 0x72, 0x14, 0x8c, 0x88, 0x1b, 0xac, 0xb8, 0xdf, 0x38, 0x8f, 0x6b,
+	0xb0, 0x64, 0x62, 0xea, 0xb4, 0x29, 0x15, 0x1f, 0x89, 0x60, 0xbc, 0x99, 0xba, 0x97, 0xd6, 0xe1,
+	0x53, 0xe7, 0x78, 0xec, 0x53, 0x68, 0x99, 0x79, 0x5c, 0x95, 0x99, 0x4c, 0x16, 0x81, 0xdc, 0x1c,
+	0xe9, 0x72, 0x7e, 0x41, 0x1e, 0x3f, 0x92, 0x71, 0x36, 0x66, 0x47, 0xcc, 0x44, 0x61, 0x82, 0x6f,
+	0x37, 0xb3, 0x73, 0x8e, 0xb0, 0xcf, 0x0e, 0x71, 0xd8, 0x31, 0xec, 0x51, 0xea, 0x75, 0x30, 0x39,
+	0xd9, 0x3e, 0x6d, 0x45, 0xce, 0xc2, 0x4e, 0x28, 0xf4, 0x20, 0xdb, 0xec, 0x84, 0x66, 0xe8, 0x3b,
+	0x21, 0x0e, 0x26, 0xae, 0x98, 0xe5, 0x41, 0xbe, 0x99, 0xb8, 0x17, 0xb3, 0xdc, 0x27, 0xae, 0x98,
+	0xe5, 0xec, 0x4d, 0x68, 0x5e, 0x09, 0x6e, 0xa6, 0x4a, 0xe8, 0xa0, 0xa0, 0xd1, 0x96, 0x76, 0xf8,
+	0xcf, 0x06, 0x74, 0xd6, 0x76, 0x10, 0x63, 0xb0, 0xa3, 0x85, 0x48, 0x83, 0x06, 0x31, 0xe9, 0x37,
+	0x46, 0x9b, 0x49, 0x6d, 0x04, 0xee, 0x26, 0x8a, 0xd6, 0x5a, 0xec, 0x3e, 0xb4, 0x2b, 0x25, 0x67,
+	0xdc, 0x88, 0x78, 0x22, 0x16, 0xb4, 0x7f, 0x5a, 0x11, 0x38, 0xe8, 0x99, 0x58, 0xb0, 0xb7, 0x00,
+	0xdc, 0x86, 0x8c, 0x65, 0x1a, 0xec, 0x9c, 0x34, 0x4e, 0x3b, 0x51, 0xcb, 0x21, 0x4f, 0x53, 0x1c,
+	0x2b, 0x4f, 0x0b, 0x1d, 0xec, 0x9e, 0x34, 0x4e, 0x9b, 0x11, 0xfd, 0x66, 0x0f, 0xa1, 0x69, 0xe6,
+	0xb1, 0x12, 0x19, 0x5f, 0x04, 0x7b, 0x9b, 0x2b, 0x76, 0x39, 0x8f, 0xd0, 0xe1, 0x57, 0xcc, 0x58,
+	0x93, 0xfa, 0x29, 0x53, 0x11, 0xec, 0x53, 0x00, 0xf4, 0x9b, 0xfd, 0x1f, 0x74, 0x7c, 0x6c, 0x95,
+	0x10, 0x4a, 0x07, 0x4d, 0x0a, 0xfd, 0xc0, 0x81, 0x17, 0x88, 0x85, 0x53, 0xe8, 0xac, 0x75, 0x89,
+	0x33, 0xbd, 0xe2, 0x45, 0x39, 0x35, 0xf4, 0xa1, 0x75, 0x22, 0x67, 0xb1, 0x0f, 0xe0, 0xd6, 0x10,
+	0x73, 0x1e, 0xcb, 0xc2, 0x08, 0x35, 0xe3, 0x59, 0x9c, 0x6b, 0xfa, 0xb4, 0x3a, 0xd1, 0x21, 0x39,
+	0x9e, 0x3a, 0x7c, 0xa0, 0xd9, 0x09, 0x1c, 0xe4, 0x7c, 0x1e, 0xa7, 0xd8, 0x2d, 0xd2, 0xb6, 0x89,
+	0x06, 0x39, 0x9f, 0x3f, 0x41, 0x68, 0xa0, 0xc3, 0xdf, 0xee, 0x42, 0xbb, 0xf6, 0x19, 0xb2, 0x37,
+	0xa0, 0x49, 0xeb, 0x8f, 0x49, 0xb2, 0xe3, 0xee, 0x93, 0xfd, 0x34, 0x65, 0x01, 0xec, 0x8f, 0x44,
+	0x21, 0xb4, 0xb4, 0xc3, 0xb5, 0x22, 0x6f, 0xa2, 0xc7, 0x17, 0x05, 0x9b, 0x78, 0x6f, 0xa2, 0x27,
+	0xe5, 0x86, 0xa7, 0x52, 0x05, 0x6d, 0xeb, 0x71, 0x26, 0x4e, 0x6f, 0x22, 0x16, 0xe8, 0x38, 0x20,
+	0x87, 0xb3, 0x70, 0x9d, 0xb4, 0xe1, 0xca, 0xc4, 0xb9, 0x2c, 0x44, 0x70, 0x44, 0xcb, 0xd1, 0x22,
+	0x64, 0x20, 0x0b, 0x81, 0x3b, 0x28, 0x29, 0x65, 0x31, 0xe4, 0x5a, 0x04, 0x77, 0xa8, 0xe1, 0xd2,
+	0x66, 0x47, 0xb0, 0x8b, 0x8d, 0x54, 0x70, 0x4c, 0x0e, 0x6b, 0xb0, 0xb7, 0x01, 0x2a, 0xae, 0x75,
+	0x35, 0x56, 0xd8, 0xe6, 0x75, 0xb7, 0x31, 0x96, 0x08, 0x7b, 0x00, 0x5d, 0x2d, 0x47, 0x85, 0x2c,
+	0x46, 0xb1, 0x0b, 0xe8, 0x2e, 0x71, 0x3a, 0x0e, 0x7d, 0x66, 0xe3, 0xfa, 0x04, 0x8e, 0x3d, 0x6d,
+	0xd5, 0x38, 0x16, 0xc5, 0x2c, 0xb8, 0x47, 0xf4, 0x23, 0xe7, 0xbd, 0x58, 0x3a, 0xcf, 0x8b, 0x19,
+	0xeb, 0xc3, 0xad, 0x1a, 0x5b, 0x8b, 0x44, 0x09, 0x13, 0xbc, 0x45, 0x7b, 0xe9, 0xb8, 0xf6, 0xf5,
+	0x13, 0xee, 0xb6, 0x52, 0x6f, 0xd5, 0xc0, 0xe2, 0xec, 0x2e, 0xb4, 0x46, 0x5c, 0xc7, 0x95, 0x92,
+	0x89, 0x08, 0x02, 0x3b, 0xe9, 0x11, 0xd7, 0x17, 0x68, 0x7b, 0x67, 0x26, 0x73, 0x69, 0x82, 0x37,
+	0x96, 0xce, 0xe7, 0x68, 0xb3, 0x0f, 0xe1, 0x16, 0x86, 0x45, 0x5f, 0x58, 0x9c, 0xc8, 0x6a, 0x8c,
+	0xbb, 0xef, 0x4d, 0xda, 0x7d, 0xbd, 0xa5, 0xa3, 0x6f, 0x71, 0x5c, 0xab, 0x6b, 0x69, 0x0a, 0xa1,
+	0x75, 0xf0, 0x36, 0xa5, 0xdd, 0x9b, 0xe8, 0xe1, 0x2a, 0x19, 0xcb, 0x99, 0x08, 0xee, 0x5b, 0x8f,
+	0x33, 0xd9, 0x3d, 0x68, 0xf1, 0x82, 0x67, 0x0b, 0x23, 0x13, 0x1d, 0x9c, 0xd8, 0xc5, 0x5a, 0x02,
+	0xec, 0x14, 0x7a, 0xcb, 0xc0, 0xe3, 0x61, 0x56, 0x26, 0x13, 0x1d, 0xbc, 0x43, 0x9b, 0xaa, 0xeb,
+	0xe3, 0xff, 0x9c, 0xd0, 0xf0, 0x87, 0x6d, 0x68, 0x2d, 0x4b, 0x3c, 0xee, 0x01, 0x55, 0x25, 0xb1,
+	0xfb, 0xd0, 0xed, 0xe7, 0xdf, 0x52, 0x55, 0xf2, 0x7c, 0xf9, 0xad, 0x8f, 0x8d, 0xa9, 0xe2, 0xb5,
+	0x42, 0x00, 0x08, 0x6d, 0x10, 0xf2, 0x32, 0x9d, 0x66, 0x22, 0xd8, 0x5e, 0x11, 0x06, 0x84, 0xb0,
+	0x8f, 0x60, 0xdf, 0x88, 0x82, 0x17, 0x46, 0x07, 0x3b, 0x27, 0xdb, 0xeb, 0x8b, 0x71, 0x49, 0x8e,
+	0xe5, 0x77, 0x6d, 0x69, 0x58, 0x89, 0xa9, 0xcb, 0xa4, 0x54, 0xb6, 0x48, 0xac, 0x55, 0xe2, 0x2f,
+	0x8d, 0xa9, 0xfa, 0xa5, 0xf2, 0xe7, 0x4e, 0x73, 0xec, 0x6c, 0x3c, 0x29, 0x84, 0x36, 0x32, 0xe7,
+	0x46, 0x04, 0x7b, 0x9b, 0xad, 0xce, 0x9d, 0xc7, 0xb7, 0xf2, 0x4c, 0x5c, 0xd3, 0xa4, 0x9a, 0xc6,
+	0xdf, 0x4d, 0x4b, 0xc3, 0xa9, 0x92, 0x74, 0xa2, 0x66, 0x52, 0x4d, 0x7f, 0x8d, 0x36, 0x7b, 0x17,
+	0xba, 0xc3, 0xa9, 0x5e, 0xc4, 0x2b, 0x46, 0x93, 0x18, 0x07, 0x88, 0xf6, 0x3d, 0xeb, 0x47, 0xc0,
+	0x94, 0xf8, 0x6e, 0x2a, 0xb4, 0x89, 0x8d, 0xcc, 0x45, 0x39, 0x35, 0xf8, 0xfd, 0xb7, 0x88, 0xd9,
+	0x73, 0x9e, 0x4b, 0xeb, 0x18, 0x68, 0xf6, 0x31, 0xec, 0x8c, 0xf0, 0xd8, 0x05, 0x0a, 0xf1, 0xad,
+	0x55, 0x88, 0x5f, 0xa8, 0x2a, 0xb9, 0x54, 0xbc, 0xd0, 0x55, 0xa9, 0x7c, 0x4e, 0x88, 0x8a, 0x4d,
+	0x70, 0x96, 0x41, 0x7b, 0xb3, 0x09, 0xe6, 0xe2, 0x46, 0x13, 0xa4, 0x86, 0x7f, 0xda, 0x86, 0xdb,
+	0xaf, 0xe8, 0x90, 0xfd, 0x3f, 0xdc, 0xc2, 0x2a, 0xa5, 0x44, 0x32, 0x8b, 0x73, 0x3d, 0x8a, 0xb5,
+	0xfc, 0x5e, 0xb8, 0xe2, 0xd3, 0xcd, 0xf9, 0x3c, 0x12, 0xc9, 0x6c, 0xa0, 0x47, 0x2f, 0xe5, 0xf7,
+	0xc2, 0x53, 0xb5, 0x28, 0xd2, 0x15, 0x75, 0x6b, 0x49, 0x7d, 0x29, 0x8a, 0xd4, 0x53, 0xdf, 0x87,
+	0x43, 0xa4, 0x26, 0x65, 0x51, 0x88, 0xc4, 0xc8, 0xb2, 0xf0, 0xe5, 0x0f, 0x89, 0xfd, 0x15, 0xca,
+	0x1e, 0xc1, 0xf1, 0x3a, 0x31, 0x96, 0x69, 0x26, 0x30, 0x5d, 0xf6, 0x94, 0xb8, 0xbd, 0xc6, 0x7f,
+	0x9a, 0x66, 0x62, 0xa0, 0xb1, 0x0a, 0x4f, 0x84, 0xa8, 0x78, 0x26, 0x67, 0x82, 0x32, 0x1c, 0xe7,
+	0x76, 0x5f, 0x74, 0xa2, 0xc3, 0xa5, 0x03, 0x13, 0x3c, 0xd0, 0xec, 0x23, 0x38, 0x5a, 0xe7, 0xba,
+	0xd5, 0xd8, 0x23, 0x3a, 0x5b, 0xa3, 0xfb, 0xf5, 0xb8, 0xb3, 0x6a, 0x91, 0xcb, 0x62, 0x39, 0xc2,
+	0xfe, 0x46, 0x93, 0x81, 0x2c, 0xdc, 0x20, 0xe7, 0x70, 0x7f, 0xd5, 0xa4, 0x12, 0x2a, 0x97, 0x26,
+	0xbe, 0x96, 0x66, 0x8c, 0x63, 0x69, 0xa3, 0x04, 0xcf, 0x69, 0x9f, 0x34, 0xa3, 0x7b, 0x4b, 0xda,
+	0x05, 0xb1, 0xbe, 0xb1, 0xa4, 0x97, 0xc4, 0x09, 0x7f, 0xbf, 0x05, 0xb7, 0x5f, 0xb1, 0x82, 0xec,
+	0x3d, 0x38, 0x54, 0x82, 0xa7, 0xf5, 0xf0, 0xed, 0x0a, 0x75, 0x10, 0x5e, 0x45, 0xfe, 0x08, 0x8e,
+	0x89, 0x37, 0x16, 0x3c, 0x15, 0xaa, 0x4e, 0xb7, 0xab, 0x74, 0x1b, 0xbd, 0x5f, 0x92, 0x73, 0xd5,
+	0xe8, 0x14, 0x7a, 0xd7, 0x4a, 0x9a, 0xb5, 0xe4, 0xb8, 0xb5, 0x22, 0x7c, 0xc5, 0x7c, 0x0f, 0x0e,
+	0x69, 0x71, 0x6a, 0x44, 0xbb, 0x48, 0x1d, 0x84, 0x57, 0xbc, 0x57, 0x2c, 0xfe, 0xee, 0x2b, 0x17,
+	0xff, 0x14, 0x7a, 0x48, 0x74, 0xe1, 0x0e, 0x17, 0x46, 0xf8, 0x75, 0x41, 0xa6, 0x0d, 0xf4, 0x73,
+	0x44, 0xc3, 0x3f, 0x36, 0xa0, 0xbb, 0xfe, 0xc5, 0x52, 0xc5, 0x2c, 0xd5, 0x44, 0x28, 0x9f, 0x0c,
+	0x6f, 0xe2, 0x51, 0xf4, 0xdd, 0x54, 0x4c, 0xfd, 0xde, 0xb4, 0x06, 0xd6, 0xb5, 0x1b, 0x33, 0x6c,
+	0x99, 0x65, 0xd0, 0xaf, 0xc3, 0x3e, 0xc6, 0x32, 0xe2, 0x76, 0x52, 0xad, 0x68, 0x2f, 0xe7, 0xf3,
+	0x2f, 0xb8, 0x66, 0xef, 0xc0, 0x41, 0x2e, 0xf2, 0x52, 0x2d, 0x5c, 0x99, 0xc7, 0xa9, 0xec, 0x44,
+	0x6d, 0x8b, 0x51, 0xa5, 0x0f, 0xff, 0xd6, 0x80, 0xee, 0x7a, 0x15, 0xc2, 0x1c, 0xf0, 0x2c, 0x2b,
+	0xaf, 0x45, 0x1a, 0x97, 0x4a, 0x8e, 0x50, 0xe1, 0xd9, 0x52, 0xda, 0x75, 0xf0, 0xaf, 0x2c, 0x5a,
+	0x27, 0xe6, 0xc2, 0x8c, 0xcb, 0x54, 0x07, 0x5b, 0x6b, 0xc4, 0x81, 0x45, 0xeb, 0x44, 0x9b, 0x30,
+	0x1d, 0x6c, 0xaf, 0x11, 0x6d, 0xbe, 0x50, 0x3b, 0xde, 0x22, 0x24, 0x4e, 0x94, 0x48, 0x45, 0x61,
+	0x24, 0xcf, 0xec, 0x9c, 0x9a, 0x51, 0x8f, 0x1c, 0xfd, 0x15, 0xee, 0xa7, 0x8d, 0xba, 0xd8, 0xae,
+	0x11, 0x4e, 0xfb, 0xf1, 0x48, 0x84, 0xbf, 0x6b, 0xc0, 0x41, 0xbd, 0x1a, 0xa3, 0xb8, 0x2a, 0x78,
+	0x6e, 0x6b, 0x43, 0x2b, 0xa2, 0xdf, 0xd8, 0x9a, 0x57, 0x92, 0x44, 0x9f, 0x55, 0x25, 0x7b, 0xbc,
+	0x92, 0x4e, 0xf0, 0x29, 0x94, 0x5c, 0x36, 0x65, 0x98, 0xec, 0x46, 0xd4, 0x42, 0xc4, 0x1e, 0x8d,
+	0x47, 0xb0, 0x3b, 0x9c, 0x2a, 0x6d, 0xdc, 0xfe, 0xb1, 0x06, 0xae, 0xa8, 0x4f, 0xc1, 0x2e, 0xcd,
+	0xcc, 0x9b, 0xe1, 0xbf, 0x1a, 0xd0, 0x5a, 0x5e, 0x03, 0xb0, 0x42, 0x67, 0xe5, 0x28, 0xce, 0xc4,
+	0x4c, 0x64, 0x2e, 0x9c, 0x66, 0x56, 0x8e, 0x9e, 0xa3, 0x8d, 0x1a, 0x0a, 0x9d, 0x57, 0x32, 0x13,
+	0x5e, 0x29, 0x65, 0xe5, 0xe8, 0x17, 0x32, 0x13, 0xec, 0x0c, 0x6e, 0x8b, 0x82, 0x0f, 0x33, 0x11,
+	0x27, 0x8a, 0xeb, 0x71, 0xac, 0x04, 0x7e, 0x63, 0x14, 0x5d, 0x33, 0xba, 0x65, 0x5d, 0x7d, 0xf4,
+	0x44, 0xe4, 0xc0, 0xed, 0x59, 0x27, 0xc6, 0x53, 0x95, 0xb9, 0xbd, 0xd1, 0x4d, 0x56, 0xb4, 0xaf,
+	0x54, 0x86, 0x11, 0xf1, 0x69, 0x2a, 0x4d, 0x9c, 0x95, 0x23, 0xca, 0x63, 0x2b, 0x6a, 0x12, 0xf0,
+	0xbc, 0x1c, 0x61, 0x37, 0x15, 0x2f, 0x64, 0xe2, 0xbb, 0x41, 0x95, 0xb3, 0x67, 0xbb, 0x21, 0xdc,
+	0x76, 0xf3, 0x44, 0x2a, 0x4c, 0xc0, 0x4c, 0x28, 0x2d, 0xcb, 0x82, 0xae, 0x56, 0xad, 0xc8, 0x9b,
+	0xe1, 0x9f, 0xb7, 0xe0, 0xa0, 0x2e, 0x54, 0xd8, 0x67, 0xd0, 0xac, 0x54, 0x39, 0x93, 0xa9, 0x50,
+	0x94, 0x82, 0xee, 0xc3, 0xfb, 0xaf, 0x96, 0x34, 0x67, 0x17, 0x8e, 0x16, 0x2d, 0x1b, 0xb0, 0x8f,
+	0x61, 0x77, 0xc6, 0xa7, 0x99, 0x71, 0x97, 0xc2, 0xbb, 0xab, 0x96, 0x5f, 0x23, 0x5c, 0x6f, 0x1e,
+	0x59, 0x26, 0xfb, 0x14, 0xf6, 0xf9, 0xb5, 0x8e, 0x27, 0xee, 0xd3, 0x69, 0x3f, 0xbc, 0x57, 0xbb,
+	0xa0, 0x5d, 0xeb, 0x67, 0xb9, 0x5e, 0x6b, 0xb5, 0xc7, 0x09, 0xc3, 0x66, 0xa3, 0xa4, 0xa2, 0x66,
+	0x3b, 0x9b, 0xcd, 0xbe, 0x48, 0xaa, 0x1b, 0xcd, 0x46, 0x84, 0x85, 0x3f, 0x81, 0xa6, 0x0f, 0x9b,
+	0x35, 0x61, 0xe7, 0x45, 0x59, 0x88, 0xde, 0x6b, 0xac, 0x05, 0xbb, 0x14, 0x5f, 0xaf, 0xc1, 0x00,
+	0xf6, 0xec, 0xa8, 0xbd, 0x2d, 0xfc, 0x6d, 0xbb, 0xea, 0x6d, 0x87, 0x06, 0x6e, 0xdd, 0x98, 0x02,
+	0x29, 0xa8, 0x34, 0x55, 0xa8, 0xad, 0xec, 0x6e, 0xf1, 0x26, 0xee, 0xe9, 0x8a, 0x9b, 0xb1, 0xdb,
+	0x28, 0xf4, 0x1b, 0xf7, 0xe6, 0x95, 0x14, 0x59, 0xea, 0xd4, 0xb4, 0x35, 0x70, 0x85, 0x4d, 0x39,
+	0x11, 0x05, 0x89, 0x4e, 0xbb, 0x09, 0x9a, 0x04, 0x9c, 0x17, 0xb3, 0x70, 0x0c, 0xec, 0x66, 0x0e,
+	0x50, 0x64, 0x2b, 0x31, 0xc2, 0xc5, 0xb4, 0xa3, 0x3a, 0x0b, 0x35, 0xb1, 0x55, 0x83, 0x46, 0xcc,
+	0x8d, 0x1b, 0xba, 0x86, 0xa0, 0xca, 0x16, 0x45, 0x5a, 0x95, 0xb2, 0x30, 0x2e, 0x86, 0xa5, 0x1d,
+	0x4e, 0x80, 0xdd, 0x4c, 0x1b, 0xee, 0xf9, 0x89, 0x58, 0xc4, 0xb5, 0xcf, 0x73, 0x7f, 0x22, 0x16,
+	0x2f, 0xf0, 0x0b, 0xfd, 0x5f, 0x06, 0xfb, 0x47, 0x03, 0xba, 0xeb, 0xd7, 0x5c, 0xf6, 0xbe, 0xad,
+	0xd8, 0x33, 0x9e, 0x4d, 0xe9, 0xa0, 0x8b, 0xcd, 0xdc, 0x8d, 0xd8, 0xc9, 0xf9, 0xfc, 0x6b, 0x84,
+	0x2f, 0x84, 0xba, 0x9c, 0x7b, 0xad, 0xb0, 0x22, 0xa6, 0xdc, 0xd7, 0x88, 0x6e, 0x8d, 0xf9, 0x84,
+	0x2f, 0xd8, 0x23, 0xb8, 0x93, 0xa2, 0xfa, 0x2a, 0x38, 0x9d, 0xff, 0x54, 0xa2, 0x50, 0x5d, 0xba,
+	0xf2, 0x76, 0x54, 0x73, 0x3e, 0xf6, 0x3e, 0x94, 0x58, 0xa9, 0x28, 0x16, 0x78, 0xc8, 0x18, 0xc5,
+	0x13, 0x13, 0x27, 0x3c, 0xcb, 0x7c, 0x95, 0x43, 0x4f, 0xdf, 0x39, 0xfa, 0x3c, 0xcb, 0x50, 0x04,
+	0xac, 0xb3, 0x53, 0x51, 0x65, 0xe5, 0xc2, 0x5d, 0x38, 0x59, 0x9d, 0xff, 0x84, 0x3c, 0xe1, 0xdf,
+	0x1b, 0xd0, 0x59, 0x7b, 0x17, 0xc0, 0x8b, 0x64, 0x52, 0xe6, 0x15, 0xb7, 0x2a, 0x85, 0x1b, 0x57,
+	0xcf, 0x0f, 0x56, 0xe0, 0x63, 0xd4, 0xfc, 0xbb, 0x95, 0x9a, 0x16, 0xe2, 0xe6, 0x73, 0xcb, 0x05,
+	0xc2, 0xfe, 0x9b, 0x22, 0x0e, 0xea, 0x53, 0x5d, 0xf0, 0x4a, 0x8f, 0x4b, 0x13, 0x6c, 0x6f, 0xea,
+	0xd3, 0x97, 0xce, 0xe3, 0xf5, 0xa9, 0x67, 0xa2, 0xbe, 0x26, 0x35, 0x1f, 0x27, 0x3c, 0x19, 0x0b,
+	0x57, 0x41, 0x81, 0xa0, 0x3e, 0x22, 0x78, 0x60, 0xb9, 0x13, 0xd5, 0x32, 0x6c, 0x5d, 0x6f, 0x5b,
+	0x8c, 0x28, 0xe1, 0x2f, 0xa1, 0xbb, 0xde, 0x3f, 0xeb, 0xc1, 0x36, 0x96, 0x37, 0xbb, 0x96, 0xf8,
+	0x93, 0x75, 0x61, 0x4b, 0xa6, 0x6e, 0xc9, 0xb6, 0x24, 0x5d, 0xfe, 0xf1, 0xd6, 0x22, 0x94, 0xdb,
+	0x27, 0xce, 0x0a, 0x7f, 0x03, 0xed, 0xda, 0xdc, 0x30, 0x3c, 0xd4, 0x38, 0x28, 0x28, 0x45, 0x61,
+	0xaf, 0xcf, 0x3b, 0x11, 0x20, 0x14, 0x11, 0xc2, 0x7e, 0x0c, 0xb7, 0x93, 0xb1, 0x48, 0x26, 0xb4,
+	0xc7, 0x96, 0xf7, 0x68, 0x1a, 0x68, 0x27, 0x62, 0x2b, 0x97, 0xbf, 0x49, 0xe3, 0x16, 0x5d, 0xb2,
+	0xec, 0xb1, 0xbd, 0xb4, 0xc3, 0xbf, 0x6c, 0x41, 0x77, 0xfd, 0xcd, 0x07, 0xe3, 0xb4, 0xa5, 0x9c,
+	0xc6, 0x6e, 0x46, 0xce, 0x5a, 0xeb, 0x66, 0x6b, 0xbd, 0x1b, 0xbc, 0xaa, 0xa7, 0x52, 0x4f, 0xe2,
+	0x6b, 0xae, 0x8a, 0x38, 0x1f, 0xd2, 0x30, 0x3b, 0x11, 0x20, 0xf6, 0x0d, 0x57, 0xc5, 0x60, 0xc8,
+	0x42, 0xe8, 0x10, 0xa3, 0xe2, 0x53, 0x2d, 0x90, 0xb2, 0x63, 0x65, 0x00, 0x82, 0x17, 0x88, 0x0d,
+	0x86, 0xa8, 0x8f, 0xae, 0x52, 0xdb, 0x47, 0x25, 0x14, 0x4d, 0xdf, 0xe6, 0xbe, 0x73, 0x95, 0x62,
+	0x37, 0x17, 0x16, 0xc4, 0x03, 0xe1, 0x2a, 0x75, 0x3d, 0x79, 0xa2, 0x93, 0x3d, 0x57, 0x29, 0x75,
+	0xe6, 0x99, 0xef, 0x42, 0xd7, 0x69, 0x0f, 0x1f, 0xd9, 0x3e, 0x0d, 0xeb, 0x14, 0x89, 0x8b, 0xed,
+	0x3d, 0x38, 0x74, 0xac, 0x65, 0x74, 0x4d, 0xa2, 0x75, 0x2c, 0xec, 0xe2, 0x0b, 0xc7, 0xd0, 0xae,
+	0x3d, 0x41, 0xe1, 0x19, 0x4d, 0xca, 0xa8, 0x2e, 0xf9, 0x5b, 0x84, 0x90, 0x84, 0xbf, 0x0f, 0xed,
+	0x54, 0x95, 0x95, 0x7f, 0x00, 0x73, 0xa5, 0x03, 0x21, 0xf7, 0xd0, 0x85, 0xaf, 0x15, 0xf4, 0x16,
+	0x32, 0xad, 0xdc, 0x19, 0xba, 0x4f, 0xf6, 0x57, 0x55, 0x78, 0x0e, 0xed, 0xda, 0x3b, 0x15, 0x5d,
+	0x54, 0x6d, 0xc5, 0x15, 0x5e, 0x06, 0xad, 0x00, 0x12, 0x72, 0x62, 0x38, 0x2e, 0xcb, 0x89, 0x3f,
+	0xb0, 0x9d, 0x19, 0x3e, 0x80, 0xd6, 0xf2, 0x0d, 0x0b, 0x69, 0x9a, 0x17, 0xe9, 0xb0, 0x9c, 0xbb,
+	0x85, 0xf5, 0x66, 0xf8, 0x0c, 0x60, 0xf5, 0x98, 0xc8, 0x7e, 0x06, 0x77, 0x53, 0x71, 0x85, 0x87,
+	0x00, 0xea, 0x12, 0x7c, 0xcc, 0x13, 0xa4, 0x06, 0xf0, 0x0a, 0xee, 0x0e, 0xcb, 0x56, 0x14, 0x38,
+	0xca, 0x33, 0xc7, 0x40, 0x7d, 0xd0, 0x47, 0x7f, 0xf8, 0xd7, 0x6d, 0x68, 0xd7, 0x9e, 0x31, 0xf1,
+	0x85, 0xc2, 0x89, 0x86, 0x5c, 0x18, 0x85, 0x37, 0x6d, 0x3b, 0x7a, 0xc7, 0xa2, 0x03, 0x0b, 0xb2,
+	0x0b, 0xe8, 0xd9, 0xe3, 0x1d, 0xdf, 0x28, 0xdc, 0xd5, 0x17, 0x75, 0x5c, 0xf7, 0xe1, 0x83, 0x57,
+	0x3e, 0x8f, 0x9e, 0x45, 0x9e, 0x6d, 0x6f, 0xc5, 0xd1, 0xa1, 0x5a, 0x07, 0xb0, 0x3a, 0xc8, 0xe2,
+	0x2a, 0x9b, 0xce, 0xd3, 0x61, 0xd0, 0xde, 0xac, 0x0e, 0x4f, 0x9d, 0xc7, 0x57, 0x07, 0xcf, 0xb4,
+	0x6a, 0x95, 0x42, 0x8a, 0x0d, 0x1f, 0xe9, 0xe0, 0x80, 0xb2, 0xdd, 0x76, 0xd8, 0x25, 0x1f, 0xe1,
+	0xfd, 0x66, 0x1f, 0x2b, 0x9d, 0x2c, 0x46, 0x41, 0xe7, 0xc6, 0xc3, 0x9a, 0x75, 0x2c, 0x2f, 0xe0,
+	0xd6, 0x64, 0x3f, 0x05, 0xa8, 0x54, 0x89, 0x6a, 0x4c, 0x4c, 0x75, 0xd0, 0xa5, 0x56, 0x6f, 0xd6,
+	0x6b, 0x9b, 0xf7, 0xb9, 0x86, 0x35, 0x36, 0x3b, 0x83, 0x3d, 0x7a, 0x09, 0x4e, 0x83, 0xc3, 0x1b,
+	0x4f, 0x2f, 0x84, 0xfb, 0xb3, 0xdf, 0xb2, 0xc2, 0xcf, 0xe0, 0x70, 0x23, 0x37, 0xec, 0x00, 0x9a,
+	0x7e, 0xc2, 0xbd, 0xd7, 0x58, 0x17, 0x60, 0x35, 0xa0, 0xd5, 0x02, 0xb6, 0xa3, 0xde, 0x56, 0xf8,
+	0x87, 0x06, 0x74, 0xd6, 0xe6, 0xf0, 0x1f, 0xcb, 0xc1, 0xfb, 0x70, 0xf8, 0x2d, 0x17, 0x23, 0xa1,
+	0xe2, 0xe5, 0xf9, 0xe7, 0x8e, 0x27, 0x0b, 0x9f, 0x3b, 0x14, 0x33, 0xaa, 0x79, 0x5e, 0x65, 0x22,
+	0x56, 0x78, 0x06, 0x39, 0x31, 0xdb, 0xb6, 0x58, 0x84, 0x10, 0x1e, 0x0d, 0x98, 0x29, 0x11, 0xfb,
+	0x27, 0x66, 0x7b, 0x0e, 0x1d, 0x10, 0xe8, 0x4e, 0x91, 0xf0, 0x03, 0xe8, 0x6d, 0xe6, 0xa9, 0xf6,
+	0xa0, 0xea, 0x24, 0x82, 0xb5, 0xc2, 0x9f, 0xc3, 0x41, 0x3d, 0x37, 0xff, 0x45, 0xc1, 0x1c, 0xc3,
+	0x5e, 0xa5, 0xc4, 0x95, 0x9c, 0x7b, 0x01, 0x6e, 0xad, 0x70, 0x0e, 0xdd, 0xf5, 0x3d, 0x82, 0x5a,
+	0x67, 0x5c, 0x6a, 0xe3, 0xf5, 0x3b, 0xfe, 0x46, 0x8c, 0x24, 0xb0, 0xad, 0x87, 0xf4, 0x1b, 0xeb,
+	0x7e, 0x3a, 0x74, 0x35, 0x7e, 0x2b, 0x1d, 0x22, 0x67, 0xaa, 0x85, 0x72, 0xa2, 0x87, 0x7e, 0x63,
+	0x2d, 0xc5, 0x87, 0xb2, 0xeb, 0x52, 0xa5, 0x5e, 0xee, 0x7a, 0x7b, 0xb8, 0x47, 0x7f, 0x60, 0x3c,
+	0xfa, 0xf7, 0x00, 0x34, 0x2f, 0x84, 0x82, 0xd0, 0x18, 0x00, 0x00,
 }
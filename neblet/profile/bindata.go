@@ -0,0 +1,237 @@
+// Code generated by go-bindata.
+// sources:
+// ../../conf/profiles/devnet/genesis.conf
+// DO NOT EDIT!
+
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _devnetGenesisConf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x54\xcb\x6e\x1b\x57\x0c\xdd\xeb\x2b\x06\xce\x36\x70\x2e\xef\x25\xef\xc3\x1f\xd1\x4d\x97\x45\x10\xf0\xd9\x08\x49\x24\xc3\x92\x8b\x06\x85\xff\xbd\x94\x5d\x29\x9b\x2c\xda\x0e\x06\x9a\xab\x19\x1e\x3e\x0e\x0f\xf9\x6e\xfb\xc5\x65\xfb\xdd\x0f\x7e\xda\x9f\xb6\xb3\xff\x79\xde\x62\xff\xd5\xef\xb7\x5f\xf5\xb3\x7f\xf3\x2d\x5f\x9a\xc7\xfe\xe0\xb6\xed\x0f\x9b\x1e\x9f\xfc\xc3\xa3\x7c\xf8\xc7\xfe\xfe\xf1\xe9\x78\x3e\xde\xef\xde\xed\x76\xdf\xfc\xcc\xdb\x5f\xbb\x6d\xd3\xcf\xbc\x3f\x7c\xda\xdb\xc3\x06\xa5\xec\x5e\x76\x3b\x3d\x1e\x4e\x7e\x38\x3d\x9f\x5e\x3f\xdb\xe3\xf1\xed\x90\xc7\xef\x07\x3e\x9d\xbf\x3f\x6c\xbf\xbd\xfe\xbd\x03\xae\xbd\x11\x0e\x83\x3e\x74\xa0\x06\xca\x8c\x05\xbd\x6b\x70\x45\x34\x2f\x38\x41\x09\x90\x91\x98\xab\xde\xbd\x7f\xc3\xd5\xf0\x16\x2b\x08\x62\x71\x21\x33\x8a\xa1\xd4\xea\x82\x3a\xf2\xb4\x60\xe4\x0d\xb8\x04\x1d\xba\x14\x99\x57\x5c\x6b\x4d\xa5\xb9\x4d\xc5\xb4\x19\x30\x91\xda\xac\x6e\xde\x34\xfa\xe0\xc2\xab\xd7\x51\xb4\x50\x78\x8d\x51\xca\x15\x87\x99\xd5\x04\xb7\x36\x17\x94\x80\xda\xaa\x82\xb0\x40\xc5\xe8\x54\x14\x67\x65\x1a\x75\x40\x6f\xd5\x64\x79\xbb\xe2\x68\x85\x52\xed\x65\x54\x29\x8b\x63\xf2\x54\x5e\x23\x8d\xd8\x33\xc1\xf4\x82\x7e\xc9\xd9\x31\xe3\xd7\xf4\x7a\xc5\x0d\x72\x74\xe2\x01\xd6\x71\xd4\x35\x65\xce\x55\x67\xa6\x2d\x64\x8c\xcd\x56\xc9\xe0\xdc\xd3\xb7\x95\x95\xd9\xde\x70\xc6\xcb\x58\xdc\x04\xb5\x3b\x54\x00\xec\x21\x58\x29\x2b\x9b\xb3\x59\x87\x59\x68\x14\xef\x79\x94\x32\x6f\xf5\xad\xc9\xcd\xdd\xfa\x1c\x1d\x8b\x64\x74\x25\x92\x20\x5d\x5e\x27\xb6\x01\x62\xca\xce\xb2\xb0\x51\x45\x23\xb8\xe2\x78\x06\x04\xb5\x45\x35\xe9\x27\xed\xbd\x14\x1d\x43\x63\x55\xe9\xe4\x45\x97\x74\xc4\xd5\x39\xdd\xd7\x49\x7d\x5d\x71\x52\xb0\x34\x6a\xae\x25\x92\x49\x68\x46\xbd\x2d\x44\x8c\x51\xa9\xf5\x09\xec\x6a\x0c\x31\x65\x41\x64\xa3\x6e\xf5\x09\x02\x62\xab\x0e\x14\x35\xbb\x30\x0a\xb4\x02\x23\xb8\xbb\xaf\x12\xba\x32\xc1\x66\xae\x33\x9d\xea\xcc\xcf\x3f\x70\x2b\x5a\xb1\xe2\x59\x92\xce\x6c\x82\x39\xa1\x1a\xe4\x33\x1b\x2f\x5a\x75\x78\x7e\xe4\xe8\xd8\x93\xdc\x5b\xff\x64\xd8\x6c\x82\xc8\xc9\xc1\x1a\xb5\x24\x2f\x09\x93\x54\x1e\x6a\xa9\xa5\x9a\xf7\x64\xc0\x45\x65\xd5\x21\xf8\x23\x4f\xa6\xae\x8a\x54\x1d\xa9\x10\x81\x0c\x59\x1a\x29\x2a\x2a\x13\xb9\xf4\xe5\x53\xc1\xb3\x62\xa8\xcc\x56\x6f\x7d\x57\x12\x8d\x54\x68\xf0\x4c\x53\x71\x8c\x2a\x79\x83\x67\xcf\xc0\x98\x92\xde\xde\xb3\xb0\x21\xa9\x24\x43\xbe\xe1\xc6\xb2\xd5\x2f\x33\x04\x92\x4a\xb3\xae\x3c\x34\x75\x0e\x09\x77\xf2\x21\x5e\x31\x15\x1d\x0e\x8d\x67\x52\x74\xc5\x25\x03\x62\x9e\xa5\x79\xaa\x32\x15\xd2\x51\x78\x20\x01\x44\xa9\xb3\xd5\x51\xbb\x1b\x1b\xad\x3e\x06\x61\x2d\xb7\x78\x36\x7b\xac\x0c\x39\x38\xdb\x16\x3c\xb8\xd7\x16\x16\x13\xc3\x72\x8c\xb1\xcb\x5a\x7a\x99\xb4\x46\xa1\x8c\x72\xe3\xc5\x4b\x8c\x29\x05\x20\x15\xb8\xd4\xbb\x4d\x19\xe9\x69\x8c\x8b\x54\x67\xb4\x70\x64\xa8\x66\x8b\xd0\x85\xf1\xa6\x97\x68\xd3\xa4\x49\xd7\x59\xc0\xf2\xea\xa9\xc2\x6e\xa6\xb5\xcc\xc9\x9c\x59\x53\xae\x8b\xd5\x7a\x87\xe5\x38\xf1\x36\xef\xa1\x23\xc9\xcf\x37\x62\x54\x57\x8f\x69\x35\xc7\x7c\x52\x1b\xd6\x5a\x54\xe2\xb4\x8d\x5c\x19\x39\x9a\xc9\x7b\xf4\xbb\x57\xd8\xc7\xfc\x7d\xb9\xec\xb1\xf3\xf1\x8b\x1f\x3e\xd9\xfe\x74\x7e\xda\xcb\xf3\x79\x7f\x3c\xbc\x2e\xae\xb7\x5d\xc6\x66\x4f\x7e\x3a\x3d\xfc\x8f\x2d\xf6\x8a\xff\x83\xbf\x3e\xfb\x05\x5d\x7e\x7a\x5d\x8c\x5e\xde\xff\x24\xda\x7f\xde\x7d\xff\x3a\xda\xee\xe3\xdf\x9b\xb7\xf2\x2f\x1b\x06\x00\x00")
+
+func devnetGenesisConfBytes() ([]byte, error) {
+	return bindataRead(
+		_devnetGenesisConf,
+		"devnet/genesis.conf",
+	)
+}
+
+func devnetGenesisConf() (*asset, error) {
+	bytes, err := devnetGenesisConfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "devnet/genesis.conf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"devnet/genesis.conf": devnetGenesisConf,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = &bintree{nil, map[string]*bintree{
+	"devnet": &bintree{nil, map[string]*bintree{
+		"genesis.conf": &bintree{devnetGenesisConf, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
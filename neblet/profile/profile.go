@@ -0,0 +1,121 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package profile is the networks bundled in the binary, joined by
+// `neb --network <name>` without distributing their genesis and seeds.
+package profile
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// Errors of network profiles
+var (
+	ErrUnknownProfile     = errors.New("unknown network profile")
+	ErrProfileNotReleased = errors.New("network profile not released in this binary")
+)
+
+// Profile is a network bundled in the binary.
+type Profile struct {
+	// Name of the network, the value of --network.
+	Name string
+	// ChainID of the network, the same as the one of its genesis.
+	ChainID uint32
+	// Seeds are the p2p seeds of the network, empty if the network has none,
+	// e.g. a devnet whose first node is the seed of the others.
+	Seeds []string
+	// Checkpoints are the hex hashes of canonical blocks by height, the chain
+	// of a snapshot bootstrapping a node must go through them down to the
+	// genesis of the profile. Added at releases.
+	Checkpoints map[uint64]string
+	// Dev is true for the networks meant for development, whose nodes may
+	// rewrite the chain, e.g. by simulated reorgs.
+	Dev bool
+
+	// asset name of the genesis conf, empty if not released yet.
+	genesis string
+}
+
+var profiles = map[string]*Profile{
+	// mainnet and testnet are filled in once their genesis and seeds are
+	// published.
+	"mainnet": {
+		Name:    "mainnet",
+		ChainID: 1,
+	},
+	"testnet": {
+		Name:    "testnet",
+		ChainID: 1001,
+	},
+	"devnet": {
+		Name:    "devnet",
+		ChainID: 100,
+		Dev:     true,
+		genesis: "devnet/genesis.conf",
+	},
+}
+
+// Get returns the profile of the network.
+func Get(name string) (*Profile, error) {
+	p, ok := profiles[name]
+	if !ok {
+		return nil, ErrUnknownProfile
+	}
+	return p, nil
+}
+
+// ByChainID returns the profile of the network of the chain id, false if the
+// chain isn't a bundled network.
+func ByChainID(chainID uint32) (*Profile, bool) {
+	for _, p := range profiles {
+		if p.ChainID == chainID {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Names returns the names of the bundled networks.
+func Names() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Genesis returns the genesis conf of the network.
+func (p *Profile) Genesis() (*corepb.Genesis, error) {
+	if len(p.genesis) == 0 {
+		return nil, ErrProfileNotReleased
+	}
+	content, err := Asset(p.genesis)
+	if err != nil {
+		return nil, err
+	}
+	genesis := new(corepb.Genesis)
+	if err := proto.UnmarshalText(string(content), genesis); err != nil {
+		return nil, err
+	}
+	return genesis, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfiles(t *testing.T) {
+	assert.Equal(t, []string{"devnet", "mainnet", "testnet"}, Names())
+
+	p, err := Get("devnet")
+	assert.Nil(t, err)
+	genesis, err := p.Genesis()
+	assert.Nil(t, err)
+	assert.Equal(t, p.ChainID, genesis.Meta.ChainId)
+	assert.NotEmpty(t, genesis.Consensus.Dpos.Dynasty)
+
+	for _, name := range []string{"mainnet", "testnet"} {
+		p, err := Get(name)
+		assert.Nil(t, err)
+		_, err = p.Genesis()
+		assert.Equal(t, ErrProfileNotReleased, err, name)
+	}
+
+	_, err = Get("unknown")
+	assert.Equal(t, ErrUnknownProfile, err)
+
+	p, ok := ByChainID(100)
+	assert.True(t, ok)
+	assert.True(t, p.Dev)
+	p, ok = ByChainID(1)
+	assert.True(t, ok)
+	assert.False(t, p.Dev)
+	_, ok = ByChainID(12345)
+	assert.False(t, ok)
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrDuplicateChain throws when two hosted chains have the same chain id.
+	ErrDuplicateChain = errors.New("duplicate chain id of hosted chains")
+
+	// ErrChainConfigNotFound throws when the config file of a hosted chain does not exist.
+	ErrChainConfigNotFound = errors.New("config file of hosted chain not found")
+)
+
+// Registry is the chains hosted in one process keyed by chain id, e.g. mainnet and
+// testnet. Each chain has its own storage, pools and p2p network, while the process
+// wide services (rpc, metrics, audit log, crash report, tracing) belong to the
+// primary neblet.
+type Registry struct {
+	mu     sync.RWMutex
+	chains map[uint32]*Neblet
+}
+
+// NewRegistry create a registry of the primary neblet.
+func NewRegistry(primary *Neblet) *Registry {
+	return &Registry{
+		chains: map[uint32]*Neblet{primary.config.Chain.ChainId: primary},
+	}
+}
+
+// Register add the neblet of a chain.
+func (r *Registry) Register(n *Neblet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	chainID := n.config.Chain.ChainId
+	if _, ok := r.chains[chainID]; ok {
+		return ErrDuplicateChain
+	}
+	r.chains[chainID] = n
+	return nil
+}
+
+// Get return the neblet of the chain.
+func (r *Registry) Get(chainID uint32) (*Neblet, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	n, ok := r.chains[chainID]
+	return n, ok
+}
+
+// ChainIDs return the id of all the chains, in ascending order.
+func (r *Registry) ChainIDs() []uint32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]uint32, 0, len(r.chains))
+	for id := range r.chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// hosted return the neblets of the chains but the primary one.
+func (r *Registry) hosted() []*Neblet {
+	var neblets []*Neblet
+	for _, id := range r.ChainIDs() {
+		n, _ := r.Get(id)
+		if n.hosted {
+			neblets = append(neblets, n)
+		}
+	}
+	return neblets
+}
+
+// setupChains sets up the chains configured to be hosted by the primary neblet.
+func (n *Neblet) setupChains() error {
+	n.registry = NewRegistry(n)
+	for _, path := range n.config.Chains {
+		if !pathExist(path) {
+			logging.CLog().WithFields(logrus.Fields{
+				"config": path,
+			}).Error("Failed to find the config of hosted chain.")
+			return ErrChainConfigNotFound
+		}
+		conf := LoadConfig(path)
+		if err := ApplyNetworkProfile(conf); err != nil {
+			return err
+		}
+		if err := ValidateConfig(conf); err != nil {
+			return err
+		}
+		hosted, err := New(*conf)
+		if err != nil {
+			return err
+		}
+		hosted.hosted = true
+		if err := hosted.Setup(); err != nil {
+			return err
+		}
+		if err := n.registry.Register(hosted); err != nil {
+			return err
+		}
+		logging.CLog().WithFields(logrus.Fields{
+			"chainID": hosted.config.Chain.ChainId,
+			"config":  path,
+		}).Info("Hosted chain set up.")
+	}
+	return nil
+}
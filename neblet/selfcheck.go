@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"github.com/nebulasio/go-nebulas/selfcheck"
+)
+
+// listenAddrs are the addresses the neblet binds, the rpc of the hosted chains
+// is served by the primary one.
+func (n *Neblet) listenAddrs() []string {
+	addrs := []string{}
+	if n.config.Network != nil {
+		addrs = append(addrs, n.config.Network.Listen...)
+	}
+	if !n.hosted && n.config.Rpc != nil {
+		addrs = append(addrs, n.config.Rpc.RpcListen...)
+		addrs = append(addrs, n.config.Rpc.HttpListen...)
+	}
+	return addrs
+}
+
+// checkPorts runs before any service binds its port.
+func (n *Neblet) checkPorts() {
+	n.diagnostics = selfcheck.NewReport()
+	n.diagnostics.Run(selfcheck.CheckPorts, func() error {
+		return selfcheck.PortsBindable(n.listenAddrs()...)
+	})
+}
+
+// selfCheck runs the checks of the storage, keys and chain once loaded, and
+// logs the report, so a misconfiguration surfaces on boot.
+func (n *Neblet) selfCheck() {
+	report := n.diagnostics
+	report.Run(selfcheck.CheckStorage, func() error {
+		return selfcheck.StorageWritable(n.storage)
+	})
+	report.Run(selfcheck.CheckKeystore, func() error {
+		if err := selfcheck.DirReadable(n.config.Chain.Keydir); err != nil {
+			return err
+		}
+		if len(n.config.Chain.SigningKeydir) > 0 {
+			return selfcheck.DirReadable(n.config.Chain.SigningKeydir)
+		}
+		return nil
+	})
+	report.Run(selfcheck.CheckClock, func() error {
+		return selfcheck.ClockSane(n.blockChain.TailBlock().Timestamp())
+	})
+	report.Run(selfcheck.CheckGenesis, n.blockChain.CheckGenesisConf)
+	report.Run(selfcheck.CheckChain, n.blockChain.CheckTail)
+	report.Log()
+}
+
+// Diagnostics returns the report of the self-check run on boot.
+func (n *Neblet) Diagnostics() *selfcheck.Report {
+	return n.diagnostics
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/feature"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/neblet/profile"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// bootstrapSnapshot unpacks the configured snapshot into the datadir of a
+// fresh node, which then syncs the blocks above it from the network.
+func (n *Neblet) bootstrapSnapshot() error {
+	conf := n.config.GetStorage().GetSnapshot()
+	if conf == nil || len(conf.Url) == 0 || !n.features.Enabled(feature.SnapshotSync) {
+		return nil
+	}
+	if infos, err := ioutil.ReadDir(n.config.Chain.Datadir); err == nil && len(infos) > 0 {
+		return nil
+	}
+	checkpoints, err := embeddedCheckpoints(n.config.Chain.Network)
+	if err != nil {
+		return err
+	}
+	return BootstrapSnapshot(conf, n.genesis, checkpoints, n.config.Chain.Datadir)
+}
+
+// embeddedCheckpoints returns the checkpoints of the network bundled in the binary.
+func embeddedCheckpoints(network string) (map[uint64]byteutils.Hash, error) {
+	checkpoints := make(map[uint64]byteutils.Hash)
+	if len(network) == 0 {
+		return checkpoints, nil
+	}
+	p, err := profile.Get(network)
+	if err != nil {
+		return nil, err
+	}
+	for height, hex := range p.Checkpoints {
+		hash, err := byteutils.ParseHash(hex)
+		if err != nil {
+			return nil, err
+		}
+		checkpoints[height] = hash
+	}
+	return checkpoints, nil
+}
+
+// BootstrapSnapshot downloads the snapshot into the datadir. Its attestation
+// must be signed by the configured signer, and its storage must hold the
+// attested tail, chained down to the genesis of the conf through the checkpoints. The snapshot is unpacked
+// beside the datadir and moved into it once verified.
+func BootstrapSnapshot(conf *nebletpb.SnapshotConfig, genesis *corepb.Genesis, checkpoints map[uint64]byteutils.Hash, datadir string) error {
+	signer, err := core.AddressParse(conf.Signer)
+	if err != nil {
+		return err
+	}
+	client := storage.NewRemoteBackupClient()
+	data, err := storage.FetchRemoteBackupFile(client, conf.Url, storage.BackupSignatureFile(conf.Id), storage.MaxRemoteBackupSignatureSize)
+	if err != nil {
+		return err
+	}
+	attestation := new(core.SnapshotAttestation)
+	if err := json.Unmarshal(data, attestation); err != nil {
+		return err
+	}
+	if err := attestation.VerifySigner(signer); err != nil {
+		return err
+	}
+	if attestation.ChainID != genesis.Meta.ChainId || attestation.ID != conf.Id {
+		return core.ErrSnapshotMismatch
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"url":    conf.Url,
+		"id":     conf.Id,
+		"height": attestation.Height,
+		"tail":   attestation.Tail,
+	}).Info("Downloading snapshot.")
+
+	// the leftover of an interrupted download is dropped.
+	tmp := datadir + ".snapshot"
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	// the files are only downloaded for the attested manifest.
+	manifest, err := storage.FetchRemoteBackupManifest(client, conf.Url, conf.Id)
+	if err != nil {
+		return err
+	}
+	if manifest.Digest() != attestation.Digest {
+		return core.ErrSnapshotMismatch
+	}
+	if err := storage.DownloadBackup(client, conf.Url, manifest, tmp); err != nil {
+		return err
+	}
+	db, err := storage.NewDiskStorage(tmp)
+	if err != nil {
+		return err
+	}
+	err = core.VerifySnapshotStorage(db, attestation, genesis, checkpoints)
+	if cerr := db.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(datadir); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Rename(tmp, datadir); err != nil {
+		return err
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"height":  attestation.Height,
+		"tail":    attestation.Tail,
+		"datadir": datadir,
+	}).Info("Bootstrapped from snapshot.")
+	return nil
+}
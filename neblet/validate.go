@@ -0,0 +1,368 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/feature"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/neblet/profile"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// ConfigError is a problem of a config field, like "chain.coinbase".
+type ConfigError struct {
+	Field   string
+	Message string
+}
+
+func (e *ConfigError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ConfigErrors are all the problems found in a config.
+type ConfigErrors []*ConfigError
+
+func (errs ConfigErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+type configValidator struct {
+	errs ConfigErrors
+}
+
+func (v *configValidator) fail(field string, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ConfigError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *configValidator) network(chain *nebletpb.ChainConfig) {
+	p, err := profile.Get(chain.Network)
+	if err != nil {
+		v.fail("chain.network", "unknown network %q, should be one of %s", chain.Network, strings.Join(profile.Names(), ", "))
+		return
+	}
+	if len(chain.Genesis) > 0 {
+		return
+	}
+	if _, err := p.Genesis(); err != nil {
+		v.fail("chain.network", "network %q: %v", chain.Network, err)
+	} else if chain.ChainId != p.ChainID {
+		v.fail("chain.chain_id", "%d differs from %d of network %q", chain.ChainId, p.ChainID, chain.Network)
+	}
+}
+
+func (v *configValidator) address(field string, addr string) {
+	if _, err := core.AddressParse(addr); err != nil {
+		v.fail(field, "invalid address %q", addr)
+	}
+}
+
+func (v *configValidator) amount(field string, amount string) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || value.Sign() < 0 || value.BitLen() > 128 {
+		v.fail(field, "invalid amount %q, should be a decimal uint128", amount)
+	}
+}
+
+func (v *configValidator) listen(field string, addrs []string) {
+	for _, addr := range addrs {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			v.fail(field, "invalid listen address %q, should be host:port", addr)
+		}
+	}
+}
+
+func (v *configValidator) sentry(network *nebletpb.NetworkConfig) {
+	switch network.Mode {
+	case p2p.ModeFull:
+		if len(network.PrivatePeers) > 0 {
+			v.fail("network.private_peers", "used by sentry or validator mode only")
+		}
+		return
+	case p2p.ModeSentry, p2p.ModeValidator:
+	default:
+		v.fail("network.mode", "unknown mode %q, should be %q or %q", network.Mode, p2p.ModeSentry, p2p.ModeValidator)
+		return
+	}
+	if len(network.PrivatePeers) == 0 {
+		v.fail("network.private_peers", "required by %s mode", network.Mode)
+	}
+	for _, peer := range network.PrivatePeers {
+		addr, err := multiaddr.NewMultiaddr(peer)
+		if err == nil {
+			_, _, err = p2p.ParsePeerAddress(addr)
+		}
+		if err != nil {
+			v.fail("network.private_peers", "invalid peer %q, should be /ip4/<ip>/tcp/<port>/ipfs/<id>", peer)
+		}
+	}
+	if network.Mode == p2p.ModeValidator {
+		if len(network.Seed) > 0 {
+			v.fail("network.seed", "conflicts with validator mode, a validator connects to its private peers only")
+		}
+		if network.Mdns {
+			v.fail("network.mdns", "conflicts with validator mode, a validator connects to its private peers only")
+		}
+	}
+}
+
+// ParseConfigFile parses a config file strictly, unknown fields and values of
+// a wrong type are reported with their line, and validates it.
+func ParseConfigFile(file string) (*nebletpb.Config, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	conf := new(nebletpb.Config)
+	if err := proto.UnmarshalText(string(b), conf); err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	if err := ValidateConfig(conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// ValidateConfig returns ConfigErrors of all the invalid values and
+// conflicting options of the config, or nil.
+func ValidateConfig(conf *nebletpb.Config) error {
+	v := &configValidator{}
+
+	if conf.Network != nil {
+		v.listen("network.listen", conf.Network.Listen)
+		v.sentry(conf.Network)
+		if relay := conf.Network.TxRelay; relay != nil {
+			rebroadcast := uint32(core.RebroadcastInterval / time.Millisecond)
+			if relay.BatchIntervalMs >= rebroadcast {
+				v.fail("network.tx_relay.batch_interval_ms", "must be less than the rebroadcast interval %d", rebroadcast)
+			}
+			if relay.MaxDelayMs >= rebroadcast {
+				v.fail("network.tx_relay.max_delay_ms", "must be less than the rebroadcast interval %d", rebroadcast)
+			}
+		}
+	}
+
+	chain := conf.Chain
+	if chain == nil {
+		v.fail("chain", "missing")
+	} else {
+		if len(chain.Datadir) == 0 {
+			v.fail("chain.datadir", "missing")
+		}
+		if len(chain.Coinbase) > 0 {
+			v.address("chain.coinbase", chain.Coinbase)
+		}
+		if len(chain.Miner) > 0 {
+			v.address("chain.miner", chain.Miner)
+		}
+		if chain.StartMine {
+			if len(chain.Coinbase) == 0 {
+				v.fail("chain.coinbase", "required by start_mine")
+			}
+			if len(chain.Miner) == 0 {
+				v.fail("chain.miner", "required by start_mine")
+			}
+		}
+		if len(chain.Passphrase) > 0 && chain.PassphraseSecret != nil &&
+			chain.PassphraseSecret.Provider != nebletpb.SecretConfig_None {
+			v.fail("chain.passphrase_secret", "conflicts with chain.passphrase, set only one of them")
+		}
+		if len(chain.GasPrice) > 0 {
+			v.amount("chain.gas_price", chain.GasPrice)
+		}
+		if len(chain.GasLimit) > 0 {
+			v.amount("chain.gas_limit", chain.GasLimit)
+		}
+		for _, cipher := range chain.SignatureCiphers {
+			if cipher != account.EccSecp256K1 {
+				v.fail("chain.signature_ciphers", "unsupported cipher %q, should be %q", cipher, account.EccSecp256K1)
+			}
+		}
+		if len(chain.Network) > 0 {
+			v.network(chain)
+		}
+	}
+
+	if conf.Rpc != nil {
+		v.listen("rpc.rpc_listen", conf.Rpc.RpcListen)
+		v.listen("rpc.http_listen", conf.Rpc.HttpListen)
+		for _, module := range conf.Rpc.HttpModule {
+			if module != "api" && module != "admin" {
+				v.fail("rpc.http_module", "unknown module %q, should be \"api\" or \"admin\"", module)
+			}
+		}
+		names := make(map[string]bool)
+		keys := make(map[string]bool)
+		for _, tenant := range conf.Rpc.Tenants {
+			if len(tenant.ApiKey) == 0 {
+				v.fail("rpc.tenants.api_key", "missing for tenant %q", tenant.Name)
+			} else if keys[tenant.ApiKey] {
+				v.fail("rpc.tenants.api_key", "duplicate key of tenant %q", tenant.Name)
+			}
+			if names[tenant.Name] {
+				v.fail("rpc.tenants.name", "duplicate tenant %q", tenant.Name)
+			}
+			names[tenant.Name] = true
+			keys[tenant.ApiKey] = true
+		}
+		if conf.Rpc.CpuQuota > 100 {
+			v.fail("rpc.cpu_quota", "%d out of [0, 100]", conf.Rpc.CpuQuota)
+		}
+		if conf.Rpc.BusyCpuQuota > 100 {
+			v.fail("rpc.busy_cpu_quota", "%d out of [0, 100]", conf.Rpc.BusyCpuQuota)
+		}
+		if estimate := conf.Rpc.Estimate; estimate != nil && len(estimate.MaxGas) > 0 {
+			v.amount("rpc.estimate.max_gas", estimate.MaxGas)
+		}
+		if cors := conf.Rpc.HttpCors; cors != nil && cors.AllowCredentials {
+			anyOrigin := len(cors.AllowedOrigins) == 0
+			for _, origin := range cors.AllowedOrigins {
+				if origin == "*" {
+					anyOrigin = true
+				}
+			}
+			if anyOrigin {
+				v.fail("rpc.http_cors.allow_credentials", "conflicts with any origin allowed, list the allowed origins")
+			}
+		}
+	}
+
+	if stats := conf.Stats; stats != nil {
+		if stats.EnableMetrics {
+			for _, module := range stats.ReportingModule {
+				switch module {
+				case nebletpb.StatsConfig_Prometheus:
+					if stats.Prometheus == nil || len(stats.Prometheus.Listen) == 0 {
+						v.fail("stats.prometheus.listen", "required by reporting module Prometheus")
+					}
+				case nebletpb.StatsConfig_Statsd:
+					if stats.Statsd == nil || len(stats.Statsd.Address) == 0 {
+						v.fail("stats.statsd.address", "required by reporting module Statsd")
+					}
+				}
+			}
+		}
+		if tracing := stats.Tracing; tracing != nil && tracing.Enable {
+			if len(tracing.JaegerEndpoint) == 0 {
+				v.fail("stats.tracing.jaeger_endpoint", "required when tracing is enabled")
+			}
+			if tracing.SampleRatio < 0 || tracing.SampleRatio > 1 {
+				v.fail("stats.tracing.sample_ratio", "%v out of [0, 1]", tracing.SampleRatio)
+			}
+		}
+	}
+
+	if watchdog := conf.Watchdog; watchdog != nil && watchdog.Enable {
+		if watchdog.DiskWarnMb > 0 && watchdog.DiskPauseMb > watchdog.DiskWarnMb {
+			v.fail("watchdog.disk_pause_mb", "should not be above disk_warn_mb")
+		}
+		if watchdog.FdWarnPercent > 100 {
+			v.fail("watchdog.fd_warn_percent", "%d above 100", watchdog.FdWarnPercent)
+		}
+		if watchdog.FdPausePercent > 100 {
+			v.fail("watchdog.fd_pause_percent", "%d above 100", watchdog.FdPausePercent)
+		}
+		if watchdog.FdPausePercent > 0 && watchdog.FdPausePercent < watchdog.FdWarnPercent {
+			v.fail("watchdog.fd_pause_percent", "should not be below fd_warn_percent")
+		}
+		if watchdog.MemoryPauseMb > 0 && watchdog.MemoryPauseMb < watchdog.MemoryWarnMb {
+			v.fail("watchdog.memory_pause_mb", "should not be below memory_warn_mb")
+		}
+	}
+
+	for _, name := range conf.Features {
+		if _, err := feature.New([]string{name}); err != nil {
+			v.fail("features", "unknown feature %q", name)
+		}
+	}
+
+	if conf.Storage != nil {
+		if _, err := storage.ParseCompactionTimes(conf.Storage.CompactionAt); err != nil {
+			v.fail("storage.compaction_at", "%v", err)
+		}
+		if snapshot := conf.Storage.Snapshot; snapshot != nil && len(snapshot.Url) > 0 {
+			if _, err := storage.RemoteBackupURL(snapshot.Url); err != nil {
+				v.fail("storage.snapshot.url", "%v", err)
+			}
+			if len(snapshot.Id) == 0 {
+				v.fail("storage.snapshot.id", "missing")
+			}
+			v.address("storage.snapshot.signer", snapshot.Signer)
+			if flags, _ := feature.New(conf.Features); !flags.Enabled(feature.SnapshotSync) {
+				v.fail("storage.snapshot", "requires feature %q", feature.SnapshotSync)
+			}
+		}
+		if conf.Storage.Prune != nil && chain != nil && chain.Archive {
+			v.fail("storage.prune", "conflicts with chain.archive, an archive node keeps all states")
+		}
+		if prune := conf.Storage.Prune; prune != nil && prune.KeepRecent > 0 && prune.KeepRecent < core.MinPruneKeepRecent {
+			v.fail("storage.prune.keep_recent", "%d below %d", prune.KeepRecent, core.MinPruneKeepRecent)
+		}
+	}
+
+	if policy := conf.TxPolicy; policy != nil {
+		if len(policy.MaxValuePerTx) > 0 {
+			v.amount("tx_policy.max_value_per_tx", policy.MaxValuePerTx)
+		}
+		if len(policy.MaxValuePerDay) > 0 {
+			v.amount("tx_policy.max_value_per_day", policy.MaxValuePerDay)
+		}
+		for _, addr := range policy.DestinationAllowlist {
+			v.address("tx_policy.destination_allowlist", addr)
+		}
+	}
+
+	if conf.Event != nil {
+		if _, err := core.ParseDropPolicy(conf.Event.DropPolicy); err != nil {
+			v.fail("event.drop_policy", "unknown policy %q, should be \"newest\" or \"oldest\"", conf.Event.DropPolicy)
+		}
+	}
+
+	for _, path := range conf.Chains {
+		if !pathExist(path) {
+			v.fail("chains", "config file %q not found", path)
+		}
+	}
+
+	if conf.Watch != nil {
+		for _, addr := range conf.Watch.Addresses {
+			v.address("watch.addresses", addr)
+		}
+	}
+
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
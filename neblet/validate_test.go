@@ -0,0 +1,175 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfigFile(t *testing.T) {
+	files, err := filepath.Glob("../conf/*/*.conf")
+	assert.Nil(t, err)
+	for _, file := range files {
+		if strings.HasSuffix(file, "genesis.conf") {
+			continue
+		}
+		_, err := ParseConfigFile(file)
+		assert.Nil(t, err, file)
+	}
+
+	dir, err := ioutil.TempDir("", "config")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"unknown field", "chain {\n  datadir: \"data.db\"\n  pruning: true\n}", "line 3: unknown field name \"pruning\""},
+		{"wrong type", "chain {\n  chain_id: \"main\"\n}", "line 2: invalid uint32: \"main\""},
+		{"invalid value", "chain {\n  datadir: \"data.db\"\n  coinbase: \"bad\"\n}", "chain.coinbase: invalid address \"bad\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(dir, "config.conf")
+			assert.Nil(t, ioutil.WriteFile(file, []byte(tt.content), 0644))
+			_, err := ParseConfigFile(file)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	valid := func() *nebletpb.Config {
+		return &nebletpb.Config{
+			Network: &nebletpb.NetworkConfig{Listen: []string{"0.0.0.0:8680"}},
+			Chain: &nebletpb.ChainConfig{
+				Datadir:          "data.db",
+				StartMine:        true,
+				Coinbase:         "eb31ad2d8a89a0ca6935c308d5425730430bc2d63f2573b8",
+				Miner:            "75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+				SignatureCiphers: []string{"ECC_SECP256K1"},
+			},
+			Rpc: &nebletpb.RPCConfig{HttpModule: []string{"api", "admin"}},
+		}
+	}
+	assert.Nil(t, ValidateConfig(valid()))
+
+	devnet := valid()
+	devnet.Chain.Network = "devnet"
+	assert.Nil(t, ApplyNetworkProfile(devnet))
+	assert.Equal(t, uint32(100), devnet.Chain.ChainId)
+	assert.Nil(t, ValidateConfig(devnet))
+	genesis, err := loadGenesis(devnet.Chain)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(100), genesis.Meta.ChainId)
+
+	tests := []struct {
+		name   string
+		modify func(conf *nebletpb.Config)
+		fields []string
+	}{
+		{"missing chain", func(conf *nebletpb.Config) { conf.Chain = nil }, []string{"chain"}},
+		{"start mine without miner", func(conf *nebletpb.Config) { conf.Chain.Miner = "" }, []string{"chain.miner"}},
+		{"passphrase conflict", func(conf *nebletpb.Config) {
+			conf.Chain.Passphrase = "passphrase"
+			conf.Chain.PassphraseSecret = &nebletpb.SecretConfig{Provider: nebletpb.SecretConfig_Vault}
+		}, []string{"chain.passphrase_secret"}},
+		{"listen", func(conf *nebletpb.Config) { conf.Network.Listen = []string{"8680"} }, []string{"network.listen"}},
+		{"tx relay delay", func(conf *nebletpb.Config) {
+			conf.Network.TxRelay = &nebletpb.TxRelayConfig{Fanout: 8, BatchIntervalMs: 500, MaxDelayMs: 30000}
+		}, []string{"network.tx_relay.max_delay_ms"}},
+		{"unknown mode", func(conf *nebletpb.Config) { conf.Network.Mode = "relay" }, []string{"network.mode"}},
+		{"validator", func(conf *nebletpb.Config) {
+			conf.Network.Mode = "validator"
+			conf.Network.Seed = []string{"/ip4/127.0.0.1/tcp/8680/ipfs/QmP7HDFcYmJL12Ez4ZNVCKjKedfE7f48f1LAkUc3Whz4jP"}
+			conf.Network.PrivatePeers = []string{"/ip4/10.0.0.2/tcp/8680"}
+		}, []string{"network.private_peers", "network.seed"}},
+		{"private peers", func(conf *nebletpb.Config) {
+			conf.Network.PrivatePeers = []string{"/ip4/10.0.0.2/tcp/8680/ipfs/QmP7HDFcYmJL12Ez4ZNVCKjKedfE7f48f1LAkUc3Whz4jP"}
+		}, []string{"network.private_peers"}},
+		{"unknown feature", func(conf *nebletpb.Config) { conf.Features = []string{"wasm"} }, []string{"features"}},
+		{"snapshot feature", func(conf *nebletpb.Config) {
+			conf.Storage = &nebletpb.StorageConfig{Snapshot: &nebletpb.SnapshotConfig{
+				Url:    "s3://bucket/snapshots/neb",
+				Id:     "1530000000000000000",
+				Signer: "eb31ad2d8a89a0ca6935c308d5425730430bc2d63f2573b8",
+			}}
+		}, []string{"storage.snapshot"}},
+		{"module", func(conf *nebletpb.Config) { conf.Rpc.HttpModule = []string{"api", "debug"} }, []string{"rpc.http_module"}},
+		{"gas price", func(conf *nebletpb.Config) { conf.Chain.GasPrice = "-1" }, []string{"chain.gas_price"}},
+		{"compaction", func(conf *nebletpb.Config) {
+			conf.Storage = &nebletpb.StorageConfig{CompactionAt: []string{"25:00"}}
+		}, []string{"storage.compaction_at"}},
+		{"prune", func(conf *nebletpb.Config) {
+			conf.Storage = &nebletpb.StorageConfig{Prune: &nebletpb.PruneConfig{KeepRecent: 16}}
+		}, []string{"storage.prune.keep_recent"}},
+		{"archive prune", func(conf *nebletpb.Config) {
+			conf.Chain.Archive = true
+			conf.Storage = &nebletpb.StorageConfig{Prune: &nebletpb.PruneConfig{KeepRecent: 1024}}
+		}, []string{"storage.prune"}},
+		{"drop policy", func(conf *nebletpb.Config) {
+			conf.Event = &nebletpb.EventConfig{DropPolicy: "random"}
+		}, []string{"event.drop_policy"}},
+		{"watchdog", func(conf *nebletpb.Config) {
+			conf.Watchdog = &nebletpb.WatchdogConfig{Enable: true, DiskWarnMb: 100, DiskPauseMb: 200, FdPausePercent: 120}
+		}, []string{"watchdog.disk_pause_mb", "watchdog.fd_pause_percent"}},
+		{"prometheus", func(conf *nebletpb.Config) {
+			conf.Stats = &nebletpb.StatsConfig{
+				EnableMetrics:   true,
+				ReportingModule: []nebletpb.StatsConfig_ReportingModule{nebletpb.StatsConfig_Prometheus},
+			}
+		}, []string{"stats.prometheus.listen"}},
+		{"tenants", func(conf *nebletpb.Config) {
+			conf.Rpc.Tenants = []*nebletpb.TenantConfig{{Name: "a", ApiKey: "key"}, {Name: "a", ApiKey: "key"}}
+		}, []string{"rpc.tenants.api_key", "rpc.tenants.name"}},
+		{"cors credentials", func(conf *nebletpb.Config) {
+			conf.Rpc.HttpCors = &nebletpb.HttpCorsConfig{AllowedOrigins: []string{"https://dapp.io", "*"}, AllowCredentials: true}
+		}, []string{"rpc.http_cors.allow_credentials"}},
+		{"unknown network", func(conf *nebletpb.Config) { conf.Chain.Network = "moonnet" }, []string{"chain.network"}},
+		{"unreleased network", func(conf *nebletpb.Config) { conf.Chain.Network = "testnet" }, []string{"chain.network"}},
+		{"network chain id", func(conf *nebletpb.Config) {
+			conf.Chain.Network = "devnet"
+			conf.Chain.ChainId = 1001
+		}, []string{"chain.chain_id"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := valid()
+			tt.modify(conf)
+			err := ValidateConfig(conf)
+			errs, ok := err.(ConfigErrors)
+			assert.True(t, ok)
+			fields := []string{}
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
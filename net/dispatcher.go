@@ -19,12 +19,15 @@
 package net
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/tracing"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Metrics map for different in/out network msg types
@@ -89,6 +92,10 @@ func (dp *Dispatcher) Start() {
 
 			case msg := <-dp.receivedMessageCh:
 				msgType := msg.MessageType()
+				_, span := tracing.Start(context.Background(), "net.dispatch",
+					attribute.String("msgType", msgType),
+					attribute.String("from", msg.MessageFrom()),
+				)
 				v, _ := dp.subscribersMap.Load(msgType)
 				m, _ := v.(*sync.Map)
 				logging.VLog().WithFields(logrus.Fields{
@@ -101,6 +108,7 @@ func (dp *Dispatcher) Start() {
 					}).Info("succeed dispatcher received message")
 					return true
 				})
+				span.End()
 			}
 		}
 	})()
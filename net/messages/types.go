@@ -39,6 +39,8 @@ type BaseMessage struct {
 type HelloMessage struct {
 	NodeID        string
 	ClientVersion string
+	// Build is the version of the binary, empty for the old peers.
+	Build string
 }
 
 // NewHelloMessage new hello message
@@ -51,6 +53,7 @@ func (h *HelloMessage) ToProto() (proto.Message, error) {
 	return &netpb.Hello{
 		NodeId:        h.NodeID,
 		ClientVersion: h.ClientVersion,
+		Build:         h.Build,
 	}, nil
 }
 
@@ -59,6 +62,7 @@ func (h *HelloMessage) FromProto(msg proto.Message) error {
 	if msg, ok := msg.(*netpb.Hello); ok {
 		h.NodeID = msg.NodeId
 		h.ClientVersion = msg.ClientVersion
+		h.Build = msg.Build
 		return nil
 	}
 	return errors.New("Pb Message cannot be converted into HelloMessage")
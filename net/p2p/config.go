@@ -39,6 +39,7 @@ const (
 	DefaultStreamStoreExtendSize  = 32
 	DefaultNetworkID              = 1
 	DefaultRoutingTableDir        = ""
+	DefaultMDNSInterval           = 10 * time.Second
 )
 
 // DefaultListen default listen
@@ -61,6 +62,10 @@ type Config struct {
 	StreamStoreExtendSize int
 	NetworkID             uint32
 	RoutingTableDir       string
+	EnableMDNS            bool
+	MDNSInterval          time.Duration
+	Mode                  string
+	PrivatePeers          []multiaddr.Multiaddr
 }
 
 // Neblet interface breaks cycle import dependency.
@@ -85,6 +90,16 @@ func NewP2PConfig(n Neblet) *Config {
 		config.NetworkID = networkID
 	}
 	config.RoutingTableDir = n.Config().Chain.Datadir
+	config.EnableMDNS = network.Mdns
+	config.Mode = network.Mode
+
+	for _, v := range network.PrivatePeers {
+		addr, err := multiaddr.NewMultiaddr(v)
+		if err != nil {
+			panic("Failed to parse private peer")
+		}
+		config.PrivatePeers = append(config.PrivatePeers, addr)
+	}
 
 	seeds := network.Seed
 	if len(seeds) > 0 {
@@ -132,5 +147,9 @@ func NewConfig() *Config {
 		DefaultStreamStoreExtendSize,
 		DefaultNetworkID,
 		DefaultRoutingTableDir,
+		false,
+		DefaultMDNSInterval,
+		ModeFull,
+		[]multiaddr.Multiaddr{},
 	}
 }
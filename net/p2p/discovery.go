@@ -48,6 +48,7 @@ func (node *Node) discovery(ctx context.Context) {
 	//FIXME  the sync routing table rate can be dynamic
 	interval := 30 * time.Second
 	ticker := time.NewTicker(interval)
+	pexTicker := time.NewTicker(PexInterval)
 
 	node.sayHelloToSeeds()
 	node.loadRoutingTableFromDisk()
@@ -57,6 +58,8 @@ func (node *Node) discovery(ctx context.Context) {
 		select {
 		case <-ticker.C:
 			node.syncRoutingTable()
+		case <-pexTicker.C:
+			node.exchangePeers()
 		case <-node.netService.quitCh:
 			logging.VLog().Info("discovery service halting")
 			return
@@ -80,6 +83,9 @@ func (node *Node) saveRoutingTableToDisk() {
 	allnode := node.routeTable.ListPeers()
 	var nodes []string
 	for _, v := range allnode {
+		if node.IsPrivatePeer(v.Pretty()) {
+			continue
+		}
 		if len(node.PeerStore().Addrs(v)) > 0 {
 			addr := node.PeerStore().Addrs(v)[0]
 			tmp := fmt.Sprintf("%s/ipfs/%s", addr, v.Pretty())
@@ -0,0 +1,43 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/crash"
+	"github.com/sirupsen/logrus"
+)
+
+// HandleMessage runs the handler of a message received from a peer. A panic of
+// the handler is recovered into a crash report and the sender is closed, so a
+// malformed message can't take down the node.
+func HandleMessage(nm Manager, where string, msg net.Message, handle func(net.Message)) {
+	var err error
+	defer func() {
+		if err != nil && nm != nil {
+			nm.ClosePeer(msg.MessageFrom(), err)
+		}
+	}()
+	defer crash.Recover(where, logrus.Fields{
+		"msgType": msg.MessageType(),
+		"from":    msg.MessageFrom(),
+	}, &err)
+
+	handle(msg)
+}
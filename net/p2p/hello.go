@@ -19,6 +19,8 @@
 package p2p
 
 import (
+	"fmt"
+
 	"github.com/gogo/protobuf/proto"
 	libnet "github.com/libp2p/go-libp2p-net"
 	peer "github.com/libp2p/go-libp2p-peer"
@@ -26,8 +28,10 @@ import (
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/nebulasio/go-nebulas/net/messages"
 	netpb "github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/nebulasio/go-nebulas/util/audit"
 	byteutils "github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/version"
 	"github.com/sirupsen/logrus"
 )
 
@@ -80,6 +84,9 @@ func (node *Node) sayHelloToSeed(seed ma.Multiaddr) error {
 
 // say hello to a peer
 func (node *Node) hello(pid peer.ID) error {
+	if !node.allowPeer(pid) {
+		return ErrPeerNotPrivate
+	}
 
 	stream, err := node.host.NewStream(
 		node.context,
@@ -87,10 +94,13 @@ func (node *Node) hello(pid peer.ID) error {
 		ProtocolID,
 	)
 	if err != nil {
+		// the secure handshake is part of opening the first stream.
+		handshakeFailed.Mark(1)
 		return err
 	}
 
 	message := messages.NewHelloMessage(node.id.String(), ClientVersion)
+	message.Build = version.String()
 	pb, _ := message.ToProto()
 	data, err := proto.Marshal(pb)
 	if err != nil {
@@ -129,6 +139,7 @@ func (node *Node) handleOkMsg(data []byte, pid peer.ID, s libnet.Stream, addrs m
 	}
 
 	if ok.NodeID == pid.String() && ok.ClientVersion == ClientVersion {
+		node.builds.Store(pid, ok.Build)
 		streamStore := NewStreamStore(key, SOK, s)
 		node.stream.Store(key, streamStore)
 		node.streamCache.Insert(streamStore)
@@ -176,11 +187,13 @@ func (node *Node) handleHelloMsg(data []byte, pid peer.ID, s libnet.Stream, addr
 		"pid":           pid,
 		"addrs":         addrs.String(),
 		"ClientVersion": hello.ClientVersion,
+		"build":         hello.Build,
 	}).Info("receive hello message.")
 
 	//Todo: clientVersion backwards compatible
 	if hello.NodeID == pid.String() && hello.ClientVersion == ClientVersion {
 		ok := messages.NewHelloMessage(node.id.String(), ClientVersion)
+		ok.Build = version.String()
 		pbok, err := ok.ToProto()
 		okdata, err := proto.Marshal(pbok)
 		if err != nil {
@@ -211,6 +224,7 @@ func (node *Node) handleHelloMsg(data []byte, pid peer.ID, s libnet.Stream, addr
 			return result
 		}
 
+		node.builds.Store(pid, hello.Build)
 		streamStore := NewStreamStore(key, SOK, s)
 		node.stream.Store(key, streamStore)
 		node.streamCache.Insert(streamStore)
@@ -228,7 +242,9 @@ func (node *Node) Bye(pid peer.ID, addrs []ma.Multiaddr, s libnet.Stream, key st
 		"pid":  pid.Pretty(),
 		"addr": addrs,
 	}).Info("Say bye to a node")
+	audit.Record(audit.ActionPeerBan, audit.OriginNode, pid.Pretty(), fmt.Sprintf("%v", addrs), nil)
 	node.clearPeerStore(pid, addrs)
 	node.stream.Delete(key)
+	node.builds.Delete(pid)
 	s.Close()
 }
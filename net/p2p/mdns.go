@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"context"
+
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/libp2p/go-libp2p/p2p/discovery"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MDNSServiceTag is the service the nodes announce themselves with on the
+// local network.
+const MDNSServiceTag = "_nebulas-discovery._udp"
+
+type mdnsDiscovery struct {
+	service discovery.Service
+	cancel  context.CancelFunc
+}
+
+// startMDNS announces the node on the local network and says hello to the
+// nodes found there.
+func (node *Node) startMDNS() error {
+	ctx, cancel := context.WithCancel(node.context)
+	service, err := discovery.NewMdnsService(ctx, node.host, node.config.MDNSInterval, MDNSServiceTag)
+	if err != nil {
+		cancel()
+		return err
+	}
+	service.RegisterNotifee((*mdnsNotifee)(node))
+	node.mdns = &mdnsDiscovery{service, cancel}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"interval": node.config.MDNSInterval,
+	}).Info("Started mDNS discovery.")
+	return nil
+}
+
+func (node *Node) stopMDNS() {
+	if node.mdns == nil {
+		return
+	}
+	node.mdns.cancel()
+	node.mdns.service.Close()
+	node.mdns = nil
+}
+
+// mdnsNotifee handles the nodes found by mDNS.
+type mdnsNotifee Node
+
+func (n *mdnsNotifee) HandlePeerFound(pi peerstore.PeerInfo) {
+	node := (*Node)(n)
+	if pi.ID == node.id {
+		return
+	}
+	if _, ok := node.stream.Load(pi.ID.Pretty()); ok {
+		return
+	}
+
+	node.peerstore.AddAddrs(pi.ID, pi.Addrs, peerstore.ProviderAddrTTL)
+	// the peer joins the routing table once it answers the hello, which
+	// also checks its network id.
+	if err := node.hello(pi.ID); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   pi.ID.Pretty(),
+			"addrs": pi.Addrs,
+			"err":   err,
+		}).Debug("Failed to say hello to mDNS peer.")
+		return
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"pid":   pi.ID.Pretty(),
+		"addrs": pi.Addrs,
+	}).Info("Found peer by mDNS.")
+}
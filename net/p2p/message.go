@@ -22,6 +22,7 @@ import (
 	"bytes"
 	"errors"
 	"hash/crc32"
+	"sync"
 
 	byteutils "github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
@@ -55,7 +56,7 @@ NebMessage defines protocol in Nebulas, we define our own wire protocol, as the
 +---------------------------------------------------------------+
 |                         Chain ID                              |
 +-----------------------------------------------+---------------+
-|                         Reserved              |   Version     |
+|                         Sequence              |   Version     |
 +-----------------------------------------------+---------------+
 |                                                               |
 +                                                               +
@@ -106,9 +107,86 @@ func buildHeader(chainID uint32, msgName string, version byte, dataLength uint32
 	return metaHeader
 }
 
+// Message sequence numbers, carried in the reserved field of the header. The
+// messages sent to a peer after the handshake are numbered from 1, wrapping
+// around and skipping 0, so a replayed message is discarded by the receiver.
+// The sequence is bound to the peer rather than to the stream, a message
+// replayed on a new stream is discarded as well, and restarts once all the
+// connections to the peer are closed. The handshake messages and those of
+// former versions have no sequence.
+const (
+	seqBits = 24
+	seqMask = 1<<seqBits - 1
+)
+
+// nextSeq returns the sequence number following seq.
+func nextSeq(seq uint32) uint32 {
+	seq = (seq + 1) & seqMask
+	if seq == 0 {
+		seq = 1
+	}
+	return seq
+}
+
+// isSeqNewer returns whether seq follows last in the serial number arithmetic
+// of the sequence space.
+func isSeqNewer(seq, last uint32) bool {
+	return seq != last && (seq-last)&seqMask < 1<<(seqBits-1)
+}
+
+// peerSeq is the sequence of the messages exchanged with a peer.
+type peerSeq struct {
+	mu       sync.Mutex
+	sent     uint32
+	received uint32
+}
+
+// peerSeq returns the sequence of the peer, created by its first message.
+func (node *Node) peerSeq(key string) *peerSeq {
+	v, _ := node.seqs.LoadOrStore(key, &peerSeq{})
+	return v.(*peerSeq)
+}
+
+// next returns the number of the next message sent to the peer, a number is
+// never reused, the message failing to be written may be partly received.
+func (ps *peerSeq) next() uint32 {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.sent = nextSeq(ps.sent)
+	return ps.sent
+}
+
+// receive records the number of a message received from the peer, it returns
+// false with the last number if the message is replayed, or unsequenced once
+// the peer numbers its messages but for the handshake.
+func (ps *peerSeq) receive(seq uint32, handshake bool) (uint32, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if seq == 0 {
+		return ps.received, ps.received == 0 || handshake
+	}
+	if ps.received > 0 && !isSeqNewer(seq, ps.received) {
+		return ps.received, false
+	}
+	ps.received = seq
+	return seq, true
+}
+
+func seqToReserved(seq uint32) []byte {
+	return []byte{byte(seq >> 16), byte(seq >> 8), byte(seq)}
+}
+
+func seqFromReserved(reserved []byte) uint32 {
+	return uint32(reserved[0])<<16 | uint32(reserved[1])<<8 | uint32(reserved[2])
+}
+
 func (node *Node) buildData(data []byte, msgName string) []byte {
+	return node.buildSequencedData(data, msgName, 0)
+}
+
+func (node *Node) buildSequencedData(data []byte, msgName string, seq uint32) []byte {
 	dataChecksum := crc32.ChecksumIEEE(data)
-	reserved := []byte{0}
+	reserved := seqToReserved(seq)
 	metaHeader := buildHeader(node.config.ChainID, msgName, node.version, uint32(len(data)), dataChecksum, reserved)
 	headerChecksum := crc32.ChecksumIEEE(metaHeader)
 	metaHeader = append(metaHeader[:], byteutils.FromUint32(headerChecksum)...)
@@ -40,8 +40,9 @@ const (
 )
 
 var (
-	packetsIn  = metrics.GetOrRegisterMeter("neb.net.packets.in", nil)
-	netBytesIn = metrics.GetOrRegisterMeter("neb.net.bytes.in", nil)
+	packetsIn         = metrics.GetOrRegisterMeter("neb.net.packets.in", nil)
+	netBytesIn        = metrics.GetOrRegisterMeter("neb.net.bytes.in", nil)
+	replayedPacketsIn = metrics.GetOrRegisterMeter("neb.net.packets.replayed", nil)
 )
 
 // MagicNumber the protocol magic number, A constant numerical or text value used to identify protocol.
@@ -57,6 +58,25 @@ func (node *Node) messageHandler(s libnet.Stream) {
 	pid := s.Conn().RemotePeer()
 	addrs := s.Conn().RemoteMultiaddr()
 	key := pid.Pretty()
+	seqs := node.peerSeq(key)
+
+	if err := verifySecureConn(s.Conn()); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   key,
+			"addrs": addrs,
+			"err":   err,
+		}).Warn("Reject stream of insecure connection.")
+		s.Close()
+		return
+	}
+	if !node.allowPeer(pid) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   key,
+			"addrs": addrs,
+		}).Warn("Reject stream of peer not private.")
+		s.Close()
+		return
+	}
 
 	for {
 		select {
@@ -117,6 +137,20 @@ func (node *Node) messageHandler(s libnet.Stream) {
 			packetsIn.Mark(1)
 			netBytesIn.Mark(int64(byteutils.Uint32(msg.dataLength) + uint32(offsetData)))
 
+			// discard the replayed messages, and the unsequenced ones once the
+			// peer numbers its messages, but the handshake.
+			seq := seqFromReserved(msg.reserved)
+			if last, ok := seqs.receive(seq, isHandshakeMsg(msg.msgName)); !ok {
+				replayedPacketsIn.Mark(1)
+				logging.VLog().WithFields(logrus.Fields{
+					"msgName": msg.msgName,
+					"pid":     pid.Pretty(),
+					"seq":     seq,
+					"last":    last,
+				}).Warn("Discard a replayed message.")
+				continue
+			}
+
 			switch msg.msgName {
 			case HELLO:
 				node.handleHelloMsg(msg.data, pid, s, addrs, key)
@@ -134,6 +168,8 @@ func (node *Node) messageHandler(s libnet.Stream) {
 				node.handleNetworkIDMsg(msg.data, pid, s)
 			case NetworkIDReply:
 				node.handleReNetworkIDMsg(msg.data, pid)
+			case PeerExchange:
+				node.handlePeerExchangeMsg(msg.data, pid, s, addrs, key)
 			default:
 				var relayness []peer.ID
 				logging.VLog().WithFields(logrus.Fields{
@@ -169,3 +205,13 @@ func (node *Node) messageHandler(s libnet.Stream) {
 	}
 
 }
+
+// isHandshakeMsg returns whether the message is sent before the stream is
+// stored, i.e. without sequence number.
+func isHandshakeMsg(msgName string) bool {
+	switch msgName {
+	case HELLO, OK, BYE, NetworkID, NetworkIDReply:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerSeq(t *testing.T) {
+	node := &Node{seqs: new(sync.Map)}
+	seqs := node.peerSeq("peer")
+	assert.Equal(t, seqs, node.peerSeq("peer"))
+
+	// the handshake is unsequenced, the messages after are numbered.
+	_, ok := seqs.receive(0, true)
+	assert.True(t, ok)
+	_, ok = seqs.receive(1, false)
+	assert.True(t, ok)
+	_, ok = seqs.receive(2, false)
+	assert.True(t, ok)
+
+	// a message replayed on a new stream of the peer is discarded too.
+	last, ok := node.peerSeq("peer").receive(1, false)
+	assert.False(t, ok)
+	assert.Equal(t, uint32(2), last)
+	_, ok = seqs.receive(0, false)
+	assert.False(t, ok)
+	_, ok = seqs.receive(0, true)
+	assert.True(t, ok)
+
+	// the sequence restarts once the peer is disconnected.
+	node.seqs.Delete("peer")
+	_, ok = node.peerSeq("peer").receive(1, false)
+	assert.True(t, ok)
+
+	assert.Equal(t, uint32(1), seqs.next())
+	assert.Equal(t, uint32(2), seqs.next())
+	seqs.sent = seqMask
+	assert.Equal(t, uint32(1), seqs.next())
+}
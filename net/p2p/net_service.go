@@ -65,6 +65,7 @@ func (ns *NetService) Start() error {
 
 // Stop stop p2p manager.
 func (ns *NetService) Stop() {
+	ns.node.stopMDNS()
 	ns.dispatcher.Stop()
 	ns.quitCh <- true
 }
@@ -108,3 +109,8 @@ func (ns *NetService) BuildData(data []byte, msgName string) []byte {
 func (ns *NetService) SendMsg(msgName string, msg []byte, target string) error {
 	return ns.node.sendMsg(msgName, msg, target)
 }
+
+// ClosePeer close the connection with a misbehaving peer.
+func (ns *NetService) ClosePeer(target string, reason error) {
+	ns.node.closePeer(target, reason)
+}
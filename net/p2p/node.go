@@ -74,6 +74,21 @@ type Node struct {
 	bootIds        []string
 	networkIDCache *lru.Cache
 	network        *swarm.Network
+	// key: peer.ID value: build of the peer
+	builds *sync.Map
+	// key: pretty peer.ID value: *peerSeq
+	seqs *sync.Map
+	mdns *mdnsDiscovery
+	// key: peer.ID value: *pexEntry
+	pexRecords *lru.Cache
+	// key: peer.ID value: time.Time of the last peer exchange received
+	pexReceived *lru.Cache
+	// pexSenders counts the records in pexRecords by the peer they are
+	// received from, pexMu guards both.
+	pexMu      sync.Mutex
+	pexSenders map[peer.ID]int
+	// key: pretty peer.ID value: address to dial
+	privatePeers map[string]multiaddr.Multiaddr
 }
 
 // NewNode start a local node and join the node to network
@@ -106,6 +121,12 @@ func (node *Node) init() error {
 	if err := node.generatePeerStore(); err != nil {
 		return err
 	}
+	if err := node.requireSecureTransport(); err != nil {
+		return err
+	}
+	if err := node.initPrivatePeers(); err != nil {
+		return err
+	}
 
 	//TODO change name Latency
 	node.routeTable = kbucket.NewRoutingTable(
@@ -118,12 +139,17 @@ func (node *Node) init() error {
 	node.routeTable.Update(node.id)
 
 	node.stream = new(sync.Map)
+	node.builds = new(sync.Map)
+	node.seqs = new(sync.Map)
 	node.streamCache = pdeque.NewPriorityDeque(streamEliminationAlgorithm)
 	node.version = node.config.Version
 	node.synchronizing = false
 
 	node.relayness, _ = lru.New(node.config.RelayCacheSize)
 	node.networkIDCache, _ = lru.New(node.config.StreamStoreSize)
+	node.pexRecords, _ = lru.NewWithEvict(PexCacheSize, node.onPexRecordEvicted)
+	node.pexReceived, _ = lru.New(PexCacheSize)
+	node.pexSenders = make(map[peer.ID]int)
 
 	var multiaddrs []multiaddr.Multiaddr
 	for _, v := range node.config.Listen {
@@ -153,7 +179,11 @@ func (node *Node) init() error {
 		node.peerstore,
 		nil,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	node.network.Notify((*secureNotifiee)(node))
+	return nil
 }
 
 // Start host & route table discovery
@@ -164,8 +194,29 @@ func (node *Node) Start() error {
 		}).Error("Failed to start Host")
 		return err
 	}
-	go node.discovery(node.context)
 	go node.manageStreamStore()
+	if len(node.privatePeers) > 0 {
+		go node.keepPrivatePeers()
+	}
+	// a validator is known to its sentries only, it neither discovers the
+	// network nor is discovered.
+	if node.isValidator() {
+		logging.CLog().WithFields(logrus.Fields{
+			"peers": len(node.privatePeers),
+		}).Info("Started as validator behind private peers")
+		return nil
+	}
+	go node.discovery(node.context)
+
+	if node.config.EnableMDNS {
+		// mDNS is a convenience for local networks, the node still works
+		// with its seeds without it.
+		if err := node.startMDNS(); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Warn("Failed to start mDNS discovery")
+		}
+	}
 
 	return nil
 }
@@ -224,6 +275,15 @@ func (node *Node) GetStream() *sync.Map {
 	return node.stream
 }
 
+// PeerBuild returns the version of the binary of the peer sent in the
+// handshake, empty if unknown.
+func (node *Node) PeerBuild(pid peer.ID) string {
+	if v, ok := node.builds.Load(pid); ok {
+		return v.(string)
+	}
+	return ""
+}
+
 func (node *Node) checkPort() error {
 	for _, v := range node.config.Listen {
 		conn, err := net.Dial("tcp", v)
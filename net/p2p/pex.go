@@ -0,0 +1,375 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	libnet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	netpb "github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// const message name
+const (
+	PeerExchange = "pex"
+)
+
+// Peer exchange parameters.
+const (
+	// PexInterval is the interval the node gossips the records it knows.
+	PexInterval = 60 * time.Second
+	// PexRecordTTL is the time a record is valid for after it is signed.
+	PexRecordTTL = 30 * time.Minute
+	// PexMaxRecords is the max records in a message.
+	PexMaxRecords = 32
+	// PexMaxAddrs is the max addresses in a record.
+	PexMaxAddrs = 8
+	// PexMaxDials is the max new peers the node says hello to for a message.
+	PexMaxDials = 4
+	// PexFanout is the number of peers the records are sent to.
+	PexFanout = 8
+	// PexCacheSize is the number of records the node keeps for gossip.
+	PexCacheSize = 1024
+	// PexMaxRecordsPerPeer is the max records received from a peer the node
+	// keeps for gossip.
+	PexMaxRecordsPerPeer = 64
+	// PexMinInterval is the min time between two messages of a peer, the
+	// messages sent sooner are dropped.
+	PexMinInterval = PexInterval / 2
+
+	// pexClockSkew is the tolerated advance of the timestamp of a record
+	// over the local clock.
+	pexClockSkew = time.Minute
+)
+
+// Error types of peer exchange
+var (
+	ErrTooManyPeerRecords    = errors.New("too many records in peer exchange message")
+	ErrInvalidPeerRecord     = errors.New("invalid peer record")
+	ErrPeerRecordExpired     = errors.New("peer record is expired")
+	ErrInvalidPeerRecordSign = errors.New("invalid peer record signature")
+	ErrNoPublicPeerAddr      = errors.New("no public address in peer record")
+	ErrPeerExchangeTooOften  = errors.New("peer exchange sent too often")
+)
+
+var (
+	pexRecordsIn       = metrics.GetOrRegisterMeter("neb.net.pex.records.in", nil)
+	pexRecordsRejected = metrics.GetOrRegisterMeter("neb.net.pex.records.rejected", nil)
+	pexDials           = metrics.GetOrRegisterMeter("neb.net.pex.dials", nil)
+)
+
+// pexEntry is a record kept for gossip, with the peer it is received from.
+type pexEntry struct {
+	record *netpb.PeerRecord
+	from   peer.ID
+}
+
+// peerRecordHash returns the signed bytes of the record.
+func peerRecordHash(record *netpb.PeerRecord) ([]byte, error) {
+	unsigned := *record
+	unsigned.Signature = nil
+	return proto.Marshal(&unsigned)
+}
+
+// signPeerRecord returns the record of the listen addresses of the node,
+// signed with its node key.
+func (node *Node) signPeerRecord(now time.Time) (*netpb.PeerRecord, error) {
+	priv := node.peerstore.PrivKey(node.id)
+	if priv == nil {
+		return nil, ErrMissingNodeKey
+	}
+	pub, err := priv.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	record := &netpb.PeerRecord{
+		Id:        node.id.Pretty(),
+		Timestamp: now.Unix(),
+		Ttl:       uint32(PexRecordTTL / time.Second),
+		PubKey:    pub,
+	}
+	for _, addr := range node.host.Addrs() {
+		if len(record.Addrs) == PexMaxAddrs {
+			break
+		}
+		record.Addrs = append(record.Addrs, addr.String())
+	}
+
+	data, err := peerRecordHash(record)
+	if err != nil {
+		return nil, err
+	}
+	if record.Signature, err = priv.Sign(data); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// verifyPeerRecord checks the record is fresh and signed by the key of the
+// node it is about, and returns its addresses.
+func verifyPeerRecord(record *netpb.PeerRecord, now time.Time) (peer.ID, []ma.Multiaddr, error) {
+	id, err := peer.IDB58Decode(record.Id)
+	if err != nil {
+		return "", nil, ErrInvalidPeerRecord
+	}
+	if len(record.Addrs) == 0 || len(record.Addrs) > PexMaxAddrs {
+		return "", nil, ErrInvalidPeerRecord
+	}
+	if record.Ttl == 0 || time.Duration(record.Ttl)*time.Second > PexRecordTTL {
+		return "", nil, ErrInvalidPeerRecord
+	}
+
+	signedAt := time.Unix(record.Timestamp, 0)
+	if signedAt.After(now.Add(pexClockSkew)) {
+		return "", nil, ErrInvalidPeerRecord
+	}
+	if !signedAt.Add(time.Duration(record.Ttl) * time.Second).After(now) {
+		return "", nil, ErrPeerRecordExpired
+	}
+
+	pub, err := crypto.UnmarshalPublicKey(record.PubKey)
+	if err != nil || !id.MatchesPublicKey(pub) {
+		return "", nil, ErrInvalidPeerRecordSign
+	}
+	data, err := peerRecordHash(record)
+	if err != nil {
+		return "", nil, ErrInvalidPeerRecord
+	}
+	if ok, err := pub.Verify(data, record.Signature); err != nil || !ok {
+		return "", nil, ErrInvalidPeerRecordSign
+	}
+
+	var addrs []ma.Multiaddr
+	for _, v := range record.Addrs {
+		addr, err := ma.NewMultiaddr(v)
+		if err != nil {
+			return "", nil, ErrInvalidPeerRecord
+		}
+		if isPublicAddr(addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		return "", nil, ErrNoPublicPeerAddr
+	}
+	return id, addrs, nil
+}
+
+// isPublicAddr returns false if the address is of a private, loopback,
+// link-local or unspecified ip, a record must not make the node dial into
+// the network of its peers. The addresses by name are public.
+func isPublicAddr(addr ma.Multiaddr) bool {
+	v, err := addr.ValueForProtocol(ma.P_IP4)
+	if err != nil {
+		if v, err = addr.ValueForProtocol(ma.P_IP6); err != nil {
+			return true
+		}
+	}
+	ip := net.ParseIP(v)
+	if ip == nil {
+		return false
+	}
+	return !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsMulticast()
+}
+
+// addPexRecord keeps the record for gossip, unless the peer it is received
+// from has PexMaxRecordsPerPeer records kept already.
+func (node *Node) addPexRecord(id peer.ID, record *netpb.PeerRecord, from peer.ID) bool {
+	node.pexMu.Lock()
+	defer node.pexMu.Unlock()
+
+	if node.pexSenders[from] >= PexMaxRecordsPerPeer {
+		return false
+	}
+	// the replaced record is not evicted, its sender is uncounted here.
+	if v, ok := node.pexRecords.Peek(id); ok {
+		node.uncountPexSender(v.(*pexEntry).from)
+	}
+	node.pexSenders[from]++
+	node.pexRecords.Add(id, &pexEntry{record: record, from: from})
+	return true
+}
+
+// onPexRecordEvicted is called by pexRecords.Add, under pexMu.
+func (node *Node) onPexRecordEvicted(key interface{}, value interface{}) {
+	node.uncountPexSender(value.(*pexEntry).from)
+}
+
+func (node *Node) uncountPexSender(from peer.ID) {
+	if node.pexSenders[from]--; node.pexSenders[from] <= 0 {
+		delete(node.pexSenders, from)
+	}
+}
+
+// exchangePeers sends the record of the node and the ones it knows to some
+// of its peers.
+func (node *Node) exchangePeers() {
+	now := time.Now()
+	own, err := node.signPeerRecord(now)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to sign peer record")
+		return
+	}
+
+	msg := &netpb.PeerExchange{Records: []*netpb.PeerRecord{own}}
+	for _, k := range node.pexRecords.Keys() {
+		if len(msg.Records) == PexMaxRecords {
+			break
+		}
+		v, ok := node.pexRecords.Peek(k)
+		if !ok {
+			continue
+		}
+		record := v.(*pexEntry).record
+		if time.Unix(record.Timestamp+int64(record.Ttl), 0).After(now) {
+			msg.Records = append(msg.Records, record)
+		}
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to marshal peer exchange")
+		return
+	}
+
+	sent := 0
+	node.stream.Range(func(key, value interface{}) bool {
+		if value.(*StreamStore).conn != SOK {
+			return true
+		}
+		if err := node.sendMsg(PeerExchange, data, key.(string)); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"key": key,
+				"err": err,
+			}).Debug("Failed to send peer exchange")
+			return true
+		}
+		sent++
+		return sent < PexFanout
+	})
+}
+
+func (node *Node) handlePeerExchangeMsg(data []byte, pid peer.ID, s libnet.Stream, addrs ma.Multiaddr, key string) bool {
+	result := false
+	defer func() {
+		if !result {
+			node.Bye(pid, []ma.Multiaddr{addrs}, s, key)
+		}
+	}()
+
+	streamStore, ok := node.stream.Load(key)
+	if !ok || streamStore.(*StreamStore).conn != SOK {
+		logging.VLog().Error("peer not shake hand before send message.")
+		return result
+	}
+
+	// the messages sent too often are dropped, the peer is kept.
+	now := time.Now()
+	if v, ok := node.pexReceived.Get(pid); ok && now.Sub(v.(time.Time)) < PexMinInterval {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid": pid.Pretty(),
+			"err": ErrPeerExchangeTooOften,
+		}).Debug("Drop peer exchange")
+		result = true
+		return result
+	}
+	node.pexReceived.Add(pid, now)
+
+	msg := new(netpb.PeerExchange)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to unmarshal peer exchange")
+		return result
+	}
+	if len(msg.Records) > PexMaxRecords {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   pid.Pretty(),
+			"count": len(msg.Records),
+			"err":   ErrTooManyPeerRecords,
+		}).Warn("Failed to handle peer exchange")
+		return result
+	}
+
+	dials := 0
+	for _, record := range msg.Records {
+		pexRecordsIn.Mark(1)
+		id, recordAddrs, err := verifyPeerRecord(record, now)
+		if err != nil {
+			pexRecordsRejected.Mark(1)
+			logging.VLog().WithFields(logrus.Fields{
+				"pid":    pid.Pretty(),
+				"record": record.Id,
+				"err":    err,
+			}).Debug("Reject peer record.")
+			continue
+		}
+		if id == node.id {
+			continue
+		}
+		// keep the newest record of a node for the gossip.
+		if v, ok := node.pexRecords.Peek(id); ok && v.(*pexEntry).record.Timestamp >= record.Timestamp {
+			continue
+		}
+		if !node.addPexRecord(id, record, pid) {
+			pexRecordsRejected.Mark(1)
+			continue
+		}
+
+		expire := time.Unix(record.Timestamp+int64(record.Ttl), 0).Sub(now)
+		node.peerstore.AddAddrs(id, recordAddrs, expire)
+
+		// dial a few unknown nodes only, the others are kept for the next
+		// messages and the routing table sync.
+		if dials == PexMaxDials || node.routeTable.Find(id) != "" {
+			continue
+		}
+		if _, ok := node.stream.Load(id.Pretty()); ok {
+			continue
+		}
+		dials++
+		pexDials.Mark(1)
+		go func(id peer.ID) {
+			if err := node.hello(id); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"id":  id.Pretty(),
+					"err": err,
+				}).Debug("Failed to say hello to exchanged peer")
+			}
+		}(id)
+	}
+
+	result = true
+	return result
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"fmt"
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	netpb "github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"/ip4/8.8.8.8/tcp/8680", true},
+		{"/ip4/10.0.0.1/tcp/8680", false},
+		{"/ip4/192.168.1.2/tcp/8680", false},
+		{"/ip4/127.0.0.1/tcp/8680", false},
+		{"/ip4/0.0.0.0/tcp/8680", false},
+		{"/ip4/169.254.1.1/tcp/8680", false},
+		{"/ip6/2001:4860:4860::8888/tcp/8680", true},
+		{"/ip6/::1/tcp/8680", false},
+		{"/ip6/fd00::1/tcp/8680", false},
+	}
+	for _, tt := range tests {
+		addr, err := ma.NewMultiaddr(tt.addr)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.public, isPublicAddr(addr), tt.addr)
+	}
+}
+
+func TestAddPexRecord(t *testing.T) {
+	node := &Node{pexSenders: make(map[peer.ID]int)}
+	node.pexRecords, _ = lru.NewWithEvict(PexMaxRecordsPerPeer+1, node.onPexRecordEvicted)
+
+	from, other := peer.ID("from"), peer.ID("other")
+	for i := 0; i < PexMaxRecordsPerPeer; i++ {
+		assert.True(t, node.addPexRecord(peer.ID(fmt.Sprintf("id%d", i)), &netpb.PeerRecord{}, from))
+	}
+	assert.False(t, node.addPexRecord("more", &netpb.PeerRecord{}, from))
+
+	// the records of a sender are uncounted once replaced or evicted.
+	assert.True(t, node.addPexRecord("id0", &netpb.PeerRecord{}, other))
+	assert.Equal(t, PexMaxRecordsPerPeer-1, node.pexSenders[from])
+	assert.True(t, node.addPexRecord("more", &netpb.PeerRecord{}, other))
+	assert.True(t, node.addPexRecord("evicting", &netpb.PeerRecord{}, other))
+	assert.Equal(t, PexMaxRecordsPerPeer-2, node.pexSenders[from])
+	assert.Equal(t, 3, node.pexSenders[other])
+}
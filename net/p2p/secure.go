@@ -0,0 +1,97 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+
+	iconn "github.com/libp2p/go-libp2p-interface-conn"
+	libnet "github.com/libp2p/go-libp2p-net"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// Error types of the secure transport
+var (
+	ErrMissingNodeKey     = errors.New("node key is required by the secure transport")
+	ErrInsecureConnection = errors.New("connection is not encrypted")
+	ErrPeerKeyMismatch    = errors.New("peer id does not match the key of the handshake")
+)
+
+var (
+	handshakeFailed    = metrics.GetOrRegisterMeter("neb.net.handshake.failed", nil)
+	handshakeDowngrade = metrics.GetOrRegisterMeter("neb.net.handshake.downgrade", nil)
+	handshakeMismatch  = metrics.GetOrRegisterMeter("neb.net.handshake.mismatch", nil)
+)
+
+// requireSecureTransport makes every connection of the swarm go through
+// the secio handshake, which authenticates the peer by its node key and
+// encrypts the transport. Listeners only negotiate secio once it is set,
+// so plaintext peers are refused during the multistream negotiation.
+func (node *Node) requireSecureTransport() error {
+	if node.peerstore.PrivKey(node.id) == nil {
+		return ErrMissingNodeKey
+	}
+	iconn.EncryptConnections = true
+	return nil
+}
+
+// verifySecureConn checks the connection was secured by a handshake with
+// the key the remote peer id is derived from.
+func verifySecureConn(c libnet.Conn) error {
+	pub := c.RemotePublicKey()
+	if pub == nil {
+		handshakeDowngrade.Mark(1)
+		return ErrInsecureConnection
+	}
+	if !c.RemotePeer().MatchesPublicKey(pub) {
+		handshakeMismatch.Mark(1)
+		return ErrPeerKeyMismatch
+	}
+	return nil
+}
+
+// secureNotifiee closes the connections which are not secured, whatever
+// side opened them, and restarts the message sequence of a peer once all its
+// connections are closed.
+type secureNotifiee Node
+
+func (n *secureNotifiee) Connected(_ libnet.Network, c libnet.Conn) {
+	if err := verifySecureConn(c); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":  c.RemotePeer().Pretty(),
+			"addr": c.RemoteMultiaddr(),
+			"err":  err,
+		}).Warn("Reject insecure connection.")
+		c.Close()
+	}
+}
+
+func (n *secureNotifiee) Listen(libnet.Network, ma.Multiaddr)        {}
+func (n *secureNotifiee) ListenClose(libnet.Network, ma.Multiaddr)   {}
+func (n *secureNotifiee) OpenedStream(libnet.Network, libnet.Stream) {}
+func (n *secureNotifiee) ClosedStream(libnet.Network, libnet.Stream) {}
+
+func (n *secureNotifiee) Disconnected(net libnet.Network, c libnet.Conn) {
+	if len(net.ConnsToPeer(c.RemotePeer())) == 0 {
+		n.seqs.Delete(c.RemotePeer().Pretty())
+	}
+}
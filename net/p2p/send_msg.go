@@ -41,9 +41,10 @@ var (
 
 // SendMsg send message to a peer
 func (node *Node) sendMsgWithStream(msgName string, msg []byte, stream libnet.Stream) error {
+	return node.writeMsg(msgName, node.buildData(msg, msgName), stream)
+}
 
-	totalData := node.buildData(msg, msgName)
-
+func (node *Node) writeMsg(msgName string, totalData []byte, stream libnet.Stream) error {
 	if err := Write(stream, totalData); err != nil {
 		return err
 	}
@@ -69,5 +70,15 @@ func (node *Node) sendMsg(msgName string, msg []byte, target string) error {
 	if !ok {
 		return ErrStreamNotExist
 	}
-	return node.sendMsgWithStream(msgName, msg, streamStore.(*StreamStore).stream)
+	return node.sendSequencedMsg(msgName, msg, streamStore.(*StreamStore))
+}
+
+// sendSequencedMsg numbers the message in the sequence of the peer, the
+// messages are written in the order of their numbers.
+func (node *Node) sendSequencedMsg(msgName string, msg []byte, streamStore *StreamStore) error {
+	streamStore.mu.Lock()
+	defer streamStore.mu.Unlock()
+
+	seq := node.peerSeq(streamStore.key).next()
+	return node.writeMsg(msgName, node.buildSequencedData(msg, msgName, seq), streamStore.stream)
 }
@@ -0,0 +1,119 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Modes of a node in a sentry topology.
+const (
+	// ModeFull is a public node, the default.
+	ModeFull = ""
+	// ModeSentry is a public node guarding validators, its private peers.
+	ModeSentry = "sentry"
+	// ModeValidator is a node connected to its sentries, its private peers, only.
+	ModeValidator = "validator"
+)
+
+// PrivatePeerInterval is the interval the disconnected private peers are dialed again.
+const PrivatePeerInterval = 10 * time.Second
+
+// Error types of sentry topology
+var (
+	ErrUnknownMode    = errors.New("unknown node mode, should be sentry or validator")
+	ErrPeerNotPrivate = errors.New("validator connects to its private peers only")
+)
+
+// initPrivatePeers indexes the private peers of the config by id.
+func (node *Node) initPrivatePeers() error {
+	switch node.config.Mode {
+	case ModeFull, ModeSentry, ModeValidator:
+	default:
+		return ErrUnknownMode
+	}
+	node.privatePeers = make(map[string]ma.Multiaddr)
+	for _, v := range node.config.PrivatePeers {
+		addr, id, err := ParsePeerAddress(v)
+		if err != nil {
+			return err
+		}
+		node.privatePeers[id.Pretty()] = addr
+	}
+	return nil
+}
+
+// IsPrivatePeer returns whether the peer of the id, as the keys of GetStream,
+// is a private peer, which always gets the blocks and the transactions.
+func (node *Node) IsPrivatePeer(key string) bool {
+	_, ok := node.privatePeers[key]
+	return ok
+}
+
+func (node *Node) isValidator() bool {
+	return node.config.Mode == ModeValidator
+}
+
+// allowPeer returns whether the node talks to the peer, a validator only
+// talks to its private peers.
+func (node *Node) allowPeer(pid peer.ID) bool {
+	return !node.isValidator() || node.IsPrivatePeer(pid.Pretty())
+}
+
+// keepPrivatePeers dials the private peers not connected until the node stops.
+func (node *Node) keepPrivatePeers() {
+	ticker := time.NewTicker(PrivatePeerInterval)
+	defer ticker.Stop()
+
+	node.connectPrivatePeers()
+	for {
+		select {
+		case <-ticker.C:
+			node.connectPrivatePeers()
+		case <-node.netService.quitCh:
+			return
+		}
+	}
+}
+
+func (node *Node) connectPrivatePeers() {
+	for key, addr := range node.privatePeers {
+		if _, ok := node.stream.Load(key); ok {
+			continue
+		}
+		id, _ := peer.IDB58Decode(key)
+		node.peerstore.AddAddr(id, addr, peerstore.PermanentAddrTTL)
+		if err := node.hello(id); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"id":   key,
+				"addr": addr,
+				"err":  err,
+			}).Warn("Failed to say hello to private peer")
+			continue
+		}
+		node.routeTable.Update(id)
+	}
+}
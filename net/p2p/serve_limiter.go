@@ -0,0 +1,211 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// ServeClass is the class of a request served to the peers, by the load it
+// puts on the node.
+type ServeClass int
+
+// Serve classes, the small requests are served before the bulk ones.
+const (
+	// ServeSmall is a lookup of a single block.
+	ServeSmall ServeClass = iota
+	// ServeBulk is a batch of block bodies.
+	ServeBulk
+)
+
+// Serve limits.
+const (
+	// MaxServeConcurrency is the max requests of the peers served at once.
+	MaxServeConcurrency = 16
+	// MaxBulkServeConcurrency is the max bulk requests served at once, lower
+	// than MaxServeConcurrency to keep slots for the small requests.
+	MaxBulkServeConcurrency = 4
+	// ServePeerRate is the cost a peer is allowed to spend per second.
+	ServePeerRate = 20
+	// ServePeerBurst is the max cost a peer spends at once.
+	ServePeerBurst = 40
+
+	// maxServePeers is the number of peer quotas over which the idle ones
+	// are forgotten.
+	maxServePeers = 1024
+)
+
+// serveCosts is the quota a request of each class takes from its peer.
+var serveCosts = map[ServeClass]float64{
+	ServeSmall: 1,
+	ServeBulk:  10,
+}
+
+// Error types of the serve limiter
+var (
+	ErrServeQuotaExceeded = errors.New("peer exceeded its serve quota")
+	ErrServeBusy          = errors.New("too many requests being served")
+)
+
+var (
+	serveShedQuota = metrics.GetOrRegisterMeter("neb.net.serve.shed.quota", nil)
+	serveShedBusy  = metrics.GetOrRegisterMeter("neb.net.serve.shed.busy", nil)
+)
+
+// ServeLimiter sheds the requests of the peers over their quota or over the
+// concurrency of the node, so that a syncing peer can't monopolize it.
+type ServeLimiter struct {
+	mu    sync.Mutex
+	peers map[string]*serveQuota
+	rate  float64
+	burst float64
+
+	slots     chan struct{}
+	bulkSlots chan struct{}
+
+	now func() time.Time
+}
+
+type serveQuota struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewServeLimiter returns a limiter serving concurrency requests at once,
+// bulk ones of them, and allowing each peer rate cost per second.
+func NewServeLimiter(concurrency, bulk int, rate, burst float64) *ServeLimiter {
+	if bulk > concurrency {
+		bulk = concurrency
+	}
+	return &ServeLimiter{
+		peers:     make(map[string]*serveQuota),
+		rate:      rate,
+		burst:     burst,
+		slots:     make(chan struct{}, concurrency),
+		bulkSlots: make(chan struct{}, bulk),
+		now:       time.Now,
+	}
+}
+
+// Acquire admits a request of the peer, the returned func must be called
+// once it is served.
+func (l *ServeLimiter) Acquire(peer string, class ServeClass) (func(), error) {
+	bulk := class == ServeBulk
+	if bulk {
+		select {
+		case l.bulkSlots <- struct{}{}:
+		default:
+			serveShedBusy.Mark(1)
+			return nil, ErrServeBusy
+		}
+	}
+	select {
+	case l.slots <- struct{}{}:
+	default:
+		if bulk {
+			<-l.bulkSlots
+		}
+		serveShedBusy.Mark(1)
+		return nil, ErrServeBusy
+	}
+
+	release := func() {
+		<-l.slots
+		if bulk {
+			<-l.bulkSlots
+		}
+	}
+	if !l.take(peer, serveCosts[class]) {
+		release()
+		serveShedQuota.Mark(1)
+		return nil, ErrServeQuotaExceeded
+	}
+	return release, nil
+}
+
+// take spends cost of the quota of the peer.
+func (l *ServeLimiter) take(peer string, cost float64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	q, ok := l.peers[peer]
+	if !ok {
+		if len(l.peers) >= maxServePeers {
+			l.forgetIdle(now)
+		}
+		q = &serveQuota{tokens: l.burst, last: now}
+		l.peers[peer] = q
+	}
+
+	q.tokens += now.Sub(q.last).Seconds() * l.rate
+	if q.tokens > l.burst {
+		q.tokens = l.burst
+	}
+	q.last = now
+
+	if q.tokens < cost {
+		return false
+	}
+	q.tokens -= cost
+	return true
+}
+
+// forgetIdle drops the quotas refilled to the burst, which are the same as
+// new ones.
+func (l *ServeLimiter) forgetIdle(now time.Time) {
+	for peer, q := range l.peers {
+		if q.tokens+now.Sub(q.last).Seconds()*l.rate >= l.burst {
+			delete(l.peers, peer)
+		}
+	}
+}
+
+var defaultServeLimiter = NewServeLimiter(MaxServeConcurrency, MaxBulkServeConcurrency, ServePeerRate, ServePeerBurst)
+
+// AcquireServe admits a request of the peer by the default limiter of the
+// node, the returned func must be called once it is served.
+func AcquireServe(peer string, class ServeClass) (func(), error) {
+	return defaultServeLimiter.Acquire(peer, class)
+}
+
+// ServeMessage serves the request of a peer by handle in a goroutine of its
+// own, unless the default limiter sheds it.
+func ServeMessage(nm Manager, where string, class ServeClass, msg net.Message, handle func(net.Message)) {
+	release, err := AcquireServe(msg.MessageFrom(), class)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"from":    msg.MessageFrom(),
+			"err":     err,
+		}).Debug("Shed a request of a peer.")
+		return
+	}
+	go func() {
+		defer release()
+		HandleMessage(nm, where, msg, handle)
+	}()
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeLimiter_Concurrency(t *testing.T) {
+	l := NewServeLimiter(3, 2, 100, 100)
+
+	var releases []func()
+	for i := 0; i < 2; i++ {
+		release, err := l.Acquire("a", ServeBulk)
+		assert.Nil(t, err)
+		releases = append(releases, release)
+	}
+	// bulk slots are full, the small requests are still served.
+	_, err := l.Acquire("b", ServeBulk)
+	assert.Equal(t, ErrServeBusy, err)
+	release, err := l.Acquire("b", ServeSmall)
+	assert.Nil(t, err)
+	releases = append(releases, release)
+
+	_, err = l.Acquire("c", ServeSmall)
+	assert.Equal(t, ErrServeBusy, err)
+
+	for _, release := range releases {
+		release()
+	}
+	release, err = l.Acquire("c", ServeBulk)
+	assert.Nil(t, err)
+	release()
+}
+
+func TestServeLimiter_PeerQuota(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewServeLimiter(16, 4, 10, 20)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		release, err := l.Acquire("a", ServeBulk)
+		assert.Nil(t, err)
+		release()
+	}
+	_, err := l.Acquire("a", ServeSmall)
+	assert.Equal(t, ErrServeQuotaExceeded, err)
+
+	// the other peers have quotas of their own.
+	release, err := l.Acquire("b", ServeBulk)
+	assert.Nil(t, err)
+	release()
+
+	now = now.Add(time.Second)
+	release, err = l.Acquire("a", ServeBulk)
+	assert.Nil(t, err)
+	release()
+	_, err = l.Acquire("a", ServeBulk)
+	assert.Equal(t, ErrServeQuotaExceeded, err)
+}
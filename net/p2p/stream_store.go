@@ -19,6 +19,7 @@
 package p2p
 
 import (
+	"sync"
 	"time"
 
 	libnet "github.com/libp2p/go-libp2p-net"
@@ -30,6 +31,9 @@ type StreamStore struct {
 	conn      int
 	stream    libnet.Stream
 	timestamp int64
+
+	// mu orders the sequenced messages written on the stream.
+	mu sync.Mutex
 }
 
 // TODO: @leon adjust stream elimination algorithm.
@@ -41,7 +45,7 @@ func streamEliminationAlgorithm(a interface{}, b interface{}) bool {
 
 // NewStreamStore return a new streamStore
 func NewStreamStore(key string, conn int, stream libnet.Stream) *StreamStore {
-	return &StreamStore{key, conn, stream, time.Now().Unix()}
+	return &StreamStore{key: key, conn: conn, stream: stream, timestamp: time.Now().Unix()}
 }
 
 func (node *Node) manageStreamStore() {
@@ -61,7 +65,7 @@ func (node *Node) manageStreamStore() {
 func (node *Node) cleanPeerStore() {
 	for _, v := range node.peerstore.Peers() {
 		if _, ok := node.stream.Load(v.Pretty()); !ok {
-			if !InArray(v.Pretty(), node.bootIds) {
+			if !InArray(v.Pretty(), node.bootIds) && !node.IsPrivatePeer(v.Pretty()) {
 				node.peerstore.ClearAddrs(v)
 			}
 		}
@@ -72,14 +76,24 @@ func (node *Node) clearStreamStore() {
 	// do clear streamStore only when the count of stream in cache exceed the cache size.
 	if node.streamCache.Len() > node.config.StreamStoreSize {
 		overflowSize := node.streamCache.Len() - node.config.StreamStoreSize
-		for i := 0; i < overflowSize; i++ {
+		// the streams of the private peers are never closed.
+		var kept []*StreamStore
+		for i := 0; i < overflowSize && node.streamCache.Len() > 0; {
 			streamStore := node.streamCache.PopMin().(*StreamStore)
 			key := streamStore.key
+			if node.IsPrivatePeer(key) {
+				kept = append(kept, streamStore)
+				continue
+			}
+			i++
 
 			if streamStore, ok := node.stream.Load(key); ok {
 				streamStore.(*StreamStore).stream.Close()
 				node.stream.Delete(key)
 			}
 		}
+		for _, streamStore := range kept {
+			node.streamCache.Insert(streamStore)
+		}
 	}
 }
@@ -71,6 +71,10 @@ func (node *Node) handleSyncRouteMsg(data []byte, pid peer.ID, s libnet.Stream,
 	peers := node.routeTable.NearestPeers(kbucket.ConvertPeerID(pid), node.config.MaxSyncNodes)
 	var peerList []*messages.PeerInfo
 	for i := range peers {
+		// the private peers are never told to the other peers.
+		if node.IsPrivatePeer(peers[i].Pretty()) {
+			continue
+		}
 		peerInfo := node.peerstore.PeerInfo(peers[i])
 		if len(peerInfo.Addrs) == 0 {
 			logging.VLog().WithFields(logrus.Fields{
@@ -36,6 +36,7 @@ type Manager interface {
 	Broadcast(string, net.Serializable)
 	Relay(string, net.Serializable)
 	SendMsg(string, []byte, string) error
+	ClosePeer(string, error)
 
 	BroadcastNetworkID([]byte)
 
@@ -34,9 +34,18 @@ import (
 	crypto "github.com/libp2p/go-libp2p-crypto"
 	peer "github.com/libp2p/go-libp2p-peer"
 	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/audit"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
 )
 
 func (node *Node) parseAddressFromMultiaddr(address ma.Multiaddr) (ma.Multiaddr, peer.ID, error) {
+	return ParsePeerAddress(address)
+}
+
+// ParsePeerAddress splits an address ending with /ipfs/<id> into the address
+// to dial and the peer id.
+func ParsePeerAddress(address ma.Multiaddr) (ma.Multiaddr, peer.ID, error) {
 
 	addr, err := ma.NewMultiaddr(
 		strings.Split(address.String(), "/ipfs/")[0],
@@ -61,7 +70,25 @@ func (node *Node) parseAddressFromMultiaddr(address ma.Multiaddr) (ma.Multiaddr,
 
 func (node *Node) clearPeerStore(pid peer.ID, addrs []ma.Multiaddr) {
 	node.peerstore.SetAddrs(pid, addrs, 0)
-	if !InArray(pid.Pretty(), node.bootIds) {
+	if !InArray(pid.Pretty(), node.bootIds) && !node.IsPrivatePeer(pid.Pretty()) {
+		node.routeTable.Remove(pid)
+	}
+}
+
+// closePeer closes the stream with a peer and forgets its addresses.
+func (node *Node) closePeer(target string, reason error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"pid":    target,
+		"reason": reason,
+	}).Warn("Close a misbehaving peer.")
+	audit.Record(audit.ActionPeerBan, audit.OriginNode, target, reason.Error(), nil)
+
+	if streamStore, ok := node.stream.Load(target); ok {
+		streamStore.(*StreamStore).stream.Close()
+		node.stream.Delete(target)
+	}
+	if pid, err := peer.IDB58Decode(target); err == nil && !InArray(target, node.bootIds) {
+		node.peerstore.ClearAddrs(pid)
 		node.routeTable.Remove(pid)
 	}
 }
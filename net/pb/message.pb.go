@@ -11,6 +11,8 @@ It has these top-level messages:
 	Hello
 	Peers
 	PeerInfo
+	PeerRecord
+	PeerExchange
 */
 package netpb
 
@@ -33,6 +35,8 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type Hello struct {
 	NodeId        string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	ClientVersion string `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// Version of the binary like "neb/0.6.0-8ee4855", informative only.
+	Build string `protobuf:"bytes,3,opt,name=build,proto3" json:"build,omitempty"`
 }
 
 func (m *Hello) Reset()                    { *m = Hello{} }
@@ -54,6 +58,13 @@ func (m *Hello) GetClientVersion() string {
 	return ""
 }
 
+func (m *Hello) GetBuild() string {
+	if m != nil {
+		return m.Build
+	}
+	return ""
+}
+
 type Peers struct {
 	Peers []*PeerInfo `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
 }
@@ -94,26 +105,112 @@ func (m *PeerInfo) GetAddrs() []string {
 	return nil
 }
 
+// PeerRecord is the addresses a node announces for itself, signed with its
+// node key so that they can be gossiped by other nodes.
+type PeerRecord struct {
+	Id    string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addrs []string `protobuf:"bytes,2,rep,name=addrs" json:"addrs,omitempty"`
+	// Unix time the record was signed at.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Seconds the record is valid for after its timestamp.
+	Ttl uint32 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Public key of the node, the id is derived from.
+	PubKey    []byte `protobuf:"bytes,5,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *PeerRecord) Reset()                    { *m = PeerRecord{} }
+func (m *PeerRecord) String() string            { return proto.CompactTextString(m) }
+func (*PeerRecord) ProtoMessage()               {}
+func (*PeerRecord) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{3} }
+
+func (m *PeerRecord) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeerRecord) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type PeerExchange struct {
+	Records []*PeerRecord `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *PeerExchange) Reset()                    { *m = PeerExchange{} }
+func (m *PeerExchange) String() string            { return proto.CompactTextString(m) }
+func (*PeerExchange) ProtoMessage()               {}
+func (*PeerExchange) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{4} }
+
+func (m *PeerExchange) GetRecords() []*PeerRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Hello)(nil), "netpb.Hello")
 	proto.RegisterType((*Peers)(nil), "netpb.Peers")
 	proto.RegisterType((*PeerInfo)(nil), "netpb.PeerInfo")
+	proto.RegisterType((*PeerRecord)(nil), "netpb.PeerRecord")
+	proto.RegisterType((*PeerExchange)(nil), "netpb.PeerExchange")
 }
 
 func init() { proto.RegisterFile("message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x8e, 0x41, 0xeb, 0x82, 0x40,
-	0x10, 0x47, 0x71, 0x65, 0xfd, 0xff, 0x9d, 0xd0, 0x60, 0x09, 0xda, 0xa3, 0x08, 0x82, 0xa7, 0x25,
-	0xea, 0x43, 0x94, 0xb7, 0xf0, 0xd0, 0x55, 0xb4, 0x9d, 0x62, 0xc1, 0x76, 0x64, 0x57, 0xfa, 0xfc,
-	0xa1, 0x4b, 0xdd, 0xe6, 0xf7, 0xde, 0xe1, 0x0d, 0x64, 0x2f, 0xf4, 0xbe, 0x7f, 0xa2, 0x9a, 0x1c,
-	0xcd, 0x24, 0xb8, 0xc5, 0x79, 0x1a, 0xca, 0x33, 0xf0, 0x0b, 0x8e, 0x23, 0x89, 0x3d, 0xfc, 0x59,
-	0xd2, 0xd8, 0x19, 0x2d, 0xa3, 0x22, 0xaa, 0xd3, 0x36, 0x59, 0x66, 0xa3, 0x45, 0x05, 0xf9, 0x7d,
-	0x34, 0x68, 0xe7, 0xee, 0x8d, 0xce, 0x1b, 0xb2, 0x92, 0xad, 0x3e, 0x0b, 0xf4, 0x16, 0x60, 0xa9,
-	0x80, 0x5f, 0x11, 0x9d, 0x17, 0x15, 0xf0, 0x69, 0x39, 0x64, 0x54, 0xc4, 0xf5, 0xe6, 0xb8, 0x55,
-	0x6b, 0x48, 0x2d, 0xb2, 0xb1, 0x0f, 0x6a, 0x83, 0x2d, 0x0f, 0xf0, 0xff, 0x45, 0x22, 0x07, 0xf6,
-	0xcb, 0x32, 0xa3, 0xc5, 0x0e, 0x78, 0xaf, 0xb5, 0xf3, 0x92, 0x15, 0x71, 0x9d, 0xb6, 0x61, 0x0c,
-	0xc9, 0xfa, 0xf8, 0xe9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x87, 0x8b, 0x73, 0xcc, 0xc9, 0x00, 0x00,
-	0x00,
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x41, 0x4b, 0xc3, 0x30,
+	0x18, 0xa5, 0xad, 0xe9, 0xdc, 0xe7, 0x36, 0x35, 0x08, 0xe6, 0xe0, 0xa1, 0x14, 0x06, 0x05, 0xa1,
+	0x88, 0x1e, 0x3d, 0x0b, 0x0e, 0x2f, 0x92, 0x83, 0x37, 0x19, 0xed, 0xf2, 0x39, 0x83, 0x5d, 0x12,
+	0x92, 0x54, 0xdc, 0x4f, 0xf1, 0xdf, 0x4a, 0x52, 0xea, 0x3c, 0x7a, 0xfb, 0xde, 0x7b, 0xf9, 0xf2,
+	0x5e, 0x5e, 0x60, 0xbe, 0x43, 0xe7, 0x9a, 0x2d, 0xd6, 0xc6, 0x6a, 0xaf, 0x29, 0x51, 0xe8, 0x4d,
+	0x5b, 0xbe, 0x02, 0x79, 0xc4, 0xae, 0xd3, 0xf4, 0x12, 0x26, 0x4a, 0x0b, 0x5c, 0x4b, 0xc1, 0x92,
+	0x22, 0xa9, 0xa6, 0x3c, 0x0f, 0x70, 0x25, 0xe8, 0x12, 0x16, 0x9b, 0x4e, 0xa2, 0xf2, 0xeb, 0x4f,
+	0xb4, 0x4e, 0x6a, 0xc5, 0xd2, 0xa8, 0xcf, 0x07, 0xf6, 0x65, 0x20, 0xe9, 0x05, 0x90, 0xb6, 0x97,
+	0x9d, 0x60, 0x59, 0x54, 0x07, 0x50, 0xd6, 0x40, 0x9e, 0x11, 0xad, 0xa3, 0x4b, 0x20, 0x26, 0x0c,
+	0x2c, 0x29, 0xb2, 0xea, 0xe4, 0xf6, 0xb4, 0x8e, 0xf6, 0x75, 0x10, 0x57, 0xea, 0x4d, 0xf3, 0x41,
+	0x2d, 0x6f, 0xe0, 0x78, 0xa4, 0xe8, 0x02, 0xd2, 0xdf, 0x30, 0xa9, 0x14, 0xc1, 0xa1, 0x11, 0xc2,
+	0x3a, 0x96, 0x16, 0x59, 0x70, 0x88, 0xa0, 0xfc, 0x4e, 0x00, 0xc2, 0x0a, 0xc7, 0x8d, 0xb6, 0xe2,
+	0x7f, 0x4b, 0xf4, 0x0a, 0xa6, 0x5e, 0xee, 0xd0, 0xf9, 0x66, 0x67, 0x62, 0xe0, 0x8c, 0x1f, 0x08,
+	0x7a, 0x06, 0x99, 0xf7, 0x1d, 0x3b, 0x2a, 0x92, 0x6a, 0xce, 0xc3, 0x18, 0xca, 0x31, 0x7d, 0xbb,
+	0xfe, 0xc0, 0x3d, 0x23, 0x45, 0x52, 0xcd, 0x78, 0x6e, 0xfa, 0xf6, 0x09, 0xf7, 0xe1, 0x22, 0x27,
+	0xb7, 0xaa, 0xf1, 0xbd, 0x45, 0x96, 0x47, 0xe9, 0x40, 0x94, 0xf7, 0x30, 0x0b, 0xd1, 0x1e, 0xbe,
+	0x36, 0xef, 0x8d, 0xda, 0x22, 0xbd, 0x86, 0x89, 0x8d, 0x31, 0xc7, 0x1a, 0xce, 0xff, 0xd4, 0x30,
+	0x3c, 0x80, 0x8f, 0x27, 0xda, 0x3c, 0xfe, 0xd3, 0xdd, 0xcf, 0x00, 0x08, 0x04, 0x43, 0x1d, 0xb8,
+	0x01, 0x00, 0x00,
 }
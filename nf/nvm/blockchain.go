@@ -32,37 +32,45 @@ import (
 // GetTxByHashFunc returns tx info by hash
 //export GetTxByHashFunc
 func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char) *C.char {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	return invokeHost(hostGetTxByHash, nil, uint64(uintptr(handler)), C.GoString(hash)).cString()
+}
+
+func getTxByHash(_ *V8Engine, handler uint64, args []string) hostResult {
+	engine, _ := getEngineByStorageHandler(handler)
 	if engine == nil || engine.ctx.block == nil {
-		return nil
+		return hostNil
 	}
-	tx, err := engine.ctx.SerializeTxByHash([]byte(C.GoString(hash)))
+	tx, err := engine.ctx.SerializeTxByHash([]byte(args[0]))
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"handler": uint64(uintptr(handler)),
-			"key":     C.GoString(hash),
+			"handler": handler,
+			"key":     args[0],
 			"err":     err,
 		}).Error("GetTxByHashFunc get tx failed.")
-		return nil
+		return hostNil
 	}
-	return C.CString(string(tx))
+	return hostResult{Value: string(tx)}
 }
 
 // GetAccountStateFunc returns account info by address
 //export GetAccountStateFunc
 func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	return invokeHost(hostGetAccount, nil, uint64(uintptr(handler)), C.GoString(address)).cString()
+}
+
+func getAccountState(_ *V8Engine, handler uint64, args []string) hostResult {
+	engine, _ := getEngineByStorageHandler(handler)
 	if engine == nil || engine.ctx.block == nil {
-		return nil
+		return hostNil
 	}
-	addr := C.GoString(address)
+	addr := args[0]
 	valid := engine.ctx.block.VerifyAddress(addr)
 	if !valid {
 		logging.VLog().WithFields(logrus.Fields{
-			"handler": uint64(uintptr(handler)),
-			"key":     C.GoString(address),
+			"handler": handler,
+			"key":     addr,
 		}).Error("GetAccountStateFunc parse address failed.")
-		return nil
+		return hostNil
 	}
 
 	acc := engine.ctx.state.GetOrCreateUserAccount([]byte(addr))
@@ -71,25 +79,29 @@ func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
 		Balance: acc.Balance().String(),
 	}
 	json, _ := json.Marshal(state)
-	return C.CString(string(json))
+	return hostResult{Value: string(json)}
 }
 
 // TransferFunc transfer vale to address
 //export TransferFunc
 func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	return invokeHost(hostTransfer, nil, uint64(uintptr(handler)), C.GoString(to), C.GoString(v)).Code
+}
+
+func transfer(_ *V8Engine, handler uint64, args []string) hostResult {
+	engine, _ := getEngineByStorageHandler(handler)
 	if engine == nil || engine.ctx.block == nil {
-		return 1
+		return hostResult{Code: 1}
 	}
 
-	addr := C.GoString(to)
+	addr := args[0]
 	valid := engine.ctx.block.VerifyAddress(addr)
 	if !valid {
 		logging.VLog().WithFields(logrus.Fields{
-			"handler": uint64(uintptr(handler)),
-			"key":     C.GoString(to),
+			"handler": handler,
+			"key":     addr,
 		}).Error("TransferFunc parse address failed.")
-		return 1
+		return hostResult{Code: 1}
 	}
 
 	toAcc := engine.ctx.state.GetOrCreateUserAccount([]byte(addr))
@@ -98,33 +110,66 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
 		amount *util.Uint128
 		err    error
 	)
-	amount = util.NewUint128FromString(C.GoString(v))
+	amount = util.NewUint128FromString(args[1])
 
 	// update balance
 	err = engine.ctx.contract.SubBalance(amount)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"handler": uint64(uintptr(handler)),
-			"key":     C.GoString(to),
+			"handler": handler,
+			"key":     addr,
 			"err":     err,
 		}).Error("TransferFunc SubBalance failed.")
-		return 1
+		return hostResult{Code: 1}
 	}
 
 	toAcc.AddBalance(amount)
-	return 0
+	return hostResult{}
 }
 
 // VerifyAddressFunc verify address is valid
 //export VerifyAddressFunc
 func VerifyAddressFunc(handler unsafe.Pointer, address *C.char) int {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	return invokeHost(hostVerifyAddress, nil, uint64(uintptr(handler)), C.GoString(address)).Code
+}
+
+func verifyAddress(_ *V8Engine, handler uint64, args []string) hostResult {
+	engine, _ := getEngineByStorageHandler(handler)
 	if engine == nil || engine.ctx.block == nil {
-		return 0
+		return hostResult{Code: 0}
 	}
 
-	if engine.ctx.block.VerifyAddress(C.GoString(address)) {
-		return 1
+	if engine.ctx.block.VerifyAddress(args[0]) {
+		return hostResult{Code: 1}
+	}
+	return hostResult{Code: 0}
+}
+
+// SendMessageFunc queue a message calling the function of the contract at
+// address, delivered in a later block with the gas prepaid by the contract.
+//export SendMessageFunc
+func SendMessageFunc(handler unsafe.Pointer, to *C.char, function *C.char, args *C.char, gasLimit *C.char) int {
+	return invokeHost(hostSendMessage, nil, uint64(uintptr(handler)), C.GoString(to), C.GoString(function), C.GoString(args), C.GoString(gasLimit)).Code
+}
+
+func sendMessage(_ *V8Engine, handler uint64, args []string) hostResult {
+	engine, _ := getEngineByStorageHandler(handler)
+	if engine == nil || engine.ctx.block == nil || engine.ctx.tx == nil {
+		return hostResult{Code: 1}
+	}
+
+	to, function := args[0], args[1]
+	gasPrice := util.NewUint128FromString(engine.ctx.tx.GasPrice)
+	limit := util.NewUint128FromString(args[3])
+	err := engine.ctx.block.SendMessage(engine.ctx.state, engine.ctx.contract.Address(), to, function, args[2], gasPrice, limit)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler":  handler,
+			"to":       to,
+			"function": function,
+			"err":      err,
+		}).Error("SendMessageFunc send message failed.")
+		return hostResult{Code: 1}
 	}
-	return 0
+	return hostResult{}
 }
@@ -37,9 +37,18 @@ char *GetTxByHashFunc(void *handler, const char *hash);
 char *GetAccountStateFunc(void *handler, const char *address);
 int TransferFunc(void *handler, const char *to, const char *value);
 int VerifyAddressFunc(void *handler, const char *address);
+int SendMessageFunc(void *handler, const char *to, const char *function, const char *args, const char *gasLimit);
 
 // event.
 void EventTriggerFunc(void *handler, const char *topic, const char *data);
+void CallResultFunc(void *handler, const char *value);
+
+// crypto.
+char *CryptoHashFunc(const char *alg, const char *data, size_t *gasCnt);
+char *CryptoRecoverAddressFunc(void *handler, int alg, const char *hash, const char *sign, size_t *gasCnt);
+
+// profiler.
+void ProfileFunc(void *engine, const char *frame, int delta, size_t count);
 
 // The gateway functions.
 void V8Log_cgo(int level, const char *msg) {
@@ -72,10 +81,27 @@ int TransferFunc_cgo(void *handler, const char *to, const char *value) {
 int VerifyAddressFunc_cgo(void *handler, const char *address) {
 	return VerifyAddressFunc(handler, address);
 };
+int SendMessageFunc_cgo(void *handler, const char *to, const char *function, const char *args, const char *gasLimit) {
+	return SendMessageFunc(handler, to, function, args, gasLimit);
+};
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data) {
 	EventTriggerFunc(handler, topic, data);
 };
+void CallResultFunc_cgo(void *handler, const char *value) {
+	CallResultFunc(handler, value);
+};
+
+char *CryptoHashFunc_cgo(const char *alg, const char *data, size_t *gasCnt) {
+	return CryptoHashFunc(alg, data, gasCnt);
+};
+char *CryptoRecoverAddressFunc_cgo(void *handler, int alg, const char *hash, const char *sign, size_t *gasCnt) {
+	return CryptoRecoverAddressFunc(handler, alg, hash, sign, gasCnt);
+};
+
+void ProfileFunc_cgo(void *engine, const char *frame, int delta, size_t count) {
+	ProfileFunc(engine, frame, delta, count);
+};
 
 */
 import "C"
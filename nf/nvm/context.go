@@ -19,6 +19,7 @@
 package nvm
 
 import (
+	"context"
 	"encoding/json"
 
 	"errors"
@@ -26,6 +27,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
@@ -35,6 +37,23 @@ const (
 	DefaultLimitsOfTotalMemorySize uint64 = 40 * 1000 * 1000
 )
 
+type memoryLimitKey struct{}
+
+// NewMemoryLimitContext returns a copy of ctx lowering the memory limit of
+// the payloads executed in it, the consensus execution keeps the default.
+func NewMemoryLimitContext(ctx context.Context, limit uint64) context.Context {
+	return context.WithValue(ctx, memoryLimitKey{}, limit)
+}
+
+// MemoryLimitFromContext returns the memory limit of the payloads executed in
+// ctx, never above DefaultLimitsOfTotalMemorySize.
+func MemoryLimitFromContext(ctx context.Context) uint64 {
+	if limit, ok := ctx.Value(memoryLimitKey{}).(uint64); ok && limit > 0 && limit < DefaultLimitsOfTotalMemorySize {
+		return limit
+	}
+	return DefaultLimitsOfTotalMemorySize
+}
+
 // Block interface breaks cycle import dependency and hides unused services.
 type Block interface {
 	CoinbaseHash() byteutils.Hash
@@ -44,6 +63,12 @@ type Block interface {
 	VerifyAddress(str string) bool
 	SerializeTxByHash(hash byteutils.Hash) (proto.Message, error)
 	RecordEvent(txHash byteutils.Hash, topic, data string) error
+	LoadLibrary(accState state.AccountState, ref string) (string, error)
+	SendMessage(accState state.AccountState, from byteutils.Hash, to string, function, args string, gasPrice, gasLimit *util.Uint128) error
+	RecoverAddress(alg keystore.Algorithm, hash, sign []byte) (string, error)
+	ContextExtension() *ContextBlockExtension
+	IsVMLimitsActive() bool
+	IsNumericPolicyActive() bool
 }
 
 // AccountState context account state
@@ -58,6 +83,18 @@ type ContextBlock struct {
 	Nonce    uint64 `json:"nonce"`
 	Hash     string `json:"hash"`
 	Height   uint64 `json:"height"`
+
+	*ContextBlockExtension
+}
+
+// ContextBlockExtension is the block context added by a fork, the existing
+// contracts get the same context before the fork.
+type ContextBlockExtension struct {
+	ParentHash string `json:"parentHash"`
+	GasLimit   string `json:"gasLimit"`
+	// Random is the seed of the block, known once the parent block is, it
+	// isn't safe to decide anything of value on.
+	Random string `json:"random"`
 }
 
 // ContextTransaction warpper transaction
@@ -70,6 +107,9 @@ type ContextTransaction struct {
 	Timestamp int64  `json:"timestamp"`
 	GasPrice  string `json:"gasPrice"`
 	GasLimit  string `json:"gasLimit"`
+
+	// Index of the tx in the block, -1 for a message, set after the fork.
+	Index *int64 `json:"index,omitempty"`
 }
 
 // Context nvm engine context
@@ -117,6 +157,8 @@ func (ctx *Context) SerializeContextBlock() ([]byte, error) {
 			Nonce:    ctx.block.Nonce(),
 			Hash:     ctx.block.Hash().String(),
 			Height:   ctx.block.Height(),
+
+			ContextBlockExtension: ctx.block.ContextExtension(),
 		}
 		return json.Marshal(block)
 	}
@@ -0,0 +1,119 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+
+import (
+	"errors"
+	"strconv"
+	"unsafe"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Gas of the crypto functions of the contracts, part of the consensus.
+const (
+	// CryptoHashWordSize is the size in bytes of a word of the hashed data.
+	CryptoHashWordSize = 32
+
+	// CryptoRecoverAddressGas is the gas to recover the signer address of a hash.
+	CryptoRecoverAddressGas uint64 = 3000
+)
+
+// Errors
+var (
+	ErrUnsupportedHashAlgorithm = errors.New("unsupported hash algorithm")
+)
+
+type cryptoHashFunc struct {
+	sum     func(...[]byte) []byte
+	baseGas uint64
+	wordGas uint64
+}
+
+// cryptoHashFuncs are the hash algorithms available in the contracts.
+var cryptoHashFuncs = map[string]*cryptoHashFunc{
+	"sha256":    {hash.Sha256, 60, 12},
+	"sha3256":   {hash.Sha3256, 30, 6},
+	"ripemd160": {hash.Ripemd160, 600, 120},
+}
+
+// cryptoHash returns the hex digest of data and the gas of the hash.
+func cryptoHash(alg, data string) (string, uint64, error) {
+	f := cryptoHashFuncs[alg]
+	if f == nil {
+		return "", 0, ErrUnsupportedHashAlgorithm
+	}
+	words := uint64((len(data) + CryptoHashWordSize - 1) / CryptoHashWordSize)
+	return byteutils.Hex(f.sum([]byte(data))), f.baseGas + f.wordGas*words, nil
+}
+
+// CryptoHashFunc returns the hex digest of data hashed by alg.
+//export CryptoHashFunc
+func CryptoHashFunc(alg *C.char, data *C.char, gasCnt *C.size_t) *C.char {
+	digest, gas, err := cryptoHash(C.GoString(alg), C.GoString(data))
+	*gasCnt = C.size_t(gas)
+	if err != nil {
+		return nil
+	}
+	return C.CString(digest)
+}
+
+// CryptoRecoverAddressFunc returns the address of the signer of the hex hash from the hex signature.
+//export CryptoRecoverAddressFunc
+func CryptoRecoverAddressFunc(handler unsafe.Pointer, alg C.int, hash *C.char, sign *C.char, gasCnt *C.size_t) *C.char {
+	*gasCnt = C.size_t(CryptoRecoverAddressGas)
+	return invokeHost(hostRecoverAddress, nil, uint64(uintptr(handler)), strconv.Itoa(int(alg)), C.GoString(hash), C.GoString(sign)).cString()
+}
+
+func recoverAddress(_ *V8Engine, handler uint64, args []string) hostResult {
+	engine, _ := getEngineByStorageHandler(handler)
+	if engine == nil || engine.ctx.block == nil {
+		return hostNil
+	}
+
+	alg, err := strconv.Atoi(args[0])
+	if err != nil {
+		return hostNil
+	}
+	h, err := byteutils.FromHex(args[1])
+	if err != nil {
+		return hostNil
+	}
+	s, err := byteutils.FromHex(args[2])
+	if err != nil {
+		return hostNil
+	}
+
+	addr, err := engine.ctx.block.RecoverAddress(keystore.Algorithm(alg), h, s)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": handler,
+			"hash":    args[1],
+			"err":     err,
+		}).Debug("CryptoRecoverAddressFunc recover address failed.")
+		return hostNil
+	}
+	return hostResult{Value: addr}
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCryptoHash(t *testing.T) {
+	tests := []struct {
+		alg         string
+		data        string
+		digest      string
+		gas         uint64
+		expectedErr error
+	}{
+		{"sha256", "nebulas", "8dfd52731ae376870f7622eb64d5b9d5683107924f93ddba7bcc19bbe1a0ee80", 72, nil},
+		{"sha3256", "nebulas", "77bf6aaa1445aafcb4d6ffe3803d868e3b92cb7663a57d0d48fc3c2bbde35f23", 36, nil},
+		{"ripemd160", "nebulas", "c8962fc4788bfb5d3d69c690bddf243bc6ad4592", 720, nil},
+		{"sha256", "", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", 60, nil},
+		{"sha256", string(make([]byte, 33)), "", 84, nil},
+		{"md5", "nebulas", "", 0, ErrUnsupportedHashAlgorithm},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alg, func(t *testing.T) {
+			digest, gas, err := cryptoHash(tt.alg, tt.data)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.gas, gas)
+			if len(tt.digest) > 0 {
+				assert.Equal(t, tt.digest, digest)
+			}
+		})
+	}
+}
@@ -38,12 +38,20 @@ char *GetTxByHashFunc_cgo(void *handler, const char *hash);
 char *GetAccountStateFunc_cgo(void *handler, const char *address);
 int TransferFunc_cgo(void *handler, const char *to, const char *value);
 int VerifyAddressFunc_cgo(void *handler, const char *address);
+int SendMessageFunc_cgo(void *handler, const char *to, const char *function, const char *args, const char *gasLimit);
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data);
+void CallResultFunc_cgo(void *handler, const char *value);
+
+char *CryptoHashFunc_cgo(const char *alg, const char *data, size_t *gasCnt);
+char *CryptoRecoverAddressFunc_cgo(void *handler, int alg, const char *hash, const char *sign, size_t *gasCnt);
+
+void ProfileFunc_cgo(void *engine, const char *frame, int delta, size_t count);
 
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -63,13 +71,28 @@ const (
 	SourceTypeTypeScript = "ts"
 )
 
+// Limits of the contract execution, part of the consensus.
+const (
+	// LimitsOfCallDepth is the max depth of the nested function calls.
+	LimitsOfCallDepth uint64 = 128
+
+	// MaxStorageKeySize is the max size in bytes of a key put in the contract storage.
+	MaxStorageKeySize = 1024
+
+	// MaxStorageValueSize is the max size in bytes of a value put in the contract storage.
+	MaxStorageValueSize = 64 * 1024
+)
+
 // Errors
 var (
 	ErrExecutionFailed                = errors.New("execution failed")
 	ErrDisallowCallPrivateFunction    = errors.New("disallow call private function")
 	ErrExecutionTimeout               = errors.New("execution timeout")
+	ErrExecutionCanceled              = errors.New("execution canceled")
 	ErrInsufficientGas                = errors.New("insufficient gas")
 	ErrExceedMemoryLimits             = errors.New("exceed memory limits")
+	ErrExceedCallDepthLimits          = errors.New("exceed call depth limits")
+	ErrExceedStorageObjectLimits      = errors.New("exceed storage object size limits")
 	ErrInjectTracingInstructionFailed = errors.New("inject tracing instructions failed")
 	ErrTranspileTypeScriptFailed      = errors.New("transpile TypeScript failed")
 	ErrUnsupportedSourceType          = errors.New("unsupported source type")
@@ -95,8 +118,14 @@ type V8Engine struct {
 	limitsOfTotalMemorySize            uint64
 	actualCountOfExecutionInstructions uint64
 	actualTotalMemorySize              uint64
+	exceedStorageObjectLimits          bool
+	vmLimits                           bool
 	lcsHandler                         uint64
 	gcsHandler                         uint64
+	cancelCtx                          context.Context
+	profiler                           *Profiler
+	result                             *CallResult
+	testing                            bool
 }
 
 // InitV8Engine initialize the v8 engine.
@@ -113,10 +142,16 @@ func InitV8Engine() {
 	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)))
 
 	// Blockchain.
-	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)))
+	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)), (C.SendMessageFunc)(unsafe.Pointer(C.SendMessageFunc_cgo)))
 
 	// Event.
-	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)))
+	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)), (C.CallResultFunc)(unsafe.Pointer(C.CallResultFunc_cgo)))
+
+	// Crypto.
+	C.InitializeCrypto((C.CryptoHashFunc)(unsafe.Pointer(C.CryptoHashFunc_cgo)), (C.CryptoRecoverAddressFunc)(unsafe.Pointer(C.CryptoRecoverAddressFunc_cgo)))
+
+	// Profiler.
+	C.InitializeProfiler((C.ProfileFunc)(unsafe.Pointer(C.ProfileFunc_cgo)))
 }
 
 // DisposeV8Engine dispose the v8 engine.
@@ -126,6 +161,12 @@ func DisposeV8Engine() {
 
 // NewV8Engine return new V8Engine instance.
 func NewV8Engine(ctx *Context) *V8Engine {
+	return newV8Engine(ctx, ctx.block != nil && ctx.block.IsVMLimitsActive())
+}
+
+// newV8Engine return new V8Engine instance, the limits of the call depth, the
+// heap and the storage objects apply if vmLimits.
+func newV8Engine(ctx *Context, vmLimits bool) *V8Engine {
 	v8engineOnce.Do(func() {
 		InitV8Engine()
 	})
@@ -133,12 +174,14 @@ func NewV8Engine(ctx *Context) *V8Engine {
 	engine := &V8Engine{
 		ctx:                                ctx,
 		modules:                            NewModules(),
-		v8engine:                           C.CreateEngine(),
+		v8engine:                           C.CreateEngine(boolToCInt(vmLimits)),
 		enableLimits:                       false,
 		limitsOfExecutionInstructions:      0,
 		limitsOfTotalMemorySize:            0,
 		actualCountOfExecutionInstructions: 0,
 		actualTotalMemorySize:              0,
+		cancelCtx:                          context.Background(),
+		vmLimits:                           vmLimits,
 	}
 
 	(func() {
@@ -176,6 +219,19 @@ func (e *V8Engine) Dispose() {
 	C.DeleteEngine(e.v8engine)
 }
 
+// setStorageHandlers replace the storage handlers of the engine.
+func (e *V8Engine) setStorageHandlers(lcsHandler, gcsHandler uint64) {
+	storagesLock.Lock()
+	defer storagesLock.Unlock()
+
+	delete(storages, e.lcsHandler)
+	delete(storages, e.gcsHandler)
+	e.lcsHandler = lcsHandler
+	e.gcsHandler = gcsHandler
+	storages[e.lcsHandler] = e
+	storages[e.gcsHandler] = e
+}
+
 // Context returns engine context
 func (e *V8Engine) Context() *Context {
 	return e.ctx
@@ -183,6 +239,7 @@ func (e *V8Engine) Context() *Context {
 
 // SetTestingFlag set testing flag, default is False.
 func (e *V8Engine) SetTestingFlag(flag bool) {
+	e.testing = flag
 	if flag {
 		e.v8engine.testing = C.int(1)
 	} else {
@@ -191,13 +248,18 @@ func (e *V8Engine) SetTestingFlag(flag bool) {
 }
 
 // SetExecutionLimits set execution limits of V8 Engine, prevent Halting Problem.
+// The depth of the nested calls is limited by LimitsOfCallDepth from the VM limits fork.
 func (e *V8Engine) SetExecutionLimits(limitsOfExecutionInstructions, limitsOfTotalMemorySize uint64) {
 	e.v8engine.limits_of_executed_instructions = C.size_t(limitsOfExecutionInstructions)
 	e.v8engine.limits_of_total_memory_size = C.size_t(limitsOfTotalMemorySize)
+	if e.vmLimits {
+		e.v8engine.limits_of_call_depth = C.size_t(LimitsOfCallDepth)
+	}
 
 	logging.VLog().WithFields(logrus.Fields{
 		"limits_of_executed_instructions": e.v8engine.limits_of_executed_instructions,
 		"limits_of_total_memory_size":     e.v8engine.limits_of_total_memory_size,
+		"limits_of_call_depth":            e.v8engine.limits_of_call_depth,
 	}).Info("set execution limits.")
 
 	e.limitsOfExecutionInstructions = limitsOfExecutionInstructions
@@ -210,6 +272,32 @@ func (e *V8Engine) SetExecutionLimits(limitsOfExecutionInstructions, limitsOfTot
 	}
 }
 
+// SetCancelContext set the context.Context whose cancellation terminates the execution.
+func (e *V8Engine) SetCancelContext(ctx context.Context) {
+	e.cancelCtx = ctx
+}
+
+// SetProfiler set the profiler the executed instructions are attributed to, nil disables the profiling.
+func (e *V8Engine) SetProfiler(p *Profiler) {
+	e.profiler = p
+	if p != nil {
+		e.v8engine.profiling = 1
+	} else {
+		e.v8engine.profiling = 0
+	}
+}
+
+// SetResult set the result the value returned by the called function is kept in, nil disables it.
+func (e *V8Engine) SetResult(r *CallResult) {
+	e.result = r
+}
+
+// terminateOnStorageObjectLimits terminate the execution putting an oversized object in the storage.
+func (e *V8Engine) terminateOnStorageObjectLimits() {
+	e.exceedStorageObjectLimits = true
+	C.TerminateExecution(e.v8engine)
+}
+
 // ExecutionInstructions returns the execution instructions
 func (e *V8Engine) ExecutionInstructions() uint64 {
 	return e.actualCountOfExecutionInstructions
@@ -236,8 +324,11 @@ func (e *V8Engine) InjectTracingInstructions(source string) (string, int, error)
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
 
+	// the calls are tracked for the call depth limits and the profiler.
+	trackCallDepth := e.vmLimits || e.profiler != nil
+
 	lineOffset := C.int(0)
-	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, &lineOffset)
+	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, &lineOffset, boolToCInt(trackCallDepth))
 	if traceableCSource == nil {
 		return "", 0, ErrInjectTracingInstructionFailed
 	}
@@ -257,6 +348,10 @@ func (e *V8Engine) CollectTracingStats() {
 
 // RunScriptSource run js source.
 func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err error) {
+	if sandboxes != nil {
+		return e.runInSandbox(source, sourceLineOffset)
+	}
+
 	if e.enableLimits {
 		traceableSource, traceableSourceLineOffset, err := e.InjectTracingInstructions(source)
 		if err != nil {
@@ -286,6 +381,14 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 		C.TerminateExecution(e.v8engine)
 		err = ErrExecutionTimeout
 
+		// wait for C.RunScriptSource() returns.
+		select {
+		case <-done:
+		}
+	case <-e.cancelCtx.Done():
+		C.TerminateExecution(e.v8engine)
+		err = ErrExecutionCanceled
+
 		// wait for C.RunScriptSource() returns.
 		select {
 		case <-done:
@@ -295,6 +398,10 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 	// collect tracing stats.
 	e.CollectTracingStats()
 
+	if e.profiler != nil {
+		e.profiler.Finish(e.actualCountOfExecutionInstructions)
+	}
+
 	if e.enableLimits {
 		// check limits.
 		ret = C.IsEngineLimitsExceeded(e.v8engine)
@@ -302,6 +409,8 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 			err = ErrInsufficientGas
 		} else if ret == 2 {
 			err = ErrExceedMemoryLimits
+		} else if ret == 3 {
+			err = ErrExceedCallDepthLimits
 		}
 
 		if e.actualCountOfExecutionInstructions > e.limitsOfExecutionInstructions || err == ErrExceedMemoryLimits {
@@ -309,6 +418,12 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 		}
 	}
 
+	// the oversized object is rejected from the VM limits fork, whether the
+	// limits of execution are enabled or not.
+	if e.exceedStorageObjectLimits {
+		err = ErrExceedStorageObjectLimits
+	}
+
 	return
 }
 
@@ -322,6 +437,11 @@ func (e *V8Engine) Call(source, sourceType, function, args string) error {
 
 // DeployAndInit a contract
 func (e *V8Engine) DeployAndInit(source, sourceType, args string) error {
+	if e.isNumericPolicyActive() {
+		if err := CheckNumericPolicy(source); err != nil {
+			return err
+		}
+	}
 	return e.RunContractScript(source, sourceType, "init", args)
 }
 
@@ -383,16 +503,29 @@ func (e *V8Engine) prepareRunnableContractScript(source, function, args string)
 	// prepare for execute.
 	blockJSON, _ := e.ctx.SerializeContextBlock()
 	txJSON, _ := e.ctx.SerializeContextTx()
-	var runnableSource string
-
+	var call string
 	if len(args) > 0 {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"));\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function, formatArgs(args))
+		call = fmt.Sprintf("__instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"))", function, formatArgs(args))
 	} else {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance);\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function)
+		call = fmt.Sprintf("__instance[\"%s\"].apply(__instance)", function)
+	}
+	statement := call + ";\n"
+	if e.result != nil {
+		statement = resultStatement(call)
+	}
+	runnableSource := fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n %s", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), statement)
+	if e.isNumericPolicyActive() {
+		runnableSource = mathPolicySource + runnableSource
 	}
 	return runnableSource, 0, nil
 }
 
+// isNumericPolicyActive returns if the numeric policy applies to the contracts
+// run in the block of the context.
+func (e *V8Engine) isNumericPolicyActive() bool {
+	return e.ctx.block != nil && e.ctx.block.IsNumericPolicyActive()
+}
+
 func getEngineByStorageHandler(handler uint64) (*V8Engine, state.Account) {
 	storagesLock.RLock()
 	engine := storages[handler]
@@ -438,3 +571,10 @@ func formatArgs(s string) string {
 	s = strings.Replace(s, "\"", "\\\"", -1)
 	return s
 }
+
+func boolToCInt(b bool) C.int {
+	if b {
+		return C.int(1)
+	}
+	return C.int(0)
+}
@@ -21,6 +21,7 @@ package nvm
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -32,6 +33,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -45,6 +47,8 @@ func TestMain(m *testing.M) {
 }
 
 type mockBlock struct {
+	beforeVMLimits      bool
+	beforeNumericPolicy bool
 }
 
 func (m *mockBlock) CoinbaseHash() byteutils.Hash {
@@ -71,6 +75,30 @@ func (m *mockBlock) RecordEvent(txHash byteutils.Hash, topic, data string) error
 	return nil
 }
 
+func (m *mockBlock) LoadLibrary(accState state.AccountState, ref string) (string, error) {
+	return "", errors.New("library not found")
+}
+
+func (m *mockBlock) SendMessage(accState state.AccountState, from byteutils.Hash, to string, function, args string, gasPrice, gasLimit *util.Uint128) error {
+	return nil
+}
+
+func (m *mockBlock) RecoverAddress(alg keystore.Algorithm, hash, sign []byte) (string, error) {
+	return "", errors.New("invalid signature")
+}
+
+func (m *mockBlock) ContextExtension() *ContextBlockExtension {
+	return nil
+}
+
+func (m *mockBlock) IsVMLimitsActive() bool {
+	return !m.beforeVMLimits
+}
+
+func (m *mockBlock) IsNumericPolicyActive() bool {
+	return !m.beforeNumericPolicy
+}
+
 func (m *mockBlock) SerializeTxByHash(hash byteutils.Hash) (proto.Message, error) {
 	from, _ := byteutils.FromHex("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf")
 	to, _ := byteutils.FromHex("22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09")
@@ -230,6 +258,39 @@ func TestRunScriptSourceWithLimits(t *testing.T) {
 	}
 }
 
+func TestRunScriptSourceWithResourceLimits(t *testing.T) {
+	tests := []struct {
+		filepath       string
+		beforeVMLimits bool
+		expectedErr    error
+	}{
+		{"test/test_call_depth.js", false, ErrExceedCallDepthLimits},
+		{"test/test_storage_object_size.js", false, ErrExceedStorageObjectLimits},
+		{"test/test_call_depth.js", true, ErrExecutionFailed},
+		{"test/test_storage_object_size.js", true, ErrExecutionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filepath, func(t *testing.T) {
+			data, err := ioutil.ReadFile(tt.filepath)
+			assert.Nil(t, err, "filepath read error")
+
+			mem, _ := storage.NewMemoryStorage()
+			context, _ := state.NewAccountState(nil, mem)
+			owner := context.GetOrCreateUserAccount([]byte("account1"))
+			owner.AddBalance(util.NewUint128FromInt(1000000000))
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			ctx := NewContext(&mockBlock{beforeVMLimits: tt.beforeVMLimits}, testContextTransaction(), owner, contract, context)
+
+			engine := NewV8Engine(ctx)
+			engine.SetExecutionLimits(10000000, 50000000)
+			err = engine.RunScriptSource(string(data), 0)
+			assert.Equal(t, tt.expectedErr, err)
+			engine.Dispose()
+		})
+	}
+}
+
 func TestRunScriptSourceTimeout(t *testing.T) {
 	tests := []struct {
 		filepath string
@@ -619,6 +680,7 @@ func TestBlockChain(t *testing.T) {
 		expectedErr error
 	}{
 		{"test/test_blockchain.js", nil},
+		{"test/test_crypto.js", nil},
 	}
 
 	for _, tt := range tests {
@@ -36,17 +36,19 @@ const (
 // EventTriggerFunc export EventTriggerFunc
 //export EventTriggerFunc
 func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
-	gTopic := C.GoString(topic)
-	gData := C.GoString(data)
+	invokeHost(hostEventTrigger, getEngineByEngineHandler(handler), 0, C.GoString(topic), C.GoString(data))
+}
+
+func eventTrigger(e *V8Engine, _ uint64, args []string) hostResult {
+	gTopic, gData := args[0], args[1]
 
-	e := getEngineByEngineHandler(handler)
 	if e == nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"category": 0, // ChainEventCategory.
 			"topic":    gTopic,
 			"data":     gData,
 		}).Error("Event.Trigger delegate handler does not found.")
-		return
+		return hostResult{}
 	}
 
 	logging.VLog().WithFields(logrus.Fields{
@@ -58,4 +60,5 @@ func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
 	txHash, _ := byteutils.FromHex(e.ctx.tx.Hash)
 	contractTopic := EventNameSpaceContract + "." + gTopic
 	e.ctx.block.RecordEvent(txHash, contractTopic, gData)
+	return hostResult{}
 }
@@ -0,0 +1,91 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+
+// Names of the functions of the node called by the contracts.
+const (
+	hostStorageGet     = "storage.get"
+	hostStoragePut     = "storage.put"
+	hostStorageDel     = "storage.del"
+	hostGetTxByHash    = "blockchain.getTxByHash"
+	hostGetAccount     = "blockchain.getAccountState"
+	hostTransfer       = "blockchain.transfer"
+	hostVerifyAddress  = "blockchain.verifyAddress"
+	hostSendMessage    = "blockchain.sendMessage"
+	hostRecoverAddress = "crypto.recoverAddress"
+	hostEventTrigger   = "event.trigger"
+	hostCallResult     = "call.result"
+	hostRequire        = "require"
+	hostProfile        = "profile"
+)
+
+// hostResult is the result of a host function, Nil is the NULL of the C
+// functions returning a string.
+type hostResult struct {
+	Value string
+	Code  int
+	Nil   bool
+}
+
+var hostNil = hostResult{Nil: true}
+
+// hostFunc is a function of the node called by the contracts. The functions
+// of the storage and the blockchain find the engine by the storage handler,
+// the others are given the engine.
+type hostFunc func(e *V8Engine, handler uint64, args []string) hostResult
+
+// hostFuncs are called by the engines in this process, or over the pipe by
+// the engines in the sandbox process.
+var hostFuncs map[string]hostFunc
+
+func init() {
+	hostFuncs = map[string]hostFunc{
+		hostStorageGet:     storageGet,
+		hostStoragePut:     storagePut,
+		hostStorageDel:     storageDel,
+		hostGetTxByHash:    getTxByHash,
+		hostGetAccount:     getAccountState,
+		hostTransfer:       transfer,
+		hostVerifyAddress:  verifyAddress,
+		hostSendMessage:    sendMessage,
+		hostRecoverAddress: recoverAddress,
+		hostEventTrigger:   eventTrigger,
+		hostCallResult:     callResult,
+		hostRequire:        requireModule,
+		hostProfile:        profile,
+	}
+}
+
+// invokeHost calls the host function in this process, or in the node when
+// running in the sandbox process.
+func invokeHost(name string, e *V8Engine, handler uint64, args ...string) hostResult {
+	if client := currentSandboxClient(); client != nil {
+		return client.call(name, handler, args)
+	}
+	return hostFuncs[name](e, handler, args)
+}
+
+func (r hostResult) cString() *C.char {
+	if r.Nil {
+		return nil
+	}
+	return C.CString(r.Value)
+}
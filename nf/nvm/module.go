@@ -34,6 +34,10 @@ var (
 	pathRe = regexp.MustCompile("^\\.{0,2}/")
 )
 
+// LibraryModulePrefix is the prefix of the ids of the libraries deployed on
+// chain, required by contracts as "@<hash>" or "@<name>@<version>".
+const LibraryModulePrefix = "lib/@"
+
 // Module module structure.
 type Module struct {
 	id         string
@@ -76,24 +80,53 @@ func (ms Modules) Get(id string) *Module {
 // RequireDelegateFunc delegate func for require.
 //export RequireDelegateFunc
 func RequireDelegateFunc(handler unsafe.Pointer, filename *C.char, lineOffset *C.size_t) *C.char {
-	id := C.GoString(filename)
+	r := invokeHost(hostRequire, getEngineByEngineHandler(handler), 0, C.GoString(filename))
+	if r.Nil {
+		return nil
+	}
+	*lineOffset = C.size_t(r.Code)
+	return C.CString(r.Value)
+}
+
+func requireModule(e *V8Engine, _ uint64, args []string) hostResult {
+	id := args[0]
 
-	e := getEngineByEngineHandler(handler)
 	if e == nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"filename": id,
 		}).Error("require delegate handler does not found.")
-		return nil
+		return hostNil
 	}
 
 	module := e.modules.Get(id)
+	if module == nil && strings.HasPrefix(id, LibraryModulePrefix) {
+		module = e.loadLibraryModule(id)
+	}
 	if module == nil {
-		return nil
+		return hostNil
 	}
 
-	*lineOffset = C.size_t(module.lineOffset)
-	cSource := C.CString(module.source)
-	return cSource
+	return hostResult{Value: module.source, Code: module.lineOffset}
+}
+
+// loadLibraryModule load the library deployed on chain as a module, traced as the contract.
+func (e *V8Engine) loadLibraryModule(id string) *Module {
+	if e.ctx.block == nil {
+		return nil
+	}
+	source, err := e.ctx.block.LoadLibrary(e.ctx.state, strings.TrimPrefix(id, LibraryModulePrefix))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"id":  id,
+			"err": err,
+		}).Error("Failed to load library.")
+		return nil
+	}
+	// the lib/ prefix is added back by NewModule.
+	if err := e.AddModule(strings.TrimPrefix(id, "lib/"), source, 0); err != nil {
+		return nil
+	}
+	return e.modules.Get(id)
 }
 
 func reformatModuleID(id string) string {
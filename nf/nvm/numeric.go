@@ -0,0 +1,293 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors of the numeric policy.
+var (
+	ErrFloatLiteralNotAllowed      = errors.New("float literal is not allowed in contract, use BigNumber instead")
+	ErrMathFunctionNotAllowed      = errors.New("nondeterministic Math function is not allowed in contract, use BigNumber instead")
+	ErrUnterminatedContractLiteral = errors.New("unterminated string, template or comment in contract")
+)
+
+// DisallowedMathFunctions are the functions of Math whose results are not
+// guaranteed to be the same across platforms and builds of V8. From the numeric
+// policy fork, they are rejected when the contract is deployed and disabled in
+// the execution.
+var DisallowedMathFunctions = []string{
+	"random", "pow", "exp", "expm1", "log", "log1p", "log2", "log10",
+	"sin", "cos", "tan", "asin", "acos", "atan", "atan2",
+	"sinh", "cosh", "tanh", "asinh", "acosh", "atanh", "cbrt", "hypot",
+}
+
+// mathPolicySource disable the DisallowedMathFunctions before the contract
+// runs from the numeric policy fork, for the contracts deployed before the
+// fork or bypassing the check with computed member accesses.
+var mathPolicySource = fmt.Sprintf("[\"%s\"].forEach(function (f) { Object.defineProperty(Math, f, { value: function () { throw new Error(\"Math.\" + f + \" is not allowed in contract, use BigNumber instead.\"); }, writable: false, configurable: false }); });\n",
+	strings.Join(DisallowedMathFunctions, "\", \""))
+
+// keywords after which a slash starts a regular expression instead of a division.
+var regexPrecedingKeywords = map[string]bool{
+	"return": true, "typeof": true, "instanceof": true, "in": true, "of": true,
+	"new": true, "delete": true, "void": true, "throw": true, "case": true,
+	"do": true, "else": true, "yield": true, "await": true,
+}
+
+type numericTokenKind int
+
+const (
+	numericTokenNone numericTokenKind = iota
+	numericTokenIdent
+	numericTokenNumber
+	numericTokenClose
+	numericTokenPunct
+)
+
+type numericToken struct {
+	kind numericTokenKind
+	text string
+}
+
+// numericScanner is a lexer of the contract source, only precise enough to
+// find the numeric literals and the member accesses outside of the strings,
+// templates, regular expressions and comments.
+type numericScanner struct {
+	src   string
+	pos   int
+	line  int
+	prev  numericToken
+	prev2 numericToken
+}
+
+// CheckNumericPolicy check the contract source uses no float literal nor
+// nondeterministic Math function, the floats are handled by BigNumber.
+func CheckNumericPolicy(source string) error {
+	s := &numericScanner{src: source, line: 1}
+	if err := s.scan(false); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"line": s.line,
+			"err":  err,
+		}).Debug("Contract breaks the numeric policy.")
+		return err
+	}
+	return nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (s *numericScanner) peek(offset int) byte {
+	if s.pos+offset < len(s.src) {
+		return s.src[s.pos+offset]
+	}
+	return 0
+}
+
+func (s *numericScanner) push(kind numericTokenKind, text string) {
+	s.prev2 = s.prev
+	s.prev = numericToken{kind: kind, text: text}
+}
+
+func (s *numericScanner) regexAllowed() bool {
+	switch s.prev.kind {
+	case numericTokenNumber, numericTokenClose:
+		return false
+	case numericTokenIdent:
+		return regexPrecedingKeywords[s.prev.text]
+	}
+	return true
+}
+
+// scan the tokens to the end, or to the closing brace of a template substitution.
+func (s *numericScanner) scan(inTemplate bool) error {
+	depth := 0
+	for s.pos < len(s.src) {
+		c := s.src[s.pos]
+		switch {
+		case c == '\n':
+			s.line++
+			s.pos++
+		case c == ' ' || c == '\t' || c == '\r':
+			s.pos++
+		case c == '/' && s.peek(1) == '/':
+			for s.pos < len(s.src) && s.src[s.pos] != '\n' {
+				s.pos++
+			}
+		case c == '/' && s.peek(1) == '*':
+			end := strings.Index(s.src[s.pos+2:], "*/")
+			if end < 0 {
+				return ErrUnterminatedContractLiteral
+			}
+			s.line += strings.Count(s.src[s.pos:s.pos+2+end], "\n")
+			s.pos += end + 4
+		case c == '"' || c == '\'':
+			if err := s.skipString(c); err != nil {
+				return err
+			}
+			s.push(numericTokenClose, "")
+		case c == '`':
+			if err := s.skipTemplate(); err != nil {
+				return err
+			}
+			s.push(numericTokenClose, "")
+		case c == '/' && s.regexAllowed():
+			if err := s.skipRegex(); err != nil {
+				return err
+			}
+			s.push(numericTokenClose, "")
+		case isDigit(c) || (c == '.' && isDigit(s.peek(1))):
+			if err := s.scanNumber(); err != nil {
+				return err
+			}
+		case isIdentStart(c):
+			start := s.pos
+			for s.pos < len(s.src) && (isIdentStart(s.src[s.pos]) || isDigit(s.src[s.pos])) {
+				s.pos++
+			}
+			ident := s.src[start:s.pos]
+			if s.prev.kind == numericTokenPunct && s.prev.text == "." &&
+				s.prev2.kind == numericTokenIdent && s.prev2.text == "Math" {
+				for _, name := range DisallowedMathFunctions {
+					if ident == name {
+						return ErrMathFunctionNotAllowed
+					}
+				}
+			}
+			s.push(numericTokenIdent, ident)
+		default:
+			s.pos++
+			switch c {
+			case '{':
+				depth++
+				s.push(numericTokenPunct, "{")
+			case '}':
+				if inTemplate && depth == 0 {
+					return nil
+				}
+				depth--
+				s.push(numericTokenClose, "}")
+			case ')', ']':
+				s.push(numericTokenClose, string(c))
+			default:
+				s.push(numericTokenPunct, string(c))
+			}
+		}
+	}
+	if inTemplate {
+		return ErrUnterminatedContractLiteral
+	}
+	return nil
+}
+
+func (s *numericScanner) scanNumber() error {
+	if s.src[s.pos] == '0' && strings.ContainsRune("xXoObB", rune(s.peek(1))) {
+		s.pos += 2
+		for s.pos < len(s.src) && (isIdentStart(s.src[s.pos]) || isDigit(s.src[s.pos])) {
+			s.pos++
+		}
+		s.push(numericTokenNumber, "")
+		return nil
+	}
+	for s.pos < len(s.src) && isDigit(s.src[s.pos]) {
+		s.pos++
+	}
+	if c := s.peek(0); c == '.' || c == 'e' || c == 'E' {
+		return ErrFloatLiteralNotAllowed
+	}
+	s.push(numericTokenNumber, "")
+	return nil
+}
+
+func (s *numericScanner) skipString(quote byte) error {
+	for s.pos++; s.pos < len(s.src); s.pos++ {
+		switch s.src[s.pos] {
+		case '\\':
+			s.pos++
+		case '\n':
+			return ErrUnterminatedContractLiteral
+		case quote:
+			s.pos++
+			return nil
+		}
+	}
+	return ErrUnterminatedContractLiteral
+}
+
+func (s *numericScanner) skipTemplate() error {
+	for s.pos++; s.pos < len(s.src); s.pos++ {
+		switch s.src[s.pos] {
+		case '\\':
+			s.pos++
+		case '\n':
+			s.line++
+		case '`':
+			s.pos++
+			return nil
+		case '$':
+			if s.peek(1) == '{' {
+				s.pos += 2
+				s.push(numericTokenPunct, "{")
+				if err := s.scan(true); err != nil {
+					return err
+				}
+				// at the closing brace of the substitution.
+				s.pos--
+			}
+		}
+	}
+	return ErrUnterminatedContractLiteral
+}
+
+func (s *numericScanner) skipRegex() error {
+	inClass := false
+	for s.pos++; s.pos < len(s.src); s.pos++ {
+		switch s.src[s.pos] {
+		case '\\':
+			s.pos++
+		case '\n':
+			return ErrUnterminatedContractLiteral
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '/':
+			if !inClass {
+				s.pos++
+				for s.pos < len(s.src) && isIdentStart(s.src[s.pos]) {
+					s.pos++
+				}
+				return nil
+			}
+		}
+	}
+	return ErrUnterminatedContractLiteral
+}
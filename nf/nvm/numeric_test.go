@@ -0,0 +1,101 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckNumericPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		source      string
+		expectedErr error
+	}{
+		{"integers", "var a = 1 + 0x1f - 0o7 * 0b1 / 3; a = a % 2;", nil},
+		{"member access", "var a = [1, 2]; a.length; Math.floor(a[0] / 2); Math.max(1, 2);", nil},
+		{"float literal", "var a = 1.5;", ErrFloatLiteralNotAllowed},
+		{"leading dot float", "var a = .5;", ErrFloatLiteralNotAllowed},
+		{"exponent", "var a = 1e18;", ErrFloatLiteralNotAllowed},
+		{"trailing dot", "var a = 1..toString();", ErrFloatLiteralNotAllowed},
+		{"in string", "var a = \"1.5\" + '2.5e3' + \"\\\"3.5\";", nil},
+		{"in comment", "// 1.5\n/* Math.random() 2.5 */ var a = 1;", nil},
+		{"in regex", "var r = /1.5|Math.sin/g; var b = 4 / 2 / 1;", nil},
+		{"regex after return", "function f() { return /1.5/.test('1.5'); }", nil},
+		{"in template", "var a = `1.5 ${1 + 2} 2.5`;", nil},
+		{"template substitution", "var a = `x ${ {b: 1.5}.b } y`;", ErrFloatLiteralNotAllowed},
+		{"math random", "var a = Math.random();", ErrMathFunctionNotAllowed},
+		{"math pow", "var a = Math . pow(2, 3);", ErrMathFunctionNotAllowed},
+		{"other random", "var a = { random: 1 }; a.random;", nil},
+		{"unterminated string", "var a = \"1;", ErrUnterminatedContractLiteral},
+		{"unterminated comment", "/* 1.5", ErrUnterminatedContractLiteral},
+		{"unterminated template", "var a = `${1", ErrUnterminatedContractLiteral},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedErr, CheckNumericPolicy(tt.source))
+		})
+	}
+
+	for _, filepath := range []string{"test/ERC20.js", "test/bank_vault_contract.js", "test/bank_vault_contract.ts", "test/contract_rectangle.js", "test/sample_contract.js"} {
+		data, err := ioutil.ReadFile(filepath)
+		assert.Nil(t, err, "filepath read error")
+		assert.Nil(t, CheckNumericPolicy(string(data)), filepath)
+	}
+}
+
+func TestNumericPolicyFork(t *testing.T) {
+	floatSource := "var C = function () {}; C.prototype = { init: function () { return 1.5; } }; module.exports = C;"
+	mathSource := "var C = function () {}; C.prototype = { init: function () { return Math[\"rand\" + \"om\"](); } }; module.exports = C;"
+
+	tests := []struct {
+		name                string
+		source              string
+		beforeNumericPolicy bool
+		expectedErr         error
+	}{
+		{"float literal before fork", floatSource, true, nil},
+		{"math function before fork", mathSource, true, nil},
+		{"float literal", floatSource, false, ErrFloatLiteralNotAllowed},
+		{"math function", mathSource, false, ErrExecutionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mem, _ := storage.NewMemoryStorage()
+			context, _ := state.NewAccountState(nil, mem)
+			owner := context.GetOrCreateUserAccount([]byte("account1"))
+			owner.AddBalance(util.NewUint128FromInt(10000000))
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+
+			ctx := NewContext(&mockBlock{beforeNumericPolicy: tt.beforeNumericPolicy}, testContextTransaction(), owner, contract, context)
+			engine := NewV8Engine(ctx)
+			engine.SetExecutionLimits(10000, 10000000)
+			assert.Equal(t, tt.expectedErr, engine.DeployAndInit(tt.source, "js", ""))
+			engine.Dispose()
+		})
+	}
+}
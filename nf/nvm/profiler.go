@@ -0,0 +1,184 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// RootFrame is the frame of the instructions executed out of any function.
+const RootFrame = "(root)"
+
+type profilerKey struct{}
+
+// FunctionProfile is the gas attributed to a contract function.
+type FunctionProfile struct {
+	// Frame is the "name:line" of the function.
+	Frame string
+	// Self is the gas of the instructions executed in the function itself.
+	Self uint64
+	// Total is the gas of the function including its callees.
+	Total uint64
+}
+
+// Profiler attributes the executed instructions of the contract to the
+// stacks of the functions executing them. The profile is only collected
+// when simulating a transaction, it is not part of the consensus.
+type Profiler struct {
+	stack   []string
+	last    uint64
+	samples map[string]uint64
+}
+
+// NewProfiler returns a new profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{
+		stack:   []string{RootFrame},
+		samples: make(map[string]uint64),
+	}
+}
+
+// NewProfilerContext returns a copy of ctx carrying the profiler, the
+// payloads executed in ctx are profiled.
+func NewProfilerContext(ctx context.Context, p *Profiler) context.Context {
+	return context.WithValue(ctx, profilerKey{}, p)
+}
+
+// ProfilerFromContext returns the profiler carried by ctx, or nil.
+func ProfilerFromContext(ctx context.Context) *Profiler {
+	p, _ := ctx.Value(profilerKey{}).(*Profiler)
+	return p
+}
+
+// sample attributes the instructions executed since the last sample to the current stack.
+func (p *Profiler) sample(count uint64) {
+	if count > p.last {
+		p.samples[strings.Join(p.stack, ";")] += count - p.last
+	}
+	p.last = count
+}
+
+// Enter is called when the function frame is entered, count is the number of the executed instructions.
+func (p *Profiler) Enter(frame string, count uint64) {
+	p.sample(count)
+	p.stack = append(p.stack, frame)
+}
+
+// Exit is called when the current function returns.
+func (p *Profiler) Exit(count uint64) {
+	p.sample(count)
+	if len(p.stack) > 1 {
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+}
+
+// Finish attributes the rest of the instructions when the execution is done,
+// the profiler is ready for the next execution.
+func (p *Profiler) Finish(count uint64) {
+	p.sample(count)
+	p.stack = p.stack[:1]
+	p.last = 0
+}
+
+// Total returns the gas attributed to all the stacks.
+func (p *Profiler) Total() uint64 {
+	total := uint64(0)
+	for _, v := range p.samples {
+		total += v
+	}
+	return total
+}
+
+// Folded returns the profile in the folded stacks format of the flame graph
+// tools, one "frame;frame;frame gas" line per stack, sorted by the stack.
+func (p *Profiler) Folded() []string {
+	lines := make([]string, 0, len(p.samples))
+	for stack, v := range p.samples {
+		lines = append(lines, fmt.Sprintf("%s %d", stack, v))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+// Functions returns the gas attributed to each function, sorted by the total gas in descending order.
+func (p *Profiler) Functions() []*FunctionProfile {
+	functions := make(map[string]*FunctionProfile)
+	get := func(frame string) *FunctionProfile {
+		f := functions[frame]
+		if f == nil {
+			f = &FunctionProfile{Frame: frame}
+			functions[frame] = f
+		}
+		return f
+	}
+
+	for stack, v := range p.samples {
+		frames := strings.Split(stack, ";")
+		get(frames[len(frames)-1]).Self += v
+
+		// the recursive calls are counted once in the total.
+		seen := make(map[string]bool)
+		for _, frame := range frames {
+			if !seen[frame] {
+				seen[frame] = true
+				get(frame).Total += v
+			}
+		}
+	}
+
+	list := make([]*FunctionProfile, 0, len(functions))
+	for _, f := range functions {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Total != list[j].Total {
+			return list[i].Total > list[j].Total
+		}
+		return list[i].Frame < list[j].Frame
+	})
+	return list
+}
+
+// ProfileFunc is called by the engine on the function calls when profiling.
+//export ProfileFunc
+func ProfileFunc(engine unsafe.Pointer, frame *C.char, delta C.int, count C.size_t) {
+	invokeHost(hostProfile, getEngineByEngineHandler(engine), 0, C.GoString(frame), strconv.Itoa(int(delta)), strconv.FormatUint(uint64(count), 10))
+}
+
+func profile(e *V8Engine, _ uint64, args []string) hostResult {
+	if e == nil || e.profiler == nil {
+		return hostResult{}
+	}
+
+	delta, _ := strconv.Atoi(args[1])
+	count, _ := strconv.ParseUint(args[2], 10, 64)
+	if delta > 0 {
+		e.profiler.Enter(args[0], count)
+	} else {
+		e.profiler.Exit(count)
+	}
+	return hostResult{}
+}
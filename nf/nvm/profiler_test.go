@@ -0,0 +1,61 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfiler(t *testing.T) {
+	p := NewProfiler()
+
+	// root 10 -> transfer 5 -> check 20 -> check 3 (recursive) -> transfer 7 -> root 4.
+	p.Enter("transfer:3", 10)
+	p.Enter("check:9", 15)
+	p.Enter("check:9", 35)
+	p.Exit(38)
+	p.Exit(38)
+	p.Exit(45)
+	p.Finish(49)
+
+	assert.Equal(t, uint64(49), p.Total())
+	assert.Equal(t, []string{
+		"(root) 14",
+		"(root);transfer:3 12",
+		"(root);transfer:3;check:9 20",
+		"(root);transfer:3;check:9;check:9 3",
+	}, p.Folded())
+
+	functions := p.Functions()
+	assert.Equal(t, 3, len(functions))
+	assert.Equal(t, &FunctionProfile{Frame: RootFrame, Self: 14, Total: 49}, functions[0])
+	assert.Equal(t, &FunctionProfile{Frame: "transfer:3", Self: 12, Total: 35}, functions[1])
+	assert.Equal(t, &FunctionProfile{Frame: "check:9", Self: 23, Total: 23}, functions[2])
+
+	// the profiler is reset for the next execution.
+	p.Enter("transfer:3", 2)
+	p.Finish(2)
+	assert.Equal(t, uint64(51), p.Total())
+
+	assert.Nil(t, ProfilerFromContext(context.Background()))
+	assert.Equal(t, p, ProfilerFromContext(NewProfilerContext(context.Background(), p)))
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+import (
+	"context"
+	"fmt"
+	"unsafe"
+)
+
+type resultKey struct{}
+
+// CallResult receives the value returned by the contract function called.
+// It is only collected when simulating a transaction, the script of the
+// contract is unchanged otherwise.
+type CallResult struct {
+	// Value is the json of the returned value, empty if nothing is returned.
+	Value string
+}
+
+// NewResultContext returns a copy of ctx carrying the result, the value
+// returned by the contract function called in ctx is kept in it.
+func NewResultContext(ctx context.Context, r *CallResult) context.Context {
+	return context.WithValue(ctx, resultKey{}, r)
+}
+
+// ResultFromContext returns the result carried by ctx, or nil.
+func ResultFromContext(ctx context.Context) *CallResult {
+	r, _ := ctx.Value(resultKey{}).(*CallResult)
+	return r
+}
+
+// CallResultFunc export CallResultFunc
+//export CallResultFunc
+func CallResultFunc(handler unsafe.Pointer, value *C.char) {
+	invokeHost(hostCallResult, getEngineByEngineHandler(handler), 0, C.GoString(value))
+}
+
+func callResult(e *V8Engine, _ uint64, args []string) hostResult {
+	if e != nil && e.result != nil {
+		e.result.Value = args[0]
+	}
+	return hostResult{}
+}
+
+// resultStatement returns the statement running call, passing its value back
+// to the engine through its own native function. The value is always passed
+// once call returns, so whatever the contract passed before is overwritten.
+func resultStatement(call string) string {
+	return fmt.Sprintf("var __result = JSON.stringify(%s);\n _native_call_result(__result === undefined ? \"\" : __result);\n", call)
+}
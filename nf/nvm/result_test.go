@@ -0,0 +1,47 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallResult(t *testing.T) {
+	assert.Nil(t, ResultFromContext(context.Background()))
+	r := &CallResult{}
+	assert.Equal(t, r, ResultFromContext(NewResultContext(context.Background(), r)))
+
+	// the returned value is passed back through its own native function,
+	// the events of the contract never reach the result.
+	statement := resultStatement("__instance[\"balanceOf\"].apply(__instance)")
+	assert.True(t, strings.Contains(statement, "__instance[\"balanceOf\"].apply(__instance)"))
+	assert.True(t, strings.Contains(statement, "_native_call_result("))
+	assert.False(t, strings.Contains(statement, "_native_event_trigger"))
+
+	e := &V8Engine{result: r}
+	callResult(e, 0, []string{`"100"`})
+	assert.Equal(t, `"100"`, r.Value)
+
+	callResult(&V8Engine{}, 0, []string{`"200"`})
+	assert.Equal(t, `"100"`, r.Value)
+}
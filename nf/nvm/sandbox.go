@@ -0,0 +1,501 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"encoding/gob"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// SandboxEnv is set to the lib dirs of the engine in the environment of the
+// sandbox process, separated by colons. The process restricts itself on start when it's set, see
+// sandbox_os.go, so a contract escaping the engine can neither read nor write
+// any file out of the lib dir, open a socket or run a program.
+const SandboxEnv = "NEB_NVM_SANDBOX"
+
+const (
+	// sandboxMaxIdle is the max number of idle sandbox processes kept for reuse.
+	sandboxMaxIdle = 8
+
+	// sandboxTimeout kills the sandbox process not done in time, longer than
+	// the execution timeout enforced by the process itself.
+	sandboxTimeout = 15 * time.Second
+)
+
+// Errors
+var (
+	ErrSandboxFailed   = errors.New("contract sandbox process failed")
+	ErrSandboxProtocol = errors.New("invalid message of contract sandbox process")
+)
+
+// Kinds of the messages between the node and the sandbox process.
+const (
+	sandboxReadyMsg = "ready"
+	sandboxRunMsg   = "run"
+	sandboxCallMsg  = "call"
+	sandboxReplyMsg = "reply"
+	sandboxDoneMsg  = "done"
+)
+
+// sandboxRun is the script the node asks the sandbox process to run, with the
+// storage handlers of the engine in the node the host functions are called with.
+type sandboxRun struct {
+	Source                        string
+	LineOffset                    int
+	LcsHandler                    uint64
+	GcsHandler                    uint64
+	EnableLimits                  bool
+	VMLimits                      bool
+	LimitsOfExecutionInstructions uint64
+	LimitsOfTotalMemorySize       uint64
+	Testing                       bool
+	Profiling                     bool
+}
+
+// sandboxCall is a host function called by the contract in the sandbox process.
+type sandboxCall struct {
+	Func    string
+	Handler uint64
+	Args    []string
+}
+
+// sandboxDone is the result of the script run in the sandbox process.
+type sandboxDone struct {
+	Err                   string
+	ExecutionInstructions uint64
+	TotalMemorySize       uint64
+}
+
+// sandboxReady is sent by the sandbox process on start with the restrictions
+// it applied.
+type sandboxReady struct {
+	Landlock bool
+	Seccomp  bool
+}
+
+type sandboxRequest struct {
+	Kind  string
+	Run   sandboxRun
+	Reply hostResult
+}
+
+type sandboxResponse struct {
+	Kind  string
+	Ready sandboxReady
+	Call  sandboxCall
+	Done  sandboxDone
+}
+
+// hostFuncArgs is the number of args of the host functions, checked before
+// calling them for the sandbox process.
+var hostFuncArgs = map[string]int{
+	hostStorageGet:     1,
+	hostStoragePut:     2,
+	hostStorageDel:     1,
+	hostGetTxByHash:    1,
+	hostGetAccount:     1,
+	hostTransfer:       2,
+	hostVerifyAddress:  1,
+	hostSendMessage:    4,
+	hostRecoverAddress: 3,
+	hostEventTrigger:   2,
+	hostCallResult:     1,
+	hostRequire:        1,
+	hostProfile:        3,
+}
+
+// sandboxErrors are the errors of the engine returned by the sandbox process.
+var sandboxErrors = []error{
+	ErrExecutionFailed,
+	ErrExecutionTimeout,
+	ErrExecutionCanceled,
+	ErrInsufficientGas,
+	ErrExceedMemoryLimits,
+	ErrExceedCallDepthLimits,
+	ErrExceedStorageObjectLimits,
+	ErrInjectTracingInstructionFailed,
+}
+
+// sandbox starts the sandbox processes running the contracts.
+type sandbox struct {
+	path string
+	dir  string
+	env  []string
+
+	mu   sync.Mutex
+	idle []*sandboxProcess
+	once sync.Once
+}
+
+var sandboxes *sandbox
+
+// EnableSandbox runs the contracts in sandbox processes of this executable,
+// instead of the engine of the node process, so a contract escaping the
+// engine can't reach the keystore or the chain database. The executable
+// must call ServeSandbox on start when IsSandboxProcess.
+func EnableSandbox() error {
+	path, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	libDirs, err := sandboxLibDirs("lib")
+	if err != nil {
+		return err
+	}
+
+	// only the lib dirs and the path of the shared libraries are passed, the
+	// secrets in the environment of the node are not.
+	env := []string{SandboxEnv + "=" + strings.Join(libDirs, ":")}
+	for _, name := range []string{"LD_LIBRARY_PATH", "DYLD_LIBRARY_PATH"} {
+		if v := os.Getenv(name); len(v) > 0 {
+			env = append(env, name+"="+v)
+		}
+	}
+	sandboxes = &sandbox{path: path, dir: dir, env: env}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"executable": path,
+		"lib":        libDirs,
+	}).Info("Contracts run in sandbox processes.")
+	return nil
+}
+
+// sandboxLibDirs returns the dirs of the files in the lib dir, the files are
+// symbolic links to the sources of the engine.
+func sandboxLibDirs(lib string) ([]string, error) {
+	dir, err := filepath.EvalSymlinks(lib)
+	if err != nil {
+		return nil, err
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := []string{dir}
+	seen := map[string]bool{dir: true}
+	for _, f := range files {
+		path, err := filepath.EvalSymlinks(filepath.Join(dir, f.Name()))
+		if err != nil {
+			continue
+		}
+		if d := filepath.Dir(path); !seen[d] {
+			seen[d] = true
+			dirs = append(dirs, d)
+		}
+	}
+	return dirs, nil
+}
+
+// IsSandboxProcess returns whether the process is started as a sandbox process.
+func IsSandboxProcess() bool {
+	return len(os.Getenv(SandboxEnv)) > 0
+}
+
+// sandboxProcess is the pipe to a sandbox process.
+type sandboxProcess struct {
+	cmd *exec.Cmd
+	enc *gob.Encoder
+	dec *gob.Decoder
+}
+
+func (s *sandbox) start() (*sandboxProcess, error) {
+	cmd := exec.Command(s.path)
+	cmd.Dir = s.dir
+	cmd.Env = s.env
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	p := &sandboxProcess{cmd: cmd, enc: gob.NewEncoder(stdin), dec: gob.NewDecoder(stdout)}
+	var resp sandboxResponse
+	if err := p.dec.Decode(&resp); err != nil || resp.Kind != sandboxReadyMsg {
+		p.kill()
+		return nil, ErrSandboxFailed
+	}
+	s.once.Do(func() {
+		entry := logging.CLog().WithFields(logrus.Fields{
+			"landlock": resp.Ready.Landlock,
+			"seccomp":  resp.Ready.Seccomp,
+		})
+		if resp.Ready.Landlock && resp.Ready.Seccomp {
+			entry.Info("Sandbox process started.")
+		} else {
+			entry.Warn("Sandbox process started without all the OS restrictions, only isolated in its process.")
+		}
+	})
+	return p, nil
+}
+
+func (s *sandbox) get() (*sandboxProcess, error) {
+	s.mu.Lock()
+	if n := len(s.idle); n > 0 {
+		p := s.idle[n-1]
+		s.idle = s.idle[:n-1]
+		s.mu.Unlock()
+		return p, nil
+	}
+	s.mu.Unlock()
+	return s.start()
+}
+
+func (s *sandbox) put(p *sandboxProcess) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.idle) >= sandboxMaxIdle {
+		p.kill()
+		return
+	}
+	s.idle = append(s.idle, p)
+}
+
+func (p *sandboxProcess) kill() {
+	p.cmd.Process.Kill()
+	p.cmd.Wait()
+}
+
+// run sends the script to the sandbox process, and calls the host functions
+// for it until it's done. The process is trusted no more than the contract,
+// the calls are checked to only reach the storage of engine e.
+func (p *sandboxProcess) run(e *V8Engine, run *sandboxRun) (*sandboxDone, error) {
+	if err := p.enc.Encode(&sandboxRequest{Kind: sandboxRunMsg, Run: *run}); err != nil {
+		return nil, err
+	}
+	for {
+		var resp sandboxResponse
+		if err := p.dec.Decode(&resp); err != nil {
+			return nil, err
+		}
+		switch resp.Kind {
+		case sandboxDoneMsg:
+			return &resp.Done, nil
+		case sandboxCallMsg:
+		default:
+			return nil, ErrSandboxProtocol
+		}
+
+		call := resp.Call
+		fn, ok := hostFuncs[call.Func]
+		if !ok || len(call.Args) != hostFuncArgs[call.Func] {
+			return nil, ErrSandboxProtocol
+		}
+		if call.Handler != 0 && call.Handler != e.lcsHandler && call.Handler != e.gcsHandler {
+			return nil, ErrSandboxProtocol
+		}
+		reply := fn(e, call.Handler, call.Args)
+		if err := p.enc.Encode(&sandboxRequest{Kind: sandboxReplyMsg, Reply: reply}); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// runInSandbox runs the script in a sandbox process instead of the engine.
+func (e *V8Engine) runInSandbox(source string, sourceLineOffset int) error {
+	p, err := sandboxes.get()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to start sandbox process.")
+		return ErrSandboxFailed
+	}
+
+	run := &sandboxRun{
+		Source:                        source,
+		LineOffset:                    sourceLineOffset,
+		LcsHandler:                    e.lcsHandler,
+		GcsHandler:                    e.gcsHandler,
+		EnableLimits:                  e.enableLimits,
+		VMLimits:                      e.vmLimits,
+		LimitsOfExecutionInstructions: e.limitsOfExecutionInstructions,
+		LimitsOfTotalMemorySize:       e.limitsOfTotalMemorySize,
+		Testing:                       e.testing,
+		Profiling:                     e.profiler != nil,
+	}
+
+	type result struct {
+		done *sandboxDone
+		err  error
+	}
+	resultCh := make(chan *result, 1)
+	go func() {
+		done, err := p.run(e, run)
+		resultCh <- &result{done, err}
+	}()
+
+	var r *result
+	select {
+	case r = <-resultCh:
+	case <-time.After(sandboxTimeout):
+		p.kill()
+		<-resultCh
+		return ErrExecutionTimeout
+	case <-e.cancelCtx.Done():
+		p.kill()
+		<-resultCh
+		return ErrExecutionCanceled
+	}
+	if r.err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": r.err,
+		}).Error("Sandbox process failed.")
+		p.kill()
+		return ErrSandboxFailed
+	}
+	sandboxes.put(p)
+
+	e.actualCountOfExecutionInstructions = r.done.ExecutionInstructions
+	e.actualTotalMemorySize = r.done.TotalMemorySize
+	if e.profiler != nil {
+		e.profiler.Finish(e.actualCountOfExecutionInstructions)
+	}
+	if len(r.done.Err) == 0 {
+		return nil
+	}
+	for _, err := range sandboxErrors {
+		if err.Error() == r.done.Err {
+			return err
+		}
+	}
+	return errors.New(r.done.Err)
+}
+
+// sandboxClient is the pipe of the sandbox process to the node.
+type sandboxClient struct {
+	mu  sync.Mutex
+	enc *gob.Encoder
+	dec *gob.Decoder
+}
+
+var (
+	currentClient     *sandboxClient
+	currentClientLock sync.RWMutex
+)
+
+func currentSandboxClient() *sandboxClient {
+	currentClientLock.RLock()
+	defer currentClientLock.RUnlock()
+	return currentClient
+}
+
+func setSandboxClient(client *sandboxClient) {
+	currentClientLock.Lock()
+	defer currentClientLock.Unlock()
+	currentClient = client
+}
+
+// call a host function in the node, a broken pipe fails the call.
+func (c *sandboxClient) call(name string, handler uint64, args []string) hostResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resp := &sandboxResponse{Kind: sandboxCallMsg, Call: sandboxCall{Func: name, Handler: handler, Args: args}}
+	if err := c.enc.Encode(resp); err != nil {
+		return hostResult{Code: 1, Nil: true}
+	}
+	var req sandboxRequest
+	if err := c.dec.Decode(&req); err != nil || req.Kind != sandboxReplyMsg {
+		return hostResult{Code: 1, Nil: true}
+	}
+	return req.Reply
+}
+
+// ServeSandbox runs the scripts sent by the node over stdin and stdout, until
+// the node closes the pipe.
+func ServeSandbox() error {
+	return serveSandbox(os.Stdin, os.Stdout)
+}
+
+func serveSandbox(r io.Reader, w io.Writer) error {
+	client := &sandboxClient{enc: gob.NewEncoder(w), dec: gob.NewDecoder(r)}
+	setSandboxClient(client)
+	defer setSandboxClient(nil)
+
+	if err := client.enc.Encode(&sandboxResponse{Kind: sandboxReadyMsg, Ready: sandboxRestrictions()}); err != nil {
+		return err
+	}
+	for {
+		var req sandboxRequest
+		if err := client.dec.Decode(&req); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if req.Kind != sandboxRunMsg {
+			return ErrSandboxProtocol
+		}
+		done := runSandboxScript(&req.Run)
+		if err := client.enc.Encode(&sandboxResponse{Kind: sandboxDoneMsg, Done: *done}); err != nil {
+			return err
+		}
+	}
+}
+
+// runSandboxScript runs the script in the sandbox process by an engine with
+// the storage handlers of the engine in the node.
+func runSandboxScript(run *sandboxRun) *sandboxDone {
+	e := newV8Engine(&Context{}, run.VMLimits)
+	defer e.Dispose()
+
+	e.setStorageHandlers(run.LcsHandler, run.GcsHandler)
+	e.SetTestingFlag(run.Testing)
+	if run.EnableLimits {
+		e.SetExecutionLimits(run.LimitsOfExecutionInstructions, run.LimitsOfTotalMemorySize)
+	}
+	if run.Profiling {
+		// the calls are profiled by the profiler in the node.
+		e.SetProfiler(NewProfiler())
+	}
+
+	done := &sandboxDone{}
+	if err := e.RunScriptSource(run.Source, run.LineOffset); err != nil {
+		done.Err = err.Error()
+	}
+	done.ExecutionInstructions = e.actualCountOfExecutionInstructions
+	done.TotalMemorySize = e.actualTotalMemorySize
+	return done
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+/*
+#ifndef _GNU_SOURCE
+#define _GNU_SOURCE
+#endif
+
+#include <stdlib.h>
+
+#ifdef __linux__
+#include <errno.h>
+#include <fcntl.h>
+#include <sched.h>
+#include <stddef.h>
+#include <stdint.h>
+#include <string.h>
+#include <unistd.h>
+#include <linux/audit.h>
+#include <linux/filter.h>
+#include <linux/seccomp.h>
+#include <sys/prctl.h>
+#include <sys/syscall.h>
+
+#ifndef __NR_landlock_create_ruleset
+#define __NR_landlock_create_ruleset 444
+#define __NR_landlock_add_rule 445
+#define __NR_landlock_restrict_self 446
+#endif
+
+#ifndef __NR_clone3
+#define __NR_clone3 435
+#endif
+
+// Landlock ABI 1, declared here for the systems without linux/landlock.h.
+#define NVM_LANDLOCK_ACCESS_FS_READ_FILE (1ULL << 2)
+#define NVM_LANDLOCK_ACCESS_FS_READ_DIR (1ULL << 3)
+#define NVM_LANDLOCK_ACCESS_FS_ALL ((1ULL << 13) - 1)
+#define NVM_LANDLOCK_RULE_PATH_BENEATH 1
+
+struct nvm_landlock_ruleset_attr {
+	uint64_t handled_access_fs;
+};
+
+struct nvm_landlock_path_beneath_attr {
+	uint64_t allowed_access;
+	int32_t parent_fd;
+} __attribute__((packed));
+
+// nvm_landlock denies all the file accesses but reading the lib dirs separated by colons.
+static int nvm_landlock(const char *libDirs) {
+	struct nvm_landlock_ruleset_attr attr = {NVM_LANDLOCK_ACCESS_FS_ALL};
+	int ruleset = syscall(__NR_landlock_create_ruleset, &attr, sizeof(attr), 0);
+	if (ruleset < 0) {
+		return -1;
+	}
+
+	char *dirs = strdup(libDirs);
+	char *save = NULL;
+	for (char *dir = strtok_r(dirs, ":", &save); dir != NULL; dir = strtok_r(NULL, ":", &save)) {
+		struct nvm_landlock_path_beneath_attr rule = {
+			NVM_LANDLOCK_ACCESS_FS_READ_FILE | NVM_LANDLOCK_ACCESS_FS_READ_DIR, -1};
+		rule.parent_fd = open(dir, O_PATH | O_CLOEXEC);
+		if (rule.parent_fd >= 0) {
+			syscall(__NR_landlock_add_rule, ruleset, NVM_LANDLOCK_RULE_PATH_BENEATH, &rule, 0);
+			close(rule.parent_fd);
+		}
+	}
+	free(dirs);
+
+	int ret = syscall(__NR_landlock_restrict_self, ruleset, 0);
+	close(ruleset);
+	return ret;
+}
+
+#if defined(__x86_64__)
+#define NVM_AUDIT_ARCH AUDIT_ARCH_X86_64
+#elif defined(__aarch64__)
+#define NVM_AUDIT_ARCH AUDIT_ARCH_AARCH64
+#endif
+
+#define NVM_DENY(nr) \
+	BPF_JUMP(BPF_JMP | BPF_JEQ | BPF_K, (nr), 0, 1), \
+	BPF_STMT(BPF_RET | BPF_K, SECCOMP_RET_ERRNO | EPERM)
+
+// nvm_seccomp denies the network, running programs, creating processes and
+// accessing the memory of the other processes.
+static int nvm_seccomp() {
+#ifdef NVM_AUDIT_ARCH
+	struct sock_filter filter[] = {
+		BPF_STMT(BPF_LD | BPF_W | BPF_ABS, offsetof(struct seccomp_data, arch)),
+		BPF_JUMP(BPF_JMP | BPF_JEQ | BPF_K, NVM_AUDIT_ARCH, 1, 0),
+		BPF_STMT(BPF_RET | BPF_K, SECCOMP_RET_KILL),
+		BPF_STMT(BPF_LD | BPF_W | BPF_ABS, offsetof(struct seccomp_data, nr)),
+		NVM_DENY(__NR_socket),
+		NVM_DENY(__NR_connect),
+		NVM_DENY(__NR_bind),
+		NVM_DENY(__NR_listen),
+		NVM_DENY(__NR_accept),
+		NVM_DENY(__NR_accept4),
+		NVM_DENY(__NR_execve),
+#ifdef __NR_execveat
+		NVM_DENY(__NR_execveat),
+#endif
+#ifdef __NR_fork
+		NVM_DENY(__NR_fork),
+#endif
+#ifdef __NR_vfork
+		NVM_DENY(__NR_vfork),
+#endif
+		NVM_DENY(__NR_ptrace),
+		NVM_DENY(__NR_process_vm_readv),
+		NVM_DENY(__NR_process_vm_writev),
+		// the flags of clone3 can't be checked, libc falls back to clone.
+		BPF_JUMP(BPF_JMP | BPF_JEQ | BPF_K, __NR_clone3, 0, 1),
+		BPF_STMT(BPF_RET | BPF_K, SECCOMP_RET_ERRNO | ENOSYS),
+		// clone is only allowed to create threads.
+		BPF_JUMP(BPF_JMP | BPF_JEQ | BPF_K, __NR_clone, 0, 3),
+		BPF_STMT(BPF_LD | BPF_W | BPF_ABS, offsetof(struct seccomp_data, args)),
+		BPF_JUMP(BPF_JMP | BPF_JSET | BPF_K, CLONE_THREAD, 1, 0),
+		BPF_STMT(BPF_RET | BPF_K, SECCOMP_RET_ERRNO | EPERM),
+		BPF_STMT(BPF_RET | BPF_K, SECCOMP_RET_ALLOW),
+	};
+	struct sock_fprog prog = {sizeof(filter) / sizeof(filter[0]), filter};
+	return prctl(PR_SET_SECCOMP, SECCOMP_MODE_FILTER, &prog, 0, 0);
+#else
+	return -1;
+#endif
+}
+#endif
+
+static int nvm_sandbox_landlock = 0;
+static int nvm_sandbox_seccomp = 0;
+
+// nvm_sandbox_init restricts the sandbox process before the go runtime starts
+// any thread, so all the threads are restricted.
+__attribute__((constructor)) static void nvm_sandbox_init() {
+#ifdef __linux__
+	const char *libDirs = getenv("NEB_NVM_SANDBOX");
+	if (libDirs == NULL || libDirs[0] == 0) {
+		return;
+	}
+	if (prctl(PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0) != 0) {
+		return;
+	}
+	nvm_sandbox_landlock = nvm_landlock(libDirs) == 0;
+	nvm_sandbox_seccomp = nvm_seccomp() == 0;
+#endif
+}
+
+static int nvm_sandbox_landlocked() { return nvm_sandbox_landlock; }
+static int nvm_sandbox_seccomped() { return nvm_sandbox_seccomp; }
+*/
+import "C"
+
+// sandboxRestrictions returns the OS restrictions applied to the sandbox process.
+func sandboxRestrictions() sandboxReady {
+	return sandboxReady{
+		Landlock: C.nvm_sandbox_landlocked() != 0,
+		Seccomp:  C.nvm_sandbox_seccomped() != 0,
+	}
+}
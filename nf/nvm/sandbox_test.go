@@ -0,0 +1,114 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSandbox answers the run of the node by the calls and result of a
+// sandbox process.
+func fakeSandbox(calls []sandboxCall, done sandboxDone) (*sandboxProcess, chan []hostResult) {
+	nodeR, sandboxW := io.Pipe()
+	sandboxR, nodeW := io.Pipe()
+	p := &sandboxProcess{enc: gob.NewEncoder(nodeW), dec: gob.NewDecoder(nodeR)}
+
+	repliesCh := make(chan []hostResult, 1)
+	go func() {
+		enc, dec := gob.NewEncoder(sandboxW), gob.NewDecoder(sandboxR)
+		replies := []hostResult{}
+		defer func() { repliesCh <- replies }()
+
+		var req sandboxRequest
+		if err := dec.Decode(&req); err != nil || req.Kind != sandboxRunMsg {
+			return
+		}
+		for _, call := range calls {
+			if err := enc.Encode(&sandboxResponse{Kind: sandboxCallMsg, Call: call}); err != nil {
+				return
+			}
+			var reply sandboxRequest
+			if err := dec.Decode(&reply); err != nil {
+				return
+			}
+			replies = append(replies, reply.Reply)
+		}
+		enc.Encode(&sandboxResponse{Kind: sandboxDoneMsg, Done: done})
+	}()
+	return p, repliesCh
+}
+
+func TestSandboxProcessRun(t *testing.T) {
+	e := &V8Engine{lcsHandler: 1001, gcsHandler: 1002}
+	run := &sandboxRun{Source: "1 + 1", LcsHandler: e.lcsHandler, GcsHandler: e.gcsHandler}
+
+	calls := []sandboxCall{
+		{Func: hostStorageGet, Handler: e.lcsHandler, Args: []string{"key"}},
+		{Func: hostVerifyAddress, Handler: e.lcsHandler, Args: []string{"addr"}},
+	}
+	p, repliesCh := fakeSandbox(calls, sandboxDone{Err: ErrInsufficientGas.Error(), ExecutionInstructions: 100})
+	done, err := p.run(e, run)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInsufficientGas.Error(), done.Err)
+	assert.Equal(t, uint64(100), done.ExecutionInstructions)
+	// the engine is not registered, the storage is not found.
+	assert.Equal(t, []hostResult{hostNil, {Code: 0}}, <-repliesCh)
+
+	tests := []struct {
+		name string
+		call sandboxCall
+	}{
+		{"unknown function", sandboxCall{Func: "os.exec", Handler: e.lcsHandler, Args: []string{"sh"}}},
+		{"wrong args", sandboxCall{Func: hostStoragePut, Handler: e.lcsHandler, Args: []string{"key"}}},
+		{"storage of other engine", sandboxCall{Func: hostStorageGet, Handler: 2001, Args: []string{"key"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, _ := fakeSandbox([]sandboxCall{tt.call}, sandboxDone{})
+			_, err := p.run(e, run)
+			assert.Equal(t, ErrSandboxProtocol, err)
+		})
+	}
+}
+
+func TestSandboxLibDirs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sandbox")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	src, lib := filepath.Join(dir, "v8", "lib"), filepath.Join(dir, "lib")
+	assert.Nil(t, os.MkdirAll(src, 0755))
+	assert.Nil(t, os.MkdirAll(lib, 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(src, "storage.js"), []byte(""), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(lib, "blockchain.js"), []byte(""), 0644))
+	assert.Nil(t, os.Symlink(filepath.Join(src, "storage.js"), filepath.Join(lib, "storage.js")))
+
+	dirs, err := sandboxLibDirs(lib)
+	assert.Nil(t, err)
+	realLib, _ := filepath.EvalSymlinks(lib)
+	realSrc, _ := filepath.EvalSymlinks(src)
+	assert.Equal(t, []string{realLib, realSrc}, dirs)
+}
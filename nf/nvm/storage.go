@@ -39,13 +39,13 @@ var (
 	keyPattern = regexp.MustCompile("^@([a-zA-Z_].*?)\\[(.+?)\\]$")
 )
 
-// hashStorageKey return the key hash.
+// HashStorageKey return the key hash.
 // There are two kinds of key, the one is ItemKey, the other is Map-ItemKey.
 // ItemKey in SmartContract is used for object storage.
 // For example, the ItemKey for the statement "token.totalSupply = 1000" is "totalSupply".
 // Map-ItemKey in SmartContrat is used for Map storage.
 // For example, the Map-ItemKey for the statement "token.balances.set('addr1', 100)" is "@balances[addr1]".
-func hashStorageKey(key string) []byte {
+func HashStorageKey(key string) []byte {
 	var domainKey, itemKey string
 
 	matches := keyPattern.FindAllStringSubmatch(key, -1)
@@ -63,64 +63,97 @@ func hashStorageKey(key string) []byte {
 // StorageGetFunc export StorageGetFunc
 //export StorageGetFunc
 func StorageGetFunc(handler unsafe.Pointer, key *C.char) *C.char {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	return invokeHost(hostStorageGet, nil, uint64(uintptr(handler)), C.GoString(key)).cString()
+}
+
+func storageGet(_ *V8Engine, handler uint64, args []string) hostResult {
+	_, storage := getEngineByStorageHandler(handler)
 	if storage == nil {
-		return nil
+		return hostNil
 	}
 
-	val, err := storage.Get([]byte(hashStorageKey(C.GoString(key))))
+	val, err := storage.Get([]byte(HashStorageKey(args[0])))
 	if err != nil {
 		if err != ErrKeyNotFound {
 			logging.VLog().WithFields(logrus.Fields{
-				"handler": uint64(uintptr(handler)),
-				"key":     C.GoString(key),
+				"handler": handler,
+				"key":     args[0],
 				"err":     err,
 			}).Error("StorageGetFunc get key failed.")
 		}
-		return nil
+		return hostNil
 	}
 
-	return C.CString(string(val))
+	return hostResult{Value: string(val)}
 }
 
 // StoragePutFunc export StoragePutFunc
 //export StoragePutFunc
 func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	k, v := C.GoString(key), C.GoString(value)
+
+	// the oversized object terminates the engine running the contract, in the
+	// sandbox process if any, from the VM limits fork.
+	if len(k) > MaxStorageKeySize || len(v) > MaxStorageValueSize {
+		engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+		if engine == nil {
+			return 1
+		}
+		if engine.vmLimits {
+			logging.VLog().WithFields(logrus.Fields{
+				"handler":   uint64(uintptr(handler)),
+				"keySize":   len(k),
+				"valueSize": len(v),
+				"err":       ErrExceedStorageObjectLimits,
+			}).Error("StoragePutFunc put key failed.")
+			engine.terminateOnStorageObjectLimits()
+			return 1
+		}
+	}
+
+	return invokeHost(hostStoragePut, nil, uint64(uintptr(handler)), k, v).Code
+}
+
+func storagePut(_ *V8Engine, handler uint64, args []string) hostResult {
+	_, storage := getEngineByStorageHandler(handler)
 	if storage == nil {
-		return 1
+		return hostResult{Code: 1}
 	}
 
-	err := storage.Put([]byte(hashStorageKey(C.GoString(key))), []byte(C.GoString(value)))
+	err := storage.Put([]byte(HashStorageKey(args[0])), []byte(args[1]))
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
-			"handler": uint64(uintptr(handler)),
-			"key":     C.GoString(key),
+			"handler": handler,
+			"key":     args[0],
 			"err":     err,
 		}).Error("StoragePutFunc put key failed.")
-		return 1
+		return hostResult{Code: 1}
 	}
-	return 0
+	return hostResult{}
 }
 
 // StorageDelFunc export StorageDelFunc
 //export StorageDelFunc
 func StorageDelFunc(handler unsafe.Pointer, key *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	return invokeHost(hostStorageDel, nil, uint64(uintptr(handler)), C.GoString(key)).Code
+}
+
+func storageDel(_ *V8Engine, handler uint64, args []string) hostResult {
+	_, storage := getEngineByStorageHandler(handler)
 	if storage == nil {
-		return 1
+		return hostResult{Code: 1}
 	}
 
-	err := storage.Del([]byte(hashStorageKey(C.GoString(key))))
+	err := storage.Del([]byte(HashStorageKey(args[0])))
 
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
-			"handler": uint64(uintptr(handler)),
-			"key":     C.GoString(key),
+			"handler": handler,
+			"key":     args[0],
 			"err":     err,
 		}).Warn("StorageDelFunc del key failed.")
-		return 1
+		return hostResult{Code: 1}
 	}
 
-	return 0
+	return hostResult{}
 }
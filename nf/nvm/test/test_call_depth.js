@@ -0,0 +1,33 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+function deep(n) {
+    return n == 0 ? 0 : deep(n - 1) + 1;
+}
+
+if (deep(100) != 100) {
+    throw new Error("deep(100) should be 100.");
+}
+
+// the exceeding is not catchable.
+try {
+    deep(1000);
+} catch (e) {
+}
+throw new Error("deep(1000) should exceed the call depth limits.");
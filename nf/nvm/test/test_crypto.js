@@ -0,0 +1,58 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+if (typeof _native_crypto === "undefined") {
+    throw new Error("_native_crypto is undefined.");
+}
+
+var digests = {
+    sha256: "8dfd52731ae376870f7622eb64d5b9d5683107924f93ddba7bcc19bbe1a0ee80",
+    sha3256: "77bf6aaa1445aafcb4d6ffe3803d868e3b92cb7663a57d0d48fc3c2bbde35f23",
+    ripemd160: "c8962fc4788bfb5d3d69c690bddf243bc6ad4592",
+};
+
+for (var alg in digests) {
+    var digest = Crypto[alg]("nebulas");
+    if (digest !== digests[alg]) {
+        throw new Error(alg + " digest mismatch: " + digest);
+    }
+}
+
+var before = _instruction_counter.count;
+Crypto.sha256("nebulas");
+if (_instruction_counter.count - before < 72) {
+    throw new Error("sha256 gas not charged.");
+}
+
+try {
+    _native_crypto.hash("md5", "nebulas");
+    throw new Error("md5 should be unsupported.");
+} catch (e) {
+    if (e !== "unsupported hash algorithm") {
+        throw e;
+    }
+}
+
+if (Crypto.recoverAddress(Crypto.SECP256K1, digests.sha256, "00") !== null) {
+    throw new Error("invalid signature should recover nothing.");
+}
+if (Crypto.verify(Crypto.SECP256K1, digests.sha256, "00", "70e30fcae5e7f4b2460faaa9e5b1bd912332ebb5")) {
+    throw new Error("invalid signature should not verify.");
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+// the value is stored in JSON, with the quotes at the max size.
+var value = new Array(64 * 1024 - 1).join("v");
+LocalContractStorage.put("k", value);
+
+// the exceeding is not catchable.
+try {
+    LocalContractStorage.put("k", value + "v");
+} catch (e) {
+}
+throw new Error("the value should exceed the storage object size limits.");
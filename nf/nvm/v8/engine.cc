@@ -37,9 +37,17 @@ using namespace v8;
 
 static Platform *platformPtr = NULL;
 
+// heap constraints of the isolates, above the limits of total memory size.
+static const int kMaxSemiSpaceSizeInMB = 16;
+static const int kMaxOldSpaceSizeInMB = 256;
+
+static ProfileFunc sProfile = NULL;
+
 void PrintException(Local<Context> context, TryCatch &trycatch);
 void EngineLimitsCheckDelegate(Isolate *isolate, size_t count,
                                void *listenerContext);
+void EngineCallDepthDelegate(Isolate *isolate, int delta, const char *frame,
+                             void *listenerContext);
 
 #define STRINGIZE2(s) #s
 #define STRINGIZE(s) STRINGIZE2(s)
@@ -51,6 +59,8 @@ void EngineLimitsCheckDelegate(Isolate *isolate, size_t count,
 static char V8VERSION[] = V8VERSION_STRING;
 char *GetV8Version() { return V8VERSION; }
 
+void InitializeProfiler(ProfileFunc profile) { sProfile = profile; }
+
 void Initialize() {
   // Initialize V8.
   platformPtr = platform::CreateDefaultPlatform();
@@ -70,6 +80,7 @@ void Initialize() {
 
   // Initialize V8Engine.
   SetInstructionCounterIncrListener(EngineLimitsCheckDelegate);
+  SetCallDepthListener(EngineCallDepthDelegate);
 }
 
 void Dispose() {
@@ -81,12 +92,19 @@ void Dispose() {
   }
 }
 
-V8Engine *CreateEngine() {
+V8Engine *CreateEngine(int vm_limits) {
   ArrayBuffer::Allocator *allocator = new ArrayBufferAllocator();
 
   Isolate::CreateParams create_params;
   create_params.array_buffer_allocator = allocator;
 
+  // from the VM limits fork, the heap is bounded by the same constraints on
+  // every host, instead of the defaults of V8 depending on the physical memory.
+  if (vm_limits) {
+    create_params.constraints.set_max_semi_space_size(kMaxSemiSpaceSizeInMB);
+    create_params.constraints.set_max_old_space_size(kMaxOldSpaceSizeInMB);
+  }
+
   Isolate *isolate = Isolate::New(create_params);
 
   // fix bug: https://github.com/nebulasio/go-nebulas/issues/5
@@ -137,10 +155,11 @@ int ExecuteSourceDataDelegate(Isolate *isolate, const char *source,
 }
 
 char *InjectTracingInstructions(V8Engine *e, const char *source,
-                                int *source_line_offset) {
+                                int *source_line_offset, int track_call_depth) {
   TracingContext tContext;
   tContext.source_line_offset = 0;
   tContext.tracable_source = NULL;
+  tContext.track_call_depth = track_call_depth;
 
   Execute(e, source, 0, 0L, 0L, InjectTracingInstructionDelegate,
           (void *)&tContext);
@@ -305,6 +324,32 @@ void EngineLimitsCheckDelegate(Isolate *isolate, size_t count,
   }
 }
 
+void EngineCallDepthDelegate(Isolate *isolate, int delta, const char *frame,
+                             void *listenerContext) {
+  V8Engine *e = static_cast<V8Engine *>(listenerContext);
+  V8EngineStats *stats = &(e->stats);
+
+  if (e->profiling && sProfile != NULL) {
+    sProfile(e, frame, delta, stats->count_of_executed_instructions);
+  }
+
+  if (delta < 0) {
+    if (stats->call_depth > 0) {
+      stats->call_depth--;
+    }
+    return;
+  }
+
+  stats->call_depth++;
+  if (stats->call_depth > stats->max_call_depth) {
+    stats->max_call_depth = stats->call_depth;
+  }
+  if (e->limits_of_call_depth > 0 &&
+      e->limits_of_call_depth < stats->max_call_depth) {
+    TerminateExecution(e);
+  }
+}
+
 int IsEngineLimitsExceeded(V8Engine *e) {
   // TODO: read memory stats everytime may impact the performance.
   ReadMemoryStatistics(e);
@@ -314,6 +359,10 @@ int IsEngineLimitsExceeded(V8Engine *e) {
           e->stats.count_of_executed_instructions) {
     // Reach instruction limits.
     return 1;
+  } else if (e->limits_of_call_depth > 0 &&
+             e->limits_of_call_depth < e->stats.max_call_depth) {
+    // reach call depth limits.
+    return 3;
   } else if (e->limits_of_total_memory_size > 0 &&
              e->limits_of_total_memory_size < e->stats.total_memory_size) {
     // reach memory limits.
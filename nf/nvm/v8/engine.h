@@ -48,7 +48,8 @@ EXPORT void InitializeLogger(LogFunc f);
 // event.
 typedef void (*EventTriggerFunc)(void *handler, const char *topic,
                                  const char *data);
-EXPORT void InitializeEvent(EventTriggerFunc trigger);
+typedef void (*CallResultFunc)(void *handler, const char *value);
+EXPORT void InitializeEvent(EventTriggerFunc trigger, CallResultFunc result);
 
 // storage
 typedef char *(*StorageGetFunc)(void *handler, const char *key);
@@ -63,15 +64,33 @@ typedef char *(*GetTxByHashFunc)(void *handler, const char *hash);
 typedef char *(*GetAccountStateFunc)(void *handler, const char *address);
 typedef int (*TransferFunc)(void *handler, const char *to, const char *value);
 typedef int (*VerifyAddressFunc)(void *handler, const char *address);
+typedef int (*SendMessageFunc)(void *handler, const char *to,
+                               const char *function, const char *args,
+                               const char *gasLimit);
 
 EXPORT void InitializeBlockchain(GetTxByHashFunc getTx,
                                  GetAccountStateFunc getAccount,
                                  TransferFunc transfer,
-                                 VerifyAddressFunc verifyAddress);
+                                 VerifyAddressFunc verifyAddress,
+                                 SendMessageFunc sendMessage);
+
+// crypto
+typedef char *(*CryptoHashFunc)(const char *alg, const char *data,
+                                size_t *gasCnt);
+typedef char *(*CryptoRecoverAddressFunc)(void *handler, int alg,
+                                          const char *hash, const char *sign,
+                                          size_t *gasCnt);
+EXPORT void InitializeCrypto(CryptoHashFunc hash,
+                             CryptoRecoverAddressFunc recoverAddress);
 
 // version
 EXPORT char *GetV8Version();
 
+// profiler, notified of the function calls when the engine is profiling.
+typedef void (*ProfileFunc)(void *engine, const char *frame, int delta,
+                            size_t count);
+EXPORT void InitializeProfiler(ProfileFunc profile);
+
 // require callback.
 typedef char *(*RequireDelegate)(void *handler, const char *filename,
                                  size_t *lineOffset);
@@ -90,6 +109,8 @@ typedef struct V8EngineStats {
   size_t peak_malloced_memory;
   size_t total_array_buffer_size;
   size_t peak_array_buffer_size;
+  size_t call_depth;
+  size_t max_call_depth;
 } V8EngineStats;
 
 typedef struct V8Engine {
@@ -97,22 +118,25 @@ typedef struct V8Engine {
   void *allocator;
   size_t limits_of_executed_instructions;
   size_t limits_of_total_memory_size;
+  size_t limits_of_call_depth;
   int is_requested_terminate_execution;
   int testing;
+  int profiling;
   V8EngineStats stats;
 } V8Engine;
 
 EXPORT void Initialize();
 EXPORT void Dispose();
 
-EXPORT V8Engine *CreateEngine();
+EXPORT V8Engine *CreateEngine(int vm_limits);
 
 EXPORT int RunScriptSource(V8Engine *e, const char *source,
                            int source_line_offset, uintptr_t lcsHandler,
                            uintptr_t gcsHandler);
 
 EXPORT char *InjectTracingInstructions(V8Engine *e, const char *source,
-                                       int *source_line_offset);
+                                       int *source_line_offset,
+                                       int track_call_depth);
 
 EXPORT char *TranspileTypeScriptModule(V8Engine *e, const char *source,
                                        int *source_line_offset);
@@ -16,6 +16,7 @@
         isNumeric = /^-?(\d+(\.\d*)?|\.\d+)(e[+-]?\d+)?$/i,
         mathceil = Math.ceil,
         mathfloor = Math.floor,
+        mathpow = Math.pow,
         notBool = ' not a boolean or binary digit',
         roundingMode = 'rounding mode',
         tooManyDigits = 'number type has more than 15 significant digits',
@@ -2402,7 +2403,7 @@
             if ( !isValidInt( n, -MAX_SAFE_INTEGER, MAX_SAFE_INTEGER, 23, 'exponent' ) &&
               ( !isFinite(n) || i > MAX_SAFE_INTEGER && ( n /= 0 ) ||
                 parseFloat(n) != n && !( n = NaN ) ) || n == 0 ) {
-                k = Math.pow( +x, n );
+                k = mathpow( +x, n );
                 return new BigNumber( m ? k % m : k );
             }
 
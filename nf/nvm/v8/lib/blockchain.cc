@@ -24,14 +24,17 @@ static GetTxByHashFunc sGetTxByHash = NULL;
 static GetAccountStateFunc sGetAccountState = NULL;
 static TransferFunc sTransfer = NULL;
 static VerifyAddressFunc sVerifyAddress = NULL;
+static SendMessageFunc sSendMessage = NULL;
 
 void InitializeBlockchain(GetTxByHashFunc getTx,
                           GetAccountStateFunc getAccount,
-                          TransferFunc transfer, VerifyAddressFunc verifyAddress) {
+                          TransferFunc transfer, VerifyAddressFunc verifyAddress,
+                          SendMessageFunc sendMessage) {
   sGetTxByHash = getTx;
   sGetAccountState = getAccount;
   sTransfer = transfer;
   sVerifyAddress = verifyAddress;
+  sSendMessage = sendMessage;
 }
 
 void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
@@ -59,6 +62,11 @@ void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                PropertyAttribute::ReadOnly));
 
+  blockTpl->Set(String::NewFromUtf8(isolate, "sendMessage"),
+                FunctionTemplate::New(isolate, SendMessageCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
   Local<Object> instance = blockTpl->NewInstance(context).ToLocalChecked();
   instance->SetInternalField(0, External::New(isolate, handler));
 
@@ -177,3 +185,31 @@ void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info) {
   int ret = sVerifyAddress(handler->Value(), *String::Utf8Value(address->ToString()));
   info.GetReturnValue().Set(ret);
 }
+
+// SendMessageCallback
+void SendMessageCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 4) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.sendMessage() requires 4 arguments"));
+    return;
+  }
+
+  for (int i = 0; i < 4; i++) {
+    if (!info[i]->IsString()) {
+      isolate->ThrowException(
+          String::NewFromUtf8(isolate, "arguments must be string"));
+      return;
+    }
+  }
+
+  int ret = sSendMessage(handler->Value(),
+                         *String::Utf8Value(info[0]->ToString()),
+                         *String::Utf8Value(info[1]->ToString()),
+                         *String::Utf8Value(info[2]->ToString()),
+                         *String::Utf8Value(info[3]->ToString()));
+  info.GetReturnValue().Set(ret);
+}
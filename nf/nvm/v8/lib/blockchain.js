@@ -73,6 +73,9 @@ Blockchain.prototype = {
     },
     verifyAddress: function (address) {
         return this.nativeBlockchain.verifyAddress(address);
+    },
+    sendMessage: function (address, func, args, gasLimit) {
+        return this.nativeBlockchain.sendMessage(address, func, args, gasLimit.toString());
     }
 };
 
@@ -0,0 +1,138 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or
+// modify it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see
+// <http://www.gnu.org/licenses/>.
+//
+
+#include "crypto.h"
+#include "../engine.h"
+#include "instruction_counter.h"
+
+static CryptoHashFunc sHash = NULL;
+static CryptoRecoverAddressFunc sRecoverAddress = NULL;
+
+void InitializeCrypto(CryptoHashFunc hash,
+                      CryptoRecoverAddressFunc recoverAddress) {
+  sHash = hash;
+  sRecoverAddress = recoverAddress;
+}
+
+void NewCryptoInstance(Isolate *isolate, Local<Context> context,
+                       void *handler) {
+  Local<ObjectTemplate> cryptoTpl = ObjectTemplate::New(isolate);
+  cryptoTpl->SetInternalFieldCount(1);
+
+  cryptoTpl->Set(String::NewFromUtf8(isolate, "hash"),
+                 FunctionTemplate::New(isolate, CryptoHashCallback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+
+  cryptoTpl->Set(String::NewFromUtf8(isolate, "recoverAddress"),
+                 FunctionTemplate::New(isolate, CryptoRecoverAddressCallback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+
+  Local<Object> instance = cryptoTpl->NewInstance(context).ToLocalChecked();
+  instance->SetInternalField(0, External::New(isolate, handler));
+
+  context->Global()->DefineOwnProperty(
+      context, String::NewFromUtf8(isolate, "_native_crypto"), instance,
+      static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                     PropertyAttribute::ReadOnly));
+}
+
+// CryptoHashCallback returns the hex digest of the data, hash(alg, data).
+void CryptoHashCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+
+  if (info.Length() != 2) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "Crypto.hash() requires 2 arguments"));
+    return;
+  }
+
+  if (!info[0]->IsString() || !info[1]->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "arguments must be string"));
+    return;
+  }
+
+  if (sHash == NULL) {
+    info.GetReturnValue().SetNull();
+    return;
+  }
+
+  size_t gasCnt = 0;
+  char *digest = sHash(*String::Utf8Value(info[0]->ToString()),
+                       *String::Utf8Value(info[1]->ToString()), &gasCnt);
+
+  // the gas is charged whether the hash succeeds or not.
+  RecordFixedUsage(isolate, isolate->GetCurrentContext(), gasCnt);
+
+  if (digest == NULL) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "unsupported hash algorithm"));
+    return;
+  }
+  info.GetReturnValue().Set(String::NewFromUtf8(isolate, digest));
+  free(digest);
+}
+
+// CryptoRecoverAddressCallback returns the address of the signer of the hash,
+// recoverAddress(alg, hash, sign), or null when the signature is invalid.
+void CryptoRecoverAddressCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 3) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Crypto.recoverAddress() requires 3 arguments"));
+    return;
+  }
+
+  if (!info[0]->IsInt32()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "alg must be integer"));
+    return;
+  }
+
+  if (!info[1]->IsString() || !info[2]->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "hash and sign must be string"));
+    return;
+  }
+
+  if (sRecoverAddress == NULL) {
+    info.GetReturnValue().SetNull();
+    return;
+  }
+
+  size_t gasCnt = 0;
+  char *address = sRecoverAddress(
+      handler->Value(), info[0]->Int32Value(),
+      *String::Utf8Value(info[1]->ToString()),
+      *String::Utf8Value(info[2]->ToString()), &gasCnt);
+
+  RecordFixedUsage(isolate, isolate->GetCurrentContext(), gasCnt);
+
+  if (address == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, address));
+    free(address);
+  }
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+// the algorithm of the signatures, the same as the transactions.
+const SECP256K1 = 1;
+
+var Crypto = function () {
+    this.nativeCrypto = _native_crypto;
+    this.SECP256K1 = SECP256K1;
+};
+
+Crypto.prototype = {
+    sha256: function (data) {
+        return this.nativeCrypto.hash("sha256", data);
+    },
+    sha3256: function (data) {
+        return this.nativeCrypto.hash("sha3256", data);
+    },
+    ripemd160: function (data) {
+        return this.nativeCrypto.hash("ripemd160", data);
+    },
+    recoverAddress: function (alg, hash, sign) {
+        return this.nativeCrypto.recoverAddress(alg, hash, sign);
+    },
+    verify: function (alg, hash, sign, address) {
+        var signer = this.recoverAddress(alg, hash, sign);
+        return signer !== null && signer === address;
+    }
+};
+
+module.exports = new Crypto();
+module.exports.Crypto = Crypto;
@@ -23,14 +23,22 @@
 #include "instruction_counter.h"
 
 static EventTriggerFunc TRIGGER = NULL;
+static CallResultFunc RESULT = NULL;
 
-void InitializeEvent(EventTriggerFunc trigger) { TRIGGER = trigger; }
+void InitializeEvent(EventTriggerFunc trigger, CallResultFunc result) {
+  TRIGGER = trigger;
+  RESULT = result;
+}
 
 void NewNativeEventFunction(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
   globalTpl->Set(String::NewFromUtf8(isolate, "_native_event_trigger"),
                  FunctionTemplate::New(isolate, EventTriggerCallback),
                  static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                 PropertyAttribute::ReadOnly));
+  globalTpl->Set(String::NewFromUtf8(isolate, "_native_call_result"),
+                 FunctionTemplate::New(isolate, CallResultCallback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
 }
 
 void EventTriggerCallback(const FunctionCallbackInfo<Value> &info) {
@@ -72,3 +80,30 @@ void EventTriggerCallback(const FunctionCallbackInfo<Value> &info) {
 
   TRIGGER(e, *sTopic, *sData);
 }
+
+void CallResultCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Context> context = isolate->GetCurrentContext();
+
+  if (info.Length() < 1) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "_native_call_result: mssing params")));
+    return;
+  }
+
+  Local<Value> value = info[0];
+  if (!value->IsString()) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_call_result: value must be string")));
+    return;
+  }
+
+  if (RESULT == NULL) {
+    return;
+  }
+
+  V8Engine *e = GetV8EngineInstance(context);
+  String::Utf8Value sValue(value);
+
+  RESULT(e, *sValue);
+}
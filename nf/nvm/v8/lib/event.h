@@ -26,5 +26,6 @@ using namespace v8;
 
 void NewNativeEventFunction(Isolate *isolate, Local<ObjectTemplate> globalTpl);
 void EventTriggerCallback(const FunctionCallbackInfo<Value> &info);
+void CallResultCallback(const FunctionCallbackInfo<Value> &info);
 
 #endif // _NEBULAS_NF_NVM_V8_LIB_EVENT_H_
@@ -107,3 +107,4 @@ const GlobalContractStorage = ContractStorage.gcs;
 const BigNumber = require('bignumber.js');
 const Blockchain = require('blockchain.js');
 const Event = require('event.js');
+const Crypto = require('crypto.js');
@@ -49,3 +49,8 @@ char *GetAccountState(void *handler, const char *address) {
 int Transfer(void *handler, const char *to, const char *value) { return 1; }
 
 int VerifyAddress(void *handler, const char *address) { return 1; }
+
+int SendMessage(void *handler, const char *to, const char *function,
+                const char *args, const char *gasLimit) {
+  return 0;
+}
@@ -19,6 +19,7 @@
 
 #include "global.h"
 #include "blockchain.h"
+#include "crypto.h"
 #include "event.h"
 #include "instruction_counter.h"
 #include "log_callback.h"
@@ -49,6 +50,7 @@ void SetGlobalObjectProperties(Isolate *isolate, Local<Context> context,
   NewInstructionCounterInstance(isolate, context,
                                 &(e->stats.count_of_executed_instructions), e);
   NewBlockchainInstance(isolate, context, lcsHandler);
+  NewCryptoInstance(isolate, context, lcsHandler);
 }
 
 V8Engine *GetV8EngineInstance(Local<Context> context) {
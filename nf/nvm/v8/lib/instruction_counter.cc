@@ -23,6 +23,7 @@
 static char sInstructionCounter[] = "_instruction_counter";
 
 static InstructionCounterIncrListener sListener = NULL;
+static CallDepthListener sCallDepthListener = NULL;
 
 void NewInstructionCounterInstance(Isolate *isolate, Local<Context> context,
                                    size_t *counter, void *listenerContext) {
@@ -34,6 +35,16 @@ void NewInstructionCounterInstance(Isolate *isolate, Local<Context> context,
                   static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                  PropertyAttribute::ReadOnly));
 
+  counterTpl->Set(String::NewFromUtf8(isolate, "enter"),
+                  FunctionTemplate::New(isolate, EnterCallCallback),
+                  static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                 PropertyAttribute::ReadOnly));
+
+  counterTpl->Set(String::NewFromUtf8(isolate, "exit"),
+                  FunctionTemplate::New(isolate, ExitCallCallback),
+                  static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                 PropertyAttribute::ReadOnly));
+
   counterTpl->SetAccessor(
       String::NewFromUtf8(isolate, "count"), CountGetterCallback, 0,
       Local<Value>(), DEFAULT,
@@ -86,6 +97,34 @@ void IncrCounterCallback(const FunctionCallbackInfo<Value> &info) {
   }
 }
 
+static void NotifyCallDepth(const FunctionCallbackInfo<Value> &info, int delta,
+                            const char *frame) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> listenerContext =
+      Local<External>::Cast(thisArg->GetInternalField(1));
+
+  if (sCallDepthListener != NULL) {
+    sCallDepthListener(isolate, delta, frame, listenerContext->Value());
+  }
+}
+
+// EnterCallCallback is injected at the beginning of each function, with the
+// "name:line" of the function as the frame.
+void EnterCallCallback(const FunctionCallbackInfo<Value> &info) {
+  if (info.Length() > 0 && info[0]->IsString()) {
+    String::Utf8Value frame(info[0]);
+    NotifyCallDepth(info, 1, *frame);
+    return;
+  }
+  NotifyCallDepth(info, 1, "");
+}
+
+// ExitCallCallback is injected at the end of each function, run in finally.
+void ExitCallCallback(const FunctionCallbackInfo<Value> &info) {
+  NotifyCallDepth(info, -1, "");
+}
+
 void CountGetterCallback(Local<String> property,
                          const PropertyCallbackInfo<Value> &info) {
   Isolate *isolate = info.GetIsolate();
@@ -101,6 +140,31 @@ void SetInstructionCounterIncrListener(
   sListener = listener;
 }
 
+void SetCallDepthListener(CallDepthListener listener) {
+  sCallDepthListener = listener;
+}
+
+void RecordFixedUsage(Isolate *isolate, Local<Context> context,
+                      size_t value) {
+  Local<Object> global = context->Global();
+  HandleScope handle_scope(isolate);
+
+  Local<Object> counter = Local<Object>::Cast(
+      global->Get(String::NewFromUtf8(isolate, sInstructionCounter)));
+
+  Local<Value> prop = counter->Get(String::NewFromUtf8(isolate, "incr"));
+  if (!prop->IsFunction()) {
+    LogDebugf("RecordFixedUsage: %s.incr is not a Function.",
+              sInstructionCounter);
+    return;
+  }
+
+  Local<Function> incr_func = Local<Function>::Cast(prop);
+  Local<Value> argv[1];
+  argv[0] = Number::New(isolate, value);
+  incr_func->Call(context, counter, 1, argv);
+}
+
 void RecordStorageUsage(Isolate *isolate, Local<Context> context,
                         size_t key_length, size_t value_length) {
   Local<Object> global = context->Global();
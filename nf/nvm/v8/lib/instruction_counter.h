@@ -28,13 +28,22 @@ typedef void (*InstructionCounterIncrListener)(Isolate *isolate, size_t count,
                                                void *context);
 void SetInstructionCounterIncrListener(InstructionCounterIncrListener listener);
 
+typedef void (*CallDepthListener)(Isolate *isolate, int delta,
+                                  const char *frame, void *context);
+void SetCallDepthListener(CallDepthListener listener);
+
 void NewInstructionCounterInstance(Isolate *isolate, Local<Context> context,
                                    size_t *counter, void *listenerContext);
 
 void IncrCounterCallback(const FunctionCallbackInfo<Value> &info);
+void EnterCallCallback(const FunctionCallbackInfo<Value> &info);
+void ExitCallCallback(const FunctionCallbackInfo<Value> &info);
 void CountGetterCallback(Local<String> property,
                          const PropertyCallbackInfo<Value> &info);
 
+void RecordFixedUsage(Isolate *isolate, Local<Context> context,
+                      size_t value);
+
 void RecordStorageUsage(Isolate *isolate, Local<Context> context,
                         size_t key_length, size_t value_length);
 
@@ -136,6 +136,18 @@ const InjectionCodeGenerators = {
     CounterIncrFuncUsingNotAndLogicalOrFunc: function (value) {
         return "!_instruction_counter.incr(" + value + ") || ";
     },
+    CallDepthEnterFunc: function (frame) {
+        return "_instruction_counter.enter(\"" + frame + "\"); try {";
+    },
+    CallDepthExitFunc: function () {
+        return "} finally { _instruction_counter.exit(); }";
+    },
+    ArrowCallDepthEnterFunc: function (frame) {
+        return "{_instruction_counter.enter(\"" + frame + "\"); try ";
+    },
+    ArrowCallDepthExitFunc: function () {
+        return "} finally { _instruction_counter.exit(); }}";
+    },
 };
 
 function InjectionContext(node, type) {
@@ -156,12 +168,51 @@ function record_injection_info(injection_records, pos, value, injection_func) {
     item.value += value;
 };
 
-function processScript(source) {
+function processScript(source, trackCallDepth) {
     var injection_records = new Map();
     var record_injection = function (pos, value, injection_func) {
         return record_injection_info(injection_records, pos, value, injection_func);
     };
 
+    // the call depth injections are not merged. At the same position, the
+    // arrow function exits go first, then the enters, the counter injections
+    // and the function exits.
+    var call_depth_records = [];
+    var record_call_depth_injection = function (pos, order, injection_func) {
+        call_depth_records.push({
+            pos: pos,
+            value: 0,
+            func: injection_func,
+            order: order,
+            seq: call_depth_records.length,
+        });
+    };
+
+    function track_call_depth(node, parents) {
+        var body = node.body;
+        var frame = functionFrameName(source, node, parents);
+        var enter_func = function (generator) {
+            return function () {
+                return generator(frame);
+            };
+        };
+
+        if (body.type !== 'BlockStatement') {
+            // arrow function with an expression body, made a block below.
+            record_call_depth_injection(body.range[0], -1, enter_func(InjectionCodeGenerators.ArrowCallDepthEnterFunc));
+            record_call_depth_injection(body.range[1], -2, InjectionCodeGenerators.ArrowCallDepthExitFunc);
+            return;
+        }
+
+        // keep the directive prologue, eg "use strict", at the beginning.
+        var pos = body.range[0] + 1; // after "{".
+        for (var i = 0; i < body.body.length && body.body[i].directive !== undefined; i++) {
+            pos = body.body[i].range[1];
+        }
+        record_call_depth_injection(pos, -1, enter_func(InjectionCodeGenerators.CallDepthEnterFunc));
+        record_call_depth_injection(body.range[1] - 1, 1, InjectionCodeGenerators.CallDepthExitFunc); // before "}".
+    };
+
     function ensure_block_statement(node) {
         if (!node || !node.type) {
             // not a valid node, ignore
@@ -196,6 +247,15 @@ function processScript(source) {
         // throw error when "_instruction_counter" was redefined in source.
         disallowRedefineOfInstructionCounter(node, parents);
 
+        // track the call depth in all functions.
+        if (trackCallDepth && node.type in {
+                FunctionDeclaration: "",
+                FunctionExpression: "",
+                ArrowFunctionExpression: "",
+            }) {
+            track_call_depth(node, parents);
+        }
+
         // 1. flag find the injection point, eg a Expression/Statement can inject code directly.
         if (node.type == "IfStatement") {
             ensure_block_statement(node.consequent);
@@ -265,8 +325,11 @@ function processScript(source) {
         } else if (node.type == "ArrowFunctionExpression") {
             var body = node.body;
             if (body.type !== 'BlockStatement') {
+                // the block is closed by the call depth injection if any.
                 record_injection(body.range[0], 0, InjectionCodeGenerators.BlockStatementBeginAndCounterIncrFuncAndReturn);
-                record_injection(body.range[1], 0, InjectionCodeGenerators.BlockStatementEndAndCounterIncrFunc);
+                if (!trackCallDepth) {
+                    record_injection(body.range[1], 0, InjectionCodeGenerators.BlockStatementEndAndCounterIncrFunc);
+                }
 
                 // only return injection context when body is not in {};
                 return {
@@ -355,8 +418,20 @@ function processScript(source) {
 
     // generate traceable source.
     var ordered_records = Array.from(injection_records.values());
+    ordered_records.forEach(function (record) {
+        record.order = 0;
+        record.seq = 0;
+    });
+    ordered_records.push.apply(ordered_records, call_depth_records);
     ordered_records.sort(function (a, b) {
-        return a.pos - b.pos;
+        if (a.pos !== b.pos) {
+            return a.pos - b.pos;
+        }
+        if (a.order !== b.order) {
+            return a.order - b.order;
+        }
+        // the inner functions are entered after the outer ones and exited before.
+        return a.order === -1 ? a.seq - b.seq : b.seq - a.seq;
     });
 
 
@@ -375,6 +450,37 @@ function processScript(source) {
     };
 };
 
+// functionFrameName returns the "name:line" of the function, the frame the
+// executed instructions are attributed to when profiling.
+function functionFrameName(source, node, parents) {
+    var name = "";
+    var parent_node = parents.length > 0 ? parents[0].node : null;
+
+    if (node.id) {
+        name = node.id.name;
+    } else if (parent_node) {
+        switch (parent_node.type) {
+            case 'VariableDeclarator':
+                name = source.slice(parent_node.id.range[0], parent_node.id.range[1]);
+                break;
+            case 'AssignmentExpression':
+                name = source.slice(parent_node.left.range[0], parent_node.left.range[1]);
+                break;
+            case 'Property':
+            case 'MethodDefinition':
+                name = source.slice(parent_node.key.range[0], parent_node.key.range[1]);
+                break;
+        }
+    }
+
+    // only the identifier characters are kept, the name is quoted in the source.
+    name = name.replace(/[^A-Za-z0-9_$.]/g, "");
+    if (name.length === 0) {
+        name = "(anonymous)";
+    }
+    return name + ":" + node.loc.start.line;
+};
+
 // throw error when "_instruction_counter" was redefined.
 function disallowRedefineOfInstructionCounter(node, parents) {
     if (node.type != 'Identifier' || node.name != '_instruction_counter') {
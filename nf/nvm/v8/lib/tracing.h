@@ -27,6 +27,7 @@ using namespace v8;
 typedef struct {
   int source_line_offset;
   char *tracable_source;
+  int track_call_depth;
 } TracingContext;
 
 int InjectTracingInstructionDelegate(Isolate *isolate, const char *source,
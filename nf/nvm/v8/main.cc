@@ -56,6 +56,10 @@ void eventTriggerFunc(void *handler, const char *topic, const char *data) {
   fprintf(stdout, "[Event] [%s] %s\n", topic, data);
 }
 
+void callResultFunc(void *handler, const char *value) {
+  fprintf(stdout, "[Result] %s\n", value);
+}
+
 void help(const char *name) {
   printf("%s [-c <concurrency>] [-i] [-li <number>] [-lm <number>] <Javascript "
          "File>\n",
@@ -85,7 +89,7 @@ void RunScriptSourceDelegate(V8Engine *e, const char *data,
     e->limits_of_executed_instructions = limits_of_executed_instructions;
     e->limits_of_total_memory_size = limits_of_total_memory_size;
 
-    char *traceableSource = InjectTracingInstructions(e, data, &lineOffset);
+    char *traceableSource = InjectTracingInstructions(e, data, &lineOffset, 1);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
     } else {
@@ -153,7 +157,7 @@ void ExecuteScript(const char *filename, V8ExecutionDelegate delegate) {
   void *lcsHandler = CreateStorageHandler();
   void *gcsHandler = CreateStorageHandler();
 
-  V8Engine *e = CreateEngine();
+  V8Engine *e = CreateEngine(1);
 
   size_t size = 0;
   int lineOffset = 0;
@@ -180,7 +184,7 @@ void ExecuteScript(const char *filename, V8ExecutionDelegate delegate) {
 
   // inject tracing code.
   if (enable_tracer_injection) {
-    char *traceableSource = InjectTracingInstructions(e, source, &lineOffset);
+    char *traceableSource = InjectTracingInstructions(e, source, &lineOffset, 1);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
       free(source);
@@ -220,8 +224,9 @@ int main(int argc, const char *argv[]) {
   InitializeLogger(logFunc);
   InitializeRequireDelegate(RequireDelegateFunc);
   InitializeStorage(StorageGet, StoragePut, StorageDel);
-  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress);
-  InitializeEvent(eventTriggerFunc);
+  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress,
+                       SendMessage);
+  InitializeEvent(eventTriggerFunc, callResultFunc);
 
   int argcIdx = 1;
   const char *filename = NULL;
@@ -2,14 +2,16 @@ package rpc
 
 import (
 	"errors"
-	"net"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/sched"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -19,22 +21,41 @@ type APIServer struct {
 
 	rpcServer *grpc.Server
 
+	health *healthServer
+
 	rpcConfig *nebletpb.RPCConfig
+
+	cache *responseCache
 }
 
 // NewAPIServer creates a new RPC server and registers the API endpoints.
 func NewAPIServer(neblet Neblet) *APIServer {
 	cfg := neblet.Config().Rpc
 
-	rpc := grpc.NewServer()
+	tenants, err := newTenantRegistry(cfg.Tenants)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Failed to load rpc tenants.")
+	}
 
-	srv := &APIServer{neblet: neblet, rpcServer: rpc, rpcConfig: cfg}
-	api := &APIService{srv}
+	// the quotas of the rpc are shared by the hosted chains.
+	sched.SetQuota(int(cfg.CpuQuota), int(cfg.BusyCpuQuota))
+
+	srv := &APIServer{neblet: neblet, rpcConfig: cfg, health: newHealthServer(), cache: newResponseCache()}
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(chainUnaryInterceptors(tracingInterceptor, deadlineInterceptor(time.Duration(cfg.RequestTimeoutMs)*time.Millisecond), errorInterceptor, tenants.interceptor, laneInterceptor, srv.chainIDInterceptor, auditInterceptor)),
+		grpc.StreamInterceptor(chainStreamInterceptors(tenants.streamInterceptor, srv.chainIDStreamInterceptor)),
+	}
+	rpc := grpc.NewServer(append(opts, grpcServerOptions(cfg.Grpc)...)...)
+	srv.rpcServer = rpc
+	api := &APIService{server: srv, cache: srv.cache}
 
 	rpcpb.RegisterApiServiceServer(rpc, api)
 	rpcpb.RegisterAdminServiceServer(rpc, api)
-	// Register reflection service on gRPC server.
-	// TODO: Enable reflection only for testing mode.
+	// Register health service for load balancers, services are not serving until started.
+	healthpb.RegisterHealthServer(rpc, srv.health)
+	// Register reflection service on gRPC server for grpcurl-like tools.
 	reflection.Register(rpc)
 
 	return srv
@@ -43,6 +64,7 @@ func NewAPIServer(neblet Neblet) *APIServer {
 // Start starts the rpc server and serves incoming requests.
 func (s *APIServer) Start() error {
 	logging.CLog().Info("Starting RPC Server")
+	s.health.setServingStatus(healthpb.HealthCheckResponse_SERVING)
 	if len(s.rpcConfig.RpcListen) > 0 {
 		for _, v := range s.rpcConfig.RpcListen {
 			err := s.start(v)
@@ -64,7 +86,11 @@ func (s *APIServer) Start() error {
 }
 
 func (s *APIServer) start(addr string) error {
-	listener, err := net.Listen("tcp", addr)
+	var maxConns uint32
+	if s.rpcConfig.Grpc != nil {
+		maxConns = s.rpcConfig.Grpc.MaxConnections
+	}
+	listener, err := listen(addr, maxConns)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
 			"err": err,
@@ -87,9 +113,8 @@ func (s *APIServer) RunGateway() error {
 	//time.Sleep(3 * time.Second)
 	rpcListen := s.rpcConfig.RpcListen[0]
 	gatewayListen := s.rpcConfig.HttpListen
-	httpModule := s.rpcConfig.HttpModule
 	logging.CLog().Info("Starting api gateway server bind rpc-server: ", rpcListen, " to:", gatewayListen)
-	if err := Run(rpcListen, gatewayListen, httpModule); err != nil {
+	if err := Run(rpcListen, s.rpcConfig); err != nil {
 		logging.CLog().Error("RPC server gateway failed to serve: ", err)
 		return err
 	}
@@ -99,7 +124,20 @@ func (s *APIServer) RunGateway() error {
 // Stop stops the rpc server and closes listener.
 func (s *APIServer) Stop() {
 	logging.CLog().Info("Stopping RPC server at: ", s.rpcConfig.RpcListen)
+	s.health.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
 	s.rpcServer.Stop()
+	s.cache.stop()
+}
+
+// Pause reports the services not serving, so load balancers drain the node
+// while the watchdog pauses it.
+func (s *APIServer) Pause() {
+	s.health.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Resume reports the services serving again.
+func (s *APIServer) Resume() {
+	s.health.setServingStatus(healthpb.HealthCheckResponse_SERVING)
 }
 
 // Neblet returns weak reference to Neblet.
@@ -19,9 +19,12 @@
 package rpc
 
 import (
+	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"strconv"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
 
@@ -32,12 +35,16 @@ import (
 	corepb "github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/feature"
+	"github.com/nebulasio/go-nebulas/neblet/profile"
 	nnet "github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/net/p2p"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/audit"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/version"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
@@ -45,6 +52,7 @@ import (
 // APIService implements the RPC API service interface.
 type APIService struct {
 	server Server
+	cache  *responseCache
 }
 
 // GetNebState is the RPC API handler.
@@ -53,7 +61,7 @@ func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsReques
 		"api": "/v1/user/nebstate",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 
 	tail := neb.BlockChain().TailBlock()
 
@@ -65,17 +73,44 @@ func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsReques
 	resp.PeerCount = p2p.GetCountOfMap(neb.NetManager().Node().GetStream())
 	resp.ProtocolVersion = p2p.ProtocolID
 	resp.Version = neb.Config().App.Version
+	lib := neb.BlockChain().LIB()
+	resp.Lib = lib.Hash().String()
+	resp.LibHeight = lib.Height()
 
 	return resp, nil
 }
 
+// GetNebVersion is the RPC API handler.
+func (s *APIService) GetNebVersion(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.NebVersionResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/nebversion",
+	}).Info("Rpc request.")
+
+	info := version.Get()
+	resp := &rpcpb.NebVersionResponse{
+		Version:         info.Version,
+		Commit:          info.Commit,
+		Branch:          info.Branch,
+		Features:        info.Features,
+		GoVersion:       info.GoVersion,
+		Os:              info.OS,
+		Arch:            info.Arch,
+		ProtocolVersion: p2p.ProtocolID,
+		ClientVersion:   p2p.ClientVersion,
+	}
+	if !info.BuildDate.IsZero() {
+		resp.BuildDate = info.BuildDate.Unix()
+	}
+	return resp, nil
+}
+
 // NodeInfo is the PRC API handler
 func (s *APIService) NodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.NodeInfoResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
 		"api": "/v1/user/nodeinfo",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	resp := &rpcpb.NodeInfoResponse{}
 	node := neb.NetManager().Node()
 	resp.Id = node.ID()
@@ -90,6 +125,7 @@ func (s *APIService) NodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest)
 	for _, v := range node.PeerStore().Peers() {
 		routeTable := &rpcpb.RouteTable{}
 		routeTable.Id = v.Pretty()
+		routeTable.Build = node.PeerBuild(v)
 		if len(node.PeerStore().Addrs(v)) > 0 {
 			var addrs []string
 			for _, val := range node.PeerStore().Addrs(v) {
@@ -108,7 +144,7 @@ func (s *APIService) StatisticsNodeInfo(ctx context.Context, req *rpcpb.NonParam
 		"api": "/v1/admin/statistics/nodeInfo",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	node := neb.NetManager().Node()
 	tail := neb.BlockChain().TailBlock()
 	resp := &rpcpb.StatisticsNodeInfoResponse{}
@@ -125,7 +161,7 @@ func (s *APIService) Accounts(ctx context.Context, req *rpcpb.NonParamsRequest)
 		"api": "/v1/user/accounts",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 
 	accs := neb.AccountManager().Accounts()
 
@@ -146,7 +182,7 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 		"api":     "/v1/user/accountstate",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 
 	addr, err := core.AddressParse(req.Address)
 	if err != nil {
@@ -155,13 +191,16 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 
 	block := neb.BlockChain().TailBlock()
 	if len(req.Block) > 0 {
-		blockHash, err := byteutils.FromHex(req.Block)
+		blockHash, err := parseHash(req.Block)
 		if err != nil {
 			return nil, err
 		}
 		block = neb.BlockChain().GetBlock(blockHash)
 		if block == nil {
-			return nil, errors.New("block hash not found")
+			return nil, ErrBlockNotFound
+		}
+		if err := neb.BlockChain().CheckStateAvailable(block); err != nil {
+			return nil, err
 		}
 	}
 
@@ -171,13 +210,69 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: fmt.Sprintf("%d", nonce)}, nil
 }
 
+// GetStateDiff is the RPC API handler.
+func (s *APIService) GetStateDiff(ctx context.Context, req *rpcpb.GetStateDiffRequest) (*rpcpb.StateDiffResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"from":   req.FromHeight,
+		"to":     req.ToHeight,
+		"cursor": req.Cursor,
+		"api":    "/v1/user/getStateDiff",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	var cursor byteutils.Hash
+	if len(req.Cursor) > 0 {
+		c, err := parseHex(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = c
+	}
+	diffs, next, err := neb.BlockChain().StateDiff(ctx, req.FromHeight, req.ToHeight, cursor, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.StateDiffResponse{Accounts: []*rpcpb.AccountStateDiff{}}
+	if next != nil {
+		resp.NextCursor = byteutils.Hex(next)
+	}
+	for _, diff := range diffs {
+		from, to := big.NewInt(0), big.NewInt(0)
+		account := &rpcpb.AccountStateDiff{
+			Address:          byteutils.Hex(diff.Address),
+			Created:          diff.From == nil,
+			BalanceFrom:      "0",
+			StorageTruncated: diff.StorageTruncated,
+		}
+		if diff.From != nil {
+			from = diff.From.Balance().Int
+			account.BalanceFrom = diff.From.Balance().String()
+			account.NonceFrom = diff.From.Nonce()
+		}
+		if diff.To != nil {
+			to = diff.To.Balance().Int
+			account.NonceTo = diff.To.Nonce()
+		}
+		account.BalanceTo = to.String()
+		account.BalanceDelta = new(big.Int).Sub(to, from).String()
+		account.NonceDelta = int64(account.NonceTo) - int64(account.NonceFrom)
+		for _, key := range diff.StorageKeys {
+			account.StorageKeys = append(account.StorageKeys, byteutils.Hex(key))
+		}
+		resp.Accounts = append(resp.Accounts, account)
+	}
+	return resp, nil
+}
+
 // GetDynasty is the RPC API handler.
 func (s *APIService) GetDynasty(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GetDynastyResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
 		"api": "/v1/admin/dynasty",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	dynastyRoot := neb.BlockChain().TailBlock().DposContext().DynastyRoot
 	dynastyTrie, err := trie.NewBatchTrie(dynastyRoot, neb.BlockChain().Storage())
 	if err != nil {
@@ -201,7 +296,7 @@ func (s *APIService) GetDelegateVoters(ctx context.Context, req *rpcpb.GetDelega
 		"api":       "/v1/admin/delegateVoters",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	delegatee, err := core.AddressParse(req.Delegatee)
 	if err != nil {
 		return nil, err
@@ -234,7 +329,7 @@ func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.Transaction
 		"api": "/v1/user/transaction",
 	}).Info("Rpc request.")
 
-	return s.sendTransaction(req)
+	return s.sendTransaction(ctx, req)
 }
 
 // Call is the RPC API handler.
@@ -243,18 +338,18 @@ func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*
 		"api": "/v1/user/call",
 	}).Info("Rpc request.")
 
-	return s.sendTransaction(req)
+	return s.sendTransaction(ctx, req)
 }
 
-func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
-	neb := s.server.Neblet()
+func (s *APIService) sendTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+	neb := s.server.Chain(ctx)
 	tail := neb.BlockChain().TailBlock()
 	addr, err := core.AddressParse(req.From)
 	if err != nil {
 		return nil, err
 	}
 	if req.Nonce <= tail.GetNonce(addr.Bytes()) {
-		return nil, errors.New("nonce is invalid")
+		return nil, core.ErrSmallTransactionNonce
 	}
 
 	tx, err := parseTransaction(neb, req)
@@ -264,7 +359,7 @@ func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.Send
 	if err := neb.AccountManager().SignTransaction(tx.From(), tx); err != nil {
 		return nil, err
 	}
-	if err := neb.BlockChain().TransactionPool().PushAndBroadcast(tx); err != nil {
+	if err := submitTransaction(ctx, neb, tx, req.NoRelay, true); err != nil {
 		return nil, err
 	}
 	if tx.Type() == core.TxPayloadDeployType {
@@ -275,6 +370,20 @@ func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.Send
 	return &rpcpb.SendTransactionResponse{Txhash: tx.Hash().String()}, nil
 }
 
+// submitTransaction pushes the tx into the pool, and sends it to the peers
+// unless noRelay. The local txs, signed by the node or submitted by its admin,
+// are rebroadcast until they are on chain.
+func submitTransaction(ctx context.Context, neb Neblet, tx *core.Transaction, noRelay bool, local bool) error {
+	pool := neb.BlockChain().TransactionPool()
+	if noRelay {
+		return pool.Push(tx)
+	}
+	if local {
+		return pool.PushAndBroadcastLocal(ctx, tx)
+	}
+	return pool.PushAndBroadcast(ctx, tx)
+}
+
 func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transaction, error) {
 	fromAddr, err := core.AddressParse(reqTx.From)
 	if err != nil {
@@ -305,6 +414,22 @@ func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transa
 	} else if reqTx.Delegate != nil {
 		payloadType = core.TxPayloadDelegateType
 		payload, err = core.NewDelegatePayload(reqTx.Delegate.Action, reqTx.Delegate.Delegatee).ToBytes()
+	} else if reqTx.Anchor != nil && len(reqTx.Anchor.Operator) > 0 {
+		payloadType = core.TxPayloadAnchorType
+		payload, err = core.NewAnchorOperatorPayload(reqTx.Anchor.ChainId, reqTx.Anchor.Operator).ToBytes()
+	} else if reqTx.Anchor != nil {
+		payloadType = core.TxPayloadAnchorType
+		stateRoot, err := parseHash(reqTx.Anchor.StateRoot)
+		if err != nil {
+			return nil, err
+		}
+		payload, err = core.NewAnchorPayload(reqTx.Anchor.ChainId, reqTx.Anchor.Height, stateRoot).ToBytes()
+		if err != nil {
+			return nil, err
+		}
+	} else if reqTx.Library != nil {
+		payloadType = core.TxPayloadLibraryType
+		payload, err = core.NewLibraryPayload(reqTx.Library.Name, reqTx.Library.Version, reqTx.Library.Source).ToBytes()
 	} else {
 		payloadType = core.TxPayloadBinaryType
 	}
@@ -323,7 +448,7 @@ func (s *APIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawT
 	}).Info("Rpc request.")
 
 	// Validate and sign the tx, then submit it to the tx pool.
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 
 	pbTx := new(corepb.Transaction)
 	if err := proto.Unmarshal(req.GetData(), pbTx); err != nil {
@@ -334,7 +459,7 @@ func (s *APIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawT
 		return nil, err
 	}
 
-	if err := neb.BlockChain().TransactionPool().PushAndBroadcast(tx); err != nil {
+	if err := submitTransaction(ctx, neb, tx, req.NoRelay, false); err != nil {
 		return nil, err
 	}
 
@@ -353,14 +478,19 @@ func (s *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHa
 		"api":  "/v1/user/getBlockByHash",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 
-	bhash, _ := byteutils.FromHex(req.GetHash())
-	block := neb.BlockChain().GetBlock(bhash)
-	if block == nil {
-		return nil, errors.New("block not found")
+	bhash, err := parseHash(req.GetHash())
+	if err != nil {
+		return nil, err
 	}
-	pbBlock, err := block.ToProto()
+	pbBlock, err := s.cache.chain(neb).get("block."+bhash.String(), true, func() (interface{}, error) {
+		block := neb.BlockChain().GetBlock(bhash)
+		if block == nil {
+			return nil, ErrBlockNotFound
+		}
+		return block.ToProto()
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -371,12 +501,18 @@ func (s *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHa
 func (s *APIService) BlockDump(ctx context.Context, req *rpcpb.BlockDumpRequest) (*rpcpb.BlockDumpResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
 		"count": req.Count,
-		"api":   "/v1/user/transaction",
+		"api":   "/v1/user/blockdump",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
-	data := neb.BlockChain().Dump(int(req.Count))
-	return &rpcpb.BlockDumpResponse{Data: data}, nil
+	if req.Count > core.MaxBlockRange {
+		return nil, core.ErrInvalidBlockRange
+	}
+	neb := s.server.Chain(ctx)
+	data := new(bytes.Buffer)
+	if err := neb.BlockChain().Dump(ctx, data, int(req.Count)); err != nil {
+		return nil, err
+	}
+	return &rpcpb.BlockDumpResponse{Data: data.String()}, nil
 }
 
 // GetTransactionReceipt get transaction info by the transaction hash
@@ -386,13 +522,25 @@ func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTr
 		"api":  "/v1/user/getTransactionReceipt",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
-	bhash, _ := byteutils.FromHex(req.GetHash())
-	tx := neb.BlockChain().GetTransaction(bhash)
-	if tx == nil {
-		return nil, errors.New("transaction not found")
+	neb := s.server.Chain(ctx)
+	bhash, err := parseHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := s.cache.chain(neb).get("receipt."+bhash.String(), false, func() (interface{}, error) {
+		tx := neb.BlockChain().GetTransaction(bhash)
+		if tx == nil {
+			return nil, ErrTransactionNotFound
+		}
+		return toTransactionResponse(tx, neb.BlockChain().ABIRegistry())
+	})
+	if err != nil {
+		return nil, err
 	}
+	return receipt.(*rpcpb.TransactionReceiptResponse), nil
+}
 
+func toTransactionResponse(tx *core.Transaction, abis *core.ABIRegistry) (*rpcpb.TransactionReceiptResponse, error) {
 	receipt := &rpcpb.TransactionReceiptResponse{
 		ChainId:   tx.ChainID(),
 		Hash:      byteutils.Hex(tx.Hash()),
@@ -413,6 +561,15 @@ func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTr
 		}
 		receipt.ContractAddress = contractAddr.String()
 	}
+	if call := abis.DecodeCall(tx); call != nil {
+		receipt.DecodedCall = &rpcpb.DecodedCall{
+			Function:  call.Function,
+			Signature: call.String(),
+		}
+		for _, arg := range call.Args {
+			receipt.DecodedCall.Args = append(receipt.DecodedCall.Args, &rpcpb.DecodedArg{Name: arg.Name, Value: arg.Value})
+		}
+	}
 	return receipt, nil
 }
 
@@ -422,7 +579,7 @@ func (s *APIService) NewAccount(ctx context.Context, req *rpcpb.NewAccountReques
 		"api": "/v1/admin/account/new",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	addr, err := neb.AccountManager().NewAccount([]byte(req.Passphrase))
 	if err != nil {
 		return nil, err
@@ -436,7 +593,7 @@ func (s *APIService) UnlockAccount(ctx context.Context, req *rpcpb.UnlockAccount
 		"api": "/v1/admin/account/unlock",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	addr, err := core.AddressParse(req.Address)
 	if err != nil {
 		return nil, err
@@ -458,7 +615,7 @@ func (s *APIService) LockAccount(ctx context.Context, req *rpcpb.LockAccountRequ
 		"api": "/v1/admin/account/lock",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	addr, err := core.AddressParse(req.Address)
 	if err != nil {
 		return nil, err
@@ -476,7 +633,7 @@ func (s *APIService) SignTransaction(ctx context.Context, req *rpcpb.Transaction
 		"api": "/v1/admin/sign",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	tx, err := parseTransaction(neb, req)
 	if err != nil {
 		return nil, err
@@ -502,7 +659,7 @@ func (s *APIService) SendTransactionWithPassphrase(ctx context.Context, req *rpc
 		"api": "/v1/admin/transactionWithPassphrase",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
 	tx, err := parseTransaction(neb, req.Transaction)
 	if err != nil {
 		return nil, err
@@ -510,12 +667,16 @@ func (s *APIService) SendTransactionWithPassphrase(ctx context.Context, req *rpc
 	if err := neb.AccountManager().SignTransactionWithPassphrase(tx.From(), tx, []byte(req.Passphrase)); err != nil {
 		return nil, err
 	}
-	if err := neb.BlockChain().TransactionPool().PushAndBroadcast(tx); err != nil {
+	if err := submitTransaction(ctx, neb, tx, req.Transaction.NoRelay, true); err != nil {
 		return nil, err
 	}
 	return &rpcpb.SendTransactionPassphraseResponse{Hash: tx.Hash().String()}, nil
 }
 
+// subscribeCatchUpInterval is the interval a subscribe stream checks the
+// events dropped for it.
+const subscribeCatchUpInterval = time.Second
+
 // Subscribe ..
 func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_SubscribeServer) error {
 	logging.VLog().WithFields(logrus.Fields{
@@ -523,7 +684,7 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 		"api":   "/v1/user/subscribe",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(gs.Context())
 
 	chainEventCh := make(chan *core.Event, 128)
 	emitter := neb.EventEmitter()
@@ -544,14 +705,40 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 	defer net.Deregister(nnet.NewSubscriber(s, netEventCh, core.MessageTypeNewBlock))
 	defer net.Deregister(nnet.NewSubscriber(s, netEventCh, core.MessageTypeNewTx))
 
+	// the events dropped for a slow stream are told, then replayed from the
+	// catch-up storage if the node keeps them.
+	catchUpTicker := time.NewTicker(subscribeCatchUpInterval)
+	defer catchUpTicker.Stop()
+	var dropped uint64
+
 	var err error
 	for {
 		select {
+		case <-gs.Context().Done():
+			return gs.Context().Err()
 		case event := <-chainEventCh:
-			err = gs.Send(&rpcpb.SubscribeResponse{MsgType: event.Topic, Data: event.Data})
+			err = gs.Send(&rpcpb.SubscribeResponse{MsgType: event.Topic, Data: event.Data, Id: event.ID})
+			if err != nil {
+				return err
+			}
+		case <-catchUpTicker.C:
+			n := emitter.Dropped(chainEventCh)
+			if n == dropped {
+				continue
+			}
+			dropped = n
+			if err := gs.Send(&rpcpb.SubscribeResponse{MsgType: core.TopicEventsDropped, Data: strconv.FormatUint(n, 10)}); err != nil {
+				return err
+			}
+			events, err := emitter.CatchUp(chainEventCh)
 			if err != nil {
 				return err
 			}
+			for _, event := range events {
+				if err := gs.Send(&rpcpb.SubscribeResponse{MsgType: event.Topic, Data: event.Data, Id: event.ID}); err != nil {
+					return err
+				}
+			}
 		case event := <-netEventCh:
 			switch event.MessageType() {
 			case core.MessageTypeNewBlock:
@@ -587,118 +774,1245 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 	}
 }
 
-// GetGasPrice get gas price from chain.
-func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GasPriceResponse, error) {
+// SubscribeBlocks pushes the blocks joining the canonical chain,
+// with their decoded transactions and events by the verbosity.
+func (s *APIService) SubscribeBlocks(req *rpcpb.SubscribeBlocksRequest, gs rpcpb.ApiService_SubscribeBlocksServer) error {
 	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/getGasPrice",
+		"verbosity": req.Verbosity,
+		"api":       "/v1/user/subscribeBlocks",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
-	gasPrice := neb.BlockChain().GasPrice()
-	return &rpcpb.GasPriceResponse{GasPrice: gasPrice.String()}, nil
+	neb := s.server.Chain(gs.Context())
+
+	blockCh := make(chan *core.Event, 128)
+	emitter := neb.EventEmitter()
+	emitter.Register(core.TopicNewTailBlock, blockCh)
+	defer emitter.Deregister(core.TopicNewTailBlock, blockCh)
+
+	for {
+		select {
+		case <-gs.Context().Done():
+			return gs.Context().Err()
+		case event := <-blockCh:
+			hash, err := byteutils.FromHex(event.Data)
+			if err != nil {
+				return err
+			}
+			block := neb.BlockChain().GetBlock(hash)
+			if block == nil {
+				return ErrBlockNotFound
+			}
+			resp, err := toBlockResponse(block, req.Verbosity, neb.BlockChain().ABIRegistry())
+			if err != nil {
+				return err
+			}
+			if err := gs.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
 }
 
-// EstimateGas Compute the smart contract gas consumption.
-func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+// DumpBlocks streams the blocks of the canonical chain from the start height,
+// loading the next block only once the previous one is sent.
+func (s *APIService) DumpBlocks(req *rpcpb.DumpBlocksRequest, gs rpcpb.ApiService_DumpBlocksServer) error {
 	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/estimateGas",
+		"start":     req.StartHeight,
+		"count":     req.Count,
+		"verbosity": req.Verbosity,
+		"api":       "/v1/user/dumpBlocks",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
-	tail := neb.BlockChain().TailBlock()
-	addr, err := core.AddressParse(req.From)
+	neb := s.server.Chain(gs.Context())
+	to := uint64(math.MaxUint64)
+	if req.Count > 0 && req.StartHeight+req.Count-1 >= req.StartHeight {
+		to = req.StartHeight + req.Count - 1
+	}
+	it, err := neb.BlockChain().IterateBlocksByHeight(req.StartHeight, to)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if req.Nonce <= tail.GetNonce(addr.Bytes()) {
-		return nil, errors.New("nonce is invalid")
+	for {
+		if err := gs.Context().Err(); err != nil {
+			return err
+		}
+		exist, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return nil
+		}
+		resp, err := toBlockResponse(it.Block(), req.Verbosity, neb.BlockChain().ABIRegistry())
+		if err != nil {
+			return err
+		}
+		if err := gs.Send(resp); err != nil {
+			return err
+		}
 	}
+}
 
-	tx, err := parseTransaction(neb, req)
+func toBlockResponse(block *core.Block, verbosity rpcpb.SubscribeBlocksRequest_Verbosity, abis *core.ABIRegistry) (*rpcpb.SubscribeBlocksResponse, error) {
+	resp := &rpcpb.SubscribeBlocksResponse{
+		Hash:       block.Hash().String(),
+		ParentHash: block.ParentHash().String(),
+		Height:     block.Height(),
+		Timestamp:  block.Timestamp(),
+		Coinbase:   block.Coinbase().String(),
+		StateRoot:  block.StateRoot().String(),
+		TxsRoot:    block.TxsRoot().String(),
+		EventsRoot: block.EventsRoot().String(),
+	}
+	if verbosity == rpcpb.SubscribeBlocksRequest_HEADER {
+		return resp, nil
+	}
+
+	for _, tx := range block.Transactions() {
+		receipt, err := toTransactionResponse(tx, abis)
+		if err != nil {
+			return nil, err
+		}
+		blockTx := &rpcpb.BlockTransaction{Transaction: receipt}
+		if verbosity == rpcpb.SubscribeBlocksRequest_RECEIPTS {
+			events, err := block.FetchEvents(tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range events {
+				blockTx.Events = append(blockTx.Events, &rpcpb.Event{Topic: v.Topic, Data: v.Data})
+			}
+		}
+		resp.Transactions = append(resp.Transactions, blockTx)
+	}
+	return resp, nil
+}
+
+// GetContractStorage get the value in the storage of a contract
+func (s *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"key":     req.Key,
+		"height":  req.Height,
+		"api":     "/v1/user/getContractStorage",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	addr, err := core.AddressParse(req.Address)
 	if err != nil {
 		return nil, err
 	}
-	estimateGas, err := neb.BlockChain().EstimateGas(tx)
+	block := neb.BlockChain().TailBlock()
+	if req.Height > 0 {
+		if block, err = neb.BlockChain().GetBlockByHeight(req.Height); err != nil {
+			return nil, err
+		}
+		if err = neb.BlockChain().CheckStateAvailable(block); err != nil {
+			return nil, err
+		}
+	}
+
+	value, proof, err := block.GetContractStorage(addr, req.Key, req.Proof)
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.EstimateGasResponse{EstimateGas: estimateGas.String()}, nil
+	resp := &rpcpb.GetContractStorageResponse{
+		Value:     string(value),
+		Height:    block.Height(),
+		BlockHash: block.Hash().String(),
+		StateRoot: block.StateRoot().String(),
+	}
+	if proof != nil {
+		resp.Proof = &rpcpb.StorageProof{
+			Account:      byteutils.Hex(proof.Account),
+			AccountProof: toProofNodes(proof.AccountProof),
+			StorageProof: toProofNodes(proof.StorageProof),
+		}
+	}
+	return resp, nil
 }
 
-// GetEventsByHash return events by tx hash.
-func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.EventsResponse, error) {
+// GetBalanceProof return the transactions of an address in a range of blocks
+// with their merkle proofs, and the proofs of its account at both ends.
+func (s *APIService) GetBalanceProof(ctx context.Context, req *rpcpb.BalanceProofRequest) (*rpcpb.BalanceProofResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/getEventsByHash",
+		"address": req.Address,
+		"from":    req.From,
+		"to":      req.To,
+		"api":     "/v1/user/getBalanceProof",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
-	bhash, _ := byteutils.FromHex(req.GetHash())
-	tx, err := neb.BlockChain().TailBlock().GetTransaction(bhash)
+	neb := s.server.Chain(ctx)
+	addr, err := core.AddressParse(req.Address)
 	if err != nil {
 		return nil, err
 	}
-	if tx != nil {
-		result, err := neb.BlockChain().TailBlock().FetchEvents(tx.Hash())
+	proof, err := neb.BlockChain().GetBalanceProof(addr, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := core.VerifyBalanceProof(neb.BlockChain().ChainID(), proof)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.BalanceProofResponse{
+		Start:   toAccountStateProof(proof.Start),
+		End:     toAccountStateProof(proof.End),
+		Changes: []*rpcpb.BalanceChangeProof{},
+	}
+	for i, change := range proof.Changes {
+		receipt, err := toTransactionResponse(txs[i], neb.BlockChain().ABIRegistry())
 		if err != nil {
 			return nil, err
 		}
-		events := []*rpcpb.Event{}
-		for _, v := range result {
-			event := &rpcpb.Event{Topic: v.Topic, Data: v.Data}
-			events = append(events, event)
+		resp.Changes = append(resp.Changes, &rpcpb.BalanceChangeProof{
+			Height:        change.Height,
+			BlockHash:     change.BlockHash.String(),
+			TxsRoot:       change.TxsRoot.String(),
+			Tx:            byteutils.Hex(change.Tx),
+			Proof:         toProofNodes(change.Proof),
+			Transaction:   receipt,
+			ParentTxsRoot: change.ParentTxsRoot.String(),
+			AbsenceProof:  toProofNodes(change.AbsenceProof),
+		})
+	}
+	return resp, nil
+}
+
+func toAccountStateProof(proof *core.AccountProof) *rpcpb.AccountStateProof {
+	return &rpcpb.AccountStateProof{
+		Height:    proof.Height,
+		BlockHash: proof.BlockHash.String(),
+		StateRoot: proof.StateRoot.String(),
+		Account:   byteutils.Hex(proof.Account),
+		Proof:     toProofNodes(proof.Proof),
+	}
+}
+
+func toProofNodes(proof trie.MerkleProof) []*rpcpb.ProofNode {
+	nodes := []*rpcpb.ProofNode{}
+	for _, v := range proof {
+		node := &rpcpb.ProofNode{}
+		for _, val := range v {
+			node.Val = append(node.Val, byteutils.Hex(val))
 		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
 
-		return &rpcpb.EventsResponse{Events: events}, nil
+// NewFilter create a filter of events
+func (s *APIService) NewFilter(ctx context.Context, req *rpcpb.NewFilterRequest) (*rpcpb.NewFilterResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"addresses": req.Addresses,
+		"topics":    req.Topics,
+		"from":      req.FromHeight,
+		"to":        req.ToHeight,
+		"api":       "/v1/user/newFilter",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	crit := &core.LogFilter{
+		Topics:     req.Topics,
+		FromHeight: req.FromHeight,
+		ToHeight:   req.ToHeight,
+	}
+	for _, v := range req.Addresses {
+		addr, err := core.AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		crit.Addresses = append(crit.Addresses, addr)
+	}
+	id, err := neb.BlockChain().FilterManager().NewFilter(crit, requestClient(ctx))
+	if err != nil {
+		return nil, err
 	}
+	return &rpcpb.NewFilterResponse{FilterId: id}, nil
+}
 
-	return nil, nil
+// GetFilterChanges get the events changed since the last poll of the filter
+func (s *APIService) GetFilterChanges(ctx context.Context, req *rpcpb.FilterRequest) (*rpcpb.LogsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"filter": req.FilterId,
+		"api":    "/v1/user/getFilterChanges",
+	}).Info("Rpc request.")
 
+	neb := s.server.Chain(ctx)
+	logs, err := neb.BlockChain().FilterManager().FilterChanges(req.FilterId)
+	if err != nil {
+		return nil, err
+	}
+	return toLogsResponse(logs), nil
 }
 
-// ChangeNetworkID change the network id
-func (s *APIService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNetworkIDRequest) (*rpcpb.ChangeNetworkIDResponse, error) {
+// GetFilterLogs get all events matching the filter in the canonical chain
+func (s *APIService) GetFilterLogs(ctx context.Context, req *rpcpb.FilterRequest) (*rpcpb.LogsResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/changeNetworkID",
+		"filter": req.FilterId,
+		"api":    "/v1/user/getFilterLogs",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
-	neb.NetManager().Node().Config().NetworkID = req.NetworkId
-	// broadcast to all the node in the routetable.
-	neb.NetManager().BroadcastNetworkID(byteutils.FromUint32(req.NetworkId))
-	return &rpcpb.ChangeNetworkIDResponse{Result: true}, nil
+	neb := s.server.Chain(ctx)
+	logs, err := neb.BlockChain().FilterManager().FilterLogs(req.FilterId)
+	if err != nil {
+		return nil, err
+	}
+	return toLogsResponse(logs), nil
 }
 
-// StartMine start mine
-func (s *APIService) StartMine(ctx context.Context, req *rpcpb.StartMineRequest) (*rpcpb.MineResponse, error) {
+// UninstallFilter remove the filter
+func (s *APIService) UninstallFilter(ctx context.Context, req *rpcpb.FilterRequest) (*rpcpb.UninstallFilterResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/startMine",
+		"filter": req.FilterId,
+		"api":    "/v1/user/uninstallFilter",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
+	result := neb.BlockChain().FilterManager().UninstallFilter(req.FilterId)
+	return &rpcpb.UninstallFilterResponse{Result: result}, nil
+}
+
+// GetAnchor get the state root of a child chain anchored into the chain
+func (s *APIService) GetAnchor(ctx context.Context, req *rpcpb.GetAnchorRequest) (*rpcpb.GetAnchorResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"chainID":      req.ChainId,
+		"anchorHeight": req.AnchorHeight,
+		"height":       req.Height,
+		"api":          "/v1/user/getAnchor",
+	}).Info("Rpc request.")
 
-	if neb.Consensus().Mining() {
-		return nil, errors.New("consensus has already been started")
+	neb := s.server.Chain(ctx)
+	block := neb.BlockChain().TailBlock()
+	var err error
+	if req.Height > 0 {
+		if block, err = neb.BlockChain().GetBlockByHeight(req.Height); err != nil {
+			return nil, err
+		}
+		if err = neb.BlockChain().CheckStateAvailable(block); err != nil {
+			return nil, err
+		}
+	}
+	anchorHeight := req.AnchorHeight
+	if anchorHeight == 0 {
+		if anchorHeight, err = block.LatestAnchorHeight(req.ChainId); err != nil {
+			return nil, err
+		}
 	}
 
-	err := neb.Consensus().StartMining([]byte(req.Passphrase))
+	stateRoot, proof, err := block.GetAnchor(req.ChainId, anchorHeight, req.Proof)
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.MineResponse{Result: true}, nil
+	resp := &rpcpb.GetAnchorResponse{
+		AnchorHeight: anchorHeight,
+		StateRoot:    stateRoot.String(),
+		Height:       block.Height(),
+		BlockHash:    block.Hash().String(),
+		AnchorsRoot:  block.AnchorsRoot().String(),
+	}
+	if proof != nil {
+		resp.Proof = toProofNodes(proof)
+	}
+	return resp, nil
 }
 
-// StopMine stop mine
-func (s *APIService) StopMine(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.MineResponse, error) {
+// GetHeaderProof return the headers of a range of heights batched by dynasty.
+func (s *APIService) GetHeaderProof(ctx context.Context, req *rpcpb.GetHeaderProofRequest) (*rpcpb.HeaderProofResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/stopMine",
+		"from": req.FromHeight,
+		"to":   req.ToHeight,
+		"api":  "/v1/user/getHeaderProof",
 	}).Info("Rpc request.")
 
-	neb := s.server.Neblet()
+	neb := s.server.Chain(ctx)
+	batches, err := neb.BlockChain().GetHeaderProof(req.FromHeight, req.ToHeight)
+	if err != nil {
+		return nil, err
+	}
 
-	if !neb.Consensus().Mining() {
-		return nil, errors.New("consensus not start yet")
+	resp := &rpcpb.HeaderProofResponse{}
+	for _, batch := range batches {
+		pbBatch := &rpcpb.HeaderBatch{DynastyRoot: batch.DynastyRoot.String()}
+		for i, v := range batch.Validators {
+			pbBatch.Validators = append(pbBatch.Validators, v.String())
+			pbBatch.Proofs = append(pbBatch.Proofs, &rpcpb.ValidatorProof{Nodes: toProofNodes(batch.Proofs[i])})
+		}
+		for _, header := range batch.Headers {
+			pbHeader := &rpcpb.ProvedHeader{Height: header.Height, Header: header.Header}
+			for _, hash := range header.TxHashes {
+				pbHeader.TxHashes = append(pbHeader.TxHashes, hash.String())
+			}
+			pbBatch.Headers = append(pbBatch.Headers, pbHeader)
+		}
+		resp.Batches = append(resp.Batches, pbBatch)
 	}
+	return resp, nil
+}
 
-	neb.Consensus().StopMining()
-	return &rpcpb.MineResponse{Result: true}, nil
+// GetBroadcastStatus returns the broadcast status of a tx submitted to the node.
+func (s *APIService) GetBroadcastStatus(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.BroadcastStatusResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"hash": req.Hash,
+		"api":  "/v1/user/getBroadcastStatus",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	hash, err := parseHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	status, err := neb.BlockChain().TransactionPool().BroadcastStatus(hash)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.BroadcastStatusResponse{
+		Status:        status.Status,
+		Submitted:     status.Submitted.Unix(),
+		LastBroadcast: status.LastBroadcast.Unix(),
+		Broadcasts:    uint32(status.Broadcasts),
+		BlockHeight:   status.BlockHeight,
+	}, nil
+}
+
+// GetAccountNextNonce returns the nonce of the next tx of the account, counting its txs in the pool.
+func (s *APIService) GetAccountNextNonce(ctx context.Context, req *rpcpb.GetAccountNextNonceRequest) (*rpcpb.GetAccountNextNonceResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"api":     "/v1/user/getAccountNextNonce",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	next, onchain := neb.BlockChain().GetAccountNextNonce(addr)
+	return &rpcpb.GetAccountNextNonceResponse{
+		Nonce:        next,
+		OnchainNonce: onchain,
+	}, nil
+}
+
+// GetExecutionReceipt returns the result of the execution of a tx in the canonical chain.
+func (s *APIService) GetExecutionReceipt(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.ExecutionReceiptResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"hash": req.Hash,
+		"api":  "/v1/user/getExecutionReceipt",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	hash, err := parseHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := neb.BlockChain().GetTransactionReceipt(hash)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.ExecutionReceiptResponse{
+		Hash:            receipt.TxHash,
+		BlockHash:       receipt.BlockHash,
+		BlockHeight:     receipt.Height,
+		Index:           receipt.Index,
+		Status:          receipt.Status,
+		GasUsed:         receipt.GasUsed,
+		ContractAddress: receipt.ContractAddress,
+		Error:           receipt.Error,
+	}
+	for _, v := range receipt.Events {
+		resp.Events = append(resp.Events, &rpcpb.Event{Topic: v.Topic, Data: v.Data})
+	}
+	return resp, nil
+}
+
+// GetPoolStats returns the pending counts of the pools and the counts of the txs and blocks they refused by reason.
+func (s *APIService) GetPoolStats(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.PoolStatsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/getPoolStats",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	stats := neb.BlockChain().PoolStats()
+	resp := &rpcpb.PoolStatsResponse{
+		PendingTxs:    uint32(stats.PendingTxs),
+		PendingBlocks: uint32(stats.PendingBlocks),
+		TxRejected:    make(map[string]uint64, len(stats.TxRejected)),
+		BlockRejected: make(map[string]uint64, len(stats.BlockRejected)),
+	}
+	for reason, count := range stats.TxRejected {
+		resp.TxRejected[reason] = uint64(count)
+	}
+	for reason, count := range stats.BlockRejected {
+		resp.BlockRejected[reason] = uint64(count)
+	}
+	return resp, nil
+}
+
+// GetBlockFinality returns whether the block is final, it can't be reverted by a fork.
+func (s *APIService) GetBlockFinality(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockFinalityResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"hash": req.Hash,
+		"api":  "/v1/user/getBlockFinality",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	bhash, err := parseHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	block := neb.BlockChain().GetBlock(bhash)
+	if block == nil {
+		return nil, ErrBlockNotFound
+	}
+	lib := neb.BlockChain().LIB()
+	return &rpcpb.BlockFinalityResponse{
+		IsFinal:   neb.BlockChain().IsFinal(block),
+		Height:    block.Height(),
+		Lib:       lib.Hash().String(),
+		LibHeight: lib.Height(),
+	}, nil
+}
+
+// GetDailyAnalytics returns the daily aggregates of the chain in the range.
+func (s *APIService) GetDailyAnalytics(ctx context.Context, req *rpcpb.DailyAnalyticsRequest) (*rpcpb.DailyAnalyticsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"from": req.From,
+		"to":   req.To,
+		"api":  "/v1/user/getDailyAnalytics",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	analytics := neb.BlockChain().Analytics()
+	if analytics == nil {
+		return nil, core.ErrAnalyticsDisabled
+	}
+	days, err := analytics.Daily(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.DailyAnalyticsResponse{Days: make([]*rpcpb.DailyStats, len(days))}
+	for i, day := range days {
+		resp.Days[i] = &rpcpb.DailyStats{
+			Date:            day.Date,
+			Blocks:          day.Blocks,
+			TxCount:         day.TxCount,
+			ActiveAddresses: day.ActiveAddresses,
+			GasUsed:         day.GasUsed,
+			Fees:            day.Fees,
+		}
+	}
+	return resp, nil
+}
+
+// GetLibrary return the source of a library deployed on chain
+func (s *APIService) GetLibrary(ctx context.Context, req *rpcpb.GetLibraryRequest) (*rpcpb.GetLibraryResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"ref":    req.Ref,
+		"height": req.Height,
+		"api":    "/v1/user/getLibrary",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	block := neb.BlockChain().TailBlock()
+	var err error
+	if req.Height > 0 {
+		if block, err = neb.BlockChain().GetBlockByHeight(req.Height); err != nil {
+			return nil, err
+		}
+		if err = neb.BlockChain().CheckStateAvailable(block); err != nil {
+			return nil, err
+		}
+	}
+
+	libHash, source, err := block.GetLibrary(req.Ref)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetLibraryResponse{
+		Hash:   libHash.String(),
+		Source: source,
+	}, nil
+}
+
+// VerifyExit verify an account of a child chain against its latest anchored state root
+func (s *APIService) VerifyExit(ctx context.Context, req *rpcpb.VerifyExitRequest) (*rpcpb.VerifyExitResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"chainID": req.ChainId,
+		"address": req.Address,
+		"api":     "/v1/user/verifyExit",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	account, err := parseHex(req.Account)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := fromProofNodes(req.AccountProof)
+	if err != nil {
+		return nil, err
+	}
+
+	tail := neb.BlockChain().TailBlock()
+	anchorHeight, err := tail.LatestAnchorHeight(req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, _, err := tail.GetAnchor(req.ChainId, anchorHeight, false)
+	if err != nil {
+		return nil, err
+	}
+	pbAcc, err := core.VerifyExitAccount(stateRoot, addr.Bytes(), account, proof)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := util.NewUint128FromFixedSizeByteSlice(pbAcc.Balance)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.VerifyExitResponse{AnchorHeight: anchorHeight, Balance: balance.String(), Nonce: pbAcc.Nonce}, nil
+}
+
+func fromProofNodes(nodes []*rpcpb.ProofNode) (trie.MerkleProof, error) {
+	proof := trie.MerkleProof{}
+	for _, node := range nodes {
+		var vals [][]byte
+		for _, v := range node.Val {
+			val, err := parseHex(v)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, val)
+		}
+		proof = append(proof, vals)
+	}
+	return proof, nil
+}
+
+func toLogsResponse(logs []*core.Log) *rpcpb.LogsResponse {
+	resp := &rpcpb.LogsResponse{Logs: []*rpcpb.Log{}}
+	for _, v := range logs {
+		resp.Logs = append(resp.Logs, &rpcpb.Log{
+			BlockHash: v.BlockHash.String(),
+			Height:    v.Height,
+			TxHash:    v.TxHash.String(),
+			Index:     uint32(v.Index),
+			Topic:     v.Topic,
+			Data:      v.Data,
+			Removed:   v.Removed,
+		})
+	}
+	return resp
+}
+
+// GetGasPrice get the gas price suggested by the recent blocks.
+func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.GasPriceRequest) (*rpcpb.GasPriceResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"percentile":     req.Percentile,
+		"has_percentile": req.HasPercentile,
+		"api":            "/v1/user/getGasPrice",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	gasPrice := neb.BlockChain().GasPrice()
+	if req.HasPercentile {
+		gasPrice = neb.BlockChain().SuggestGasPrice(req.Percentile)
+	}
+	return &rpcpb.GasPriceResponse{GasPrice: gasPrice.String()}, nil
+}
+
+// GetChainConfig return the consensus params, forks and limits of the chain.
+func (s *APIService) GetChainConfig(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.ChainConfigResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/chainConfig",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	chain := neb.BlockChain()
+	pool := chain.TransactionPool()
+	forks := core.ForksOf(chain.ChainID())
+	blockGasLimit := core.DefaultBlockGasLimit
+	if limit := forks.BlockGasLimitAt(chain.TailBlock().Height() + 1); limit != nil {
+		blockGasLimit = limit
+	}
+
+	return &rpcpb.ChainConfigResponse{
+		ChainId:            chain.ChainID(),
+		GenesisHash:        chain.GenesisBlock().Hash().String(),
+		BlockInterval:      core.BlockInterval,
+		DynastyInterval:    core.DynastyInterval,
+		DynastySize:        core.DynastySize,
+		SafeSize:           core.SafeSize,
+		GasScheduleVersion: core.GasScheduleVersion,
+		Forks: &rpcpb.ChainForks{
+			ContractContextHeight: forks.ContractContextHeight,
+			SupplyHeight:          forks.SupplyHeight,
+			FeeBurnHeight:         forks.FeeBurnHeight,
+			FeeBurnPercent:        forks.FeeBurnPercent,
+			HeaderV1Height:        forks.HeaderV1Height,
+			BlockGasLimitHeight:   forks.BlockGasLimitHeight,
+			MessageHeight:         forks.MessageHeight,
+			VmLimitsHeight:        forks.VMLimitsHeight,
+			NumericPolicyHeight:   forks.NumericPolicyHeight,
+		},
+		Limits: &rpcpb.ChainLimits{
+			TxsPerBlock:          core.TxsPerBlock,
+			MaxGas:               core.TransactionMaxGas.String(),
+			MaxGasPrice:          core.TransactionMaxGasPrice.String(),
+			MinGasPrice:          pool.GasPrice().String(),
+			GasLimit:             pool.GasLimit().String(),
+			MinGasPerTransaction: core.MinGasCountPerTransaction.String(),
+			GasPerByte:           core.GasCountPerByte.String(),
+			BlockGasLimit:        blockGasLimit.String(),
+			MaxFilterBlockRange:  core.MaxFilterBlockRange,
+		},
+	}, nil
+}
+
+// GetSupplyInfo return the supply of the chain after the block of the height.
+func (s *APIService) GetSupplyInfo(ctx context.Context, req *rpcpb.GetSupplyInfoRequest) (*rpcpb.SupplyInfoResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"height": req.Height,
+		"api":    "/v1/user/getSupplyInfo",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	block := neb.BlockChain().TailBlock()
+	if req.Height > 0 {
+		var err error
+		if block, err = neb.BlockChain().GetBlockByHeight(req.Height); err != nil {
+			return nil, err
+		}
+		if err = neb.BlockChain().CheckStateAvailable(block); err != nil {
+			return nil, err
+		}
+	}
+
+	info, err := neb.BlockChain().SupplyInfo(block)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.SupplyInfoResponse{
+		Height:  info.Height,
+		Initial: info.Initial.String(),
+		Issued:  info.Issued.String(),
+		Burned:  info.Burned.String(),
+		Total:   info.Total.String(),
+		Tracked: info.Tracked,
+	}, nil
+}
+
+// GetMempoolStats return the congestion of the transaction pool.
+func (s *APIService) GetMempoolStats(ctx context.Context, req *rpcpb.GetMempoolStatsRequest) (*rpcpb.MempoolStatsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"gasPrice": req.GasPrice,
+		"api":      "/v1/user/getMempoolStats",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	gasPrice := neb.BlockChain().GasPrice()
+	if len(req.GasPrice) > 0 {
+		var ok bool
+		if gasPrice, ok = util.NewUint128().FromString(req.GasPrice); !ok {
+			return nil, ErrInvalidGasPrice
+		}
+	}
+	stats := neb.BlockChain().TransactionPool().Stats(gasPrice)
+	resp := &rpcpb.MempoolStatsResponse{
+		TxCount:           uint32(stats.TxCount),
+		TxBytes:           uint64(stats.Size),
+		Histogram:         []*rpcpb.GasPriceBucket{},
+		GasPrice:          gasPrice.String(),
+		BlocksToInclusion: uint32(stats.BlocksToInclusion),
+	}
+	for _, bucket := range stats.Histogram {
+		resp.Histogram = append(resp.Histogram, &rpcpb.GasPriceBucket{
+			MinGasPrice: bucket.MinGasPrice.String(),
+			Count:       uint32(bucket.Count),
+		})
+	}
+	return resp, nil
+}
+
+// GetPendingBlock return the transactions the producer would pack in the next block now.
+func (s *APIService) GetPendingBlock(ctx context.Context, req *rpcpb.GetPendingBlockRequest) (*rpcpb.PendingBlockResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"hash": req.Hash,
+		"api":  "/v1/user/getPendingBlock",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	var hash byteutils.Hash
+	if len(req.Hash) > 0 {
+		var err error
+		if hash, err = parseHash(req.Hash); err != nil {
+			return nil, err
+		}
+	}
+
+	pending, err := neb.BlockChain().PendingBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.PendingBlockResponse{
+		Height:       pending.Height,
+		ParentHash:   pending.ParentHash.String(),
+		Transactions: []*rpcpb.PendingTransaction{},
+		GasUsed:      pending.GasUsed.String(),
+		GasLimit:     pending.GasLimit.String(),
+		PoolSize:     uint32(pending.PoolSize),
+		Index:        -1,
+	}
+	for i, v := range pending.Transactions {
+		tx, err := toTransactionResponse(v.Tx, neb.BlockChain().ABIRegistry())
+		if err != nil {
+			return nil, err
+		}
+		resp.Transactions = append(resp.Transactions, &rpcpb.PendingTransaction{Transaction: tx, Gas: v.Gas.String()})
+		if hash != nil && hash.Equals(v.Tx.Hash()) {
+			resp.Index = int32(i)
+		}
+	}
+	return resp, nil
+}
+
+// EstimateGas Compute the smart contract gas consumption.
+func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.EstimateGasResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/estimateGas",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	tail := neb.BlockChain().TailBlock()
+	addr, err := core.AddressParse(req.From)
+	if err != nil {
+		return nil, err
+	}
+	if req.Nonce <= tail.GetNonce(addr.Bytes()) {
+		return nil, core.ErrSmallTransactionNonce
+	}
+
+	tx, err := parseTransaction(neb, req)
+	if err != nil {
+		return nil, err
+	}
+	estimateGas, err := neb.BlockChain().EstimateGas(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.EstimateGasResponse{EstimateGas: estimateGas.String()}, nil
+}
+
+// ProfileGas estimates the gas of the transaction with the gas profile of the contract execution.
+func (s *APIService) ProfileGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.ProfileGasResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/profileGas",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	tail := neb.BlockChain().TailBlock()
+	addr, err := core.AddressParse(req.From)
+	if err != nil {
+		return nil, err
+	}
+	if req.Nonce <= tail.GetNonce(addr.Bytes()) {
+		return nil, core.ErrSmallTransactionNonce
+	}
+
+	tx, err := parseTransaction(neb, req)
+	if err != nil {
+		return nil, err
+	}
+	estimateGas, profiler, err := neb.BlockChain().ProfileGas(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.ProfileGasResponse{
+		EstimateGas:  estimateGas.String(),
+		ExecutionGas: profiler.Total(),
+		Folded:       profiler.Folded(),
+	}
+	for _, f := range profiler.Functions() {
+		resp.Functions = append(resp.Functions, &rpcpb.FunctionGas{Frame: f.Frame, Self: f.Self, Total: f.Total})
+	}
+	return resp, nil
+}
+
+// GetEventsByHash return events by tx hash.
+func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.EventsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/getEventsByHash",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	bhash, err := parseHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.cache.chain(neb).get("events."+bhash.String(), false, func() (interface{}, error) {
+		tx, err := neb.BlockChain().TailBlock().GetTransaction(bhash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			return nil, nil
+		}
+		result, err := neb.BlockChain().TailBlock().FetchEvents(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		events := []*rpcpb.Event{}
+		for _, v := range result {
+			event := &rpcpb.Event{Topic: v.Topic, Data: v.Data}
+			events = append(events, event)
+		}
+		return &rpcpb.EventsResponse{Events: events}, nil
+	})
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return resp.(*rpcpb.EventsResponse), nil
+}
+
+// ChangeNetworkID change the network id
+func (s *APIService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNetworkIDRequest) (*rpcpb.ChangeNetworkIDResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/changeNetworkID",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	audit.Record(audit.ActionConfigChange, requestOrigin(ctx), "network_id", fmt.Sprintf("%d -> %d", neb.NetManager().Node().Config().NetworkID, req.NetworkId), nil)
+	neb.NetManager().Node().Config().NetworkID = req.NetworkId
+	// broadcast to all the node in the routetable.
+	neb.NetManager().BroadcastNetworkID(byteutils.FromUint32(req.NetworkId))
+	return &rpcpb.ChangeNetworkIDResponse{Result: true}, nil
+}
+
+// StartMine start mine
+func (s *APIService) StartMine(ctx context.Context, req *rpcpb.StartMineRequest) (*rpcpb.MineResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/startMine",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	if neb.Consensus().Mining() {
+		return nil, ErrMiningAlreadyStarted
+	}
+
+	err := neb.Consensus().StartMining([]byte(req.Passphrase))
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.MineResponse{Result: true}, nil
+}
+
+// StopMine stop mine
+func (s *APIService) StopMine(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.MineResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/stopMine",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	if !neb.Consensus().Mining() {
+		return nil, ErrMiningNotStarted
+	}
+
+	neb.Consensus().StopMining()
+	return &rpcpb.MineResponse{Result: true}, nil
+}
+
+// CompactStorage starts compacting the storage in background.
+func (s *APIService) CompactStorage(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.CompactStorageResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/compactStorage",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	if err := neb.CompactionScheduler().Trigger(); err != nil {
+		return nil, err
+	}
+	return &rpcpb.CompactStorageResponse{Result: true}, nil
+}
+
+// GetNodeDiagnostics return the report of the self-check run on boot.
+func (s *APIService) GetNodeDiagnostics(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.NodeDiagnosticsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/diagnostics",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	report := neb.Diagnostics()
+	resp := &rpcpb.NodeDiagnosticsResponse{
+		Timestamp: report.Time().Unix(),
+		Passed:    report.Passed(),
+		Checks:    []*rpcpb.DiagnosticCheck{},
+	}
+	for _, c := range report.Checks() {
+		resp.Checks = append(resp.Checks, &rpcpb.DiagnosticCheck{
+			Name:    c.Name,
+			Passed:  c.Passed,
+			Message: c.Message,
+			Elapsed: int64(c.Elapsed / time.Millisecond),
+		})
+	}
+	return resp, nil
+}
+
+// GetFeatures return the experimental features of the binary and whether each is enabled.
+func (s *APIService) GetFeatures(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.FeaturesResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/features",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	flags := neb.Features()
+	resp := &rpcpb.FeaturesResponse{Features: []*rpcpb.FeatureState{}}
+	for _, f := range feature.Known() {
+		resp.Features = append(resp.Features, &rpcpb.FeatureState{
+			Name:        f.Name,
+			Description: f.Description,
+			Enabled:     flags.Enabled(f.Name),
+		})
+	}
+	return resp, nil
+}
+
+// SimulateReorg builds and adopts a fork reverting the canonical blocks, on
+// the nodes with the dev_mode feature of a dev chain, i.e. not of a bundled
+// network but devnet.
+func (s *APIService) SimulateReorg(ctx context.Context, req *rpcpb.SimulateReorgRequest) (*rpcpb.SimulateReorgResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"depth": req.Depth,
+		"api":   "/v1/admin/simulateReorg",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+	if !neb.Features().Enabled(feature.DevMode) {
+		return nil, ErrFeatureDisabled
+	}
+	if p, ok := profile.ByChainID(neb.BlockChain().ChainID()); ok && !p.Dev {
+		return nil, ErrNotDevChain
+	}
+
+	tail, err := neb.Consensus().SimulateReorg(ctx, req.Depth)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.SimulateReorgResponse{TailHash: tail.Hash().String(), TailHeight: tail.Height()}, nil
+}
+
+// WatchAddress add or remove an address of the watch list.
+func (s *APIService) WatchAddress(ctx context.Context, req *rpcpb.WatchAddressRequest) (*rpcpb.WatchAddressResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"unwatch": req.Unwatch,
+		"api":     "/v1/admin/watchAddress",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	watchList := neb.BlockChain().WatchList()
+	if req.Unwatch {
+		result, err := watchList.Unwatch(addr)
+		if err != nil {
+			return nil, err
+		}
+		return &rpcpb.WatchAddressResponse{Result: result}, nil
+	}
+	if err := watchList.Watch(addr); err != nil {
+		return nil, err
+	}
+	return &rpcpb.WatchAddressResponse{Result: true}, nil
+}
+
+// RegisterContractABI register the abi of a contract, or remove it.
+func (s *APIService) RegisterContractABI(ctx context.Context, req *rpcpb.RegisterContractABIRequest) (*rpcpb.RegisterContractABIResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address":    req.Address,
+		"unregister": req.Unregister,
+		"api":        "/v1/admin/registerContractABI",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	registry := neb.BlockChain().ABIRegistry()
+	result := true
+	if req.Unregister {
+		result, err = registry.Unregister(addr)
+	} else {
+		abi := &core.ContractABI{}
+		if err := json.Unmarshal([]byte(req.Abi), abi); err != nil {
+			return nil, core.ErrInvalidContractABI
+		}
+		err = registry.Register(addr, abi)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the cached responses are decoded with the previous abi.
+	s.cache.chain(neb).purge()
+	return &rpcpb.RegisterContractABIResponse{Result: result}, nil
+}
+
+// GetWatchedAddresses return the addresses of the watch list and their activity.
+func (s *APIService) GetWatchedAddresses(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.WatchedAddressesResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/watchedAddresses",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	addresses := []*rpcpb.WatchedAddress{}
+	for _, w := range neb.BlockChain().WatchList().Watched() {
+		addresses = append(addresses, &rpcpb.WatchedAddress{
+			Address: w.Address,
+			Balance: w.Balance,
+			Nonce:   w.Nonce,
+			TxCount: w.TxCount,
+			Height:  w.Height,
+		})
+	}
+	return &rpcpb.WatchedAddressesResponse{Addresses: addresses}, nil
+}
+
+func toDepositAddresses(addresses []*core.DepositAddress) []*rpcpb.DepositAddress {
+	result := []*rpcpb.DepositAddress{}
+	for _, v := range addresses {
+		result = append(result, &rpcpb.DepositAddress{
+			Address:  v.Address,
+			Index:    v.Index,
+			Received: v.Received,
+		})
+	}
+	return result
+}
+
+// DeriveDepositAddresses derive the next deposit addresses of an extended public key and watch them.
+func (s *APIService) DeriveDepositAddresses(ctx context.Context, req *rpcpb.DeriveDepositAddressesRequest) (*rpcpb.DeriveDepositAddressesResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"xpub":  req.Xpub,
+		"count": req.Count,
+		"api":   "/v1/admin/deriveDepositAddresses",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	addresses, err := neb.BlockChain().DepositLedger().Derive(req.Xpub, req.Count)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.DeriveDepositAddressesResponse{Addresses: toDepositAddresses(addresses)}, nil
+}
+
+// GetDeposits return the deposit addresses of an extended public key and their credits.
+func (s *APIService) GetDeposits(ctx context.Context, req *rpcpb.GetDepositsRequest) (*rpcpb.GetDepositsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"xpub":       req.Xpub,
+		"fromHeight": req.FromHeight,
+		"api":        "/v1/admin/deposits",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	addresses, credits, err := neb.BlockChain().DepositLedger().Deposits(req.Xpub, req.FromHeight)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.GetDepositsResponse{
+		Addresses: toDepositAddresses(addresses),
+		Credits:   []*rpcpb.DepositCredit{},
+	}
+	for _, c := range credits {
+		resp.Credits = append(resp.Credits, &rpcpb.DepositCredit{
+			Address:   c.Address,
+			Index:     c.Index,
+			From:      c.From,
+			Amount:    c.Amount,
+			TxHash:    c.TxHash,
+			BlockHash: c.BlockHash,
+			Height:    c.Height,
+		})
+	}
+	return resp, nil
+}
+
+// parseHash parses a hash of the request strictly, the malformed ones never
+// reach the chain.
+func parseHash(s string) (byteutils.Hash, error) {
+	hash, err := byteutils.ParseHash(s)
+	if err != nil {
+		return nil, ErrInvalidHash
+	}
+	return hash, nil
+}
+
+// parseHex parses the hex string of user input which isn't a hash, e.g. an
+// address or an encoded account.
+func parseHex(s string) (byteutils.Hash, error) {
+	data, err := byteutils.ParseHex(s)
+	if err != nil {
+		return nil, ErrInvalidHex
+	}
+	return data, nil
+}
+
+// GetBlockHeader returns the header of a block by hash, or of the canonical block at the height.
+func (s *APIService) GetBlockHeader(ctx context.Context, req *rpcpb.BlockHeaderRequest) (*rpcpb.BlockHeaderResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"hash":   req.Hash,
+		"height": req.Height,
+		"api":    "/v1/user/getBlockHeader",
+	}).Info("Rpc request.")
+
+	neb := s.server.Chain(ctx)
+
+	var (
+		header *core.ChainHeader
+		err    error
+	)
+	if len(req.Hash) > 0 {
+		bhash, err := parseHash(req.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if header, err = neb.BlockChain().GetBlockHeader(bhash); err != nil {
+			return nil, ErrBlockNotFound
+		}
+	} else if header, err = neb.BlockChain().GetHeaderByHeight(req.Height); err != nil {
+		return nil, err
+	}
+	pbHeader, err := header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.BlockHeaderResponse{
+		Header: pbHeader,
+		Height: header.Height(),
+	}
+	if gasUsed := header.GasUsed(); gasUsed != nil {
+		resp.GasUsed = gasUsed.String()
+	}
+	return resp, nil
 }
@@ -0,0 +1,123 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/util/audit"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	adminServicePrefix = "/rpcpb.AdminService/"
+	// forwardedForKey is the metadata set by the gateway with the http client address.
+	forwardedForKey = "x-forwarded-for"
+	// gatewayKey is the metadata proving a request comes from the gateway of the node.
+	gatewayKey = "x-neb-gateway"
+)
+
+// auditedMethods are the api methods using unlocked keys, besides all admin methods.
+var auditedMethods = map[string]bool{
+	"/rpcpb.ApiService/SendTransaction": true,
+}
+
+// gatewayToken is the secret the gateway of this process adds to its
+// requests, the forwarded address of a request without it is not trusted.
+var gatewayToken = newGatewayToken()
+
+func newGatewayToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// gatewayInterceptor adds the gateway token to the requests of the gateway,
+// replacing one set by the http client.
+func gatewayInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	md := metadata.MD{}
+	if out, ok := metadata.FromOutgoingContext(ctx); ok {
+		for k, v := range out {
+			md[k] = v
+		}
+	}
+	md[gatewayKey] = []string{gatewayToken}
+	return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+}
+
+// auditInterceptor records admin and signing requests with their origin in the audit log.
+func auditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if strings.HasPrefix(info.FullMethod, adminServicePrefix) || auditedMethods[info.FullMethod] {
+		audit.Record(audit.ActionAdminRPC, requestOrigin(ctx), info.FullMethod, tenantFromContext(ctx), err)
+	}
+	return resp, err
+}
+
+// requestOrigin returns the peer address of the request. The http client
+// address is recorded before it for the requests through the gateway of the
+// node, any other client may set it.
+func requestOrigin(ctx context.Context) string {
+	origin := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		origin = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok && fromGateway(md) {
+		if v := md[forwardedForKey]; len(v) > 0 && len(v[0]) > 0 {
+			return v[0] + " via " + origin
+		}
+	}
+	return origin
+}
+
+// requestClient returns the client of the request the node limits the
+// resources of: its tenant, else the host it comes from. The gateway appends
+// the address it's called from to the forwarded ones, the last one is trusted.
+func requestClient(ctx context.Context) string {
+	if name := tenantFromContext(ctx); len(name) > 0 {
+		return "tenant:" + name
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok && fromGateway(md) {
+		if v := md[forwardedForKey]; len(v) > 0 && len(v[0]) > 0 {
+			hosts := strings.Split(v[0], ",")
+			return strings.TrimSpace(hosts[len(hosts)-1])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+func fromGateway(md metadata.MD) bool {
+	v := md[gatewayKey]
+	return len(v) == 1 && subtle.ConstantTimeCompare([]byte(v[0]), []byte(gatewayToken)) == 1
+}
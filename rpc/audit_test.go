@@ -0,0 +1,67 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRequestOrigin(t *testing.T) {
+	assert.Equal(t, "unknown", requestOrigin(context.Background()))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000}})
+	assert.Equal(t, "10.0.0.1:5000", requestOrigin(ctx))
+
+	// the forwarded address is only trusted from the gateway.
+	forged := metadata.NewIncomingContext(ctx, metadata.Pairs(forwardedForKey, "1.2.3.4"))
+	assert.Equal(t, "10.0.0.1:5000", requestOrigin(forged))
+	forged = metadata.NewIncomingContext(ctx, metadata.Pairs(forwardedForKey, "1.2.3.4", gatewayKey, "guess"))
+	assert.Equal(t, "10.0.0.1:5000", requestOrigin(forged))
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	out := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(forwardedForKey, "1.2.3.4", gatewayKey, "guess"))
+	assert.Nil(t, gatewayInterceptor(out, "/rpcpb.AdminService/Accounts", nil, nil, nil, invoker))
+	ctx = metadata.NewIncomingContext(ctx, md)
+	assert.Equal(t, "1.2.3.4 via 10.0.0.1:5000", requestOrigin(ctx))
+}
+
+func TestRequestClient(t *testing.T) {
+	assert.Equal(t, "unknown", requestClient(context.Background()))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000}})
+	assert.Equal(t, "10.0.0.1", requestClient(ctx))
+	forged := metadata.NewIncomingContext(ctx, metadata.Pairs(forwardedForKey, "1.2.3.4"))
+	assert.Equal(t, "10.0.0.1", requestClient(forged))
+
+	gateway := metadata.NewIncomingContext(ctx, metadata.Pairs(forwardedForKey, "5.6.7.8, 1.2.3.4", gatewayKey, gatewayToken))
+	assert.Equal(t, "1.2.3.4", requestClient(gateway))
+
+	assert.Equal(t, "tenant:bob", requestClient(context.WithValue(ctx, tenantKey{}, "bob")))
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"sync"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// ResponseCacheSize is the number of responses of each kind kept for a chain.
+const ResponseCacheSize = 4096
+
+var (
+	responseCacheHitCounter  = metrics.GetOrRegisterCounter("rpc.cache.hit", nil)
+	responseCacheMissCounter = metrics.GetOrRegisterCounter("rpc.cache.miss", nil)
+)
+
+// responseCache keeps the responses about blocks and transactions by hash, so
+// repeated queries of explorers don't deserialize them again. A block never
+// changes under its hash, its responses are only evicted by newer ones. The
+// responses about a transaction hold while its block is in the canonical
+// chain, they are all invalidated when a block is reverted.
+type responseCache struct {
+	mu     sync.Mutex
+	chains map[*core.BlockChain]*chainResponseCache
+}
+
+type chainResponseCache struct {
+	emitter *core.EventEmitter
+
+	// final are the responses about blocks, pending about transactions.
+	final   *lru.Cache
+	pending *lru.Cache
+	// reverts counts the reverted blocks, a response loaded meanwhile is not cached.
+	reverts uint64
+
+	eventCh chan *core.Event
+	quitCh  chan int
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{chains: make(map[*core.BlockChain]*chainResponseCache)}
+}
+
+// chain returns the cache of the chain, created by its first query.
+func (c *responseCache) chain(neb Neblet) *chainResponseCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	bc := neb.BlockChain()
+	if cc, ok := c.chains[bc]; ok {
+		return cc
+	}
+	final, _ := lru.New(ResponseCacheSize)
+	pending, _ := lru.New(ResponseCacheSize)
+	cc := &chainResponseCache{
+		emitter: neb.EventEmitter(),
+		final:   final,
+		pending: pending,
+		eventCh: make(chan *core.Event, 128),
+		quitCh:  make(chan int, 1),
+	}
+	cc.emitter.Register(core.TopicRevertBlock, cc.eventCh)
+	go cc.loop()
+	c.chains[bc] = cc
+	return cc
+}
+
+func (c *responseCache) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for bc, cc := range c.chains {
+		cc.emitter.Deregister(core.TopicRevertBlock, cc.eventCh)
+		cc.quitCh <- 0
+		delete(c.chains, bc)
+	}
+}
+
+func (cc *chainResponseCache) loop() {
+	for {
+		select {
+		case <-cc.quitCh:
+			return
+		case e := <-cc.eventCh:
+			logging.VLog().WithFields(logrus.Fields{
+				"block": e.Data,
+			}).Debug("Invalidate cached transaction responses on reverted block.")
+			atomic.AddUint64(&cc.reverts, 1)
+			cc.pending.Purge()
+		}
+	}
+}
+
+// purge drops the responses about blocks and transactions, e.g. when the abis
+// decoding them change.
+func (cc *chainResponseCache) purge() {
+	cc.final.Purge()
+	cc.pending.Purge()
+}
+
+// get returns the cached response of the key, or loads and caches it. The
+// responses about blocks are final, the ones about transactions are not.
+func (cc *chainResponseCache) get(key string, final bool, load func() (interface{}, error)) (interface{}, error) {
+	cache := cc.pending
+	if final {
+		cache = cc.final
+	}
+	if v, ok := cache.Get(key); ok {
+		responseCacheHitCounter.Inc(1)
+		return v, nil
+	}
+	responseCacheMissCounter.Inc(1)
+	reverts := atomic.LoadUint64(&cc.reverts)
+	v, err := load()
+	if err != nil || v == nil {
+		return v, err
+	}
+	if final || atomic.LoadUint64(&cc.reverts) == reverts {
+		cache.Add(key, v)
+	}
+	return v, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCacheNeblet struct {
+	Neblet
+	bc      *core.BlockChain
+	emitter *core.EventEmitter
+}
+
+func (n *mockCacheNeblet) BlockChain() *core.BlockChain {
+	return n.bc
+}
+
+func (n *mockCacheNeblet) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func TestResponseCache(t *testing.T) {
+	emitter := core.NewEventEmitter(16)
+	emitter.Start()
+	defer emitter.Stop()
+	neb := &mockCacheNeblet{bc: &core.BlockChain{}, emitter: emitter}
+
+	cache := newResponseCache()
+	defer cache.stop()
+	cc := cache.chain(neb)
+	assert.Equal(t, cc, cache.chain(neb))
+
+	loads := 0
+	load := func(v interface{}, err error) func() (interface{}, error) {
+		return func() (interface{}, error) {
+			loads++
+			return v, err
+		}
+	}
+
+	// errors and missing responses are not cached.
+	_, err := cc.get("block.a", true, load(nil, errors.New("not found")))
+	assert.NotNil(t, err)
+	v, err := cc.get("events.a", false, load(nil, nil))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+	assert.Equal(t, 2, loads)
+
+	for i := 0; i < 2; i++ {
+		v, err = cc.get("block.b", true, load("block", nil))
+		assert.Nil(t, err)
+		assert.Equal(t, "block", v)
+		v, err = cc.get("receipt.b", false, load("receipt", nil))
+		assert.Nil(t, err)
+		assert.Equal(t, "receipt", v)
+	}
+	assert.Equal(t, 4, loads)
+
+	// a reverted block invalidates the responses about transactions only.
+	emitter.Trigger(&core.Event{Topic: core.TopicRevertBlock, Data: "hash"})
+	for i := 0; i < 100 && cc.pending.Len() > 0; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+	assert.Equal(t, 0, cc.pending.Len())
+	cc.get("block.b", true, load("block", nil))
+	assert.Equal(t, 4, loads)
+	cc.get("receipt.b", false, load("receipt", nil))
+	assert.Equal(t, 5, loads)
+
+	// a registered abi invalidates all the responses.
+	cc.purge()
+	assert.Equal(t, 0, cc.final.Len())
+	assert.Equal(t, 0, cc.pending.Len())
+}
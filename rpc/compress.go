@@ -0,0 +1,126 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// GzipMinSize is the size under which the responses of the gateway are not compressed.
+const GzipMinSize = 1024
+
+// immutablePaths are the gateway paths whose response never changes for a
+// request, they carry an ETag and conditional requests matching it get 304.
+var immutablePaths = map[string]bool{
+	"/v1/user/getBlockByHash": true,
+	"/v1/user/getLibrary":     true,
+}
+
+// streamPaths are the gateway paths of streams, their responses are written
+// as they come and never buffered.
+var streamPaths = map[string]bool{
+	"/v1/user/subscribe":       true,
+	"/v1/user/subscribeBlocks": true,
+}
+
+// bufferedResponseWriter keeps the response to decide its encoding once complete.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+// etagMatch returns whether the If-None-Match header lists the etag, with the weak comparison.
+func etagMatch(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// compressionHandler gzips the responses of the gateway for the clients
+// accepting it, and answers the conditional requests of immutable paths
+// with 304 Not Modified when the response is unchanged.
+func compressionHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if streamPaths[r.URL.Path] || strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		buf := &bufferedResponseWriter{header: w.Header()}
+		h.ServeHTTP(buf, r)
+		if buf.status == 0 {
+			buf.status = http.StatusOK
+		}
+		body := buf.body.Bytes()
+
+		if buf.status == http.StatusOK && immutablePaths[r.URL.Path] {
+			// weak, since the gzip encoding of the same response differs.
+			etag := "W/\"" + byteutils.Hex(hash.Sha3256(body)[:16]) + "\""
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+			if match := r.Header.Get("If-None-Match"); len(match) > 0 && etagMatch(match, etag) {
+				w.Header().Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		if len(body) < GzipMinSize || !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.WriteHeader(buf.status)
+			w.Write(body)
+			return
+		}
+		var compressed bytes.Buffer
+		zw := gzip.NewWriter(&compressed)
+		zw.Write(body)
+		zw.Close()
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Set("Content-Length", strconv.Itoa(compressed.Len()))
+		w.WriteHeader(buf.status)
+		w.Write(compressed.Bytes())
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionHandler(t *testing.T) {
+	large := strings.Repeat("block", GzipMinSize)
+	h := compressionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/user/nebstate" {
+			w.Write([]byte("small"))
+			return
+		}
+		w.Write([]byte(large))
+	}))
+	serve := func(path string, headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", path, nil)
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	gzipped := map[string]string{"Accept-Encoding": "gzip, deflate"}
+
+	w := serve("/v1/user/nebstate", gzipped)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "", w.Header().Get("ETag"))
+	assert.Equal(t, "small", w.Body.String())
+
+	w = serve("/v1/user/subscribe", gzipped)
+	assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, large, w.Body.String())
+
+	w = serve("/v1/user/getBlockByHash", gzipped)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	zr, err := gzip.NewReader(w.Body)
+	assert.Nil(t, err)
+	body, _ := ioutil.ReadAll(zr)
+	assert.Equal(t, large, string(body))
+	etag := w.Header().Get("ETag")
+	assert.True(t, strings.HasPrefix(etag, "W/\""))
+
+	w = serve("/v1/user/getBlockByHash", map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, 0, w.Body.Len())
+	w = serve("/v1/user/getBlockByHash", map[string]string{"If-None-Match": "\"other\""})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, large, w.Body.String())
+
+	// only the responses of immutable paths are conditional.
+	w = serve("/v1/user/accountstate", map[string]string{"If-None-Match": "*"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "", w.Header().Get("ETag"))
+}
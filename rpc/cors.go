@@ -0,0 +1,126 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+var (
+	defaultCorsMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
+	defaultCorsHeaders = []string{"Content-Type", "Accept", "Authorization", "Grpc-Metadata-Neb-Api-Key"}
+)
+
+// corsPolicy decides the cross-origin access of browsers to the gateway.
+type corsPolicy struct {
+	anyOrigin   bool
+	origins     []string
+	methods     string
+	headers     string
+	credentials bool
+	maxAge      string
+}
+
+func newCorsPolicy(conf *nebletpb.HttpCorsConfig) *corsPolicy {
+	if conf == nil {
+		conf = &nebletpb.HttpCorsConfig{}
+	}
+	p := &corsPolicy{
+		anyOrigin:   len(conf.AllowedOrigins) == 0,
+		methods:     strings.Join(defaultCorsMethods, ","),
+		headers:     strings.Join(defaultCorsHeaders, ","),
+		credentials: conf.AllowCredentials,
+	}
+	for _, origin := range conf.AllowedOrigins {
+		if origin == "*" {
+			p.anyOrigin = true
+		}
+		p.origins = append(p.origins, strings.ToLower(origin))
+	}
+	if len(conf.AllowedMethods) > 0 {
+		p.methods = strings.ToUpper(strings.Join(conf.AllowedMethods, ","))
+	}
+	if len(conf.AllowedHeaders) > 0 {
+		p.headers = strings.Join(conf.AllowedHeaders, ",")
+	}
+	if conf.MaxAge > 0 {
+		p.maxAge = strconv.FormatUint(uint64(conf.MaxAge), 10)
+	}
+	return p
+}
+
+// allowed returns whether the origin matches any allowed origin, a leading
+// "*." in the host of an allowed origin matches any subdomain.
+func (p *corsPolicy) allowed(origin string) bool {
+	if p.anyOrigin {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	for _, o := range p.origins {
+		if o == origin {
+			return true
+		}
+		if i := strings.Index(o, "://*."); i >= 0 {
+			scheme, suffix := o[:i+3], o[i+4:]
+			if strings.HasPrefix(origin, scheme) && strings.HasSuffix(origin, suffix) && len(origin) > len(scheme)+len(suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// handler sets the CORS headers for allowed origins and answers preflight requests.
+// Websocket upgrades of other origins are refused, since browsers don't apply CORS to them.
+func (p *corsPolicy) handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if !p.allowed(origin) {
+			if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				http.Error(w, "origin not allowed", http.StatusForbidden)
+				return
+			}
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+		if p.credentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Headers", p.headers)
+			w.Header().Set("Access-Control-Allow-Methods", p.methods)
+			if len(p.maxAge) > 0 {
+				w.Header().Set("Access-Control-Max-Age", p.maxAge)
+			}
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
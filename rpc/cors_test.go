@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorsPolicy_Allowed(t *testing.T) {
+	p := newCorsPolicy(nil)
+	assert.True(t, p.allowed("http://anything"))
+
+	p = newCorsPolicy(&nebletpb.HttpCorsConfig{AllowedOrigins: []string{"https://dapp.io", "https://*.example.com"}})
+	assert.True(t, p.allowed("https://dapp.io"))
+	assert.True(t, p.allowed("HTTPS://DAPP.IO"))
+	assert.True(t, p.allowed("https://a.example.com"))
+	assert.True(t, p.allowed("https://a.b.example.com"))
+	assert.False(t, p.allowed("https://example.com"))
+	assert.False(t, p.allowed("https://evilexample.com"))
+	assert.False(t, p.allowed("http://a.example.com"))
+	assert.False(t, p.allowed("https://dapp.io.evil"))
+}
+
+func TestCorsPolicy_Handler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	h := newCorsPolicy(&nebletpb.HttpCorsConfig{
+		AllowedOrigins:   []string{"https://dapp.io"},
+		AllowedMethods:   []string{"post"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	}).handler(next)
+
+	serve := func(method, origin string, headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/v1/user/nebstate", nil)
+		if len(origin) > 0 {
+			r.Header.Set("Origin", origin)
+		}
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve("OPTIONS", "https://dapp.io", map[string]string{"Access-Control-Request-Method": "POST"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://dapp.io", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "POST", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+
+	w = serve("GET", "https://dapp.io", nil)
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, "https://dapp.io", w.Header().Get("Access-Control-Allow-Origin"))
+
+	w = serve("GET", "https://evil.io", nil)
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+
+	w = serve("GET", "https://evil.io", map[string]string{"Upgrade": "websocket"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serve("GET", "", nil)
+	assert.Equal(t, http.StatusTeapot, w.Code)
+}
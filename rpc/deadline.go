@@ -0,0 +1,61 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"strings"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultRequestTimeout is the time an api request runs at most if not configured.
+const DefaultRequestTimeout = 30 * time.Second
+
+var (
+	deadlineExceededCounter = metrics.GetOrRegisterCounter("rpc.deadline.exceeded", nil)
+)
+
+// deadlineInterceptor bounds the requests of the api service by the timeout,
+// on top of the deadline of the client the gateway forwards as Grpc-Timeout.
+// The contract executions and trie walks of a request stop with its context,
+// so neither an abandoned http request nor a slow query runs on for minutes.
+func deadlineInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	if timeout <= 0 {
+		timeout = DefaultRequestTimeout
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, apiServicePrefix) {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			deadlineExceededCounter.Inc(1)
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeadlineInterceptor(t *testing.T) {
+	interceptor := deadlineInterceptor(10 * time.Millisecond)
+	api := &grpc.UnaryServerInfo{FullMethod: apiServicePrefix + "EstimateGas"}
+	admin := &grpc.UnaryServerInfo{FullMethod: "/rpcpb.AdminService/NodeInfo"}
+
+	// a long execution is terminated at the deadline.
+	slow := func(ctx context.Context, req interface{}) (interface{}, error) {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("execution terminated")
+		case <-time.After(time.Second):
+			return req, nil
+		}
+	}
+	_, err := interceptor(context.Background(), "req", api, slow)
+	s, _ := status.FromError(err)
+	assert.Equal(t, codes.DeadlineExceeded, s.Code())
+
+	// the deadline of the client applies if sooner.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	deadline := func(ctx context.Context, req interface{}) (interface{}, error) {
+		d, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.True(t, d.Sub(time.Now()) <= time.Millisecond)
+		return req, nil
+	}
+	resp, err := interceptor(ctx, "req", api, deadline)
+	assert.Nil(t, err)
+	assert.Equal(t, "req", resp)
+
+	// the admin service is not bounded.
+	unbounded := func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+		return req, nil
+	}
+	_, err = interceptor(context.Background(), "req", admin, unbounded)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nebulasio/go-nebulas/util/errcode"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Errors
+var (
+	ErrBlockNotFound        = errcode.New(errcode.ModuleRPC, 3001, "block not found", false)
+	ErrTransactionNotFound  = errcode.New(errcode.ModuleRPC, 3002, "transaction not found", false)
+	ErrMiningAlreadyStarted = errcode.New(errcode.ModuleRPC, 3003, "consensus has already been started", false)
+	ErrMiningNotStarted     = errcode.New(errcode.ModuleRPC, 3004, "consensus not start yet", false)
+	ErrInvalidGasPrice      = errcode.New(errcode.ModuleRPC, 3009, "invalid gas price", false)
+	ErrInvalidHash          = errcode.New(errcode.ModuleRPC, 3010, "invalid hash", false)
+	ErrFeatureDisabled      = errcode.New(errcode.ModuleRPC, 3011, "feature disabled", false)
+	ErrInvalidHex           = errcode.New(errcode.ModuleRPC, 3012, "invalid hex string", false)
+	ErrNotDevChain          = errcode.New(errcode.ModuleRPC, 3013, "not a dev chain", false)
+)
+
+// Trailer keys carrying the machine-readable error to clients,
+// the gateway forwards them as "Grpc-Trailer-" prefixed headers.
+const (
+	ErrorCodeKey      = "neb-error-code"
+	ErrorModuleKey    = "neb-error-module"
+	ErrorRetryableKey = "neb-error-retryable"
+)
+
+// errorBody is the http response body of an error.
+type errorBody struct {
+	Error     string `json:"error"`
+	Code      int32  `json:"code"`
+	ErrCode   uint32 `json:"err_code"`
+	Module    string `json:"module,omitempty"`
+	Retryable bool   `json:"retryable"`
+}
+
+// errorInterceptor converts coded errors into grpc status with error trailers.
+func errorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+	e, ok := err.(*errcode.Error)
+	if !ok {
+		return resp, err
+	}
+
+	md := metadata.Pairs(
+		ErrorCodeKey, strconv.FormatUint(uint64(e.Code()), 10),
+		ErrorModuleKey, e.Module(),
+		ErrorRetryableKey, strconv.FormatBool(e.Retryable()),
+	)
+	if err := grpc.SetTrailer(ctx, md); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"method": info.FullMethod,
+			"err":    err,
+		}).Error("Failed to set error trailer.")
+	}
+
+	code := codes.FailedPrecondition
+	if e.Retryable() {
+		code = codes.Unavailable
+	}
+	return resp, status.Error(code, e.Error())
+}
+
+// httpError writes the error of gateway with the machine-readable code in body.
+func httpError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	s, ok := status.FromError(err)
+	if !ok {
+		s = status.New(codes.Unknown, err.Error())
+	}
+	body := &errorBody{
+		Error: s.Message(),
+		Code:  int32(s.Code()),
+	}
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if v := md.TrailerMD[ErrorCodeKey]; len(v) > 0 {
+			code, _ := strconv.ParseUint(v[0], 10, 32)
+			body.ErrCode = uint32(code)
+		}
+		if v := md.TrailerMD[ErrorModuleKey]; len(v) > 0 {
+			body.Module = v[0]
+		}
+		if v := md.TrailerMD[ErrorRetryableKey]; len(v) > 0 {
+			body.Retryable, _ = strconv.ParseBool(v[0])
+		}
+	}
+
+	buf, merr := marshaler.Marshal(body)
+	if merr != nil {
+		runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+		return
+	}
+	w.Header().Del("Trailer")
+	w.Header().Set("Content-Type", marshaler.ContentType())
+	w.WriteHeader(runtime.HTTPStatusFromCode(s.Code()))
+	if _, err := w.Write(buf); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to write error response.")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/SendTransaction"}
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"coded", core.ErrInsufficientBalance, codes.FailedPrecondition},
+		{"retryable", core.ErrLargeTransactionNonce, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tt.err
+			}
+			_, err := errorInterceptor(context.Background(), nil, info, handler)
+			s, _ := status.FromError(err)
+			assert.Equal(t, tt.code, s.Code())
+			assert.Equal(t, tt.err.Error(), s.Message())
+		})
+	}
+
+	plain := errors.New("plain")
+	_, err := errorInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, plain
+	})
+	assert.Equal(t, plain, err)
+}
+
+func TestHTTPError(t *testing.T) {
+	md := runtime.ServerMetadata{
+		TrailerMD: metadata.Pairs(ErrorCodeKey, "1022", ErrorModuleKey, "core", ErrorRetryableKey, "false"),
+	}
+	ctx := runtime.NewServerMetadataContext(context.Background(), md)
+	w := httptest.NewRecorder()
+	err := status.Error(codes.FailedPrecondition, core.ErrSmallTransactionNonce.Error())
+	httpError(ctx, runtime.NewServeMux(), &runtime.JSONBuiltin{}, w, nil, err)
+
+	assert.Equal(t, 412, w.Code)
+	assert.JSONEq(t, `{"error":"cannot accept a transaction with smaller nonce","code":9,"err_code":1022,"module":"core","retryable":false}`, w.Body.String())
+}
@@ -2,13 +2,11 @@ package rpc
 
 import (
 	"flag"
-	"net/http"
-	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc"
 )
 
 // const
@@ -18,15 +16,16 @@ const (
 )
 
 // Run start gateway proxy to mapping grpc to http.
-func Run(rpcListen string, gatewayListen []string, httpModule []string) error {
+func Run(rpcListen string, conf *nebletpb.RPCConfig) error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	runtime.HTTPError = httpError
 	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+	opts := gatewayDialOptions(conf.Grpc)
 	echoEndpoint := flag.String("rpc", rpcListen, "")
-	for _, v := range httpModule {
+	for _, v := range conf.HttpModule {
 		switch v {
 		case API:
 			rpcpb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
@@ -35,33 +34,20 @@ func Run(rpcListen string, gatewayListen []string, httpModule []string) error {
 		}
 	}
 
-	for _, v := range gatewayListen {
-		err := http.ListenAndServe(v, allowCORS(mux))
+	var maxConns uint32
+	if conf.Http != nil {
+		maxConns = conf.Http.MaxConnections
+	}
+	handler := newCorsPolicy(conf.HttpCors).handler(compressionHandler(mux))
+	for _, v := range conf.HttpListen {
+		listener, err := listen(v, maxConns)
 		if err != nil {
 			return err
 		}
+		if err := newHTTPServer(v, handler, conf.Http).Serve(listener); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
-
-func allowCORS(h http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if origin := r.Header.Get("Origin"); origin != "" {
-			w.Header().Set("Access-Control-Allow-Origin", origin)
-			if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
-				preflightHandler(w, r)
-				return
-			}
-		}
-		h.ServeHTTP(w, r)
-	})
-}
-
-func preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
-	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
-	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
-	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
-	return
-}
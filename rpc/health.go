@@ -0,0 +1,65 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthServices are the service names reported by the health service,
+// the empty name stands for the whole server.
+var healthServices = []string{"", "rpcpb.ApiService", "rpcpb.AdminService"}
+
+// healthServer implements grpc.health.v1.Health. Unlike the grpc one it
+// reports the status set for the whole server, so load balancers checking
+// the empty service drain the node when it is paused.
+type healthServer struct {
+	mu       sync.Mutex
+	statuses map[string]healthpb.HealthCheckResponse_ServingStatus
+}
+
+func newHealthServer() *healthServer {
+	s := &healthServer{statuses: make(map[string]healthpb.HealthCheckResponse_ServingStatus)}
+	s.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return s
+}
+
+// Check returns the serving status of the service.
+func (s *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status, ok := s.statuses[req.Service]
+	if !ok {
+		return nil, grpc.Errorf(codes.NotFound, "unknown service")
+	}
+	return &healthpb.HealthCheckResponse{Status: status}, nil
+}
+
+func (s *healthServer) setServingStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, service := range healthServices {
+		s.statuses[service] = status
+	}
+}
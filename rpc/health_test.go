@@ -0,0 +1,48 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestAPIServer_Health(t *testing.T) {
+	s := &APIServer{health: newHealthServer()}
+	check := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := s.health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.Nil(t, err)
+		return resp.Status
+	}
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(""))
+
+	s.Resume()
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, check(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, check("rpcpb.ApiService"))
+
+	s.Pause()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check("rpcpb.AdminService"))
+
+	_, err := s.health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	assert.NotNil(t, err)
+}
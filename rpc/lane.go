@@ -0,0 +1,42 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/util/sched"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// laneInterceptor runs the requests of the api service in the best-effort
+// lane, they wait while the quota of the lane is taken. The admin and health
+// services are not queued behind the queries.
+func laneInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, apiServicePrefix) {
+		return handler(ctx, req)
+	}
+	done, err := sched.BestEffort(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	return handler(ctx, req)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"strconv"
+
+	"github.com/nebulasio/go-nebulas/util/errcode"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ChainIDKey is the metadata selecting the chain hosted in the process a request
+	// is served by, the gateway forwards it from "Grpc-Metadata-Neb-Chain-Id" header.
+	// The requests without it are served by the node's own chain.
+	ChainIDKey = "neb-chain-id"
+)
+
+// Multi-chain errors
+var (
+	ErrChainNotFound = errcode.New(errcode.ModuleRPC, 3008, "chain not hosted by the node", false)
+)
+
+// Chain returns the neblet of the chain selected by the request.
+func (s *APIServer) Chain(ctx context.Context) Neblet {
+	n, err := s.chain(ctx)
+	if err != nil {
+		// unknown chains are rejected by the interceptors before the handlers.
+		return s.neblet
+	}
+	return n
+}
+
+func (s *APIServer) chain(ctx context.Context) (Neblet, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[ChainIDKey]) == 0 {
+		return s.neblet, nil
+	}
+	chainID, err := strconv.ParseUint(md[ChainIDKey][0], 10, 32)
+	if err != nil {
+		return nil, ErrChainNotFound
+	}
+	n, ok := s.neblet.Chain(uint32(chainID))
+	if !ok {
+		return nil, ErrChainNotFound
+	}
+	return n, nil
+}
+
+// chainIDInterceptor rejects the requests to the chains not hosted by the node.
+func (s *APIServer) chainIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if _, err := s.chain(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// chainIDStreamInterceptor rejects the streams of the chains not hosted by the node.
+func (s *APIServer) chainIDStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, err := s.chain(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
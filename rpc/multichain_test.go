@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockChainNeblet struct {
+	Neblet
+	chainID uint32
+	chains  map[uint32]Neblet
+}
+
+func (n *mockChainNeblet) Config() nebletpb.Config {
+	return nebletpb.Config{Chain: &nebletpb.ChainConfig{ChainId: n.chainID}}
+}
+
+func (n *mockChainNeblet) Chain(chainID uint32) (Neblet, bool) {
+	v, ok := n.chains[chainID]
+	return v, ok
+}
+
+func TestAPIServer_Chain(t *testing.T) {
+	primary := &mockChainNeblet{chainID: 1}
+	testnet := &mockChainNeblet{chainID: 1001}
+	primary.chains = map[uint32]Neblet{1: primary, 1001: testnet}
+	s := &APIServer{neblet: primary}
+
+	withChain := func(v string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(ChainIDKey, v))
+	}
+	assert.Equal(t, primary, s.Chain(context.Background()))
+	assert.Equal(t, primary, s.Chain(withChain("1")))
+	assert.Equal(t, testnet, s.Chain(withChain("1001")))
+
+	var served Neblet
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		served = s.Chain(ctx)
+		return "ok", nil
+	}
+	call := func(ctx context.Context) error {
+		_, err := s.chainIDInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/GetNebState"}, handler)
+		return err
+	}
+	assert.Nil(t, call(withChain("1001")))
+	assert.Equal(t, testnet, served)
+	assert.Equal(t, ErrChainNotFound, call(withChain("2")))
+	assert.Equal(t, ErrChainNotFound, call(withChain("mainnet")))
+}
@@ -9,6 +9,9 @@ It is generated from these files:
 
 It has these top-level messages:
 	SubscribeRequest
+	SubscribeBlocksRequest
+	SubscribeBlocksResponse
+	BlockTransaction
 	ChangeNetworkIDRequest
 	ChangeNetworkIDResponse
 	SubscribeResponse
@@ -17,8 +20,12 @@ It has these top-level messages:
 	StatisticsNodeInfoResponse
 	RouteTable
 	GetNebStateResponse
+	NebVersionResponse
 	AccountsResponse
 	GetAccountStateRequest
+	GetStateDiffRequest
+	StateDiffResponse
+	AccountStateDiff
 	GetAccountStateResponse
 	GetDynastyResponse
 	GetDelegateVotersRequest
@@ -27,13 +34,18 @@ It has these top-level messages:
 	ContractRequest
 	CandidateRequest
 	DelegateRequest
+	AnchorRequest
+	LibraryRequest
 	SendRawTransactionRequest
 	SendTransactionResponse
 	GetBlockByHashRequest
 	GetTransactionByHashRequest
 	BlockDumpRequest
 	BlockDumpResponse
+	DumpBlocksRequest
 	TransactionReceiptResponse
+	DecodedArg
+	DecodedCall
 	NewAccountRequest
 	NewAccountResponse
 	UnlockAccountRequest
@@ -43,12 +55,81 @@ It has these top-level messages:
 	SignTransactionResponse
 	SendTransactionPassphraseRequest
 	SendTransactionPassphraseResponse
+	GasPriceRequest
 	GasPriceResponse
+	ChainConfigResponse
+	ChainForks
+	GetSupplyInfoRequest
+	SupplyInfoResponse
+	ChainLimits
+	GetMempoolStatsRequest
+	GasPriceBucket
+	MempoolStatsResponse
+	GetPendingBlockRequest
+	PendingTransaction
+	PendingBlockResponse
 	EstimateGasResponse
+	ProfileGasResponse
+	FunctionGas
 	EventsResponse
 	Event
+	GetContractStorageRequest
+	GetContractStorageResponse
+	StorageProof
+	BalanceProofRequest
+	AccountStateProof
+	BalanceChangeProof
+	BalanceProofResponse
+	ProofNode
+	NewFilterRequest
+	NewFilterResponse
+	FilterRequest
+	LogsResponse
+	Log
+	UninstallFilterResponse
+	GetAnchorRequest
+	GetAnchorResponse
+	VerifyExitRequest
+	VerifyExitResponse
+	GetHeaderProofRequest
+	HeaderProofResponse
+	HeaderBatch
+	ValidatorProof
+	ProvedHeader
+	GetLibraryRequest
+	GetLibraryResponse
 	StartMineRequest
 	MineResponse
+	CompactStorageResponse
+	DiagnosticCheck
+	NodeDiagnosticsResponse
+	SimulateReorgRequest
+	SimulateReorgResponse
+	WatchAddressRequest
+	WatchAddressResponse
+	WatchedAddress
+	WatchedAddressesResponse
+	RegisterContractABIRequest
+	RegisterContractABIResponse
+	DeriveDepositAddressesRequest
+	DepositAddress
+	DeriveDepositAddressesResponse
+	GetDepositsRequest
+	DepositCredit
+	GetDepositsResponse
+	BroadcastStatusResponse
+	GetAccountNextNonceRequest
+	GetAccountNextNonceResponse
+	ExecutionReceiptResponse
+	PoolStatsResponse
+	BlockFinalityResponse
+	DailyAnalyticsRequest
+	DailyStats
+	DailyAnalyticsResponse
+	BlockHeaderRequest
+	BlockHeaderResponse
+	FeatureState
+	FeaturesResponse
 */
 package rpcpb
 
@@ -74,6 +155,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type SubscribeBlocksRequest_Verbosity int32
+
+const (
+	// Block header only.
+	SubscribeBlocksRequest_HEADER SubscribeBlocksRequest_Verbosity = 0
+	// Header and decoded transactions.
+	SubscribeBlocksRequest_TRANSACTIONS SubscribeBlocksRequest_Verbosity = 1
+	// Header, decoded transactions and their events.
+	SubscribeBlocksRequest_RECEIPTS SubscribeBlocksRequest_Verbosity = 2
+)
+
+var SubscribeBlocksRequest_Verbosity_name = map[int32]string{
+	0: "HEADER",
+	1: "TRANSACTIONS",
+	2: "RECEIPTS",
+}
+var SubscribeBlocksRequest_Verbosity_value = map[string]int32{
+	"HEADER":       0,
+	"TRANSACTIONS": 1,
+	"RECEIPTS":     2,
+}
+
+func (x SubscribeBlocksRequest_Verbosity) String() string {
+	return proto.EnumName(SubscribeBlocksRequest_Verbosity_name, int32(x))
+}
+func (SubscribeBlocksRequest_Verbosity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{1, 0}
+}
+
 // Request message of Subscribe rpc
 type SubscribeRequest struct {
 	Topic []string `protobuf:"bytes,1,rep,name=topic" json:"topic,omitempty"`
@@ -91,6 +201,133 @@ func (m *SubscribeRequest) GetTopic() []string {
 	return nil
 }
 
+// Request message of SubscribeBlocks rpc
+type SubscribeBlocksRequest struct {
+	Verbosity SubscribeBlocksRequest_Verbosity `protobuf:"varint,1,opt,name=verbosity,proto3,enum=rpcpb.SubscribeBlocksRequest_Verbosity" json:"verbosity,omitempty"`
+}
+
+func (m *SubscribeBlocksRequest) Reset()                    { *m = SubscribeBlocksRequest{} }
+func (m *SubscribeBlocksRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeBlocksRequest) ProtoMessage()               {}
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{1} }
+
+func (m *SubscribeBlocksRequest) GetVerbosity() SubscribeBlocksRequest_Verbosity {
+	if m != nil {
+		return m.Verbosity
+	}
+	return SubscribeBlocksRequest_HEADER
+}
+
+// Response message of SubscribeBlocks rpc
+type SubscribeBlocksResponse struct {
+	// Hex string of block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of parent block hash.
+	ParentHash string `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Height     uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp  int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hex string of the coinbase address.
+	Coinbase     string              `protobuf:"bytes,5,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	StateRoot    string              `protobuf:"bytes,6,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	TxsRoot      string              `protobuf:"bytes,7,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
+	EventsRoot   string              `protobuf:"bytes,8,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
+	Transactions []*BlockTransaction `protobuf:"bytes,9,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *SubscribeBlocksResponse) Reset()                    { *m = SubscribeBlocksResponse{} }
+func (m *SubscribeBlocksResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeBlocksResponse) ProtoMessage()               {}
+func (*SubscribeBlocksResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{2} }
+
+func (m *SubscribeBlocksResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SubscribeBlocksResponse) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *SubscribeBlocksResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribeBlocksResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SubscribeBlocksResponse) GetCoinbase() string {
+	if m != nil {
+		return m.Coinbase
+	}
+	return ""
+}
+
+func (m *SubscribeBlocksResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *SubscribeBlocksResponse) GetTxsRoot() string {
+	if m != nil {
+		return m.TxsRoot
+	}
+	return ""
+}
+
+func (m *SubscribeBlocksResponse) GetEventsRoot() string {
+	if m != nil {
+		return m.EventsRoot
+	}
+	return ""
+}
+
+func (m *SubscribeBlocksResponse) GetTransactions() []*BlockTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// Transaction in SubscribeBlocksResponse.
+type BlockTransaction struct {
+	Transaction *TransactionReceiptResponse `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	// Events of the transaction, including its execution result.
+	Events []*Event `protobuf:"bytes,2,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *BlockTransaction) Reset()                    { *m = BlockTransaction{} }
+func (m *BlockTransaction) String() string            { return proto.CompactTextString(m) }
+func (*BlockTransaction) ProtoMessage()               {}
+func (*BlockTransaction) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{3} }
+
+func (m *BlockTransaction) GetTransaction() *TransactionReceiptResponse {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *BlockTransaction) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 // Request message of change networkID.
 type ChangeNetworkIDRequest struct {
 	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
@@ -99,7 +336,7 @@ type ChangeNetworkIDRequest struct {
 func (m *ChangeNetworkIDRequest) Reset()                    { *m = ChangeNetworkIDRequest{} }
 func (m *ChangeNetworkIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChangeNetworkIDRequest) ProtoMessage()               {}
-func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{1} }
+func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{4} }
 
 func (m *ChangeNetworkIDRequest) GetNetworkId() uint32 {
 	if m != nil {
@@ -116,7 +353,7 @@ type ChangeNetworkIDResponse struct {
 func (m *ChangeNetworkIDResponse) Reset()                    { *m = ChangeNetworkIDResponse{} }
 func (m *ChangeNetworkIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChangeNetworkIDResponse) ProtoMessage()               {}
-func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{2} }
+func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{5} }
 
 func (m *ChangeNetworkIDResponse) GetResult() bool {
 	if m != nil {
@@ -129,12 +366,14 @@ func (m *ChangeNetworkIDResponse) GetResult() bool {
 type SubscribeResponse struct {
 	MsgType string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
 	Data    string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Id of an event of a block, <block>/<tx>/<index>, empty for the others.
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()               {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{3} }
+func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{6} }
 
 func (m *SubscribeResponse) GetMsgType() string {
 	if m != nil {
@@ -150,6 +389,13 @@ func (m *SubscribeResponse) GetData() string {
 	return ""
 }
 
+func (m *SubscribeResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 // Request message of non params.
 type NonParamsRequest struct {
 }
@@ -157,7 +403,7 @@ type NonParamsRequest struct {
 func (m *NonParamsRequest) Reset()                    { *m = NonParamsRequest{} }
 func (m *NonParamsRequest) String() string            { return proto.CompactTextString(m) }
 func (*NonParamsRequest) ProtoMessage()               {}
-func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{4} }
+func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{7} }
 
 // Response message of node info.
 type NodeInfoResponse struct {
@@ -187,7 +433,7 @@ type NodeInfoResponse struct {
 func (m *NodeInfoResponse) Reset()                    { *m = NodeInfoResponse{} }
 func (m *NodeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfoResponse) ProtoMessage()               {}
-func (*NodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{5} }
+func (*NodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{8} }
 
 func (m *NodeInfoResponse) GetId() string {
 	if m != nil {
@@ -276,7 +522,7 @@ type StatisticsNodeInfoResponse struct {
 func (m *StatisticsNodeInfoResponse) Reset()                    { *m = StatisticsNodeInfoResponse{} }
 func (m *StatisticsNodeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsNodeInfoResponse) ProtoMessage()               {}
-func (*StatisticsNodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{6} }
+func (*StatisticsNodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{9} }
 
 func (m *StatisticsNodeInfoResponse) GetNodeID() string {
 	if m != nil {
@@ -309,12 +555,14 @@ func (m *StatisticsNodeInfoResponse) GetPeerCount() uint32 {
 type RouteTable struct {
 	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Address []string `protobuf:"bytes,2,rep,name=address" json:"address,omitempty"`
+	// Build of the peer announced in its hello, empty when unknown.
+	Build string `protobuf:"bytes,3,opt,name=build,proto3" json:"build,omitempty"`
 }
 
 func (m *RouteTable) Reset()                    { *m = RouteTable{} }
 func (m *RouteTable) String() string            { return proto.CompactTextString(m) }
 func (*RouteTable) ProtoMessage()               {}
-func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{7} }
+func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{10} }
 
 func (m *RouteTable) GetId() string {
 	if m != nil {
@@ -330,6 +578,13 @@ func (m *RouteTable) GetAddress() []string {
 	return nil
 }
 
+func (m *RouteTable) GetBuild() string {
+	if m != nil {
+		return m.Build
+	}
+	return ""
+}
+
 // Response message of GetNebState rpc.
 type GetNebStateResponse struct {
 	// Block chain id
@@ -347,12 +602,16 @@ type GetNebStateResponse struct {
 	// The peer sync status.
 	Synchronized bool   `protobuf:"varint,7,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
 	Version      string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Hex string of the latest irreversible block hash.
+	Lib string `protobuf:"bytes,9,opt,name=lib,proto3" json:"lib,omitempty"`
+	// Height of the latest irreversible block.
+	LibHeight uint64 `protobuf:"varint,10,opt,name=lib_height,json=libHeight,proto3" json:"lib_height,omitempty"`
 }
 
 func (m *GetNebStateResponse) Reset()                    { *m = GetNebStateResponse{} }
 func (m *GetNebStateResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetNebStateResponse) ProtoMessage()               {}
-func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{8} }
+func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{11} }
 
 func (m *GetNebStateResponse) GetChainId() uint32 {
 	if m != nil {
@@ -410,6 +669,116 @@ func (m *GetNebStateResponse) GetVersion() string {
 	return ""
 }
 
+func (m *GetNebStateResponse) GetLib() string {
+	if m != nil {
+		return m.Lib
+	}
+	return ""
+}
+
+func (m *GetNebStateResponse) GetLibHeight() uint64 {
+	if m != nil {
+		return m.LibHeight
+	}
+	return 0
+}
+
+// Response message of GetNebVersion rpc.
+type NebVersionResponse struct {
+	// Release version of the binary.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Git commit the binary was built from.
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Git branch the binary was built from.
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// Unix time of the commit, stable across rebuilds.
+	BuildDate int64 `protobuf:"varint,4,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	// Build tags enabled in the binary.
+	Features []string `protobuf:"bytes,5,rep,name=features" json:"features,omitempty"`
+	// Go toolchain, os and arch of the build.
+	GoVersion string `protobuf:"bytes,6,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	Os        string `protobuf:"bytes,7,opt,name=os,proto3" json:"os,omitempty"`
+	Arch      string `protobuf:"bytes,8,opt,name=arch,proto3" json:"arch,omitempty"`
+	// The neb p2p protocol and client version.
+	ProtocolVersion string `protobuf:"bytes,9,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	ClientVersion   string `protobuf:"bytes,10,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+}
+
+func (m *NebVersionResponse) Reset()                    { *m = NebVersionResponse{} }
+func (m *NebVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*NebVersionResponse) ProtoMessage()               {}
+func (*NebVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{12} }
+
+func (m *NebVersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *NebVersionResponse) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *NebVersionResponse) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *NebVersionResponse) GetBuildDate() int64 {
+	if m != nil {
+		return m.BuildDate
+	}
+	return 0
+}
+
+func (m *NebVersionResponse) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *NebVersionResponse) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
+}
+
+func (m *NebVersionResponse) GetOs() string {
+	if m != nil {
+		return m.Os
+	}
+	return ""
+}
+
+func (m *NebVersionResponse) GetArch() string {
+	if m != nil {
+		return m.Arch
+	}
+	return ""
+}
+
+func (m *NebVersionResponse) GetProtocolVersion() string {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return ""
+}
+
+func (m *NebVersionResponse) GetClientVersion() string {
+	if m != nil {
+		return m.ClientVersion
+	}
+	return ""
+}
+
 // Response message of Accounts rpc.
 type AccountsResponse struct {
 	// Account list
@@ -419,7 +788,7 @@ type AccountsResponse struct {
 func (m *AccountsResponse) Reset()                    { *m = AccountsResponse{} }
 func (m *AccountsResponse) String() string            { return proto.CompactTextString(m) }
 func (*AccountsResponse) ProtoMessage()               {}
-func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{9} }
+func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{13} }
 
 func (m *AccountsResponse) GetAddresses() []string {
 	if m != nil {
@@ -439,7 +808,7 @@ type GetAccountStateRequest struct {
 func (m *GetAccountStateRequest) Reset()                    { *m = GetAccountStateRequest{} }
 func (m *GetAccountStateRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAccountStateRequest) ProtoMessage()               {}
-func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{10} }
+func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{14} }
 
 func (m *GetAccountStateRequest) GetAddress() string {
 	if m != nil {
@@ -455,6 +824,168 @@ func (m *GetAccountStateRequest) GetBlock() string {
 	return ""
 }
 
+// Request message of GetStateDiff rpc.
+type GetStateDiffRequest struct {
+	// Height of the state to diff from.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// Height of the state to diff to, above from_height.
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// Hex string of the address to start at, the next_cursor of the last page.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Max number of accounts in the page, at most 1000.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *GetStateDiffRequest) Reset()                    { *m = GetStateDiffRequest{} }
+func (m *GetStateDiffRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetStateDiffRequest) ProtoMessage()               {}
+func (*GetStateDiffRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{15} }
+
+func (m *GetStateDiffRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetStateDiffRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetStateDiffRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetStateDiffRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Response message of GetStateDiff rpc.
+type StateDiffResponse struct {
+	Accounts []*AccountStateDiff `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
+	// Cursor of the next page, empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (m *StateDiffResponse) Reset()                    { *m = StateDiffResponse{} }
+func (m *StateDiffResponse) String() string            { return proto.CompactTextString(m) }
+func (*StateDiffResponse) ProtoMessage()               {}
+func (*StateDiffResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{16} }
+
+func (m *StateDiffResponse) GetAccounts() []*AccountStateDiff {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *StateDiffResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type AccountStateDiff struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The account doesn't exist at from_height.
+	Created     bool   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	BalanceFrom string `protobuf:"bytes,3,opt,name=balance_from,json=balanceFrom,proto3" json:"balance_from,omitempty"`
+	BalanceTo   string `protobuf:"bytes,4,opt,name=balance_to,json=balanceTo,proto3" json:"balance_to,omitempty"`
+	// Signed decimal of balance_to - balance_from.
+	BalanceDelta string `protobuf:"bytes,5,opt,name=balance_delta,json=balanceDelta,proto3" json:"balance_delta,omitempty"`
+	NonceFrom    uint64 `protobuf:"varint,6,opt,name=nonce_from,json=nonceFrom,proto3" json:"nonce_from,omitempty"`
+	NonceTo      uint64 `protobuf:"varint,7,opt,name=nonce_to,json=nonceTo,proto3" json:"nonce_to,omitempty"`
+	NonceDelta   int64  `protobuf:"varint,8,opt,name=nonce_delta,json=nonceDelta,proto3" json:"nonce_delta,omitempty"`
+	// Hex strings of the changed storage keys, at most 100.
+	StorageKeys      []string `protobuf:"bytes,9,rep,name=storage_keys,json=storageKeys" json:"storage_keys,omitempty"`
+	StorageTruncated bool     `protobuf:"varint,10,opt,name=storage_truncated,json=storageTruncated,proto3" json:"storage_truncated,omitempty"`
+}
+
+func (m *AccountStateDiff) Reset()                    { *m = AccountStateDiff{} }
+func (m *AccountStateDiff) String() string            { return proto.CompactTextString(m) }
+func (*AccountStateDiff) ProtoMessage()               {}
+func (*AccountStateDiff) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{17} }
+
+func (m *AccountStateDiff) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountStateDiff) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *AccountStateDiff) GetBalanceFrom() string {
+	if m != nil {
+		return m.BalanceFrom
+	}
+	return ""
+}
+
+func (m *AccountStateDiff) GetBalanceTo() string {
+	if m != nil {
+		return m.BalanceTo
+	}
+	return ""
+}
+
+func (m *AccountStateDiff) GetBalanceDelta() string {
+	if m != nil {
+		return m.BalanceDelta
+	}
+	return ""
+}
+
+func (m *AccountStateDiff) GetNonceFrom() uint64 {
+	if m != nil {
+		return m.NonceFrom
+	}
+	return 0
+}
+
+func (m *AccountStateDiff) GetNonceTo() uint64 {
+	if m != nil {
+		return m.NonceTo
+	}
+	return 0
+}
+
+func (m *AccountStateDiff) GetNonceDelta() int64 {
+	if m != nil {
+		return m.NonceDelta
+	}
+	return 0
+}
+
+func (m *AccountStateDiff) GetStorageKeys() []string {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
+func (m *AccountStateDiff) GetStorageTruncated() bool {
+	if m != nil {
+		return m.StorageTruncated
+	}
+	return false
+}
+
 // Response message of GetAccountState rpc.
 type GetAccountStateResponse struct {
 	// Current balance in unit of 1/(10^18) nas.
@@ -466,7 +997,7 @@ type GetAccountStateResponse struct {
 func (m *GetAccountStateResponse) Reset()                    { *m = GetAccountStateResponse{} }
 func (m *GetAccountStateResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAccountStateResponse) ProtoMessage()               {}
-func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{11} }
+func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{18} }
 
 func (m *GetAccountStateResponse) GetBalance() string {
 	if m != nil {
@@ -490,7 +1021,7 @@ type GetDynastyResponse struct {
 func (m *GetDynastyResponse) Reset()                    { *m = GetDynastyResponse{} }
 func (m *GetDynastyResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetDynastyResponse) ProtoMessage()               {}
-func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{12} }
+func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{19} }
 
 func (m *GetDynastyResponse) GetDelegatees() []string {
 	if m != nil {
@@ -507,7 +1038,7 @@ type GetDelegateVotersRequest struct {
 func (m *GetDelegateVotersRequest) Reset()                    { *m = GetDelegateVotersRequest{} }
 func (m *GetDelegateVotersRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetDelegateVotersRequest) ProtoMessage()               {}
-func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{13} }
+func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{20} }
 
 func (m *GetDelegateVotersRequest) GetDelegatee() string {
 	if m != nil {
@@ -524,7 +1055,7 @@ type GetDelegateVotersResponse struct {
 func (m *GetDelegateVotersResponse) Reset()                    { *m = GetDelegateVotersResponse{} }
 func (m *GetDelegateVotersResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetDelegateVotersResponse) ProtoMessage()               {}
-func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{14} }
+func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{21} }
 
 func (m *GetDelegateVotersResponse) GetVoters() []string {
 	if m != nil {
@@ -553,12 +1084,19 @@ type TransactionRequest struct {
 	Candidate *CandidateRequest `protobuf:"bytes,8,opt,name=candidate" json:"candidate,omitempty"`
 	// delegate vote sending with this transaction.
 	Delegate *DelegateRequest `protobuf:"bytes,9,opt,name=delegate" json:"delegate,omitempty"`
+	// child chain state root anchored with this transaction.
+	Anchor *AnchorRequest `protobuf:"bytes,10,opt,name=anchor" json:"anchor,omitempty"`
+	// library version deployed with this transaction.
+	Library *LibraryRequest `protobuf:"bytes,11,opt,name=library" json:"library,omitempty"`
+	// only add the transaction to the pool of the node without sending it to
+	// the peers, for the users delivering it to the producers themselves.
+	NoRelay bool `protobuf:"varint,12,opt,name=no_relay,json=noRelay,proto3" json:"no_relay,omitempty"`
 }
 
 func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
 func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*TransactionRequest) ProtoMessage()               {}
-func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{15} }
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{22} }
 
 func (m *TransactionRequest) GetFrom() string {
 	if m != nil {
@@ -623,21 +1161,42 @@ func (m *TransactionRequest) GetDelegate() *DelegateRequest {
 	return nil
 }
 
-type ContractRequest struct {
-	// contract source code.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// contract source type, support javascript and typescript
-	SourceType string `protobuf:"bytes,2,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
-	// call contract function name
-	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
-	// the params of contract.
-	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+func (m *TransactionRequest) GetAnchor() *AnchorRequest {
+	if m != nil {
+		return m.Anchor
+	}
+	return nil
 }
 
-func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
+func (m *TransactionRequest) GetLibrary() *LibraryRequest {
+	if m != nil {
+		return m.Library
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetNoRelay() bool {
+	if m != nil {
+		return m.NoRelay
+	}
+	return false
+}
+
+type ContractRequest struct {
+	// contract source code.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// contract source type, support javascript and typescript
+	SourceType string `protobuf:"bytes,2,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// call contract function name
+	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+	// the params of contract.
+	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
 func (m *ContractRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContractRequest) ProtoMessage()               {}
-func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{16} }
+func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{23} }
 
 func (m *ContractRequest) GetSource() string {
 	if m != nil {
@@ -675,7 +1234,7 @@ type CandidateRequest struct {
 func (m *CandidateRequest) Reset()                    { *m = CandidateRequest{} }
 func (m *CandidateRequest) String() string            { return proto.CompactTextString(m) }
 func (*CandidateRequest) ProtoMessage()               {}
-func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{17} }
+func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{24} }
 
 func (m *CandidateRequest) GetAction() string {
 	if m != nil {
@@ -694,7 +1253,7 @@ type DelegateRequest struct {
 func (m *DelegateRequest) Reset()                    { *m = DelegateRequest{} }
 func (m *DelegateRequest) String() string            { return proto.CompactTextString(m) }
 func (*DelegateRequest) ProtoMessage()               {}
-func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{18} }
+func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{25} }
 
 func (m *DelegateRequest) GetAction() string {
 	if m != nil {
@@ -710,16 +1269,99 @@ func (m *DelegateRequest) GetDelegatee() string {
 	return ""
 }
 
+type AnchorRequest struct {
+	// chain id of the child chain.
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// height of the child chain block.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the state root of the child chain block.
+	StateRoot string `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// address of the operator registered for the child chain by a dynasty
+	// member, the height and the state root are unset then.
+	Operator string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *AnchorRequest) Reset()                    { *m = AnchorRequest{} }
+func (m *AnchorRequest) String() string            { return proto.CompactTextString(m) }
+func (*AnchorRequest) ProtoMessage()               {}
+func (*AnchorRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{26} }
+
+func (m *AnchorRequest) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *AnchorRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AnchorRequest) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *AnchorRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+type LibraryRequest struct {
+	// library name, owned by its first deployer.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// library version, as major.minor.patch.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// library JavaScript source code.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *LibraryRequest) Reset()                    { *m = LibraryRequest{} }
+func (m *LibraryRequest) String() string            { return proto.CompactTextString(m) }
+func (*LibraryRequest) ProtoMessage()               {}
+func (*LibraryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{27} }
+
+func (m *LibraryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LibraryRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *LibraryRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 // Request message of SendRawTransactionRequest rpc.
 type SendRawTransactionRequest struct {
 	// Signed data of transaction
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// only add the transaction to the pool of the node without sending it to
+	// the peers, for the users delivering it to the producers themselves.
+	NoRelay bool `protobuf:"varint,2,opt,name=no_relay,json=noRelay,proto3" json:"no_relay,omitempty"`
 }
 
 func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
 func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendRawTransactionRequest) ProtoMessage()               {}
-func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{19} }
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{28} }
 
 func (m *SendRawTransactionRequest) GetData() []byte {
 	if m != nil {
@@ -728,6 +1370,13 @@ func (m *SendRawTransactionRequest) GetData() []byte {
 	return nil
 }
 
+func (m *SendRawTransactionRequest) GetNoRelay() bool {
+	if m != nil {
+		return m.NoRelay
+	}
+	return false
+}
+
 // Response message of SendTransaction rpc.
 type SendTransactionResponse struct {
 	// Hex string of transaction hash.
@@ -739,7 +1388,7 @@ type SendTransactionResponse struct {
 func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
 func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendTransactionResponse) ProtoMessage()               {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{20} }
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{29} }
 
 func (m *SendTransactionResponse) GetTxhash() string {
 	if m != nil {
@@ -771,7 +1420,7 @@ type GetBlockByHashRequest struct {
 func (m *GetBlockByHashRequest) Reset()                    { *m = GetBlockByHashRequest{} }
 func (m *GetBlockByHashRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetBlockByHashRequest) ProtoMessage()               {}
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{21} }
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{30} }
 
 func (m *GetBlockByHashRequest) GetHash() string {
 	if m != nil {
@@ -790,7 +1439,7 @@ func (m *GetTransactionByHashRequest) Reset()         { *m = GetTransactionByHas
 func (m *GetTransactionByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionByHashRequest) ProtoMessage()    {}
 func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{22}
+	return fileDescriptorApiRpc, []int{31}
 }
 
 func (m *GetTransactionByHashRequest) GetHash() string {
@@ -809,7 +1458,7 @@ type BlockDumpRequest struct {
 func (m *BlockDumpRequest) Reset()                    { *m = BlockDumpRequest{} }
 func (m *BlockDumpRequest) String() string            { return proto.CompactTextString(m) }
 func (*BlockDumpRequest) ProtoMessage()               {}
-func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{23} }
+func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{32} }
 
 func (m *BlockDumpRequest) GetCount() int32 {
 	if m != nil {
@@ -827,7 +1476,7 @@ type BlockDumpResponse struct {
 func (m *BlockDumpResponse) Reset()                    { *m = BlockDumpResponse{} }
 func (m *BlockDumpResponse) String() string            { return proto.CompactTextString(m) }
 func (*BlockDumpResponse) ProtoMessage()               {}
-func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{24} }
+func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{33} }
 
 func (m *BlockDumpResponse) GetData() string {
 	if m != nil {
@@ -836,6 +1485,41 @@ func (m *BlockDumpResponse) GetData() string {
 	return ""
 }
 
+// Request message of DumpBlocks.
+type DumpBlocksRequest struct {
+	// the height of the first block.
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the count of blocks to dump, 0 to dump up to the tail.
+	Count     uint64                           `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Verbosity SubscribeBlocksRequest_Verbosity `protobuf:"varint,3,opt,name=verbosity,proto3,enum=rpcpb.SubscribeBlocksRequest_Verbosity" json:"verbosity,omitempty"`
+}
+
+func (m *DumpBlocksRequest) Reset()                    { *m = DumpBlocksRequest{} }
+func (m *DumpBlocksRequest) String() string            { return proto.CompactTextString(m) }
+func (*DumpBlocksRequest) ProtoMessage()               {}
+func (*DumpBlocksRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{34} }
+
+func (m *DumpBlocksRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DumpBlocksRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DumpBlocksRequest) GetVerbosity() SubscribeBlocksRequest_Verbosity {
+	if m != nil {
+		return m.Verbosity
+	}
+	return SubscribeBlocksRequest_HEADER
+}
+
 // Response message of TransactionReceipt.
 type TransactionReceiptResponse struct {
 	// Hex string of tx hash.
@@ -854,13 +1538,15 @@ type TransactionReceiptResponse struct {
 	GasPrice        string `protobuf:"bytes,10,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 	GasLimit        string `protobuf:"bytes,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	ContractAddress string `protobuf:"bytes,12,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// the call decoded with the abi registered for the contract, absent if none.
+	DecodedCall *DecodedCall `protobuf:"bytes,13,opt,name=decoded_call,json=decodedCall" json:"decoded_call,omitempty"`
 }
 
 func (m *TransactionReceiptResponse) Reset()         { *m = TransactionReceiptResponse{} }
 func (m *TransactionReceiptResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionReceiptResponse) ProtoMessage()    {}
 func (*TransactionReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{25}
+	return fileDescriptorApiRpc, []int{35}
 }
 
 func (m *TransactionReceiptResponse) GetHash() string {
@@ -947,6 +1633,72 @@ func (m *TransactionReceiptResponse) GetContractAddress() string {
 	return ""
 }
 
+func (m *TransactionReceiptResponse) GetDecodedCall() *DecodedCall {
+	if m != nil {
+		return m.DecodedCall
+	}
+	return nil
+}
+
+type DecodedArg struct {
+	// name of the argument in the abi, empty for the args beyond it.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// json of the value.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *DecodedArg) Reset()                    { *m = DecodedArg{} }
+func (m *DecodedArg) String() string            { return proto.CompactTextString(m) }
+func (*DecodedArg) ProtoMessage()               {}
+func (*DecodedArg) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{36} }
+
+func (m *DecodedArg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DecodedArg) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type DecodedCall struct {
+	Function string        `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	Args     []*DecodedArg `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+	// the call in the form of "transfer(\"n1...\", 10)".
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *DecodedCall) Reset()                    { *m = DecodedCall{} }
+func (m *DecodedCall) String() string            { return proto.CompactTextString(m) }
+func (*DecodedCall) ProtoMessage()               {}
+func (*DecodedCall) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{37} }
+
+func (m *DecodedCall) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *DecodedCall) GetArgs() []*DecodedArg {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *DecodedCall) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
 type NewAccountRequest struct {
 	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 }
@@ -954,7 +1706,7 @@ type NewAccountRequest struct {
 func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
 func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewAccountRequest) ProtoMessage()               {}
-func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{26} }
+func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{38} }
 
 func (m *NewAccountRequest) GetPassphrase() string {
 	if m != nil {
@@ -970,7 +1722,7 @@ type NewAccountResponse struct {
 func (m *NewAccountResponse) Reset()                    { *m = NewAccountResponse{} }
 func (m *NewAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewAccountResponse) ProtoMessage()               {}
-func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{27} }
+func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{39} }
 
 func (m *NewAccountResponse) GetAddress() string {
 	if m != nil {
@@ -988,7 +1740,7 @@ type UnlockAccountRequest struct {
 func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
 func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*UnlockAccountRequest) ProtoMessage()               {}
-func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{28} }
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{40} }
 
 func (m *UnlockAccountRequest) GetAddress() string {
 	if m != nil {
@@ -1018,7 +1770,7 @@ type UnlockAccountResponse struct {
 func (m *UnlockAccountResponse) Reset()                    { *m = UnlockAccountResponse{} }
 func (m *UnlockAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*UnlockAccountResponse) ProtoMessage()               {}
-func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{29} }
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{41} }
 
 func (m *UnlockAccountResponse) GetResult() bool {
 	if m != nil {
@@ -1034,7 +1786,7 @@ type LockAccountRequest struct {
 func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
 func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
 func (*LockAccountRequest) ProtoMessage()               {}
-func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{30} }
+func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{42} }
 
 func (m *LockAccountRequest) GetAddress() string {
 	if m != nil {
@@ -1050,7 +1802,7 @@ type LockAccountResponse struct {
 func (m *LockAccountResponse) Reset()                    { *m = LockAccountResponse{} }
 func (m *LockAccountResponse) String() string            { return proto.CompactTextString(m) }
 func (*LockAccountResponse) ProtoMessage()               {}
-func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{31} }
+func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{43} }
 
 func (m *LockAccountResponse) GetResult() bool {
 	if m != nil {
@@ -1066,7 +1818,7 @@ type SignTransactionResponse struct {
 func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
 func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignTransactionResponse) ProtoMessage()               {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{32} }
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{44} }
 
 func (m *SignTransactionResponse) GetData() []byte {
 	if m != nil {
@@ -1086,7 +1838,7 @@ func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactio
 func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
 func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{33}
+	return fileDescriptorApiRpc, []int{45}
 }
 
 func (m *SendTransactionPassphraseRequest) GetTransaction() *TransactionRequest {
@@ -1112,7 +1864,7 @@ func (m *SendTransactionPassphraseResponse) Reset()         { *m = SendTransacti
 func (m *SendTransactionPassphraseResponse) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionPassphraseResponse) ProtoMessage()    {}
 func (*SendTransactionPassphraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{34}
+	return fileDescriptorApiRpc, []int{46}
 }
 
 func (m *SendTransactionPassphraseResponse) GetHash() string {
@@ -1122,6 +1874,33 @@ func (m *SendTransactionPassphraseResponse) GetHash() string {
 	return ""
 }
 
+// Request message of GetGasPrice rpc.
+type GasPriceRequest struct {
+	// Percentile of the lowest gas prices of the recent blocks.
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// Whether the percentile is set, the median is suggested if not.
+	HasPercentile bool `protobuf:"varint,2,opt,name=has_percentile,json=hasPercentile,proto3" json:"has_percentile,omitempty"`
+}
+
+func (m *GasPriceRequest) Reset()                    { *m = GasPriceRequest{} }
+func (m *GasPriceRequest) String() string            { return proto.CompactTextString(m) }
+func (*GasPriceRequest) ProtoMessage()               {}
+func (*GasPriceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{47} }
+
+func (m *GasPriceRequest) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *GasPriceRequest) GetHasPercentile() bool {
+	if m != nil {
+		return m.HasPercentile
+	}
+	return false
+}
+
 type GasPriceResponse struct {
 	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 }
@@ -1129,7 +1908,7 @@ type GasPriceResponse struct {
 func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
 func (m *GasPriceResponse) String() string            { return proto.CompactTextString(m) }
 func (*GasPriceResponse) ProtoMessage()               {}
-func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{35} }
+func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{48} }
 
 func (m *GasPriceResponse) GetGasPrice() string {
 	if m != nil {
@@ -1138,122 +1917,2647 @@ func (m *GasPriceResponse) GetGasPrice() string {
 	return ""
 }
 
-type EstimateGasResponse struct {
-	EstimateGas string `protobuf:"bytes,1,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+// Response message of GetChainConfig rpc.
+type ChainConfigResponse struct {
+	// Block chain id
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Hash of the genesis block
+	GenesisHash string `protobuf:"bytes,2,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// Seconds between two blocks
+	BlockInterval int64 `protobuf:"varint,3,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// Seconds of a dynasty
+	DynastyInterval int64 `protobuf:"varint,4,opt,name=dynasty_interval,json=dynastyInterval,proto3" json:"dynasty_interval,omitempty"`
+	// Number of the miners in a dynasty
+	DynastySize uint32 `protobuf:"varint,5,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	// Number of the miners to confirm a block irreversible
+	SafeSize uint32 `protobuf:"varint,6,opt,name=safe_size,json=safeSize,proto3" json:"safe_size,omitempty"`
+	// Version of the gas costs of the transactions and the contracts
+	GasScheduleVersion uint32 `protobuf:"varint,7,opt,name=gas_schedule_version,json=gasScheduleVersion,proto3" json:"gas_schedule_version,omitempty"`
+	// Fork heights of the chain, 0 if not scheduled
+	Forks  *ChainForks  `protobuf:"bytes,8,opt,name=forks" json:"forks,omitempty"`
+	Limits *ChainLimits `protobuf:"bytes,9,opt,name=limits" json:"limits,omitempty"`
+}
+
+func (m *ChainConfigResponse) Reset()                    { *m = ChainConfigResponse{} }
+func (m *ChainConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChainConfigResponse) ProtoMessage()               {}
+func (*ChainConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{49} }
+
+func (m *ChainConfigResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func (m *EstimateGasResponse) Reset()                    { *m = EstimateGasResponse{} }
-func (m *EstimateGasResponse) String() string            { return proto.CompactTextString(m) }
-func (*EstimateGasResponse) ProtoMessage()               {}
-func (*EstimateGasResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{36} }
-
-func (m *EstimateGasResponse) GetEstimateGas() string {
+func (m *ChainConfigResponse) GetGenesisHash() string {
 	if m != nil {
-		return m.EstimateGas
+		return m.GenesisHash
 	}
 	return ""
 }
 
-type EventsResponse struct {
-	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+func (m *ChainConfigResponse) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
 }
 
-func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
-func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()               {}
-func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{37} }
+func (m *ChainConfigResponse) GetDynastyInterval() int64 {
+	if m != nil {
+		return m.DynastyInterval
+	}
+	return 0
+}
 
-func (m *EventsResponse) GetEvents() []*Event {
+func (m *ChainConfigResponse) GetDynastySize() uint32 {
 	if m != nil {
-		return m.Events
+		return m.DynastySize
+	}
+	return 0
+}
+
+func (m *ChainConfigResponse) GetSafeSize() uint32 {
+	if m != nil {
+		return m.SafeSize
+	}
+	return 0
+}
+
+func (m *ChainConfigResponse) GetGasScheduleVersion() uint32 {
+	if m != nil {
+		return m.GasScheduleVersion
+	}
+	return 0
+}
+
+func (m *ChainConfigResponse) GetForks() *ChainForks {
+	if m != nil {
+		return m.Forks
 	}
 	return nil
 }
 
-type Event struct {
-	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *ChainConfigResponse) GetLimits() *ChainLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
 }
 
-func (m *Event) Reset()                    { *m = Event{} }
-func (m *Event) String() string            { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()               {}
-func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{38} }
+type ChainForks struct {
+	ContractContextHeight uint64 `protobuf:"varint,1,opt,name=contract_context_height,json=contractContextHeight,proto3" json:"contract_context_height,omitempty"`
+	SupplyHeight          uint64 `protobuf:"varint,2,opt,name=supply_height,json=supplyHeight,proto3" json:"supply_height,omitempty"`
+	FeeBurnHeight         uint64 `protobuf:"varint,3,opt,name=fee_burn_height,json=feeBurnHeight,proto3" json:"fee_burn_height,omitempty"`
+	FeeBurnPercent        uint32 `protobuf:"varint,4,opt,name=fee_burn_percent,json=feeBurnPercent,proto3" json:"fee_burn_percent,omitempty"`
+	HeaderV1Height        uint64 `protobuf:"varint,5,opt,name=header_v1_height,json=headerV1Height,proto3" json:"header_v1_height,omitempty"`
+	BlockGasLimitHeight   uint64 `protobuf:"varint,6,opt,name=block_gas_limit_height,json=blockGasLimitHeight,proto3" json:"block_gas_limit_height,omitempty"`
+	MessageHeight         uint64 `protobuf:"varint,7,opt,name=message_height,json=messageHeight,proto3" json:"message_height,omitempty"`
+	VmLimitsHeight        uint64 `protobuf:"varint,8,opt,name=vm_limits_height,json=vmLimitsHeight,proto3" json:"vm_limits_height,omitempty"`
+	NumericPolicyHeight   uint64 `protobuf:"varint,9,opt,name=numeric_policy_height,json=numericPolicyHeight,proto3" json:"numeric_policy_height,omitempty"`
+}
 
-func (m *Event) GetTopic() string {
+func (m *ChainForks) Reset()                    { *m = ChainForks{} }
+func (m *ChainForks) String() string            { return proto.CompactTextString(m) }
+func (*ChainForks) ProtoMessage()               {}
+func (*ChainForks) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{50} }
+
+func (m *ChainForks) GetContractContextHeight() uint64 {
 	if m != nil {
-		return m.Topic
+		return m.ContractContextHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *Event) GetData() string {
+func (m *ChainForks) GetSupplyHeight() uint64 {
 	if m != nil {
-		return m.Data
+		return m.SupplyHeight
 	}
-	return ""
+	return 0
 }
 
-type StartMineRequest struct {
-	// miner address passphrase
-	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+func (m *ChainForks) GetFeeBurnHeight() uint64 {
+	if m != nil {
+		return m.FeeBurnHeight
+	}
+	return 0
 }
 
-func (m *StartMineRequest) Reset()                    { *m = StartMineRequest{} }
-func (m *StartMineRequest) String() string            { return proto.CompactTextString(m) }
-func (*StartMineRequest) ProtoMessage()               {}
-func (*StartMineRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{39} }
+func (m *ChainForks) GetFeeBurnPercent() uint32 {
+	if m != nil {
+		return m.FeeBurnPercent
+	}
+	return 0
+}
 
-func (m *StartMineRequest) GetPassphrase() string {
+func (m *ChainForks) GetHeaderV1Height() uint64 {
 	if m != nil {
-		return m.Passphrase
+		return m.HeaderV1Height
 	}
-	return ""
+	return 0
 }
 
-type MineResponse struct {
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *ChainForks) GetBlockGasLimitHeight() uint64 {
+	if m != nil {
+		return m.BlockGasLimitHeight
+	}
+	return 0
 }
 
-func (m *MineResponse) Reset()                    { *m = MineResponse{} }
-func (m *MineResponse) String() string            { return proto.CompactTextString(m) }
-func (*MineResponse) ProtoMessage()               {}
-func (*MineResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{40} }
+func (m *ChainForks) GetMessageHeight() uint64 {
+	if m != nil {
+		return m.MessageHeight
+	}
+	return 0
+}
 
-func (m *MineResponse) GetResult() bool {
+func (m *ChainForks) GetVmLimitsHeight() uint64 {
 	if m != nil {
-		return m.Result
+		return m.VmLimitsHeight
 	}
-	return false
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
-	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
-	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
-	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
-	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
-	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
-	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
-	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
-	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
-	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
-	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
-	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
-	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
-	proto.RegisterType((*GetDelegateVotersRequest)(nil), "rpcpb.GetDelegateVotersRequest")
-	proto.RegisterType((*GetDelegateVotersResponse)(nil), "rpcpb.GetDelegateVotersResponse")
-	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
-	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
+func (m *ChainForks) GetNumericPolicyHeight() uint64 {
+	if m != nil {
+		return m.NumericPolicyHeight
+	}
+	return 0
+}
+
+// Request message of GetSupplyInfo rpc.
+type GetSupplyInfoRequest struct {
+	// Height of the block, the tail if 0.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetSupplyInfoRequest) Reset()                    { *m = GetSupplyInfoRequest{} }
+func (m *GetSupplyInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSupplyInfoRequest) ProtoMessage()               {}
+func (*GetSupplyInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{51} }
+
+func (m *GetSupplyInfoRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetSupplyInfo rpc.
+type SupplyInfoResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Balance distributed in the genesis
+	Initial string `protobuf:"bytes,2,opt,name=initial,proto3" json:"initial,omitempty"`
+	// Sum of the block rewards
+	Issued string `protobuf:"bytes,3,opt,name=issued,proto3" json:"issued,omitempty"`
+	// Sum of the burned fees
+	Burned string `protobuf:"bytes,4,opt,name=burned,proto3" json:"burned,omitempty"`
+	// initial + issued - burned
+	Total string `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	// Recorded in the state, otherwise derived from the block reward before the supply fork
+	Tracked bool `protobuf:"varint,6,opt,name=tracked,proto3" json:"tracked,omitempty"`
+}
+
+func (m *SupplyInfoResponse) Reset()                    { *m = SupplyInfoResponse{} }
+func (m *SupplyInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*SupplyInfoResponse) ProtoMessage()               {}
+func (*SupplyInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{52} }
+
+func (m *SupplyInfoResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SupplyInfoResponse) GetInitial() string {
+	if m != nil {
+		return m.Initial
+	}
+	return ""
+}
+
+func (m *SupplyInfoResponse) GetIssued() string {
+	if m != nil {
+		return m.Issued
+	}
+	return ""
+}
+
+func (m *SupplyInfoResponse) GetBurned() string {
+	if m != nil {
+		return m.Burned
+	}
+	return ""
+}
+
+func (m *SupplyInfoResponse) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *SupplyInfoResponse) GetTracked() bool {
+	if m != nil {
+		return m.Tracked
+	}
+	return false
+}
+
+type ChainLimits struct {
+	// Max number of transactions in a block
+	TxsPerBlock uint32 `protobuf:"varint,1,opt,name=txs_per_block,json=txsPerBlock,proto3" json:"txs_per_block,omitempty"`
+	// Max gas limit of a transaction
+	MaxGas string `protobuf:"bytes,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+	// Max gas price of a transaction
+	MaxGasPrice string `protobuf:"bytes,3,opt,name=max_gas_price,json=maxGasPrice,proto3" json:"max_gas_price,omitempty"`
+	// Lowest gas price accepted by the transaction pool of this node
+	MinGasPrice string `protobuf:"bytes,4,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+	// Max gas limit accepted by the transaction pool of this node
+	GasLimit string `protobuf:"bytes,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Gas of a normal transaction
+	MinGasPerTransaction string `protobuf:"bytes,6,opt,name=min_gas_per_transaction,json=minGasPerTransaction,proto3" json:"min_gas_per_transaction,omitempty"`
+	// Gas of a byte of the transaction data
+	GasPerByte string `protobuf:"bytes,7,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty"`
+	// Gas limit of the next block, exposed to the contracts
+	BlockGasLimit string `protobuf:"bytes,8,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
+	// Max number of blocks queried by a filter
+	MaxFilterBlockRange uint64 `protobuf:"varint,9,opt,name=max_filter_block_range,json=maxFilterBlockRange,proto3" json:"max_filter_block_range,omitempty"`
+}
+
+func (m *ChainLimits) Reset()                    { *m = ChainLimits{} }
+func (m *ChainLimits) String() string            { return proto.CompactTextString(m) }
+func (*ChainLimits) ProtoMessage()               {}
+func (*ChainLimits) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{53} }
+
+func (m *ChainLimits) GetTxsPerBlock() uint32 {
+	if m != nil {
+		return m.TxsPerBlock
+	}
+	return 0
+}
+
+func (m *ChainLimits) GetMaxGas() string {
+	if m != nil {
+		return m.MaxGas
+	}
+	return ""
+}
+
+func (m *ChainLimits) GetMaxGasPrice() string {
+	if m != nil {
+		return m.MaxGasPrice
+	}
+	return ""
+}
+
+func (m *ChainLimits) GetMinGasPrice() string {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return ""
+}
+
+func (m *ChainLimits) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *ChainLimits) GetMinGasPerTransaction() string {
+	if m != nil {
+		return m.MinGasPerTransaction
+	}
+	return ""
+}
+
+func (m *ChainLimits) GetGasPerByte() string {
+	if m != nil {
+		return m.GasPerByte
+	}
+	return ""
+}
+
+func (m *ChainLimits) GetBlockGasLimit() string {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return ""
+}
+
+func (m *ChainLimits) GetMaxFilterBlockRange() uint64 {
+	if m != nil {
+		return m.MaxFilterBlockRange
+	}
+	return 0
+}
+
+// Request message of GetMempoolStats rpc
+type GetMempoolStatsRequest struct {
+	// gas price the blocks to inclusion are estimated for, the suggested gas price if empty.
+	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+}
+
+func (m *GetMempoolStatsRequest) Reset()                    { *m = GetMempoolStatsRequest{} }
+func (m *GetMempoolStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMempoolStatsRequest) ProtoMessage()               {}
+func (*GetMempoolStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{54} }
+
+func (m *GetMempoolStatsRequest) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+type GasPriceBucket struct {
+	// lowest gas price of the bucket, the next bucket starts at its double.
+	MinGasPrice string `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+	Count       uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GasPriceBucket) Reset()                    { *m = GasPriceBucket{} }
+func (m *GasPriceBucket) String() string            { return proto.CompactTextString(m) }
+func (*GasPriceBucket) ProtoMessage()               {}
+func (*GasPriceBucket) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{55} }
+
+func (m *GasPriceBucket) GetMinGasPrice() string {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return ""
+}
+
+func (m *GasPriceBucket) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type MempoolStatsResponse struct {
+	// count of the pending transactions.
+	TxCount uint32 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// bytes of the pending transactions.
+	TxBytes   uint64            `protobuf:"varint,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	Histogram []*GasPriceBucket `protobuf:"bytes,3,rep,name=histogram" json:"histogram,omitempty"`
+	GasPrice  string            `protobuf:"bytes,4,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// estimated count of blocks until a transaction at the gas price is packed.
+	BlocksToInclusion uint32 `protobuf:"varint,5,opt,name=blocks_to_inclusion,json=blocksToInclusion,proto3" json:"blocks_to_inclusion,omitempty"`
+}
+
+func (m *MempoolStatsResponse) Reset()                    { *m = MempoolStatsResponse{} }
+func (m *MempoolStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*MempoolStatsResponse) ProtoMessage()               {}
+func (*MempoolStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{56} }
+
+func (m *MempoolStatsResponse) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *MempoolStatsResponse) GetTxBytes() uint64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
+}
+
+func (m *MempoolStatsResponse) GetHistogram() []*GasPriceBucket {
+	if m != nil {
+		return m.Histogram
+	}
+	return nil
+}
+
+func (m *MempoolStatsResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *MempoolStatsResponse) GetBlocksToInclusion() uint32 {
+	if m != nil {
+		return m.BlocksToInclusion
+	}
+	return 0
+}
+
+// Request message of GetPendingBlock rpc
+type GetPendingBlockRequest struct {
+	// Hex string of a transaction hash to locate in the pending block, optional.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetPendingBlockRequest) Reset()                    { *m = GetPendingBlockRequest{} }
+func (m *GetPendingBlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetPendingBlockRequest) ProtoMessage()               {}
+func (*GetPendingBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{57} }
+
+func (m *GetPendingBlockRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type PendingTransaction struct {
+	Transaction *TransactionReceiptResponse `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	// gas used by the transaction executed after the transactions before it.
+	Gas string `protobuf:"bytes,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *PendingTransaction) Reset()                    { *m = PendingTransaction{} }
+func (m *PendingTransaction) String() string            { return proto.CompactTextString(m) }
+func (*PendingTransaction) ProtoMessage()               {}
+func (*PendingTransaction) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{58} }
+
+func (m *PendingTransaction) GetTransaction() *TransactionReceiptResponse {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *PendingTransaction) GetGas() string {
+	if m != nil {
+		return m.Gas
+	}
+	return ""
+}
+
+type PendingBlockResponse struct {
+	Height     uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ParentHash string `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	// transactions in the order packed.
+	Transactions []*PendingTransaction `protobuf:"bytes,3,rep,name=transactions" json:"transactions,omitempty"`
+	GasUsed      string                `protobuf:"bytes,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GasLimit     string                `protobuf:"bytes,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// count of the pending transactions the block is selected from.
+	PoolSize uint32 `protobuf:"varint,6,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	// index of the transaction of the requested hash, -1 if it's not packed.
+	Index int32 `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *PendingBlockResponse) Reset()                    { *m = PendingBlockResponse{} }
+func (m *PendingBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*PendingBlockResponse) ProtoMessage()               {}
+func (*PendingBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{59} }
+
+func (m *PendingBlockResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingBlockResponse) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *PendingBlockResponse) GetTransactions() []*PendingTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *PendingBlockResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *PendingBlockResponse) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *PendingBlockResponse) GetPoolSize() uint32 {
+	if m != nil {
+		return m.PoolSize
+	}
+	return 0
+}
+
+func (m *PendingBlockResponse) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type EstimateGasResponse struct {
+	EstimateGas string `protobuf:"bytes,1,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+}
+
+func (m *EstimateGasResponse) Reset()                    { *m = EstimateGasResponse{} }
+func (m *EstimateGasResponse) String() string            { return proto.CompactTextString(m) }
+func (*EstimateGasResponse) ProtoMessage()               {}
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{60} }
+
+func (m *EstimateGasResponse) GetEstimateGas() string {
+	if m != nil {
+		return m.EstimateGas
+	}
+	return ""
+}
+
+type ProfileGasResponse struct {
+	// the estimate gas of the transaction.
+	EstimateGas string `protobuf:"bytes,1,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+	// the gas of the contract execution attributed to the functions.
+	ExecutionGas uint64 `protobuf:"varint,2,opt,name=execution_gas,json=executionGas,proto3" json:"execution_gas,omitempty"`
+	// the gas of each function, by the total gas in descending order.
+	Functions []*FunctionGas `protobuf:"bytes,3,rep,name=functions" json:"functions,omitempty"`
+	// the profile in the folded stacks format of the flame graph tools, "frame;frame gas".
+	Folded []string `protobuf:"bytes,4,rep,name=folded" json:"folded,omitempty"`
+}
+
+func (m *ProfileGasResponse) Reset()                    { *m = ProfileGasResponse{} }
+func (m *ProfileGasResponse) String() string            { return proto.CompactTextString(m) }
+func (*ProfileGasResponse) ProtoMessage()               {}
+func (*ProfileGasResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{61} }
+
+func (m *ProfileGasResponse) GetEstimateGas() string {
+	if m != nil {
+		return m.EstimateGas
+	}
+	return ""
+}
+
+func (m *ProfileGasResponse) GetExecutionGas() uint64 {
+	if m != nil {
+		return m.ExecutionGas
+	}
+	return 0
+}
+
+func (m *ProfileGasResponse) GetFunctions() []*FunctionGas {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *ProfileGasResponse) GetFolded() []string {
+	if m != nil {
+		return m.Folded
+	}
+	return nil
+}
+
+type FunctionGas struct {
+	// the "name:line" of the function in the contract source.
+	Frame string `protobuf:"bytes,1,opt,name=frame,proto3" json:"frame,omitempty"`
+	// the gas of the function itself.
+	Self uint64 `protobuf:"varint,2,opt,name=self,proto3" json:"self,omitempty"`
+	// the gas of the function including its callees.
+	Total uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *FunctionGas) Reset()                    { *m = FunctionGas{} }
+func (m *FunctionGas) String() string            { return proto.CompactTextString(m) }
+func (*FunctionGas) ProtoMessage()               {}
+func (*FunctionGas) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{62} }
+
+func (m *FunctionGas) GetFrame() string {
+	if m != nil {
+		return m.Frame
+	}
+	return ""
+}
+
+func (m *FunctionGas) GetSelf() uint64 {
+	if m != nil {
+		return m.Self
+	}
+	return 0
+}
+
+func (m *FunctionGas) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type EventsResponse struct {
+	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
+func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()               {}
+func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{63} }
+
+func (m *EventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type Event struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{64} }
+
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Event) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// Request message of GetContractStorage rpc
+type GetContractStorageRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Key of LocalContractStorage, e.g. "totalSupply" or "@balances[addr]".
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Height of the block, 0 means the tail.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Return the merkle proof of the value.
+	Proof bool `protobuf:"varint,4,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageRequest{} }
+func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageRequest) ProtoMessage()    {}
+func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{65}
+}
+
+func (m *GetContractStorageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetContractStorageRequest) GetProof() bool {
+	if m != nil {
+		return m.Proof
+	}
+	return false
+}
+
+// Response message of GetContractStorage rpc
+type GetContractStorageResponse struct {
+	Value  string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the block hash.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the state root of the block.
+	StateRoot string        `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	Proof     *StorageProof `protobuf:"bytes,5,opt,name=proof" json:"proof,omitempty"`
+}
+
+func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
+func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageResponse) ProtoMessage()    {}
+func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{66}
+}
+
+func (m *GetContractStorageResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *GetContractStorageResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetContractStorageResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetContractStorageResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *GetContractStorageResponse) GetProof() *StorageProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// Merkle proof of a value in the storage of a contract.
+type StorageProof struct {
+	// Hex string of the contract account, proved against the state root.
+	Account      string       `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AccountProof []*ProofNode `protobuf:"bytes,2,rep,name=account_proof,json=accountProof" json:"account_proof,omitempty"`
+	// Proof of the value against the vars hash of the account.
+	StorageProof []*ProofNode `protobuf:"bytes,3,rep,name=storage_proof,json=storageProof" json:"storage_proof,omitempty"`
+}
+
+func (m *StorageProof) Reset()                    { *m = StorageProof{} }
+func (m *StorageProof) String() string            { return proto.CompactTextString(m) }
+func (*StorageProof) ProtoMessage()               {}
+func (*StorageProof) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{67} }
+
+func (m *StorageProof) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *StorageProof) GetAccountProof() []*ProofNode {
+	if m != nil {
+		return m.AccountProof
+	}
+	return nil
+}
+
+func (m *StorageProof) GetStorageProof() []*ProofNode {
+	if m != nil {
+		return m.StorageProof
+	}
+	return nil
+}
+
+// Request message of GetBalanceProof rpc
+type BalanceProofRequest struct {
+	// Hex string of the address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the transactions of the blocks after from up to to are returned.
+	From uint64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *BalanceProofRequest) Reset()                    { *m = BalanceProofRequest{} }
+func (m *BalanceProofRequest) String() string            { return proto.CompactTextString(m) }
+func (*BalanceProofRequest) ProtoMessage()               {}
+func (*BalanceProofRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{68} }
+
+func (m *BalanceProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceProofRequest) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *BalanceProofRequest) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+// Merkle proof of an account against the state root of a block.
+type AccountStateProof struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the block hash.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the state root of the block.
+	StateRoot string `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// Hex string of the account, empty if not in the state.
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	// Proof of the account, or of its absence if empty.
+	Proof []*ProofNode `protobuf:"bytes,5,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *AccountStateProof) Reset()                    { *m = AccountStateProof{} }
+func (m *AccountStateProof) String() string            { return proto.CompactTextString(m) }
+func (*AccountStateProof) ProtoMessage()               {}
+func (*AccountStateProof) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{69} }
+
+func (m *AccountStateProof) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AccountStateProof) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *AccountStateProof) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *AccountStateProof) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountStateProof) GetProof() []*ProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// Merkle proof of a transaction in the txs root of its block, and of its
+// absence from the txs root of the parent block.
+type BalanceChangeProof struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the block hash.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the txs root of the block.
+	TxsRoot string `protobuf:"bytes,3,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
+	// Hex string of the transaction proto, its value in the txs trie.
+	Tx          string                      `protobuf:"bytes,4,opt,name=tx,proto3" json:"tx,omitempty"`
+	Proof       []*ProofNode                `protobuf:"bytes,5,rep,name=proof" json:"proof,omitempty"`
+	Transaction *TransactionReceiptResponse `protobuf:"bytes,6,opt,name=transaction" json:"transaction,omitempty"`
+	// Hex string of the txs root of the parent block.
+	ParentTxsRoot string       `protobuf:"bytes,7,opt,name=parent_txs_root,json=parentTxsRoot,proto3" json:"parent_txs_root,omitempty"`
+	AbsenceProof  []*ProofNode `protobuf:"bytes,8,rep,name=absence_proof,json=absenceProof" json:"absence_proof,omitempty"`
+}
+
+func (m *BalanceChangeProof) Reset()                    { *m = BalanceChangeProof{} }
+func (m *BalanceChangeProof) String() string            { return proto.CompactTextString(m) }
+func (*BalanceChangeProof) ProtoMessage()               {}
+func (*BalanceChangeProof) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{70} }
+
+func (m *BalanceChangeProof) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceChangeProof) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *BalanceChangeProof) GetTxsRoot() string {
+	if m != nil {
+		return m.TxsRoot
+	}
+	return ""
+}
+
+func (m *BalanceChangeProof) GetTx() string {
+	if m != nil {
+		return m.Tx
+	}
+	return ""
+}
+
+func (m *BalanceChangeProof) GetProof() []*ProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *BalanceChangeProof) GetTransaction() *TransactionReceiptResponse {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *BalanceChangeProof) GetParentTxsRoot() string {
+	if m != nil {
+		return m.ParentTxsRoot
+	}
+	return ""
+}
+
+func (m *BalanceChangeProof) GetAbsenceProof() []*ProofNode {
+	if m != nil {
+		return m.AbsenceProof
+	}
+	return nil
+}
+
+// Response message of GetBalanceProof rpc. Only the transactions sent by the
+// address are proved complete, their nonces cover the ones of the accounts.
+// The received ones are proved included only, the gap of the balances is the
+// transfers not listed, like the ones of the contracts.
+type BalanceProofResponse struct {
+	Start   *AccountStateProof    `protobuf:"bytes,1,opt,name=start" json:"start,omitempty"`
+	End     *AccountStateProof    `protobuf:"bytes,2,opt,name=end" json:"end,omitempty"`
+	Changes []*BalanceChangeProof `protobuf:"bytes,3,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *BalanceProofResponse) Reset()                    { *m = BalanceProofResponse{} }
+func (m *BalanceProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*BalanceProofResponse) ProtoMessage()               {}
+func (*BalanceProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{71} }
+
+func (m *BalanceProofResponse) GetStart() *AccountStateProof {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *BalanceProofResponse) GetEnd() *AccountStateProof {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *BalanceProofResponse) GetChanges() []*BalanceChangeProof {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// Node in the path of a merkle proof.
+type ProofNode struct {
+	// Hex strings of the node value.
+	Val []string `protobuf:"bytes,1,rep,name=val" json:"val,omitempty"`
+}
+
+func (m *ProofNode) Reset()                    { *m = ProofNode{} }
+func (m *ProofNode) String() string            { return proto.CompactTextString(m) }
+func (*ProofNode) ProtoMessage()               {}
+func (*ProofNode) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{72} }
+
+func (m *ProofNode) GetVal() []string {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+// Request message of NewFilter rpc
+type NewFilterRequest struct {
+	// Hex string of the sender or receiver addresses of the transactions, empty matches all.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	// Topics of the events, empty matches all.
+	Topics     []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	FromHeight uint64   `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// 0 means no upper bound.
+	ToHeight uint64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *NewFilterRequest) Reset()                    { *m = NewFilterRequest{} }
+func (m *NewFilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewFilterRequest) ProtoMessage()               {}
+func (*NewFilterRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{73} }
+
+func (m *NewFilterRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *NewFilterRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *NewFilterRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *NewFilterRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// Response message of NewFilter rpc
+type NewFilterResponse struct {
+	FilterId string `protobuf:"bytes,1,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+}
+
+func (m *NewFilterResponse) Reset()                    { *m = NewFilterResponse{} }
+func (m *NewFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*NewFilterResponse) ProtoMessage()               {}
+func (*NewFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{74} }
+
+func (m *NewFilterResponse) GetFilterId() string {
+	if m != nil {
+		return m.FilterId
+	}
+	return ""
+}
+
+// Request message of filter rpcs
+type FilterRequest struct {
+	FilterId string `protobuf:"bytes,1,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+}
+
+func (m *FilterRequest) Reset()                    { *m = FilterRequest{} }
+func (m *FilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*FilterRequest) ProtoMessage()               {}
+func (*FilterRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{75} }
+
+func (m *FilterRequest) GetFilterId() string {
+	if m != nil {
+		return m.FilterId
+	}
+	return ""
+}
+
+type LogsResponse struct {
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+}
+
+func (m *LogsResponse) Reset()                    { *m = LogsResponse{} }
+func (m *LogsResponse) String() string            { return proto.CompactTextString(m) }
+func (*LogsResponse) ProtoMessage()               {}
+func (*LogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{76} }
+
+func (m *LogsResponse) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+// An event of a transaction in a block.
+type Log struct {
+	// Hex string of block hash.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of tx hash.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Index of the event in the transaction.
+	Index uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Topic string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  string `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// True if the block is reverted from the canonical chain.
+	Removed bool `protobuf:"varint,7,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *Log) Reset()                    { *m = Log{} }
+func (m *Log) String() string            { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()               {}
+func (*Log) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{77} }
+
+func (m *Log) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Log) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Log) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Log) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Log) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Log) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Log) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+type UninstallFilterResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *UninstallFilterResponse) Reset()                    { *m = UninstallFilterResponse{} }
+func (m *UninstallFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*UninstallFilterResponse) ProtoMessage()               {}
+func (*UninstallFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{78} }
+
+func (m *UninstallFilterResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+// Request message of GetAnchor rpc
+type GetAnchorRequest struct {
+	// Chain id of the child chain.
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Height of the child chain anchored, 0 means the latest one.
+	AnchorHeight uint64 `protobuf:"varint,2,opt,name=anchor_height,json=anchorHeight,proto3" json:"anchor_height,omitempty"`
+	// Height of the block, 0 means the tail.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Whether to return the merkle proof against the anchors root.
+	Proof bool `protobuf:"varint,4,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *GetAnchorRequest) Reset()                    { *m = GetAnchorRequest{} }
+func (m *GetAnchorRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAnchorRequest) ProtoMessage()               {}
+func (*GetAnchorRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{79} }
+
+func (m *GetAnchorRequest) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *GetAnchorRequest) GetAnchorHeight() uint64 {
+	if m != nil {
+		return m.AnchorHeight
+	}
+	return 0
+}
+
+func (m *GetAnchorRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetAnchorRequest) GetProof() bool {
+	if m != nil {
+		return m.Proof
+	}
+	return false
+}
+
+// Response message of GetAnchor rpc
+type GetAnchorResponse struct {
+	AnchorHeight uint64 `protobuf:"varint,1,opt,name=anchor_height,json=anchorHeight,proto3" json:"anchor_height,omitempty"`
+	// Hex string of the state root of the child chain anchored.
+	StateRoot string `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	Height    uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the block hash.
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the anchors root of the block.
+	AnchorsRoot string       `protobuf:"bytes,5,opt,name=anchors_root,json=anchorsRoot,proto3" json:"anchors_root,omitempty"`
+	Proof       []*ProofNode `protobuf:"bytes,6,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *GetAnchorResponse) Reset()                    { *m = GetAnchorResponse{} }
+func (m *GetAnchorResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAnchorResponse) ProtoMessage()               {}
+func (*GetAnchorResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{80} }
+
+func (m *GetAnchorResponse) GetAnchorHeight() uint64 {
+	if m != nil {
+		return m.AnchorHeight
+	}
+	return 0
+}
+
+func (m *GetAnchorResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *GetAnchorResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetAnchorResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetAnchorResponse) GetAnchorsRoot() string {
+	if m != nil {
+		return m.AnchorsRoot
+	}
+	return ""
+}
+
+func (m *GetAnchorResponse) GetProof() []*ProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// Request message of VerifyExit rpc
+type VerifyExitRequest struct {
+	// Chain id of the child chain.
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Hex string of the account address in the child chain.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the account in the child chain.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// Proof of the account against the latest anchored state root of the child chain.
+	AccountProof []*ProofNode `protobuf:"bytes,4,rep,name=account_proof,json=accountProof" json:"account_proof,omitempty"`
+}
+
+func (m *VerifyExitRequest) Reset()                    { *m = VerifyExitRequest{} }
+func (m *VerifyExitRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyExitRequest) ProtoMessage()               {}
+func (*VerifyExitRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{81} }
+
+func (m *VerifyExitRequest) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *VerifyExitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifyExitRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *VerifyExitRequest) GetAccountProof() []*ProofNode {
+	if m != nil {
+		return m.AccountProof
+	}
+	return nil
+}
+
+// Response message of VerifyExit rpc
+type VerifyExitResponse struct {
+	// Height of the child chain anchored the account is verified at.
+	AnchorHeight uint64 `protobuf:"varint,1,opt,name=anchor_height,json=anchorHeight,proto3" json:"anchor_height,omitempty"`
+	Balance      string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *VerifyExitResponse) Reset()                    { *m = VerifyExitResponse{} }
+func (m *VerifyExitResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyExitResponse) ProtoMessage()               {}
+func (*VerifyExitResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{82} }
+
+func (m *VerifyExitResponse) GetAnchorHeight() uint64 {
+	if m != nil {
+		return m.AnchorHeight
+	}
+	return 0
+}
+
+func (m *VerifyExitResponse) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *VerifyExitResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// Request message of GetHeaderProof rpc
+type GetHeaderProofRequest struct {
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// Height of the last header, at most 1024 headers from from_height.
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *GetHeaderProofRequest) Reset()                    { *m = GetHeaderProofRequest{} }
+func (m *GetHeaderProofRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetHeaderProofRequest) ProtoMessage()               {}
+func (*GetHeaderProofRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{83} }
+
+func (m *GetHeaderProofRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetHeaderProofRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// Response message of GetHeaderProof rpc
+type HeaderProofResponse struct {
+	Batches []*HeaderBatch `protobuf:"bytes,1,rep,name=batches" json:"batches,omitempty"`
+}
+
+func (m *HeaderProofResponse) Reset()                    { *m = HeaderProofResponse{} }
+func (m *HeaderProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*HeaderProofResponse) ProtoMessage()               {}
+func (*HeaderProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{84} }
+
+func (m *HeaderProofResponse) GetBatches() []*HeaderBatch {
+	if m != nil {
+		return m.Batches
+	}
+	return nil
+}
+
+// Consecutive headers signed by the validators of a dynasty.
+type HeaderBatch struct {
+	// Hex string of the dynasty root of the headers.
+	DynastyRoot string `protobuf:"bytes,1,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	// Hex strings of the addresses of the validators of the dynasty.
+	Validators []string `protobuf:"bytes,2,rep,name=validators" json:"validators,omitempty"`
+	// Proofs of the validators against the dynasty root, in their order.
+	Proofs  []*ValidatorProof `protobuf:"bytes,3,rep,name=proofs" json:"proofs,omitempty"`
+	Headers []*ProvedHeader   `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty"`
+}
+
+func (m *HeaderBatch) Reset()                    { *m = HeaderBatch{} }
+func (m *HeaderBatch) String() string            { return proto.CompactTextString(m) }
+func (*HeaderBatch) ProtoMessage()               {}
+func (*HeaderBatch) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{85} }
+
+func (m *HeaderBatch) GetDynastyRoot() string {
+	if m != nil {
+		return m.DynastyRoot
+	}
+	return ""
+}
+
+func (m *HeaderBatch) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *HeaderBatch) GetProofs() []*ValidatorProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *HeaderBatch) GetHeaders() []*ProvedHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type ValidatorProof struct {
+	Nodes []*ProofNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *ValidatorProof) Reset()                    { *m = ValidatorProof{} }
+func (m *ValidatorProof) String() string            { return proto.CompactTextString(m) }
+func (*ValidatorProof) ProtoMessage()               {}
+func (*ValidatorProof) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{86} }
+
+func (m *ValidatorProof) GetNodes() []*ProofNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type ProvedHeader struct {
+	Height uint64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Header *corepb.BlockHeader `protobuf:"bytes,2,opt,name=header" json:"header,omitempty"`
+	// Hex strings of the hashes of the transactions, the block hash commits to.
+	TxHashes []string `protobuf:"bytes,3,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+}
+
+func (m *ProvedHeader) Reset()                    { *m = ProvedHeader{} }
+func (m *ProvedHeader) String() string            { return proto.CompactTextString(m) }
+func (*ProvedHeader) ProtoMessage()               {}
+func (*ProvedHeader) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{87} }
+
+func (m *ProvedHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProvedHeader) GetHeader() *corepb.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ProvedHeader) GetTxHashes() []string {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+// Request message of GetLibrary rpc
+type GetLibraryRequest struct {
+	// Hex string of the hash of the library source, or "name@version".
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Height of the block, 0 means the tail.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetLibraryRequest) Reset()                    { *m = GetLibraryRequest{} }
+func (m *GetLibraryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLibraryRequest) ProtoMessage()               {}
+func (*GetLibraryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{88} }
+
+func (m *GetLibraryRequest) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+func (m *GetLibraryRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetLibrary rpc
+type GetLibraryResponse struct {
+	// Hex string of the hash of the library source, required by contracts as "@hash".
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *GetLibraryResponse) Reset()                    { *m = GetLibraryResponse{} }
+func (m *GetLibraryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetLibraryResponse) ProtoMessage()               {}
+func (*GetLibraryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{89} }
+
+func (m *GetLibraryResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetLibraryResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+type StartMineRequest struct {
+	// miner address passphrase
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *StartMineRequest) Reset()                    { *m = StartMineRequest{} }
+func (m *StartMineRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartMineRequest) ProtoMessage()               {}
+func (*StartMineRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{90} }
+
+func (m *StartMineRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type MineResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *MineResponse) Reset()                    { *m = MineResponse{} }
+func (m *MineResponse) String() string            { return proto.CompactTextString(m) }
+func (*MineResponse) ProtoMessage()               {}
+func (*MineResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{91} }
+
+func (m *MineResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type CompactStorageResponse struct {
+	// compaction started
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *CompactStorageResponse) Reset()                    { *m = CompactStorageResponse{} }
+func (m *CompactStorageResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactStorageResponse) ProtoMessage()               {}
+func (*CompactStorageResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{92} }
+
+func (m *CompactStorageResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type DiagnosticCheck struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// error of the failed check.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// duration of the check in milliseconds.
+	Elapsed int64 `protobuf:"varint,4,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+}
+
+func (m *DiagnosticCheck) Reset()                    { *m = DiagnosticCheck{} }
+func (m *DiagnosticCheck) String() string            { return proto.CompactTextString(m) }
+func (*DiagnosticCheck) ProtoMessage()               {}
+func (*DiagnosticCheck) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{93} }
+
+func (m *DiagnosticCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DiagnosticCheck) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *DiagnosticCheck) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DiagnosticCheck) GetElapsed() int64 {
+	if m != nil {
+		return m.Elapsed
+	}
+	return 0
+}
+
+type NodeDiagnosticsResponse struct {
+	// unix time the self-check ran on boot.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// all the checks passed.
+	Passed bool               `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Checks []*DiagnosticCheck `protobuf:"bytes,3,rep,name=checks" json:"checks,omitempty"`
+}
+
+func (m *NodeDiagnosticsResponse) Reset()                    { *m = NodeDiagnosticsResponse{} }
+func (m *NodeDiagnosticsResponse) String() string            { return proto.CompactTextString(m) }
+func (*NodeDiagnosticsResponse) ProtoMessage()               {}
+func (*NodeDiagnosticsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{94} }
+
+func (m *NodeDiagnosticsResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *NodeDiagnosticsResponse) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *NodeDiagnosticsResponse) GetChecks() []*DiagnosticCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+// Request message of SimulateReorg rpc
+type SimulateReorgRequest struct {
+	// number of canonical blocks reverted by the fork.
+	Depth uint64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *SimulateReorgRequest) Reset()                    { *m = SimulateReorgRequest{} }
+func (m *SimulateReorgRequest) String() string            { return proto.CompactTextString(m) }
+func (*SimulateReorgRequest) ProtoMessage()               {}
+func (*SimulateReorgRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{95} }
+
+func (m *SimulateReorgRequest) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type SimulateReorgResponse struct {
+	// Hex string of the hash of the new tail, the tip of the fork.
+	TailHash   string `protobuf:"bytes,1,opt,name=tail_hash,json=tailHash,proto3" json:"tail_hash,omitempty"`
+	TailHeight uint64 `protobuf:"varint,2,opt,name=tail_height,json=tailHeight,proto3" json:"tail_height,omitempty"`
+}
+
+func (m *SimulateReorgResponse) Reset()                    { *m = SimulateReorgResponse{} }
+func (m *SimulateReorgResponse) String() string            { return proto.CompactTextString(m) }
+func (*SimulateReorgResponse) ProtoMessage()               {}
+func (*SimulateReorgResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{96} }
+
+func (m *SimulateReorgResponse) GetTailHash() string {
+	if m != nil {
+		return m.TailHash
+	}
+	return ""
+}
+
+func (m *SimulateReorgResponse) GetTailHeight() uint64 {
+	if m != nil {
+		return m.TailHeight
+	}
+	return 0
+}
+
+// Request message of WatchAddress rpc
+type WatchAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// remove the address from the watch list instead.
+	Unwatch bool `protobuf:"varint,2,opt,name=unwatch,proto3" json:"unwatch,omitempty"`
+}
+
+func (m *WatchAddressRequest) Reset()                    { *m = WatchAddressRequest{} }
+func (m *WatchAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchAddressRequest) ProtoMessage()               {}
+func (*WatchAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{97} }
+
+func (m *WatchAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WatchAddressRequest) GetUnwatch() bool {
+	if m != nil {
+		return m.Unwatch
+	}
+	return false
+}
+
+type WatchAddressResponse struct {
+	// false if the address to unwatch is not in the watch list.
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *WatchAddressResponse) Reset()                    { *m = WatchAddressResponse{} }
+func (m *WatchAddressResponse) String() string            { return proto.CompactTextString(m) }
+func (*WatchAddressResponse) ProtoMessage()               {}
+func (*WatchAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{98} }
+
+func (m *WatchAddressResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type WatchedAddress struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce   uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// count of the transactions of the address since it was watched.
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// height of the last block with transactions of the address.
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *WatchedAddress) Reset()                    { *m = WatchedAddress{} }
+func (m *WatchedAddress) String() string            { return proto.CompactTextString(m) }
+func (*WatchedAddress) ProtoMessage()               {}
+func (*WatchedAddress) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{99} }
+
+func (m *WatchedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WatchedAddress) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *WatchedAddress) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *WatchedAddress) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *WatchedAddress) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type WatchedAddressesResponse struct {
+	Addresses []*WatchedAddress `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *WatchedAddressesResponse) Reset()         { *m = WatchedAddressesResponse{} }
+func (m *WatchedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchedAddressesResponse) ProtoMessage()    {}
+func (*WatchedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{100}
+}
+
+func (m *WatchedAddressesResponse) GetAddresses() []*WatchedAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// Request message of RegisterContractABI rpc
+type RegisterContractABIRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// json of the abi, as {"functions": [{"name": "transfer", "args": ["to", "value"]}]}.
+	Abi string `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+	// remove the abi of the contract instead.
+	Unregister bool `protobuf:"varint,3,opt,name=unregister,proto3" json:"unregister,omitempty"`
+}
+
+func (m *RegisterContractABIRequest) Reset()         { *m = RegisterContractABIRequest{} }
+func (m *RegisterContractABIRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterContractABIRequest) ProtoMessage()    {}
+func (*RegisterContractABIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{101}
+}
+
+func (m *RegisterContractABIRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RegisterContractABIRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *RegisterContractABIRequest) GetUnregister() bool {
+	if m != nil {
+		return m.Unregister
+	}
+	return false
+}
+
+type RegisterContractABIResponse struct {
+	// false if the contract to unregister has no abi.
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *RegisterContractABIResponse) Reset()         { *m = RegisterContractABIResponse{} }
+func (m *RegisterContractABIResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterContractABIResponse) ProtoMessage()    {}
+func (*RegisterContractABIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{102}
+}
+
+func (m *RegisterContractABIResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+// Request message of DeriveDepositAddresses rpc
+type DeriveDepositAddressesRequest struct {
+	// base58 extended public key, whose non hardened children are the deposit addresses.
+	Xpub string `protobuf:"bytes,1,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	// count of the next addresses derived.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *DeriveDepositAddressesRequest) Reset()         { *m = DeriveDepositAddressesRequest{} }
+func (m *DeriveDepositAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeriveDepositAddressesRequest) ProtoMessage()    {}
+func (*DeriveDepositAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{103}
+}
+
+func (m *DeriveDepositAddressesRequest) GetXpub() string {
+	if m != nil {
+		return m.Xpub
+	}
+	return ""
+}
+
+func (m *DeriveDepositAddressesRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DepositAddress struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// index of the child key.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// sum of the incoming transfers credited.
+	Received string `protobuf:"bytes,3,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *DepositAddress) Reset()                    { *m = DepositAddress{} }
+func (m *DepositAddress) String() string            { return proto.CompactTextString(m) }
+func (*DepositAddress) ProtoMessage()               {}
+func (*DepositAddress) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{104} }
+
+func (m *DepositAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DepositAddress) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DepositAddress) GetReceived() string {
+	if m != nil {
+		return m.Received
+	}
+	return ""
+}
+
+type DeriveDepositAddressesResponse struct {
+	Addresses []*DepositAddress `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *DeriveDepositAddressesResponse) Reset()         { *m = DeriveDepositAddressesResponse{} }
+func (m *DeriveDepositAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeriveDepositAddressesResponse) ProtoMessage()    {}
+func (*DeriveDepositAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{105}
+}
+
+func (m *DeriveDepositAddressesResponse) GetAddresses() []*DepositAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// Request message of GetDeposits rpc
+type GetDepositsRequest struct {
+	Xpub string `protobuf:"bytes,1,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	// only the credits from the height are returned.
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+}
+
+func (m *GetDepositsRequest) Reset()                    { *m = GetDepositsRequest{} }
+func (m *GetDepositsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDepositsRequest) ProtoMessage()               {}
+func (*GetDepositsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{106} }
+
+func (m *GetDepositsRequest) GetXpub() string {
+	if m != nil {
+		return m.Xpub
+	}
+	return ""
+}
+
+func (m *GetDepositsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+type DepositCredit struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Index     uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	From      string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TxHash    string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height    uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DepositCredit) Reset()                    { *m = DepositCredit{} }
+func (m *DepositCredit) String() string            { return proto.CompactTextString(m) }
+func (*DepositCredit) ProtoMessage()               {}
+func (*DepositCredit) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{107} }
+
+func (m *DepositCredit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DepositCredit) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DepositCredit) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DepositCredit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *DepositCredit) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *DepositCredit) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *DepositCredit) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetDepositsResponse struct {
+	Addresses []*DepositAddress `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	Credits   []*DepositCredit  `protobuf:"bytes,2,rep,name=credits" json:"credits,omitempty"`
+}
+
+func (m *GetDepositsResponse) Reset()                    { *m = GetDepositsResponse{} }
+func (m *GetDepositsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDepositsResponse) ProtoMessage()               {}
+func (*GetDepositsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{108} }
+
+func (m *GetDepositsResponse) GetAddresses() []*DepositAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *GetDepositsResponse) GetCredits() []*DepositCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+// Response message of GetBroadcastStatus rpc
+type BroadcastStatusResponse struct {
+	// pending, confirmed or expired.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// unix time the transaction was submitted.
+	Submitted int64 `protobuf:"varint,2,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	// unix time the transaction was last broadcast.
+	LastBroadcast int64  `protobuf:"varint,3,opt,name=last_broadcast,json=lastBroadcast,proto3" json:"last_broadcast,omitempty"`
+	Broadcasts    uint32 `protobuf:"varint,4,opt,name=broadcasts,proto3" json:"broadcasts,omitempty"`
+	// height of the block including the confirmed transaction.
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *BroadcastStatusResponse) Reset()                    { *m = BroadcastStatusResponse{} }
+func (m *BroadcastStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*BroadcastStatusResponse) ProtoMessage()               {}
+func (*BroadcastStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{109} }
+
+func (m *BroadcastStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BroadcastStatusResponse) GetSubmitted() int64 {
+	if m != nil {
+		return m.Submitted
+	}
+	return 0
+}
+
+func (m *BroadcastStatusResponse) GetLastBroadcast() int64 {
+	if m != nil {
+		return m.LastBroadcast
+	}
+	return 0
+}
+
+func (m *BroadcastStatusResponse) GetBroadcasts() uint32 {
+	if m != nil {
+		return m.Broadcasts
+	}
+	return 0
+}
+
+func (m *BroadcastStatusResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type GetAccountNextNonceRequest struct {
+	// Hex string of the account addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetAccountNextNonceRequest) Reset()         { *m = GetAccountNextNonceRequest{} }
+func (m *GetAccountNextNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountNextNonceRequest) ProtoMessage()    {}
+func (*GetAccountNextNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{110}
+}
+
+func (m *GetAccountNextNonceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetAccountNextNonceResponse struct {
+	// nonce of the next transaction of the account.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// nonce of the last transaction of the account on chain.
+	OnchainNonce uint64 `protobuf:"varint,2,opt,name=onchain_nonce,json=onchainNonce,proto3" json:"onchain_nonce,omitempty"`
+}
+
+func (m *GetAccountNextNonceResponse) Reset()         { *m = GetAccountNextNonceResponse{} }
+func (m *GetAccountNextNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountNextNonceResponse) ProtoMessage()    {}
+func (*GetAccountNextNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{111}
+}
+
+func (m *GetAccountNextNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *GetAccountNextNonceResponse) GetOnchainNonce() uint64 {
+	if m != nil {
+		return m.OnchainNonce
+	}
+	return 0
+}
+
+type ExecutionReceiptResponse struct {
+	// Hex string of tx hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the canonical block including the transaction.
+	BlockHash   string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// index of the transaction in the block.
+	Index uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// 1 if the execution succeeded, 0 if it failed.
+	Status  uint32 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	GasUsed string `protobuf:"bytes,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Hex string of the contract deployed by the transaction.
+	ContractAddress string   `protobuf:"bytes,7,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Events          []*Event `protobuf:"bytes,8,rep,name=events" json:"events,omitempty"`
+	// error of the failed execution.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ExecutionReceiptResponse) Reset()         { *m = ExecutionReceiptResponse{} }
+func (m *ExecutionReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutionReceiptResponse) ProtoMessage()    {}
+func (*ExecutionReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{112}
+}
+
+func (m *ExecutionReceiptResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ExecutionReceiptResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *ExecutionReceiptResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ExecutionReceiptResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ExecutionReceiptResponse) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ExecutionReceiptResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *ExecutionReceiptResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ExecutionReceiptResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ExecutionReceiptResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PoolStatsResponse struct {
+	// count of the transactions pending in the pool.
+	PendingTxs uint32 `protobuf:"varint,1,opt,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
+	// count of the blocks cached in the block pool.
+	PendingBlocks uint32 `protobuf:"varint,2,opt,name=pending_blocks,json=pendingBlocks,proto3" json:"pending_blocks,omitempty"`
+	// count of the refused transactions since the node started, by reason.
+	TxRejected map[string]uint64 `protobuf:"bytes,3,rep,name=tx_rejected,json=txRejected" json:"tx_rejected,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// count of the refused blocks since the node started, by reason.
+	BlockRejected map[string]uint64 `protobuf:"bytes,4,rep,name=block_rejected,json=blockRejected" json:"block_rejected,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *PoolStatsResponse) Reset()                    { *m = PoolStatsResponse{} }
+func (m *PoolStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PoolStatsResponse) ProtoMessage()               {}
+func (*PoolStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{113} }
+
+func (m *PoolStatsResponse) GetPendingTxs() uint32 {
+	if m != nil {
+		return m.PendingTxs
+	}
+	return 0
+}
+
+func (m *PoolStatsResponse) GetPendingBlocks() uint32 {
+	if m != nil {
+		return m.PendingBlocks
+	}
+	return 0
+}
+
+func (m *PoolStatsResponse) GetTxRejected() map[string]uint64 {
+	if m != nil {
+		return m.TxRejected
+	}
+	return nil
+}
+
+func (m *PoolStatsResponse) GetBlockRejected() map[string]uint64 {
+	if m != nil {
+		return m.BlockRejected
+	}
+	return nil
+}
+
+type BlockFinalityResponse struct {
+	// true if the block can't be reverted by a fork.
+	IsFinal bool   `protobuf:"varint,1,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the latest irreversible block hash.
+	Lib       string `protobuf:"bytes,3,opt,name=lib,proto3" json:"lib,omitempty"`
+	LibHeight uint64 `protobuf:"varint,4,opt,name=lib_height,json=libHeight,proto3" json:"lib_height,omitempty"`
+}
+
+func (m *BlockFinalityResponse) Reset()                    { *m = BlockFinalityResponse{} }
+func (m *BlockFinalityResponse) String() string            { return proto.CompactTextString(m) }
+func (*BlockFinalityResponse) ProtoMessage()               {}
+func (*BlockFinalityResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{114} }
+
+func (m *BlockFinalityResponse) GetIsFinal() bool {
+	if m != nil {
+		return m.IsFinal
+	}
+	return false
+}
+
+func (m *BlockFinalityResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFinalityResponse) GetLib() string {
+	if m != nil {
+		return m.Lib
+	}
+	return ""
+}
+
+func (m *BlockFinalityResponse) GetLibHeight() uint64 {
+	if m != nil {
+		return m.LibHeight
+	}
+	return 0
+}
+
+type DailyAnalyticsRequest struct {
+	// first UTC day, as 2006-01-02.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// last UTC day, as 2006-01-02, at most 366 days after from.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *DailyAnalyticsRequest) Reset()                    { *m = DailyAnalyticsRequest{} }
+func (m *DailyAnalyticsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DailyAnalyticsRequest) ProtoMessage()               {}
+func (*DailyAnalyticsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{115} }
+
+func (m *DailyAnalyticsRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *DailyAnalyticsRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type DailyStats struct {
+	// UTC day, as 2006-01-02.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// count of the canonical blocks of the day.
+	Blocks  uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	TxCount uint64 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// count of the addresses sending or receiving a transaction in the day.
+	ActiveAddresses uint64 `protobuf:"varint,4,opt,name=active_addresses,json=activeAddresses,proto3" json:"active_addresses,omitempty"`
+	GasUsed         string `protobuf:"bytes,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// sum of gas used times gas price of the transactions.
+	Fees string `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (m *DailyStats) Reset()                    { *m = DailyStats{} }
+func (m *DailyStats) String() string            { return proto.CompactTextString(m) }
+func (*DailyStats) ProtoMessage()               {}
+func (*DailyStats) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{116} }
+
+func (m *DailyStats) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *DailyStats) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *DailyStats) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *DailyStats) GetActiveAddresses() uint64 {
+	if m != nil {
+		return m.ActiveAddresses
+	}
+	return 0
+}
+
+func (m *DailyStats) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *DailyStats) GetFees() string {
+	if m != nil {
+		return m.Fees
+	}
+	return ""
+}
+
+type DailyAnalyticsResponse struct {
+	// the days with blocks in the range, in order.
+	Days []*DailyStats `protobuf:"bytes,1,rep,name=days" json:"days,omitempty"`
+}
+
+func (m *DailyAnalyticsResponse) Reset()                    { *m = DailyAnalyticsResponse{} }
+func (m *DailyAnalyticsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DailyAnalyticsResponse) ProtoMessage()               {}
+func (*DailyAnalyticsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{117} }
+
+func (m *DailyAnalyticsResponse) GetDays() []*DailyStats {
+	if m != nil {
+		return m.Days
+	}
+	return nil
+}
+
+type BlockHeaderRequest struct {
+	// Hex string of block hash, the height is used when empty.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// height of the canonical block.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BlockHeaderRequest) Reset()                    { *m = BlockHeaderRequest{} }
+func (m *BlockHeaderRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlockHeaderRequest) ProtoMessage()               {}
+func (*BlockHeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{118} }
+
+func (m *BlockHeaderRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockHeaderRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type BlockHeaderResponse struct {
+	Header *corepb.BlockHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Height uint64              `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// gas used by the transactions of the block, empty if unknown.
+	GasUsed string `protobuf:"bytes,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *BlockHeaderResponse) Reset()                    { *m = BlockHeaderResponse{} }
+func (m *BlockHeaderResponse) String() string            { return proto.CompactTextString(m) }
+func (*BlockHeaderResponse) ProtoMessage()               {}
+func (*BlockHeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{119} }
+
+func (m *BlockHeaderResponse) GetHeader() *corepb.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockHeaderResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockHeaderResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+type FeatureState struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// enabled by the features of the config of the node.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *FeatureState) Reset()                    { *m = FeatureState{} }
+func (m *FeatureState) String() string            { return proto.CompactTextString(m) }
+func (*FeatureState) ProtoMessage()               {}
+func (*FeatureState) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{120} }
+
+func (m *FeatureState) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeatureState) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *FeatureState) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type FeaturesResponse struct {
+	// the features of the binary, by name.
+	Features []*FeatureState `protobuf:"bytes,1,rep,name=features" json:"features,omitempty"`
+}
+
+func (m *FeaturesResponse) Reset()                    { *m = FeaturesResponse{} }
+func (m *FeaturesResponse) String() string            { return proto.CompactTextString(m) }
+func (*FeaturesResponse) ProtoMessage()               {}
+func (*FeaturesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{121} }
+
+func (m *FeaturesResponse) GetFeatures() []*FeatureState {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
+	proto.RegisterType((*SubscribeBlocksRequest)(nil), "rpcpb.SubscribeBlocksRequest")
+	proto.RegisterType((*SubscribeBlocksResponse)(nil), "rpcpb.SubscribeBlocksResponse")
+	proto.RegisterType((*BlockTransaction)(nil), "rpcpb.BlockTransaction")
+	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
+	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
+	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
+	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
+	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
+	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
+	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
+	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
+	proto.RegisterType((*NebVersionResponse)(nil), "rpcpb.NebVersionResponse")
+	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
+	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
+	proto.RegisterType((*GetStateDiffRequest)(nil), "rpcpb.GetStateDiffRequest")
+	proto.RegisterType((*StateDiffResponse)(nil), "rpcpb.StateDiffResponse")
+	proto.RegisterType((*AccountStateDiff)(nil), "rpcpb.AccountStateDiff")
+	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
+	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
+	proto.RegisterType((*GetDelegateVotersRequest)(nil), "rpcpb.GetDelegateVotersRequest")
+	proto.RegisterType((*GetDelegateVotersResponse)(nil), "rpcpb.GetDelegateVotersResponse")
+	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
+	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
 	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
 	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
+	proto.RegisterType((*AnchorRequest)(nil), "rpcpb.AnchorRequest")
+	proto.RegisterType((*LibraryRequest)(nil), "rpcpb.LibraryRequest")
 	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
 	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
 	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
 	proto.RegisterType((*BlockDumpRequest)(nil), "rpcpb.BlockDumpRequest")
 	proto.RegisterType((*BlockDumpResponse)(nil), "rpcpb.BlockDumpResponse")
+	proto.RegisterType((*DumpBlocksRequest)(nil), "rpcpb.DumpBlocksRequest")
 	proto.RegisterType((*TransactionReceiptResponse)(nil), "rpcpb.TransactionReceiptResponse")
+	proto.RegisterType((*DecodedArg)(nil), "rpcpb.DecodedArg")
+	proto.RegisterType((*DecodedCall)(nil), "rpcpb.DecodedCall")
 	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
 	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
 	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
@@ -1263,498 +4567,1426 @@ func init() {
 	proto.RegisterType((*SignTransactionResponse)(nil), "rpcpb.SignTransactionResponse")
 	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
 	proto.RegisterType((*SendTransactionPassphraseResponse)(nil), "rpcpb.SendTransactionPassphraseResponse")
+	proto.RegisterType((*GasPriceRequest)(nil), "rpcpb.GasPriceRequest")
 	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
+	proto.RegisterType((*ChainConfigResponse)(nil), "rpcpb.ChainConfigResponse")
+	proto.RegisterType((*ChainForks)(nil), "rpcpb.ChainForks")
+	proto.RegisterType((*GetSupplyInfoRequest)(nil), "rpcpb.GetSupplyInfoRequest")
+	proto.RegisterType((*SupplyInfoResponse)(nil), "rpcpb.SupplyInfoResponse")
+	proto.RegisterType((*ChainLimits)(nil), "rpcpb.ChainLimits")
+	proto.RegisterType((*GetMempoolStatsRequest)(nil), "rpcpb.GetMempoolStatsRequest")
+	proto.RegisterType((*GasPriceBucket)(nil), "rpcpb.GasPriceBucket")
+	proto.RegisterType((*MempoolStatsResponse)(nil), "rpcpb.MempoolStatsResponse")
+	proto.RegisterType((*GetPendingBlockRequest)(nil), "rpcpb.GetPendingBlockRequest")
+	proto.RegisterType((*PendingTransaction)(nil), "rpcpb.PendingTransaction")
+	proto.RegisterType((*PendingBlockResponse)(nil), "rpcpb.PendingBlockResponse")
 	proto.RegisterType((*EstimateGasResponse)(nil), "rpcpb.EstimateGasResponse")
+	proto.RegisterType((*ProfileGasResponse)(nil), "rpcpb.ProfileGasResponse")
+	proto.RegisterType((*FunctionGas)(nil), "rpcpb.FunctionGas")
 	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
 	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*GetContractStorageRequest)(nil), "rpcpb.GetContractStorageRequest")
+	proto.RegisterType((*GetContractStorageResponse)(nil), "rpcpb.GetContractStorageResponse")
+	proto.RegisterType((*StorageProof)(nil), "rpcpb.StorageProof")
+	proto.RegisterType((*BalanceProofRequest)(nil), "rpcpb.BalanceProofRequest")
+	proto.RegisterType((*AccountStateProof)(nil), "rpcpb.AccountStateProof")
+	proto.RegisterType((*BalanceChangeProof)(nil), "rpcpb.BalanceChangeProof")
+	proto.RegisterType((*BalanceProofResponse)(nil), "rpcpb.BalanceProofResponse")
+	proto.RegisterType((*ProofNode)(nil), "rpcpb.ProofNode")
+	proto.RegisterType((*NewFilterRequest)(nil), "rpcpb.NewFilterRequest")
+	proto.RegisterType((*NewFilterResponse)(nil), "rpcpb.NewFilterResponse")
+	proto.RegisterType((*FilterRequest)(nil), "rpcpb.FilterRequest")
+	proto.RegisterType((*LogsResponse)(nil), "rpcpb.LogsResponse")
+	proto.RegisterType((*Log)(nil), "rpcpb.Log")
+	proto.RegisterType((*UninstallFilterResponse)(nil), "rpcpb.UninstallFilterResponse")
+	proto.RegisterType((*GetAnchorRequest)(nil), "rpcpb.GetAnchorRequest")
+	proto.RegisterType((*GetAnchorResponse)(nil), "rpcpb.GetAnchorResponse")
+	proto.RegisterType((*VerifyExitRequest)(nil), "rpcpb.VerifyExitRequest")
+	proto.RegisterType((*VerifyExitResponse)(nil), "rpcpb.VerifyExitResponse")
+	proto.RegisterType((*GetHeaderProofRequest)(nil), "rpcpb.GetHeaderProofRequest")
+	proto.RegisterType((*HeaderProofResponse)(nil), "rpcpb.HeaderProofResponse")
+	proto.RegisterType((*HeaderBatch)(nil), "rpcpb.HeaderBatch")
+	proto.RegisterType((*ValidatorProof)(nil), "rpcpb.ValidatorProof")
+	proto.RegisterType((*ProvedHeader)(nil), "rpcpb.ProvedHeader")
+	proto.RegisterType((*GetLibraryRequest)(nil), "rpcpb.GetLibraryRequest")
+	proto.RegisterType((*GetLibraryResponse)(nil), "rpcpb.GetLibraryResponse")
 	proto.RegisterType((*StartMineRequest)(nil), "rpcpb.StartMineRequest")
 	proto.RegisterType((*MineResponse)(nil), "rpcpb.MineResponse")
+	proto.RegisterType((*CompactStorageResponse)(nil), "rpcpb.CompactStorageResponse")
+	proto.RegisterType((*DiagnosticCheck)(nil), "rpcpb.DiagnosticCheck")
+	proto.RegisterType((*NodeDiagnosticsResponse)(nil), "rpcpb.NodeDiagnosticsResponse")
+	proto.RegisterType((*SimulateReorgRequest)(nil), "rpcpb.SimulateReorgRequest")
+	proto.RegisterType((*SimulateReorgResponse)(nil), "rpcpb.SimulateReorgResponse")
+	proto.RegisterType((*WatchAddressRequest)(nil), "rpcpb.WatchAddressRequest")
+	proto.RegisterType((*WatchAddressResponse)(nil), "rpcpb.WatchAddressResponse")
+	proto.RegisterType((*WatchedAddress)(nil), "rpcpb.WatchedAddress")
+	proto.RegisterType((*WatchedAddressesResponse)(nil), "rpcpb.WatchedAddressesResponse")
+	proto.RegisterType((*RegisterContractABIRequest)(nil), "rpcpb.RegisterContractABIRequest")
+	proto.RegisterType((*RegisterContractABIResponse)(nil), "rpcpb.RegisterContractABIResponse")
+	proto.RegisterType((*DeriveDepositAddressesRequest)(nil), "rpcpb.DeriveDepositAddressesRequest")
+	proto.RegisterType((*DepositAddress)(nil), "rpcpb.DepositAddress")
+	proto.RegisterType((*DeriveDepositAddressesResponse)(nil), "rpcpb.DeriveDepositAddressesResponse")
+	proto.RegisterType((*GetDepositsRequest)(nil), "rpcpb.GetDepositsRequest")
+	proto.RegisterType((*DepositCredit)(nil), "rpcpb.DepositCredit")
+	proto.RegisterType((*GetDepositsResponse)(nil), "rpcpb.GetDepositsResponse")
+	proto.RegisterType((*BroadcastStatusResponse)(nil), "rpcpb.BroadcastStatusResponse")
+	proto.RegisterType((*GetAccountNextNonceRequest)(nil), "rpcpb.GetAccountNextNonceRequest")
+	proto.RegisterType((*GetAccountNextNonceResponse)(nil), "rpcpb.GetAccountNextNonceResponse")
+	proto.RegisterType((*ExecutionReceiptResponse)(nil), "rpcpb.ExecutionReceiptResponse")
+	proto.RegisterType((*PoolStatsResponse)(nil), "rpcpb.PoolStatsResponse")
+	proto.RegisterType((*BlockFinalityResponse)(nil), "rpcpb.BlockFinalityResponse")
+	proto.RegisterType((*DailyAnalyticsRequest)(nil), "rpcpb.DailyAnalyticsRequest")
+	proto.RegisterType((*DailyStats)(nil), "rpcpb.DailyStats")
+	proto.RegisterType((*DailyAnalyticsResponse)(nil), "rpcpb.DailyAnalyticsResponse")
+	proto.RegisterType((*BlockHeaderRequest)(nil), "rpcpb.BlockHeaderRequest")
+	proto.RegisterType((*BlockHeaderResponse)(nil), "rpcpb.BlockHeaderResponse")
+	proto.RegisterType((*FeatureState)(nil), "rpcpb.FeatureState")
+	proto.RegisterType((*FeaturesResponse)(nil), "rpcpb.FeaturesResponse")
+	proto.RegisterEnum("rpcpb.SubscribeBlocksRequest_Verbosity", SubscribeBlocksRequest_Verbosity_name, SubscribeBlocksRequest_Verbosity_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiService service
+
+type ApiServiceClient interface {
+	// Return the state of the neb.
+	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
+	// Return the build info of the running neb.
+	GetNebVersion(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NebVersionResponse, error)
+	// Return the p2p node info.
+	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	// Return the dump info of blockchain, at most 1024 blocks, use DumpBlocks for more.
+	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
+	// Stream the blocks of the canonical chain from a start height.
+	DumpBlocks(ctx context.Context, in *DumpBlocksRequest, opts ...grpc.CallOption) (ApiService_DumpBlocksClient, error)
+	// Accounts return account list.
+	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	// Return the state of the account.
+	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
+	// Return the accounts changed between the states of two heights, paged by address.
+	GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*StateDiffResponse, error)
+	// Verify, sign, and send the transaction.
+	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Call smart contract.
+	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Get block header info by the block hash.
+	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error)
+	// Subscribe message
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
+	// Subscribe the blocks joining the canonical chain
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeBlocksClient, error)
+	// Get the value in the storage of a contract by the key of LocalContractStorage
+	GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error)
+	// Return the transactions of an address in a range of blocks with the proofs of them and of its account at both ends.
+	GetBalanceProof(ctx context.Context, in *BalanceProofRequest, opts ...grpc.CallOption) (*BalanceProofResponse, error)
+	// Create a filter of events, return its id
+	NewFilter(ctx context.Context, in *NewFilterRequest, opts ...grpc.CallOption) (*NewFilterResponse, error)
+	// Get events changed since the last poll of the filter, including the removed by reorgs
+	GetFilterChanges(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*LogsResponse, error)
+	// Get all events matching the filter in the canonical chain
+	GetFilterLogs(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*LogsResponse, error)
+	// Remove the filter
+	UninstallFilter(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*UninstallFilterResponse, error)
+	// Get the state root of a child chain anchored into the chain
+	GetAnchor(ctx context.Context, in *GetAnchorRequest, opts ...grpc.CallOption) (*GetAnchorResponse, error)
+	// Verify an account of a child chain against its latest anchored state root
+	VerifyExit(ctx context.Context, in *VerifyExitRequest, opts ...grpc.CallOption) (*VerifyExitResponse, error)
+	// Get the source of a library deployed on chain
+	GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error)
+	// Get the gas price suggested by the recent blocks.
+	GetGasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// Return the consensus params, forks and limits of the chain.
+	GetChainConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ChainConfigResponse, error)
+	// Return the initial, issued, burned and total supply after a block.
+	GetSupplyInfo(ctx context.Context, in *GetSupplyInfoRequest, opts ...grpc.CallOption) (*SupplyInfoResponse, error)
+	// GetMempoolStats
+	GetMempoolStats(ctx context.Context, in *GetMempoolStatsRequest, opts ...grpc.CallOption) (*MempoolStatsResponse, error)
+	// Return the transactions the producer would pack in the next block if it were produced now.
+	GetPendingBlock(ctx context.Context, in *GetPendingBlockRequest, opts ...grpc.CallOption) (*PendingBlockResponse, error)
+	// EstimateGas
+	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// ProfileGas estimates the gas of the transaction, attributing the gas of the contract execution to the contract functions.
+	ProfileGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*ProfileGasResponse, error)
+	GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	// Get the headers of a range of heights batched by dynasty, with the proofs of the validators of the dynasties, for the light clients
+	GetHeaderProof(ctx context.Context, in *GetHeaderProofRequest, opts ...grpc.CallOption) (*HeaderProofResponse, error)
+	// Get the broadcast status of a transaction signed by the node or submitted by its admin, which is rebroadcast until it is on chain
+	GetBroadcastStatus(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*BroadcastStatusResponse, error)
+	// Get the next nonce of an account, counting its transactions pending in the pool
+	GetAccountNextNonce(ctx context.Context, in *GetAccountNextNonceRequest, opts ...grpc.CallOption) (*GetAccountNextNonceResponse, error)
+	// Get the result of the execution of a transaction in the canonical chain
+	GetExecutionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*ExecutionReceiptResponse, error)
+	// Get the pending counts of the pools and the counts of the transactions and blocks they refused by reason
+	GetPoolStats(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PoolStatsResponse, error)
+	// Get whether a block is final, in the canonical chain at or below the latest irreversible block
+	GetBlockFinality(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockFinalityResponse, error)
+	// Get the daily aggregates of the chain, the node must enable chain.analytics
+	GetDailyAnalytics(ctx context.Context, in *DailyAnalyticsRequest, opts ...grpc.CallOption) (*DailyAnalyticsResponse, error)
+	// Get the header of a block by hash, or of the canonical block at a height, without its transactions
+	GetBlockHeader(ctx context.Context, in *BlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeaderResponse, error)
+}
+
+type apiServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
+	return &apiServiceClient{cc}
+}
+
+func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
+	out := new(GetNebStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetNebVersion(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NebVersionResponse, error) {
+	out := new(NebVersionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebVersion", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
+	out := new(NodeInfoResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/NodeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error) {
+	out := new(BlockDumpResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/BlockDump", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) DumpBlocks(ctx context.Context, in *DumpBlocksRequest, opts ...grpc.CallOption) (ApiService_DumpBlocksClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/DumpBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceDumpBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_DumpBlocksClient interface {
+	Recv() (*SubscribeBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceDumpBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceDumpBlocksClient) Recv() (*SubscribeBlocksResponse, error) {
+	m := new(SubscribeBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Accounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
+	out := new(GetAccountStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*StateDiffResponse, error) {
+	out := new(StateDiffResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetStateDiff", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Call", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error) {
+	out := new(corepb.Block)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error) {
+	out := new(TransactionReceiptResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[1], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeBlocksClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[2], c.cc, "/rpcpb.ApiService/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeBlocksClient interface {
+	Recv() (*SubscribeBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeBlocksClient) Recv() (*SubscribeBlocksResponse, error) {
+	m := new(SubscribeBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error) {
+	out := new(GetContractStorageResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractStorage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBalanceProof(ctx context.Context, in *BalanceProofRequest, opts ...grpc.CallOption) (*BalanceProofResponse, error) {
+	out := new(BalanceProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBalanceProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) NewFilter(ctx context.Context, in *NewFilterRequest, opts ...grpc.CallOption) (*NewFilterResponse, error) {
+	out := new(NewFilterResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/NewFilter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetFilterChanges(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*LogsResponse, error) {
+	out := new(LogsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetFilterChanges", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetFilterLogs(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*LogsResponse, error) {
+	out := new(LogsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetFilterLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UninstallFilter(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*UninstallFilterResponse, error) {
+	out := new(UninstallFilterResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/UninstallFilter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAnchor(ctx context.Context, in *GetAnchorRequest, opts ...grpc.CallOption) (*GetAnchorResponse, error) {
+	out := new(GetAnchorResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAnchor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) VerifyExit(ctx context.Context, in *VerifyExitRequest, opts ...grpc.CallOption) (*VerifyExitResponse, error) {
+	out := new(VerifyExitResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/VerifyExit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error) {
+	out := new(GetLibraryResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetLibrary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetChainConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ChainConfigResponse, error) {
+	out := new(ChainConfigResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetChainConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetSupplyInfo(ctx context.Context, in *GetSupplyInfoRequest, opts ...grpc.CallOption) (*SupplyInfoResponse, error) {
+	out := new(SupplyInfoResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetSupplyInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetMempoolStats(ctx context.Context, in *GetMempoolStatsRequest, opts ...grpc.CallOption) (*MempoolStatsResponse, error) {
+	out := new(MempoolStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetMempoolStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPendingBlock(ctx context.Context, in *GetPendingBlockRequest, opts ...grpc.CallOption) (*PendingBlockResponse, error) {
+	out := new(PendingBlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetPendingBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ProfileGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*ProfileGasResponse, error) {
+	out := new(ProfileGasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/ProfileGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetHeaderProof(ctx context.Context, in *GetHeaderProofRequest, opts ...grpc.CallOption) (*HeaderProofResponse, error) {
+	out := new(HeaderProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetHeaderProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBroadcastStatus(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*BroadcastStatusResponse, error) {
+	out := new(BroadcastStatusResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBroadcastStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountNextNonce(ctx context.Context, in *GetAccountNextNonceRequest, opts ...grpc.CallOption) (*GetAccountNextNonceResponse, error) {
+	out := new(GetAccountNextNonceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountNextNonce", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetExecutionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*ExecutionReceiptResponse, error) {
+	out := new(ExecutionReceiptResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetExecutionReceipt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPoolStats(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PoolStatsResponse, error) {
+	out := new(PoolStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetPoolStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockFinality(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockFinalityResponse, error) {
+	out := new(BlockFinalityResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockFinality", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetDailyAnalytics(ctx context.Context, in *DailyAnalyticsRequest, opts ...grpc.CallOption) (*DailyAnalyticsResponse, error) {
+	out := new(DailyAnalyticsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDailyAnalytics", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockHeader(ctx context.Context, in *BlockHeaderRequest, opts ...grpc.CallOption) (*BlockHeaderResponse, error) {
+	out := new(BlockHeaderResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockHeader", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApiService service
+
+type ApiServiceServer interface {
+	// Return the state of the neb.
+	GetNebState(context.Context, *NonParamsRequest) (*GetNebStateResponse, error)
+	// Return the build info of the running neb.
+	GetNebVersion(context.Context, *NonParamsRequest) (*NebVersionResponse, error)
+	// Return the p2p node info.
+	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
+	// Return the dump info of blockchain, at most 1024 blocks, use DumpBlocks for more.
+	BlockDump(context.Context, *BlockDumpRequest) (*BlockDumpResponse, error)
+	// Stream the blocks of the canonical chain from a start height.
+	DumpBlocks(*DumpBlocksRequest, ApiService_DumpBlocksServer) error
+	// Accounts return account list.
+	Accounts(context.Context, *NonParamsRequest) (*AccountsResponse, error)
+	// Return the state of the account.
+	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
+	// Return the accounts changed between the states of two heights, paged by address.
+	GetStateDiff(context.Context, *GetStateDiffRequest) (*StateDiffResponse, error)
+	// Verify, sign, and send the transaction.
+	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
+	// Call smart contract.
+	Call(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendTransactionResponse, error)
+	// Get block header info by the block hash.
+	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*corepb.Block, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(context.Context, *GetTransactionByHashRequest) (*TransactionReceiptResponse, error)
+	// Subscribe message
+	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
+	// Subscribe the blocks joining the canonical chain
+	SubscribeBlocks(*SubscribeBlocksRequest, ApiService_SubscribeBlocksServer) error
+	// Get the value in the storage of a contract by the key of LocalContractStorage
+	GetContractStorage(context.Context, *GetContractStorageRequest) (*GetContractStorageResponse, error)
+	// Return the transactions of an address in a range of blocks with the proofs of them and of its account at both ends.
+	GetBalanceProof(context.Context, *BalanceProofRequest) (*BalanceProofResponse, error)
+	// Create a filter of events, return its id
+	NewFilter(context.Context, *NewFilterRequest) (*NewFilterResponse, error)
+	// Get events changed since the last poll of the filter, including the removed by reorgs
+	GetFilterChanges(context.Context, *FilterRequest) (*LogsResponse, error)
+	// Get all events matching the filter in the canonical chain
+	GetFilterLogs(context.Context, *FilterRequest) (*LogsResponse, error)
+	// Remove the filter
+	UninstallFilter(context.Context, *FilterRequest) (*UninstallFilterResponse, error)
+	// Get the state root of a child chain anchored into the chain
+	GetAnchor(context.Context, *GetAnchorRequest) (*GetAnchorResponse, error)
+	// Verify an account of a child chain against its latest anchored state root
+	VerifyExit(context.Context, *VerifyExitRequest) (*VerifyExitResponse, error)
+	// Get the source of a library deployed on chain
+	GetLibrary(context.Context, *GetLibraryRequest) (*GetLibraryResponse, error)
+	// Get the gas price suggested by the recent blocks.
+	GetGasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
+	// Return the consensus params, forks and limits of the chain.
+	GetChainConfig(context.Context, *NonParamsRequest) (*ChainConfigResponse, error)
+	// Return the initial, issued, burned and total supply after a block.
+	GetSupplyInfo(context.Context, *GetSupplyInfoRequest) (*SupplyInfoResponse, error)
+	// GetMempoolStats
+	GetMempoolStats(context.Context, *GetMempoolStatsRequest) (*MempoolStatsResponse, error)
+	// Return the transactions the producer would pack in the next block if it were produced now.
+	GetPendingBlock(context.Context, *GetPendingBlockRequest) (*PendingBlockResponse, error)
+	// EstimateGas
+	EstimateGas(context.Context, *TransactionRequest) (*EstimateGasResponse, error)
+	// ProfileGas estimates the gas of the transaction, attributing the gas of the contract execution to the contract functions.
+	ProfileGas(context.Context, *TransactionRequest) (*ProfileGasResponse, error)
+	GetEventsByHash(context.Context, *GetTransactionByHashRequest) (*EventsResponse, error)
+	// Get the headers of a range of heights batched by dynasty, with the proofs of the validators of the dynasties, for the light clients
+	GetHeaderProof(context.Context, *GetHeaderProofRequest) (*HeaderProofResponse, error)
+	// Get the broadcast status of a transaction signed by the node or submitted by its admin, which is rebroadcast until it is on chain
+	GetBroadcastStatus(context.Context, *GetTransactionByHashRequest) (*BroadcastStatusResponse, error)
+	// Get the next nonce of an account, counting its transactions pending in the pool
+	GetAccountNextNonce(context.Context, *GetAccountNextNonceRequest) (*GetAccountNextNonceResponse, error)
+	// Get the result of the execution of a transaction in the canonical chain
+	GetExecutionReceipt(context.Context, *GetTransactionByHashRequest) (*ExecutionReceiptResponse, error)
+	// Get the pending counts of the pools and the counts of the transactions and blocks they refused by reason
+	GetPoolStats(context.Context, *NonParamsRequest) (*PoolStatsResponse, error)
+	// Get whether a block is final, in the canonical chain at or below the latest irreversible block
+	GetBlockFinality(context.Context, *GetBlockByHashRequest) (*BlockFinalityResponse, error)
+	// Get the daily aggregates of the chain, the node must enable chain.analytics
+	GetDailyAnalytics(context.Context, *DailyAnalyticsRequest) (*DailyAnalyticsResponse, error)
+	// Get the header of a block by hash, or of the canonical block at a height, without its transactions
+	GetBlockHeader(context.Context, *BlockHeaderRequest) (*BlockHeaderResponse, error)
+}
+
+func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
+	s.RegisterService(&_ApiService_serviceDesc, srv)
+}
+
+func _ApiService_GetNebState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNebState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNebState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNebState(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetNebVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNebVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNebVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNebVersion(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).NodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/NodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).NodeInfo(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BlockDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BlockDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/BlockDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BlockDump(ctx, req.(*BlockDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func _ApiService_DumpBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DumpBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).DumpBlocks(m, &apiServiceDumpBlocksServer{stream})
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type ApiService_DumpBlocksServer interface {
+	Send(*SubscribeBlocksResponse) error
+	grpc.ServerStream
+}
 
-// Client API for ApiService service
+type apiServiceDumpBlocksServer struct {
+	grpc.ServerStream
+}
 
-type ApiServiceClient interface {
-	// Return the state of the neb.
-	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
-	// Return the p2p node info.
-	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
-	// Return the dump info of blockchain.
-	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
-	// Accounts return account list.
-	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	// Return the state of the account.
-	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
-	// Verify, sign, and send the transaction.
-	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Get block header info by the block hash.
-	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error)
-	// Subscribe message
-	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
-	// Get GasPrice
-	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
-	GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+func (x *apiServiceDumpBlocksServer) Send(m *SubscribeBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-type apiServiceClient struct {
-	cc *grpc.ClientConn
+func _ApiService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Accounts(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
-	return &apiServiceClient{cc}
+func _ApiService_GetAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountState(ctx, req.(*GetAccountStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
-	out := new(GetNebStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateDiffRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetStateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetStateDiff(ctx, req.(*GetStateDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
-	out := new(NodeInfoResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/NodeInfo", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error) {
-	out := new(BlockDumpResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/BlockDump", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Call(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
-	out := new(AccountsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Accounts", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
-	out := new(GetAccountStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Call", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).Subscribe(m, &apiServiceSubscribeServer{stream})
+}
+
+type ApiService_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeBlocks(m, &apiServiceSubscribeBlocksServer{stream})
+}
+
+type ApiService_SubscribeBlocksServer interface {
+	Send(*SubscribeBlocksResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeBlocksServer) Send(m *SubscribeBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractStorageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractStorage(ctx, req.(*GetContractStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetBalanceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceProofRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBalanceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBalanceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBalanceProof(ctx, req.(*BalanceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error) {
-	out := new(corepb.Block)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_NewFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewFilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).NewFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/NewFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).NewFilter(ctx, req.(*NewFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error) {
-	out := new(TransactionReceiptResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetFilterChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetFilterChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetFilterChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetFilterChanges(ctx, req.(*FilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
-	if err != nil {
+func _ApiService_GetFilterLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &apiServiceSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetFilterLogs(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetFilterLogs",
 	}
-	return x, nil
-}
-
-type ApiService_SubscribeClient interface {
-	Recv() (*SubscribeResponse, error)
-	grpc.ClientStream
-}
-
-type apiServiceSubscribeClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetFilterLogs(ctx, req.(*FilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
-	m := new(SubscribeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _ApiService_UninstallFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UninstallFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/UninstallFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UninstallFilter(ctx, req.(*FilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnchorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAnchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAnchor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAnchor(ctx, req.(*GetAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
-	out := new(EstimateGasResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_VerifyExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyExitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).VerifyExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/VerifyExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).VerifyExit(ctx, req.(*VerifyExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
-	out := new(EventsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLibraryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for ApiService service
-
-type ApiServiceServer interface {
-	// Return the state of the neb.
-	GetNebState(context.Context, *NonParamsRequest) (*GetNebStateResponse, error)
-	// Return the p2p node info.
-	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
-	// Return the dump info of blockchain.
-	BlockDump(context.Context, *BlockDumpRequest) (*BlockDumpResponse, error)
-	// Accounts return account list.
-	Accounts(context.Context, *NonParamsRequest) (*AccountsResponse, error)
-	// Return the state of the account.
-	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
-	// Verify, sign, and send the transaction.
-	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendTransactionResponse, error)
-	// Get block header info by the block hash.
-	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*corepb.Block, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(context.Context, *GetTransactionByHashRequest) (*TransactionReceiptResponse, error)
-	// Subscribe message
-	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
-	// Get GasPrice
-	GetGasPrice(context.Context, *NonParamsRequest) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(context.Context, *TransactionRequest) (*EstimateGasResponse, error)
-	GetEventsByHash(context.Context, *GetTransactionByHashRequest) (*EventsResponse, error)
-}
-
-func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
-	s.RegisterService(&_ApiService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetLibrary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetLibrary(ctx, req.(*GetLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetNebState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
+func _ApiService_GetGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetNebState(ctx, in)
+		return srv.(ApiServiceServer).GetGasPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetNebState",
+		FullMethod: "/rpcpb.ApiService/GetGasPrice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetNebState(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetGasPrice(ctx, req.(*GasPriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_GetChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).NodeInfo(ctx, in)
+		return srv.(ApiServiceServer).GetChainConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/NodeInfo",
+		FullMethod: "/rpcpb.ApiService/GetChainConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).NodeInfo(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetChainConfig(ctx, req.(*NonParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_BlockDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockDumpRequest)
+func _ApiService_GetSupplyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupplyInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).BlockDump(ctx, in)
+		return srv.(ApiServiceServer).GetSupplyInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/BlockDump",
+		FullMethod: "/rpcpb.ApiService/GetSupplyInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).BlockDump(ctx, req.(*BlockDumpRequest))
+		return srv.(ApiServiceServer).GetSupplyInfo(ctx, req.(*GetSupplyInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
+func _ApiService_GetMempoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).Accounts(ctx, in)
+		return srv.(ApiServiceServer).GetMempoolStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/Accounts",
+		FullMethod: "/rpcpb.ApiService/GetMempoolStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Accounts(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetMempoolStats(ctx, req.(*GetMempoolStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountStateRequest)
+func _ApiService_GetPendingBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAccountState(ctx, in)
+		return srv.(ApiServiceServer).GetPendingBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetAccountState",
+		FullMethod: "/rpcpb.ApiService/GetPendingBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAccountState(ctx, req.(*GetAccountStateRequest))
+		return srv.(ApiServiceServer).GetPendingBlock(ctx, req.(*GetPendingBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SendTransaction(ctx, in)
+		return srv.(ApiServiceServer).EstimateGas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/SendTransaction",
+		FullMethod: "/rpcpb.ApiService/EstimateGas",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendTransaction(ctx, req.(*TransactionRequest))
+		return srv.(ApiServiceServer).EstimateGas(ctx, req.(*TransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_ProfileGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).Call(ctx, in)
+		return srv.(ApiServiceServer).ProfileGas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/Call",
+		FullMethod: "/rpcpb.ApiService/ProfileGas",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Call(ctx, req.(*TransactionRequest))
+		return srv.(ApiServiceServer).ProfileGas(ctx, req.(*TransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRawTransactionRequest)
+func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).GetEventsByHash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/SendRawTransaction",
+		FullMethod: "/rpcpb.ApiService/GetEventsByHash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+		return srv.(ApiServiceServer).GetEventsByHash(ctx, req.(*GetTransactionByHashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHashRequest)
+func _ApiService_GetHeaderProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeaderProofRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+		return srv.(ApiServiceServer).GetHeaderProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+		FullMethod: "/rpcpb.ApiService/GetHeaderProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+		return srv.(ApiServiceServer).GetHeaderProof(ctx, req.(*GetHeaderProofRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_GetBroadcastStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionByHashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, in)
+		return srv.(ApiServiceServer).GetBroadcastStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetTransactionReceipt",
+		FullMethod: "/rpcpb.ApiService/GetBroadcastStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, req.(*GetTransactionByHashRequest))
+		return srv.(ApiServiceServer).GetBroadcastStatus(ctx, req.(*GetTransactionByHashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _ApiService_GetAccountNextNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountNextNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ApiServiceServer).Subscribe(m, &apiServiceSubscribeServer{stream})
-}
-
-type ApiService_SubscribeServer interface {
-	Send(*SubscribeResponse) error
-	grpc.ServerStream
-}
-
-type apiServiceSubscribeServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountNextNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountNextNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountNextNonce(ctx, req.(*GetAccountNextNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *apiServiceSubscribeServer) Send(m *SubscribeResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _ApiService_GetExecutionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetExecutionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetExecutionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetExecutionReceipt(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_GetPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetGasPrice(ctx, in)
+		return srv.(ApiServiceServer).GetPoolStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetGasPrice",
+		FullMethod: "/rpcpb.ApiService/GetPoolStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetGasPrice(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetPoolStats(ctx, req.(*NonParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _ApiService_GetBlockFinality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockFinality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockFinality",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockFinality(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetDailyAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DailyAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).EstimateGas(ctx, in)
+		return srv.(ApiServiceServer).GetDailyAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/EstimateGas",
+		FullMethod: "/rpcpb.ApiService/GetDailyAnalytics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).EstimateGas(ctx, req.(*TransactionRequest))
+		return srv.(ApiServiceServer).GetDailyAnalytics(ctx, req.(*DailyAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionByHashRequest)
+func _ApiService_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeaderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetEventsByHash(ctx, in)
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetEventsByHash",
+		FullMethod: "/rpcpb.ApiService/GetBlockHeader",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetEventsByHash(ctx, req.(*GetTransactionByHashRequest))
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, req.(*BlockHeaderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1767,6 +5999,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNebState",
 			Handler:    _ApiService_GetNebState_Handler,
 		},
+		{
+			MethodName: "GetNebVersion",
+			Handler:    _ApiService_GetNebVersion_Handler,
+		},
 		{
 			MethodName: "NodeInfo",
 			Handler:    _ApiService_NodeInfo_Handler,
@@ -1783,6 +6019,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountState",
 			Handler:    _ApiService_GetAccountState_Handler,
 		},
+		{
+			MethodName: "GetStateDiff",
+			Handler:    _ApiService_GetStateDiff_Handler,
+		},
 		{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
@@ -1803,25 +6043,123 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionReceipt",
 			Handler:    _ApiService_GetTransactionReceipt_Handler,
 		},
+		{
+			MethodName: "GetContractStorage",
+			Handler:    _ApiService_GetContractStorage_Handler,
+		},
+		{
+			MethodName: "GetBalanceProof",
+			Handler:    _ApiService_GetBalanceProof_Handler,
+		},
+		{
+			MethodName: "NewFilter",
+			Handler:    _ApiService_NewFilter_Handler,
+		},
+		{
+			MethodName: "GetFilterChanges",
+			Handler:    _ApiService_GetFilterChanges_Handler,
+		},
+		{
+			MethodName: "GetFilterLogs",
+			Handler:    _ApiService_GetFilterLogs_Handler,
+		},
+		{
+			MethodName: "UninstallFilter",
+			Handler:    _ApiService_UninstallFilter_Handler,
+		},
+		{
+			MethodName: "GetAnchor",
+			Handler:    _ApiService_GetAnchor_Handler,
+		},
+		{
+			MethodName: "VerifyExit",
+			Handler:    _ApiService_VerifyExit_Handler,
+		},
+		{
+			MethodName: "GetLibrary",
+			Handler:    _ApiService_GetLibrary_Handler,
+		},
 		{
 			MethodName: "GetGasPrice",
 			Handler:    _ApiService_GetGasPrice_Handler,
 		},
+		{
+			MethodName: "GetChainConfig",
+			Handler:    _ApiService_GetChainConfig_Handler,
+		},
+		{
+			MethodName: "GetSupplyInfo",
+			Handler:    _ApiService_GetSupplyInfo_Handler,
+		},
+		{
+			MethodName: "GetMempoolStats",
+			Handler:    _ApiService_GetMempoolStats_Handler,
+		},
+		{
+			MethodName: "GetPendingBlock",
+			Handler:    _ApiService_GetPendingBlock_Handler,
+		},
 		{
 			MethodName: "EstimateGas",
 			Handler:    _ApiService_EstimateGas_Handler,
 		},
+		{
+			MethodName: "ProfileGas",
+			Handler:    _ApiService_ProfileGas_Handler,
+		},
 		{
 			MethodName: "GetEventsByHash",
 			Handler:    _ApiService_GetEventsByHash_Handler,
 		},
+		{
+			MethodName: "GetHeaderProof",
+			Handler:    _ApiService_GetHeaderProof_Handler,
+		},
+		{
+			MethodName: "GetBroadcastStatus",
+			Handler:    _ApiService_GetBroadcastStatus_Handler,
+		},
+		{
+			MethodName: "GetAccountNextNonce",
+			Handler:    _ApiService_GetAccountNextNonce_Handler,
+		},
+		{
+			MethodName: "GetExecutionReceipt",
+			Handler:    _ApiService_GetExecutionReceipt_Handler,
+		},
+		{
+			MethodName: "GetPoolStats",
+			Handler:    _ApiService_GetPoolStats_Handler,
+		},
+		{
+			MethodName: "GetBlockFinality",
+			Handler:    _ApiService_GetBlockFinality_Handler,
+		},
+		{
+			MethodName: "GetDailyAnalytics",
+			Handler:    _ApiService_GetDailyAnalytics_Handler,
+		},
+		{
+			MethodName: "GetBlockHeader",
+			Handler:    _ApiService_GetBlockHeader_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DumpBlocks",
+			Handler:       _ApiService_DumpBlocks_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _ApiService_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _ApiService_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_rpc.proto",
 }
@@ -1845,6 +6183,18 @@ type AdminServiceClient interface {
 	ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error)
 	StartMine(ctx context.Context, in *StartMineRequest, opts ...grpc.CallOption) (*MineResponse, error)
 	StopMine(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MineResponse, error)
+	CompactStorage(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*CompactStorageResponse, error)
+	GetNodeDiagnostics(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeDiagnosticsResponse, error)
+	// List the experimental features of the binary, and whether each is enabled on the node.
+	GetFeatures(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*FeaturesResponse, error)
+	// Build and adopt a fork reverting the given number of canonical blocks, to test the reorg handling. Requires the dev_mode feature on a dev chain.
+	SimulateReorg(ctx context.Context, in *SimulateReorgRequest, opts ...grpc.CallOption) (*SimulateReorgResponse, error)
+	WatchAddress(ctx context.Context, in *WatchAddressRequest, opts ...grpc.CallOption) (*WatchAddressResponse, error)
+	GetWatchedAddresses(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*WatchedAddressesResponse, error)
+	// Register the abi of a contract, used to decode the calls to it in the transaction responses.
+	RegisterContractABI(ctx context.Context, in *RegisterContractABIRequest, opts ...grpc.CallOption) (*RegisterContractABIResponse, error)
+	DeriveDepositAddresses(ctx context.Context, in *DeriveDepositAddressesRequest, opts ...grpc.CallOption) (*DeriveDepositAddressesResponse, error)
+	GetDeposits(ctx context.Context, in *GetDepositsRequest, opts ...grpc.CallOption) (*GetDepositsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -1954,6 +6304,87 @@ func (c *adminServiceClient) StopMine(ctx context.Context, in *NonParamsRequest,
 	return out, nil
 }
 
+func (c *adminServiceClient) CompactStorage(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*CompactStorageResponse, error) {
+	out := new(CompactStorageResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/CompactStorage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNodeDiagnostics(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeDiagnosticsResponse, error) {
+	out := new(NodeDiagnosticsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetNodeDiagnostics", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetFeatures(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*FeaturesResponse, error) {
+	out := new(FeaturesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetFeatures", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SimulateReorg(ctx context.Context, in *SimulateReorgRequest, opts ...grpc.CallOption) (*SimulateReorgResponse, error) {
+	out := new(SimulateReorgResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SimulateReorg", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WatchAddress(ctx context.Context, in *WatchAddressRequest, opts ...grpc.CallOption) (*WatchAddressResponse, error) {
+	out := new(WatchAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/WatchAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWatchedAddresses(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*WatchedAddressesResponse, error) {
+	out := new(WatchedAddressesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetWatchedAddresses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RegisterContractABI(ctx context.Context, in *RegisterContractABIRequest, opts ...grpc.CallOption) (*RegisterContractABIResponse, error) {
+	out := new(RegisterContractABIResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/RegisterContractABI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeriveDepositAddresses(ctx context.Context, in *DeriveDepositAddressesRequest, opts ...grpc.CallOption) (*DeriveDepositAddressesResponse, error) {
+	out := new(DeriveDepositAddressesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/DeriveDepositAddresses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDeposits(ctx context.Context, in *GetDepositsRequest, opts ...grpc.CallOption) (*GetDepositsResponse, error) {
+	out := new(GetDepositsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDeposits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceServer interface {
@@ -1973,6 +6404,18 @@ type AdminServiceServer interface {
 	ChangeNetworkID(context.Context, *ChangeNetworkIDRequest) (*ChangeNetworkIDResponse, error)
 	StartMine(context.Context, *StartMineRequest) (*MineResponse, error)
 	StopMine(context.Context, *NonParamsRequest) (*MineResponse, error)
+	CompactStorage(context.Context, *NonParamsRequest) (*CompactStorageResponse, error)
+	GetNodeDiagnostics(context.Context, *NonParamsRequest) (*NodeDiagnosticsResponse, error)
+	// List the experimental features of the binary, and whether each is enabled on the node.
+	GetFeatures(context.Context, *NonParamsRequest) (*FeaturesResponse, error)
+	// Build and adopt a fork reverting the given number of canonical blocks, to test the reorg handling. Requires the dev_mode feature on a dev chain.
+	SimulateReorg(context.Context, *SimulateReorgRequest) (*SimulateReorgResponse, error)
+	WatchAddress(context.Context, *WatchAddressRequest) (*WatchAddressResponse, error)
+	GetWatchedAddresses(context.Context, *NonParamsRequest) (*WatchedAddressesResponse, error)
+	// Register the abi of a contract, used to decode the calls to it in the transaction responses.
+	RegisterContractABI(context.Context, *RegisterContractABIRequest) (*RegisterContractABIResponse, error)
+	DeriveDepositAddresses(context.Context, *DeriveDepositAddressesRequest) (*DeriveDepositAddressesResponse, error)
+	GetDeposits(context.Context, *GetDepositsRequest) (*GetDepositsResponse, error)
 }
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
@@ -2177,6 +6620,168 @@ func _AdminService_StopMine_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CompactStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CompactStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/CompactStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CompactStorage(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNodeDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNodeDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetNodeDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNodeDiagnostics(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetFeatures(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SimulateReorg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateReorgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SimulateReorg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/SimulateReorg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SimulateReorg(ctx, req.(*SimulateReorgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WatchAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).WatchAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/WatchAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).WatchAddress(ctx, req.(*WatchAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWatchedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWatchedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetWatchedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWatchedAddresses(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RegisterContractABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterContractABIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RegisterContractABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RegisterContractABI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RegisterContractABI(ctx, req.(*RegisterContractABIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeriveDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeriveDepositAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeriveDepositAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/DeriveDepositAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeriveDepositAddresses(ctx, req.(*DeriveDepositAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDeposits(ctx, req.(*GetDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -2225,6 +6830,42 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopMine",
 			Handler:    _AdminService_StopMine_Handler,
 		},
+		{
+			MethodName: "CompactStorage",
+			Handler:    _AdminService_CompactStorage_Handler,
+		},
+		{
+			MethodName: "GetNodeDiagnostics",
+			Handler:    _AdminService_GetNodeDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetFeatures",
+			Handler:    _AdminService_GetFeatures_Handler,
+		},
+		{
+			MethodName: "SimulateReorg",
+			Handler:    _AdminService_SimulateReorg_Handler,
+		},
+		{
+			MethodName: "WatchAddress",
+			Handler:    _AdminService_WatchAddress_Handler,
+		},
+		{
+			MethodName: "GetWatchedAddresses",
+			Handler:    _AdminService_GetWatchedAddresses_Handler,
+		},
+		{
+			MethodName: "RegisterContractABI",
+			Handler:    _AdminService_RegisterContractABI_Handler,
+		},
+		{
+			MethodName: "DeriveDepositAddresses",
+			Handler:    _AdminService_DeriveDepositAddresses_Handler,
+		},
+		{
+			MethodName: "GetDeposits",
+			Handler:    _AdminService_GetDeposits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api_rpc.proto",
@@ -2233,136 +6874,401 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api_rpc.proto", fileDescriptorApiRpc) }
 
 var fileDescriptorApiRpc = []byte{
-	// 2094 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0x06, 0xa9, 0x3f, 0xb2, 0xa8, 0xdf, 0xd6, 0x1f, 0x35, 0x96, 0x64, 0xb9, 0xbd, 0xc9, 0x6a,
-	0x15, 0x58, 0x5c, 0xd3, 0xc9, 0x7a, 0xe1, 0x9c, 0x6c, 0xd9, 0x90, 0x05, 0x78, 0x0d, 0x63, 0xe8,
-	0xec, 0x22, 0x58, 0x2c, 0x88, 0xe6, 0x4c, 0x9b, 0x1c, 0x98, 0x9c, 0x99, 0x9d, 0x6e, 0x4a, 0x2b,
-	0x05, 0x48, 0x80, 0x00, 0x39, 0xe4, 0x9c, 0x37, 0xc8, 0x2d, 0x0f, 0x91, 0x43, 0x0e, 0x79, 0x82,
-	0x1c, 0xf2, 0x02, 0x79, 0x90, 0xa0, 0x6b, 0xba, 0xe7, 0x9f, 0x96, 0x8d, 0xbd, 0x4d, 0x55, 0x57,
-	0xd7, 0x57, 0x5d, 0x5d, 0x7f, 0xd3, 0xb0, 0xc2, 0x42, 0xaf, 0x1f, 0x85, 0xce, 0x69, 0x18, 0x05,
-	0x32, 0x20, 0x0b, 0x51, 0xe8, 0x84, 0x03, 0x6b, 0x7f, 0x18, 0x04, 0xc3, 0x31, 0xef, 0xb0, 0xd0,
-	0xeb, 0x30, 0xdf, 0x0f, 0x24, 0x93, 0x5e, 0xe0, 0x8b, 0x58, 0xc8, 0x7a, 0x34, 0xf4, 0xe4, 0x68,
-	0x3a, 0x38, 0x75, 0x82, 0x49, 0xc7, 0xe7, 0x83, 0xe9, 0x98, 0x09, 0x2f, 0xe8, 0x0c, 0x83, 0x07,
-	0x9a, 0xe8, 0x38, 0x41, 0xc4, 0x3b, 0xe1, 0xa0, 0x33, 0x18, 0x07, 0xce, 0xfb, 0x78, 0x13, 0x3d,
-	0x86, 0xf5, 0xde, 0x74, 0x20, 0x9c, 0xc8, 0x1b, 0x70, 0x9b, 0xff, 0x38, 0xe5, 0x42, 0x92, 0x2d,
-	0x58, 0x90, 0x41, 0xe8, 0x39, 0xed, 0xda, 0xd1, 0xdc, 0x71, 0xd3, 0x8e, 0x09, 0xfa, 0x18, 0x76,
-	0xce, 0x46, 0xcc, 0x1f, 0xf2, 0xd7, 0x5c, 0x5e, 0x05, 0xd1, 0xfb, 0x8b, 0xe7, 0x46, 0xfe, 0x00,
-	0xc0, 0x8f, 0x79, 0x7d, 0xcf, 0x6d, 0xd7, 0x8e, 0x6a, 0xc7, 0x2b, 0x76, 0x53, 0x73, 0x2e, 0x5c,
-	0xfa, 0x10, 0x76, 0x4b, 0x1b, 0x45, 0x18, 0xf8, 0x82, 0x93, 0x1d, 0x58, 0x8c, 0xb8, 0x98, 0x8e,
-	0x25, 0xee, 0x6a, 0xd8, 0x9a, 0xa2, 0xcf, 0x60, 0x23, 0x63, 0x95, 0x16, 0xde, 0x83, 0xc6, 0x44,
-	0x0c, 0xfb, 0xf2, 0x3a, 0xe4, 0x28, 0xde, 0xb4, 0x97, 0x26, 0x62, 0xf8, 0xf6, 0x3a, 0xe4, 0x84,
-	0xc0, 0xbc, 0xcb, 0x24, 0x6b, 0xd7, 0x91, 0x8d, 0xdf, 0x94, 0xc0, 0xfa, 0xeb, 0xc0, 0x7f, 0xc3,
-	0x22, 0x36, 0x11, 0xda, 0x52, 0xfa, 0x8f, 0x39, 0xc5, 0x74, 0xf9, 0x85, 0xff, 0x2e, 0x48, 0xf4,
-	0xae, 0x42, 0x5d, 0x9b, 0xdd, 0xb4, 0xeb, 0x9e, 0xab, 0x70, 0x9c, 0x11, 0xf3, 0x7c, 0x75, 0x98,
-	0x3a, 0x1e, 0x66, 0x09, 0xe9, 0x0b, 0x97, 0xb4, 0x61, 0xe9, 0x92, 0x47, 0xc2, 0x0b, 0xfc, 0xf6,
-	0x5c, 0xbc, 0xa2, 0x49, 0xe5, 0x83, 0x90, 0xf3, 0xa8, 0xef, 0x04, 0x53, 0x5f, 0xb6, 0xe7, 0x63,
-	0x1f, 0x28, 0xce, 0x99, 0x62, 0x10, 0x0a, 0xcb, 0xe2, 0xda, 0x77, 0x46, 0x51, 0xe0, 0x7b, 0x37,
-	0xdc, 0x6d, 0x2f, 0xe0, 0x71, 0x73, 0x3c, 0x72, 0x17, 0x5a, 0x83, 0xa9, 0xf3, 0x9e, 0xcb, 0xbe,
-	0xf0, 0x6e, 0x78, 0x7b, 0xf1, 0xa8, 0x76, 0xbc, 0x60, 0x43, 0xcc, 0xea, 0x79, 0x37, 0x9c, 0x1c,
-	0xc3, 0x7a, 0xc4, 0xc7, 0xec, 0xba, 0xef, 0x30, 0x67, 0xc4, 0x63, 0xa9, 0x25, 0x94, 0x5a, 0x45,
-	0xfe, 0x99, 0x62, 0xa3, 0xe4, 0x09, 0x6c, 0x08, 0x19, 0x71, 0x36, 0xe9, 0x0b, 0x19, 0x44, 0x5a,
-	0xb4, 0x81, 0xa2, 0x6b, 0xf1, 0x42, 0x4f, 0xf1, 0x51, 0xf6, 0x31, 0xb4, 0x73, 0xb2, 0xfc, 0x27,
-	0xc9, 0x7d, 0x37, 0xde, 0xd2, 0xc4, 0x2d, 0xdb, 0x99, 0x2d, 0x2f, 0x70, 0x15, 0x37, 0x7e, 0x01,
-	0xeb, 0x18, 0x43, 0x4e, 0x30, 0xee, 0x1b, 0xaf, 0x00, 0x7a, 0x71, 0xcd, 0xf0, 0xbf, 0xd5, 0xde,
-	0xe9, 0x42, 0x2b, 0x0a, 0xa6, 0x92, 0xf7, 0x25, 0x1b, 0x8c, 0x79, 0xbb, 0x75, 0x34, 0x77, 0xdc,
-	0xea, 0x6e, 0x9c, 0x62, 0x54, 0x9f, 0xda, 0x6a, 0xe5, 0xad, 0x5a, 0xb0, 0x21, 0x4a, 0xbe, 0xe9,
-	0x1f, 0xc1, 0xea, 0xa9, 0x00, 0x17, 0xd2, 0x73, 0x44, 0xe9, 0xd2, 0x76, 0x60, 0x11, 0x79, 0xcf,
-	0xf5, 0xc5, 0x69, 0x4a, 0xf1, 0x5f, 0x72, 0x6f, 0x38, 0x92, 0x78, 0x75, 0xf3, 0xb6, 0xa6, 0x54,
-	0x84, 0xbc, 0x64, 0x62, 0x84, 0xd7, 0xd6, 0xb4, 0xf1, 0x9b, 0xec, 0x43, 0xf3, 0x8d, 0xb9, 0x21,
-	0x73, 0x65, 0x09, 0x83, 0x7e, 0x05, 0x90, 0x5a, 0x56, 0x0a, 0x92, 0x36, 0x2c, 0x31, 0xd7, 0x8d,
-	0xb8, 0x10, 0xed, 0x3a, 0x66, 0x89, 0x21, 0xe9, 0x5f, 0xea, 0xb0, 0x79, 0xce, 0xe5, 0x6b, 0x3e,
-	0x50, 0xe6, 0xe7, 0xc2, 0x37, 0x09, 0xab, 0x5a, 0x3e, 0xac, 0x08, 0xcc, 0x4b, 0xe6, 0x8d, 0x4d,
-	0xf8, 0xaa, 0x6f, 0x62, 0x41, 0xc3, 0x09, 0x3c, 0x7f, 0xc0, 0x04, 0xd7, 0x46, 0x27, 0xf4, 0x6d,
-	0xc1, 0x76, 0x07, 0x9a, 0x9e, 0xe8, 0x4f, 0x3c, 0xdf, 0xf3, 0x87, 0x3a, 0xd2, 0x1a, 0x9e, 0xf8,
-	0x06, 0xe9, 0xca, 0x5b, 0x5b, 0xac, 0xbe, 0xb5, 0x62, 0xd0, 0x2e, 0x55, 0x04, 0x6d, 0x26, 0x23,
-	0x1a, 0x71, 0x4e, 0x6a, 0x92, 0x7e, 0x09, 0xeb, 0x4f, 0x1d, 0xb4, 0x50, 0x24, 0x3e, 0xd8, 0x87,
-	0xa6, 0x76, 0x13, 0x17, 0xba, 0xba, 0xa4, 0x0c, 0xfa, 0x12, 0x76, 0xce, 0xb9, 0xd4, 0x9b, 0xb4,
-	0xf3, 0xe2, 0x0a, 0x93, 0xf1, 0xb6, 0xce, 0x7c, 0x4d, 0xaa, 0x5a, 0x85, 0xe5, 0x4c, 0xfb, 0x2e,
-	0x26, 0xe8, 0x05, 0xec, 0x96, 0x34, 0x69, 0x13, 0xda, 0xb0, 0x34, 0x60, 0x63, 0xe6, 0x3b, 0x49,
-	0x11, 0xd1, 0xa4, 0x52, 0xe5, 0x07, 0x8a, 0xaf, 0x55, 0x21, 0x41, 0x7f, 0x0d, 0xe4, 0x9c, 0xcb,
-	0xe7, 0xd7, 0x3e, 0x13, 0xf2, 0x3a, 0xd1, 0x72, 0x08, 0xe0, 0xf2, 0x31, 0x1f, 0x32, 0xc9, 0x93,
-	0x93, 0x64, 0x38, 0xf4, 0x6b, 0x68, 0xab, 0x5d, 0x9a, 0xf1, 0x6d, 0x20, 0x79, 0x64, 0x8a, 0x90,
-	0x72, 0x42, 0x22, 0xa9, 0x6d, 0x48, 0x19, 0xf4, 0x11, 0xec, 0x55, 0xec, 0x4c, 0xa3, 0xfe, 0x12,
-	0x39, 0x1a, 0x52, 0x53, 0xf4, 0x9f, 0x75, 0x20, 0x6f, 0x23, 0xe6, 0x0b, 0xe6, 0xa8, 0x8e, 0x60,
-	0x90, 0x08, 0xcc, 0xbf, 0x8b, 0x82, 0x89, 0x06, 0xc1, 0x6f, 0x15, 0xc8, 0x32, 0xd0, 0x47, 0xac,
-	0xcb, 0x40, 0x9d, 0xfa, 0x92, 0x8d, 0xa7, 0x26, 0xc8, 0x62, 0x22, 0xf5, 0xc5, 0x3c, 0x66, 0x51,
-	0x4c, 0xa8, 0xc0, 0x1a, 0x32, 0xd1, 0x0f, 0x23, 0xcf, 0xe1, 0x18, 0x58, 0x4d, 0xbb, 0x31, 0x64,
-	0xe2, 0x8d, 0xa2, 0xcd, 0xe2, 0xd8, 0x9b, 0x78, 0x52, 0x47, 0x94, 0x5a, 0x7c, 0xa5, 0x68, 0xd2,
-	0x55, 0xd1, 0xec, 0xcb, 0x88, 0x39, 0x12, 0xc3, 0xa8, 0xd5, 0xdd, 0xd1, 0xd9, 0x7f, 0xa6, 0xd9,
-	0xda, 0x66, 0x3b, 0x91, 0x23, 0xbf, 0x81, 0xa6, 0xc3, 0x7c, 0xd7, 0x73, 0x99, 0x8c, 0x8b, 0x57,
-	0xab, 0xbb, 0x6b, 0x36, 0x19, 0xbe, 0xd9, 0x95, 0x4a, 0x2a, 0x28, 0xe3, 0x4d, 0xac, 0x5f, 0x29,
-	0x94, 0x71, 0x6a, 0x02, 0x65, 0xe4, 0xe8, 0x0d, 0xac, 0x15, 0xec, 0x50, 0xae, 0x16, 0xc1, 0x34,
-	0x4a, 0xc2, 0x44, 0x53, 0xaa, 0x4a, 0xc7, 0x5f, 0x71, 0x23, 0x8a, 0x1d, 0x09, 0x31, 0x0b, 0x7b,
-	0x91, 0x05, 0x8d, 0x77, 0x53, 0x1f, 0xef, 0xc1, 0x24, 0xae, 0xa1, 0xd5, 0x85, 0xb0, 0x68, 0x28,
-	0xd0, 0xab, 0x4d, 0x1b, 0xbf, 0xe9, 0x09, 0xac, 0x17, 0x8f, 0xa3, 0xc0, 0xe3, 0x9b, 0x34, 0xe0,
-	0x31, 0x45, 0xcf, 0x61, 0xad, 0x70, 0x88, 0x59, 0xa2, 0xf9, 0x28, 0xab, 0x17, 0xa3, 0xac, 0x03,
-	0x7b, 0x3d, 0xee, 0xbb, 0x36, 0xbb, 0xaa, 0x0e, 0x1b, 0xec, 0xa6, 0x4a, 0xe1, 0xb2, 0xee, 0xa6,
-	0x12, 0x76, 0xd5, 0x86, 0x9c, 0x74, 0x1a, 0x94, 0xf2, 0xa7, 0x91, 0x2a, 0xae, 0xda, 0x82, 0x98,
-	0x52, 0x95, 0xc6, 0xdc, 0x65, 0x3f, 0xad, 0x95, 0x58, 0x69, 0x0c, 0xff, 0xa9, 0xce, 0xe2, 0x74,
-	0x0e, 0x98, 0xcb, 0xcd, 0x01, 0xbf, 0x82, 0xed, 0x73, 0x2e, 0x9f, 0xa9, 0x9c, 0x7e, 0x76, 0xad,
-	0x6a, 0x76, 0xc6, 0xc4, 0x0c, 0x22, 0x7e, 0xd3, 0x87, 0x70, 0xe7, 0x9c, 0xcb, 0x8c, 0x85, 0xb7,
-	0x6f, 0x39, 0x86, 0x75, 0x54, 0xfe, 0x7c, 0x3a, 0x09, 0x33, 0xd3, 0x4f, 0x5c, 0x57, 0x6b, 0xd8,
-	0xfc, 0x62, 0x82, 0x7e, 0x0e, 0x1b, 0x19, 0x49, 0x7d, 0xf2, 0xac, 0xa3, 0xcc, 0xd8, 0xf1, 0xef,
-	0x3a, 0x58, 0x39, 0x2f, 0x39, 0xdc, 0x0b, 0x65, 0x76, 0x4b, 0xd1, 0x0a, 0x55, 0x92, 0x74, 0x27,
-	0x28, 0xce, 0x1b, 0x26, 0x81, 0xe7, 0x4a, 0x09, 0x3c, 0x5f, 0x4e, 0xe0, 0x85, 0xca, 0x04, 0x5e,
-	0xcc, 0x26, 0xf0, 0x3e, 0x34, 0xa5, 0x37, 0xe1, 0x42, 0xb2, 0x49, 0x88, 0x79, 0x38, 0x67, 0xa7,
-	0x0c, 0x6c, 0x43, 0x2a, 0xa6, 0x1b, 0xba, 0x0d, 0x65, 0x27, 0xab, 0x66, 0x7a, 0xc4, 0x7c, 0x19,
-	0x80, 0x0f, 0x95, 0x81, 0x56, 0xa1, 0x0c, 0x54, 0x85, 0xc4, 0x72, 0x65, 0x48, 0xd0, 0x47, 0xb0,
-	0xf1, 0x9a, 0x5f, 0xe9, 0x12, 0x6e, 0xee, 0xe6, 0x10, 0x20, 0x64, 0x42, 0x84, 0xa3, 0x48, 0xb5,
-	0xc5, 0xd8, 0x87, 0x19, 0x0e, 0x3d, 0x05, 0x92, 0xdd, 0x94, 0x96, 0xfc, 0xea, 0xee, 0x41, 0xc7,
-	0xb0, 0xf5, 0x3b, 0x5f, 0x5d, 0x6b, 0x01, 0x67, 0x76, 0xbf, 0xc9, 0x5b, 0x50, 0x2f, 0x5a, 0xa0,
-	0xb2, 0xdf, 0x9d, 0x46, 0x2c, 0xc9, 0xfe, 0x79, 0x3b, 0xa1, 0x69, 0x07, 0xb6, 0x0b, 0x68, 0xb7,
-	0x8c, 0xc1, 0xa7, 0x40, 0x5e, 0x7d, 0x82, 0x71, 0xf4, 0x01, 0x6c, 0xbe, 0xfa, 0x04, 0xf5, 0x0f,
-	0x60, 0xb7, 0xe7, 0x0d, 0xfd, 0xaa, 0x9c, 0xae, 0x2a, 0x01, 0x7f, 0x82, 0xa3, 0x42, 0x09, 0x78,
-	0x93, 0x9c, 0xdb, 0xd8, 0xf6, 0x5b, 0x68, 0xc9, 0x74, 0x1d, 0xb7, 0xb7, 0xba, 0x7b, 0xba, 0xfe,
-	0x96, 0x4b, 0x8d, 0x9d, 0x95, 0xbe, 0xcd, 0xb7, 0xf4, 0x31, 0xdc, 0xfb, 0x80, 0x01, 0xb3, 0x13,
-	0x8c, 0x76, 0x60, 0xfd, 0x5c, 0xc7, 0x67, 0x22, 0x97, 0x0b, 0xe2, 0x5a, 0x3e, 0x88, 0xe9, 0xd7,
-	0xb0, 0xf9, 0x42, 0x48, 0x6f, 0xc2, 0x24, 0x3f, 0x67, 0x69, 0xfb, 0xbd, 0x07, 0xcb, 0x5c, 0xb3,
-	0xfb, 0x43, 0x66, 0xdc, 0xdf, 0xe2, 0xa9, 0x28, 0xfd, 0x0a, 0x56, 0x5f, 0x5c, 0xf2, 0xec, 0xcc,
-	0xf3, 0x19, 0x2c, 0x72, 0xe4, 0x60, 0xcf, 0x6e, 0x75, 0x97, 0xb5, 0x37, 0x50, 0xcc, 0xd6, 0x6b,
-	0xf4, 0x21, 0x2c, 0x20, 0x23, 0xfb, 0xf3, 0x55, 0x4b, 0x7e, 0xbe, 0x2a, 0x7f, 0x70, 0xba, 0xb0,
-	0xde, 0x93, 0x2c, 0x92, 0xdf, 0x78, 0x3e, 0xff, 0xd8, 0x04, 0xf9, 0x25, 0x2c, 0xc7, 0xe2, 0x1f,
-	0x0e, 0x8d, 0xee, 0xbf, 0x5a, 0x00, 0x4f, 0x43, 0xaf, 0xc7, 0xa3, 0x4b, 0x95, 0xd4, 0x3f, 0x40,
-	0x2b, 0x33, 0xd2, 0x12, 0xd3, 0x86, 0x8b, 0xff, 0x57, 0x96, 0xa5, 0x17, 0x2a, 0xe6, 0x5f, 0xba,
-	0xf7, 0xe7, 0xff, 0xfc, 0xef, 0x6f, 0xf5, 0x4d, 0xb2, 0xd1, 0xb9, 0x7c, 0xd8, 0x99, 0x0a, 0x1e,
-	0xa9, 0x9f, 0x54, 0x81, 0xfa, 0xbe, 0x83, 0x86, 0x19, 0xf0, 0x67, 0xeb, 0x4e, 0x17, 0xf2, 0xbf,
-	0x02, 0x55, 0x8a, 0x03, 0x97, 0x7b, 0x4a, 0xd9, 0x0f, 0xd0, 0x4c, 0xaa, 0x76, 0xa2, 0xb9, 0x58,
-	0xf1, 0xad, 0x76, 0x79, 0x41, 0xab, 0x3e, 0x40, 0xd5, 0xbb, 0x94, 0x24, 0xaa, 0x71, 0xbe, 0x74,
-	0xa7, 0x93, 0xf0, 0x49, 0xed, 0x44, 0xd9, 0x6d, 0x46, 0xdc, 0xdb, 0xed, 0x2e, 0x0e, 0xc3, 0x15,
-	0x76, 0x33, 0xa3, 0x2c, 0x82, 0xb5, 0xc2, 0xfc, 0x4a, 0x0e, 0x52, 0xd7, 0x56, 0x4c, 0xc8, 0xd6,
-	0xe1, 0xac, 0x65, 0x0d, 0x76, 0x84, 0x60, 0x16, 0xdd, 0x2e, 0x81, 0x29, 0x31, 0x75, 0x98, 0x09,
-	0xac, 0x15, 0xb2, 0x8b, 0xcc, 0x4e, 0xdc, 0x04, 0x6f, 0xc6, 0x50, 0x40, 0xef, 0x22, 0xde, 0x1e,
-	0xdd, 0x4a, 0xf0, 0x32, 0x99, 0xae, 0xe0, 0xbe, 0x87, 0xf9, 0x33, 0x36, 0x1e, 0xff, 0x1c, 0x8c,
-	0x36, 0x62, 0x10, 0xba, 0x92, 0x60, 0x38, 0x6c, 0x3c, 0x56, 0xca, 0x6f, 0x80, 0x94, 0xc7, 0x1b,
-	0x72, 0x94, 0xd1, 0x57, 0x39, 0xf9, 0xdc, 0x8a, 0x48, 0x11, 0x71, 0x9f, 0xee, 0x26, 0x88, 0x11,
-	0xbb, 0x2a, 0x1c, 0x8c, 0xc1, 0x6a, 0x7e, 0x66, 0x21, 0xfb, 0xe9, 0xdd, 0x94, 0x47, 0x19, 0x6b,
-	0xe5, 0xd4, 0x09, 0x22, 0x6e, 0xc2, 0xaf, 0x02, 0x62, 0x98, 0xdb, 0xa6, 0x20, 0xfe, 0x5a, 0xc3,
-	0xb9, 0xa8, 0x3c, 0x66, 0x10, 0x9a, 0x42, 0xcd, 0x1a, 0x84, 0xac, 0x7b, 0x55, 0x1e, 0xcf, 0x4d,
-	0x29, 0xf4, 0x0b, 0x34, 0xe2, 0x3e, 0x3d, 0xcc, 0x1a, 0x51, 0x96, 0x57, 0xb6, 0xf4, 0xa1, 0x99,
-	0x3c, 0xd5, 0x24, 0x49, 0x50, 0x7c, 0x52, 0x4a, 0x52, 0xac, 0xf4, 0xaa, 0x53, 0x91, 0x62, 0xc2,
-	0xc8, 0x3c, 0xa9, 0x9d, 0x7c, 0x59, 0xd3, 0xb5, 0xc7, 0xd4, 0xef, 0xdb, 0xf3, 0xac, 0x58, 0xe9,
-	0xe9, 0x3e, 0x22, 0xec, 0x90, 0xad, 0xec, 0x61, 0x12, 0x7d, 0x1c, 0x5a, 0x99, 0x52, 0xff, 0xa1,
-	0x70, 0x34, 0xc5, 0xad, 0xa2, 0x33, 0x54, 0x84, 0x7b, 0xa6, 0x29, 0x28, 0x37, 0xfd, 0x88, 0x19,
-	0x1d, 0xb7, 0x06, 0x1d, 0x16, 0x1f, 0x73, 0x57, 0xdb, 0xd9, 0x66, 0x91, 0xc2, 0xdd, 0x47, 0xb8,
-	0x03, 0xda, 0xce, 0x1e, 0x29, 0xab, 0xfc, 0x49, 0xed, 0xa4, 0xfb, 0xdf, 0x26, 0x2c, 0x3f, 0x75,
-	0x27, 0x9e, 0x6f, 0xaa, 0xb8, 0x03, 0x90, 0x4e, 0x47, 0xc4, 0x5c, 0x49, 0x69, 0xca, 0xb2, 0xf6,
-	0x2a, 0x56, 0xaa, 0xca, 0x08, 0x53, 0xca, 0x4d, 0x1d, 0xe9, 0xf8, 0xfc, 0x4a, 0x1d, 0x34, 0x80,
-	0x95, 0xdc, 0x90, 0x43, 0xee, 0x68, 0x6d, 0x55, 0x83, 0x96, 0xb5, 0x5f, 0xbd, 0x58, 0x75, 0xcc,
-	0x3c, 0xda, 0x14, 0x37, 0x28, 0xc0, 0x21, 0xb4, 0x32, 0x43, 0x4f, 0x72, 0x81, 0xe5, 0xc1, 0x29,
-	0xb9, 0xc0, 0x8a, 0x19, 0x89, 0xde, 0x43, 0xa8, 0x3b, 0x74, 0xa7, 0x0c, 0x95, 0x02, 0xad, 0x15,
-	0xc6, 0xa5, 0x8f, 0x2a, 0x5e, 0xd5, 0x13, 0x96, 0xa9, 0xfe, 0x74, 0x35, 0x05, 0x14, 0xde, 0x10,
-	0x2b, 0xc8, 0xdf, 0x6b, 0x70, 0x50, 0xa8, 0x40, 0xdf, 0x79, 0x72, 0x94, 0x0e, 0x3b, 0xe4, 0xf3,
-	0xea, 0x3a, 0x55, 0x9a, 0xc7, 0xac, 0xe3, 0xdb, 0x05, 0xb5, 0x3d, 0xa7, 0x68, 0xcf, 0x31, 0xbd,
-	0x9f, 0xda, 0x23, 0x67, 0xe1, 0x2b, 0x23, 0xaf, 0x80, 0x94, 0x9f, 0xe7, 0x66, 0x67, 0xa7, 0x29,
-	0x3a, 0xb3, 0x9f, 0xf4, 0xe8, 0x2f, 0xd0, 0x82, 0xbb, 0xe4, 0x20, 0xe3, 0x91, 0x44, 0x1a, 0x7b,
-	0x3a, 0x42, 0x7c, 0x0f, 0x90, 0x3e, 0xc8, 0xcc, 0x06, 0xdc, 0x4b, 0xb3, 0xab, 0xf0, 0x78, 0x93,
-	0x6f, 0xbc, 0x31, 0x90, 0xab, 0xd5, 0xfd, 0x01, 0x36, 0x4a, 0xaf, 0x2f, 0xe4, 0x6e, 0x46, 0x55,
-	0xd5, 0x8b, 0x8e, 0x75, 0x34, 0x5b, 0x60, 0x76, 0x24, 0xbb, 0x39, 0x49, 0xe5, 0xd2, 0x4b, 0x58,
-	0x2b, 0x3c, 0x94, 0x27, 0x5d, 0xbf, 0xfa, 0xe5, 0x3d, 0x09, 0xb2, 0x19, 0xef, 0xeb, 0xf4, 0x33,
-	0x84, 0x3d, 0xa4, 0x7b, 0x29, 0xac, 0x93, 0x17, 0x55, 0xb8, 0xbf, 0x87, 0x66, 0x32, 0x48, 0xa6,
-	0x25, 0xbc, 0x30, 0x5a, 0x5a, 0x9b, 0x7a, 0x21, 0x3b, 0x3f, 0xd2, 0x43, 0x04, 0x68, 0xd3, 0xcd,
-	0xdc, 0x9d, 0xc5, 0x1b, 0x95, 0xea, 0xb7, 0xd0, 0xe8, 0xc9, 0x20, 0xcc, 0x69, 0x2e, 0x5d, 0x55,
-	0xa5, 0x66, 0x0b, 0x35, 0x6f, 0x11, 0x92, 0xd5, 0x1c, 0x6b, 0x1a, 0x2c, 0xe2, 0x4b, 0xe5, 0xa3,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x5c, 0xc3, 0x21, 0x26, 0x19, 0x00, 0x00,
+	// 6321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x3c, 0x4d, 0x73, 0x24, 0x47,
+	0x56, 0x74, 0xb7, 0x3e, 0xb3, 0xd5, 0x92, 0xa6, 0x34, 0x1f, 0x9a, 0x9e, 0x0f, 0xcf, 0xa4, 0xbf,
+	0xc6, 0x5f, 0x1a, 0x7b, 0x8c, 0x3f, 0x62, 0x1d, 0x0e, 0x98, 0xd1, 0x8c, 0x6d, 0x2d, 0xf6, 0xec,
+	0x44, 0x6b, 0x3c, 0x5e, 0xc2, 0xbb, 0xf4, 0x56, 0x77, 0x97, 0x5a, 0xe5, 0x69, 0x55, 0xb5, 0xab,
+	0xaa, 0x35, 0x92, 0x37, 0x96, 0xdd, 0x85, 0x60, 0x23, 0xf6, 0xc0, 0x85, 0x8d, 0x20, 0xe0, 0x04,
+	0xc1, 0x01, 0x82, 0x20, 0x58, 0x0e, 0x44, 0xf0, 0x11, 0xdc, 0xf8, 0x01, 0x5c, 0xb8, 0xc0, 0x9d,
+	0xbd, 0x71, 0xe4, 0x42, 0xc0, 0x81, 0xf7, 0x5e, 0xbe, 0xcc, 0xca, 0xac, 0xae, 0x52, 0x6b, 0xd6,
+	0x9c, 0xd4, 0xf9, 0xf2, 0x65, 0xbe, 0xcc, 0x97, 0x2f, 0x5f, 0xbe, 0xaf, 0x92, 0x68, 0xf9, 0xe3,
+	0xb0, 0x9b, 0x8c, 0xfb, 0x5b, 0xe3, 0x24, 0xce, 0x62, 0x6f, 0x1e, 0x7e, 0x8e, 0x7b, 0xed, 0xcb,
+	0xc3, 0x38, 0x1e, 0x8e, 0x82, 0x9b, 0xd0, 0x79, 0xd3, 0x8f, 0xa2, 0x38, 0xf3, 0xb3, 0x30, 0x8e,
+	0x52, 0x85, 0xd4, 0x7e, 0x73, 0x18, 0x66, 0xfb, 0x93, 0xde, 0x56, 0x3f, 0x3e, 0xb8, 0x19, 0x05,
+	0xbd, 0xc9, 0xc8, 0x4f, 0xc3, 0xf8, 0xe6, 0x30, 0x7e, 0x8d, 0x1b, 0x37, 0xfb, 0x71, 0x12, 0xdc,
+	0x1c, 0xf7, 0x6e, 0xf6, 0x46, 0x71, 0xff, 0xb1, 0x1a, 0x24, 0x6f, 0x88, 0xf5, 0xdd, 0x49, 0x2f,
+	0xed, 0x27, 0x61, 0x2f, 0xe8, 0x04, 0x5f, 0x4e, 0x82, 0x34, 0xf3, 0xce, 0x8a, 0xf9, 0x2c, 0x1e,
+	0x87, 0xfd, 0xcd, 0xda, 0xb5, 0xc6, 0x8d, 0xe5, 0x8e, 0x6a, 0xc8, 0x3f, 0xaa, 0x89, 0xf3, 0x06,
+	0xf5, 0x0e, 0x4e, 0x91, 0xea, 0x01, 0xf7, 0xc4, 0xf2, 0x61, 0x90, 0xf4, 0xe2, 0x34, 0xcc, 0x8e,
+	0x61, 0x50, 0xed, 0xc6, 0xea, 0xad, 0x17, 0xb7, 0x68, 0xc9, 0x5b, 0xe5, 0x23, 0xb6, 0x1e, 0x69,
+	0xf4, 0x4e, 0x3e, 0x52, 0xbe, 0x23, 0x96, 0x0d, 0xdc, 0x13, 0x62, 0xe1, 0xa3, 0x7b, 0xb7, 0xef,
+	0xde, 0xeb, 0xac, 0xff, 0x8a, 0xb7, 0x2e, 0x56, 0x1e, 0x76, 0x6e, 0xdf, 0xdf, 0xbd, 0xbd, 0xfd,
+	0x70, 0xe7, 0x5b, 0xf7, 0x77, 0xd7, 0x6b, 0xde, 0x8a, 0x58, 0xea, 0xdc, 0xdb, 0xbe, 0xb7, 0xf3,
+	0xe0, 0xe1, 0xee, 0x7a, 0x5d, 0xfe, 0x43, 0x5d, 0x5c, 0x98, 0x22, 0x94, 0x8e, 0x81, 0x35, 0x81,
+	0xe7, 0x89, 0xb9, 0x7d, 0x3f, 0xdd, 0xa7, 0x65, 0x2d, 0x77, 0xe8, 0xb7, 0xf7, 0x8c, 0x68, 0x8e,
+	0xfd, 0x24, 0x88, 0xb2, 0x2e, 0x75, 0xd5, 0xa9, 0x4b, 0x28, 0xd0, 0x47, 0x88, 0x70, 0x5e, 0x2c,
+	0xec, 0x07, 0xe1, 0x70, 0x3f, 0xdb, 0x6c, 0x40, 0xdf, 0x5c, 0x87, 0x5b, 0xde, 0x65, 0xb1, 0x9c,
+	0x85, 0x07, 0xb0, 0x01, 0xff, 0x60, 0xbc, 0x39, 0x07, 0x5d, 0x8d, 0x4e, 0x0e, 0xf0, 0xda, 0x62,
+	0xa9, 0x1f, 0x87, 0x51, 0xcf, 0x4f, 0x83, 0xcd, 0x79, 0x9a, 0xd3, 0xb4, 0xbd, 0x2b, 0x42, 0x00,
+	0x52, 0x16, 0x74, 0x93, 0x38, 0xce, 0x36, 0x17, 0xa8, 0x77, 0x99, 0x20, 0x1d, 0x00, 0x78, 0x17,
+	0xc5, 0x52, 0x76, 0x94, 0xaa, 0xce, 0x45, 0xea, 0x5c, 0x84, 0x36, 0x75, 0xc1, 0x62, 0x83, 0x43,
+	0x58, 0x18, 0xf7, 0x2e, 0xa9, 0xc5, 0x2a, 0x10, 0x21, 0xbc, 0x27, 0x56, 0xb2, 0xc4, 0x8f, 0x52,
+	0xbf, 0x4f, 0xd2, 0xb0, 0xb9, 0x0c, 0xa7, 0xd6, 0xbc, 0x75, 0x81, 0x0f, 0x80, 0xd8, 0xf1, 0x30,
+	0xef, 0xef, 0x38, 0xc8, 0xf2, 0x07, 0x62, 0xbd, 0x88, 0xe1, 0x6d, 0x8b, 0xa6, 0x85, 0x43, 0x9c,
+	0x6b, 0xde, 0xba, 0xce, 0xf3, 0xd9, 0x53, 0x05, 0xfd, 0x20, 0x1c, 0x67, 0x9a, 0xd5, 0x1d, 0x7b,
+	0x94, 0xf7, 0x9c, 0x58, 0x50, 0x6b, 0x04, 0xf6, 0xe2, 0x7a, 0x56, 0x78, 0xfc, 0x3d, 0x04, 0x76,
+	0xb8, 0x0f, 0x8e, 0xfc, 0xfc, 0xf6, 0xbe, 0x1f, 0x0d, 0x83, 0xfb, 0x41, 0xf6, 0x24, 0x4e, 0x1e,
+	0xef, 0xdc, 0xd5, 0x32, 0x05, 0x0c, 0x8b, 0x14, 0xac, 0x1b, 0x0e, 0x68, 0x0d, 0xad, 0xce, 0x32,
+	0x43, 0x76, 0x06, 0xf2, 0x0d, 0x71, 0x61, 0x6a, 0x20, 0x9f, 0x38, 0x1c, 0x5e, 0x12, 0xa4, 0x93,
+	0x51, 0x46, 0xa3, 0x96, 0x3a, 0xdc, 0x92, 0x1d, 0x71, 0xc6, 0x12, 0x75, 0x46, 0x06, 0xc6, 0x1f,
+	0xa4, 0xc3, 0x6e, 0x76, 0x3c, 0x0e, 0x58, 0x44, 0x16, 0xa1, 0xfd, 0x10, 0x9a, 0x28, 0x39, 0x03,
+	0x3f, 0xf3, 0x59, 0x3c, 0xe8, 0xb7, 0xb7, 0x2a, 0xea, 0xb0, 0x9a, 0x06, 0x41, 0xe0, 0x97, 0xf4,
+	0xc4, 0xfa, 0xfd, 0x38, 0x7a, 0xe0, 0x27, 0xfe, 0x81, 0x96, 0x6d, 0xf9, 0x97, 0x0d, 0x04, 0x0e,
+	0x82, 0x9d, 0x68, 0x2f, 0x36, 0x74, 0xd4, 0xc0, 0x9a, 0x1e, 0x88, 0x74, 0xfb, 0xfb, 0x7e, 0x18,
+	0xe1, 0xe6, 0xea, 0xb4, 0xb9, 0x45, 0x6a, 0xef, 0x0c, 0xbc, 0x4d, 0xb1, 0x08, 0x77, 0x22, 0x45,
+	0xd6, 0x37, 0x54, 0x0f, 0x37, 0x91, 0x27, 0xe3, 0x20, 0x48, 0xba, 0xfd, 0x78, 0x12, 0x65, 0x24,
+	0x7f, 0xc0, 0x13, 0x84, 0x6c, 0x23, 0xc0, 0x93, 0x62, 0x25, 0x3d, 0x8e, 0xfa, 0xfb, 0x49, 0x1c,
+	0x85, 0x5f, 0x05, 0x03, 0x92, 0xc1, 0xa5, 0x8e, 0x03, 0x43, 0x69, 0xea, 0x4d, 0xfa, 0x8f, 0x83,
+	0xac, 0x9b, 0x42, 0x9b, 0x04, 0x71, 0xbe, 0x23, 0x14, 0x68, 0x17, 0x20, 0x1e, 0x28, 0x84, 0x24,
+	0x18, 0xf9, 0xc7, 0xdd, 0xbe, 0xdf, 0xdf, 0x0f, 0x14, 0xd6, 0x22, 0x61, 0xad, 0x12, 0x7c, 0x1b,
+	0xc1, 0x84, 0xf9, 0xb2, 0x38, 0x93, 0x66, 0x49, 0xe0, 0x1f, 0x74, 0xd3, 0x0c, 0x34, 0x8b, 0x42,
+	0x5d, 0x22, 0xd4, 0x35, 0xd5, 0xb1, 0x8b, 0x70, 0xc2, 0x7d, 0x47, 0x6c, 0x3a, 0xb8, 0xc1, 0x51,
+	0x16, 0x44, 0x03, 0x35, 0x64, 0x99, 0x86, 0x9c, 0xb3, 0x86, 0xdc, 0xa3, 0x5e, 0x1a, 0xf8, 0x92,
+	0x58, 0x27, 0x45, 0xd5, 0x8f, 0x47, 0x5d, 0xcd, 0x15, 0x41, 0x5c, 0x5c, 0xd3, 0xf0, 0x47, 0xcc,
+	0x9d, 0x5b, 0xa2, 0x99, 0xc4, 0x13, 0xb8, 0x62, 0x99, 0xdf, 0x1b, 0x05, 0x9b, 0x4d, 0x12, 0xbb,
+	0x33, 0x2c, 0x76, 0x1d, 0xec, 0x79, 0x88, 0x1d, 0x1d, 0x91, 0x98, 0xdf, 0xf2, 0xb7, 0x45, 0x7b,
+	0x17, 0xb5, 0x68, 0x9a, 0x85, 0xfd, 0x74, 0xea, 0xd0, 0x40, 0x92, 0x08, 0x76, 0x97, 0x0f, 0x8e,
+	0x5b, 0x08, 0xff, 0x48, 0xa9, 0x87, 0xba, 0x52, 0x0f, 0xaa, 0x85, 0x12, 0x83, 0xea, 0x83, 0xe5,
+	0x83, 0x7e, 0xa3, 0xca, 0x78, 0xa0, 0x4f, 0x48, 0x1f, 0x99, 0x01, 0xc8, 0x8f, 0x85, 0xc8, 0x57,
+	0x36, 0x25, 0x24, 0x20, 0x09, 0xfe, 0x60, 0x00, 0xe2, 0xab, 0x2e, 0x11, 0xc8, 0x26, 0x37, 0x51,
+	0x45, 0xf7, 0x26, 0xe1, 0x48, 0x8b, 0xa2, 0x6a, 0xc8, 0xbf, 0xab, 0x8b, 0x8d, 0x0f, 0x83, 0xec,
+	0x7e, 0xd0, 0xdb, 0x25, 0xcd, 0x62, 0x09, 0xb9, 0x11, 0xb6, 0x9a, 0x2b, 0x6c, 0xb0, 0xe4, 0xcc,
+	0x0f, 0x47, 0x5a, 0xc8, 0xf1, 0xb7, 0xa3, 0xc7, 0x1a, 0xd3, 0x7a, 0xec, 0x24, 0x11, 0xbc, 0x24,
+	0x96, 0xc3, 0xb4, 0x7b, 0x10, 0x46, 0x61, 0x34, 0x64, 0xf9, 0x5b, 0x0a, 0xd3, 0x4f, 0xa8, 0x5d,
+	0x7a, 0x96, 0x0b, 0xe5, 0x67, 0x59, 0x14, 0xe5, 0xc5, 0x12, 0x51, 0xb6, 0xee, 0x89, 0x52, 0x8a,
+	0xe6, 0x9e, 0xac, 0x8b, 0xc6, 0x28, 0xec, 0x91, 0x60, 0x2d, 0x77, 0xf0, 0x27, 0x2e, 0x1b, 0xfe,
+	0x74, 0x59, 0xa9, 0x0b, 0x3a, 0xb5, 0x65, 0x80, 0xa8, 0x83, 0x93, 0x3f, 0xaf, 0x0b, 0x0f, 0xb8,
+	0xc6, 0xd4, 0x0d, 0xdf, 0x2c, 0x0a, 0x35, 0x97, 0x02, 0x48, 0x00, 0x3c, 0xb3, 0x07, 0x61, 0xc6,
+	0x8c, 0xe3, 0x16, 0xc2, 0x7b, 0xa0, 0x04, 0xfb, 0x5a, 0x06, 0xb8, 0x85, 0xf4, 0xe9, 0x88, 0xba,
+	0xa0, 0x45, 0x02, 0xfd, 0x72, 0x10, 0xe4, 0x2e, 0x00, 0x90, 0xe3, 0x7b, 0x81, 0x9f, 0x4d, 0xe0,
+	0x6c, 0x81, 0x6b, 0x78, 0xd2, 0xa6, 0x8d, 0x43, 0x87, 0x71, 0x81, 0x5f, 0xcb, 0xc3, 0x58, 0x73,
+	0x0a, 0x64, 0x26, 0x4e, 0xf9, 0xcd, 0x80, 0x5f, 0x78, 0xa0, 0x7e, 0x02, 0xf4, 0x15, 0x4b, 0xe8,
+	0x77, 0x29, 0xe3, 0x97, 0xcb, 0x19, 0xff, 0xbc, 0x58, 0xed, 0x8f, 0x42, 0x7c, 0x1a, 0xdd, 0xdb,
+	0xd6, 0x52, 0x50, 0x46, 0x93, 0xaf, 0x8b, 0xf5, 0xdb, 0x7d, 0x92, 0x81, 0xfc, 0xa5, 0x05, 0x49,
+	0x67, 0xf1, 0x84, 0x5d, 0x28, 0xd3, 0x21, 0x07, 0xc8, 0x8f, 0xc4, 0x79, 0x10, 0x4d, 0x1e, 0xc4,
+	0xe2, 0xa9, 0x34, 0xbd, 0x25, 0xe5, 0xcc, 0x65, 0x5b, 0xca, 0xf1, 0x71, 0x62, 0x26, 0xab, 0x86,
+	0xfc, 0x71, 0x8d, 0xa4, 0x9c, 0xe6, 0xb8, 0x1b, 0xee, 0xed, 0xe9, 0x79, 0x40, 0xb5, 0xed, 0x25,
+	0xf1, 0x81, 0x3e, 0xe4, 0x1a, 0x1d, 0xb2, 0x40, 0x10, 0x5f, 0x4f, 0x10, 0xce, 0x2c, 0xd6, 0xdd,
+	0xea, 0xe6, 0x2e, 0x65, 0x31, 0x77, 0xe2, 0x89, 0x4e, 0x92, 0x34, 0x4e, 0xf4, 0xc9, 0xa9, 0x16,
+	0xae, 0x61, 0x14, 0xe2, 0x41, 0x2b, 0x59, 0x57, 0x0d, 0x19, 0xc2, 0x5b, 0x92, 0xd3, 0x67, 0x06,
+	0xbc, 0x29, 0x96, 0x7c, 0x66, 0x0a, 0xed, 0x3f, 0x7f, 0x84, 0xed, 0x6d, 0xd3, 0x10, 0x83, 0x88,
+	0xab, 0x8e, 0x40, 0x1b, 0x76, 0x99, 0x38, 0xdb, 0x22, 0x08, 0xda, 0x26, 0x88, 0xfc, 0xb7, 0xba,
+	0xe1, 0xb5, 0x19, 0x7f, 0x02, 0xcf, 0xa0, 0xa7, 0x0f, 0x8a, 0x34, 0x0b, 0xd4, 0xbb, 0xb2, 0xd4,
+	0xd1, 0x4d, 0xef, 0xba, 0x58, 0xe9, 0xf9, 0x23, 0x10, 0xc7, 0xa0, 0x8b, 0x4c, 0xe1, 0x7d, 0x36,
+	0x19, 0xf6, 0x01, 0x80, 0x48, 0x4c, 0x19, 0x25, 0x8b, 0x69, 0xc7, 0x70, 0x86, 0x0c, 0x79, 0x18,
+	0x7b, 0xcf, 0x8a, 0x96, 0xee, 0x1e, 0x04, 0x23, 0x78, 0x1a, 0x95, 0x95, 0xa3, 0xa7, 0xbd, 0x8b,
+	0x30, 0x7a, 0xb8, 0x63, 0x43, 0x64, 0x41, 0x5d, 0x35, 0x82, 0x10, 0x09, 0xd0, 0x45, 0xaa, 0x1b,
+	0x08, 0x2c, 0x52, 0xe7, 0x22, 0xb5, 0x61, 0x7a, 0x64, 0x45, 0x9c, 0x4f, 0xbe, 0x44, 0xb7, 0x44,
+	0x4d, 0xa6, 0xa6, 0x86, 0x1d, 0xe0, 0xf3, 0xe1, 0x0f, 0x83, 0xee, 0xe3, 0xe0, 0x58, 0x59, 0x3a,
+	0xb0, 0x03, 0x86, 0xfd, 0x06, 0x80, 0xbc, 0x57, 0xf0, 0x51, 0x52, 0x28, 0x59, 0x32, 0x89, 0xfa,
+	0xc4, 0x08, 0x41, 0x8c, 0x58, 0xe7, 0x8e, 0x87, 0x1a, 0x2e, 0x77, 0xc4, 0x85, 0x29, 0x99, 0xcc,
+	0xaf, 0x3e, 0xef, 0x4a, 0x33, 0x98, 0x9b, 0x28, 0x10, 0xb4, 0x24, 0x2d, 0x94, 0xd4, 0x90, 0xbf,
+	0x2a, 0x3c, 0x98, 0xea, 0xee, 0x71, 0xe4, 0xa7, 0x60, 0xd4, 0xea, 0x59, 0xae, 0x0a, 0x01, 0x7b,
+	0x09, 0x86, 0x30, 0xb3, 0xb9, 0x13, 0x16, 0x44, 0xbe, 0x2b, 0x36, 0x71, 0x14, 0x03, 0x1e, 0xc5,
+	0x19, 0x5c, 0x2f, 0x2d, 0xce, 0x70, 0x9d, 0x0c, 0x26, 0xaf, 0x21, 0x07, 0xc8, 0x37, 0xc5, 0xc5,
+	0x92, 0x91, 0xf9, 0xbb, 0x75, 0x48, 0x10, 0x26, 0xc9, 0x2d, 0xf9, 0xf7, 0x0d, 0xe1, 0x39, 0xf6,
+	0x9b, 0xa2, 0x04, 0x2a, 0x83, 0xce, 0x8a, 0x4d, 0x64, 0xfc, 0x8d, 0x6a, 0x05, 0x0e, 0x48, 0x6d,
+	0x11, 0x7e, 0xe1, 0xae, 0x0f, 0xfd, 0xd1, 0x44, 0x3f, 0x08, 0xaa, 0x91, 0xf3, 0x62, 0x8e, 0x4e,
+	0x52, 0x35, 0xf0, 0x9e, 0x0d, 0xfd, 0xb4, 0x3b, 0x4e, 0xc2, 0xbe, 0x31, 0x84, 0x01, 0xf0, 0x00,
+	0xdb, 0xba, 0x53, 0xdd, 0xa9, 0x05, 0xd3, 0xf9, 0x31, 0xb6, 0xe1, 0x09, 0x87, 0x97, 0x26, 0x02,
+	0x33, 0xb2, 0xaf, 0xcc, 0xe0, 0xe6, 0xad, 0xf3, 0x7c, 0x83, 0xb6, 0x19, 0xcc, 0x6b, 0xee, 0x18,
+	0x3c, 0xef, 0x2d, 0xb1, 0xdc, 0xf7, 0xa3, 0x41, 0x48, 0x9a, 0x75, 0x89, 0x06, 0xe9, 0x6b, 0xb7,
+	0xad, 0xe1, 0x7a, 0x54, 0x8e, 0x89, 0xa4, 0x34, 0x37, 0x49, 0x17, 0xe6, 0xa4, 0x34, 0x53, 0x0d,
+	0x29, 0x8d, 0xe7, 0xbd, 0x2a, 0x16, 0x50, 0x9b, 0xc3, 0x35, 0x15, 0x34, 0xe2, 0xac, 0xbe, 0xde,
+	0x04, 0xd4, 0xf8, 0x8c, 0xe3, 0xdd, 0x14, 0x8b, 0xf0, 0xc2, 0x24, 0x7e, 0x72, 0x0c, 0xb6, 0x08,
+	0xa2, 0x9f, 0x63, 0xf4, 0x8f, 0x15, 0x54, 0xe3, 0x6b, 0x2c, 0x75, 0x35, 0xba, 0x64, 0x65, 0x6d,
+	0xae, 0xa8, 0xbb, 0x1b, 0x81, 0x31, 0x02, 0x4d, 0xf9, 0x95, 0x58, 0x2b, 0x70, 0x00, 0x0f, 0x39,
+	0x8d, 0x27, 0x89, 0x11, 0x50, 0x6e, 0xe1, 0x2d, 0x52, 0xbf, 0x94, 0x51, 0xcb, 0x0a, 0x45, 0x81,
+	0xc8, 0xae, 0xc5, 0xc7, 0x06, 0x6e, 0x40, 0xa6, 0x0d, 0x4c, 0x7c, 0x6c, 0xb8, 0xad, 0x5e, 0x8f,
+	0x61, 0xca, 0x57, 0x9f, 0x7e, 0xcb, 0x97, 0xc5, 0x7a, 0x91, 0x91, 0x48, 0xdc, 0xf2, 0x0e, 0x80,
+	0xb8, 0x6a, 0xc9, 0x0f, 0xc5, 0x5a, 0x81, 0x7d, 0x55, 0xa8, 0xae, 0x7c, 0xd7, 0x8b, 0xf2, 0xfd,
+	0x03, 0xd1, 0x72, 0xb8, 0x7a, 0x92, 0x0d, 0x93, 0x7b, 0x6b, 0x75, 0xc7, 0x5b, 0x73, 0x7d, 0xae,
+	0x46, 0xd1, 0xe7, 0x02, 0x3e, 0xc4, 0xe3, 0x20, 0xf1, 0x41, 0x2b, 0xf0, 0x7e, 0x4d, 0x5b, 0x3e,
+	0x12, 0xab, 0xee, 0x29, 0x21, 0x67, 0x22, 0xff, 0x40, 0x33, 0x9b, 0x7e, 0xdb, 0xf6, 0x41, 0x7d,
+	0xca, 0x3e, 0xe0, 0xc3, 0x69, 0xd8, 0x87, 0x23, 0xbf, 0x29, 0x2e, 0xee, 0x82, 0x69, 0xdb, 0xf1,
+	0x9f, 0x94, 0xdf, 0x43, 0x72, 0x38, 0x90, 0xc4, 0x0a, 0x3b, 0x1c, 0xb6, 0x4c, 0xd4, 0x5d, 0x99,
+	0xc8, 0xc0, 0xe9, 0x85, 0xb9, 0x9c, 0x89, 0x72, 0x05, 0x90, 0x1d, 0x59, 0x6e, 0x2f, 0xb7, 0xd0,
+	0x10, 0xd0, 0xf7, 0xa6, 0x9b, 0x5b, 0x96, 0x64, 0x08, 0x68, 0xf8, 0x6d, 0x7e, 0x47, 0x72, 0x2f,
+	0xaa, 0xe1, 0x78, 0x51, 0xaf, 0x88, 0x73, 0xa0, 0x78, 0xc8, 0x67, 0xbc, 0x73, 0x8c, 0x16, 0xae,
+	0xb5, 0xfa, 0xa2, 0xa3, 0x0d, 0x5e, 0xda, 0x25, 0x40, 0xb6, 0x56, 0x38, 0x7b, 0xc8, 0x0d, 0x76,
+	0x48, 0xef, 0x4e, 0x0e, 0xc6, 0x56, 0x40, 0x42, 0xd9, 0x9b, 0x35, 0x72, 0x15, 0x54, 0x43, 0xbe,
+	0x28, 0xce, 0x58, 0x98, 0xb9, 0xbb, 0x6f, 0x78, 0xc8, 0x4e, 0x9b, 0xfc, 0x59, 0x4d, 0x9c, 0x41,
+	0x24, 0x37, 0x68, 0x41, 0x8f, 0x89, 0x9f, 0x64, 0xae, 0xbd, 0xd0, 0x24, 0x18, 0xdb, 0x04, 0x86,
+	0xae, 0x92, 0x2b, 0xd5, 0x70, 0xa3, 0x1d, 0x8d, 0x5f, 0x3a, 0xda, 0xf1, 0xbf, 0x75, 0xd1, 0xae,
+	0x76, 0xa6, 0x4b, 0xe3, 0x16, 0xf8, 0xb6, 0x2b, 0x99, 0x2f, 0xfa, 0x8c, 0x5a, 0x85, 0x37, 0xa6,
+	0x54, 0xf8, 0xdc, 0xb4, 0x0a, 0x9f, 0x2f, 0x55, 0xe1, 0x0b, 0xb6, 0x0a, 0x77, 0x02, 0x1d, 0x8b,
+	0xc5, 0x40, 0x07, 0x3a, 0x0d, 0xa8, 0x5b, 0xd8, 0xc6, 0xcc, 0x6c, 0x6f, 0x79, 0xd9, 0xf2, 0x96,
+	0x9d, 0x87, 0x40, 0x9c, 0xf4, 0x10, 0x34, 0x0b, 0x0f, 0x41, 0x99, 0xa0, 0xae, 0x94, 0x0b, 0xea,
+	0x5b, 0x62, 0x65, 0x10, 0xf4, 0xc1, 0x31, 0x1b, 0x80, 0xcb, 0x3a, 0x1a, 0x6d, 0xb6, 0x48, 0xd7,
+	0x7a, 0x46, 0x99, 0x53, 0xd7, 0x36, 0xf4, 0x74, 0x9a, 0x83, 0xbc, 0x21, 0xdf, 0x16, 0x82, 0xfb,
+	0x6e, 0x27, 0xc3, 0xd2, 0xdb, 0x6d, 0xf8, 0x55, 0xb7, 0xf8, 0x25, 0x23, 0xd1, 0xb4, 0xe6, 0x74,
+	0x94, 0x69, 0xad, 0xa0, 0x4c, 0x9f, 0x67, 0x65, 0x5a, 0x77, 0x3c, 0xd1, 0x9c, 0xaa, 0xd2, 0xaf,
+	0xc8, 0xeb, 0x34, 0x1c, 0x46, 0x64, 0xee, 0x1b, 0x2d, 0xa5, 0x01, 0xf0, 0xd0, 0x9f, 0xb9, 0x1f,
+	0x3c, 0x61, 0x1b, 0x45, 0xcb, 0x2e, 0xd8, 0x15, 0x63, 0x3f, 0x4d, 0xc7, 0xfb, 0x09, 0xfa, 0x68,
+	0x35, 0x1d, 0xbf, 0xd2, 0x10, 0xb9, 0x85, 0xee, 0x4c, 0x3e, 0x28, 0xb7, 0x69, 0xca, 0x8d, 0x46,
+	0x39, 0x12, 0x67, 0x3f, 0x8d, 0x50, 0x64, 0x0b, 0x74, 0xaa, 0xcd, 0x4c, 0x77, 0x05, 0xf5, 0xe2,
+	0x0a, 0x90, 0x2f, 0x83, 0x49, 0xe2, 0x9b, 0x47, 0x06, 0x4c, 0x6d, 0xdd, 0x96, 0x37, 0xc5, 0xb9,
+	0x02, 0xb5, 0x19, 0x91, 0x1b, 0xd8, 0xce, 0xc7, 0x4f, 0xb1, 0x38, 0xf9, 0x9a, 0xd8, 0xf8, 0xf8,
+	0x29, 0xa6, 0x7f, 0x0d, 0x14, 0x29, 0xf0, 0xbb, 0x4c, 0x91, 0x96, 0xa8, 0x64, 0xf9, 0x43, 0x71,
+	0xad, 0xa0, 0x77, 0x1f, 0x98, 0x7d, 0xeb, 0xb5, 0xbd, 0x57, 0x16, 0x42, 0xbb, 0x58, 0x16, 0x42,
+	0x53, 0x36, 0x80, 0x13, 0x3a, 0x9b, 0xc1, 0x5b, 0xf9, 0x8e, 0xb8, 0x7e, 0xc2, 0x02, 0xaa, 0xf5,
+	0x87, 0xfc, 0xb6, 0x58, 0xfb, 0x90, 0xaf, 0x9f, 0x2d, 0x49, 0x01, 0xbc, 0x4c, 0x51, 0x16, 0x8e,
+	0x02, 0x7e, 0x58, 0x2d, 0x08, 0xfa, 0x83, 0xfb, 0x78, 0x85, 0x73, 0x1c, 0xf5, 0x0a, 0xb5, 0x00,
+	0xfa, 0xc0, 0x00, 0xe1, 0x48, 0xd7, 0xf3, 0x99, 0x79, 0x05, 0xce, 0xed, 0xaf, 0xb9, 0xb7, 0x5f,
+	0xfe, 0x67, 0x5d, 0x6c, 0x6c, 0xa3, 0xf2, 0x02, 0xb3, 0x66, 0x2f, 0x1c, 0x9e, 0x26, 0x54, 0x01,
+	0x0a, 0x7b, 0x18, 0x44, 0x41, 0x1a, 0xa6, 0x76, 0xd8, 0xb6, 0xc9, 0x30, 0x0a, 0xb6, 0xc0, 0x6a,
+	0xc9, 0x47, 0xec, 0x86, 0x11, 0x18, 0xbc, 0x70, 0x61, 0x49, 0xf6, 0x1a, 0x9d, 0x16, 0x41, 0x77,
+	0x18, 0x88, 0xda, 0x65, 0xa0, 0x2c, 0xf5, 0x1c, 0x51, 0xf9, 0xe4, 0x6b, 0x0c, 0x37, 0xa8, 0x40,
+	0x54, 0xa3, 0x52, 0xb0, 0x6a, 0x9e, 0xd6, 0xd4, 0x64, 0x18, 0x85, 0xa8, 0x60, 0x9f, 0xa9, 0xbf,
+	0x17, 0xe4, 0x01, 0xb5, 0x56, 0x67, 0x09, 0x01, 0xd4, 0xf9, 0xba, 0x38, 0x8b, 0x4c, 0x48, 0xfb,
+	0xfb, 0xc1, 0x60, 0x32, 0x0a, 0x8c, 0x57, 0xbd, 0x48, 0x78, 0x1e, 0xf4, 0xed, 0x72, 0x97, 0xf6,
+	0xc0, 0x5f, 0x14, 0xf3, 0x7b, 0x71, 0xf2, 0x38, 0x65, 0x5b, 0x56, 0xab, 0x0d, 0x62, 0xd6, 0x07,
+	0xd8, 0xd1, 0x51, 0xfd, 0xde, 0xcb, 0x62, 0x81, 0x94, 0x67, 0xca, 0xf6, 0xab, 0x67, 0x63, 0x92,
+	0x1a, 0x4d, 0x3b, 0x8c, 0x21, 0xff, 0xa4, 0x21, 0x44, 0x3e, 0x83, 0xf7, 0xb6, 0xb8, 0x60, 0xd4,
+	0x2b, 0xfe, 0x40, 0x07, 0xd4, 0x79, 0x06, 0xcf, 0xe9, 0xee, 0x6d, 0xd5, 0xcb, 0x0f, 0x22, 0x38,
+	0x80, 0xe9, 0x64, 0x3c, 0x1e, 0x1d, 0xbb, 0x5e, 0xf4, 0x8a, 0x02, 0x32, 0xd2, 0x0b, 0x62, 0x6d,
+	0x2f, 0x08, 0xba, 0xbd, 0x49, 0x12, 0x75, 0x9d, 0x28, 0x7a, 0x0b, 0xc0, 0x77, 0x00, 0xca, 0x78,
+	0xf0, 0xd2, 0x1b, 0x3c, 0x96, 0x2f, 0x76, 0xb2, 0x57, 0x19, 0x91, 0x05, 0x0c, 0x31, 0xf7, 0x03,
+	0x7f, 0x10, 0x24, 0xdd, 0xc3, 0x37, 0xf4, 0x94, 0xf3, 0x34, 0xe5, 0xaa, 0x82, 0x3f, 0x7a, 0x83,
+	0xe7, 0x7c, 0x53, 0x9c, 0x57, 0x02, 0x60, 0x9e, 0x16, 0x8d, 0xaf, 0x9e, 0xb7, 0x0d, 0xea, 0xfd,
+	0x90, 0x9f, 0x19, 0x1e, 0x04, 0x52, 0x03, 0x2f, 0x5b, 0x8a, 0x3e, 0x23, 0x23, 0x2b, 0xc7, 0xb4,
+	0xc5, 0xd0, 0x7c, 0xbd, 0x87, 0x07, 0x6a, 0xd2, 0x54, 0x23, 0x2e, 0xa9, 0x55, 0x1c, 0x1e, 0x28,
+	0x7e, 0x33, 0xe6, 0x2d, 0x71, 0x2e, 0x9a, 0x1c, 0x04, 0x20, 0xe8, 0xdd, 0x71, 0x3c, 0x0a, 0xfb,
+	0x86, 0x5d, 0xcb, 0x6a, 0x11, 0xdc, 0xf9, 0x80, 0xfa, 0x38, 0x04, 0xb5, 0x25, 0xce, 0x62, 0x50,
+	0x83, 0x18, 0xa9, 0x82, 0x90, 0xc6, 0x7c, 0x76, 0x4e, 0x86, 0x5b, 0xf2, 0xcf, 0x6b, 0xc2, 0xb3,
+	0xb1, 0x73, 0x1d, 0x57, 0x86, 0x8e, 0xca, 0x32, 0x8c, 0xc2, 0x2c, 0xf4, 0x75, 0xa8, 0x4f, 0x37,
+	0x71, 0x44, 0x98, 0xa6, 0x93, 0x40, 0xc7, 0x12, 0xb9, 0x45, 0xa1, 0x2c, 0x38, 0x03, 0x80, 0xcf,
+	0x71, 0x28, 0x8b, 0x5a, 0x2a, 0x3b, 0x94, 0xc1, 0x3c, 0x6c, 0x46, 0x50, 0x03, 0xe7, 0x47, 0x79,
+	0x79, 0x0c, 0xe8, 0x0b, 0xca, 0x4c, 0xe5, 0xa6, 0xfc, 0x45, 0x5d, 0x34, 0x2d, 0x91, 0xf4, 0xa4,
+	0x68, 0x61, 0xaa, 0x03, 0x4e, 0xbc, 0xab, 0x82, 0x3b, 0xea, 0x9a, 0x37, 0x01, 0x08, 0xe7, 0x4d,
+	0x86, 0x93, 0x77, 0x41, 0x2c, 0x1e, 0xf8, 0x47, 0x78, 0x88, 0x3a, 0xbe, 0x06, 0x4d, 0x38, 0x35,
+	0x1c, 0xcc, 0x1d, 0xac, 0x57, 0x38, 0x88, 0xa1, 0xba, 0x95, 0x61, 0x81, 0x38, 0xa0, 0x40, 0x72,
+	0x9c, 0x39, 0xc6, 0x09, 0xa3, 0x0f, 0x4b, 0x8d, 0x8f, 0xf9, 0x82, 0xf1, 0xf1, 0x96, 0xb8, 0x60,
+	0x26, 0x80, 0x55, 0xda, 0x8a, 0x5c, 0x39, 0xac, 0x67, 0x79, 0xaa, 0x20, 0xb1, 0xd3, 0x26, 0xd7,
+	0x40, 0x3f, 0xf1, 0x90, 0xde, 0x71, 0x16, 0x70, 0x4c, 0x4e, 0x0c, 0x09, 0xf1, 0x0e, 0x40, 0xf0,
+	0x66, 0x14, 0xa4, 0x93, 0x4d, 0xa8, 0x96, 0x23, 0x96, 0x28, 0xc5, 0xb8, 0xcb, 0xbd, 0x70, 0x94,
+	0x69, 0x2e, 0x75, 0x13, 0x4c, 0x76, 0x68, 0x01, 0x82, 0xde, 0x0f, 0xa8, 0x93, 0xd8, 0xd5, 0xc1,
+	0x2e, 0xf9, 0x16, 0x05, 0xd8, 0x3e, 0x09, 0x0e, 0xc6, 0x71, 0x3c, 0xc2, 0x60, 0x86, 0xb1, 0x74,
+	0x4f, 0x54, 0xc4, 0xdf, 0x14, 0xab, 0x9a, 0x2b, 0x77, 0x28, 0x0b, 0x30, 0xcd, 0xbf, 0xda, 0x34,
+	0xff, 0x1c, 0xcb, 0xb8, 0xa5, 0x2d, 0xf2, 0x7f, 0xa9, 0x89, 0xb3, 0xee, 0x02, 0x72, 0xad, 0x9e,
+	0x1d, 0x75, 0x73, 0x1b, 0xbe, 0x85, 0xe9, 0x2d, 0x15, 0x31, 0x56, 0x5d, 0xc8, 0xb0, 0x94, 0xb5,
+	0x09, 0x74, 0x21, 0xb7, 0x52, 0x60, 0xc3, 0xf2, 0x7e, 0x98, 0x66, 0xf1, 0x30, 0xf1, 0xd1, 0xb2,
+	0x6d, 0x58, 0x2e, 0xb4, 0xbb, 0xe4, 0x4e, 0x8e, 0xe7, 0x6e, 0x76, 0xae, 0x60, 0x73, 0x6e, 0x09,
+	0xa5, 0x00, 0xd2, 0x6e, 0x16, 0x83, 0xea, 0xef, 0x8f, 0x26, 0xa4, 0x8c, 0x95, 0x52, 0x3f, 0xa3,
+	0xba, 0x1e, 0xc6, 0x3b, 0xba, 0x43, 0xbe, 0x4a, 0x3c, 0x7d, 0x00, 0x8f, 0x6d, 0x18, 0x0d, 0x15,
+	0xaf, 0x4f, 0x70, 0x5d, 0x1e, 0x0b, 0x8f, 0x51, 0xff, 0xdf, 0xb3, 0x69, 0xeb, 0xa2, 0x91, 0x5f,
+	0x06, 0xfc, 0x29, 0xff, 0x1b, 0x78, 0xed, 0x2e, 0x6c, 0x86, 0x06, 0x98, 0x99, 0xf4, 0x7c, 0xbf,
+	0x90, 0x47, 0x54, 0x1c, 0xd7, 0x46, 0xcb, 0xf4, 0xce, 0xdc, 0x4c, 0x22, 0x1e, 0x24, 0x32, 0x7e,
+	0x92, 0x1a, 0x8d, 0xb1, 0x08, 0xed, 0x4f, 0xa1, 0x79, 0xf2, 0x6d, 0x83, 0x4e, 0x14, 0x18, 0xe7,
+	0xf9, 0x24, 0x09, 0xc2, 0xe7, 0x13, 0xe4, 0x2c, 0x8c, 0x06, 0xc1, 0x11, 0xa7, 0xa0, 0x54, 0x43,
+	0xbe, 0x2b, 0x36, 0xee, 0xa5, 0xe0, 0x8e, 0x80, 0x27, 0x0f, 0x82, 0x60, 0x76, 0x0e, 0x6f, 0x75,
+	0xc0, 0x60, 0x52, 0x1d, 0x2c, 0xb7, 0x41, 0x8e, 0x4a, 0x5a, 0xf3, 0x41, 0x12, 0xc3, 0xcd, 0x7a,
+	0xca, 0x91, 0xf8, 0xf4, 0x05, 0x47, 0x41, 0x7f, 0x82, 0x9b, 0x35, 0x8a, 0x09, 0x9e, 0x3e, 0x03,
+	0x44, 0xa4, 0xd7, 0xc5, 0xb2, 0xb6, 0xfe, 0x35, 0xff, 0xf4, 0xab, 0xfc, 0x01, 0xc3, 0x91, 0x6c,
+	0x8e, 0x84, 0xa7, 0xb5, 0x17, 0x8f, 0x06, 0xc4, 0x33, 0x0a, 0xd5, 0xa9, 0x96, 0xfc, 0x44, 0x34,
+	0xad, 0x11, 0xc8, 0x87, 0xbd, 0x24, 0x77, 0x50, 0x54, 0x03, 0x85, 0x30, 0x0d, 0x46, 0x7b, 0xbc,
+	0x14, 0xfa, 0x9d, 0xab, 0x67, 0xf5, 0xe6, 0xaa, 0x06, 0x78, 0x3b, 0xab, 0xf7, 0x54, 0xc6, 0x58,
+	0x6f, 0x39, 0xcf, 0xcf, 0xd6, 0x4e, 0xc8, 0xcf, 0xbe, 0x21, 0xe6, 0x09, 0x60, 0xd7, 0x04, 0xd4,
+	0x4c, 0x4d, 0x40, 0x59, 0x8a, 0x54, 0x4e, 0x28, 0x32, 0xa9, 0xa3, 0x55, 0xbb, 0x2a, 0xe6, 0x3a,
+	0xdb, 0xa1, 0x00, 0x09, 0x7f, 0x1c, 0x1c, 0x6b, 0x09, 0x87, 0x9f, 0x95, 0x49, 0x78, 0x58, 0xca,
+	0x38, 0x89, 0xe3, 0x3d, 0x92, 0xb2, 0xa5, 0x8e, 0x6a, 0xc8, 0xbf, 0xad, 0x89, 0x76, 0x19, 0x5d,
+	0xde, 0xae, 0x71, 0xe6, 0x6a, 0xb6, 0xf3, 0x7b, 0x42, 0xe4, 0x48, 0x69, 0xdd, 0xfd, 0x3c, 0x9d,
+	0xb7, 0x4c, 0x10, 0xba, 0x29, 0x6e, 0x60, 0x69, 0xae, 0x18, 0x58, 0x7a, 0x49, 0x2f, 0x70, 0x9e,
+	0xee, 0xfa, 0x86, 0x0e, 0x0e, 0xa8, 0x25, 0x3d, 0xc0, 0x2e, 0xbd, 0xea, 0x3f, 0xac, 0x89, 0x15,
+	0x1b, 0x4e, 0x0c, 0xea, 0xe7, 0x8a, 0x12, 0x19, 0xa4, 0x9a, 0xf0, 0x2a, 0xb5, 0xf8, 0x67, 0x57,
+	0xcd, 0xae, 0xdc, 0xca, 0x75, 0x7d, 0x3f, 0x11, 0x86, 0xf9, 0xc9, 0xce, 0x0a, 0xa3, 0xa9, 0x09,
+	0x61, 0x98, 0x0e, 0x88, 0xab, 0x61, 0x8d, 0xaa, 0x61, 0xa9, 0xb5, 0x0e, 0xb9, 0x2b, 0x36, 0xee,
+	0xa8, 0x80, 0xb7, 0x5a, 0xef, 0xcc, 0xf3, 0xd3, 0x11, 0x08, 0x96, 0x45, 0x2b, 0x02, 0xa1, 0x4e,
+	0x0f, 0x7e, 0xc9, 0xbf, 0xaa, 0x89, 0x33, 0x76, 0xb4, 0x5d, 0xad, 0xb0, 0x4a, 0x61, 0xb9, 0x87,
+	0x50, 0x3f, 0xf9, 0x10, 0xa6, 0xa2, 0x7b, 0x16, 0x23, 0xe7, 0x5c, 0x46, 0xbe, 0x90, 0x1f, 0x4f,
+	0x39, 0x27, 0xf8, 0x6c, 0xfe, 0xb9, 0x2e, 0x3c, 0xe6, 0x81, 0x2a, 0x35, 0xf8, 0x5a, 0xcb, 0xb5,
+	0x2b, 0x3c, 0x1a, 0x6e, 0x85, 0x07, 0xb2, 0xe9, 0xc8, 0x04, 0x6a, 0x8e, 0x4e, 0xbb, 0xc0, 0xe2,
+	0xcb, 0xb2, 0xf0, 0x4b, 0xbd, 0x2c, 0x60, 0x93, 0xf0, 0xb3, 0x50, 0x28, 0x40, 0x69, 0x29, 0xf0,
+	0x43, 0x5e, 0x24, 0x8a, 0x5f, 0x2f, 0x0d, 0x30, 0x3d, 0xa3, 0x16, 0xb7, 0x54, 0x29, 0x7e, 0x0a,
+	0x4d, 0xc9, 0xd1, 0x5f, 0xc0, 0x33, 0xe5, 0x0a, 0x12, 0x5f, 0xc8, 0x2d, 0x31, 0x4f, 0xa1, 0x36,
+	0x7e, 0x10, 0x37, 0x4b, 0x32, 0x65, 0x7c, 0x53, 0x08, 0x0d, 0xbc, 0x9d, 0x06, 0xbc, 0x40, 0xc4,
+	0xd7, 0x93, 0xb0, 0x11, 0x09, 0x0c, 0x07, 0x74, 0x1a, 0xe1, 0xc4, 0x8a, 0x8f, 0xd8, 0xf4, 0x71,
+	0x76, 0x34, 0xa6, 0xbc, 0x22, 0x96, 0xcd, 0x26, 0x50, 0x1b, 0xa1, 0x53, 0xa8, 0xd2, 0x27, 0xf8,
+	0x53, 0xfe, 0xa4, 0x26, 0xd6, 0xef, 0x07, 0x4f, 0x94, 0xd9, 0x65, 0xe5, 0x68, 0xaa, 0x53, 0x9e,
+	0x14, 0x85, 0x45, 0x35, 0xa9, 0xb3, 0xf7, 0xdc, 0x2a, 0x26, 0x2a, 0x1b, 0x27, 0x27, 0x2a, 0xe7,
+	0xdc, 0x44, 0xa5, 0x7c, 0x9d, 0x02, 0x42, 0x7a, 0x1d, 0xb9, 0xaf, 0xcd, 0xd6, 0xa2, 0x29, 0x20,
+	0x58, 0x52, 0x80, 0x9d, 0x01, 0x58, 0x31, 0x2d, 0x77, 0xd9, 0x27, 0x62, 0x6f, 0x89, 0x95, 0x8f,
+	0xe3, 0x61, 0x6a, 0xe5, 0xb0, 0xe6, 0x46, 0xd0, 0xe6, 0x67, 0x42, 0xe8, 0x1c, 0x46, 0x3c, 0xec,
+	0x10, 0x5c, 0xfe, 0x4d, 0x4d, 0x34, 0xa0, 0x55, 0x90, 0xff, 0x5a, 0x51, 0xfe, 0xab, 0x54, 0x2d,
+	0x98, 0xfa, 0x60, 0xff, 0x59, 0x7a, 0x76, 0x21, 0x3b, 0xa2, 0x01, 0xe6, 0xe9, 0xe7, 0xc4, 0x2b,
+	0x35, 0xf2, 0x77, 0x68, 0xbe, 0xec, 0x1d, 0x5a, 0xb0, 0x82, 0x8f, 0xa0, 0x00, 0x92, 0xe0, 0x20,
+	0x3e, 0x34, 0xd5, 0x03, 0xba, 0x89, 0xb5, 0x43, 0x9f, 0x46, 0x61, 0x04, 0x72, 0x35, 0x1a, 0x15,
+	0xf8, 0x58, 0x15, 0x22, 0xfa, 0x11, 0x9c, 0x3e, 0xa6, 0x0a, 0x4f, 0x9b, 0x92, 0x00, 0x6b, 0x41,
+	0x65, 0x81, 0x0a, 0x8e, 0xb2, 0x02, 0xe6, 0x29, 0xe7, 0xa7, 0x78, 0xe0, 0xfe, 0x1d, 0x94, 0xa7,
+	0xb5, 0x04, 0x5e, 0xf0, 0x14, 0xa1, 0x5a, 0x09, 0x21, 0x57, 0x55, 0xd6, 0x8b, 0xaa, 0xb2, 0x6a,
+	0x1d, 0xee, 0x89, 0xce, 0x15, 0x4f, 0x14, 0x8c, 0x26, 0x45, 0x85, 0xd5, 0x86, 0x3a, 0x91, 0x26,
+	0xc3, 0x68, 0x66, 0xa3, 0xc9, 0x16, 0x4e, 0x56, 0xb5, 0x7f, 0x0c, 0x7b, 0x7b, 0x04, 0x2e, 0xf1,
+	0xde, 0xf1, 0xbd, 0xa3, 0x30, 0x3b, 0x05, 0x7f, 0x9d, 0xca, 0x98, 0x62, 0xfe, 0x5b, 0xeb, 0xfd,
+	0xc6, 0x8c, 0x07, 0x74, 0xee, 0x34, 0x0f, 0xa8, 0x0c, 0x85, 0x67, 0x2f, 0xed, 0x69, 0xf8, 0x6e,
+	0x25, 0x91, 0xeb, 0x15, 0x49, 0xe4, 0x86, 0x15, 0x75, 0x97, 0x9f, 0x52, 0x6e, 0xe5, 0x23, 0x0a,
+	0x69, 0x38, 0xcf, 0xee, 0xd7, 0x2a, 0x6d, 0x90, 0xdb, 0x62, 0xc3, 0x99, 0x93, 0xb7, 0xf0, 0x2a,
+	0xae, 0x2e, 0xeb, 0xef, 0x07, 0xfa, 0x6e, 0x6b, 0x53, 0x55, 0x21, 0xdf, 0xc1, 0xbe, 0x8e, 0x46,
+	0x91, 0x3f, 0xaf, 0x89, 0xa6, 0xd5, 0x61, 0x07, 0xc6, 0xe8, 0xf4, 0xd9, 0x64, 0x66, 0x18, 0x9d,
+	0xfe, 0x55, 0x21, 0x40, 0x73, 0x62, 0xde, 0x10, 0xe4, 0x81, 0x75, 0xa0, 0x05, 0xf1, 0x5e, 0x13,
+	0x0b, 0x74, 0x10, 0x69, 0xc1, 0xb9, 0x7b, 0xa4, 0x51, 0xd4, 0x7a, 0x19, 0x09, 0xd0, 0x17, 0x55,
+	0xb4, 0x27, 0xe5, 0x93, 0xdb, 0xc8, 0x4f, 0x0e, 0xae, 0xb5, 0x5a, 0x5c, 0x47, 0xe3, 0x80, 0x93,
+	0xb0, 0xea, 0x4e, 0x84, 0xd2, 0x18, 0xc1, 0xf9, 0xea, 0xed, 0x96, 0x48, 0x23, 0x75, 0xcb, 0xb1,
+	0x58, 0xb1, 0xa7, 0xac, 0x7c, 0xf1, 0x5f, 0x41, 0x38, 0x62, 0xf0, 0xab, 0xb4, 0xb1, 0x85, 0x15,
+	0xb6, 0xba, 0xe6, 0x92, 0xd7, 0xc3, 0x28, 0x74, 0x42, 0x4a, 0xcf, 0xf1, 0xab, 0x04, 0x3a, 0x57,
+	0x69, 0x3a, 0xa0, 0xf8, 0x3e, 0x5d, 0xed, 0x42, 0xc6, 0x11, 0xde, 0xa0, 0x24, 0xd8, 0x63, 0xc6,
+	0xe2, 0xcf, 0x2a, 0x1d, 0x2a, 0x7f, 0x9d, 0x8a, 0x0f, 0xcc, 0xf0, 0x13, 0x32, 0x48, 0x79, 0x5e,
+	0xb2, 0xee, 0xe4, 0x25, 0x6f, 0x89, 0xf5, 0x5d, 0x7c, 0x66, 0x3f, 0x09, 0xa3, 0xe0, 0xb4, 0x49,
+	0x86, 0x17, 0xc4, 0x8a, 0x42, 0x9f, 0xa1, 0x3b, 0x5f, 0x17, 0xe7, 0xb7, 0xe3, 0x83, 0x71, 0x89,
+	0x51, 0x5e, 0x35, 0xe2, 0x4b, 0xb1, 0x76, 0x37, 0xf4, 0x87, 0x51, 0x8c, 0x65, 0x79, 0xdb, 0xfb,
+	0x41, 0xff, 0x71, 0x69, 0x82, 0x06, 0x86, 0xe3, 0x72, 0x4c, 0xa5, 0x0b, 0xb7, 0xf0, 0xda, 0x71,
+	0xe4, 0x4e, 0xab, 0x00, 0x6e, 0x62, 0x4f, 0x30, 0xf2, 0xc7, 0xda, 0x45, 0x6d, 0x74, 0x74, 0x53,
+	0xfe, 0x50, 0x5c, 0x40, 0x11, 0xc8, 0xc9, 0x3a, 0x75, 0x4d, 0x79, 0x2e, 0xac, 0x56, 0xcc, 0x85,
+	0x55, 0x2d, 0x62, 0x4b, 0x2c, 0xf4, 0x71, 0xe5, 0x5a, 0xb8, 0x4d, 0x75, 0x81, 0xbb, 0xb1, 0x0e,
+	0x63, 0xc1, 0x23, 0x7d, 0x76, 0x37, 0x3c, 0x98, 0x8c, 0x28, 0x73, 0x1e, 0x27, 0x43, 0x2b, 0xf7,
+	0x39, 0x08, 0xc6, 0xd9, 0x3e, 0xcb, 0x9e, 0x6a, 0xa0, 0xa6, 0x28, 0x60, 0xe7, 0x86, 0x00, 0xd6,
+	0xf0, 0xd9, 0x8f, 0xf0, 0x12, 0x02, 0x3e, 0xe2, 0xba, 0x67, 0xd5, 0x69, 0x0b, 0x91, 0xa0, 0x6e,
+	0x25, 0x48, 0x3b, 0x62, 0xe3, 0x33, 0xbc, 0xdd, 0x9c, 0x5b, 0x9b, 0x6d, 0xf5, 0x43, 0xcf, 0x24,
+	0x7a, 0x82, 0x43, 0x74, 0x76, 0x9a, 0x9b, 0x18, 0xcf, 0x74, 0xa7, 0x9a, 0x71, 0xe6, 0xbf, 0x5f,
+	0x13, 0xab, 0x34, 0x20, 0x18, 0xdc, 0xb6, 0x54, 0x79, 0x25, 0xd9, 0xa7, 0x51, 0xac, 0x4e, 0xfc,
+	0x69, 0x4e, 0x07, 0x99, 0x54, 0xfc, 0x29, 0xbf, 0x53, 0xf3, 0xce, 0x9d, 0xfa, 0x96, 0xd8, 0x74,
+	0x97, 0x13, 0xa4, 0x56, 0xa1, 0x57, 0xc1, 0xec, 0xcb, 0x75, 0x97, 0x3b, 0xc6, 0x2e, 0x80, 0xdb,
+	0x17, 0xed, 0x4e, 0x30, 0x0c, 0xd3, 0x0c, 0x6b, 0x25, 0x39, 0x85, 0x79, 0x67, 0xe7, 0x54, 0x8e,
+	0xb1, 0xdf, 0x0b, 0xb5, 0x63, 0x0c, 0x3f, 0xf1, 0x62, 0x4e, 0xa2, 0x84, 0xe7, 0xe2, 0xf4, 0xbc,
+	0x05, 0x91, 0x6f, 0x89, 0x4b, 0xa5, 0x94, 0x66, 0x9c, 0xc0, 0x8e, 0xb8, 0x72, 0x17, 0x1e, 0xba,
+	0xc3, 0xe0, 0x6e, 0x30, 0xc6, 0x14, 0xb5, 0xb5, 0x6f, 0x13, 0xf3, 0x3a, 0x1a, 0x4f, 0x7a, 0xfa,
+	0x0e, 0xe2, 0xef, 0x8a, 0x40, 0xe0, 0x77, 0xc4, 0xaa, 0x3b, 0xc9, 0xc9, 0x45, 0x7e, 0xca, 0xce,
+	0xab, 0xdb, 0x76, 0x5e, 0x5b, 0x2c, 0x25, 0xe8, 0xb6, 0x1c, 0x9a, 0xb8, 0xb4, 0x69, 0x83, 0xf0,
+	0x5f, 0xad, 0x5a, 0xe8, 0xec, 0x03, 0x72, 0xc7, 0xd8, 0x07, 0xb4, 0xa3, 0x4a, 0xb8, 0x54, 0xff,
+	0x89, 0x9b, 0x2e, 0x3c, 0xc7, 0xf5, 0xe2, 0x73, 0x2c, 0xff, 0xa9, 0x26, 0x5a, 0x3c, 0xd1, 0x76,
+	0x12, 0x0c, 0xc2, 0xec, 0xa9, 0xf7, 0x5f, 0x96, 0xd0, 0xc7, 0x4a, 0x9a, 0x03, 0xcb, 0xa3, 0xe5,
+	0x96, 0x6d, 0x42, 0xcf, 0x3b, 0x26, 0xb4, 0x6b, 0xc0, 0x2d, 0x54, 0x9b, 0xe4, 0x8b, 0x8e, 0xe8,
+	0x7f, 0x45, 0xf5, 0x95, 0x39, 0x23, 0xbe, 0x06, 0x53, 0x41, 0x0d, 0x62, 0xfd, 0xe1, 0x20, 0x34,
+	0xdf, 0x01, 0x9c, 0x75, 0x87, 0x28, 0xf6, 0x74, 0x34, 0x92, 0xfc, 0xc7, 0x9a, 0xb8, 0x70, 0x27,
+	0x89, 0xfd, 0x41, 0x1f, 0xcc, 0x08, 0xf4, 0xeb, 0x26, 0x8e, 0xea, 0x48, 0x09, 0x62, 0x2a, 0x9e,
+	0xa8, 0x45, 0x09, 0xf4, 0x49, 0xef, 0x20, 0xcc, 0x74, 0xd1, 0x23, 0x28, 0x68, 0x03, 0xc0, 0xec,
+	0xce, 0x08, 0xe6, 0xea, 0xf6, 0xf4, 0xac, 0x3a, 0x27, 0x88, 0x50, 0x43, 0x0a, 0x2f, 0x95, 0xc1,
+	0x48, 0xd9, 0xe7, 0xb0, 0x20, 0x54, 0x3d, 0xa9, 0x78, 0x69, 0x6b, 0x8b, 0xa6, 0xe2, 0xa6, 0xe2,
+	0xdb, 0xdb, 0x14, 0x81, 0x62, 0x9f, 0xf4, 0x7e, 0x70, 0x94, 0xdd, 0x47, 0xe5, 0x33, 0x3b, 0x5d,
+	0xfd, 0x6d, 0xaa, 0x92, 0x99, 0x1e, 0x97, 0x87, 0xae, 0x94, 0x4a, 0xab, 0xd9, 0x2a, 0x0d, 0x0c,
+	0x50, 0xf8, 0x4b, 0xe6, 0x71, 0x5e, 0x8e, 0x08, 0x06, 0x28, 0x03, 0x69, 0x0a, 0xf9, 0xa7, 0x75,
+	0xb1, 0x79, 0x4f, 0x07, 0x28, 0x4f, 0x53, 0x61, 0x32, 0x23, 0x88, 0x51, 0x64, 0x42, 0x63, 0x8a,
+	0x09, 0x15, 0x6e, 0x5b, 0x7e, 0x74, 0x2a, 0xd6, 0xae, 0x8f, 0xce, 0x0e, 0x1a, 0x2f, 0xb8, 0x41,
+	0xe3, 0xb2, 0x12, 0x90, 0xc5, 0xf2, 0x12, 0x90, 0x3c, 0x96, 0xb9, 0x54, 0x1d, 0xcb, 0xc4, 0x95,
+	0x05, 0x49, 0x12, 0x27, 0x5c, 0xa2, 0xa2, 0x1a, 0xf2, 0x7f, 0xea, 0xe2, 0xcc, 0x83, 0xa9, 0x84,
+	0x05, 0x06, 0xcb, 0x55, 0xc0, 0x1b, 0xc3, 0x22, 0x79, 0x5e, 0x5c, 0xc5, 0xc0, 0x8f, 0x52, 0x94,
+	0x2a, 0x8d, 0xa0, 0xd2, 0x06, 0x7c, 0x7d, 0x5b, 0x63, 0x2b, 0x26, 0x9f, 0x7a, 0x3b, 0xf0, 0xe2,
+	0x1e, 0x75, 0x93, 0xe0, 0x8b, 0xa0, 0x9f, 0x91, 0x26, 0xc3, 0xe5, 0xdd, 0xd0, 0x86, 0x67, 0x91,
+	0xec, 0xd6, 0xc3, 0xa3, 0x0e, 0xa3, 0xde, 0x83, 0x1d, 0x1e, 0xc3, 0xdb, 0x6c, 0x00, 0x5e, 0x47,
+	0xe7, 0xb6, 0xcd, 0x6c, 0xca, 0x0a, 0x7e, 0xa5, 0x72, 0x36, 0xce, 0x0b, 0xd8, 0x13, 0xaa, 0x44,
+	0x93, 0x86, 0xb5, 0xdf, 0x17, 0x6b, 0x05, 0x92, 0x3a, 0x0e, 0x5b, 0xcb, 0xe3, 0xb0, 0x4e, 0x1d,
+	0xcc, 0x1c, 0x87, 0x4e, 0xbf, 0x51, 0x7f, 0xb7, 0xd6, 0x06, 0xbb, 0x73, 0x9a, 0xc6, 0xd3, 0xcc,
+	0x20, 0xbf, 0x2f, 0xce, 0xd1, 0x0c, 0x1f, 0x84, 0x11, 0xd8, 0xea, 0x56, 0xe5, 0x2c, 0x08, 0x46,
+	0x98, 0x76, 0xf7, 0x10, 0xcc, 0xcf, 0xd4, 0x62, 0x98, 0x12, 0x56, 0x65, 0x24, 0x81, 0xab, 0xfe,
+	0x1b, 0x55, 0x55, 0xff, 0x73, 0xc5, 0xaa, 0xff, 0xf7, 0xc4, 0xb9, 0xbb, 0x60, 0xfb, 0x1c, 0xdf,
+	0x86, 0x59, 0x8f, 0x95, 0xc9, 0x77, 0xea, 0x82, 0x58, 0xf9, 0xd7, 0x35, 0x21, 0x68, 0x34, 0xf1,
+	0x9c, 0x23, 0x10, 0x81, 0x55, 0x77, 0x46, 0xfa, 0xca, 0x92, 0x0d, 0x58, 0xa8, 0x6a, 0x39, 0xd6,
+	0x48, 0xc3, 0xb5, 0x46, 0x40, 0xe8, 0x31, 0x2e, 0x77, 0x18, 0x74, 0x73, 0x55, 0xab, 0xd6, 0xbd,
+	0xa6, 0xe0, 0xe6, 0xad, 0x73, 0xae, 0xce, 0xbc, 0x7b, 0x75, 0x70, 0xfd, 0x58, 0x70, 0xcc, 0xe1,
+	0x10, 0xfc, 0x2d, 0x7f, 0x4d, 0x9c, 0x2f, 0x6e, 0x96, 0x59, 0xfd, 0x3c, 0x2e, 0xfd, 0x58, 0xab,
+	0x74, 0x53, 0xa6, 0x64, 0xf6, 0xd6, 0xa1, 0x6e, 0xa9, 0x0f, 0x9b, 0xfd, 0x9a, 0xea, 0x3c, 0x58,
+	0xa5, 0x9b, 0x32, 0x11, 0x1b, 0xce, 0x0c, 0x4c, 0x3f, 0x77, 0xa3, 0x6a, 0xb3, 0xdd, 0xa8, 0xaa,
+	0xc3, 0xb7, 0xb9, 0xd1, 0x70, 0xb8, 0x21, 0x7f, 0x4b, 0xac, 0x7c, 0xa0, 0x3e, 0xa6, 0xa0, 0x38,
+	0x61, 0xa9, 0x2b, 0x71, 0x4d, 0x34, 0xc1, 0xf3, 0xeb, 0x27, 0xa0, 0x1f, 0xf3, 0x6a, 0x4e, 0x1b,
+	0x44, 0xae, 0x43, 0x84, 0x5f, 0xe9, 0x0c, 0xd8, 0xe2, 0xd2, 0x4d, 0x70, 0xaf, 0xd7, 0x79, 0xfe,
+	0x9c, 0xa7, 0x37, 0xad, 0x0f, 0x3a, 0x6a, 0x8e, 0xb3, 0x6a, 0x2f, 0x25, 0xff, 0xca, 0xe3, 0xd6,
+	0x7f, 0x5d, 0x17, 0xe2, 0xf6, 0x38, 0xdc, 0x0d, 0x92, 0x43, 0x4c, 0x54, 0x7e, 0x57, 0x34, 0xad,
+	0x0f, 0x79, 0x3c, 0x5d, 0xd0, 0x5c, 0xfc, 0xd6, 0xac, 0xdd, 0xd6, 0xf9, 0xd0, 0xe9, 0xaf, 0x7e,
+	0xe4, 0xc5, 0xdf, 0xf9, 0xd7, 0xff, 0xf8, 0x59, 0x7d, 0xc3, 0x3b, 0x73, 0xf3, 0xf0, 0x8d, 0x9b,
+	0xc0, 0x98, 0x04, 0xbf, 0x0a, 0xa5, 0xa8, 0x8f, 0xf7, 0x3d, 0xd1, 0x52, 0x23, 0x74, 0xd1, 0x49,
+	0x25, 0x01, 0x1d, 0x39, 0x9d, 0xfe, 0x3a, 0x46, 0x5e, 0xa2, 0xf9, 0xcf, 0x79, 0x1b, 0xf6, 0xfc,
+	0xba, 0x00, 0xf6, 0x33, 0xb1, 0xa4, 0x3f, 0xa7, 0xaa, 0x9e, 0x3c, 0xef, 0x70, 0x3f, 0xbc, 0x2a,
+	0x5b, 0x3a, 0xa0, 0x84, 0x38, 0xd9, 0x77, 0xc5, 0xb2, 0xa9, 0xfa, 0xf4, 0x9c, 0x8f, 0x1c, 0xad,
+	0x8a, 0xd1, 0xf6, 0xe6, 0x74, 0x07, 0x4f, 0x7d, 0x85, 0xa6, 0xbe, 0x20, 0x3d, 0x33, 0x35, 0xdd,
+	0xca, 0x01, 0xe0, 0x7c, 0xa3, 0xf6, 0xb2, 0xb7, 0x0f, 0xb7, 0xda, 0x94, 0x8a, 0x7a, 0x7a, 0x9a,
+	0xa9, 0xea, 0xd1, 0xf6, 0xd5, 0xaa, 0x8a, 0x4f, 0x26, 0x73, 0x95, 0xc8, 0x6c, 0xca, 0x9c, 0x39,
+	0x03, 0x33, 0x07, 0xd0, 0x79, 0xbd, 0x86, 0x1c, 0xd2, 0x9f, 0xd0, 0xcc, 0xe6, 0x50, 0xf1, 0x63,
+	0x9b, 0x12, 0x0e, 0x99, 0x2f, 0x4a, 0x12, 0xb1, 0x56, 0xf8, 0xaa, 0xc1, 0xbb, 0x92, 0x8b, 0x49,
+	0xc9, 0x17, 0x38, 0x66, 0x33, 0x15, 0x1f, 0x43, 0xc8, 0x6b, 0x44, 0xac, 0x2d, 0xcf, 0x4d, 0x11,
+	0x43, 0x34, 0x64, 0xdb, 0x9e, 0x58, 0xb1, 0x3f, 0xc9, 0xf1, 0x2c, 0xb9, 0x2c, 0x7e, 0xa7, 0x63,
+	0xce, 0x66, 0xea, 0x03, 0x9a, 0x12, 0x3a, 0x43, 0x6b, 0x3c, 0xd2, 0x39, 0x10, 0x6b, 0x85, 0xd2,
+	0x37, 0xaf, 0xba, 0xaa, 0x2e, 0x3f, 0xa4, 0xf2, 0x32, 0x69, 0xf9, 0x0c, 0xd1, 0xbb, 0x28, 0xcf,
+	0x1a, 0x7a, 0x56, 0x66, 0x04, 0xc9, 0x7d, 0x2e, 0xe6, 0xa8, 0xca, 0xf3, 0x6b, 0xd0, 0xd8, 0x24,
+	0x1a, 0x9e, 0x6c, 0x19, 0x1a, 0x58, 0xa5, 0x8a, 0x93, 0x7f, 0x25, 0xbc, 0xe9, 0x5a, 0x70, 0xef,
+	0x9a, 0x35, 0x5f, 0x69, 0x99, 0xf8, 0x4c, 0x8a, 0x92, 0x28, 0x5e, 0x96, 0x17, 0x0c, 0xc5, 0xc4,
+	0x7f, 0x52, 0xd8, 0x98, 0x2f, 0x56, 0xdd, 0x2a, 0x6e, 0xef, 0x72, 0x7e, 0x62, 0xd3, 0xc5, 0xdd,
+	0xed, 0x96, 0xa3, 0x93, 0x4b, 0x48, 0x0c, 0x9d, 0x61, 0x48, 0xe2, 0xa7, 0x35, 0x8a, 0x66, 0x4e,
+	0xe7, 0xa1, 0x3c, 0x99, 0x93, 0xaa, 0x2a, 0x0d, 0x6f, 0xcf, 0x4e, 0x63, 0xc9, 0x97, 0x68, 0x11,
+	0xcf, 0xca, 0xab, 0xf6, 0x22, 0xa6, 0xf1, 0x71, 0x2d, 0x5d, 0xb1, 0x6c, 0x2e, 0xaa, 0xb9, 0x6c,
+	0xc5, 0xef, 0xde, 0x73, 0xc1, 0x2c, 0x7e, 0x25, 0x5c, 0xa2, 0x34, 0x52, 0x8d, 0xa3, 0x2e, 0xf3,
+	0x13, 0x90, 0x4b, 0x57, 0x13, 0x98, 0x3b, 0x57, 0x5e, 0x13, 0x3e, 0x53, 0x81, 0x3c, 0x4b, 0x24,
+	0xaf, 0xc8, 0xcd, 0x69, 0x92, 0xb6, 0x16, 0xf9, 0x71, 0x8d, 0xbc, 0xd6, 0x42, 0xda, 0xdb, 0x48,
+	0x51, 0x65, 0x26, 0xde, 0x30, 0xb8, 0x3a, 0x67, 0x2e, 0x5f, 0xa0, 0x25, 0x5c, 0x93, 0x97, 0x6c,
+	0x06, 0x17, 0x90, 0x91, 0xbb, 0x31, 0x29, 0x1c, 0x3b, 0xcb, 0x67, 0xee, 0x7f, 0x49, 0x0e, 0xb9,
+	0x7d, 0xa9, 0xb4, 0xaf, 0x72, 0xdb, 0x43, 0x77, 0x6a, 0x24, 0x08, 0x6f, 0x80, 0x49, 0x81, 0xe5,
+	0xba, 0xb3, 0x90, 0x9c, 0x33, 0xc7, 0x39, 0x95, 0x2d, 0x2b, 0x39, 0xce, 0x48, 0xe3, 0xe0, 0xf4,
+	0x7d, 0xca, 0xf5, 0xa8, 0xb6, 0x4a, 0x15, 0x82, 0xf3, 0xa0, 0x9f, 0x6f, 0x87, 0xc4, 0x46, 0x9e,
+	0x0d, 0xcb, 0x4f, 0xee, 0x39, 0x9a, 0xfd, 0xaa, 0xbc, 0x68, 0x6f, 0xc1, 0x99, 0x4d, 0xed, 0xa1,
+	0x65, 0x88, 0xe0, 0xf0, 0xa7, 0xa1, 0x70, 0x9d, 0x28, 0x5c, 0x92, 0xe7, 0xa7, 0x29, 0x20, 0x1e,
+	0x4e, 0x3f, 0x12, 0x6b, 0x85, 0x1c, 0x57, 0x05, 0x01, 0x2d, 0x87, 0x15, 0x19, 0xb1, 0x92, 0x03,
+	0x99, 0xb8, 0x98, 0x7c, 0x20, 0x26, 0x35, 0x65, 0x0e, 0xa4, 0x98, 0x2f, 0x33, 0x07, 0x32, 0x95,
+	0xc5, 0x2a, 0x39, 0x90, 0xa1, 0xc6, 0x51, 0xda, 0x4a, 0xe4, 0x29, 0x18, 0xf3, 0x28, 0x4f, 0x25,
+	0x8c, 0x8c, 0xb1, 0x32, 0x9d, 0xaf, 0x29, 0x79, 0x8f, 0x0f, 0x0d, 0x12, 0x93, 0xc8, 0x43, 0xe8,
+	0x9e, 0xb5, 0x52, 0x37, 0x28, 0x6f, 0x48, 0x4c, 0xc7, 0xdb, 0x4b, 0x48, 0x0c, 0x0d, 0x12, 0x92,
+	0xf8, 0x0e, 0xd9, 0x74, 0xa6, 0x84, 0xee, 0x7c, 0xa1, 0x94, 0xad, 0xf8, 0xe4, 0x17, 0xeb, 0xa9,
+	0xe5, 0x65, 0x9a, 0xff, 0xbc, 0x77, 0xd6, 0x9e, 0xdf, 0x4c, 0xd7, 0x27, 0x8d, 0x6e, 0x95, 0x54,
+	0xcf, 0x36, 0x1a, 0x4b, 0xea, 0xaf, 0x4b, 0x88, 0xf4, 0xad, 0x29, 0xbf, 0x20, 0xa1, 0xcd, 0xcb,
+	0x4e, 0xbd, 0x4b, 0xd6, 0x3b, 0x5f, 0x2c, 0x5d, 0x35, 0xbc, 0x9a, 0x2e, 0x53, 0x2d, 0x97, 0xe0,
+	0x1c, 0x0f, 0xd9, 0xa5, 0xcc, 0x18, 0xbb, 0x9c, 0xd0, 0x36, 0x63, 0x4a, 0xea, 0x1c, 0x8d, 0x62,
+	0x29, 0x2b, 0x41, 0x2c, 0x57, 0x2c, 0x36, 0x66, 0x4e, 0xd3, 0x2e, 0xab, 0xb3, 0x69, 0x96, 0xd4,
+	0x01, 0x1a, 0x9a, 0x65, 0xa5, 0x78, 0xe5, 0x34, 0x6d, 0x4c, 0xa4, 0x19, 0x88, 0xa6, 0x55, 0xcc,
+	0x76, 0x92, 0xa9, 0xa1, 0xcf, 0xad, 0xa4, 0xf6, 0xad, 0xc4, 0x94, 0xb1, 0x8a, 0xd7, 0x90, 0x4c,
+	0x4f, 0x88, 0xbc, 0xf0, 0xed, 0x24, 0x2a, 0x17, 0xf3, 0xb4, 0x58, 0xa1, 0x4c, 0xae, 0x44, 0xc2,
+	0xc7, 0x06, 0x09, 0x69, 0x7c, 0x49, 0xec, 0x53, 0x85, 0x66, 0x6c, 0x56, 0x9c, 0xe6, 0xad, 0x3f,
+	0x67, 0x87, 0x6b, 0x66, 0x9c, 0x98, 0x3d, 0x39, 0x92, 0x8c, 0x48, 0xec, 0xad, 0xf4, 0xa6, 0x6d,
+	0xc8, 0x4c, 0x67, 0x52, 0x0d, 0x0f, 0x4b, 0x12, 0xa2, 0xe5, 0x56, 0x8d, 0x85, 0x88, 0xf4, 0x7e,
+	0xa4, 0xde, 0xdb, 0x42, 0x88, 0xf2, 0x54, 0xdb, 0xd4, 0x9a, 0xb6, 0x22, 0xbc, 0x59, 0xfe, 0xdc,
+	0x16, 0x90, 0x71, 0x09, 0xbf, 0xa7, 0xbe, 0x7f, 0x2f, 0xc6, 0x0b, 0xbd, 0xeb, 0x53, 0x56, 0x7c,
+	0x31, 0x06, 0xd9, 0x96, 0x27, 0xa1, 0xf0, 0x32, 0x5e, 0xa4, 0x65, 0x5c, 0x97, 0x97, 0x1d, 0x5d,
+	0x5c, 0xc0, 0xc6, 0x75, 0xfc, 0xae, 0x5a, 0x47, 0x31, 0xbe, 0x78, 0x2a, 0x5e, 0x3c, 0xa3, 0x8f,
+	0xbc, 0x22, 0x38, 0x59, 0xbe, 0x8a, 0x22, 0x36, 0xae, 0xe2, 0x7b, 0xe4, 0x79, 0x98, 0xe0, 0x57,
+	0xb5, 0xd6, 0xdb, 0xac, 0x8a, 0x93, 0xe9, 0xd7, 0xc7, 0x73, 0xdc, 0x8e, 0x7c, 0xc6, 0x8c, 0xcc,
+	0x01, 0x27, 0x4c, 0x35, 0xc3, 0x5a, 0xbe, 0x6c, 0x7b, 0x9f, 0xc5, 0xd0, 0x56, 0xb9, 0x7d, 0xe0,
+	0xa0, 0xe2, 0xbe, 0x9e, 0x50, 0x4a, 0xd8, 0x0d, 0xd9, 0x18, 0xb2, 0xa5, 0x61, 0xab, 0xf6, 0x95,
+	0x8a, 0x5e, 0xa6, 0xfb, 0x3c, 0xd1, 0x7d, 0x46, 0xb6, 0x6d, 0xba, 0x2e, 0x2e, 0x12, 0x7e, 0x9c,
+	0xbb, 0x06, 0x9c, 0xff, 0xbe, 0x68, 0x6f, 0xc7, 0x09, 0xff, 0x98, 0xeb, 0x54, 0x12, 0xd7, 0x39,
+	0xc1, 0x49, 0x50, 0x88, 0x40, 0xec, 0xd6, 0x2f, 0xce, 0x88, 0x95, 0xdb, 0x83, 0x83, 0x30, 0xd2,
+	0x81, 0x8f, 0xbe, 0x10, 0xf9, 0x97, 0x6b, 0x9e, 0x65, 0xc2, 0xb9, 0x1f, 0x7f, 0x59, 0x71, 0x89,
+	0xe2, 0x67, 0x6e, 0xae, 0x17, 0xe9, 0xe3, 0xe4, 0xda, 0x5d, 0x45, 0x33, 0x4f, 0x19, 0xac, 0x2d,
+	0xe7, 0x03, 0x34, 0xf3, 0x8c, 0x95, 0x7d, 0x04, 0x67, 0x4e, 0xb3, 0xf4, 0x9b, 0x35, 0x57, 0x4b,
+	0xb9, 0xd4, 0x26, 0x91, 0xd6, 0xf1, 0x43, 0xd1, 0xb4, 0x3e, 0x48, 0x33, 0x0c, 0x9d, 0xfe, 0xa8,
+	0xcd, 0x30, 0xb4, 0xe4, 0xfb, 0x35, 0xf7, 0xd1, 0x74, 0x49, 0xe5, 0x84, 0xd6, 0x0a, 0x9f, 0xb2,
+	0x9d, 0xca, 0x77, 0x2d, 0xff, 0xfa, 0x4d, 0x07, 0x19, 0xe4, 0x6a, 0x4e, 0x10, 0x3f, 0x4c, 0x44,
+	0x42, 0x7f, 0x56, 0x13, 0x57, 0x0a, 0x0e, 0xe8, 0x67, 0x61, 0xb6, 0x9f, 0x7f, 0x88, 0xe6, 0xbd,
+	0x58, 0xee, 0xa6, 0x4e, 0x7d, 0x2b, 0xd7, 0xbe, 0x31, 0x1b, 0x91, 0xd7, 0xb3, 0x45, 0xeb, 0xb9,
+	0x21, 0x9f, 0xcd, 0xd7, 0x93, 0x55, 0xd1, 0x57, 0x77, 0xc8, 0x9b, 0xfe, 0xef, 0x3e, 0xd5, 0x1a,
+	0xe2, 0xba, 0x15, 0x98, 0x28, 0xff, 0x8f, 0x40, 0xfa, 0x0e, 0x79, 0x57, 0x2c, 0x8e, 0x18, 0x6c,
+	0x0a, 0x52, 0x11, 0x89, 0xcf, 0xc9, 0x9a, 0xe4, 0xff, 0x06, 0x31, 0x3b, 0xb8, 0x36, 0xfd, 0x9f,
+	0x23, 0xdc, 0xf8, 0x8e, 0x22, 0xc4, 0xa5, 0x35, 0xde, 0xf7, 0x95, 0x66, 0x70, 0xfe, 0xf5, 0x83,
+	0xf7, 0x8c, 0x35, 0x55, 0xd9, 0xbf, 0x93, 0x68, 0x5f, 0xab, 0x46, 0xa8, 0x96, 0xe4, 0x81, 0x83,
+	0x89, 0x2c, 0x3d, 0x14, 0x6b, 0x85, 0xff, 0xbb, 0x65, 0x2c, 0xa4, 0xf2, 0x7f, 0xe4, 0x65, 0x84,
+	0xac, 0xe2, 0xdf, 0x75, 0xb9, 0xea, 0x50, 0x91, 0xed, 0xbb, 0xa8, 0x48, 0xf7, 0x37, 0xc1, 0x83,
+	0xd7, 0x05, 0x2a, 0xb9, 0x07, 0x5f, 0x28, 0x59, 0x31, 0xde, 0x92, 0x5d, 0x97, 0xe2, 0x5a, 0x2d,
+	0xe6, 0xcc, 0xd4, 0x40, 0x9c, 0xfa, 0xa1, 0x58, 0x02, 0x67, 0x76, 0xec, 0xcc, 0x3c, 0x75, 0x54,
+	0xa5, 0x33, 0xb7, 0x69, 0xe6, 0xb3, 0x9e, 0x67, 0xcf, 0xcc, 0x33, 0x1d, 0x88, 0x55, 0xb7, 0xea,
+	0xa5, 0x7a, 0x6e, 0xc3, 0xc0, 0xd2, 0x2a, 0x99, 0xb2, 0x73, 0xe9, 0x3b, 0x98, 0xca, 0xdf, 0x43,
+	0xb3, 0xa4, 0x50, 0xc2, 0x52, 0x4d, 0xf2, 0xaa, 0x15, 0x79, 0x2d, 0xa9, 0x79, 0x71, 0x9f, 0x44,
+	0x96, 0x05, 0x6b, 0xde, 0xcf, 0xc9, 0x95, 0xd1, 0x51, 0xef, 0xd9, 0xe1, 0xcb, 0x62, 0x7c, 0xbc,
+	0x8c, 0x73, 0xe6, 0x1f, 0x1e, 0x45, 0xa2, 0xe5, 0xd4, 0xb6, 0x18, 0xed, 0x5c, 0x56, 0x1f, 0x63,
+	0xb4, 0x73, 0x69, 0x39, 0x8c, 0xfb, 0x06, 0x69, 0x0d, 0x66, 0x21, 0x22, 0xeb, 0xbe, 0x10, 0x2b,
+	0x76, 0xa5, 0x8a, 0x89, 0x5d, 0x94, 0x54, 0xc2, 0x18, 0x73, 0xbf, 0xac, 0xb4, 0xa5, 0x4c, 0x3f,
+	0x3f, 0xb1, 0xf0, 0x90, 0x56, 0x4a, 0x26, 0x53, 0xb1, 0xb0, 0xa4, 0x9a, 0x81, 0xcf, 0x94, 0x96,
+	0x95, 0x58, 0x8c, 0xe4, 0x0d, 0x7a, 0xed, 0x02, 0x4d, 0x7b, 0xf6, 0x9f, 0x80, 0xa1, 0x56, 0x52,
+	0x10, 0x62, 0x0c, 0xc6, 0xea, 0xb2, 0x14, 0x63, 0x30, 0x9e, 0x50, 0x4f, 0x22, 0x6f, 0xd0, 0x12,
+	0xa4, 0xb4, 0x74, 0x62, 0x32, 0x8d, 0x8e, 0xbb, 0xff, 0x83, 0x9a, 0x38, 0x5f, 0x5e, 0xb9, 0xe1,
+	0x3d, 0x67, 0xca, 0x02, 0x4e, 0xa8, 0x40, 0x69, 0x3f, 0x3f, 0x03, 0x8b, 0x57, 0xf4, 0x0a, 0xad,
+	0xe8, 0x79, 0x79, 0xcd, 0xd6, 0x64, 0x65, 0x23, 0x54, 0xb4, 0xa7, 0x69, 0x55, 0x3b, 0x78, 0xb6,
+	0x4e, 0x76, 0x4b, 0x41, 0xec, 0x64, 0x4b, 0xb1, 0x38, 0xc2, 0x8d, 0x60, 0x68, 0x92, 0x0a, 0x07,
+	0x88, 0xf4, 0x16, 0xe8, 0xff, 0x6c, 0xbd, 0xf9, 0x7f, 0x90, 0xee, 0x8a, 0x4b, 0xd8, 0x53, 0x00,
+	0x00,
 }
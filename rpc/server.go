@@ -22,8 +22,12 @@ import (
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/feature"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/selfcheck"
+	"github.com/nebulasio/go-nebulas/storage"
+	"golang.org/x/net/context"
 )
 
 // Neblet interface breaks cycle import dependency and hides unused services.
@@ -34,6 +38,13 @@ type Neblet interface {
 	NetManager() p2p.Manager
 	EventEmitter() *core.EventEmitter
 	Consensus() consensus.Consensus
+	CompactionScheduler() *storage.CompactionScheduler
+	// Diagnostics returns the report of the self-check run on boot.
+	Diagnostics() *selfcheck.Report
+	// Features returns the experimental features enabled on the node.
+	Features() *feature.Flags
+	// Chain returns the neblet of the chain hosted in the process.
+	Chain(chainID uint32) (Neblet, bool)
 }
 
 // Server server interface for api & management etc.
@@ -47,5 +58,14 @@ type Server interface {
 	// Neblet return neblet
 	Neblet() Neblet
 
+	// Chain return the neblet of the chain selected by the request.
+	Chain(ctx context.Context) Neblet
+
 	RunGateway() error
+
+	// Pause report the server not serving to health checks.
+	Pause()
+
+	// Resume report the server serving to health checks.
+	Resume()
 }
@@ -0,0 +1,254 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/audit"
+	"github.com/nebulasio/go-nebulas/util/errcode"
+	metrics "github.com/rcrowley/go-metrics"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	apiServicePrefix = "/rpcpb.ApiService/"
+	// healthServicePrefix is the health service open to load balancers without api key.
+	healthServicePrefix = "/grpc.health.v1.Health/"
+	// APIKeyKey is the metadata carrying the tenant api key, the gateway
+	// forwards it from "Grpc-Metadata-Neb-Api-Key" header.
+	APIKeyKey = "neb-api-key"
+	// authorizationKey is the metadata of "Authorization: Bearer <key>" header.
+	authorizationKey = "authorization"
+	bearerPrefix     = "bearer "
+)
+
+// Tenant errors
+var (
+	ErrInvalidAPIKey      = errcode.New(errcode.ModuleRPC, 3005, "missing or invalid api key", false)
+	ErrMethodNotPermitted = errcode.New(errcode.ModuleRPC, 3006, "method not permitted for tenant", false)
+	ErrTenantRateLimited  = errcode.New(errcode.ModuleRPC, 3007, "tenant rate limit exceeded", true)
+
+	ErrInvalidTenantConfig = errors.New("invalid tenant config")
+	ErrDuplicateAPIKey     = errors.New("duplicate tenant api key")
+)
+
+type tenantKey struct{}
+
+// tenant is a customer sharing the rpc with its own quota and permissions.
+type tenant struct {
+	name    string
+	methods []string
+	limiter *rateLimiter
+
+	requests  metrics.Meter
+	denied    metrics.Counter
+	throttled metrics.Counter
+	failed    metrics.Counter
+	latency   metrics.Timer
+}
+
+func newTenant(conf *nebletpb.TenantConfig) *tenant {
+	prefix := "neb.rpc.tenant." + conf.Name
+	t := &tenant{
+		name:      conf.Name,
+		methods:   conf.Methods,
+		requests:  metrics.GetOrRegisterMeter(prefix+".requests", nil),
+		denied:    metrics.GetOrRegisterCounter(prefix+".denied", nil),
+		throttled: metrics.GetOrRegisterCounter(prefix+".throttled", nil),
+		failed:    metrics.GetOrRegisterCounter(prefix+".failed", nil),
+		latency:   metrics.GetOrRegisterTimer(prefix+".latency", nil),
+	}
+	if conf.RateLimit > 0 {
+		burst := float64(conf.Burst)
+		if burst < 1 {
+			burst = math.Ceil(conf.RateLimit)
+		}
+		t.limiter = newRateLimiter(conf.RateLimit, burst)
+	}
+	return t
+}
+
+// permitted returns whether the tenant may call the method, tenants without
+// method list can only call the ApiService.
+func (t *tenant) permitted(method string) bool {
+	if len(t.methods) == 0 {
+		return strings.HasPrefix(method, apiServicePrefix)
+	}
+	for _, m := range t.methods {
+		if m == method || (strings.HasSuffix(m, "/") && strings.HasPrefix(method, m)) {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantRegistry authenticates requests by api key when tenants are configured.
+type tenantRegistry struct {
+	tenants map[string]*tenant
+}
+
+func newTenantRegistry(confs []*nebletpb.TenantConfig) (*tenantRegistry, error) {
+	r := &tenantRegistry{tenants: make(map[string]*tenant)}
+	for _, conf := range confs {
+		if len(conf.Name) == 0 || len(conf.ApiKey) == 0 || conf.RateLimit < 0 {
+			return nil, ErrInvalidTenantConfig
+		}
+		if _, ok := r.tenants[conf.ApiKey]; ok {
+			return nil, ErrDuplicateAPIKey
+		}
+		r.tenants[conf.ApiKey] = newTenant(conf)
+	}
+	return r, nil
+}
+
+// authorize returns the tenant of the request, an error for unknown keys,
+// unpermitted methods and exhausted quotas. The unknown keys and unpermitted
+// methods are recorded in the audit log, the throttled requests only counted.
+func (r *tenantRegistry) authorize(ctx context.Context, method string) (*tenant, error) {
+	t, ok := r.tenants[apiKeyFromContext(ctx)]
+	if !ok {
+		audit.Record(audit.ActionRPCDenied, requestOrigin(ctx), method, "", ErrInvalidAPIKey)
+		return nil, ErrInvalidAPIKey
+	}
+	t.requests.Mark(1)
+	if !t.permitted(method) {
+		t.denied.Inc(1)
+		audit.Record(audit.ActionRPCDenied, requestOrigin(ctx), method, t.name, ErrMethodNotPermitted)
+		return nil, ErrMethodNotPermitted
+	}
+	if t.limiter != nil && !t.limiter.allow(time.Now()) {
+		t.throttled.Inc(1)
+		return nil, ErrTenantRateLimited
+	}
+	return t, nil
+}
+
+// interceptor rejects requests of unknown keys, unpermitted methods and
+// exhausted quotas, and accounts the usage of each tenant.
+func (r *tenantRegistry) interceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if len(r.tenants) == 0 || strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(ctx, req)
+	}
+
+	t, err := r.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := handler(context.WithValue(ctx, tenantKey{}, t.name), req)
+	t.latency.UpdateSince(start)
+	if err != nil {
+		t.failed.Inc(1)
+	}
+	return resp, err
+}
+
+// streamInterceptor authorizes the streams as interceptor does the requests,
+// a stream counts once against the quota of its tenant.
+func (r *tenantRegistry) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if len(r.tenants) == 0 || strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(srv, ss)
+	}
+
+	t, err := r.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = handler(srv, &tenantServerStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), tenantKey{}, t.name)})
+	t.latency.UpdateSince(start)
+	if err != nil {
+		t.failed.Inc(1)
+	}
+	return err
+}
+
+// tenantServerStream is a stream whose context carries its tenant.
+type tenantServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// apiKeyFromContext returns the api key of the request, empty if absent.
+func apiKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md[APIKeyKey]; len(v) > 0 {
+		return v[0]
+	}
+	if v := md[authorizationKey]; len(v) > 0 && strings.HasPrefix(strings.ToLower(v[0]), bearerPrefix) {
+		return strings.TrimSpace(v[0][len(bearerPrefix):])
+	}
+	return ""
+}
+
+// tenantFromContext returns the tenant name of the request, empty if tenants are not enabled.
+func tenantFromContext(ctx context.Context) string {
+	if name, ok := ctx.Value(tenantKey{}).(string); ok {
+		return name
+	}
+	return ""
+}
+
+// rateLimiter is a token bucket refilled at rate tokens per second.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate, burst float64) *rateLimiter {
+	return &rateLimiter{rate: rate, burst: burst, tokens: burst}
+}
+
+func (l *rateLimiter) allow(now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTenantRegistry(t *testing.T) {
+	_, err := newTenantRegistry([]*nebletpb.TenantConfig{{Name: "a"}})
+	assert.Equal(t, ErrInvalidTenantConfig, err)
+	_, err = newTenantRegistry([]*nebletpb.TenantConfig{{Name: "a", ApiKey: "k"}, {Name: "b", ApiKey: "k"}})
+	assert.Equal(t, ErrDuplicateAPIKey, err)
+
+	r, err := newTenantRegistry([]*nebletpb.TenantConfig{
+		{Name: "alice", ApiKey: "ka", RateLimit: 1, Burst: 2},
+		{Name: "bob", ApiKey: "kb", Methods: []string{"/rpcpb.ApiService/GetNebState", "/rpcpb.AdminService/"}},
+	})
+	assert.Nil(t, err)
+
+	var tenantName string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		tenantName = tenantFromContext(ctx)
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string) error {
+		_, err := r.interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	state := "/rpcpb.ApiService/GetNebState"
+
+	assert.Equal(t, ErrInvalidAPIKey, call(context.Background(), state))
+	assert.Equal(t, ErrInvalidAPIKey, call(metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyKey, "kx")), state))
+
+	alice := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer ka"))
+	assert.Nil(t, call(alice, state))
+	assert.Equal(t, "alice", tenantName)
+	assert.Equal(t, ErrMethodNotPermitted, call(alice, "/rpcpb.AdminService/Accounts"))
+	assert.Nil(t, call(alice, state))
+	assert.Equal(t, ErrTenantRateLimited, call(alice, state))
+
+	bob := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyKey, "kb"))
+	assert.Nil(t, call(bob, state))
+	assert.Equal(t, "bob", tenantName)
+	assert.Nil(t, call(bob, "/rpcpb.AdminService/Accounts"))
+	assert.Equal(t, ErrMethodNotPermitted, call(bob, "/rpcpb.ApiService/Call"))
+
+	assert.Nil(t, call(context.Background(), "/grpc.health.v1.Health/Check"))
+
+	empty, _ := newTenantRegistry(nil)
+	_, err = empty.interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: state}, handler)
+	assert.Nil(t, err)
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestTenantRegistry_Stream(t *testing.T) {
+	r, err := newTenantRegistry([]*nebletpb.TenantConfig{
+		{Name: "bob", ApiKey: "kb", Methods: []string{"/rpcpb.ApiService/Subscribe"}},
+	})
+	assert.Nil(t, err)
+
+	var tenantName string
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		tenantName = tenantFromContext(ss.Context())
+		return nil
+	}
+	stream := func(ctx context.Context, method string) error {
+		return r.streamInterceptor(nil, &mockServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method}, handler)
+	}
+	subscribe := "/rpcpb.ApiService/Subscribe"
+
+	assert.Equal(t, ErrInvalidAPIKey, stream(context.Background(), subscribe))
+	bob := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyKey, "kb"))
+	assert.Nil(t, stream(bob, subscribe))
+	assert.Equal(t, "bob", tenantName)
+	assert.Equal(t, ErrMethodNotPermitted, stream(bob, "/rpcpb.AdminService/Subscribe"))
+}
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(2, 2)
+	now := time.Unix(0, 0)
+	assert.True(t, l.allow(now))
+	assert.True(t, l.allow(now))
+	assert.False(t, l.allow(now))
+	assert.True(t, l.allow(now.Add(500*time.Millisecond)))
+	assert.False(t, l.allow(now.Add(500*time.Millisecond)))
+	assert.True(t, l.allow(now.Add(10*time.Second)))
+	assert.True(t, l.allow(now.Add(10*time.Second)))
+	assert.False(t, l.allow(now.Add(10*time.Second)))
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/nebulasio/go-nebulas/util/tracing"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TraceIDKey is the response header carrying the trace id of the request,
+// the gateway forwards it as "Grpc-Metadata-Neb-Trace-Id" header.
+const TraceIDKey = "neb-trace-id"
+
+// metadataCarrier adapts grpc metadata to the propagation carrier,
+// gateway clients pass "Grpc-Metadata-Traceparent" header to join a trace.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := c[strings.ToLower(key)]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	c[strings.ToLower(key)] = []string{value}
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// tracingInterceptor starts a span for each request, joining the trace propagated by client.
+func tracingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = tracing.Extract(ctx, metadataCarrier(md))
+	}
+	ctx, span := tracing.Start(ctx, info.FullMethod)
+
+	if traceID := tracing.TraceID(ctx); len(traceID) > 0 {
+		if err := grpc.SetHeader(ctx, metadata.Pairs(TraceIDKey, traceID)); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"method": info.FullMethod,
+				"err":    err,
+			}).Debug("Failed to set trace id header.")
+		}
+	}
+
+	resp, err := handler(ctx, req)
+	tracing.End(span, err)
+	return resp, err
+}
+
+// chainUnaryInterceptors chain the interceptors, the first one is the outermost.
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+// chainStreamInterceptors chain the stream interceptors, the first one is the outermost.
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return chained(srv, ss)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestChainUnaryInterceptors(t *testing.T) {
+	var order []string
+	interceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			order = append(order, name)
+			return handler(ctx, req)
+		}
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		order = append(order, "handler")
+		return req, nil
+	}
+
+	chained := chainUnaryInterceptors(interceptor("first"), interceptor("second"))
+	resp, err := chained(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "req", resp)
+	assert.Equal(t, []string{"first", "second", "handler"}, order)
+}
+
+func TestMetadataCarrier(t *testing.T) {
+	carrier := metadataCarrier(metadata.Pairs("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", carrier.Get("Traceparent"))
+	carrier.Set("Tracestate", "neb=1")
+	assert.Equal(t, "neb=1", carrier.Get("tracestate"))
+	assert.Len(t, carrier.Keys(), 2)
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"golang.org/x/net/netutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// grpcServerOptions returns the transport options of the rpc server.
+func grpcServerOptions(conf *nebletpb.GrpcTransportConfig) []grpc.ServerOption {
+	if conf == nil {
+		return nil
+	}
+
+	var opts []grpc.ServerOption
+	if conf.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(msgSize(conf.MaxRecvMsgSize)))
+	}
+	if conf.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(msgSize(conf.MaxSendMsgSize)))
+	}
+	// zero values are replaced with the defaults by grpc.
+	opts = append(opts, grpc.KeepaliveParams(keepalive.ServerParameters{
+		MaxConnectionIdle: millis(conf.MaxConnectionIdleMs),
+		Time:              millis(conf.KeepaliveTimeMs),
+		Timeout:           millis(conf.KeepaliveTimeoutMs),
+	}))
+	opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             millis(conf.KeepaliveMinTimeMs),
+		PermitWithoutStream: conf.KeepalivePermitWithoutStream,
+	}))
+	return opts
+}
+
+// gatewayDialOptions returns the options of the gateway connecting the rpc
+// server, the gateway receives the responses as large as the server sends and
+// proves its requests with the gateway token.
+func gatewayDialOptions(conf *nebletpb.GrpcTransportConfig) []grpc.DialOption {
+	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithUnaryInterceptor(gatewayInterceptor)}
+	if conf != nil && conf.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(msgSize(conf.MaxSendMsgSize))))
+	}
+	return opts
+}
+
+// newHTTPServer returns the http server of the gateway listening at addr.
+func newHTTPServer(addr string, handler http.Handler, conf *nebletpb.HttpTransportConfig) *http.Server {
+	srv := &http.Server{Addr: addr, Handler: handler}
+	if conf != nil {
+		srv.ReadTimeout = millis(conf.ReadTimeoutMs)
+		srv.ReadHeaderTimeout = millis(conf.ReadHeaderTimeoutMs)
+		srv.WriteTimeout = millis(conf.WriteTimeoutMs)
+		srv.IdleTimeout = millis(conf.IdleTimeoutMs)
+		srv.MaxHeaderBytes = int(conf.MaxHeaderBytes)
+	}
+	return srv
+}
+
+// listen listens to addr, accepting maxConns connections at the same time
+// at most if it's not 0.
+func listen(addr string, maxConns uint32) (net.Listener, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if maxConns > 0 {
+		listener = netutil.LimitListener(listener, int(maxConns))
+	}
+	return listener, nil
+}
+
+func millis(ms uint32) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+func msgSize(size uint32) int {
+	if size > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int(size)
+}
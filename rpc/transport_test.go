@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListen_MaxConnections(t *testing.T) {
+	listener, err := listen("127.0.0.1:0", 1)
+	assert.Nil(t, err)
+	defer listener.Close()
+
+	accepted := make(chan net.Conn, 2)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			accepted <- conn
+		}
+	}()
+
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		assert.Nil(t, err)
+		defer conn.Close()
+	}
+
+	first := <-accepted
+	select {
+	case <-accepted:
+		t.Fatal("accepted more connections than the limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the waiting connection is accepted once the first is closed.
+	first.Close()
+	select {
+	case conn := <-accepted:
+		conn.Close()
+	case <-time.After(time.Second):
+		t.Fatal("waiting connection not accepted")
+	}
+}
+
+func TestNewHTTPServer(t *testing.T) {
+	srv := newHTTPServer(":8685", nil, nil)
+	assert.Equal(t, time.Duration(0), srv.WriteTimeout)
+	assert.Equal(t, 0, srv.MaxHeaderBytes)
+
+	srv = newHTTPServer(":8685", nil, &nebletpb.HttpTransportConfig{
+		ReadTimeoutMs:  30000,
+		WriteTimeoutMs: 60000,
+		IdleTimeoutMs:  120000,
+		MaxHeaderBytes: 8192,
+	})
+	assert.Equal(t, 30*time.Second, srv.ReadTimeout)
+	assert.Equal(t, time.Duration(0), srv.ReadHeaderTimeout)
+	assert.Equal(t, time.Minute, srv.WriteTimeout)
+	assert.Equal(t, 2*time.Minute, srv.IdleTimeout)
+	assert.Equal(t, 8192, srv.MaxHeaderBytes)
+}
+
+func TestGrpcServerOptions(t *testing.T) {
+	assert.Nil(t, grpcServerOptions(nil))
+	assert.Equal(t, 2, len(grpcServerOptions(&nebletpb.GrpcTransportConfig{})))
+	assert.Equal(t, 4, len(grpcServerOptions(&nebletpb.GrpcTransportConfig{MaxRecvMsgSize: 1 << 24, MaxSendMsgSize: 1 << 24})))
+	assert.Equal(t, 2, len(gatewayDialOptions(nil)))
+	assert.Equal(t, 3, len(gatewayDialOptions(&nebletpb.GrpcTransportConfig{MaxSendMsgSize: 1 << 24})))
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package selfcheck
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Names of the checks run on boot.
+const (
+	CheckStorage  = "storage writable"
+	CheckKeystore = "keystore readable"
+	CheckClock    = "clock sane"
+	CheckPorts    = "ports bindable"
+	CheckGenesis  = "genesis matches config"
+	CheckChain    = "chain head consistent"
+)
+
+// MaxClockDrift is the most the local clock may be behind the tail block.
+const MaxClockDrift = 2 * time.Second
+
+var (
+	// ErrClockBehind the local clock is before the timestamp of the tail block.
+	ErrClockBehind = errors.New("local clock is behind the tail block")
+
+	storageCheckKey = []byte("selfcheck")
+)
+
+// Check is the result of a check.
+type Check struct {
+	Name    string
+	Passed  bool
+	Message string
+	Elapsed time.Duration
+}
+
+// Report is the result of the checks run on boot.
+type Report struct {
+	mu     sync.RWMutex
+	time   time.Time
+	checks []*Check
+}
+
+// NewReport returns an empty report.
+func NewReport() *Report {
+	return &Report{time: time.Now()}
+}
+
+// Run runs the check and records its result.
+func (r *Report) Run(name string, check func() error) error {
+	start := time.Now()
+	err := check()
+	c := &Check{Name: name, Passed: err == nil, Elapsed: time.Since(start)}
+	if err != nil {
+		c.Message = err.Error()
+	}
+
+	r.mu.Lock()
+	r.checks = append(r.checks, c)
+	r.mu.Unlock()
+	return err
+}
+
+// Time returns when the checks started.
+func (r *Report) Time() time.Time {
+	return r.time
+}
+
+// Checks returns the results of the checks in the order they ran.
+func (r *Report) Checks() []*Check {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	checks := make([]*Check, len(r.checks))
+	copy(checks, r.checks)
+	return checks
+}
+
+// Passed returns whether all the checks passed.
+func (r *Report) Passed() bool {
+	for _, c := range r.Checks() {
+		if !c.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Log logs the report, each failed check as an error.
+func (r *Report) Log() {
+	for _, c := range r.Checks() {
+		fields := logrus.Fields{
+			"check":   c.Name,
+			"elapsed": c.Elapsed,
+		}
+		if c.Passed {
+			logging.CLog().WithFields(fields).Info("Self-check passed.")
+			continue
+		}
+		fields["err"] = c.Message
+		logging.CLog().WithFields(fields).Error("Self-check failed.")
+	}
+}
+
+// StorageWritable writes, reads back and deletes a key.
+func StorageWritable(stor storage.Storage) error {
+	value := []byte(time.Now().String())
+	if err := stor.Put(storageCheckKey, value); err != nil {
+		return err
+	}
+	read, err := stor.Get(storageCheckKey)
+	if err != nil {
+		return err
+	}
+	if string(read) != string(value) {
+		return errors.New("storage returned a different value")
+	}
+	return stor.Del(storageCheckKey)
+}
+
+// DirReadable checks the files of the dir can be listed and read, a missing
+// dir is fine as it's created on the first key.
+func DirReadable(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		file, err := os.Open(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return err
+		}
+		file.Close()
+	}
+	return nil
+}
+
+// ClockSane checks the local clock is not behind the timestamp of the tail block.
+func ClockSane(tailTimestamp int64) error {
+	if drift := time.Unix(tailTimestamp, 0).Sub(time.Now()); drift > MaxClockDrift {
+		return fmt.Errorf("%s by %s", ErrClockBehind, drift)
+	}
+	return nil
+}
+
+// PortsBindable listens on each address and closes it at once.
+func PortsBindable(addrs ...string) error {
+	for _, addr := range addrs {
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			return err
+		}
+		l.Close()
+	}
+	return nil
+}
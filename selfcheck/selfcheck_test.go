@@ -0,0 +1,70 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package selfcheck
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	r := NewReport()
+	assert.True(t, r.Passed())
+	assert.Nil(t, r.Run(CheckStorage, func() error { return nil }))
+	assert.True(t, r.Passed())
+	assert.NotNil(t, r.Run(CheckClock, func() error { return errors.New("late") }))
+	assert.False(t, r.Passed())
+
+	checks := r.Checks()
+	assert.Equal(t, 2, len(checks))
+	assert.Equal(t, CheckStorage, checks[0].Name)
+	assert.True(t, checks[0].Passed)
+	assert.Equal(t, CheckClock, checks[1].Name)
+	assert.Equal(t, "late", checks[1].Message)
+}
+
+func TestChecks(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	assert.Nil(t, StorageWritable(stor))
+	_, err := stor.Get(storageCheckKey)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	dir, _ := ioutil.TempDir("", "selfcheck")
+	defer os.RemoveAll(dir)
+	assert.Nil(t, DirReadable(filepath.Join(dir, "missing")))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "key"), []byte("{}"), 0600))
+	assert.Nil(t, DirReadable(dir))
+
+	assert.Nil(t, ClockSane(time.Now().Unix()))
+	assert.NotNil(t, ClockSane(time.Now().Add(time.Minute).Unix()))
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer l.Close()
+	assert.Nil(t, PortsBindable("127.0.0.1:0"))
+	assert.NotNil(t, PortsBindable(l.Addr().String()))
+}
@@ -0,0 +1,345 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/sha3"
+)
+
+// Errors
+var (
+	ErrBackupNotFound      = errors.New("backup not found")
+	ErrBackupCorrupted     = errors.New("backup file is missing or corrupted")
+	ErrRestoreTargetExists = errors.New("restore target directory is not empty")
+)
+
+const (
+	backupManifestsDir = "manifests"
+	backupFilesDir     = "files"
+)
+
+// BackupFile is a file of levelDB in a backup, stored under its content hash.
+type BackupFile struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+	Hash string `json:"hash"`
+}
+
+// BackupManifest lists the files of levelDB making up a backup.
+type BackupManifest struct {
+	ID      string        `json:"id"`
+	Parent  string        `json:"parent,omitempty"`
+	Created int64         `json:"created"`
+	Files   []*BackupFile `json:"files"`
+
+	// Copied is the number of bytes copied by the backup, the files already
+	// in the backup directory are not copied again.
+	Copied int64 `json:"copied"`
+}
+
+// Size return the total size of the files in the backup.
+func (m *BackupManifest) Size() int64 {
+	var size int64
+	for _, f := range m.Files {
+		size += f.Size
+	}
+	return size
+}
+
+// Digest return the hex sha3 hash of the files of the backup, sorted by name,
+// binding their names, sizes and hashes.
+func (m *BackupManifest) Digest() string {
+	files := make([]*BackupFile, len(m.Files))
+	copy(files, m.Files)
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	hasher := sha3.New256()
+	for _, f := range files {
+		io.WriteString(hasher, f.Name+"\x00"+strconv.FormatInt(f.Size, 10)+"\x00"+f.Hash+"\n")
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// Backup copies the files of the levelDB at dbPath into the backup directory, the
+// node using the levelDB must be stopped. The files are stored by content hash, so
+// the ones shared with former backups are kept once. When incremental, the tables
+// listed in the latest manifest with the same size are neither read nor copied again,
+// since levelDB never modifies a table once written.
+func Backup(dbPath, backupDir string, incremental bool) (*BackupManifest, error) {
+	// opening the levelDB fails if it is in use, and flushes its journal into tables.
+	db, err := NewDiskStorage(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, dir := range []string{backupManifestsDir, backupFilesDir} {
+		if err := os.MkdirAll(filepath.Join(backupDir, dir), 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	var last *BackupManifest
+	if incremental {
+		if last, err = LoadBackupManifest(backupDir, ""); err != nil && err != ErrBackupNotFound {
+			return nil, err
+		}
+	}
+	known := make(map[string]*BackupFile)
+	if last != nil {
+		for _, f := range last.Files {
+			if isTableFile(f.Name) {
+				known[f.Name] = f
+			}
+		}
+	}
+
+	infos, err := ioutil.ReadDir(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	manifest := &BackupManifest{
+		ID:      strconv.FormatInt(now.UnixNano(), 10),
+		Created: now.Unix(),
+	}
+	if last != nil {
+		manifest.Parent = last.ID
+	}
+	for _, info := range infos {
+		if !info.Mode().IsRegular() || isVolatileFile(info.Name()) {
+			continue
+		}
+		if f, ok := known[info.Name()]; ok && f.Size == info.Size() {
+			manifest.Files = append(manifest.Files, f)
+			continue
+		}
+		f, copied, err := backupFile(filepath.Join(dbPath, info.Name()), backupDir)
+		if err != nil {
+			return nil, err
+		}
+		f.Name = info.Name()
+		manifest.Files = append(manifest.Files, f)
+		manifest.Copied += copied
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(backupDir, backupManifestsDir, manifest.ID+".json"), data, 0600); err != nil {
+		return nil, err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"id":          manifest.ID,
+		"parent":      manifest.Parent,
+		"files":       len(manifest.Files),
+		"size":        manifest.Size(),
+		"copied":      manifest.Copied,
+		"incremental": incremental,
+	}).Info("Backed up storage.")
+	return manifest, nil
+}
+
+// ListBackups return the manifests in the backup directory, oldest first.
+func ListBackups(backupDir string) ([]*BackupManifest, error) {
+	infos, err := ioutil.ReadDir(filepath.Join(backupDir, backupManifestsDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []*BackupManifest
+	for _, info := range infos {
+		if !strings.HasSuffix(info.Name(), ".json") {
+			continue
+		}
+		m, err := LoadBackupManifest(backupDir, strings.TrimSuffix(info.Name(), ".json"))
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		if manifests[i].Created != manifests[j].Created {
+			return manifests[i].Created < manifests[j].Created
+		}
+		return manifests[i].ID < manifests[j].ID
+	})
+	return manifests, nil
+}
+
+// LoadBackupManifest return the manifest of the backup, the latest one if id is empty.
+func LoadBackupManifest(backupDir, id string) (*BackupManifest, error) {
+	if len(id) == 0 {
+		manifests, err := ListBackups(backupDir)
+		if err != nil {
+			return nil, err
+		}
+		if len(manifests) == 0 {
+			return nil, ErrBackupNotFound
+		}
+		return manifests[len(manifests)-1], nil
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(backupDir, backupManifestsDir, id+".json"))
+	if os.IsNotExist(err) {
+		return nil, ErrBackupNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(BackupManifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// VerifyBackup checks the size and hash of every file of the backup, the latest
+// one if id is empty.
+func VerifyBackup(backupDir, id string) (*BackupManifest, error) {
+	manifest, err := LoadBackupManifest(backupDir, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range manifest.Files {
+		size, sum, err := hashFile(filepath.Join(backupDir, backupFilesDir, f.Hash), ioutil.Discard)
+		if err != nil || size != f.Size || sum != f.Hash {
+			logging.CLog().WithFields(logrus.Fields{
+				"backup": manifest.ID,
+				"file":   f.Name,
+				"err":    err,
+			}).Error("Failed to verify backup file.")
+			return nil, ErrBackupCorrupted
+		}
+	}
+	return manifest, nil
+}
+
+// RestoreBackup verifies the backup and copies its files into the empty target
+// directory, which can be used as the datadir of the chain.
+func RestoreBackup(backupDir, id, target string) (*BackupManifest, error) {
+	if infos, err := ioutil.ReadDir(target); err == nil && len(infos) > 0 {
+		return nil, ErrRestoreTargetExists
+	}
+	manifest, err := VerifyBackup(backupDir, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(target, 0700); err != nil {
+		return nil, err
+	}
+	for _, f := range manifest.Files {
+		if err := copyFile(filepath.Join(backupDir, backupFilesDir, f.Hash), filepath.Join(target, f.Name)); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// backupFile copies the file into the backup files by its content hash,
+// return the number of bytes copied, 0 if the content is already backed up.
+func backupFile(path, backupDir string) (*BackupFile, int64, error) {
+	tmp, err := ioutil.TempFile(filepath.Join(backupDir, backupFilesDir), ".tmp")
+	if err != nil {
+		return nil, 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	size, sum, err := hashFile(path, tmp)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	f := &BackupFile{Size: size, Hash: sum}
+	dst := filepath.Join(backupDir, backupFilesDir, sum)
+	if _, err := os.Stat(dst); err == nil {
+		return f, 0, nil
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		return nil, 0, err
+	}
+	return f, size, nil
+}
+
+// hashFile copies the file to w, return its size and hex sha3 hash.
+func hashFile(path string, w io.Writer) (int64, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+
+	hasher := sha3.New256()
+	size, err := io.Copy(io.MultiWriter(hasher, w), file)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// isTableFile return whether the levelDB file is an immutable table.
+func isTableFile(name string) bool {
+	return strings.HasSuffix(name, ".ldb") || strings.HasSuffix(name, ".sst")
+}
+
+// isVolatileFile return whether the levelDB file is not needed to restore it.
+func isVolatileFile(name string) bool {
+	return name == "LOCK" || strings.HasPrefix(name, "LOG")
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/sha3"
+)
+
+// Errors of remote backups
+var (
+	ErrInvalidBackupURL = errors.New("backup url should be https:// or s3://")
+)
+
+// Timeouts of the remote backup client. A download of a large backup takes
+// long, so the client has no overall timeout, a connection is dropped once it
+// stalls instead.
+const (
+	remoteBackupDialTimeout = 30 * time.Second
+	remoteBackupReadTimeout = 60 * time.Second
+	remoteBackupIdleTimeout = 90 * time.Second
+)
+
+// NewRemoteBackupClient return the http client downloading remote backups,
+// it fails on a server not answering or stalling within the timeouts.
+func NewRemoteBackupClient() *http.Client {
+	dialer := &net.Dialer{Timeout: remoteBackupDialTimeout}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				conn, err := dialer.DialContext(ctx, network, addr)
+				if err != nil {
+					return nil, err
+				}
+				return &stallTimeoutConn{Conn: conn, timeout: remoteBackupReadTimeout}, nil
+			},
+			TLSHandshakeTimeout:   remoteBackupDialTimeout,
+			ResponseHeaderTimeout: remoteBackupReadTimeout,
+			IdleConnTimeout:       remoteBackupIdleTimeout,
+		},
+	}
+}
+
+// stallTimeoutConn fails a read getting no data within the timeout.
+type stallTimeoutConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *stallTimeoutConn) Read(b []byte) (int, error) {
+	if err := c.Conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(b)
+}
+
+// RemoteBackupURL return the https url of a backup directory published on a
+// web server or an object storage, s3://bucket/path is the public url of the
+// path in the S3 bucket. Plain http is refused, the content of a backup is
+// only checked against its manifest, which the transport must protect.
+func RemoteBackupURL(url string) (string, error) {
+	url = strings.TrimSuffix(url, "/")
+	switch {
+	case strings.HasPrefix(url, "https://"):
+		return url, nil
+	case strings.HasPrefix(url, "s3://"):
+		path := strings.TrimPrefix(url, "s3://")
+		bucket := path
+		if i := strings.Index(path, "/"); i >= 0 {
+			bucket, path = path[:i], path[i:]
+		} else {
+			path = ""
+		}
+		if len(bucket) == 0 {
+			return "", ErrInvalidBackupURL
+		}
+		return "https://" + bucket + ".s3.amazonaws.com" + path, nil
+	}
+	return "", ErrInvalidBackupURL
+}
+
+// Size limits of the small files of a remote backup, read into memory. The
+// files of the backup are streamed to disk and checked against the manifest.
+const (
+	MaxRemoteBackupSignatureSize = 4 << 10
+	MaxRemoteBackupManifestSize  = 16 << 20
+)
+
+// BackupSignatureFile return the path in the backup directory of the signed
+// attestation of the backup, published with it as a snapshot.
+func BackupSignatureFile(id string) string {
+	return backupManifestsDir + "/" + id + ".sig"
+}
+
+// FetchRemoteBackupFile return the content of the file at the path of the
+// backup directory published at url, ErrBackupCorrupted if it's larger than
+// the limit.
+func FetchRemoteBackupFile(client *http.Client, url, path string, limit int64) ([]byte, error) {
+	body, err := getRemoteBackupFile(client, url, path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrBackupCorrupted
+	}
+	return data, nil
+}
+
+// FetchRemoteBackupManifest return the manifest of the backup published at
+// url, checked by the caller before the files are downloaded.
+func FetchRemoteBackupManifest(client *http.Client, url, id string) (*BackupManifest, error) {
+	data, err := FetchRemoteBackupFile(client, url, backupManifestsDir+"/"+id+".json", MaxRemoteBackupManifestSize)
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(BackupManifest)
+	if err := json.Unmarshal(data, manifest); err != nil || manifest.ID != id {
+		return nil, ErrBackupCorrupted
+	}
+	return manifest, nil
+}
+
+// DownloadBackup downloads the files of the manifest of the backup published
+// at url, a backup directory served as is, into the empty target directory.
+// The size and hash of every file are checked, as VerifyBackup does for a
+// local backup.
+func DownloadBackup(client *http.Client, url string, manifest *BackupManifest, target string) error {
+	if infos, err := ioutil.ReadDir(target); err == nil && len(infos) > 0 {
+		return ErrRestoreTargetExists
+	}
+	if err := os.MkdirAll(target, 0700); err != nil {
+		return err
+	}
+
+	for _, f := range manifest.Files {
+		if err := downloadBackupFile(client, url, f, filepath.Join(target, f.Name)); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"backup": manifest.ID,
+				"file":   f.Name,
+				"err":    err,
+			}).Error("Failed to download backup file.")
+			return err
+		}
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"url":    url,
+		"id":     manifest.ID,
+		"files":  len(manifest.Files),
+		"size":   manifest.Size(),
+		"target": target,
+	}).Info("Downloaded backup.")
+	return nil
+}
+
+func downloadBackupFile(client *http.Client, url string, f *BackupFile, dst string) error {
+	if strings.ContainsAny(f.Name, "/\\") || f.Name == ".." {
+		return ErrBackupCorrupted
+	}
+	body, err := getRemoteBackupFile(client, url, backupFilesDir+"/"+f.Hash)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	hasher := sha3.New256()
+	size, err := io.Copy(io.MultiWriter(out, hasher), body)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if size != f.Size || hex.EncodeToString(hasher.Sum(nil)) != f.Hash {
+		return ErrBackupCorrupted
+	}
+	return nil
+}
+
+func getRemoteBackupFile(client *http.Client, url, path string) (io.ReadCloser, error) {
+	base, err := RemoteBackupURL(url)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(base + "/" + path)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrBackupNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("get %s: %s", path, resp.Status)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteBackupURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+		err  error
+	}{
+		{"https://example.com/neb/", "https://example.com/neb", nil},
+		{"http://example.com/neb", "", ErrInvalidBackupURL},
+		{"s3://bucket/snapshots/neb", "https://bucket.s3.amazonaws.com/snapshots/neb", nil},
+		{"s3://bucket", "https://bucket.s3.amazonaws.com", nil},
+		{"s3:///neb", "", ErrInvalidBackupURL},
+		{"ftp://example.com/neb", "", ErrInvalidBackupURL},
+	}
+	for _, tt := range tests {
+		got, err := RemoteBackupURL(tt.url)
+		assert.Equal(t, tt.err, err, tt.url)
+		assert.Equal(t, tt.want, got, tt.url)
+	}
+}
+
+func TestDownloadBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, "data.db")
+	backupDir := filepath.Join(dir, "backup")
+
+	putEntries(t, dbPath, 0, 1000)
+	m, err := Backup(dbPath, backupDir, false)
+	assert.Nil(t, err)
+
+	server := httptest.NewTLSServer(http.FileServer(http.Dir(backupDir)))
+	defer server.Close()
+	client := server.Client()
+
+	target := filepath.Join(dir, "download.db")
+	downloaded, err := FetchRemoteBackupManifest(client, server.URL, m.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, m.Digest(), downloaded.Digest())
+	assert.Nil(t, DownloadBackup(client, server.URL, downloaded, target))
+	db, err := NewDiskStorage(target)
+	assert.Nil(t, err)
+	value, err := db.Get([]byte("key999"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value999"), value)
+	assert.Nil(t, db.Close())
+
+	assert.Equal(t, ErrRestoreTargetExists, DownloadBackup(client, server.URL, downloaded, target))
+	_, err = FetchRemoteBackupManifest(client, server.URL, "missing")
+	assert.Equal(t, ErrBackupNotFound, err)
+
+	// a file larger than its limit is refused.
+	data, err := FetchRemoteBackupFile(client, server.URL, backupManifestsDir+"/"+m.ID+".json", MaxRemoteBackupManifestSize)
+	assert.Nil(t, err)
+	_, err = FetchRemoteBackupFile(client, server.URL, backupManifestsDir+"/"+m.ID+".json", int64(len(data)-1))
+	assert.Equal(t, ErrBackupCorrupted, err)
+
+	// a corrupted file is refused.
+	f := m.Files[0]
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(backupDir, backupFilesDir, f.Hash), []byte("corrupted"), 0600))
+	assert.Equal(t, ErrBackupCorrupted, DownloadBackup(client, server.URL, downloaded, filepath.Join(dir, "corrupted.db")))
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func putEntries(t *testing.T, path string, from, to int) {
+	db, err := NewDiskStorage(path)
+	assert.Nil(t, err)
+	for i := from; i < to; i++ {
+		assert.Nil(t, db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	assert.Nil(t, db.Close())
+}
+
+func TestBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, "data.db")
+	backupDir := filepath.Join(dir, "backup")
+
+	_, err = LoadBackupManifest(backupDir, "")
+	assert.Equal(t, ErrBackupNotFound, err)
+
+	putEntries(t, dbPath, 0, 1000)
+	full, err := Backup(dbPath, backupDir, true)
+	assert.Nil(t, err)
+	assert.Equal(t, "", full.Parent)
+	assert.Equal(t, full.Size(), full.Copied)
+
+	var tables int
+	for _, f := range full.Files {
+		if isTableFile(f.Name) {
+			tables++
+		}
+	}
+	assert.NotEqual(t, 0, tables)
+
+	// only the changed files are copied by the incremental backup.
+	putEntries(t, dbPath, 1000, 1100)
+	incr, err := Backup(dbPath, backupDir, true)
+	assert.Nil(t, err)
+	assert.Equal(t, full.ID, incr.Parent)
+	assert.True(t, incr.Copied < incr.Size())
+	for _, f := range full.Files {
+		if isTableFile(f.Name) {
+			assert.Contains(t, incr.Files, f)
+		}
+	}
+
+	backups, err := ListBackups(backupDir)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{full.ID, incr.ID}, []string{backups[0].ID, backups[1].ID})
+
+	m, err := VerifyBackup(backupDir, "")
+	assert.Nil(t, err)
+	assert.Equal(t, incr.ID, m.ID)
+
+	target := filepath.Join(dir, "restored.db")
+	_, err = RestoreBackup(backupDir, full.ID, target)
+	assert.Nil(t, err)
+	_, err = RestoreBackup(backupDir, full.ID, target)
+	assert.Equal(t, ErrRestoreTargetExists, err)
+	db, err := NewDiskStorage(target)
+	assert.Nil(t, err)
+	value, err := db.Get([]byte("key999"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value999"), value)
+	_, err = db.Get([]byte("key1000"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	assert.Nil(t, db.Close())
+
+	// corrupted files fail the verification.
+	f := incr.Files[len(incr.Files)-1]
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(backupDir, backupFilesDir, f.Hash), []byte("corrupted"), 0600))
+	_, err = VerifyBackup(backupDir, incr.ID)
+	assert.Equal(t, ErrBackupCorrupted, err)
+	_, err = VerifyBackup(backupDir, "unknown")
+	assert.Equal(t, ErrBackupNotFound, err)
+}
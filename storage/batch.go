@@ -0,0 +1,68 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+// Batch is a list of writes a storage commits at once by WriteBatch, a crash
+// never leaves a part of them written.
+type Batch struct {
+	ops []batchOp
+}
+
+type batchOp struct {
+	key   []byte
+	value []byte
+	del   bool
+}
+
+// NewBatch returns an empty batch.
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// Put adds the put of the key-value entry to the batch.
+func (b *Batch) Put(key []byte, value []byte) {
+	b.ops = append(b.ops, batchOp{key: key, value: value})
+}
+
+// Del adds the deletion of the key to the batch.
+func (b *Batch) Del(key []byte) {
+	b.ops = append(b.ops, batchOp{key: key, del: true})
+}
+
+// Len returns the count of the writes in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
+// Replay calls put and del for the writes of the batch in order, it stops at
+// the first error.
+func (b *Batch) Replay(put func(key []byte, value []byte) error, del func(key []byte) error) error {
+	for _, op := range b.ops {
+		var err error
+		if op.del {
+			err = del(op.key)
+		} else {
+			err = put(op.key, op.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
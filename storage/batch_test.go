@@ -0,0 +1,85 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testWriteBatch(t *testing.T, s Storage) {
+	assert.Nil(t, s.Put([]byte("a"), []byte("1")))
+
+	batch := NewBatch()
+	batch.Put([]byte("b"), []byte("2"))
+	batch.Del([]byte("a"))
+	batch.Put([]byte("c"), []byte("3"))
+	batch.Put([]byte("c"), []byte("4"))
+	assert.Equal(t, 4, batch.Len())
+	assert.Nil(t, s.WriteBatch(batch))
+
+	_, err := s.Get([]byte("a"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	value, err := s.Get([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("2"), value)
+	// the writes are applied in order.
+	value, err = s.Get([]byte("c"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("4"), value)
+}
+
+func TestWriteBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "batch")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	disk, err := NewDiskStorage(dir)
+	assert.Nil(t, err)
+	defer disk.Close()
+	testWriteBatch(t, disk)
+
+	mem, _ := NewMemoryStorage()
+	testWriteBatch(t, mem)
+	mem, _ = NewMemoryStorage()
+	testWriteBatch(t, NewMeteredStorage(mem, NamespaceIndex))
+	mem, _ = NewMemoryStorage()
+	testWriteBatch(t, NewOverlayStorage(mem))
+}
+
+func TestWriteBatch_Wrapped(t *testing.T) {
+	mem, _ := NewMemoryStorage()
+	guarded := NewGuardedStorage(mem)
+	assert.Nil(t, guarded.Put([]byte("a"), []byte("1")))
+	guarded.Guard()
+	batch := NewBatch()
+	batch.Put([]byte("a"), []byte("2"))
+	assert.Nil(t, guarded.WriteBatch(batch))
+	deleted, err := guarded.DelUnwritten([]byte("a"))
+	assert.Nil(t, err)
+	assert.False(t, deleted)
+
+	recording := NewRecordingStorage(mem)
+	assert.Nil(t, recording.WriteBatch(batch))
+	_, err = recording.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Empty(t, recording.Reads())
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors
+var (
+	ErrCompactionRunning     = errors.New("compaction is already running")
+	ErrInvalidCompactionTime = errors.New("invalid compaction time, should be HH:MM")
+)
+
+// Metrics
+var (
+	compactionProgressGauge = metrics.GetOrRegisterGauge("neb.storage.compaction.progress", nil)
+	compactionRunningGauge  = metrics.GetOrRegisterGauge("neb.storage.compaction.running", nil)
+	compactionTimer         = metrics.GetOrRegisterTimer("neb.storage.compaction.duration", nil)
+	compactionFailedCounter = metrics.GetOrRegisterCounter("neb.storage.compaction.failed", nil)
+)
+
+const (
+	compactionCheckInterval = 30 * time.Second
+)
+
+// Compactor is a storage able to compact its underlying files.
+// progress is called after each compacted part with the number of parts done.
+type Compactor interface {
+	Compact(ctx context.Context, progress func(done, total int)) error
+}
+
+// CompactionScheduler runs the compaction of a storage at off-peak times of day,
+// or manually on request. At most one compaction runs at a time.
+type CompactionScheduler struct {
+	compactor Compactor
+	dailyAt   []int
+
+	mu      sync.Mutex
+	started bool
+	running bool
+	cancel  context.CancelFunc
+	lastRun string
+
+	now    func() time.Time
+	quitCh chan bool
+}
+
+// NewCompactionScheduler create a scheduler compacting daily at the local times "HH:MM".
+func NewCompactionScheduler(compactor Compactor, dailyAt []string) (*CompactionScheduler, error) {
+	minutes, err := ParseCompactionTimes(dailyAt)
+	if err != nil {
+		return nil, err
+	}
+	return &CompactionScheduler{
+		compactor: compactor,
+		dailyAt:   minutes,
+		now:       time.Now,
+		quitCh:    make(chan bool, 1),
+	}, nil
+}
+
+// ParseCompactionTimes parse the local times of day "HH:MM" into minutes of day.
+func ParseCompactionTimes(dailyAt []string) ([]int, error) {
+	minutes := make([]int, 0, len(dailyAt))
+	for _, v := range dailyAt {
+		var hour, minute int
+		if _, err := fmt.Sscanf(v, "%d:%d", &hour, &minute); err != nil ||
+			hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+			return nil, ErrInvalidCompactionTime
+		}
+		minutes = append(minutes, hour*60+minute)
+	}
+	return minutes, nil
+}
+
+// Start the schedule loop, once however many times the node starts it.
+func (s *CompactionScheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+
+	logging.CLog().WithFields(logrus.Fields{
+		"dailyAt": s.dailyAt,
+	}).Info("Start CompactionScheduler.")
+
+	go s.loop()
+}
+
+// Stop the schedule loop and cancel the running compaction.
+func (s *CompactionScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		logging.CLog().Info("Stop CompactionScheduler.")
+		s.started = false
+		s.quitCh <- true
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// Running returns whether a compaction is running.
+func (s *CompactionScheduler) Running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+// Trigger starts a compaction in background.
+func (s *CompactionScheduler) Trigger() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return ErrCompactionRunning
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.running = true
+	s.cancel = cancel
+
+	go s.compact(ctx)
+	return nil
+}
+
+func (s *CompactionScheduler) loop() {
+	ticker := time.NewTicker(compactionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case <-ticker.C:
+			s.checkSchedule()
+		}
+	}
+}
+
+// checkSchedule triggers a compaction once in a scheduled minute.
+func (s *CompactionScheduler) checkSchedule() {
+	now := s.now()
+	minute := now.Hour()*60 + now.Minute()
+	key := now.Format("2006-01-02 15:04")
+	for _, m := range s.dailyAt {
+		if m != minute || !s.markRun(key) {
+			continue
+		}
+		if err := s.Trigger(); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Warn("Failed to trigger scheduled compaction.")
+		}
+		return
+	}
+}
+
+// markRun records the scheduled minute run, false if it already ran.
+func (s *CompactionScheduler) markRun(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastRun == key {
+		return false
+	}
+	s.lastRun = key
+	return true
+}
+
+func (s *CompactionScheduler) compact(ctx context.Context) {
+	logging.CLog().Info("Start compacting storage.")
+
+	start := time.Now()
+	compactionRunningGauge.Update(1)
+	compactionProgressGauge.Update(0)
+
+	err := s.compactor.Compact(ctx, func(done, total int) {
+		compactionProgressGauge.Update(int64(done * 100 / total))
+	})
+
+	compactionRunningGauge.Update(0)
+	if err != nil {
+		compactionFailedCounter.Inc(1)
+		logging.CLog().WithFields(logrus.Fields{
+			"err":  err,
+			"cost": time.Since(start),
+		}).Error("Failed to compact storage.")
+	} else {
+		compactionTimer.UpdateSince(start)
+		logging.CLog().WithFields(logrus.Fields{
+			"cost": time.Since(start),
+		}).Info("Compacted storage.")
+	}
+
+	s.mu.Lock()
+	s.running = false
+	s.cancel()
+	s.cancel = nil
+	s.mu.Unlock()
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCompactor struct {
+	startCh chan bool
+	doneCh  chan bool
+	calls   int
+}
+
+func (c *mockCompactor) Compact(ctx context.Context, progress func(done, total int)) error {
+	c.calls++
+	c.startCh <- true
+	select {
+	case <-c.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	progress(1, 1)
+	return nil
+}
+
+func TestNewCompactionScheduler(t *testing.T) {
+	s, err := NewCompactionScheduler(nil, []string{"03:30", "0:05"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{210, 5}, s.dailyAt)
+
+	for _, v := range []string{"24:00", "12:60", "noon", ""} {
+		_, err = NewCompactionScheduler(nil, []string{v})
+		assert.Equal(t, ErrInvalidCompactionTime, err, v)
+	}
+}
+
+func TestCompactionScheduler_Trigger(t *testing.T) {
+	compactor := &mockCompactor{startCh: make(chan bool, 1), doneCh: make(chan bool, 1)}
+	s, err := NewCompactionScheduler(compactor, []string{"03:30"})
+	assert.Nil(t, err)
+
+	now := time.Date(2018, 1, 1, 3, 29, 0, 0, time.Local)
+	s.now = func() time.Time { return now }
+
+	s.checkSchedule()
+	assert.False(t, s.Running())
+
+	now = now.Add(time.Minute)
+	s.checkSchedule()
+	<-compactor.startCh
+	assert.True(t, s.Running())
+	assert.Equal(t, ErrCompactionRunning, s.Trigger())
+
+	compactor.doneCh <- true
+	for s.Running() {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, int64(100), compactionProgressGauge.Value())
+
+	// only once in the scheduled minute.
+	s.checkSchedule()
+	assert.False(t, s.Running())
+	assert.Equal(t, 1, compactor.calls)
+
+	// manual trigger, canceled by stop.
+	assert.Nil(t, s.Trigger())
+	<-compactor.startCh
+	s.Stop()
+	for s.Running() {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, 2, compactor.calls)
+}
+
+func TestCompactionScheduler_StartOnce(t *testing.T) {
+	s, err := NewCompactionScheduler(&mockCompactor{}, nil)
+	assert.Nil(t, err)
+
+	// the node starts it again when the sync starts, a single loop quits.
+	s.Start()
+	s.Start()
+	s.Stop()
+	s.Stop()
+	assert.False(t, s.started)
+}
+
+func TestDiskStorage_Compact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compaction")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	storage, err := NewDiskStorage(dir)
+	assert.Nil(t, err)
+	defer storage.Close()
+
+	for i := 0; i < 256; i++ {
+		assert.Nil(t, storage.Put([]byte{byte(i), 1}, []byte{byte(i)}))
+	}
+
+	parts := 0
+	assert.Nil(t, storage.Compact(context.Background(), func(done, total int) {
+		parts = done
+		assert.Equal(t, compactionParts, total)
+	}))
+	assert.Equal(t, compactionParts, parts)
+
+	value, err := storage.Get([]byte{255, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{255}, value)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, storage.Compact(ctx, nil))
+}
@@ -19,9 +19,18 @@
 package storage
 
 import (
+	"context"
+
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// compactionParts is the number of key ranges a compaction is split into,
+	// so that it reports progress and can be canceled in between.
+	compactionParts = 16
 )
 
 // DiskStorage the nodes in trie.
@@ -65,6 +74,44 @@ func (storage *DiskStorage) Del(key []byte) error {
 	return storage.db.Delete(key, nil)
 }
 
+// WriteBatch commits the writes of the batch in a levelDB batch.
+func (storage *DiskStorage) WriteBatch(batch *Batch) error {
+	b := new(leveldb.Batch)
+	batch.Replay(func(key []byte, value []byte) error {
+		b.Put(key, value)
+		return nil
+	}, func(key []byte) error {
+		b.Delete(key)
+		return nil
+	})
+	return storage.db.Write(b, nil)
+}
+
+// Compact the whole key space of levelDB part by part.
+func (storage *DiskStorage) Compact(ctx context.Context, progress func(done, total int)) error {
+	step := 256 / compactionParts
+	for i := 0; i < compactionParts; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		r := util.Range{}
+		if i > 0 {
+			r.Start = []byte{byte(i * step)}
+		}
+		if i < compactionParts-1 {
+			r.Limit = []byte{byte((i + 1) * step)}
+		}
+		if err := storage.db.CompactRange(r); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(i+1, compactionParts)
+		}
+	}
+	return nil
+}
+
 // Close levelDB
 func (storage *DiskStorage) Close() error {
 	return storage.db.Close()
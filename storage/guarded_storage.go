@@ -0,0 +1,107 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// GuardedStorage records the keys written while it is guarded, so that a
+// garbage collector deleting the entries it found unused doesn't delete the
+// ones written again since, e.g. a trie node recreated by a new block.
+type GuardedStorage struct {
+	storage Storage
+
+	mu      sync.Mutex
+	written map[string]bool
+}
+
+// NewGuardedStorage wrap the storage with a guard.
+func NewGuardedStorage(storage Storage) *GuardedStorage {
+	return &GuardedStorage{
+		storage: storage,
+	}
+}
+
+// Get return value to the key in the wrapped storage.
+func (s *GuardedStorage) Get(key []byte) ([]byte, error) {
+	return s.storage.Get(key)
+}
+
+// Put put the key-value entry to the wrapped storage.
+func (s *GuardedStorage) Put(key []byte, value []byte) error {
+	s.mu.Lock()
+	if s.written != nil {
+		s.written[byteutils.Hex(key)] = true
+	}
+	s.mu.Unlock()
+
+	return s.storage.Put(key, value)
+}
+
+// Del delete the key in the wrapped storage.
+func (s *GuardedStorage) Del(key []byte) error {
+	return s.storage.Del(key)
+}
+
+// WriteBatch commits the batch to the wrapped storage.
+func (s *GuardedStorage) WriteBatch(batch *Batch) error {
+	s.mu.Lock()
+	if s.written != nil {
+		batch.Replay(func(key []byte, value []byte) error {
+			s.written[byteutils.Hex(key)] = true
+			return nil
+		}, func(key []byte) error {
+			return nil
+		})
+	}
+	s.mu.Unlock()
+
+	return s.storage.WriteBatch(batch)
+}
+
+// Guard starts recording the keys written.
+func (s *GuardedStorage) Guard() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.written = make(map[string]bool)
+}
+
+// Unguard stops recording the keys written.
+func (s *GuardedStorage) Unguard() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.written = nil
+}
+
+// DelUnwritten deletes the key unless it was written since Guard, and returns
+// whether it was deleted.
+func (s *GuardedStorage) DelUnwritten(key []byte) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.written[byteutils.Hex(key)] {
+		return false, nil
+	}
+	if err := s.storage.Del(key); err != nil {
+		return false, err
+	}
+	return true, nil
+}
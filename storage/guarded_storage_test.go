@@ -0,0 +1,52 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardedStorage(t *testing.T) {
+	mem, _ := NewMemoryStorage()
+	s := NewGuardedStorage(mem)
+	assert.Nil(t, s.Put([]byte("a"), []byte("1")))
+	assert.Nil(t, s.Put([]byte("b"), []byte("2")))
+
+	s.Guard()
+	assert.Nil(t, s.Put([]byte("b"), []byte("2")))
+	deleted, err := s.DelUnwritten([]byte("a"))
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+	deleted, err = s.DelUnwritten([]byte("b"))
+	assert.Nil(t, err)
+	assert.False(t, deleted)
+	s.Unguard()
+
+	_, err = mem.Get([]byte("a"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	value, err := mem.Get([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("2"), value)
+
+	deleted, err = s.DelUnwritten([]byte("b"))
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
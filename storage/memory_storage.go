@@ -55,3 +55,8 @@ func (db *MemoryStorage) Del(key []byte) error {
 	db.data.Delete(byteutils.Hex(key))
 	return nil
 }
+
+// WriteBatch applies the writes of the batch in order.
+func (db *MemoryStorage) WriteBatch(batch *Batch) error {
+	return batch.Replay(db.Put, db.Del)
+}
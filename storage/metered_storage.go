@@ -0,0 +1,157 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// Namespaces of storage metrics.
+const (
+	NamespaceBlocks = "blocks"
+	NamespaceState  = "state"
+	NamespaceIndex  = "index"
+)
+
+var (
+	meters = new(sync.Map)
+)
+
+// namespaceMeter holds the metrics of a namespace, e.g. neb.storage.blocks.get.
+type namespaceMeter struct {
+	getTimer     metrics.Timer
+	putTimer     metrics.Timer
+	delTimer     metrics.Timer
+	batchTimer   metrics.Timer
+	readSize     metrics.Histogram
+	writeSize    metrics.Histogram
+	missCounter  metrics.Counter
+	errorCounter metrics.Counter
+}
+
+func newNamespaceMeter(namespace string) *namespaceMeter {
+	prefix := fmt.Sprintf("neb.storage.%s", namespace)
+	return &namespaceMeter{
+		getTimer:     metrics.GetOrRegisterTimer(prefix+".get", nil),
+		putTimer:     metrics.GetOrRegisterTimer(prefix+".put", nil),
+		delTimer:     metrics.GetOrRegisterTimer(prefix+".del", nil),
+		batchTimer:   metrics.GetOrRegisterTimer(prefix+".batch", nil),
+		readSize:     metrics.GetOrRegisterHistogram(prefix+".read.size", nil, metrics.NewExpDecaySample(1028, 0.015)),
+		writeSize:    metrics.GetOrRegisterHistogram(prefix+".write.size", nil, metrics.NewExpDecaySample(1028, 0.015)),
+		missCounter:  metrics.GetOrRegisterCounter(prefix+".miss", nil),
+		errorCounter: metrics.GetOrRegisterCounter(prefix+".errors", nil),
+	}
+}
+
+func meterOf(namespace string) *namespaceMeter {
+	if v, ok := meters.Load(namespace); ok {
+		return v.(*namespaceMeter)
+	}
+	v, _ := meters.LoadOrStore(namespace, newNamespaceMeter(namespace))
+	return v.(*namespaceMeter)
+}
+
+// MeteredStorage records op latencies, value sizes and error counts of the wrapped storage
+// under a namespace, so the workload hammering the disk can be told apart.
+type MeteredStorage struct {
+	storage Storage
+	meter   *namespaceMeter
+}
+
+// NewMeteredStorage wrap the storage with metrics of the namespace.
+func NewMeteredStorage(storage Storage, namespace string) *MeteredStorage {
+	return &MeteredStorage{
+		storage: storage,
+		meter:   meterOf(namespace),
+	}
+}
+
+// WithNamespace returns a view of a metered storage recording under another namespace,
+// other storages are returned as is.
+func WithNamespace(storage Storage, namespace string) Storage {
+	if s, ok := storage.(*MeteredStorage); ok {
+		return NewMeteredStorage(s.storage, namespace)
+	}
+	return storage
+}
+
+// Get return value to the key in the wrapped storage.
+func (s *MeteredStorage) Get(key []byte) ([]byte, error) {
+	start := time.Now()
+	value, err := s.storage.Get(key)
+	s.meter.getTimer.UpdateSince(start)
+
+	if err == ErrKeyNotFound {
+		s.meter.missCounter.Inc(1)
+	} else if err != nil {
+		s.meter.errorCounter.Inc(1)
+	} else {
+		s.meter.readSize.Update(int64(len(value)))
+	}
+	return value, err
+}
+
+// Put put the key-value entry to the wrapped storage.
+func (s *MeteredStorage) Put(key []byte, value []byte) error {
+	start := time.Now()
+	err := s.storage.Put(key, value)
+	s.meter.putTimer.UpdateSince(start)
+
+	if err != nil {
+		s.meter.errorCounter.Inc(1)
+	} else {
+		s.meter.writeSize.Update(int64(len(value)))
+	}
+	return err
+}
+
+// Del delete the key in the wrapped storage.
+func (s *MeteredStorage) Del(key []byte) error {
+	start := time.Now()
+	err := s.storage.Del(key)
+	s.meter.delTimer.UpdateSince(start)
+
+	if err != nil && err != ErrKeyNotFound {
+		s.meter.errorCounter.Inc(1)
+	}
+	return err
+}
+
+// WriteBatch commits the batch to the wrapped storage.
+func (s *MeteredStorage) WriteBatch(batch *Batch) error {
+	start := time.Now()
+	err := s.storage.WriteBatch(batch)
+	s.meter.batchTimer.UpdateSince(start)
+
+	if err != nil {
+		s.meter.errorCounter.Inc(1)
+	} else {
+		batch.Replay(func(key []byte, value []byte) error {
+			s.meter.writeSize.Update(int64(len(value)))
+			return nil
+		}, func(key []byte) error {
+			return nil
+		})
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeteredStorage(t *testing.T) {
+	memory, _ := NewMemoryStorage()
+	state := NewMeteredStorage(memory, "test.state")
+	blocks := WithNamespace(state, "test.blocks")
+
+	assert.Nil(t, state.Put([]byte("k1"), []byte("value")))
+	assert.Nil(t, blocks.Put([]byte("k2"), []byte("v")))
+
+	value, err := blocks.Get([]byte("k1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	_, err = blocks.Get([]byte("none"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	assert.Nil(t, state.Del([]byte("k2")))
+
+	stateMeter := meterOf("test.state")
+	assert.Equal(t, int64(1), stateMeter.putTimer.Count())
+	assert.Equal(t, int64(5), stateMeter.writeSize.Max())
+	assert.Equal(t, int64(1), stateMeter.delTimer.Count())
+	assert.Equal(t, int64(0), stateMeter.getTimer.Count())
+
+	blocksMeter := meterOf("test.blocks")
+	assert.Equal(t, int64(1), blocksMeter.putTimer.Count())
+	assert.Equal(t, int64(2), blocksMeter.getTimer.Count())
+	assert.Equal(t, int64(1), blocksMeter.missCounter.Count())
+	assert.Equal(t, int64(5), blocksMeter.readSize.Max())
+	assert.Equal(t, int64(0), blocksMeter.errorCounter.Count())
+
+	// unmetered storage has no namespaces.
+	assert.Equal(t, Storage(memory), WithNamespace(memory, NamespaceBlocks))
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// OverlayStorage reads through to the base storage and keeps all changes in
+// memory, so the base storage is never modified, e.g. when replaying blocks.
+type OverlayStorage struct {
+	base    Storage
+	changes *sync.Map
+}
+
+// overlayDeleted marks a key deleted in the overlay.
+type overlayDeleted struct{}
+
+// NewOverlayStorage return an overlay on the base storage.
+func NewOverlayStorage(base Storage) *OverlayStorage {
+	return &OverlayStorage{
+		base:    base,
+		changes: new(sync.Map),
+	}
+}
+
+// Get return the value of the overlay if changed, otherwise the value of the base storage.
+func (s *OverlayStorage) Get(key []byte) ([]byte, error) {
+	if v, ok := s.changes.Load(byteutils.Hex(key)); ok {
+		if value, ok := v.([]byte); ok {
+			return value, nil
+		}
+		return nil, ErrKeyNotFound
+	}
+	return s.base.Get(key)
+}
+
+// Put put the key-value entry to the overlay.
+func (s *OverlayStorage) Put(key []byte, value []byte) error {
+	s.changes.Store(byteutils.Hex(key), value)
+	return nil
+}
+
+// Del delete the key in the overlay.
+func (s *OverlayStorage) Del(key []byte) error {
+	s.changes.Store(byteutils.Hex(key), overlayDeleted{})
+	return nil
+}
+
+// WriteBatch applies the writes of the batch to the overlay.
+func (s *OverlayStorage) WriteBatch(batch *Batch) error {
+	return batch.Replay(s.Put, s.Del)
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayStorage(t *testing.T) {
+	base, _ := NewMemoryStorage()
+	base.Put([]byte("a"), []byte("1"))
+	base.Put([]byte("b"), []byte("2"))
+
+	s := NewOverlayStorage(base)
+	v, err := s.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), v)
+
+	assert.Nil(t, s.Put([]byte("a"), []byte("3")))
+	assert.Nil(t, s.Put([]byte("c"), []byte("4")))
+	assert.Nil(t, s.Del([]byte("b")))
+
+	v, _ = s.Get([]byte("a"))
+	assert.Equal(t, []byte("3"), v)
+	v, _ = s.Get([]byte("c"))
+	assert.Equal(t, []byte("4"), v)
+	_, err = s.Get([]byte("b"))
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	v, _ = base.Get([]byte("a"))
+	assert.Equal(t, []byte("1"), v)
+	v, _ = base.Get([]byte("b"))
+	assert.Equal(t, []byte("2"), v)
+	_, err = base.Get([]byte("c"))
+	assert.Equal(t, ErrKeyNotFound, err)
+}
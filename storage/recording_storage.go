@@ -0,0 +1,105 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// RecordingStorage records the entries read from the wrapped storage, except
+// the ones written through it before, e.g. the trie nodes read by a block execution.
+type RecordingStorage struct {
+	storage Storage
+
+	mu      sync.Mutex
+	reads   map[string][]byte
+	written map[string]bool
+}
+
+// NewRecordingStorage wrap the storage with recording.
+func NewRecordingStorage(storage Storage) *RecordingStorage {
+	return &RecordingStorage{
+		storage: storage,
+		reads:   make(map[string][]byte),
+		written: make(map[string]bool),
+	}
+}
+
+// Get return value to the key in the wrapped storage.
+func (s *RecordingStorage) Get(key []byte) ([]byte, error) {
+	value, err := s.storage.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := byteutils.Hex(key)
+	if !s.written[k] {
+		s.reads[k] = value
+	}
+	return value, nil
+}
+
+// Put put the key-value entry to the wrapped storage.
+func (s *RecordingStorage) Put(key []byte, value []byte) error {
+	s.mu.Lock()
+	s.written[byteutils.Hex(key)] = true
+	s.mu.Unlock()
+
+	return s.storage.Put(key, value)
+}
+
+// Del delete the key in the wrapped storage.
+func (s *RecordingStorage) Del(key []byte) error {
+	s.mu.Lock()
+	s.written[byteutils.Hex(key)] = true
+	s.mu.Unlock()
+
+	return s.storage.Del(key)
+}
+
+// WriteBatch commits the batch to the wrapped storage.
+func (s *RecordingStorage) WriteBatch(batch *Batch) error {
+	s.mu.Lock()
+	batch.Replay(func(key []byte, value []byte) error {
+		s.written[byteutils.Hex(key)] = true
+		return nil
+	}, func(key []byte) error {
+		s.written[byteutils.Hex(key)] = true
+		return nil
+	})
+	s.mu.Unlock()
+
+	return s.storage.WriteBatch(batch)
+}
+
+// Reads return the entries read, keyed by the hex string of the keys.
+func (s *RecordingStorage) Reads() map[string][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reads := make(map[string][]byte, len(s.reads))
+	for k, v := range s.reads {
+		reads[k] = v
+	}
+	return reads
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordingStorage(t *testing.T) {
+	base, _ := NewMemoryStorage()
+	base.Put([]byte("a"), []byte("1"))
+	base.Put([]byte("b"), []byte("2"))
+
+	s := NewRecordingStorage(base)
+	v, err := s.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), v)
+	_, err = s.Get([]byte("x"))
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	// entries written before are not recorded when read.
+	assert.Nil(t, s.Put([]byte("c"), []byte("3")))
+	assert.Nil(t, s.Del([]byte("b")))
+	v, _ = s.Get([]byte("c"))
+	assert.Equal(t, []byte("3"), v)
+
+	assert.Equal(t, map[string][]byte{byteutils.Hex([]byte("a")): []byte("1")}, s.Reads())
+}
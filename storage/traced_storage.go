@@ -0,0 +1,70 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TracedStorage records a span for each read of the wrapped storage.
+type TracedStorage struct {
+	storage Storage
+}
+
+// NewTracedStorage wrap the storage with tracing.
+func NewTracedStorage(storage Storage) *TracedStorage {
+	return &TracedStorage{storage: storage}
+}
+
+// Get return value to the key in the wrapped storage.
+func (s *TracedStorage) Get(key []byte) ([]byte, error) {
+	_, span := tracing.Start(context.Background(), "storage.get",
+		attribute.Int("key.size", len(key)),
+	)
+
+	value, err := s.storage.Get(key)
+	span.SetAttributes(
+		attribute.Bool("found", err == nil),
+		attribute.Int("value.size", len(value)),
+	)
+	if err == ErrKeyNotFound {
+		span.End()
+	} else {
+		tracing.End(span, err)
+	}
+	return value, err
+}
+
+// Put put the key-value entry to the wrapped storage.
+func (s *TracedStorage) Put(key []byte, value []byte) error {
+	return s.storage.Put(key, value)
+}
+
+// Del delete the key in the wrapped storage.
+func (s *TracedStorage) Del(key []byte) error {
+	return s.storage.Del(key)
+}
+
+// WriteBatch commits the batch to the wrapped storage.
+func (s *TracedStorage) WriteBatch(batch *Batch) error {
+	return s.storage.WriteBatch(batch)
+}
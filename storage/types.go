@@ -35,4 +35,7 @@ type Storage interface {
 
 	// Del delete the key entry in Storage.
 	Del(key []byte) error
+
+	// WriteBatch commits the writes of the batch to Storage atomically.
+	WriteBatch(batch *Batch) error
 }
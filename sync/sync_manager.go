@@ -19,6 +19,8 @@
 package sync
 
 import (
+	"context"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/gogo/protobuf/proto"
@@ -34,6 +36,13 @@ import (
 // const
 const (
 	DescendantCount = 10
+
+	// ReplyTimeout is the max time spent on replying a sync request.
+	ReplyTimeout = 10 * time.Second
+
+	// netBlockField is the number of the block field of NetBlock and of the
+	// blocks field of NetBlocks.
+	netBlockField = 3
 )
 
 var (
@@ -55,6 +64,7 @@ type Manager struct {
 	curTail                *core.Block
 	canSyncWithBlockListCh chan bool
 	goParentSyncCh         chan bool
+	paused                 int32
 }
 
 // NewManager new sync manager
@@ -72,6 +82,7 @@ func NewManager(blockChain *core.BlockChain, consensus consensus.Consensus, ns p
 		blockChain.TailBlock(),
 		make(chan bool, 1),
 		make(chan bool, 1),
+		0,
 	}
 	m.RegisterSyncBlockInNetwork(ns)
 	m.RegisterSyncReplyInNetwork(ns)
@@ -114,6 +125,25 @@ func (m *Manager) Start() {
 	}
 }
 
+// Pause drops sync replies so no more blocks are downloaded, e.g. when the disk is almost full.
+func (m *Manager) Pause() {
+	if atomic.CompareAndSwapInt32(&m.paused, 0, 1) {
+		logging.CLog().Warn("Pause sync.")
+	}
+}
+
+// Resume handling sync replies.
+func (m *Manager) Resume() {
+	if atomic.CompareAndSwapInt32(&m.paused, 1, 0) {
+		logging.CLog().Info("Resume sync.")
+	}
+}
+
+// Paused returns whether sync is paused.
+func (m *Manager) Paused() bool {
+	return atomic.LoadInt32(&m.paused) == 1
+}
+
 func (m *Manager) startSync() {
 	go m.loop()
 	m.syncWithPeers(m.curTail)
@@ -197,109 +227,128 @@ func (m *Manager) startMsgHandle() {
 		for {
 			select {
 			case msg := <-m.receiveTailCh:
-				if m.ns.Node().GetSynchronizing() {
-					logging.VLog().Warn("node can not reply sync message when it is synchronizing")
-					continue
-				}
-				// 1.find the common ancestors
-				// 2.find 10 blocks after ancestors if exist
-				tail := new(NetBlock)
-				pbblock := new(corepb.NetBlock)
-				if err := pb.Unmarshal(msg.Data().([]byte), pbblock); err != nil {
-					logging.VLog().Error("StartMsgHandle.receiveTailCh: unmarshal data occurs error, ", err)
-					continue
-				}
-				if err := tail.FromProto(pbblock); err != nil {
-					logging.VLog().Error("StartMsgHandle.receiveTailCh: get block from proto occurs error: ", err)
-					continue
-				}
+				p2p.ServeMessage(m.ns, "sync.handleTail", p2p.ServeBulk, msg, m.handleTail)
+			case msg := <-m.receiveSyncReplyCh:
+				p2p.HandleMessage(m.ns, "sync.handleSyncReply", msg, m.handleSyncReply)
+			}
+		}
+	})()
+}
 
-				key := m.ns.Node().ID()
+func (m *Manager) handleTail(msg net.Message) {
+	if m.ns.Node().GetSynchronizing() {
+		logging.VLog().Warn("node can not reply sync message when it is synchronizing")
+		return
+	}
+	// 1.find the common ancestors
+	// 2.find 10 blocks after ancestors if exist
+	tail := new(NetBlock)
+	pbblock := new(corepb.NetBlock)
+	if err := core.CheckEmbeddedBlockEncoding(msg.Data().([]byte), netBlockField); err != nil {
+		logging.VLog().Error("StartMsgHandle.receiveTailCh: check block encoding occurs error, ", err)
+		return
+	}
+	if err := pb.Unmarshal(msg.Data().([]byte), pbblock); err != nil {
+		logging.VLog().Error("StartMsgHandle.receiveTailCh: unmarshal data occurs error, ", err)
+		return
+	}
+	if err := tail.FromProto(pbblock); err != nil {
+		logging.VLog().Error("StartMsgHandle.receiveTailCh: get block from proto occurs error: ", err)
+		return
+	}
 
-				ancestor, err := m.blockChain.FindCommonAncestorWithTail(tail.block)
-				var emptyblocks []*core.Block
-				if err != nil {
-					logging.VLog().Error("StartMsgHandle.receiveTailCh: find common ancestor with tail occurs error, ", err)
-					netblocks := NewNetBlocks(key, tail.batch, emptyblocks)
-					m.ns.SendSyncReply(tail.from, netblocks)
-					continue
-				}
-				subsequentBlocks, err := m.blockChain.FetchDescendantInCanonicalChain(DescendantCount, ancestor)
-				if err != nil {
-					logging.VLog().Error("StartMsgHandle.receiveTailCh: FetchDescendantInCanonicalChain occurs error, ", err)
-					netblocks := NewNetBlocks(key, tail.batch, emptyblocks)
-					m.ns.SendSyncReply(tail.from, netblocks)
-					continue
-				}
-				subsequentBlocks = append(subsequentBlocks, ancestor)
-				blocks := NewNetBlocks(key, tail.batch, subsequentBlocks)
-				logging.VLog().WithFields(logrus.Fields{
-					"from":   blocks.from,
-					"batch":  blocks.batch,
-					"blocks": blocks.blocks,
-				}).Info("StartMsgHandle.receiveTailCh: receive receiveTailCh message.")
-				m.ns.SendSyncReply(tail.from, blocks)
+	key := m.ns.Node().ID()
 
-			case msg := <-m.receiveSyncReplyCh:
-				// 1. compare the common ancestors, if over n+1 are the same, suppose the ancestor is the right ancestor
-				// 2. find overlapping blocks in 10 blocks who has the same ancestors
-				// 3. give the overlapping blocks to block pool one by one, if return false, go to next sync.
-				// 4. if all remote peers return the number of blocks less than 10, end sync
-				data := new(NetBlocks)
-				pbblocks := new(corepb.NetBlocks)
-				if err := pb.Unmarshal(msg.Data().([]byte), pbblocks); err != nil {
-					logging.VLog().Error("StartMsgHandle.receiveSyncReplyCh: unmarshal data occurs error, ", err)
-					continue
-				}
-				if err := data.FromProto(pbblocks); err != nil {
-					logging.VLog().Error("StartMsgHandle.receiveSyncReplyCh: get blocks from proto occurs error: ", err)
-					continue
-				}
-
-				blocks := data.Blocks()
+	ctx, cancel := context.WithTimeout(context.Background(), ReplyTimeout)
+	ancestor, err := m.blockChain.FindCommonAncestorWithTail(ctx, tail.block)
+	var emptyblocks []*core.Block
+	if err != nil {
+		cancel()
+		logging.VLog().Error("StartMsgHandle.receiveTailCh: find common ancestor with tail occurs error, ", err)
+		netblocks := NewNetBlocks(key, tail.batch, emptyblocks)
+		m.ns.SendSyncReply(tail.from, netblocks)
+		return
+	}
+	subsequentBlocks, err := m.blockChain.FetchDescendantInCanonicalChain(ctx, DescendantCount, ancestor)
+	cancel()
+	if err != nil {
+		logging.VLog().Error("StartMsgHandle.receiveTailCh: FetchDescendantInCanonicalChain occurs error, ", err)
+		netblocks := NewNetBlocks(key, tail.batch, emptyblocks)
+		m.ns.SendSyncReply(tail.from, netblocks)
+		return
+	}
+	subsequentBlocks = append(subsequentBlocks, ancestor)
+	blocks := NewNetBlocks(key, tail.batch, subsequentBlocks)
+	logging.VLog().WithFields(logrus.Fields{
+		"from":   blocks.from,
+		"batch":  blocks.batch,
+		"blocks": blocks.blocks,
+	}).Info("StartMsgHandle.receiveTailCh: receive receiveTailCh message.")
+	m.ns.SendSyncReply(tail.from, blocks)
+}
 
-				if data.batch < batch {
-					logging.VLog().WithFields(logrus.Fields{
-						"from":       data.from,
-						"blocks":     data.Blocks(),
-						"data.batch": data.batch,
-						"batch":      batch,
-					}).Info("batch is error")
-					continue
-				}
+func (m *Manager) handleSyncReply(msg net.Message) {
+	if m.Paused() {
+		logging.VLog().Warn("sync is paused, drop the sync reply message")
+		return
+	}
+	// 1. compare the common ancestors, if over n+1 are the same, suppose the ancestor is the right ancestor
+	// 2. find overlapping blocks in 10 blocks who has the same ancestors
+	// 3. give the overlapping blocks to block pool one by one, if return false, go to next sync.
+	// 4. if all remote peers return the number of blocks less than 10, end sync
+	data := new(NetBlocks)
+	pbblocks := new(corepb.NetBlocks)
+	if err := core.CheckEmbeddedBlockEncoding(msg.Data().([]byte), netBlockField); err != nil {
+		logging.VLog().Error("StartMsgHandle.receiveSyncReplyCh: check blocks encoding occurs error, ", err)
+		return
+	}
+	if err := pb.Unmarshal(msg.Data().([]byte), pbblocks); err != nil {
+		logging.VLog().Error("StartMsgHandle.receiveSyncReplyCh: unmarshal data occurs error, ", err)
+		return
+	}
+	if err := data.FromProto(pbblocks); err != nil {
+		logging.VLog().Error("StartMsgHandle.receiveSyncReplyCh: get blocks from proto occurs error: ", err)
+		return
+	}
 
-				if len(blocks) == 0 {
-					msgErrCount++
-					logging.VLog().WithFields(logrus.Fields{
-						"from":       data.from,
-						"blocks":     blocks,
-						"data.batch": data.batch,
-						"batch":      batch,
-					}).Info("Reveived sync reply message is wrong")
-
-					if msgErrCount >= p2p.LimitToSync/2 {
-						// go to next sync
-						msgErrCount = 0
-						m.goParentSyncCh <- true
-					}
-				}
+	blocks := data.Blocks()
 
-				logging.VLog().WithFields(logrus.Fields{
-					"from":       data.from,
-					"blocks":     blocks,
-					"data.batch": data.batch,
-					"batch":      batch,
-				}).Info("Reveived sync reply message")
-
-				if len(blocks) > 0 && len(m.cacheList) < p2p.LimitToSync {
-					m.checkSyncLimitHandler(data)
-				} else {
-					continue
-				}
+	if data.batch < batch {
+		logging.VLog().WithFields(logrus.Fields{
+			"from":       data.from,
+			"blocks":     data.Blocks(),
+			"data.batch": data.batch,
+			"batch":      batch,
+		}).Info("batch is error")
+		return
+	}
 
-			}
+	if len(blocks) == 0 {
+		msgErrCount++
+		logging.VLog().WithFields(logrus.Fields{
+			"from":       data.from,
+			"blocks":     blocks,
+			"data.batch": data.batch,
+			"batch":      batch,
+		}).Info("Reveived sync reply message is wrong")
+
+		if msgErrCount >= p2p.LimitToSync/2 {
+			// go to next sync
+			msgErrCount = 0
+			m.goParentSyncCh <- true
 		}
-	})()
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"from":       data.from,
+		"blocks":     blocks,
+		"data.batch": data.batch,
+		"batch":      batch,
+	}).Info("Reveived sync reply message")
+
+	if len(blocks) > 0 && len(m.cacheList) < p2p.LimitToSync {
+		m.checkSyncLimitHandler(data)
+	}
 }
 
 func (m *Manager) checkSyncLimitHandler(data *NetBlocks) {
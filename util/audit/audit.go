@@ -0,0 +1,240 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Actions recorded in the audit log.
+const (
+	ActionKeyUnlock    = "key.unlock"
+	ActionKeyLock      = "key.lock"
+	ActionSignTx       = "key.signTransaction"
+	ActionSignBlock    = "key.signBlock"
+	ActionSignSnapshot = "key.signSnapshot"
+	ActionPeerBan      = "peer.ban"
+	ActionAdminRPC     = "admin.rpc"
+	ActionRPCDenied    = "rpc.denied"
+	ActionConfigChange = "config.change"
+)
+
+// OriginNode is the origin of operations initiated by the node itself.
+const OriginNode = "node"
+
+// Errors
+var (
+	ErrBrokenChain = errors.New("audit log hash chain is broken")
+)
+
+// Entry is a record of the audit log, chained to the previous one by its hash.
+type Entry struct {
+	Seq      uint64 `json:"seq"`
+	Time     string `json:"time"`
+	Action   string `json:"action"`
+	Origin   string `json:"origin"`
+	Subject  string `json:"subject"`
+	Detail   string `json:"detail,omitempty"`
+	Err      string `json:"err,omitempty"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// computeHash returns the hash of the entry content and its previous hash.
+func (e *Entry) computeHash() (string, error) {
+	c := *e
+	c.Hash = ""
+	bytes, err := json.Marshal(&c)
+	if err != nil {
+		return "", err
+	}
+	return byteutils.Hex(hash.Sha3256(bytes)), nil
+}
+
+// Log is an append-only, hash-chained audit log file.
+type Log struct {
+	mu       sync.Mutex
+	file     *os.File
+	seq      uint64
+	lastHash string
+	now      func() time.Time
+}
+
+// Open the audit log at path, appending after the verified existing entries.
+func Open(path string) (*Log, error) {
+	log := &Log{now: time.Now}
+
+	if f, err := os.Open(path); err == nil {
+		last, err := Verify(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		if last != nil {
+			log.seq = last.Seq
+			log.lastHash = last.Hash
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	log.file = file
+	return log, nil
+}
+
+// Record appends an entry, the write is synced to disk before returning.
+func (l *Log) Record(action, origin, subject, detail string, opErr error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry := &Entry{
+		Seq:      l.seq + 1,
+		Time:     l.now().UTC().Format(time.RFC3339Nano),
+		Action:   action,
+		Origin:   origin,
+		Subject:  subject,
+		Detail:   detail,
+		PrevHash: l.lastHash,
+	}
+	if opErr != nil {
+		entry.Err = opErr.Error()
+	}
+	h, err := entry.computeHash()
+	if err != nil {
+		return err
+	}
+	entry.Hash = h
+
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(append(bytes, '\n')); err != nil {
+		return err
+	}
+	if err := l.file.Sync(); err != nil {
+		return err
+	}
+	l.seq = entry.Seq
+	l.lastHash = entry.Hash
+	return nil
+}
+
+// Close the audit log.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// Verify checks the hash chain of an exported audit log, returns the last entry.
+func Verify(r io.Reader) (*Entry, error) {
+	var last *Entry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		entry := new(Entry)
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, err
+		}
+		h, err := entry.computeHash()
+		if err != nil {
+			return nil, err
+		}
+		if h != entry.Hash {
+			return nil, ErrBrokenChain
+		}
+		if last == nil && (entry.Seq != 1 || entry.PrevHash != "") {
+			return nil, ErrBrokenChain
+		}
+		if last != nil && (entry.Seq != last.Seq+1 || entry.PrevHash != last.Hash) {
+			return nil, ErrBrokenChain
+		}
+		last = entry
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return last, nil
+}
+
+var (
+	defaultLog *Log
+	defaultMu  sync.RWMutex
+)
+
+// Init opens the default audit log used by Record.
+func Init(path string) error {
+	log, err := Open(path)
+	if err != nil {
+		return err
+	}
+	defaultMu.Lock()
+	defaultLog = log
+	defaultMu.Unlock()
+
+	logging.CLog().WithFields(logrus.Fields{
+		"path": path,
+		"seq":  log.seq,
+	}).Info("Opened audit log.")
+	return nil
+}
+
+// Close the default audit log.
+func Close() error {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if defaultLog == nil {
+		return nil
+	}
+	err := defaultLog.Close()
+	defaultLog = nil
+	return err
+}
+
+// Record appends an entry to the default audit log, it's a no-op if audit log is disabled.
+func Record(action, origin, subject, detail string, opErr error) {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	if defaultLog == nil {
+		return
+	}
+	if err := defaultLog.Record(action, origin, subject, detail, opErr); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"action":  action,
+			"subject": subject,
+			"err":     err,
+		}).Error("Failed to write audit log.")
+	}
+}
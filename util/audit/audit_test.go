@@ -0,0 +1,102 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	log, err := Open(path)
+	assert.Nil(t, err)
+	assert.Nil(t, log.Record(ActionKeyUnlock, OriginNode, "addr", "1s", nil))
+	assert.Nil(t, log.Record(ActionSignTx, OriginNode, "addr", "hash", errors.New("locked")))
+	assert.Nil(t, log.Close())
+
+	// reopen appends to the chain.
+	log, err = Open(path)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), log.seq)
+	assert.Nil(t, log.Record(ActionPeerBan, OriginNode, "peer", "", nil))
+	assert.Nil(t, log.Close())
+
+	raw, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	last, err := Verify(bytes.NewReader(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), last.Seq)
+	assert.Equal(t, ActionPeerBan, last.Action)
+
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	assert.Equal(t, 3, len(lines))
+	assert.Contains(t, lines[1], `"err":"locked"`)
+
+	// tampered entry.
+	tampered := strings.Replace(string(raw), `"subject":"peer"`, `"subject":"other"`, 1)
+	_, err = Verify(strings.NewReader(tampered))
+	assert.Equal(t, ErrBrokenChain, err)
+
+	// removed entry.
+	_, err = Verify(strings.NewReader(lines[0] + "\n" + lines[2] + "\n"))
+	assert.Equal(t, ErrBrokenChain, err)
+
+	// truncated head.
+	_, err = Verify(strings.NewReader(lines[1] + "\n" + lines[2] + "\n"))
+	assert.Equal(t, ErrBrokenChain, err)
+
+	// a tampered log is not reopened.
+	assert.Nil(t, ioutil.WriteFile(path, []byte(tampered), 0600))
+	_, err = Open(path)
+	assert.Equal(t, ErrBrokenChain, err)
+}
+
+func TestRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	// disabled.
+	Record(ActionKeyLock, OriginNode, "addr", "", nil)
+
+	assert.Nil(t, Init(path))
+	Record(ActionKeyLock, OriginNode, "addr", "", nil)
+	assert.Nil(t, Close())
+	Record(ActionKeyLock, OriginNode, "addr", "", nil)
+
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+	last, err := Verify(f)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), last.Seq)
+}
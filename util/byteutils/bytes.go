@@ -22,7 +22,21 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"hash/fnv"
+	"strings"
+)
+
+// HashLength is the bytes of a sha3-256 hash.
+const HashLength = 32
+
+// HexPrefix is the optional prefix of the hex strings parsed by ParseHex.
+const HexPrefix = "0x"
+
+// Errors of the strict parsing
+var (
+	ErrInvalidHex        = errors.New("invalid hex string")
+	ErrInvalidHashLength = errors.New("invalid hash length")
 )
 
 // Hash by Sha3-256
@@ -74,6 +88,33 @@ func FromHex(data string) ([]byte, error) {
 	return hex.DecodeString(data)
 }
 
+// ParseHex decodes a hex string of user input, with an optional HexPrefix.
+// The empty string and an odd count of digits are refused.
+func ParseHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(s, HexPrefix)
+	if len(s) == 0 || len(s)%2 != 0 {
+		return nil, ErrInvalidHex
+	}
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidHex
+	}
+	return data, nil
+}
+
+// ParseHash decodes the hex string of a hash of user input, it must be
+// HashLength bytes.
+func ParseHash(s string) (Hash, error) {
+	data, err := ParseHex(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != HashLength {
+		return nil, ErrInvalidHashLength
+	}
+	return Hash(data), nil
+}
+
 // Uint64 encodes []byte.
 func Uint64(data []byte) uint64 {
 	return binary.BigEndian.Uint64(data)
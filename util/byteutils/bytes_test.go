@@ -131,6 +131,38 @@ func TestFromHex(t *testing.T) {
 	}
 }
 
+func TestParseHash(t *testing.T) {
+	hash := "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a"
+	want := Hash{167, 255, 198, 248, 191, 30, 215, 102, 81, 193, 71, 86, 160, 97, 214, 98, 245, 128, 255, 77, 228, 59, 73, 250, 130, 216, 10, 75, 128, 248, 67, 74}
+	tests := []struct {
+		name    string
+		s       string
+		wantErr error
+	}{
+		{"hash", hash, nil},
+		{"prefixed", "0x" + hash, nil},
+		{"upper case", "A7FFC6F8BF1ED76651C14756A061D662F580FF4DE43B49FA82D80A4B80F8434A", nil},
+		{"empty", "", ErrInvalidHex},
+		{"prefix only", "0x", ErrInvalidHex},
+		{"double prefix", "0x0x" + hash, ErrInvalidHex},
+		{"upper case prefix", "0X" + hash, ErrInvalidHex},
+		{"odd digits", hash[1:], ErrInvalidHex},
+		{"invalid digit", "z" + hash[1:], ErrInvalidHex},
+		{"space", " " + hash, ErrInvalidHex},
+		{"short", hash[2:], ErrInvalidHashLength},
+		{"long", hash + "00", ErrInvalidHashLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHash(tt.s)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
 func TestUint64(t *testing.T) {
 	type args struct {
 		data []byte
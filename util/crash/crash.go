@@ -0,0 +1,188 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package crash recovers panics of the goroutines handling untrusted input,
+// converts them into errors and writes a crash report of each of them, so one
+// malformed message or transaction can't take down the node.
+package crash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	recoveredPanicCounter = metrics.GetOrRegisterCounter("neb.panic.recovered", nil)
+)
+
+// Error is a recovered panic.
+type Error struct {
+	// Where is the name of the recovering function.
+	Where string
+	// Value is the value passed to panic.
+	Value interface{}
+	// Report is the path of the crash report, empty if it isn't written.
+	Report string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("recovered panic in %s: %v", e.Where, e.Value)
+}
+
+// IsPanic returns whether err is a recovered panic.
+func IsPanic(err error) bool {
+	_, ok := err.(*Error)
+	return ok
+}
+
+// Report is the crash report of a recovered panic.
+type Report struct {
+	Time    string            `json:"time"`
+	Where   string            `json:"where"`
+	Panic   string            `json:"panic"`
+	Stack   string            `json:"stack"`
+	Context map[string]string `json:"context,omitempty"`
+}
+
+// MaxReports is the max crash reports kept in the report directory, the
+// oldest are removed for the new ones, so repeated panics can't fill the disk.
+const MaxReports = 100
+
+const reportPrefix = "panic_"
+
+var (
+	reportDir string
+	reportMu  sync.RWMutex
+)
+
+// Init sets the directory the crash reports are written to, they are only
+// logged if dir is empty.
+func Init(dir string) error {
+	if len(dir) > 0 {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+	reportMu.Lock()
+	reportDir = dir
+	reportMu.Unlock()
+	return nil
+}
+
+// Recover converts a panic of the calling goroutine into an *Error set to err
+// and writes its crash report with the context in fields. It must be deferred
+// directly, err may be nil if the caller has no error to return:
+//
+//	defer crash.Recover("core.tx.execute", logrus.Fields{"tx": tx}, &err)
+func Recover(where string, fields logrus.Fields, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	recoveredPanicCounter.Inc(1)
+
+	e := &Error{Where: where, Value: r}
+	report := &Report{
+		Time:    time.Now().UTC().Format(time.RFC3339Nano),
+		Where:   where,
+		Panic:   fmt.Sprintf("%v", r),
+		Stack:   string(debug.Stack()),
+		Context: make(map[string]string, len(fields)),
+	}
+	for k, v := range fields {
+		report.Context[k] = fmt.Sprintf("%v", v)
+	}
+
+	path, werr := write(report)
+	if werr != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"where": where,
+			"err":   werr,
+		}).Error("Failed to write crash report.")
+	}
+	e.Report = path
+
+	logging.CLog().WithFields(fields).WithFields(logrus.Fields{
+		"where":  where,
+		"panic":  report.Panic,
+		"report": path,
+		"stack":  report.Stack,
+	}).Error("Recovered a panic.")
+
+	if err != nil {
+		*err = e
+	}
+}
+
+// write writes the report into the report directory, returns its path.
+func write(report *Report) (string, error) {
+	reportMu.Lock()
+	defer reportMu.Unlock()
+	dir := reportDir
+	if len(dir) == 0 {
+		return "", nil
+	}
+
+	bytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s%d_%s.json", reportPrefix, time.Now().UnixNano(), strings.Replace(report.Where, "/", "_", -1))
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, bytes, 0600); err != nil {
+		return "", err
+	}
+	if err := rotate(dir); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"dir": dir,
+			"err": err,
+		}).Error("Failed to remove old crash reports.")
+	}
+	return path, nil
+}
+
+// rotate removes the oldest reports beyond MaxReports, the names of the
+// reports sort by the time they are written.
+func rotate(dir string) error {
+	names, err := filepath.Glob(filepath.Join(dir, reportPrefix+"*.json"))
+	if err != nil {
+		return err
+	}
+	if len(names) <= MaxReports {
+		return nil
+	}
+	sort.Strings(names)
+	for _, name := range names[:len(names)-MaxReports] {
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package crash
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func panicking(f func()) (err error) {
+	defer Recover("crash.test", logrus.Fields{"height": 7}, &err)
+	f()
+	return nil
+}
+
+func notPanicking() (err error) {
+	defer Recover("crash.test", nil, &err)
+	return errors.New("not a panic")
+}
+
+func TestRecover(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crash")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, Init(dir))
+	defer Init("")
+
+	err = panicking(func() { panic("boom") })
+	assert.True(t, IsPanic(err))
+	e := err.(*Error)
+	assert.Equal(t, "crash.test", e.Where)
+	assert.Equal(t, "boom", e.Value)
+	assert.True(t, strings.HasPrefix(e.Report, dir))
+
+	raw, err := ioutil.ReadFile(e.Report)
+	assert.Nil(t, err)
+	report := new(Report)
+	assert.Nil(t, json.Unmarshal(raw, report))
+	assert.Equal(t, "crash.test", report.Where)
+	assert.Equal(t, "boom", report.Panic)
+	assert.Equal(t, "7", report.Context["height"])
+	assert.Contains(t, report.Stack, "panicking")
+
+	// a runtime error is recovered as well.
+	var m map[string]int
+	err = panicking(func() { m["a"] = 1 })
+	assert.True(t, IsPanic(err))
+
+	assert.Nil(t, panicking(func() {}))
+
+	err = notPanicking()
+	assert.NotNil(t, err)
+	assert.False(t, IsPanic(err))
+}
+
+func TestRecoverWithoutReportDir(t *testing.T) {
+	err := panicking(func() { panic("boom") })
+	assert.True(t, IsPanic(err))
+	assert.Equal(t, "", err.(*Error).Report)
+
+	func() {
+		defer Recover("crash.test", nil, nil)
+		panic("no error to set")
+	}()
+}
+
+func TestRecoverRotatesReports(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crash")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, Init(dir))
+	defer Init("")
+
+	var first, last string
+	for i := 0; i < MaxReports+5; i++ {
+		err := panicking(func() { panic("boom") }).(*Error)
+		if i == 0 {
+			first = err.Report
+		}
+		last = err.Report
+	}
+	infos, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, MaxReports, len(infos))
+	_, err = os.Stat(first)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(last)
+	assert.Nil(t, err)
+}
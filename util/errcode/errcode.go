@@ -0,0 +1,87 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package errcode
+
+// Code is the machine-readable code of an error, unique across modules.
+// Each module owns a range of codes: core 1000-1999, state 2000-2999, rpc 3000-3999.
+type Code uint32
+
+// CodeUnknown is the code of errors without a taxonomy.
+const CodeUnknown Code = 0
+
+// Module names
+const (
+	ModuleCore  = "core"
+	ModuleState = "state"
+	ModuleRPC   = "rpc"
+)
+
+// Error is an error with code, module and retryable flag.
+type Error struct {
+	code      Code
+	module    string
+	retryable bool
+	msg       string
+}
+
+// New create a new #Error.
+func New(module string, code Code, msg string, retryable bool) *Error {
+	return &Error{
+		code:      code,
+		module:    module,
+		retryable: retryable,
+		msg:       msg,
+	}
+}
+
+// Error return the message of error.
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// Code return the code of error.
+func (e *Error) Code() Code {
+	return e.code
+}
+
+// Module return the module raising the error.
+func (e *Error) Module() string {
+	return e.module
+}
+
+// Retryable return whether the same request may succeed later.
+func (e *Error) Retryable() bool {
+	return e.retryable
+}
+
+// CodeOf return the code of err, CodeUnknown if err has no code.
+func CodeOf(err error) Code {
+	if e, ok := err.(*Error); ok {
+		return e.code
+	}
+	return CodeUnknown
+}
+
+// IsRetryable return whether err is retryable.
+func IsRetryable(err error) bool {
+	if e, ok := err.(*Error); ok {
+		return e.retryable
+	}
+	return false
+}
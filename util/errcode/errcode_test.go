@@ -0,0 +1,43 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package errcode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError(t *testing.T) {
+	err := New(ModuleCore, 1001, "insufficient balance", false)
+	assert.Equal(t, "insufficient balance", err.Error())
+	assert.Equal(t, Code(1001), err.Code())
+	assert.Equal(t, ModuleCore, err.Module())
+	assert.Equal(t, Code(1001), CodeOf(err))
+	assert.False(t, IsRetryable(err))
+
+	err = New(ModuleCore, 1002, "nonce too large", true)
+	assert.True(t, IsRetryable(err))
+
+	plain := errors.New("plain")
+	assert.Equal(t, CodeUnknown, CodeOf(plain))
+	assert.False(t, IsRetryable(plain))
+	assert.False(t, IsRetryable(nil))
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package sched separates the consensus work from the best-effort work of
+// the node. The goroutines can't be pinned to CPUs, instead the best-effort
+// work, i.e. the rpc requests, is admitted by a quota of the GOMAXPROCS which
+// is lowered while blocks are produced or verified, so a heavy query load
+// can't make the validator miss its slot.
+package sched
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// Default quotas, in percent of GOMAXPROCS.
+const (
+	DefaultQuota     = 75
+	DefaultBusyQuota = 25
+)
+
+var (
+	criticalGauge     = metrics.GetOrRegisterGauge("neb.sched.critical", nil)
+	bestEffortGauge   = metrics.GetOrRegisterGauge("neb.sched.besteffort", nil)
+	bestEffortWaitCnt = metrics.GetOrRegisterCounter("neb.sched.besteffort.wait", nil)
+)
+
+// Scheduler admits the best-effort work by the lane quotas.
+type Scheduler struct {
+	mu sync.Mutex
+	// quota and busyQuota are the best-effort work admitted when no
+	// critical work runs and while some does.
+	quota, busyQuota int
+
+	critical, bestEffort int
+	// changed is closed and replaced when a slot may be free.
+	changed chan struct{}
+}
+
+// New returns a scheduler of the quotas in percent of GOMAXPROCS, at least
+// one best-effort work is always admitted.
+func New(quota, busyQuota int) *Scheduler {
+	s := &Scheduler{changed: make(chan struct{})}
+	s.SetQuota(quota, busyQuota)
+	return s
+}
+
+// SetQuota sets the quotas in percent of GOMAXPROCS, the defaults if 0.
+func (s *Scheduler) SetQuota(quota, busyQuota int) {
+	if quota <= 0 {
+		quota = DefaultQuota
+	}
+	if busyQuota <= 0 || busyQuota > quota {
+		busyQuota = min(DefaultBusyQuota, quota)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quota = slots(quota)
+	s.busyQuota = slots(busyQuota)
+	s.notify()
+}
+
+func slots(percent int) int {
+	n := runtime.GOMAXPROCS(0) * percent / 100
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// notify wakes the waiting best-effort work, called with the lock held.
+func (s *Scheduler) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// Critical marks consensus work running until done is called, it never waits.
+func (s *Scheduler) Critical() (done func()) {
+	s.mu.Lock()
+	s.critical++
+	criticalGauge.Update(int64(s.critical))
+	s.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.critical--
+			criticalGauge.Update(int64(s.critical))
+			s.notify()
+		})
+	}
+}
+
+// BestEffort waits for a slot of the best-effort lane, which is released when
+// done is called. It returns the error of ctx if done before.
+func (s *Scheduler) BestEffort(ctx context.Context) (done func(), err error) {
+	waited := false
+	for {
+		s.mu.Lock()
+		limit := s.quota
+		if s.critical > 0 {
+			limit = s.busyQuota
+		}
+		if s.bestEffort < limit {
+			s.bestEffort++
+			bestEffortGauge.Update(int64(s.bestEffort))
+			s.mu.Unlock()
+			break
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		if !waited {
+			waited = true
+			bestEffortWaitCnt.Inc(1)
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.bestEffort--
+			bestEffortGauge.Update(int64(s.bestEffort))
+			s.notify()
+		})
+	}, nil
+}
+
+var defaultScheduler = New(DefaultQuota, DefaultBusyQuota)
+
+// SetQuota sets the quotas of the scheduler of the process.
+func SetQuota(quota, busyQuota int) {
+	defaultScheduler.SetQuota(quota, busyQuota)
+}
+
+// Critical marks consensus work running on the scheduler of the process.
+func Critical() (done func()) {
+	return defaultScheduler.Critical()
+}
+
+// BestEffort waits for a best-effort slot of the scheduler of the process.
+func BestEffort(ctx context.Context) (done func(), err error) {
+	return defaultScheduler.BestEffort(ctx)
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sched
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	s := New(DefaultQuota, DefaultBusyQuota)
+	s.quota, s.busyQuota = 2, 1
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	first, err := s.BestEffort(ctx)
+	assert.Nil(t, err)
+	second, err := s.BestEffort(ctx)
+	assert.Nil(t, err)
+	_, err = s.BestEffort(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// the critical work lowers the quota, the released slot isn't admitted.
+	critical := s.Critical()
+	first()
+	first()
+	waiting := make(chan func())
+	go func() {
+		done, _ := s.BestEffort(context.Background())
+		waiting <- done
+	}()
+	select {
+	case <-waiting:
+		t.Fatal("admitted over the busy quota")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	critical()
+	done := <-waiting
+	done()
+	second()
+	assert.Equal(t, 0, s.bestEffort)
+	assert.Equal(t, 0, s.critical)
+}
+
+func TestSetQuota(t *testing.T) {
+	s := New(0, 0)
+	assert.Equal(t, slots(DefaultQuota), s.quota)
+	assert.Equal(t, slots(DefaultBusyQuota), s.busyQuota)
+
+	s.SetQuota(10, 50)
+	assert.Equal(t, slots(10), s.busyQuota)
+	assert.True(t, s.busyQuota <= s.quota)
+}
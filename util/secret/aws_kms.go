@@ -0,0 +1,168 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Environment variables holding the AWS credentials.
+const (
+	AwsAccessKeyIDEnv     = "AWS_ACCESS_KEY_ID"
+	AwsSecretAccessKeyEnv = "AWS_SECRET_ACCESS_KEY"
+	AwsSessionTokenEnv    = "AWS_SESSION_TOKEN"
+)
+
+const (
+	awsKmsService  = "kms"
+	awsTimeFormat  = "20060102T150405Z"
+	awsDateFormat  = "20060102"
+	awsSigningAlgo = "AWS4-HMAC-SHA256"
+)
+
+// ErrMissingAwsCredentials throws when the AWS credentials are not in the environment.
+var ErrMissingAwsCredentials = errors.New("missing aws credentials in environment")
+
+// AwsKmsProvider decrypts a secret with AWS KMS.
+type AwsKmsProvider struct {
+	region     string
+	ciphertext string
+	endpoint   string
+	client     *http.Client
+	now        func() time.Time
+}
+
+// NewAwsKmsProvider create an aws kms provider decrypting the base64 ciphertext blob.
+func NewAwsKmsProvider(region, ciphertext, endpoint string) *AwsKmsProvider {
+	if len(endpoint) == 0 {
+		endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com", region)
+	}
+	return &AwsKmsProvider{
+		region:     region,
+		ciphertext: ciphertext,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		client:     &http.Client{Timeout: DefaultTimeout},
+		now:        time.Now,
+	}
+}
+
+// Secret returns the decrypted secret.
+func (p *AwsKmsProvider) Secret(ctx context.Context) ([]byte, error) {
+	accessKey, secretKey := os.Getenv(AwsAccessKeyIDEnv), os.Getenv(AwsSecretAccessKeyEnv)
+	if len(accessKey) == 0 || len(secretKey) == 0 {
+		return nil, ErrMissingAwsCredentials
+	}
+
+	body, err := json.Marshal(map[string]string{"CiphertextBlob": p.ciphertext})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", p.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService.Decrypt")
+	if token := os.Getenv(AwsSessionTokenEnv); len(token) > 0 {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+	signV4(req, body, awsKmsService, p.region, accessKey, secretKey, p.now())
+
+	var resp struct {
+		Plaintext string `json:"Plaintext"`
+	}
+	if err := doJSON(p.client, req, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
+
+// signV4 signs the request with AWS signature version 4, all headers of the request are signed.
+func signV4(req *http.Request, body []byte, service, region, accessKey, secretKey string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(awsTimeFormat)
+	date := now.Format(awsDateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	canonicalHeaders := new(bytes.Buffer)
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if len(path) == 0 {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSha256(body),
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{awsSigningAlgo, amzDate, scope, hexSha256([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSha256([]byte("AWS4"+secretKey), date)
+	key = hmacSha256(key, region)
+	key = hmacSha256(key, service)
+	key = hmacSha256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsSigningAlgo, accessKey, scope, signedHeaders, signature))
+}
+
+func hexSha256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	defaultGcpKmsEndpoint = "https://cloudkms.googleapis.com"
+
+	// GcpAccessTokenEnv is the environment variable holding an oauth2 access token,
+	// the token of the instance service account is used if it's unset.
+	GcpAccessTokenEnv = "GOOGLE_OAUTH_ACCESS_TOKEN"
+)
+
+var (
+	gcpMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+// GcpKmsProvider decrypts a secret with a Google Cloud KMS crypto key.
+type GcpKmsProvider struct {
+	keyName    string
+	ciphertext string
+	endpoint   string
+	client     *http.Client
+}
+
+// NewGcpKmsProvider create a gcp kms provider decrypting the base64 ciphertext.
+func NewGcpKmsProvider(keyName, ciphertext, endpoint string) *GcpKmsProvider {
+	if len(endpoint) == 0 {
+		endpoint = defaultGcpKmsEndpoint
+	}
+	return &GcpKmsProvider{
+		keyName:    strings.TrimPrefix(keyName, "/"),
+		ciphertext: ciphertext,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		client:     &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Secret returns the decrypted secret.
+func (p *GcpKmsProvider) Secret(ctx context.Context) ([]byte, error) {
+	token, err := p.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(map[string]string{"ciphertext": p.ciphertext})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", p.endpoint+"/v1/"+p.keyName+":decrypt", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp struct {
+		Plaintext string `json:"plaintext"`
+	}
+	if err := doJSON(p.client, req, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
+
+func (p *GcpKmsProvider) accessToken(ctx context.Context) (string, error) {
+	if token := os.Getenv(GcpAccessTokenEnv); len(token) > 0 {
+		return token, nil
+	}
+
+	req, err := http.NewRequest("GET", gcpMetadataTokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := doJSON(p.client, req, &resp); err != nil {
+		return "", err
+	}
+	return resp.AccessToken, nil
+}
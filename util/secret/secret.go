@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+// Errors
+var (
+	ErrUnknownProvider       = errors.New("unknown secret provider")
+	ErrMissingProviderConfig = errors.New("missing secret provider config")
+	ErrSecretNotFound        = errors.New("secret not found")
+)
+
+const (
+	// DefaultTimeout of a request to the secret provider.
+	DefaultTimeout = 10 * time.Second
+)
+
+// Provider retrieves a secret, e.g. a keystore passphrase, from a secret manager
+// so that it never has to be written in plaintext config files.
+type Provider interface {
+	Secret(ctx context.Context) ([]byte, error)
+}
+
+// NewProvider returns the provider of the config, nil if no provider is configured.
+func NewProvider(conf *nebletpb.SecretConfig) (Provider, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	switch conf.Provider {
+	case nebletpb.SecretConfig_None:
+		return nil, nil
+	case nebletpb.SecretConfig_Vault:
+		if conf.Vault == nil {
+			return nil, ErrMissingProviderConfig
+		}
+		return NewVaultProvider(conf.Vault.Address, conf.Vault.Path, conf.Vault.Field, conf.Vault.TokenEnv), nil
+	case nebletpb.SecretConfig_AwsKms:
+		if conf.AwsKms == nil {
+			return nil, ErrMissingProviderConfig
+		}
+		return NewAwsKmsProvider(conf.AwsKms.Region, conf.AwsKms.Ciphertext, conf.AwsKms.Endpoint), nil
+	case nebletpb.SecretConfig_GcpKms:
+		if conf.GcpKms == nil {
+			return nil, ErrMissingProviderConfig
+		}
+		return NewGcpKmsProvider(conf.GcpKms.KeyName, conf.GcpKms.Ciphertext, conf.GcpKms.Endpoint), nil
+	default:
+		return nil, ErrUnknownProvider
+	}
+}
+
+// doJSON sends the request and decodes a json response into out.
+func doJSON(client *http.Client, req *http.Request, out interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("secret provider responded %d: %s", resp.StatusCode, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProvider(t *testing.T) {
+	p, err := NewProvider(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, p)
+
+	_, err = NewProvider(&nebletpb.SecretConfig{Provider: nebletpb.SecretConfig_Vault})
+	assert.Equal(t, ErrMissingProviderConfig, err)
+
+	p, err = NewProvider(&nebletpb.SecretConfig{
+		Provider: nebletpb.SecretConfig_AwsKms,
+		AwsKms:   &nebletpb.AwsKmsSecretConfig{Region: "us-east-1"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://kms.us-east-1.amazonaws.com", p.(*AwsKmsProvider).endpoint)
+}
+
+func TestVaultProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/neb" || r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"data":{"data":{"passphrase":"passphrase"},"metadata":{"version":1}}}`))
+	}))
+	defer server.Close()
+
+	os.Setenv("TEST_VAULT_TOKEN", "token")
+	defer os.Unsetenv("TEST_VAULT_TOKEN")
+
+	secret, err := NewVaultProvider(server.URL, "/secret/data/neb", "", "TEST_VAULT_TOKEN").Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase"), secret)
+
+	_, err = NewVaultProvider(server.URL, "secret/data/neb", "other", "TEST_VAULT_TOKEN").Secret(context.Background())
+	assert.Equal(t, ErrSecretNotFound, err)
+
+	_, err = NewVaultProvider(server.URL, "secret/data/neb", "", "TEST_NO_TOKEN").Secret(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestAwsKmsProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		json.NewDecoder(r.Body).Decode(&req)
+		if r.Header.Get("X-Amz-Target") != "TrentService.Decrypt" || req["CiphertextBlob"] != "Y2lwaGVy" ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/20180101/us-east-1/kms/aws4_request") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"Plaintext":"` + base64.StdEncoding.EncodeToString([]byte("passphrase")) + `"}`))
+	}))
+	defer server.Close()
+
+	p := NewAwsKmsProvider("us-east-1", "Y2lwaGVy", server.URL)
+	p.now = func() time.Time { return time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	os.Unsetenv(AwsAccessKeyIDEnv)
+	_, err := p.Secret(context.Background())
+	assert.Equal(t, ErrMissingAwsCredentials, err)
+
+	os.Setenv(AwsAccessKeyIDEnv, "AKID")
+	os.Setenv(AwsSecretAccessKeyEnv, "secret")
+	defer os.Unsetenv(AwsAccessKeyIDEnv)
+	defer os.Unsetenv(AwsSecretAccessKeyEnv)
+
+	secret, err := p.Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase"), secret)
+}
+
+// TestSignV4 uses the get-vanilla case of the AWS signature v4 test suite.
+func TestSignV4(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+	signV4(req, nil, "service", "us-east-1", "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+		"SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		req.Header.Get("Authorization"))
+}
+
+func TestGcpKmsProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		json.NewDecoder(r.Body).Decode(&req)
+		if r.URL.Path != "/v1/projects/p/locations/global/keyRings/r/cryptoKeys/k:decrypt" ||
+			r.Header.Get("Authorization") != "Bearer token" || req["ciphertext"] != "Y2lwaGVy" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"plaintext":"` + base64.StdEncoding.EncodeToString([]byte("passphrase")) + `"}`))
+	}))
+	defer server.Close()
+
+	os.Setenv(GcpAccessTokenEnv, "token")
+	defer os.Unsetenv(GcpAccessTokenEnv)
+
+	secret, err := NewGcpKmsProvider("projects/p/locations/global/keyRings/r/cryptoKeys/k", "Y2lwaGVy", server.URL).Secret(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("passphrase"), secret)
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secret
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	defaultVaultField    = "passphrase"
+	defaultVaultTokenEnv = "VAULT_TOKEN"
+)
+
+// VaultProvider reads a secret from a HashiCorp Vault KV v2 engine.
+type VaultProvider struct {
+	address  string
+	path     string
+	field    string
+	tokenEnv string
+	client   *http.Client
+}
+
+// NewVaultProvider create a vault provider reading field of the secret at path.
+func NewVaultProvider(address, path, field, tokenEnv string) *VaultProvider {
+	if len(field) == 0 {
+		field = defaultVaultField
+	}
+	if len(tokenEnv) == 0 {
+		tokenEnv = defaultVaultTokenEnv
+	}
+	return &VaultProvider{
+		address:  strings.TrimSuffix(address, "/"),
+		path:     strings.TrimPrefix(path, "/"),
+		field:    field,
+		tokenEnv: tokenEnv,
+		client:   &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Secret returns the field of the vault secret.
+func (p *VaultProvider) Secret(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequest("GET", p.address+"/v1/"+p.path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("X-Vault-Token", os.Getenv(p.tokenEnv))
+
+	var resp struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	if err := doJSON(p.client, req, &resp); err != nil {
+		return nil, err
+	}
+	value, ok := resp.Data.Data[p.field]
+	if !ok {
+		return nil, ErrSecretNotFound
+	}
+	return []byte(value), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package version is the build info of the binary, set by the -X linker flags
+// of the Makefile.
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Build info, set by the linker flags.
+var (
+	// Version is the release version.
+	Version = "0.6.0"
+	// Commit is the git commit of the source.
+	Commit string
+	// Branch is the git branch of the source.
+	Branch string
+	// BuildDate is the unix time of the commit, not of the build, so the same
+	// source always builds the same binary.
+	BuildDate string
+	// Features are the build tags separated by commas.
+	Features string
+)
+
+// Info is the build info of the binary.
+type Info struct {
+	Version   string
+	Commit    string
+	Branch    string
+	BuildDate time.Time
+	Features  []string
+	GoVersion string
+	OS        string
+	Arch      string
+}
+
+// Get returns the build info of the binary.
+func Get() *Info {
+	info := &Info{
+		Version:   Version,
+		Commit:    Commit,
+		Branch:    Branch,
+		Features:  []string{},
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+	}
+	var sec int64
+	if _, err := fmt.Sscanf(BuildDate, "%d", &sec); err == nil {
+		info.BuildDate = time.Unix(sec, 0).UTC()
+	}
+	for _, f := range strings.Split(Features, ",") {
+		if f = strings.TrimSpace(f); len(f) > 0 {
+			info.Features = append(info.Features, f)
+		}
+	}
+	return info
+}
+
+// String returns the version of the binary like "neb/0.6.0-8ee4855", shown to the peers.
+func String() string {
+	s := "neb/" + Version
+	if len(Commit) > 0 {
+		commit := Commit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+		s += "-" + commit
+	}
+	return s
+}
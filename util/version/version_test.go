@@ -0,0 +1,45 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package version
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	Commit, BuildDate, Features = "8ee4855e1f0c3b9b0d3f7cd1c2c9a6c4cbd07a1e", "1528000000", "sandbox, debug"
+	defer func() { Commit, BuildDate, Features = "", "", "" }()
+
+	info := Get()
+	assert.Equal(t, Version, info.Version)
+	assert.Equal(t, time.Unix(1528000000, 0).UTC(), info.BuildDate)
+	assert.Equal(t, []string{"sandbox", "debug"}, info.Features)
+	assert.Equal(t, runtime.GOOS, info.OS)
+	assert.Equal(t, "neb/"+Version+"-8ee4855", String())
+
+	Commit, BuildDate, Features = "", "", ""
+	info = Get()
+	assert.True(t, info.BuildDate.IsZero())
+	assert.Equal(t, []string{}, info.Features)
+	assert.Equal(t, "neb/"+Version, String())
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package watchdog
+
+import (
+	"io/ioutil"
+	"runtime"
+	"syscall"
+)
+
+// fdDirs list the directories holding one entry per open file descriptor.
+var fdDirs = []string{"/proc/self/fd", "/dev/fd"}
+
+// Usage is a snapshot of the resources used by the node.
+type Usage struct {
+	// DiskFree is the free disk space of datadir in bytes.
+	DiskFree uint64
+	// OpenFDs is the number of open file descriptors.
+	OpenFDs uint64
+	// MaxFDs is the soft limit of open file descriptors.
+	MaxFDs uint64
+	// Memory is the memory obtained from the OS in bytes.
+	Memory uint64
+}
+
+// probeUsage reads the resource usage of current process.
+func probeUsage(datadir string) (*Usage, error) {
+	usage := new(Usage)
+
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(datadir, &stat); err != nil {
+		return nil, err
+	}
+	usage.DiskFree = uint64(stat.Bavail) * uint64(stat.Bsize)
+
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		return nil, err
+	}
+	usage.MaxFDs = uint64(limit.Cur)
+
+	for _, dir := range fdDirs {
+		if files, err := ioutil.ReadDir(dir); err == nil {
+			usage.OpenFDs = uint64(len(files))
+			break
+		}
+	}
+
+	memstats := new(runtime.MemStats)
+	runtime.ReadMemStats(memstats)
+	usage.Memory = memstats.Sys
+
+	return usage, nil
+}
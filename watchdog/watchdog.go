@@ -0,0 +1,280 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package watchdog
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultInterval = 10 * time.Second
+	mb              = 1024 * 1024
+)
+
+// resources
+const (
+	ResourceDisk   = "disk"
+	ResourceFD     = "fd"
+	ResourceMemory = "memory"
+)
+
+// levels of a resource.
+const (
+	levelNormal = iota
+	levelWarn
+	levelPause
+)
+
+// Metrics
+var (
+	diskFreeGauge = metrics.GetOrRegisterGauge("neb.watchdog.disk.free", nil)
+	openFDsGauge  = metrics.GetOrRegisterGauge("neb.watchdog.fds", nil)
+	memoryGauge   = metrics.GetOrRegisterGauge("neb.watchdog.memory", nil)
+)
+
+// Pauser is a service which stops accepting new blocks when resources are exhausted.
+type Pauser interface {
+	Pause()
+	Resume()
+}
+
+// ResourceEvent is the data of watchdog events.
+type ResourceEvent struct {
+	Resource  string `json:"resource,omitempty"`
+	Value     uint64 `json:"value,omitempty"`
+	Threshold uint64 `json:"threshold,omitempty"`
+	Pause     bool   `json:"pause,omitempty"`
+}
+
+// Watchdog monitors free disk space, open file descriptors and memory.
+// It warns via events when a resource crosses its warning threshold, and pauses
+// sync and block acceptance before a full disk corrupts the database. The services
+// are resumed once every resource is back below its warning threshold.
+type Watchdog struct {
+	conf    *nebletpb.WatchdogConfig
+	datadir string
+	emitter *core.EventEmitter
+	pausers []Pauser
+
+	mu      sync.Mutex
+	started bool
+	levels  map[string]int
+	paused  bool
+
+	probe  func(datadir string) (*Usage, error)
+	quitCh chan bool
+}
+
+// NewWatchdog create a watchdog of the resources used by datadir.
+func NewWatchdog(conf *nebletpb.WatchdogConfig, datadir string, emitter *core.EventEmitter, pausers ...Pauser) *Watchdog {
+	return &Watchdog{
+		conf:    conf,
+		datadir: datadir,
+		emitter: emitter,
+		pausers: pausers,
+		levels:  make(map[string]int),
+		probe:   probeUsage,
+		quitCh:  make(chan bool, 1),
+	}
+}
+
+// Start watchdog loop, once however many times the node starts it.
+func (w *Watchdog) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.started {
+		return
+	}
+	w.started = true
+
+	logging.CLog().WithFields(logrus.Fields{
+		"interval": w.interval(),
+	}).Info("Start Watchdog.")
+
+	go w.loop()
+}
+
+// Stop watchdog loop.
+func (w *Watchdog) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.started {
+		return
+	}
+	w.started = false
+
+	logging.CLog().Info("Stop Watchdog.")
+
+	w.quitCh <- true
+}
+
+// Paused returns whether the watchdog has paused the services.
+func (w *Watchdog) Paused() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.paused
+}
+
+func (w *Watchdog) interval() time.Duration {
+	if w.conf.Interval == 0 {
+		return defaultInterval
+	}
+	return time.Duration(w.conf.Interval) * time.Second
+}
+
+func (w *Watchdog) loop() {
+	ticker := time.NewTicker(w.interval())
+	defer ticker.Stop()
+
+	w.checkOnce()
+	for {
+		select {
+		case <-w.quitCh:
+			return
+		case <-ticker.C:
+			w.checkOnce()
+		}
+	}
+}
+
+func (w *Watchdog) checkOnce() {
+	usage, err := w.probe(w.datadir)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"datadir": w.datadir,
+			"err":     err,
+		}).Error("Failed to probe resource usage.")
+		return
+	}
+	w.check(usage)
+}
+
+// check updates the level of each resource and pauses or resumes the services.
+func (w *Watchdog) check(usage *Usage) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	diskFreeGauge.Update(int64(usage.DiskFree))
+	openFDsGauge.Update(int64(usage.OpenFDs))
+	memoryGauge.Update(int64(usage.Memory))
+
+	pause := false
+	normal := true
+
+	if w.conf.DiskWarnMb > 0 || w.conf.DiskPauseMb > 0 {
+		free := usage.DiskFree / mb
+		level := levelNormal
+		threshold := w.conf.DiskWarnMb
+		if free < w.conf.DiskPauseMb {
+			level, threshold = levelPause, w.conf.DiskPauseMb
+		} else if free < w.conf.DiskWarnMb {
+			level = levelWarn
+		}
+		w.update(ResourceDisk, level, free, threshold)
+		pause = pause || level == levelPause
+		normal = normal && level == levelNormal
+	}
+
+	if (w.conf.FdWarnPercent > 0 || w.conf.FdPausePercent > 0) && usage.MaxFDs > 0 {
+		percent := usage.OpenFDs * 100 / usage.MaxFDs
+		level := levelNormal
+		threshold := uint64(w.conf.FdWarnPercent)
+		if w.conf.FdPausePercent > 0 && percent >= uint64(w.conf.FdPausePercent) {
+			level, threshold = levelPause, uint64(w.conf.FdPausePercent)
+		} else if w.conf.FdWarnPercent > 0 && percent >= uint64(w.conf.FdWarnPercent) {
+			level = levelWarn
+		}
+		w.update(ResourceFD, level, percent, threshold)
+		pause = pause || level == levelPause
+		normal = normal && level == levelNormal
+	}
+
+	if w.conf.MemoryWarnMb > 0 || w.conf.MemoryPauseMb > 0 {
+		memory := usage.Memory / mb
+		level := levelNormal
+		threshold := w.conf.MemoryWarnMb
+		if w.conf.MemoryPauseMb > 0 && memory >= w.conf.MemoryPauseMb {
+			level, threshold = levelPause, w.conf.MemoryPauseMb
+		} else if w.conf.MemoryWarnMb > 0 && memory >= w.conf.MemoryWarnMb {
+			level = levelWarn
+		}
+		w.update(ResourceMemory, level, memory, threshold)
+		pause = pause || level == levelPause
+		normal = normal && level == levelNormal
+	}
+
+	if pause && !w.paused {
+		w.paused = true
+		for _, p := range w.pausers {
+			p.Pause()
+		}
+		w.trigger(core.TopicResourcePaused, &ResourceEvent{})
+	} else if normal && w.paused {
+		w.paused = false
+		for _, p := range w.pausers {
+			p.Resume()
+		}
+		w.trigger(core.TopicResourceResumed, &ResourceEvent{})
+	}
+}
+
+// update records the level of a resource and warns when it gets worse, it's
+// called with mu held.
+func (w *Watchdog) update(resource string, level int, value, threshold uint64) {
+	last := w.levels[resource]
+	w.levels[resource] = level
+	if level <= last {
+		return
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"resource":  resource,
+		"value":     value,
+		"threshold": threshold,
+		"pause":     level == levelPause,
+	}).Warn("Resource crossed the threshold.")
+	w.trigger(core.TopicResourceWarning, &ResourceEvent{
+		Resource:  resource,
+		Value:     value,
+		Threshold: threshold,
+		Pause:     level == levelPause,
+	})
+}
+
+func (w *Watchdog) trigger(topic string, data *ResourceEvent) {
+	if w.emitter == nil {
+		return
+	}
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	w.emitter.Trigger(&core.Event{
+		Topic: topic,
+		Data:  string(bytes),
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package watchdog
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPauser struct {
+	paused bool
+}
+
+func (p *mockPauser) Pause()  { p.paused = true }
+func (p *mockPauser) Resume() { p.paused = false }
+
+func TestWatchdog_Check(t *testing.T) {
+	emitter := core.NewEventEmitter(16)
+	emitter.Start()
+	defer emitter.Stop()
+
+	warnCh := make(chan *core.Event, 16)
+	pausedCh := make(chan *core.Event, 16)
+	resumedCh := make(chan *core.Event, 16)
+	emitter.Register(core.TopicResourceWarning, warnCh)
+	emitter.Register(core.TopicResourcePaused, pausedCh)
+	emitter.Register(core.TopicResourceResumed, resumedCh)
+
+	pauser := new(mockPauser)
+	conf := &nebletpb.WatchdogConfig{
+		DiskWarnMb:     100,
+		DiskPauseMb:    10,
+		FdWarnPercent:  80,
+		FdPausePercent: 95,
+	}
+	w := NewWatchdog(conf, os.TempDir(), emitter, pauser)
+
+	// all normal.
+	w.check(&Usage{DiskFree: 200 * mb, OpenFDs: 10, MaxFDs: 100})
+	assert.False(t, w.Paused())
+
+	// disk warning.
+	w.check(&Usage{DiskFree: 50 * mb, OpenFDs: 10, MaxFDs: 100})
+	assert.False(t, pauser.paused)
+	e := waitEvent(t, warnCh)
+	assert.Equal(t, `{"resource":"disk","value":50,"threshold":100}`, e.Data)
+
+	// warning is not repeated while the level stays.
+	w.check(&Usage{DiskFree: 40 * mb, OpenFDs: 10, MaxFDs: 100})
+
+	// disk almost full, pause.
+	w.check(&Usage{DiskFree: 5 * mb, OpenFDs: 10, MaxFDs: 100})
+	assert.True(t, w.Paused())
+	assert.True(t, pauser.paused)
+	e = waitEvent(t, warnCh)
+	assert.Equal(t, `{"resource":"disk","value":5,"threshold":10,"pause":true}`, e.Data)
+	waitEvent(t, pausedCh)
+
+	// still above the warning threshold, keep paused.
+	w.check(&Usage{DiskFree: 50 * mb, OpenFDs: 10, MaxFDs: 100})
+	assert.True(t, pauser.paused)
+
+	// fd exhaustion alone also pauses.
+	w.check(&Usage{DiskFree: 200 * mb, OpenFDs: 96, MaxFDs: 100})
+	assert.True(t, pauser.paused)
+	e = waitEvent(t, warnCh)
+	assert.Equal(t, `{"resource":"fd","value":96,"threshold":95,"pause":true}`, e.Data)
+
+	// back to normal, resume.
+	w.check(&Usage{DiskFree: 200 * mb, OpenFDs: 10, MaxFDs: 100})
+	assert.False(t, w.Paused())
+	assert.False(t, pauser.paused)
+	waitEvent(t, resumedCh)
+
+	select {
+	case e := <-warnCh:
+		t.Errorf("unexpected warning %s", e.Data)
+	default:
+	}
+}
+
+func TestWatchdog_StartOnce(t *testing.T) {
+	probed := make(chan bool, 4)
+	w := NewWatchdog(&nebletpb.WatchdogConfig{Interval: 3600}, os.TempDir(), nil)
+	w.probe = func(datadir string) (*Usage, error) {
+		probed <- true
+		return &Usage{}, nil
+	}
+
+	// the node starts it again when the sync starts.
+	w.Start()
+	w.Start()
+	<-probed
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, len(probed))
+
+	w.Stop()
+	w.Stop()
+}
+
+func TestProbeUsage(t *testing.T) {
+	usage, err := probeUsage(os.TempDir())
+	assert.Nil(t, err)
+	assert.True(t, usage.DiskFree > 0)
+	assert.True(t, usage.MaxFDs > 0)
+	assert.True(t, usage.Memory > 0)
+
+	_, err = probeUsage("/not/exist/dir")
+	assert.NotNil(t, err)
+}
+
+func waitEvent(t *testing.T, ch chan *core.Event) *core.Event {
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("event not triggered")
+	}
+	return nil
+}